@@ -0,0 +1,48 @@
+// Command backfill_rollups rebuilds the metrics rollups read by the trends from the invocations in the database, for
+// databases with invocations saved before the rollups existed.
+package main
+
+import (
+	"context"
+	"flag"
+	"log/slog"
+	"os"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/migrate"
+	"github.com/buildbarn/bb-portal/pkg/rollup"
+)
+
+var (
+	dsDriver = flag.String("datasource-driver", "sqlite3", "Data source driver to use")
+	dsURL    = flag.String("datasource-url", "file:buildportal.db?_journal=WAL&_fk=1", "Data source URL for the DB")
+)
+
+func main() {
+	flag.Parse()
+
+	ctx := context.Background()
+	client, err := ent.Open(*dsDriver, *dsURL)
+	if err != nil {
+		fatal("opening ent client", "err", err)
+	}
+	if err = client.Schema.Create(ctx, migrate.WithGlobalUniqueID(true)); err != nil {
+		fatal("running schema migration", "err", err)
+	}
+	count, err := rollup.Backfill(ctx, client)
+	if err != nil {
+		fatal("backfilling metrics rollups", "err", err)
+	}
+	slog.Info("Backfilled metrics rollups", "invocations", count)
+	if err = client.Close(); err != nil {
+		fatal("closing ent client", "err", err)
+	}
+}
+
+func fatal(msg string, args ...any) {
+	// Workaround: No slog.Fatal.
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
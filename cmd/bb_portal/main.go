@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"expvar"
 	"flag"
 	"log"
 	"log/slog"
@@ -13,8 +14,12 @@ import (
 	"time"
 
 	"entgo.io/contrib/entgql"
+	gqlgengraphql "github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/debug"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/fsnotify/fsnotify"
 	_ "github.com/mattn/go-sqlite3"
@@ -24,8 +29,17 @@ import (
 	"github.com/buildbarn/bb-portal/internal/api"
 	"github.com/buildbarn/bb-portal/internal/api/grpc"
 	"github.com/buildbarn/bb-portal/internal/graphql"
+	"github.com/buildbarn/bb-portal/internal/graphql/limits"
+	"github.com/buildbarn/bb-portal/pkg/auth"
+	"github.com/buildbarn/bb-portal/pkg/blobs"
 	"github.com/buildbarn/bb-portal/pkg/cas"
+	"github.com/buildbarn/bb-portal/pkg/events"
+	"github.com/buildbarn/bb-portal/pkg/export"
 	"github.com/buildbarn/bb-portal/pkg/processing"
+	"github.com/buildbarn/bb-portal/pkg/progress"
+	"github.com/buildbarn/bb-portal/pkg/search"
+	"github.com/buildbarn/bb-portal/pkg/storage"
+	"github.com/buildbarn/bb-portal/pkg/summary"
 )
 
 const (
@@ -36,14 +50,51 @@ const (
 var (
 	httpBindAddr             = flag.String("bind-http", ":8081", "Bind address for the HTTP server.")
 	grpcBindAddr             = flag.String("bind-grpc", ":8082", "Bind address for the gRPC server.")
+	diagnosticsBindAddr      = flag.String("bind-diagnostics", "", "Bind address for the diagnostics HTTP server serving /debug/vars, not served if empty.")
 	enableDebug              = flag.Bool("debug", false, "Enable debugging mode.")
 	dsDriver                 = flag.String("datasource-driver", "sqlite3", "Data source driver to use")
 	dsURL                    = flag.String("datasource-url", "file:buildportal.db?_journal=WAL&_fk=1", "Data source URL for the DB")
 	bepFolder                = flag.String("bep-folder", "./bep-files/", "Folder to watch for new BEP files")
 	caFile                   = flag.String("ca-file", "", "Custom CA certificate file")
 	credentialsHelperCommand = flag.String("credential_helper", "", "Path to a credential helper. Compatible with Bazel's --credential_helper")
-	blobArchiveFolder        = flag.String("blob-archive-folder", "./blob-archive/",
+	credentialsHelperTimeout = flag.Duration("credential_helper_timeout", auth.DefaultCredentialsHelperParams().Timeout,
+		"How long the credential helper may run. Compatible with Bazel's --credential_helper_timeout")
+	credentialsHelperCacheDuration = flag.Duration("credential_helper_cache_duration", auth.DefaultCredentialsHelperParams().CacheDuration,
+		"How long credentials are cached when the credential helper does not tell when they expire. Compatible with Bazel's --credential_helper_cache_duration")
+	casHostsConfig = flag.String("cas-hosts-config", "",
+		"JSON file configuring the port, plaintext or TLS, mTLS client certificates and instance name mapping of CAS hosts, keyed by host name")
+	casIdleTimeout    = flag.Duration("cas-idle-timeout", cas.DefaultManagerParams().IdleTimeout, "Close CAS connections unused for this long, 0 keeps them open")
+	blobArchiveFolder = flag.String("blob-archive-folder", "./blob-archive/",
 		"Folder where blobs (log outputs, stdout, stderr, undeclared test outputs) referenced from failures are archived")
+	blobStorageURL = flag.String("blob-storage-url", "",
+		"Where to archive blobs: s3://<bucket> or bytestream://<host>/<instance>, the blob archive folder if empty")
+	s3Endpoint          = flag.String("s3-endpoint", "https://s3.amazonaws.com", "Endpoint of the S3-compatible object storage, credentials are read from AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY")
+	s3Region            = flag.String("s3-region", "us-east-1", "Region of the S3 bucket")
+	blobArchiveCompress = flag.Bool("blob-archive-compress", false, "Compress blobs at rest in the blob archive folder")
+	blobArchiveMaxSize  = flag.Int64("blob-archive-max-size-bytes", 0, "Stop archiving blobs once the blob archive folder holds this many bytes, 0 for no limit")
+	blobMaxSize         = flag.Int64("blob-max-size-bytes", 0,
+		"Truncate archived blobs bigger than this to their head and tail, 0 for no limit. Ignored when archiving to a bytestream blob storage URL")
+	blobArchiveWorkers     = flag.Int("blob-archive-workers", processing.DefaultBlobArchivingParams().Workers, "Number of blob batches archived concurrently")
+	blobArchiveMaxAttempts = flag.Int("blob-archive-max-attempts", processing.DefaultBlobArchivingParams().MaxAttempts, "Number of times a blob is tried before giving up on transient failures")
+	retentionInterval      = flag.Duration("retention-interval", processing.DefaultRetentionParams().Interval, "Interval between garbage collections of invocations and blobs, must be positive")
+	retentionMaxAge        = flag.Duration("retention-max-age", 0, "Delete invocations older than this, unless pinned. 0 keeps them forever")
+	retentionFailedMaxAge  = flag.Duration("retention-failed-max-age", 0, "Keep failed invocations until they are older than this, when longer than --retention-max-age")
+	adminTokensFile        = flag.String("admin-tokens-file", "", "File with the tokens authorizing GraphQL mutations, one per line. Mutations are forbidden without it")
+	retentionMaxPerBuild   = flag.Int("retention-max-invocations-per-build", 0, "Delete all but the latest invocations of every build, unless pinned. 0 for no limit")
+	graphqlMaxComplexity   = flag.Int("graphql-max-complexity", limits.DefaultParams().MaxComplexity,
+		"Reject GraphQL operations costing more than this, the fields loading lists costing their fields times the expected length. 0 for no limit")
+	graphqlMaxDepth               = flag.Int("graphql-max-depth", limits.DefaultParams().MaxDepth, "Reject GraphQL operations nesting fields deeper than this, 0 for no limit")
+	graphqlRateLimit              = flag.Float64("graphql-rate-limit", limits.DefaultParams().RatePerSecond, "GraphQL requests per second allowed from every client IP address, 0 for no limit")
+	graphqlRateBurst              = flag.Int("graphql-rate-burst", limits.DefaultParams().Burst, "GraphQL requests a client IP address may send at once, before being rate limited")
+	graphqlTrustedProxies         = flag.String("graphql-trusted-proxies", "", "Comma separated IP addresses or CIDR ranges of the proxies whose X-Forwarded-For header tells the client IP address to rate limit")
+	graphqlAPQCacheSize           = flag.Int("graphql-apq-cache-size", 100, "Number of automatic persisted queries remembered by their hash")
+	portalURL                     = flag.String("portal-url", "", "External URL of the portal the reports link back to, the URL of their requests when empty")
+	testHealthShardImbalanceRatio = flag.Float64("test-health-shard-imbalance-ratio", summary.DefaultTestHealthThresholds().ShardImbalanceRatio,
+		"Report the shards of a test as imbalanced when the slowest runs this many times longer than the median shard")
+	testHealthTimeoutUsage = flag.Float64("test-health-timeout-usage", summary.DefaultTestHealthThresholds().TimeoutUsage,
+		"Report a test as close to its timeout when it runs longer than this fraction of the timeout of its size")
+	testHealthOversizedTimeoutUsage = flag.Float64("test-health-oversized-timeout-usage", summary.DefaultTestHealthThresholds().OversizedTimeoutUsage,
+		"Report the size of a test as oversized when it runs shorter than this fraction of the timeout of a smaller size")
 )
 
 func main() {
@@ -59,9 +110,32 @@ func main() {
 	if err = client.Schema.Create(context.Background(), migrate.WithGlobalUniqueID(true)); err != nil {
 		fatal("running schema migration", "err", err)
 	}
+	if err = search.Migrate(context.Background(), client); err != nil {
+		fatal("running search index migration", "err", err)
+	}
+	if err = processing.MigrateProblemBlobs(context.Background(), client); err != nil {
+		fatal("linking problems to their blobs", "err", err)
+	}
+
+	casManager := configureCASConnections()
 
+	blobStorage := configureBlobStorage(casManager)
 	blobArchiver := processing.NewBlobMultiArchiver()
-	configureBlobArchiving(blobArchiver, *blobArchiveFolder)
+	configureBlobArchiving(blobArchiver, casManager, blobStorage)
+	blobArchivingParams := processing.DefaultBlobArchivingParams()
+	blobArchivingParams.Workers = *blobArchiveWorkers
+	blobArchivingParams.MaxAttempts = *blobArchiveMaxAttempts
+	blobArchivingPool := processing.NewBlobArchivingPool(client, blobArchiver, blobStorage, blobArchivingParams)
+	go blobArchivingPool.Run(context.Background())
+	garbageCollector := runGarbageCollector(client, blobStorage)
+	progressHub := progress.NewHub()
+	// Served on /debug/vars by the diagnostics server.
+	expvar.Publish("progress_subscribers", expvar.Func(func() any { return progressHub.Subscribers() }))
+	testHealthThresholds := summary.TestHealthThresholds{
+		ShardImbalanceRatio:   *testHealthShardImbalanceRatio,
+		TimeoutUsage:          *testHealthTimeoutUsage,
+		OversizedTimeoutUsage: *testHealthOversizedTimeoutUsage,
+	}
 
 	// Create new watcher.
 	watcher, err := fsnotify.NewWatcher()
@@ -69,35 +143,60 @@ func main() {
 		fatal("failed to create fsnotify.Watcher", "err", err)
 	}
 	defer watcher.Close()
-	runWatcher(watcher, client, *bepFolder, blobArchiver)
+	worker := processing.New(client, blobArchivingPool, progressHub, testHealthThresholds)
+	runWatcher(watcher, *bepFolder, worker)
 
-	srv := handler.NewDefaultServer(graphql.NewSchema(client))
+	blobOpener := blobs.NewOpener(casManager, blobStorage)
+	srv := newGraphQLServer(graphql.NewSchema(client, graphql.SchemaParams{
+		BlobOpener:        blobOpener,
+		GarbageCollector:  garbageCollector,
+		BlobArchivingPool: blobArchivingPool,
+		ProgressHub:       progressHub,
+		Workflow:          worker,
+	}))
 	srv.Use(entgql.Transactioner{TxOpener: client})
 	if *enableDebug {
 		srv.Use(&debug.Tracer{})
 	}
 
 	fs := frontendServer()
-	http.Handle("/graphql", srv)
-	http.Handle("/graphiql",
+	// Not the default mux, which serves /debug/vars once expvar is imported.
+	mux := http.NewServeMux()
+	trustedProxies, err := limits.ParseTrustedProxies(*graphqlTrustedProxies)
+	if err != nil {
+		fatal("parsing the trusted proxies", "err", err)
+	}
+	rateLimiter := limits.NewRateLimiter(*graphqlRateLimit, *graphqlRateBurst, trustedProxies)
+	mux.Handle("/graphql", rateLimiter.Middleware(adminAuthorization(srv)))
+	mux.Handle("/graphiql",
 		playground.Handler("GraphQL Playground", "/graphql"),
 	)
-	casManager := cas.NewConnectionManager(cas.ManagerParams{
-		TLSCACertFile:            *caFile,
-		CredentialsHelperCommand: *credentialsHelperCommand,
-	})
-
-	http.Handle("/api/v1/blobs/{blobID}/{name}", api.NewBlobHandler(client, casManager))
-	http.Handle("POST /api/v1/bep/upload", api.NewBEPUploadHandler(client, blobArchiver))
-	http.Handle("/", fs)
+	mux.Handle("/api/v1/blobs/{key}/{name}", api.NewBlobHandler(client, casManager, blobStorage))
+	blobZipHandler := api.NewBlobZipHandler(client, blobOpener)
+	mux.Handle("GET /api/v1/blobs/{key}/{name}/entries", blobZipHandler)
+	mux.Handle("GET /api/v1/blobs/{key}/{name}/entries/{entry...}", blobZipHandler)
+	mux.Handle("POST /api/v1/bep/upload", api.NewBEPUploadHandler(client, blobArchivingPool, progressHub, testHealthThresholds))
+	mux.Handle("GET /api/v1/invocations", api.NewInvocationListHandler(client))
+	mux.Handle("GET /api/v1/invocations/{uuid}", api.NewInvocationExportHandler(client))
+	mux.Handle("GET /api/v1/builds/{uuid}", api.NewBuildExportHandler(client))
+	mux.Handle("GET /api/v1/invocations/{uuid}/junit.xml", api.NewInvocationReportHandler(client, *portalURL, export.ReportJUnit))
+	mux.Handle("GET /api/v1/invocations/{uuid}/report.md", api.NewInvocationReportHandler(client, *portalURL, export.ReportMarkdown))
+	mux.Handle("GET /api/v1/builds/{uuid}/junit.xml", api.NewBuildReportHandler(client, *portalURL, export.ReportJUnit))
+	mux.Handle("GET /api/v1/builds/{uuid}/report.md", api.NewBuildReportHandler(client, *portalURL, export.ReportMarkdown))
+	mux.Handle("GET /api/v1/invocations/{uuid}/events.ndjson", api.NewInvocationEventsHandler(client, events.FormatJSON))
+	mux.Handle("GET /api/v1/invocations/{uuid}/events.bin", api.NewInvocationEventsHandler(client, events.FormatBinary))
+	mux.Handle("/", fs)
 	slog.Info("HTTP listening on", "address", *httpBindAddr)
 
-	grpcServer := runGRPCServer(client, *grpcBindAddr, blobArchiver)
+	grpcServer := runGRPCServer(client, *grpcBindAddr, blobArchivingPool, progressHub, testHealthThresholds)
 	defer grpcServer.GracefulStop()
 	slog.Info("gRPC listening on", "address", *grpcBindAddr)
 
+	runDiagnosticsServer(*diagnosticsBindAddr)
+
 	server := &http.Server{
 		Addr:              *httpBindAddr,
+		Handler:           mux,
 		ReadHeaderTimeout: readHeaderTimeout,
 	}
 	if err = server.ListenAndServe(); err != nil {
@@ -105,21 +204,158 @@ func main() {
 	}
 }
 
-func configureBlobArchiving(blobArchiver processing.BlobMultiArchiver, archiveFolder string) {
-	err := os.MkdirAll(archiveFolder, folderPermission)
+func configureCASConnections() *cas.ConnectionManager {
+	casManagerParams := cas.DefaultManagerParams()
+	casManagerParams.TLSCACertFile = *caFile
+	casManagerParams.CredentialsHelper.Command = *credentialsHelperCommand
+	casManagerParams.CredentialsHelper.Timeout = *credentialsHelperTimeout
+	casManagerParams.CredentialsHelper.CacheDuration = *credentialsHelperCacheDuration
+	casManagerParams.IdleTimeout = *casIdleTimeout
+	if *casHostsConfig != "" {
+		hosts, err := cas.LoadHostParams(*casHostsConfig)
+		if err != nil {
+			fatal("loading CAS hosts configuration", "err", err)
+		}
+		casManagerParams.Hosts = hosts
+	}
+	casManager := cas.NewConnectionManager(casManagerParams)
+	// Served on /debug/vars by the diagnostics server.
+	expvar.Publish("cas_connections", expvar.Func(func() any { return casManager.Connections() }))
+	go casManager.Run(context.Background())
+	return casManager
+}
+
+func configureBlobStorage(casManager *cas.ConnectionManager) storage.BlobStorage {
+	if *blobStorageURL == "" {
+		blobStorage, err := storage.NewLocalStorage(storage.LocalStorageParams{
+			Folder:            *blobArchiveFolder,
+			Compress:          *blobArchiveCompress,
+			MaxTotalSizeBytes: *blobArchiveMaxSize,
+		})
+		if err != nil {
+			fatal("failed to create blob archive folder", "folder", *blobArchiveFolder, "err", err)
+		}
+		return blobStorage
+	}
+	uri, err := url.Parse(*blobStorageURL)
 	if err != nil {
-		fatal("failed to create blob archive folder", "folder", archiveFolder, "err", err)
+		fatal("invalid blob storage URL", "url", *blobStorageURL, "err", err)
+	}
+	switch uri.Scheme {
+	case "s3":
+		blobStorage, err := storage.NewS3Storage(storage.S3Params{
+			Endpoint:        *s3Endpoint,
+			Bucket:          uri.Host,
+			Region:          *s3Region,
+			AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		}, http.DefaultClient)
+		if err != nil {
+			fatal("failed to configure S3 blob storage", "err", err)
+		}
+		return blobStorage
+	case "bytestream":
+		return storage.NewCASStorage(casManager, uri)
+	default:
+		fatal("unsupported blob storage URL scheme", "url", *blobStorageURL)
+		return nil
 	}
-	localBlobArchiver := processing.NewLocalFileArchiver(archiveFolder)
+}
+
+func configureBlobArchiving(blobArchiver processing.BlobMultiArchiver, casManager *cas.ConnectionManager, blobStorage storage.BlobStorage) {
+	maxBlobSizeBytes := *blobMaxSize
+	if _, ok := blobStorage.(*storage.CASStorage); ok {
+		// The CAS verifies the digest of what is written, truncated blobs would not match it.
+		maxBlobSizeBytes = 0
+	}
+	localBlobArchiver := processing.NewLocalFileArchiver(blobStorage, maxBlobSizeBytes)
 	blobArchiver.RegisterArchiver("file", localBlobArchiver)
+	bytestreamBlobArchiver := processing.NewBytestreamArchiver(casManager, blobStorage, maxBlobSizeBytes)
+	blobArchiver.RegisterArchiver("bytestream", bytestreamBlobArchiver)
+}
+
+// The garbage collector also deletes invocations and builds on demand, it only runs in the background when a retention
+// policy is set.
+func runGarbageCollector(db *ent.Client, blobStorage storage.BlobStorage) *processing.GarbageCollector {
+	if *retentionInterval <= 0 {
+		fatal("--retention-interval must be positive", "interval", *retentionInterval)
+	}
+	retentionParams := processing.DefaultRetentionParams()
+	retentionParams.Interval = *retentionInterval
+	retentionParams.MaxAge = *retentionMaxAge
+	retentionParams.FailedMaxAge = *retentionFailedMaxAge
+	retentionParams.MaxInvocationsPerBuild = *retentionMaxPerBuild
+	garbageCollector := processing.NewGarbageCollector(db, blobStorage, retentionParams)
+	// Served on /debug/vars by the diagnostics server.
+	expvar.Publish("garbage_collector", expvar.Func(func() any { return garbageCollector.Stats() }))
+	// Always run, to sweep the blobs left unreferenced by the invocations deleted through the API.
+	go garbageCollector.Run(context.Background())
+	return garbageCollector
+}
+
+// Serve the variables published with expvar on their own address, away from the public HTTP server.
+func runDiagnosticsServer(bindAddr string) {
+	if bindAddr == "" {
+		return
+	}
+	mux := http.NewServeMux()
+	mux.Handle("GET /debug/vars", expvar.Handler())
+	server := &http.Server{
+		Addr:              bindAddr,
+		Handler:           mux,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	go func() {
+		if err := server.ListenAndServe(); err != nil {
+			slog.Error("diagnostics http server terminated", "err", err)
+		}
+	}()
+	slog.Info("Diagnostics HTTP listening on", "address", bindAddr)
+}
+
+// Authorize the requests bearing an administration token to run the GraphQL mutations.
+func adminAuthorization(handler http.Handler) http.Handler {
+	if *adminTokensFile == "" {
+		return handler
+	}
+	adminAuthorizer, err := auth.LoadAdminAuthorizer(*adminTokensFile)
+	if err != nil {
+		fatal("loading administration tokens", "err", err)
+	}
+	return adminAuthorizer.Middleware(handler)
+}
+
+// The GraphQL server of handler.NewDefaultServer, which also serves subscriptions over server-sent events, with limits
+// on the complexity and depth of the operations.
+func newGraphQLServer(schema gqlgengraphql.ExecutableSchema) *handler.Server {
+	srv := handler.New(schema)
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+	})
+	// Before POST, which would take the subscriptions asking for text/event-stream.
+	srv.AddTransport(transport.SSE{})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+	srv.SetQueryCache(lru.New(1000))
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.AutomaticPersistedQuery{
+		Cache: lru.New(*graphqlAPQCacheSize),
+	})
+	limitParams := limits.DefaultParams()
+	limitParams.MaxComplexity = *graphqlMaxComplexity
+	limitParams.MaxDepth = *graphqlMaxDepth
+	limits.Use(srv, limitParams)
+	return srv
 }
 
-func runGRPCServer(db *ent.Client, bindAddr string, blobArchiver processing.BlobMultiArchiver) *grpc.Server {
+func runGRPCServer(db *ent.Client, bindAddr string, blobArchivingPool *processing.BlobArchivingPool, progressHub *progress.Hub, testHealthThresholds summary.TestHealthThresholds) *grpc.Server {
 	lis, err := net.Listen("tcp", bindAddr)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
-	srv := grpc.NewServer(db, blobArchiver)
+	srv := grpc.NewServer(db, blobArchivingPool, progressHub, testHealthThresholds)
 	go func() {
 		if err := srv.Serve(lis); err != nil {
 			slog.Error("error from gRPC server", "err", err)
@@ -128,9 +364,8 @@ func runGRPCServer(db *ent.Client, bindAddr string, blobArchiver processing.Blob
 	return srv
 }
 
-func runWatcher(watcher *fsnotify.Watcher, client *ent.Client, bepFolder string, blobArchiver processing.BlobMultiArchiver) {
+func runWatcher(watcher *fsnotify.Watcher, bepFolder string, worker *processing.Workflow) {
 	ctx := context.Background()
-	worker := processing.New(client, blobArchiver)
 	// Start listening for events.
 	go func() {
 		for {
@@ -32,6 +32,9 @@ func main() {
 	if err := entc.Generate("./ent/schema", &gen.Config{
 		Target:  "./ent/gen/ent",
 		Package: "github.com/buildbarn/bb-portal/ent/gen/ent",
+		// NOTE: The search index is maintained with raw SQL, see the search package. Upserts create the rows shared by
+		// invocations saved concurrently.
+		Features: []gen.Feature{gen.FeatureExecQuery, gen.FeatureUpsert},
 	}, entc.Extensions(extensions...), entc.TemplateDir("./ent/template")); err != nil {
 		log.Fatalf("running ent codegen: %v", err)
 	}
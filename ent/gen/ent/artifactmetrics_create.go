@@ -4,8 +4,10 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/artifactmetrics"
@@ -18,6 +20,7 @@ type ArtifactMetricsCreate struct {
 	config
 	mutation *ArtifactMetricsMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // AddMetricIDs adds the "metrics" edge to the Metrics entity by IDs.
@@ -155,6 +158,7 @@ func (amc *ArtifactMetricsCreate) createSpec() (*ArtifactMetrics, *sqlgraph.Crea
 		_node = &ArtifactMetrics{config: amc.config}
 		_spec = sqlgraph.NewCreateSpec(artifactmetrics.Table, sqlgraph.NewFieldSpec(artifactmetrics.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = amc.conflict
 	if nodes := amc.mutation.MetricsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -238,11 +242,128 @@ func (amc *ArtifactMetricsCreate) createSpec() (*ArtifactMetrics, *sqlgraph.Crea
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ArtifactMetrics.Create().
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (amc *ArtifactMetricsCreate) OnConflict(opts ...sql.ConflictOption) *ArtifactMetricsUpsertOne {
+	amc.conflict = opts
+	return &ArtifactMetricsUpsertOne{
+		create: amc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ArtifactMetrics.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (amc *ArtifactMetricsCreate) OnConflictColumns(columns ...string) *ArtifactMetricsUpsertOne {
+	amc.conflict = append(amc.conflict, sql.ConflictColumns(columns...))
+	return &ArtifactMetricsUpsertOne{
+		create: amc,
+	}
+}
+
+type (
+	// ArtifactMetricsUpsertOne is the builder for "upsert"-ing
+	//  one ArtifactMetrics node.
+	ArtifactMetricsUpsertOne struct {
+		create *ArtifactMetricsCreate
+	}
+
+	// ArtifactMetricsUpsert is the "OnConflict" setter.
+	ArtifactMetricsUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ArtifactMetrics.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ArtifactMetricsUpsertOne) UpdateNewValues() *ArtifactMetricsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ArtifactMetrics.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ArtifactMetricsUpsertOne) Ignore() *ArtifactMetricsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ArtifactMetricsUpsertOne) DoNothing() *ArtifactMetricsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ArtifactMetricsCreate.OnConflict
+// documentation for more info.
+func (u *ArtifactMetricsUpsertOne) Update(set func(*ArtifactMetricsUpsert)) *ArtifactMetricsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ArtifactMetricsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ArtifactMetricsUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ArtifactMetricsCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ArtifactMetricsUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ArtifactMetricsUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ArtifactMetricsUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // ArtifactMetricsCreateBulk is the builder for creating many ArtifactMetrics entities in bulk.
 type ArtifactMetricsCreateBulk struct {
 	config
 	err      error
 	builders []*ArtifactMetricsCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the ArtifactMetrics entities in the database.
@@ -271,6 +392,7 @@ func (amcb *ArtifactMetricsCreateBulk) Save(ctx context.Context) ([]*ArtifactMet
 					_, err = mutators[i+1].Mutate(root, amcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = amcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, amcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -324,3 +446,102 @@ func (amcb *ArtifactMetricsCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ArtifactMetrics.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (amcb *ArtifactMetricsCreateBulk) OnConflict(opts ...sql.ConflictOption) *ArtifactMetricsUpsertBulk {
+	amcb.conflict = opts
+	return &ArtifactMetricsUpsertBulk{
+		create: amcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ArtifactMetrics.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (amcb *ArtifactMetricsCreateBulk) OnConflictColumns(columns ...string) *ArtifactMetricsUpsertBulk {
+	amcb.conflict = append(amcb.conflict, sql.ConflictColumns(columns...))
+	return &ArtifactMetricsUpsertBulk{
+		create: amcb,
+	}
+}
+
+// ArtifactMetricsUpsertBulk is the builder for "upsert"-ing
+// a bulk of ArtifactMetrics nodes.
+type ArtifactMetricsUpsertBulk struct {
+	create *ArtifactMetricsCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ArtifactMetrics.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ArtifactMetricsUpsertBulk) UpdateNewValues() *ArtifactMetricsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ArtifactMetrics.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ArtifactMetricsUpsertBulk) Ignore() *ArtifactMetricsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ArtifactMetricsUpsertBulk) DoNothing() *ArtifactMetricsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ArtifactMetricsCreateBulk.OnConflict
+// documentation for more info.
+func (u *ArtifactMetricsUpsertBulk) Update(set func(*ArtifactMetricsUpsert)) *ArtifactMetricsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ArtifactMetricsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ArtifactMetricsUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ArtifactMetricsCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ArtifactMetricsCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ArtifactMetricsUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
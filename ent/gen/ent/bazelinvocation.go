@@ -55,6 +55,14 @@ type BazelInvocation struct {
 	ConfigurationMnemonic string `json:"configuration_mnemonic,omitempty"`
 	// NumFetches holds the value of the "num_fetches" field.
 	NumFetches int64 `json:"num_fetches,omitempty"`
+	// Branch holds the value of the "branch" field.
+	Branch string `json:"branch,omitempty"`
+	// Commit holds the value of the "commit" field.
+	Commit string `json:"commit,omitempty"`
+	// FailureClassification holds the value of the "failure_classification" field.
+	FailureClassification bazelinvocation.FailureClassification `json:"failure_classification,omitempty"`
+	// Pinned holds the value of the "pinned" field.
+	Pinned bool `json:"pinned,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the BazelInvocationQuery when eager-loading is set.
 	Edges                       BazelInvocationEdges `json:"edges"`
@@ -77,15 +85,21 @@ type BazelInvocationEdges struct {
 	TestCollection []*TestCollection `json:"test_collection,omitempty"`
 	// Targets holds the value of the targets edge.
 	Targets []*TargetPair `json:"targets,omitempty"`
+	// TestHealthReports holds the value of the test_health_reports edge.
+	TestHealthReports []*TestHealthReport `json:"test_health_reports,omitempty"`
+	// SearchChunks holds the value of the search_chunks edge.
+	SearchChunks []*SearchChunk `json:"search_chunks,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [6]bool
+	loadedTypes [8]bool
 	// totalCount holds the count of the edges above.
-	totalCount [5]map[string]int
+	totalCount [6]map[string]int
 
-	namedProblems       map[string][]*BazelInvocationProblem
-	namedTestCollection map[string][]*TestCollection
-	namedTargets        map[string][]*TargetPair
+	namedProblems          map[string][]*BazelInvocationProblem
+	namedTestCollection    map[string][]*TestCollection
+	namedTargets           map[string][]*TargetPair
+	namedTestHealthReports map[string][]*TestHealthReport
+	namedSearchChunks      map[string][]*SearchChunk
 }
 
 // EventFileOrErr returns the EventFile value or an error if the edge
@@ -148,6 +162,24 @@ func (e BazelInvocationEdges) TargetsOrErr() ([]*TargetPair, error) {
 	return nil, &NotLoadedError{edge: "targets"}
 }
 
+// TestHealthReportsOrErr returns the TestHealthReports value or an error if the edge
+// was not loaded in eager-loading.
+func (e BazelInvocationEdges) TestHealthReportsOrErr() ([]*TestHealthReport, error) {
+	if e.loadedTypes[6] {
+		return e.TestHealthReports, nil
+	}
+	return nil, &NotLoadedError{edge: "test_health_reports"}
+}
+
+// SearchChunksOrErr returns the SearchChunks value or an error if the edge
+// was not loaded in eager-loading.
+func (e BazelInvocationEdges) SearchChunksOrErr() ([]*SearchChunk, error) {
+	if e.loadedTypes[7] {
+		return e.SearchChunks, nil
+	}
+	return nil, &NotLoadedError{edge: "search_chunks"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*BazelInvocation) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -155,11 +187,11 @@ func (*BazelInvocation) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case bazelinvocation.FieldSummary, bazelinvocation.FieldRelatedFiles:
 			values[i] = new([]byte)
-		case bazelinvocation.FieldBepCompleted:
+		case bazelinvocation.FieldBepCompleted, bazelinvocation.FieldPinned:
 			values[i] = new(sql.NullBool)
 		case bazelinvocation.FieldID, bazelinvocation.FieldChangeNumber, bazelinvocation.FieldPatchsetNumber, bazelinvocation.FieldNumFetches:
 			values[i] = new(sql.NullInt64)
-		case bazelinvocation.FieldStepLabel, bazelinvocation.FieldUserEmail, bazelinvocation.FieldUserLdap, bazelinvocation.FieldBuildLogs, bazelinvocation.FieldCPU, bazelinvocation.FieldPlatformName, bazelinvocation.FieldConfigurationMnemonic:
+		case bazelinvocation.FieldStepLabel, bazelinvocation.FieldUserEmail, bazelinvocation.FieldUserLdap, bazelinvocation.FieldBuildLogs, bazelinvocation.FieldCPU, bazelinvocation.FieldPlatformName, bazelinvocation.FieldConfigurationMnemonic, bazelinvocation.FieldBranch, bazelinvocation.FieldCommit, bazelinvocation.FieldFailureClassification:
 			values[i] = new(sql.NullString)
 		case bazelinvocation.FieldStartedAt, bazelinvocation.FieldEndedAt:
 			values[i] = new(sql.NullTime)
@@ -290,6 +322,30 @@ func (bi *BazelInvocation) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				bi.NumFetches = value.Int64
 			}
+		case bazelinvocation.FieldBranch:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field branch", values[i])
+			} else if value.Valid {
+				bi.Branch = value.String
+			}
+		case bazelinvocation.FieldCommit:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field commit", values[i])
+			} else if value.Valid {
+				bi.Commit = value.String
+			}
+		case bazelinvocation.FieldFailureClassification:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_classification", values[i])
+			} else if value.Valid {
+				bi.FailureClassification = bazelinvocation.FailureClassification(value.String)
+			}
+		case bazelinvocation.FieldPinned:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field pinned", values[i])
+			} else if value.Valid {
+				bi.Pinned = value.Bool
+			}
 		case bazelinvocation.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field build_invocations", value)
@@ -347,6 +403,16 @@ func (bi *BazelInvocation) QueryTargets() *TargetPairQuery {
 	return NewBazelInvocationClient(bi.config).QueryTargets(bi)
 }
 
+// QueryTestHealthReports queries the "test_health_reports" edge of the BazelInvocation entity.
+func (bi *BazelInvocation) QueryTestHealthReports() *TestHealthReportQuery {
+	return NewBazelInvocationClient(bi.config).QueryTestHealthReports(bi)
+}
+
+// QuerySearchChunks queries the "search_chunks" edge of the BazelInvocation entity.
+func (bi *BazelInvocation) QuerySearchChunks() *SearchChunkQuery {
+	return NewBazelInvocationClient(bi.config).QuerySearchChunks(bi)
+}
+
 // Update returns a builder for updating this BazelInvocation.
 // Note that you need to call BazelInvocation.Unwrap() before calling this method if this BazelInvocation
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -417,6 +483,18 @@ func (bi *BazelInvocation) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("num_fetches=")
 	builder.WriteString(fmt.Sprintf("%v", bi.NumFetches))
+	builder.WriteString(", ")
+	builder.WriteString("branch=")
+	builder.WriteString(bi.Branch)
+	builder.WriteString(", ")
+	builder.WriteString("commit=")
+	builder.WriteString(bi.Commit)
+	builder.WriteString(", ")
+	builder.WriteString("failure_classification=")
+	builder.WriteString(fmt.Sprintf("%v", bi.FailureClassification))
+	builder.WriteString(", ")
+	builder.WriteString("pinned=")
+	builder.WriteString(fmt.Sprintf("%v", bi.Pinned))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -493,5 +571,53 @@ func (bi *BazelInvocation) appendNamedTargets(name string, edges ...*TargetPair)
 	}
 }
 
+// NamedTestHealthReports returns the TestHealthReports named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (bi *BazelInvocation) NamedTestHealthReports(name string) ([]*TestHealthReport, error) {
+	if bi.Edges.namedTestHealthReports == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := bi.Edges.namedTestHealthReports[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (bi *BazelInvocation) appendNamedTestHealthReports(name string, edges ...*TestHealthReport) {
+	if bi.Edges.namedTestHealthReports == nil {
+		bi.Edges.namedTestHealthReports = make(map[string][]*TestHealthReport)
+	}
+	if len(edges) == 0 {
+		bi.Edges.namedTestHealthReports[name] = []*TestHealthReport{}
+	} else {
+		bi.Edges.namedTestHealthReports[name] = append(bi.Edges.namedTestHealthReports[name], edges...)
+	}
+}
+
+// NamedSearchChunks returns the SearchChunks named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (bi *BazelInvocation) NamedSearchChunks(name string) ([]*SearchChunk, error) {
+	if bi.Edges.namedSearchChunks == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := bi.Edges.namedSearchChunks[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (bi *BazelInvocation) appendNamedSearchChunks(name string, edges ...*SearchChunk) {
+	if bi.Edges.namedSearchChunks == nil {
+		bi.Edges.namedSearchChunks = make(map[string][]*SearchChunk)
+	}
+	if len(edges) == 0 {
+		bi.Edges.namedSearchChunks[name] = []*SearchChunk{}
+	} else {
+		bi.Edges.namedSearchChunks[name] = append(bi.Edges.namedSearchChunks[name], edges...)
+	}
+}
+
 // BazelInvocations is a parsable slice of BazelInvocation.
 type BazelInvocations []*BazelInvocation
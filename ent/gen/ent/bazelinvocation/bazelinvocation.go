@@ -3,6 +3,10 @@
 package bazelinvocation
 
 import (
+	"fmt"
+	"io"
+	"strconv"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -44,6 +48,14 @@ const (
 	FieldConfigurationMnemonic = "configuration_mnemonic"
 	// FieldNumFetches holds the string denoting the num_fetches field in the database.
 	FieldNumFetches = "num_fetches"
+	// FieldBranch holds the string denoting the branch field in the database.
+	FieldBranch = "branch"
+	// FieldCommit holds the string denoting the commit field in the database.
+	FieldCommit = "commit"
+	// FieldFailureClassification holds the string denoting the failure_classification field in the database.
+	FieldFailureClassification = "failure_classification"
+	// FieldPinned holds the string denoting the pinned field in the database.
+	FieldPinned = "pinned"
 	// EdgeEventFile holds the string denoting the event_file edge name in mutations.
 	EdgeEventFile = "event_file"
 	// EdgeBuild holds the string denoting the build edge name in mutations.
@@ -56,6 +68,10 @@ const (
 	EdgeTestCollection = "test_collection"
 	// EdgeTargets holds the string denoting the targets edge name in mutations.
 	EdgeTargets = "targets"
+	// EdgeTestHealthReports holds the string denoting the test_health_reports edge name in mutations.
+	EdgeTestHealthReports = "test_health_reports"
+	// EdgeSearchChunks holds the string denoting the search_chunks edge name in mutations.
+	EdgeSearchChunks = "search_chunks"
 	// Table holds the table name of the bazelinvocation in the database.
 	Table = "bazel_invocations"
 	// EventFileTable is the table that holds the event_file relation/edge.
@@ -96,6 +112,20 @@ const (
 	// TargetsInverseTable is the table name for the TargetPair entity.
 	// It exists in this package in order to avoid circular dependency with the "targetpair" package.
 	TargetsInverseTable = "target_pairs"
+	// TestHealthReportsTable is the table that holds the test_health_reports relation/edge.
+	TestHealthReportsTable = "test_health_reports"
+	// TestHealthReportsInverseTable is the table name for the TestHealthReport entity.
+	// It exists in this package in order to avoid circular dependency with the "testhealthreport" package.
+	TestHealthReportsInverseTable = "test_health_reports"
+	// TestHealthReportsColumn is the table column denoting the test_health_reports relation/edge.
+	TestHealthReportsColumn = "bazel_invocation_test_health_reports"
+	// SearchChunksTable is the table that holds the search_chunks relation/edge.
+	SearchChunksTable = "search_chunks"
+	// SearchChunksInverseTable is the table name for the SearchChunk entity.
+	// It exists in this package in order to avoid circular dependency with the "searchchunk" package.
+	SearchChunksInverseTable = "search_chunks"
+	// SearchChunksColumn is the table column denoting the search_chunks relation/edge.
+	SearchChunksColumn = "bazel_invocation_search_chunks"
 )
 
 // Columns holds all SQL columns for bazelinvocation fields.
@@ -117,6 +147,10 @@ var Columns = []string{
 	FieldPlatformName,
 	FieldConfigurationMnemonic,
 	FieldNumFetches,
+	FieldBranch,
+	FieldCommit,
+	FieldFailureClassification,
+	FieldPinned,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "bazel_invocations"
@@ -150,6 +184,36 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultPinned holds the default value on creation for the "pinned" field.
+	DefaultPinned bool
+)
+
+// FailureClassification defines the type for the "failure_classification" enum field.
+type FailureClassification string
+
+// FailureClassification values.
+const (
+	FailureClassificationUSER_ERROR  FailureClassification = "USER_ERROR"
+	FailureClassificationINFRA_ERROR FailureClassification = "INFRA_ERROR"
+	FailureClassificationFLAKY       FailureClassification = "FLAKY"
+	FailureClassificationCANCELLED   FailureClassification = "CANCELLED"
+)
+
+func (fc FailureClassification) String() string {
+	return string(fc)
+}
+
+// FailureClassificationValidator is a validator for the "failure_classification" field enum values. It is called by the builders before save.
+func FailureClassificationValidator(fc FailureClassification) error {
+	switch fc {
+	case FailureClassificationUSER_ERROR, FailureClassificationINFRA_ERROR, FailureClassificationFLAKY, FailureClassificationCANCELLED:
+		return nil
+	default:
+		return fmt.Errorf("bazelinvocation: invalid enum value for failure_classification field: %q", fc)
+	}
+}
+
 // OrderOption defines the ordering options for the BazelInvocation queries.
 type OrderOption func(*sql.Selector)
 
@@ -228,6 +292,26 @@ func ByNumFetches(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldNumFetches, opts...).ToFunc()
 }
 
+// ByBranch orders the results by the branch field.
+func ByBranch(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBranch, opts...).ToFunc()
+}
+
+// ByCommit orders the results by the commit field.
+func ByCommit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommit, opts...).ToFunc()
+}
+
+// ByFailureClassification orders the results by the failure_classification field.
+func ByFailureClassification(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailureClassification, opts...).ToFunc()
+}
+
+// ByPinned orders the results by the pinned field.
+func ByPinned(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPinned, opts...).ToFunc()
+}
+
 // ByEventFileField orders the results by event_file field.
 func ByEventFileField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -290,6 +374,34 @@ func ByTargets(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTargetsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByTestHealthReportsCount orders the results by test_health_reports count.
+func ByTestHealthReportsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTestHealthReportsStep(), opts...)
+	}
+}
+
+// ByTestHealthReports orders the results by test_health_reports terms.
+func ByTestHealthReports(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTestHealthReportsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySearchChunksCount orders the results by search_chunks count.
+func BySearchChunksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSearchChunksStep(), opts...)
+	}
+}
+
+// BySearchChunks orders the results by search_chunks terms.
+func BySearchChunks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSearchChunksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newEventFileStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -332,3 +444,35 @@ func newTargetsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, false, TargetsTable, TargetsPrimaryKey...),
 	)
 }
+func newTestHealthReportsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TestHealthReportsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TestHealthReportsTable, TestHealthReportsColumn),
+	)
+}
+func newSearchChunksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SearchChunksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SearchChunksTable, SearchChunksColumn),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e FailureClassification) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *FailureClassification) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = FailureClassification(str)
+	if err := FailureClassificationValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid FailureClassification", str)
+	}
+	return nil
+}
@@ -126,6 +126,21 @@ func NumFetches(v int64) predicate.BazelInvocation {
 	return predicate.BazelInvocation(sql.FieldEQ(FieldNumFetches, v))
 }
 
+// Branch applies equality check predicate on the "branch" field. It's identical to BranchEQ.
+func Branch(v string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldEQ(FieldBranch, v))
+}
+
+// Commit applies equality check predicate on the "commit" field. It's identical to CommitEQ.
+func Commit(v string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldEQ(FieldCommit, v))
+}
+
+// Pinned applies equality check predicate on the "pinned" field. It's identical to PinnedEQ.
+func Pinned(v bool) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldEQ(FieldPinned, v))
+}
+
 // InvocationIDEQ applies the EQ predicate on the "invocation_id" field.
 func InvocationIDEQ(v uuid.UUID) predicate.BazelInvocation {
 	return predicate.BazelInvocation(sql.FieldEQ(FieldInvocationID, v))
@@ -941,6 +956,196 @@ func NumFetchesNotNil() predicate.BazelInvocation {
 	return predicate.BazelInvocation(sql.FieldNotNull(FieldNumFetches))
 }
 
+// BranchEQ applies the EQ predicate on the "branch" field.
+func BranchEQ(v string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldEQ(FieldBranch, v))
+}
+
+// BranchNEQ applies the NEQ predicate on the "branch" field.
+func BranchNEQ(v string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldNEQ(FieldBranch, v))
+}
+
+// BranchIn applies the In predicate on the "branch" field.
+func BranchIn(vs ...string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldIn(FieldBranch, vs...))
+}
+
+// BranchNotIn applies the NotIn predicate on the "branch" field.
+func BranchNotIn(vs ...string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldNotIn(FieldBranch, vs...))
+}
+
+// BranchGT applies the GT predicate on the "branch" field.
+func BranchGT(v string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldGT(FieldBranch, v))
+}
+
+// BranchGTE applies the GTE predicate on the "branch" field.
+func BranchGTE(v string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldGTE(FieldBranch, v))
+}
+
+// BranchLT applies the LT predicate on the "branch" field.
+func BranchLT(v string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldLT(FieldBranch, v))
+}
+
+// BranchLTE applies the LTE predicate on the "branch" field.
+func BranchLTE(v string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldLTE(FieldBranch, v))
+}
+
+// BranchContains applies the Contains predicate on the "branch" field.
+func BranchContains(v string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldContains(FieldBranch, v))
+}
+
+// BranchHasPrefix applies the HasPrefix predicate on the "branch" field.
+func BranchHasPrefix(v string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldHasPrefix(FieldBranch, v))
+}
+
+// BranchHasSuffix applies the HasSuffix predicate on the "branch" field.
+func BranchHasSuffix(v string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldHasSuffix(FieldBranch, v))
+}
+
+// BranchIsNil applies the IsNil predicate on the "branch" field.
+func BranchIsNil() predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldIsNull(FieldBranch))
+}
+
+// BranchNotNil applies the NotNil predicate on the "branch" field.
+func BranchNotNil() predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldNotNull(FieldBranch))
+}
+
+// BranchEqualFold applies the EqualFold predicate on the "branch" field.
+func BranchEqualFold(v string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldEqualFold(FieldBranch, v))
+}
+
+// BranchContainsFold applies the ContainsFold predicate on the "branch" field.
+func BranchContainsFold(v string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldContainsFold(FieldBranch, v))
+}
+
+// CommitEQ applies the EQ predicate on the "commit" field.
+func CommitEQ(v string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldEQ(FieldCommit, v))
+}
+
+// CommitNEQ applies the NEQ predicate on the "commit" field.
+func CommitNEQ(v string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldNEQ(FieldCommit, v))
+}
+
+// CommitIn applies the In predicate on the "commit" field.
+func CommitIn(vs ...string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldIn(FieldCommit, vs...))
+}
+
+// CommitNotIn applies the NotIn predicate on the "commit" field.
+func CommitNotIn(vs ...string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldNotIn(FieldCommit, vs...))
+}
+
+// CommitGT applies the GT predicate on the "commit" field.
+func CommitGT(v string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldGT(FieldCommit, v))
+}
+
+// CommitGTE applies the GTE predicate on the "commit" field.
+func CommitGTE(v string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldGTE(FieldCommit, v))
+}
+
+// CommitLT applies the LT predicate on the "commit" field.
+func CommitLT(v string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldLT(FieldCommit, v))
+}
+
+// CommitLTE applies the LTE predicate on the "commit" field.
+func CommitLTE(v string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldLTE(FieldCommit, v))
+}
+
+// CommitContains applies the Contains predicate on the "commit" field.
+func CommitContains(v string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldContains(FieldCommit, v))
+}
+
+// CommitHasPrefix applies the HasPrefix predicate on the "commit" field.
+func CommitHasPrefix(v string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldHasPrefix(FieldCommit, v))
+}
+
+// CommitHasSuffix applies the HasSuffix predicate on the "commit" field.
+func CommitHasSuffix(v string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldHasSuffix(FieldCommit, v))
+}
+
+// CommitIsNil applies the IsNil predicate on the "commit" field.
+func CommitIsNil() predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldIsNull(FieldCommit))
+}
+
+// CommitNotNil applies the NotNil predicate on the "commit" field.
+func CommitNotNil() predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldNotNull(FieldCommit))
+}
+
+// CommitEqualFold applies the EqualFold predicate on the "commit" field.
+func CommitEqualFold(v string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldEqualFold(FieldCommit, v))
+}
+
+// CommitContainsFold applies the ContainsFold predicate on the "commit" field.
+func CommitContainsFold(v string) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldContainsFold(FieldCommit, v))
+}
+
+// FailureClassificationEQ applies the EQ predicate on the "failure_classification" field.
+func FailureClassificationEQ(v FailureClassification) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldEQ(FieldFailureClassification, v))
+}
+
+// FailureClassificationNEQ applies the NEQ predicate on the "failure_classification" field.
+func FailureClassificationNEQ(v FailureClassification) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldNEQ(FieldFailureClassification, v))
+}
+
+// FailureClassificationIn applies the In predicate on the "failure_classification" field.
+func FailureClassificationIn(vs ...FailureClassification) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldIn(FieldFailureClassification, vs...))
+}
+
+// FailureClassificationNotIn applies the NotIn predicate on the "failure_classification" field.
+func FailureClassificationNotIn(vs ...FailureClassification) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldNotIn(FieldFailureClassification, vs...))
+}
+
+// FailureClassificationIsNil applies the IsNil predicate on the "failure_classification" field.
+func FailureClassificationIsNil() predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldIsNull(FieldFailureClassification))
+}
+
+// FailureClassificationNotNil applies the NotNil predicate on the "failure_classification" field.
+func FailureClassificationNotNil() predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldNotNull(FieldFailureClassification))
+}
+
+// PinnedEQ applies the EQ predicate on the "pinned" field.
+func PinnedEQ(v bool) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldEQ(FieldPinned, v))
+}
+
+// PinnedNEQ applies the NEQ predicate on the "pinned" field.
+func PinnedNEQ(v bool) predicate.BazelInvocation {
+	return predicate.BazelInvocation(sql.FieldNEQ(FieldPinned, v))
+}
+
 // HasEventFile applies the HasEdge predicate on the "event_file" edge.
 func HasEventFile() predicate.BazelInvocation {
 	return predicate.BazelInvocation(func(s *sql.Selector) {
@@ -1079,6 +1284,52 @@ func HasTargetsWith(preds ...predicate.TargetPair) predicate.BazelInvocation {
 	})
 }
 
+// HasTestHealthReports applies the HasEdge predicate on the "test_health_reports" edge.
+func HasTestHealthReports() predicate.BazelInvocation {
+	return predicate.BazelInvocation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TestHealthReportsTable, TestHealthReportsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTestHealthReportsWith applies the HasEdge predicate on the "test_health_reports" edge with a given conditions (other predicates).
+func HasTestHealthReportsWith(preds ...predicate.TestHealthReport) predicate.BazelInvocation {
+	return predicate.BazelInvocation(func(s *sql.Selector) {
+		step := newTestHealthReportsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSearchChunks applies the HasEdge predicate on the "search_chunks" edge.
+func HasSearchChunks() predicate.BazelInvocation {
+	return predicate.BazelInvocation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SearchChunksTable, SearchChunksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSearchChunksWith applies the HasEdge predicate on the "search_chunks" edge with a given conditions (other predicates).
+func HasSearchChunksWith(preds ...predicate.SearchChunk) predicate.BazelInvocation {
+	return predicate.BazelInvocation(func(s *sql.Selector) {
+		step := newSearchChunksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.BazelInvocation) predicate.BazelInvocation {
 	return predicate.BazelInvocation(sql.AndPredicates(predicates...))
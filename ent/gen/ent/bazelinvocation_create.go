@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
@@ -15,8 +16,10 @@ import (
 	"github.com/buildbarn/bb-portal/ent/gen/ent/build"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/eventfile"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/metrics"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/searchchunk"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/targetpair"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testcollection"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/testhealthreport"
 	"github.com/buildbarn/bb-portal/pkg/summary"
 	"github.com/google/uuid"
 )
@@ -26,6 +29,7 @@ type BazelInvocationCreate struct {
 	config
 	mutation *BazelInvocationMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetInvocationID sets the "invocation_id" field.
@@ -212,6 +216,62 @@ func (bic *BazelInvocationCreate) SetNillableNumFetches(i *int64) *BazelInvocati
 	return bic
 }
 
+// SetBranch sets the "branch" field.
+func (bic *BazelInvocationCreate) SetBranch(s string) *BazelInvocationCreate {
+	bic.mutation.SetBranch(s)
+	return bic
+}
+
+// SetNillableBranch sets the "branch" field if the given value is not nil.
+func (bic *BazelInvocationCreate) SetNillableBranch(s *string) *BazelInvocationCreate {
+	if s != nil {
+		bic.SetBranch(*s)
+	}
+	return bic
+}
+
+// SetCommit sets the "commit" field.
+func (bic *BazelInvocationCreate) SetCommit(s string) *BazelInvocationCreate {
+	bic.mutation.SetCommit(s)
+	return bic
+}
+
+// SetNillableCommit sets the "commit" field if the given value is not nil.
+func (bic *BazelInvocationCreate) SetNillableCommit(s *string) *BazelInvocationCreate {
+	if s != nil {
+		bic.SetCommit(*s)
+	}
+	return bic
+}
+
+// SetFailureClassification sets the "failure_classification" field.
+func (bic *BazelInvocationCreate) SetFailureClassification(bc bazelinvocation.FailureClassification) *BazelInvocationCreate {
+	bic.mutation.SetFailureClassification(bc)
+	return bic
+}
+
+// SetNillableFailureClassification sets the "failure_classification" field if the given value is not nil.
+func (bic *BazelInvocationCreate) SetNillableFailureClassification(bc *bazelinvocation.FailureClassification) *BazelInvocationCreate {
+	if bc != nil {
+		bic.SetFailureClassification(*bc)
+	}
+	return bic
+}
+
+// SetPinned sets the "pinned" field.
+func (bic *BazelInvocationCreate) SetPinned(b bool) *BazelInvocationCreate {
+	bic.mutation.SetPinned(b)
+	return bic
+}
+
+// SetNillablePinned sets the "pinned" field if the given value is not nil.
+func (bic *BazelInvocationCreate) SetNillablePinned(b *bool) *BazelInvocationCreate {
+	if b != nil {
+		bic.SetPinned(*b)
+	}
+	return bic
+}
+
 // SetEventFileID sets the "event_file" edge to the EventFile entity by ID.
 func (bic *BazelInvocationCreate) SetEventFileID(id int) *BazelInvocationCreate {
 	bic.mutation.SetEventFileID(id)
@@ -306,6 +366,36 @@ func (bic *BazelInvocationCreate) AddTargets(t ...*TargetPair) *BazelInvocationC
 	return bic.AddTargetIDs(ids...)
 }
 
+// AddTestHealthReportIDs adds the "test_health_reports" edge to the TestHealthReport entity by IDs.
+func (bic *BazelInvocationCreate) AddTestHealthReportIDs(ids ...int) *BazelInvocationCreate {
+	bic.mutation.AddTestHealthReportIDs(ids...)
+	return bic
+}
+
+// AddTestHealthReports adds the "test_health_reports" edges to the TestHealthReport entity.
+func (bic *BazelInvocationCreate) AddTestHealthReports(t ...*TestHealthReport) *BazelInvocationCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return bic.AddTestHealthReportIDs(ids...)
+}
+
+// AddSearchChunkIDs adds the "search_chunks" edge to the SearchChunk entity by IDs.
+func (bic *BazelInvocationCreate) AddSearchChunkIDs(ids ...int) *BazelInvocationCreate {
+	bic.mutation.AddSearchChunkIDs(ids...)
+	return bic
+}
+
+// AddSearchChunks adds the "search_chunks" edges to the SearchChunk entity.
+func (bic *BazelInvocationCreate) AddSearchChunks(s ...*SearchChunk) *BazelInvocationCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bic.AddSearchChunkIDs(ids...)
+}
+
 // Mutation returns the BazelInvocationMutation object of the builder.
 func (bic *BazelInvocationCreate) Mutation() *BazelInvocationMutation {
 	return bic.mutation
@@ -313,6 +403,7 @@ func (bic *BazelInvocationCreate) Mutation() *BazelInvocationMutation {
 
 // Save creates the BazelInvocation in the database.
 func (bic *BazelInvocationCreate) Save(ctx context.Context) (*BazelInvocation, error) {
+	bic.defaults()
 	return withHooks(ctx, bic.sqlSave, bic.mutation, bic.hooks)
 }
 
@@ -338,6 +429,14 @@ func (bic *BazelInvocationCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (bic *BazelInvocationCreate) defaults() {
+	if _, ok := bic.mutation.Pinned(); !ok {
+		v := bazelinvocation.DefaultPinned
+		bic.mutation.SetPinned(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (bic *BazelInvocationCreate) check() error {
 	if _, ok := bic.mutation.InvocationID(); !ok {
@@ -355,6 +454,14 @@ func (bic *BazelInvocationCreate) check() error {
 	if _, ok := bic.mutation.RelatedFiles(); !ok {
 		return &ValidationError{Name: "related_files", err: errors.New(`ent: missing required field "BazelInvocation.related_files"`)}
 	}
+	if v, ok := bic.mutation.FailureClassification(); ok {
+		if err := bazelinvocation.FailureClassificationValidator(v); err != nil {
+			return &ValidationError{Name: "failure_classification", err: fmt.Errorf(`ent: validator failed for field "BazelInvocation.failure_classification": %w`, err)}
+		}
+	}
+	if _, ok := bic.mutation.Pinned(); !ok {
+		return &ValidationError{Name: "pinned", err: errors.New(`ent: missing required field "BazelInvocation.pinned"`)}
+	}
 	if _, ok := bic.mutation.EventFileID(); !ok {
 		return &ValidationError{Name: "event_file", err: errors.New(`ent: missing required edge "BazelInvocation.event_file"`)}
 	}
@@ -384,6 +491,7 @@ func (bic *BazelInvocationCreate) createSpec() (*BazelInvocation, *sqlgraph.Crea
 		_node = &BazelInvocation{config: bic.config}
 		_spec = sqlgraph.NewCreateSpec(bazelinvocation.Table, sqlgraph.NewFieldSpec(bazelinvocation.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = bic.conflict
 	if value, ok := bic.mutation.InvocationID(); ok {
 		_spec.SetField(bazelinvocation.FieldInvocationID, field.TypeUUID, value)
 		_node.InvocationID = value
@@ -448,6 +556,22 @@ func (bic *BazelInvocationCreate) createSpec() (*BazelInvocation, *sqlgraph.Crea
 		_spec.SetField(bazelinvocation.FieldNumFetches, field.TypeInt64, value)
 		_node.NumFetches = value
 	}
+	if value, ok := bic.mutation.Branch(); ok {
+		_spec.SetField(bazelinvocation.FieldBranch, field.TypeString, value)
+		_node.Branch = value
+	}
+	if value, ok := bic.mutation.Commit(); ok {
+		_spec.SetField(bazelinvocation.FieldCommit, field.TypeString, value)
+		_node.Commit = value
+	}
+	if value, ok := bic.mutation.FailureClassification(); ok {
+		_spec.SetField(bazelinvocation.FieldFailureClassification, field.TypeEnum, value)
+		_node.FailureClassification = value
+	}
+	if value, ok := bic.mutation.Pinned(); ok {
+		_spec.SetField(bazelinvocation.FieldPinned, field.TypeBool, value)
+		_node.Pinned = value
+	}
 	if nodes := bic.mutation.EventFileIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -546,92 +670,1465 @@ func (bic *BazelInvocationCreate) createSpec() (*BazelInvocation, *sqlgraph.Crea
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := bic.mutation.TestHealthReportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bazelinvocation.TestHealthReportsTable,
+			Columns: []string{bazelinvocation.TestHealthReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testhealthreport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bic.mutation.SearchChunksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bazelinvocation.SearchChunksTable,
+			Columns: []string{bazelinvocation.SearchChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(searchchunk.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
-// BazelInvocationCreateBulk is the builder for creating many BazelInvocation entities in bulk.
-type BazelInvocationCreateBulk struct {
-	config
-	err      error
-	builders []*BazelInvocationCreate
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BazelInvocation.Create().
+//		SetInvocationID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BazelInvocationUpsert) {
+//			SetInvocationID(v+v).
+//		}).
+//		Exec(ctx)
+func (bic *BazelInvocationCreate) OnConflict(opts ...sql.ConflictOption) *BazelInvocationUpsertOne {
+	bic.conflict = opts
+	return &BazelInvocationUpsertOne{
+		create: bic,
+	}
 }
 
-// Save creates the BazelInvocation entities in the database.
-func (bicb *BazelInvocationCreateBulk) Save(ctx context.Context) ([]*BazelInvocation, error) {
-	if bicb.err != nil {
-		return nil, bicb.err
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BazelInvocation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bic *BazelInvocationCreate) OnConflictColumns(columns ...string) *BazelInvocationUpsertOne {
+	bic.conflict = append(bic.conflict, sql.ConflictColumns(columns...))
+	return &BazelInvocationUpsertOne{
+		create: bic,
 	}
-	specs := make([]*sqlgraph.CreateSpec, len(bicb.builders))
-	nodes := make([]*BazelInvocation, len(bicb.builders))
-	mutators := make([]Mutator, len(bicb.builders))
-	for i := range bicb.builders {
-		func(i int, root context.Context) {
-			builder := bicb.builders[i]
-			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				mutation, ok := m.(*BazelInvocationMutation)
-				if !ok {
-					return nil, fmt.Errorf("unexpected mutation type %T", m)
-				}
-				if err := builder.check(); err != nil {
-					return nil, err
-				}
-				builder.mutation = mutation
-				var err error
-				nodes[i], specs[i] = builder.createSpec()
-				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, bicb.builders[i+1].mutation)
-				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
-					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, bicb.driver, spec); err != nil {
-						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
-						}
-					}
-				}
-				if err != nil {
-					return nil, err
-				}
-				mutation.id = &nodes[i].ID
-				if specs[i].ID.Value != nil {
-					id := specs[i].ID.Value.(int64)
-					nodes[i].ID = int(id)
-				}
-				mutation.done = true
-				return nodes[i], nil
-			})
-			for i := len(builder.hooks) - 1; i >= 0; i-- {
-				mut = builder.hooks[i](mut)
-			}
-			mutators[i] = mut
-		}(i, ctx)
+}
+
+type (
+	// BazelInvocationUpsertOne is the builder for "upsert"-ing
+	//  one BazelInvocation node.
+	BazelInvocationUpsertOne struct {
+		create *BazelInvocationCreate
 	}
-	if len(mutators) > 0 {
-		if _, err := mutators[0].Mutate(ctx, bicb.builders[0].mutation); err != nil {
-			return nil, err
-		}
+
+	// BazelInvocationUpsert is the "OnConflict" setter.
+	BazelInvocationUpsert struct {
+		*sql.UpdateSet
 	}
-	return nodes, nil
+)
+
+// SetStartedAt sets the "started_at" field.
+func (u *BazelInvocationUpsert) SetStartedAt(v time.Time) *BazelInvocationUpsert {
+	u.Set(bazelinvocation.FieldStartedAt, v)
+	return u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (bicb *BazelInvocationCreateBulk) SaveX(ctx context.Context) []*BazelInvocation {
-	v, err := bicb.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *BazelInvocationUpsert) UpdateStartedAt() *BazelInvocationUpsert {
+	u.SetExcluded(bazelinvocation.FieldStartedAt)
+	return u
 }
 
-// Exec executes the query.
-func (bicb *BazelInvocationCreateBulk) Exec(ctx context.Context) error {
-	_, err := bicb.Save(ctx)
-	return err
+// SetEndedAt sets the "ended_at" field.
+func (u *BazelInvocationUpsert) SetEndedAt(v time.Time) *BazelInvocationUpsert {
+	u.Set(bazelinvocation.FieldEndedAt, v)
+	return u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (bicb *BazelInvocationCreateBulk) ExecX(ctx context.Context) {
-	if err := bicb.Exec(ctx); err != nil {
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *BazelInvocationUpsert) UpdateEndedAt() *BazelInvocationUpsert {
+	u.SetExcluded(bazelinvocation.FieldEndedAt)
+	return u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (u *BazelInvocationUpsert) ClearEndedAt() *BazelInvocationUpsert {
+	u.SetNull(bazelinvocation.FieldEndedAt)
+	return u
+}
+
+// SetChangeNumber sets the "change_number" field.
+func (u *BazelInvocationUpsert) SetChangeNumber(v int) *BazelInvocationUpsert {
+	u.Set(bazelinvocation.FieldChangeNumber, v)
+	return u
+}
+
+// UpdateChangeNumber sets the "change_number" field to the value that was provided on create.
+func (u *BazelInvocationUpsert) UpdateChangeNumber() *BazelInvocationUpsert {
+	u.SetExcluded(bazelinvocation.FieldChangeNumber)
+	return u
+}
+
+// AddChangeNumber adds v to the "change_number" field.
+func (u *BazelInvocationUpsert) AddChangeNumber(v int) *BazelInvocationUpsert {
+	u.Add(bazelinvocation.FieldChangeNumber, v)
+	return u
+}
+
+// ClearChangeNumber clears the value of the "change_number" field.
+func (u *BazelInvocationUpsert) ClearChangeNumber() *BazelInvocationUpsert {
+	u.SetNull(bazelinvocation.FieldChangeNumber)
+	return u
+}
+
+// SetPatchsetNumber sets the "patchset_number" field.
+func (u *BazelInvocationUpsert) SetPatchsetNumber(v int) *BazelInvocationUpsert {
+	u.Set(bazelinvocation.FieldPatchsetNumber, v)
+	return u
+}
+
+// UpdatePatchsetNumber sets the "patchset_number" field to the value that was provided on create.
+func (u *BazelInvocationUpsert) UpdatePatchsetNumber() *BazelInvocationUpsert {
+	u.SetExcluded(bazelinvocation.FieldPatchsetNumber)
+	return u
+}
+
+// AddPatchsetNumber adds v to the "patchset_number" field.
+func (u *BazelInvocationUpsert) AddPatchsetNumber(v int) *BazelInvocationUpsert {
+	u.Add(bazelinvocation.FieldPatchsetNumber, v)
+	return u
+}
+
+// ClearPatchsetNumber clears the value of the "patchset_number" field.
+func (u *BazelInvocationUpsert) ClearPatchsetNumber() *BazelInvocationUpsert {
+	u.SetNull(bazelinvocation.FieldPatchsetNumber)
+	return u
+}
+
+// SetSummary sets the "summary" field.
+func (u *BazelInvocationUpsert) SetSummary(v summary.InvocationSummary) *BazelInvocationUpsert {
+	u.Set(bazelinvocation.FieldSummary, v)
+	return u
+}
+
+// UpdateSummary sets the "summary" field to the value that was provided on create.
+func (u *BazelInvocationUpsert) UpdateSummary() *BazelInvocationUpsert {
+	u.SetExcluded(bazelinvocation.FieldSummary)
+	return u
+}
+
+// SetBepCompleted sets the "bep_completed" field.
+func (u *BazelInvocationUpsert) SetBepCompleted(v bool) *BazelInvocationUpsert {
+	u.Set(bazelinvocation.FieldBepCompleted, v)
+	return u
+}
+
+// UpdateBepCompleted sets the "bep_completed" field to the value that was provided on create.
+func (u *BazelInvocationUpsert) UpdateBepCompleted() *BazelInvocationUpsert {
+	u.SetExcluded(bazelinvocation.FieldBepCompleted)
+	return u
+}
+
+// ClearBepCompleted clears the value of the "bep_completed" field.
+func (u *BazelInvocationUpsert) ClearBepCompleted() *BazelInvocationUpsert {
+	u.SetNull(bazelinvocation.FieldBepCompleted)
+	return u
+}
+
+// SetStepLabel sets the "step_label" field.
+func (u *BazelInvocationUpsert) SetStepLabel(v string) *BazelInvocationUpsert {
+	u.Set(bazelinvocation.FieldStepLabel, v)
+	return u
+}
+
+// UpdateStepLabel sets the "step_label" field to the value that was provided on create.
+func (u *BazelInvocationUpsert) UpdateStepLabel() *BazelInvocationUpsert {
+	u.SetExcluded(bazelinvocation.FieldStepLabel)
+	return u
+}
+
+// SetRelatedFiles sets the "related_files" field.
+func (u *BazelInvocationUpsert) SetRelatedFiles(v map[string]string) *BazelInvocationUpsert {
+	u.Set(bazelinvocation.FieldRelatedFiles, v)
+	return u
+}
+
+// UpdateRelatedFiles sets the "related_files" field to the value that was provided on create.
+func (u *BazelInvocationUpsert) UpdateRelatedFiles() *BazelInvocationUpsert {
+	u.SetExcluded(bazelinvocation.FieldRelatedFiles)
+	return u
+}
+
+// SetUserEmail sets the "user_email" field.
+func (u *BazelInvocationUpsert) SetUserEmail(v string) *BazelInvocationUpsert {
+	u.Set(bazelinvocation.FieldUserEmail, v)
+	return u
+}
+
+// UpdateUserEmail sets the "user_email" field to the value that was provided on create.
+func (u *BazelInvocationUpsert) UpdateUserEmail() *BazelInvocationUpsert {
+	u.SetExcluded(bazelinvocation.FieldUserEmail)
+	return u
+}
+
+// ClearUserEmail clears the value of the "user_email" field.
+func (u *BazelInvocationUpsert) ClearUserEmail() *BazelInvocationUpsert {
+	u.SetNull(bazelinvocation.FieldUserEmail)
+	return u
+}
+
+// SetUserLdap sets the "user_ldap" field.
+func (u *BazelInvocationUpsert) SetUserLdap(v string) *BazelInvocationUpsert {
+	u.Set(bazelinvocation.FieldUserLdap, v)
+	return u
+}
+
+// UpdateUserLdap sets the "user_ldap" field to the value that was provided on create.
+func (u *BazelInvocationUpsert) UpdateUserLdap() *BazelInvocationUpsert {
+	u.SetExcluded(bazelinvocation.FieldUserLdap)
+	return u
+}
+
+// ClearUserLdap clears the value of the "user_ldap" field.
+func (u *BazelInvocationUpsert) ClearUserLdap() *BazelInvocationUpsert {
+	u.SetNull(bazelinvocation.FieldUserLdap)
+	return u
+}
+
+// SetBuildLogs sets the "build_logs" field.
+func (u *BazelInvocationUpsert) SetBuildLogs(v string) *BazelInvocationUpsert {
+	u.Set(bazelinvocation.FieldBuildLogs, v)
+	return u
+}
+
+// UpdateBuildLogs sets the "build_logs" field to the value that was provided on create.
+func (u *BazelInvocationUpsert) UpdateBuildLogs() *BazelInvocationUpsert {
+	u.SetExcluded(bazelinvocation.FieldBuildLogs)
+	return u
+}
+
+// ClearBuildLogs clears the value of the "build_logs" field.
+func (u *BazelInvocationUpsert) ClearBuildLogs() *BazelInvocationUpsert {
+	u.SetNull(bazelinvocation.FieldBuildLogs)
+	return u
+}
+
+// SetCPU sets the "cpu" field.
+func (u *BazelInvocationUpsert) SetCPU(v string) *BazelInvocationUpsert {
+	u.Set(bazelinvocation.FieldCPU, v)
+	return u
+}
+
+// UpdateCPU sets the "cpu" field to the value that was provided on create.
+func (u *BazelInvocationUpsert) UpdateCPU() *BazelInvocationUpsert {
+	u.SetExcluded(bazelinvocation.FieldCPU)
+	return u
+}
+
+// ClearCPU clears the value of the "cpu" field.
+func (u *BazelInvocationUpsert) ClearCPU() *BazelInvocationUpsert {
+	u.SetNull(bazelinvocation.FieldCPU)
+	return u
+}
+
+// SetPlatformName sets the "platform_name" field.
+func (u *BazelInvocationUpsert) SetPlatformName(v string) *BazelInvocationUpsert {
+	u.Set(bazelinvocation.FieldPlatformName, v)
+	return u
+}
+
+// UpdatePlatformName sets the "platform_name" field to the value that was provided on create.
+func (u *BazelInvocationUpsert) UpdatePlatformName() *BazelInvocationUpsert {
+	u.SetExcluded(bazelinvocation.FieldPlatformName)
+	return u
+}
+
+// ClearPlatformName clears the value of the "platform_name" field.
+func (u *BazelInvocationUpsert) ClearPlatformName() *BazelInvocationUpsert {
+	u.SetNull(bazelinvocation.FieldPlatformName)
+	return u
+}
+
+// SetConfigurationMnemonic sets the "configuration_mnemonic" field.
+func (u *BazelInvocationUpsert) SetConfigurationMnemonic(v string) *BazelInvocationUpsert {
+	u.Set(bazelinvocation.FieldConfigurationMnemonic, v)
+	return u
+}
+
+// UpdateConfigurationMnemonic sets the "configuration_mnemonic" field to the value that was provided on create.
+func (u *BazelInvocationUpsert) UpdateConfigurationMnemonic() *BazelInvocationUpsert {
+	u.SetExcluded(bazelinvocation.FieldConfigurationMnemonic)
+	return u
+}
+
+// ClearConfigurationMnemonic clears the value of the "configuration_mnemonic" field.
+func (u *BazelInvocationUpsert) ClearConfigurationMnemonic() *BazelInvocationUpsert {
+	u.SetNull(bazelinvocation.FieldConfigurationMnemonic)
+	return u
+}
+
+// SetNumFetches sets the "num_fetches" field.
+func (u *BazelInvocationUpsert) SetNumFetches(v int64) *BazelInvocationUpsert {
+	u.Set(bazelinvocation.FieldNumFetches, v)
+	return u
+}
+
+// UpdateNumFetches sets the "num_fetches" field to the value that was provided on create.
+func (u *BazelInvocationUpsert) UpdateNumFetches() *BazelInvocationUpsert {
+	u.SetExcluded(bazelinvocation.FieldNumFetches)
+	return u
+}
+
+// AddNumFetches adds v to the "num_fetches" field.
+func (u *BazelInvocationUpsert) AddNumFetches(v int64) *BazelInvocationUpsert {
+	u.Add(bazelinvocation.FieldNumFetches, v)
+	return u
+}
+
+// ClearNumFetches clears the value of the "num_fetches" field.
+func (u *BazelInvocationUpsert) ClearNumFetches() *BazelInvocationUpsert {
+	u.SetNull(bazelinvocation.FieldNumFetches)
+	return u
+}
+
+// SetBranch sets the "branch" field.
+func (u *BazelInvocationUpsert) SetBranch(v string) *BazelInvocationUpsert {
+	u.Set(bazelinvocation.FieldBranch, v)
+	return u
+}
+
+// UpdateBranch sets the "branch" field to the value that was provided on create.
+func (u *BazelInvocationUpsert) UpdateBranch() *BazelInvocationUpsert {
+	u.SetExcluded(bazelinvocation.FieldBranch)
+	return u
+}
+
+// ClearBranch clears the value of the "branch" field.
+func (u *BazelInvocationUpsert) ClearBranch() *BazelInvocationUpsert {
+	u.SetNull(bazelinvocation.FieldBranch)
+	return u
+}
+
+// SetCommit sets the "commit" field.
+func (u *BazelInvocationUpsert) SetCommit(v string) *BazelInvocationUpsert {
+	u.Set(bazelinvocation.FieldCommit, v)
+	return u
+}
+
+// UpdateCommit sets the "commit" field to the value that was provided on create.
+func (u *BazelInvocationUpsert) UpdateCommit() *BazelInvocationUpsert {
+	u.SetExcluded(bazelinvocation.FieldCommit)
+	return u
+}
+
+// ClearCommit clears the value of the "commit" field.
+func (u *BazelInvocationUpsert) ClearCommit() *BazelInvocationUpsert {
+	u.SetNull(bazelinvocation.FieldCommit)
+	return u
+}
+
+// SetFailureClassification sets the "failure_classification" field.
+func (u *BazelInvocationUpsert) SetFailureClassification(v bazelinvocation.FailureClassification) *BazelInvocationUpsert {
+	u.Set(bazelinvocation.FieldFailureClassification, v)
+	return u
+}
+
+// UpdateFailureClassification sets the "failure_classification" field to the value that was provided on create.
+func (u *BazelInvocationUpsert) UpdateFailureClassification() *BazelInvocationUpsert {
+	u.SetExcluded(bazelinvocation.FieldFailureClassification)
+	return u
+}
+
+// ClearFailureClassification clears the value of the "failure_classification" field.
+func (u *BazelInvocationUpsert) ClearFailureClassification() *BazelInvocationUpsert {
+	u.SetNull(bazelinvocation.FieldFailureClassification)
+	return u
+}
+
+// SetPinned sets the "pinned" field.
+func (u *BazelInvocationUpsert) SetPinned(v bool) *BazelInvocationUpsert {
+	u.Set(bazelinvocation.FieldPinned, v)
+	return u
+}
+
+// UpdatePinned sets the "pinned" field to the value that was provided on create.
+func (u *BazelInvocationUpsert) UpdatePinned() *BazelInvocationUpsert {
+	u.SetExcluded(bazelinvocation.FieldPinned)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.BazelInvocation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BazelInvocationUpsertOne) UpdateNewValues() *BazelInvocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.InvocationID(); exists {
+			s.SetIgnore(bazelinvocation.FieldInvocationID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BazelInvocation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BazelInvocationUpsertOne) Ignore() *BazelInvocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BazelInvocationUpsertOne) DoNothing() *BazelInvocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BazelInvocationCreate.OnConflict
+// documentation for more info.
+func (u *BazelInvocationUpsertOne) Update(set func(*BazelInvocationUpsert)) *BazelInvocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BazelInvocationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *BazelInvocationUpsertOne) SetStartedAt(v time.Time) *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *BazelInvocationUpsertOne) UpdateStartedAt() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *BazelInvocationUpsertOne) SetEndedAt(v time.Time) *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetEndedAt(v)
+	})
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *BazelInvocationUpsertOne) UpdateEndedAt() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateEndedAt()
+	})
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (u *BazelInvocationUpsertOne) ClearEndedAt() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearEndedAt()
+	})
+}
+
+// SetChangeNumber sets the "change_number" field.
+func (u *BazelInvocationUpsertOne) SetChangeNumber(v int) *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetChangeNumber(v)
+	})
+}
+
+// AddChangeNumber adds v to the "change_number" field.
+func (u *BazelInvocationUpsertOne) AddChangeNumber(v int) *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.AddChangeNumber(v)
+	})
+}
+
+// UpdateChangeNumber sets the "change_number" field to the value that was provided on create.
+func (u *BazelInvocationUpsertOne) UpdateChangeNumber() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateChangeNumber()
+	})
+}
+
+// ClearChangeNumber clears the value of the "change_number" field.
+func (u *BazelInvocationUpsertOne) ClearChangeNumber() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearChangeNumber()
+	})
+}
+
+// SetPatchsetNumber sets the "patchset_number" field.
+func (u *BazelInvocationUpsertOne) SetPatchsetNumber(v int) *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetPatchsetNumber(v)
+	})
+}
+
+// AddPatchsetNumber adds v to the "patchset_number" field.
+func (u *BazelInvocationUpsertOne) AddPatchsetNumber(v int) *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.AddPatchsetNumber(v)
+	})
+}
+
+// UpdatePatchsetNumber sets the "patchset_number" field to the value that was provided on create.
+func (u *BazelInvocationUpsertOne) UpdatePatchsetNumber() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdatePatchsetNumber()
+	})
+}
+
+// ClearPatchsetNumber clears the value of the "patchset_number" field.
+func (u *BazelInvocationUpsertOne) ClearPatchsetNumber() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearPatchsetNumber()
+	})
+}
+
+// SetSummary sets the "summary" field.
+func (u *BazelInvocationUpsertOne) SetSummary(v summary.InvocationSummary) *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetSummary(v)
+	})
+}
+
+// UpdateSummary sets the "summary" field to the value that was provided on create.
+func (u *BazelInvocationUpsertOne) UpdateSummary() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateSummary()
+	})
+}
+
+// SetBepCompleted sets the "bep_completed" field.
+func (u *BazelInvocationUpsertOne) SetBepCompleted(v bool) *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetBepCompleted(v)
+	})
+}
+
+// UpdateBepCompleted sets the "bep_completed" field to the value that was provided on create.
+func (u *BazelInvocationUpsertOne) UpdateBepCompleted() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateBepCompleted()
+	})
+}
+
+// ClearBepCompleted clears the value of the "bep_completed" field.
+func (u *BazelInvocationUpsertOne) ClearBepCompleted() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearBepCompleted()
+	})
+}
+
+// SetStepLabel sets the "step_label" field.
+func (u *BazelInvocationUpsertOne) SetStepLabel(v string) *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetStepLabel(v)
+	})
+}
+
+// UpdateStepLabel sets the "step_label" field to the value that was provided on create.
+func (u *BazelInvocationUpsertOne) UpdateStepLabel() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateStepLabel()
+	})
+}
+
+// SetRelatedFiles sets the "related_files" field.
+func (u *BazelInvocationUpsertOne) SetRelatedFiles(v map[string]string) *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetRelatedFiles(v)
+	})
+}
+
+// UpdateRelatedFiles sets the "related_files" field to the value that was provided on create.
+func (u *BazelInvocationUpsertOne) UpdateRelatedFiles() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateRelatedFiles()
+	})
+}
+
+// SetUserEmail sets the "user_email" field.
+func (u *BazelInvocationUpsertOne) SetUserEmail(v string) *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetUserEmail(v)
+	})
+}
+
+// UpdateUserEmail sets the "user_email" field to the value that was provided on create.
+func (u *BazelInvocationUpsertOne) UpdateUserEmail() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateUserEmail()
+	})
+}
+
+// ClearUserEmail clears the value of the "user_email" field.
+func (u *BazelInvocationUpsertOne) ClearUserEmail() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearUserEmail()
+	})
+}
+
+// SetUserLdap sets the "user_ldap" field.
+func (u *BazelInvocationUpsertOne) SetUserLdap(v string) *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetUserLdap(v)
+	})
+}
+
+// UpdateUserLdap sets the "user_ldap" field to the value that was provided on create.
+func (u *BazelInvocationUpsertOne) UpdateUserLdap() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateUserLdap()
+	})
+}
+
+// ClearUserLdap clears the value of the "user_ldap" field.
+func (u *BazelInvocationUpsertOne) ClearUserLdap() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearUserLdap()
+	})
+}
+
+// SetBuildLogs sets the "build_logs" field.
+func (u *BazelInvocationUpsertOne) SetBuildLogs(v string) *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetBuildLogs(v)
+	})
+}
+
+// UpdateBuildLogs sets the "build_logs" field to the value that was provided on create.
+func (u *BazelInvocationUpsertOne) UpdateBuildLogs() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateBuildLogs()
+	})
+}
+
+// ClearBuildLogs clears the value of the "build_logs" field.
+func (u *BazelInvocationUpsertOne) ClearBuildLogs() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearBuildLogs()
+	})
+}
+
+// SetCPU sets the "cpu" field.
+func (u *BazelInvocationUpsertOne) SetCPU(v string) *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetCPU(v)
+	})
+}
+
+// UpdateCPU sets the "cpu" field to the value that was provided on create.
+func (u *BazelInvocationUpsertOne) UpdateCPU() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateCPU()
+	})
+}
+
+// ClearCPU clears the value of the "cpu" field.
+func (u *BazelInvocationUpsertOne) ClearCPU() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearCPU()
+	})
+}
+
+// SetPlatformName sets the "platform_name" field.
+func (u *BazelInvocationUpsertOne) SetPlatformName(v string) *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetPlatformName(v)
+	})
+}
+
+// UpdatePlatformName sets the "platform_name" field to the value that was provided on create.
+func (u *BazelInvocationUpsertOne) UpdatePlatformName() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdatePlatformName()
+	})
+}
+
+// ClearPlatformName clears the value of the "platform_name" field.
+func (u *BazelInvocationUpsertOne) ClearPlatformName() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearPlatformName()
+	})
+}
+
+// SetConfigurationMnemonic sets the "configuration_mnemonic" field.
+func (u *BazelInvocationUpsertOne) SetConfigurationMnemonic(v string) *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetConfigurationMnemonic(v)
+	})
+}
+
+// UpdateConfigurationMnemonic sets the "configuration_mnemonic" field to the value that was provided on create.
+func (u *BazelInvocationUpsertOne) UpdateConfigurationMnemonic() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateConfigurationMnemonic()
+	})
+}
+
+// ClearConfigurationMnemonic clears the value of the "configuration_mnemonic" field.
+func (u *BazelInvocationUpsertOne) ClearConfigurationMnemonic() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearConfigurationMnemonic()
+	})
+}
+
+// SetNumFetches sets the "num_fetches" field.
+func (u *BazelInvocationUpsertOne) SetNumFetches(v int64) *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetNumFetches(v)
+	})
+}
+
+// AddNumFetches adds v to the "num_fetches" field.
+func (u *BazelInvocationUpsertOne) AddNumFetches(v int64) *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.AddNumFetches(v)
+	})
+}
+
+// UpdateNumFetches sets the "num_fetches" field to the value that was provided on create.
+func (u *BazelInvocationUpsertOne) UpdateNumFetches() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateNumFetches()
+	})
+}
+
+// ClearNumFetches clears the value of the "num_fetches" field.
+func (u *BazelInvocationUpsertOne) ClearNumFetches() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearNumFetches()
+	})
+}
+
+// SetBranch sets the "branch" field.
+func (u *BazelInvocationUpsertOne) SetBranch(v string) *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetBranch(v)
+	})
+}
+
+// UpdateBranch sets the "branch" field to the value that was provided on create.
+func (u *BazelInvocationUpsertOne) UpdateBranch() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateBranch()
+	})
+}
+
+// ClearBranch clears the value of the "branch" field.
+func (u *BazelInvocationUpsertOne) ClearBranch() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearBranch()
+	})
+}
+
+// SetCommit sets the "commit" field.
+func (u *BazelInvocationUpsertOne) SetCommit(v string) *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetCommit(v)
+	})
+}
+
+// UpdateCommit sets the "commit" field to the value that was provided on create.
+func (u *BazelInvocationUpsertOne) UpdateCommit() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateCommit()
+	})
+}
+
+// ClearCommit clears the value of the "commit" field.
+func (u *BazelInvocationUpsertOne) ClearCommit() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearCommit()
+	})
+}
+
+// SetFailureClassification sets the "failure_classification" field.
+func (u *BazelInvocationUpsertOne) SetFailureClassification(v bazelinvocation.FailureClassification) *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetFailureClassification(v)
+	})
+}
+
+// UpdateFailureClassification sets the "failure_classification" field to the value that was provided on create.
+func (u *BazelInvocationUpsertOne) UpdateFailureClassification() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateFailureClassification()
+	})
+}
+
+// ClearFailureClassification clears the value of the "failure_classification" field.
+func (u *BazelInvocationUpsertOne) ClearFailureClassification() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearFailureClassification()
+	})
+}
+
+// SetPinned sets the "pinned" field.
+func (u *BazelInvocationUpsertOne) SetPinned(v bool) *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetPinned(v)
+	})
+}
+
+// UpdatePinned sets the "pinned" field to the value that was provided on create.
+func (u *BazelInvocationUpsertOne) UpdatePinned() *BazelInvocationUpsertOne {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdatePinned()
+	})
+}
+
+// Exec executes the query.
+func (u *BazelInvocationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BazelInvocationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BazelInvocationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BazelInvocationUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BazelInvocationUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BazelInvocationCreateBulk is the builder for creating many BazelInvocation entities in bulk.
+type BazelInvocationCreateBulk struct {
+	config
+	err      error
+	builders []*BazelInvocationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BazelInvocation entities in the database.
+func (bicb *BazelInvocationCreateBulk) Save(ctx context.Context) ([]*BazelInvocation, error) {
+	if bicb.err != nil {
+		return nil, bicb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(bicb.builders))
+	nodes := make([]*BazelInvocation, len(bicb.builders))
+	mutators := make([]Mutator, len(bicb.builders))
+	for i := range bicb.builders {
+		func(i int, root context.Context) {
+			builder := bicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BazelInvocationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = bicb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bicb *BazelInvocationCreateBulk) SaveX(ctx context.Context) []*BazelInvocation {
+	v, err := bicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bicb *BazelInvocationCreateBulk) Exec(ctx context.Context) error {
+	_, err := bicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bicb *BazelInvocationCreateBulk) ExecX(ctx context.Context) {
+	if err := bicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BazelInvocation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BazelInvocationUpsert) {
+//			SetInvocationID(v+v).
+//		}).
+//		Exec(ctx)
+func (bicb *BazelInvocationCreateBulk) OnConflict(opts ...sql.ConflictOption) *BazelInvocationUpsertBulk {
+	bicb.conflict = opts
+	return &BazelInvocationUpsertBulk{
+		create: bicb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BazelInvocation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bicb *BazelInvocationCreateBulk) OnConflictColumns(columns ...string) *BazelInvocationUpsertBulk {
+	bicb.conflict = append(bicb.conflict, sql.ConflictColumns(columns...))
+	return &BazelInvocationUpsertBulk{
+		create: bicb,
+	}
+}
+
+// BazelInvocationUpsertBulk is the builder for "upsert"-ing
+// a bulk of BazelInvocation nodes.
+type BazelInvocationUpsertBulk struct {
+	create *BazelInvocationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BazelInvocation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BazelInvocationUpsertBulk) UpdateNewValues() *BazelInvocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.InvocationID(); exists {
+				s.SetIgnore(bazelinvocation.FieldInvocationID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BazelInvocation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BazelInvocationUpsertBulk) Ignore() *BazelInvocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BazelInvocationUpsertBulk) DoNothing() *BazelInvocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BazelInvocationCreateBulk.OnConflict
+// documentation for more info.
+func (u *BazelInvocationUpsertBulk) Update(set func(*BazelInvocationUpsert)) *BazelInvocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BazelInvocationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *BazelInvocationUpsertBulk) SetStartedAt(v time.Time) *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *BazelInvocationUpsertBulk) UpdateStartedAt() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (u *BazelInvocationUpsertBulk) SetEndedAt(v time.Time) *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetEndedAt(v)
+	})
+}
+
+// UpdateEndedAt sets the "ended_at" field to the value that was provided on create.
+func (u *BazelInvocationUpsertBulk) UpdateEndedAt() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateEndedAt()
+	})
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (u *BazelInvocationUpsertBulk) ClearEndedAt() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearEndedAt()
+	})
+}
+
+// SetChangeNumber sets the "change_number" field.
+func (u *BazelInvocationUpsertBulk) SetChangeNumber(v int) *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetChangeNumber(v)
+	})
+}
+
+// AddChangeNumber adds v to the "change_number" field.
+func (u *BazelInvocationUpsertBulk) AddChangeNumber(v int) *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.AddChangeNumber(v)
+	})
+}
+
+// UpdateChangeNumber sets the "change_number" field to the value that was provided on create.
+func (u *BazelInvocationUpsertBulk) UpdateChangeNumber() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateChangeNumber()
+	})
+}
+
+// ClearChangeNumber clears the value of the "change_number" field.
+func (u *BazelInvocationUpsertBulk) ClearChangeNumber() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearChangeNumber()
+	})
+}
+
+// SetPatchsetNumber sets the "patchset_number" field.
+func (u *BazelInvocationUpsertBulk) SetPatchsetNumber(v int) *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetPatchsetNumber(v)
+	})
+}
+
+// AddPatchsetNumber adds v to the "patchset_number" field.
+func (u *BazelInvocationUpsertBulk) AddPatchsetNumber(v int) *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.AddPatchsetNumber(v)
+	})
+}
+
+// UpdatePatchsetNumber sets the "patchset_number" field to the value that was provided on create.
+func (u *BazelInvocationUpsertBulk) UpdatePatchsetNumber() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdatePatchsetNumber()
+	})
+}
+
+// ClearPatchsetNumber clears the value of the "patchset_number" field.
+func (u *BazelInvocationUpsertBulk) ClearPatchsetNumber() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearPatchsetNumber()
+	})
+}
+
+// SetSummary sets the "summary" field.
+func (u *BazelInvocationUpsertBulk) SetSummary(v summary.InvocationSummary) *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetSummary(v)
+	})
+}
+
+// UpdateSummary sets the "summary" field to the value that was provided on create.
+func (u *BazelInvocationUpsertBulk) UpdateSummary() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateSummary()
+	})
+}
+
+// SetBepCompleted sets the "bep_completed" field.
+func (u *BazelInvocationUpsertBulk) SetBepCompleted(v bool) *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetBepCompleted(v)
+	})
+}
+
+// UpdateBepCompleted sets the "bep_completed" field to the value that was provided on create.
+func (u *BazelInvocationUpsertBulk) UpdateBepCompleted() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateBepCompleted()
+	})
+}
+
+// ClearBepCompleted clears the value of the "bep_completed" field.
+func (u *BazelInvocationUpsertBulk) ClearBepCompleted() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearBepCompleted()
+	})
+}
+
+// SetStepLabel sets the "step_label" field.
+func (u *BazelInvocationUpsertBulk) SetStepLabel(v string) *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetStepLabel(v)
+	})
+}
+
+// UpdateStepLabel sets the "step_label" field to the value that was provided on create.
+func (u *BazelInvocationUpsertBulk) UpdateStepLabel() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateStepLabel()
+	})
+}
+
+// SetRelatedFiles sets the "related_files" field.
+func (u *BazelInvocationUpsertBulk) SetRelatedFiles(v map[string]string) *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetRelatedFiles(v)
+	})
+}
+
+// UpdateRelatedFiles sets the "related_files" field to the value that was provided on create.
+func (u *BazelInvocationUpsertBulk) UpdateRelatedFiles() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateRelatedFiles()
+	})
+}
+
+// SetUserEmail sets the "user_email" field.
+func (u *BazelInvocationUpsertBulk) SetUserEmail(v string) *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetUserEmail(v)
+	})
+}
+
+// UpdateUserEmail sets the "user_email" field to the value that was provided on create.
+func (u *BazelInvocationUpsertBulk) UpdateUserEmail() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateUserEmail()
+	})
+}
+
+// ClearUserEmail clears the value of the "user_email" field.
+func (u *BazelInvocationUpsertBulk) ClearUserEmail() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearUserEmail()
+	})
+}
+
+// SetUserLdap sets the "user_ldap" field.
+func (u *BazelInvocationUpsertBulk) SetUserLdap(v string) *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetUserLdap(v)
+	})
+}
+
+// UpdateUserLdap sets the "user_ldap" field to the value that was provided on create.
+func (u *BazelInvocationUpsertBulk) UpdateUserLdap() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateUserLdap()
+	})
+}
+
+// ClearUserLdap clears the value of the "user_ldap" field.
+func (u *BazelInvocationUpsertBulk) ClearUserLdap() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearUserLdap()
+	})
+}
+
+// SetBuildLogs sets the "build_logs" field.
+func (u *BazelInvocationUpsertBulk) SetBuildLogs(v string) *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetBuildLogs(v)
+	})
+}
+
+// UpdateBuildLogs sets the "build_logs" field to the value that was provided on create.
+func (u *BazelInvocationUpsertBulk) UpdateBuildLogs() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateBuildLogs()
+	})
+}
+
+// ClearBuildLogs clears the value of the "build_logs" field.
+func (u *BazelInvocationUpsertBulk) ClearBuildLogs() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearBuildLogs()
+	})
+}
+
+// SetCPU sets the "cpu" field.
+func (u *BazelInvocationUpsertBulk) SetCPU(v string) *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetCPU(v)
+	})
+}
+
+// UpdateCPU sets the "cpu" field to the value that was provided on create.
+func (u *BazelInvocationUpsertBulk) UpdateCPU() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateCPU()
+	})
+}
+
+// ClearCPU clears the value of the "cpu" field.
+func (u *BazelInvocationUpsertBulk) ClearCPU() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearCPU()
+	})
+}
+
+// SetPlatformName sets the "platform_name" field.
+func (u *BazelInvocationUpsertBulk) SetPlatformName(v string) *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetPlatformName(v)
+	})
+}
+
+// UpdatePlatformName sets the "platform_name" field to the value that was provided on create.
+func (u *BazelInvocationUpsertBulk) UpdatePlatformName() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdatePlatformName()
+	})
+}
+
+// ClearPlatformName clears the value of the "platform_name" field.
+func (u *BazelInvocationUpsertBulk) ClearPlatformName() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearPlatformName()
+	})
+}
+
+// SetConfigurationMnemonic sets the "configuration_mnemonic" field.
+func (u *BazelInvocationUpsertBulk) SetConfigurationMnemonic(v string) *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetConfigurationMnemonic(v)
+	})
+}
+
+// UpdateConfigurationMnemonic sets the "configuration_mnemonic" field to the value that was provided on create.
+func (u *BazelInvocationUpsertBulk) UpdateConfigurationMnemonic() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateConfigurationMnemonic()
+	})
+}
+
+// ClearConfigurationMnemonic clears the value of the "configuration_mnemonic" field.
+func (u *BazelInvocationUpsertBulk) ClearConfigurationMnemonic() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearConfigurationMnemonic()
+	})
+}
+
+// SetNumFetches sets the "num_fetches" field.
+func (u *BazelInvocationUpsertBulk) SetNumFetches(v int64) *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetNumFetches(v)
+	})
+}
+
+// AddNumFetches adds v to the "num_fetches" field.
+func (u *BazelInvocationUpsertBulk) AddNumFetches(v int64) *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.AddNumFetches(v)
+	})
+}
+
+// UpdateNumFetches sets the "num_fetches" field to the value that was provided on create.
+func (u *BazelInvocationUpsertBulk) UpdateNumFetches() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateNumFetches()
+	})
+}
+
+// ClearNumFetches clears the value of the "num_fetches" field.
+func (u *BazelInvocationUpsertBulk) ClearNumFetches() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearNumFetches()
+	})
+}
+
+// SetBranch sets the "branch" field.
+func (u *BazelInvocationUpsertBulk) SetBranch(v string) *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetBranch(v)
+	})
+}
+
+// UpdateBranch sets the "branch" field to the value that was provided on create.
+func (u *BazelInvocationUpsertBulk) UpdateBranch() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateBranch()
+	})
+}
+
+// ClearBranch clears the value of the "branch" field.
+func (u *BazelInvocationUpsertBulk) ClearBranch() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearBranch()
+	})
+}
+
+// SetCommit sets the "commit" field.
+func (u *BazelInvocationUpsertBulk) SetCommit(v string) *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetCommit(v)
+	})
+}
+
+// UpdateCommit sets the "commit" field to the value that was provided on create.
+func (u *BazelInvocationUpsertBulk) UpdateCommit() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateCommit()
+	})
+}
+
+// ClearCommit clears the value of the "commit" field.
+func (u *BazelInvocationUpsertBulk) ClearCommit() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearCommit()
+	})
+}
+
+// SetFailureClassification sets the "failure_classification" field.
+func (u *BazelInvocationUpsertBulk) SetFailureClassification(v bazelinvocation.FailureClassification) *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetFailureClassification(v)
+	})
+}
+
+// UpdateFailureClassification sets the "failure_classification" field to the value that was provided on create.
+func (u *BazelInvocationUpsertBulk) UpdateFailureClassification() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdateFailureClassification()
+	})
+}
+
+// ClearFailureClassification clears the value of the "failure_classification" field.
+func (u *BazelInvocationUpsertBulk) ClearFailureClassification() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.ClearFailureClassification()
+	})
+}
+
+// SetPinned sets the "pinned" field.
+func (u *BazelInvocationUpsertBulk) SetPinned(v bool) *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.SetPinned(v)
+	})
+}
+
+// UpdatePinned sets the "pinned" field to the value that was provided on create.
+func (u *BazelInvocationUpsertBulk) UpdatePinned() *BazelInvocationUpsertBulk {
+	return u.Update(func(s *BazelInvocationUpsert) {
+		s.UpdatePinned()
+	})
+}
+
+// Exec executes the query.
+func (u *BazelInvocationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BazelInvocationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BazelInvocationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BazelInvocationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
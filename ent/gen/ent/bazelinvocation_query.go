@@ -17,29 +17,35 @@ import (
 	"github.com/buildbarn/bb-portal/ent/gen/ent/eventfile"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/metrics"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/predicate"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/searchchunk"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/targetpair"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testcollection"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/testhealthreport"
 )
 
 // BazelInvocationQuery is the builder for querying BazelInvocation entities.
 type BazelInvocationQuery struct {
 	config
-	ctx                     *QueryContext
-	order                   []bazelinvocation.OrderOption
-	inters                  []Interceptor
-	predicates              []predicate.BazelInvocation
-	withEventFile           *EventFileQuery
-	withBuild               *BuildQuery
-	withProblems            *BazelInvocationProblemQuery
-	withMetrics             *MetricsQuery
-	withTestCollection      *TestCollectionQuery
-	withTargets             *TargetPairQuery
-	withFKs                 bool
-	modifiers               []func(*sql.Selector)
-	loadTotal               []func(context.Context, []*BazelInvocation) error
-	withNamedProblems       map[string]*BazelInvocationProblemQuery
-	withNamedTestCollection map[string]*TestCollectionQuery
-	withNamedTargets        map[string]*TargetPairQuery
+	ctx                        *QueryContext
+	order                      []bazelinvocation.OrderOption
+	inters                     []Interceptor
+	predicates                 []predicate.BazelInvocation
+	withEventFile              *EventFileQuery
+	withBuild                  *BuildQuery
+	withProblems               *BazelInvocationProblemQuery
+	withMetrics                *MetricsQuery
+	withTestCollection         *TestCollectionQuery
+	withTargets                *TargetPairQuery
+	withTestHealthReports      *TestHealthReportQuery
+	withSearchChunks           *SearchChunkQuery
+	withFKs                    bool
+	modifiers                  []func(*sql.Selector)
+	loadTotal                  []func(context.Context, []*BazelInvocation) error
+	withNamedProblems          map[string]*BazelInvocationProblemQuery
+	withNamedTestCollection    map[string]*TestCollectionQuery
+	withNamedTargets           map[string]*TargetPairQuery
+	withNamedTestHealthReports map[string]*TestHealthReportQuery
+	withNamedSearchChunks      map[string]*SearchChunkQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -208,6 +214,50 @@ func (biq *BazelInvocationQuery) QueryTargets() *TargetPairQuery {
 	return query
 }
 
+// QueryTestHealthReports chains the current query on the "test_health_reports" edge.
+func (biq *BazelInvocationQuery) QueryTestHealthReports() *TestHealthReportQuery {
+	query := (&TestHealthReportClient{config: biq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := biq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := biq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bazelinvocation.Table, bazelinvocation.FieldID, selector),
+			sqlgraph.To(testhealthreport.Table, testhealthreport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, bazelinvocation.TestHealthReportsTable, bazelinvocation.TestHealthReportsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(biq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySearchChunks chains the current query on the "search_chunks" edge.
+func (biq *BazelInvocationQuery) QuerySearchChunks() *SearchChunkQuery {
+	query := (&SearchChunkClient{config: biq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := biq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := biq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bazelinvocation.Table, bazelinvocation.FieldID, selector),
+			sqlgraph.To(searchchunk.Table, searchchunk.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, bazelinvocation.SearchChunksTable, bazelinvocation.SearchChunksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(biq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first BazelInvocation entity from the query.
 // Returns a *NotFoundError when no BazelInvocation was found.
 func (biq *BazelInvocationQuery) First(ctx context.Context) (*BazelInvocation, error) {
@@ -395,17 +445,19 @@ func (biq *BazelInvocationQuery) Clone() *BazelInvocationQuery {
 		return nil
 	}
 	return &BazelInvocationQuery{
-		config:             biq.config,
-		ctx:                biq.ctx.Clone(),
-		order:              append([]bazelinvocation.OrderOption{}, biq.order...),
-		inters:             append([]Interceptor{}, biq.inters...),
-		predicates:         append([]predicate.BazelInvocation{}, biq.predicates...),
-		withEventFile:      biq.withEventFile.Clone(),
-		withBuild:          biq.withBuild.Clone(),
-		withProblems:       biq.withProblems.Clone(),
-		withMetrics:        biq.withMetrics.Clone(),
-		withTestCollection: biq.withTestCollection.Clone(),
-		withTargets:        biq.withTargets.Clone(),
+		config:                biq.config,
+		ctx:                   biq.ctx.Clone(),
+		order:                 append([]bazelinvocation.OrderOption{}, biq.order...),
+		inters:                append([]Interceptor{}, biq.inters...),
+		predicates:            append([]predicate.BazelInvocation{}, biq.predicates...),
+		withEventFile:         biq.withEventFile.Clone(),
+		withBuild:             biq.withBuild.Clone(),
+		withProblems:          biq.withProblems.Clone(),
+		withMetrics:           biq.withMetrics.Clone(),
+		withTestCollection:    biq.withTestCollection.Clone(),
+		withTargets:           biq.withTargets.Clone(),
+		withTestHealthReports: biq.withTestHealthReports.Clone(),
+		withSearchChunks:      biq.withSearchChunks.Clone(),
 		// clone intermediate query.
 		sql:  biq.sql.Clone(),
 		path: biq.path,
@@ -478,6 +530,28 @@ func (biq *BazelInvocationQuery) WithTargets(opts ...func(*TargetPairQuery)) *Ba
 	return biq
 }
 
+// WithTestHealthReports tells the query-builder to eager-load the nodes that are connected to
+// the "test_health_reports" edge. The optional arguments are used to configure the query builder of the edge.
+func (biq *BazelInvocationQuery) WithTestHealthReports(opts ...func(*TestHealthReportQuery)) *BazelInvocationQuery {
+	query := (&TestHealthReportClient{config: biq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	biq.withTestHealthReports = query
+	return biq
+}
+
+// WithSearchChunks tells the query-builder to eager-load the nodes that are connected to
+// the "search_chunks" edge. The optional arguments are used to configure the query builder of the edge.
+func (biq *BazelInvocationQuery) WithSearchChunks(opts ...func(*SearchChunkQuery)) *BazelInvocationQuery {
+	query := (&SearchChunkClient{config: biq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	biq.withSearchChunks = query
+	return biq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -557,13 +631,15 @@ func (biq *BazelInvocationQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		nodes       = []*BazelInvocation{}
 		withFKs     = biq.withFKs
 		_spec       = biq.querySpec()
-		loadedTypes = [6]bool{
+		loadedTypes = [8]bool{
 			biq.withEventFile != nil,
 			biq.withBuild != nil,
 			biq.withProblems != nil,
 			biq.withMetrics != nil,
 			biq.withTestCollection != nil,
 			biq.withTargets != nil,
+			biq.withTestHealthReports != nil,
+			biq.withSearchChunks != nil,
 		}
 	)
 	if biq.withEventFile != nil || biq.withBuild != nil {
@@ -634,6 +710,22 @@ func (biq *BazelInvocationQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
+	if query := biq.withTestHealthReports; query != nil {
+		if err := biq.loadTestHealthReports(ctx, query, nodes,
+			func(n *BazelInvocation) { n.Edges.TestHealthReports = []*TestHealthReport{} },
+			func(n *BazelInvocation, e *TestHealthReport) {
+				n.Edges.TestHealthReports = append(n.Edges.TestHealthReports, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := biq.withSearchChunks; query != nil {
+		if err := biq.loadSearchChunks(ctx, query, nodes,
+			func(n *BazelInvocation) { n.Edges.SearchChunks = []*SearchChunk{} },
+			func(n *BazelInvocation, e *SearchChunk) { n.Edges.SearchChunks = append(n.Edges.SearchChunks, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range biq.withNamedProblems {
 		if err := biq.loadProblems(ctx, query, nodes,
 			func(n *BazelInvocation) { n.appendNamedProblems(name) },
@@ -655,6 +747,20 @@ func (biq *BazelInvocationQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
+	for name, query := range biq.withNamedTestHealthReports {
+		if err := biq.loadTestHealthReports(ctx, query, nodes,
+			func(n *BazelInvocation) { n.appendNamedTestHealthReports(name) },
+			func(n *BazelInvocation, e *TestHealthReport) { n.appendNamedTestHealthReports(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range biq.withNamedSearchChunks {
+		if err := biq.loadSearchChunks(ctx, query, nodes,
+			func(n *BazelInvocation) { n.appendNamedSearchChunks(name) },
+			func(n *BazelInvocation, e *SearchChunk) { n.appendNamedSearchChunks(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for i := range biq.loadTotal {
 		if err := biq.loadTotal[i](ctx, nodes); err != nil {
 			return nil, err
@@ -908,6 +1014,68 @@ func (biq *BazelInvocationQuery) loadTargets(ctx context.Context, query *TargetP
 	}
 	return nil
 }
+func (biq *BazelInvocationQuery) loadTestHealthReports(ctx context.Context, query *TestHealthReportQuery, nodes []*BazelInvocation, init func(*BazelInvocation), assign func(*BazelInvocation, *TestHealthReport)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*BazelInvocation)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.TestHealthReport(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(bazelinvocation.TestHealthReportsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.bazel_invocation_test_health_reports
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "bazel_invocation_test_health_reports" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "bazel_invocation_test_health_reports" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (biq *BazelInvocationQuery) loadSearchChunks(ctx context.Context, query *SearchChunkQuery, nodes []*BazelInvocation, init func(*BazelInvocation), assign func(*BazelInvocation, *SearchChunk)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*BazelInvocation)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SearchChunk(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(bazelinvocation.SearchChunksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.bazel_invocation_search_chunks
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "bazel_invocation_search_chunks" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "bazel_invocation_search_chunks" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (biq *BazelInvocationQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := biq.querySpec()
@@ -1035,6 +1203,34 @@ func (biq *BazelInvocationQuery) WithNamedTargets(name string, opts ...func(*Tar
 	return biq
 }
 
+// WithNamedTestHealthReports tells the query-builder to eager-load the nodes that are connected to the "test_health_reports"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (biq *BazelInvocationQuery) WithNamedTestHealthReports(name string, opts ...func(*TestHealthReportQuery)) *BazelInvocationQuery {
+	query := (&TestHealthReportClient{config: biq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if biq.withNamedTestHealthReports == nil {
+		biq.withNamedTestHealthReports = make(map[string]*TestHealthReportQuery)
+	}
+	biq.withNamedTestHealthReports[name] = query
+	return biq
+}
+
+// WithNamedSearchChunks tells the query-builder to eager-load the nodes that are connected to the "search_chunks"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (biq *BazelInvocationQuery) WithNamedSearchChunks(name string, opts ...func(*SearchChunkQuery)) *BazelInvocationQuery {
+	query := (&SearchChunkClient{config: biq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if biq.withNamedSearchChunks == nil {
+		biq.withNamedSearchChunks = make(map[string]*SearchChunkQuery)
+	}
+	biq.withNamedSearchChunks[name] = query
+	return biq
+}
+
 // BazelInvocationGroupBy is the group-by builder for BazelInvocation entities.
 type BazelInvocationGroupBy struct {
 	selector
@@ -17,8 +17,10 @@ import (
 	"github.com/buildbarn/bb-portal/ent/gen/ent/eventfile"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/metrics"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/predicate"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/searchchunk"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/targetpair"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testcollection"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/testhealthreport"
 	"github.com/buildbarn/bb-portal/pkg/summary"
 )
 
@@ -324,6 +326,80 @@ func (biu *BazelInvocationUpdate) ClearNumFetches() *BazelInvocationUpdate {
 	return biu
 }
 
+// SetBranch sets the "branch" field.
+func (biu *BazelInvocationUpdate) SetBranch(s string) *BazelInvocationUpdate {
+	biu.mutation.SetBranch(s)
+	return biu
+}
+
+// SetNillableBranch sets the "branch" field if the given value is not nil.
+func (biu *BazelInvocationUpdate) SetNillableBranch(s *string) *BazelInvocationUpdate {
+	if s != nil {
+		biu.SetBranch(*s)
+	}
+	return biu
+}
+
+// ClearBranch clears the value of the "branch" field.
+func (biu *BazelInvocationUpdate) ClearBranch() *BazelInvocationUpdate {
+	biu.mutation.ClearBranch()
+	return biu
+}
+
+// SetCommit sets the "commit" field.
+func (biu *BazelInvocationUpdate) SetCommit(s string) *BazelInvocationUpdate {
+	biu.mutation.SetCommit(s)
+	return biu
+}
+
+// SetNillableCommit sets the "commit" field if the given value is not nil.
+func (biu *BazelInvocationUpdate) SetNillableCommit(s *string) *BazelInvocationUpdate {
+	if s != nil {
+		biu.SetCommit(*s)
+	}
+	return biu
+}
+
+// ClearCommit clears the value of the "commit" field.
+func (biu *BazelInvocationUpdate) ClearCommit() *BazelInvocationUpdate {
+	biu.mutation.ClearCommit()
+	return biu
+}
+
+// SetFailureClassification sets the "failure_classification" field.
+func (biu *BazelInvocationUpdate) SetFailureClassification(bc bazelinvocation.FailureClassification) *BazelInvocationUpdate {
+	biu.mutation.SetFailureClassification(bc)
+	return biu
+}
+
+// SetNillableFailureClassification sets the "failure_classification" field if the given value is not nil.
+func (biu *BazelInvocationUpdate) SetNillableFailureClassification(bc *bazelinvocation.FailureClassification) *BazelInvocationUpdate {
+	if bc != nil {
+		biu.SetFailureClassification(*bc)
+	}
+	return biu
+}
+
+// ClearFailureClassification clears the value of the "failure_classification" field.
+func (biu *BazelInvocationUpdate) ClearFailureClassification() *BazelInvocationUpdate {
+	biu.mutation.ClearFailureClassification()
+	return biu
+}
+
+// SetPinned sets the "pinned" field.
+func (biu *BazelInvocationUpdate) SetPinned(b bool) *BazelInvocationUpdate {
+	biu.mutation.SetPinned(b)
+	return biu
+}
+
+// SetNillablePinned sets the "pinned" field if the given value is not nil.
+func (biu *BazelInvocationUpdate) SetNillablePinned(b *bool) *BazelInvocationUpdate {
+	if b != nil {
+		biu.SetPinned(*b)
+	}
+	return biu
+}
+
 // SetEventFileID sets the "event_file" edge to the EventFile entity by ID.
 func (biu *BazelInvocationUpdate) SetEventFileID(id int) *BazelInvocationUpdate {
 	biu.mutation.SetEventFileID(id)
@@ -418,6 +494,36 @@ func (biu *BazelInvocationUpdate) AddTargets(t ...*TargetPair) *BazelInvocationU
 	return biu.AddTargetIDs(ids...)
 }
 
+// AddTestHealthReportIDs adds the "test_health_reports" edge to the TestHealthReport entity by IDs.
+func (biu *BazelInvocationUpdate) AddTestHealthReportIDs(ids ...int) *BazelInvocationUpdate {
+	biu.mutation.AddTestHealthReportIDs(ids...)
+	return biu
+}
+
+// AddTestHealthReports adds the "test_health_reports" edges to the TestHealthReport entity.
+func (biu *BazelInvocationUpdate) AddTestHealthReports(t ...*TestHealthReport) *BazelInvocationUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return biu.AddTestHealthReportIDs(ids...)
+}
+
+// AddSearchChunkIDs adds the "search_chunks" edge to the SearchChunk entity by IDs.
+func (biu *BazelInvocationUpdate) AddSearchChunkIDs(ids ...int) *BazelInvocationUpdate {
+	biu.mutation.AddSearchChunkIDs(ids...)
+	return biu
+}
+
+// AddSearchChunks adds the "search_chunks" edges to the SearchChunk entity.
+func (biu *BazelInvocationUpdate) AddSearchChunks(s ...*SearchChunk) *BazelInvocationUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return biu.AddSearchChunkIDs(ids...)
+}
+
 // Mutation returns the BazelInvocationMutation object of the builder.
 func (biu *BazelInvocationUpdate) Mutation() *BazelInvocationMutation {
 	return biu.mutation
@@ -504,6 +610,48 @@ func (biu *BazelInvocationUpdate) RemoveTargets(t ...*TargetPair) *BazelInvocati
 	return biu.RemoveTargetIDs(ids...)
 }
 
+// ClearTestHealthReports clears all "test_health_reports" edges to the TestHealthReport entity.
+func (biu *BazelInvocationUpdate) ClearTestHealthReports() *BazelInvocationUpdate {
+	biu.mutation.ClearTestHealthReports()
+	return biu
+}
+
+// RemoveTestHealthReportIDs removes the "test_health_reports" edge to TestHealthReport entities by IDs.
+func (biu *BazelInvocationUpdate) RemoveTestHealthReportIDs(ids ...int) *BazelInvocationUpdate {
+	biu.mutation.RemoveTestHealthReportIDs(ids...)
+	return biu
+}
+
+// RemoveTestHealthReports removes "test_health_reports" edges to TestHealthReport entities.
+func (biu *BazelInvocationUpdate) RemoveTestHealthReports(t ...*TestHealthReport) *BazelInvocationUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return biu.RemoveTestHealthReportIDs(ids...)
+}
+
+// ClearSearchChunks clears all "search_chunks" edges to the SearchChunk entity.
+func (biu *BazelInvocationUpdate) ClearSearchChunks() *BazelInvocationUpdate {
+	biu.mutation.ClearSearchChunks()
+	return biu
+}
+
+// RemoveSearchChunkIDs removes the "search_chunks" edge to SearchChunk entities by IDs.
+func (biu *BazelInvocationUpdate) RemoveSearchChunkIDs(ids ...int) *BazelInvocationUpdate {
+	biu.mutation.RemoveSearchChunkIDs(ids...)
+	return biu
+}
+
+// RemoveSearchChunks removes "search_chunks" edges to SearchChunk entities.
+func (biu *BazelInvocationUpdate) RemoveSearchChunks(s ...*SearchChunk) *BazelInvocationUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return biu.RemoveSearchChunkIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (biu *BazelInvocationUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, biu.sqlSave, biu.mutation, biu.hooks)
@@ -533,6 +681,11 @@ func (biu *BazelInvocationUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (biu *BazelInvocationUpdate) check() error {
+	if v, ok := biu.mutation.FailureClassification(); ok {
+		if err := bazelinvocation.FailureClassificationValidator(v); err != nil {
+			return &ValidationError{Name: "failure_classification", err: fmt.Errorf(`ent: validator failed for field "BazelInvocation.failure_classification": %w`, err)}
+		}
+	}
 	if _, ok := biu.mutation.EventFileID(); biu.mutation.EventFileCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "BazelInvocation.event_file"`)
 	}
@@ -638,6 +791,27 @@ func (biu *BazelInvocationUpdate) sqlSave(ctx context.Context) (n int, err error
 	if biu.mutation.NumFetchesCleared() {
 		_spec.ClearField(bazelinvocation.FieldNumFetches, field.TypeInt64)
 	}
+	if value, ok := biu.mutation.Branch(); ok {
+		_spec.SetField(bazelinvocation.FieldBranch, field.TypeString, value)
+	}
+	if biu.mutation.BranchCleared() {
+		_spec.ClearField(bazelinvocation.FieldBranch, field.TypeString)
+	}
+	if value, ok := biu.mutation.Commit(); ok {
+		_spec.SetField(bazelinvocation.FieldCommit, field.TypeString, value)
+	}
+	if biu.mutation.CommitCleared() {
+		_spec.ClearField(bazelinvocation.FieldCommit, field.TypeString)
+	}
+	if value, ok := biu.mutation.FailureClassification(); ok {
+		_spec.SetField(bazelinvocation.FieldFailureClassification, field.TypeEnum, value)
+	}
+	if biu.mutation.FailureClassificationCleared() {
+		_spec.ClearField(bazelinvocation.FieldFailureClassification, field.TypeEnum)
+	}
+	if value, ok := biu.mutation.Pinned(); ok {
+		_spec.SetField(bazelinvocation.FieldPinned, field.TypeBool, value)
+	}
 	if biu.mutation.EventFileCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -860,6 +1034,96 @@ func (biu *BazelInvocationUpdate) sqlSave(ctx context.Context) (n int, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if biu.mutation.TestHealthReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bazelinvocation.TestHealthReportsTable,
+			Columns: []string{bazelinvocation.TestHealthReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testhealthreport.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := biu.mutation.RemovedTestHealthReportsIDs(); len(nodes) > 0 && !biu.mutation.TestHealthReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bazelinvocation.TestHealthReportsTable,
+			Columns: []string{bazelinvocation.TestHealthReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testhealthreport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := biu.mutation.TestHealthReportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bazelinvocation.TestHealthReportsTable,
+			Columns: []string{bazelinvocation.TestHealthReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testhealthreport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if biu.mutation.SearchChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bazelinvocation.SearchChunksTable,
+			Columns: []string{bazelinvocation.SearchChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(searchchunk.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := biu.mutation.RemovedSearchChunksIDs(); len(nodes) > 0 && !biu.mutation.SearchChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bazelinvocation.SearchChunksTable,
+			Columns: []string{bazelinvocation.SearchChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(searchchunk.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := biu.mutation.SearchChunksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bazelinvocation.SearchChunksTable,
+			Columns: []string{bazelinvocation.SearchChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(searchchunk.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, biu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{bazelinvocation.Label}
@@ -1169,6 +1433,80 @@ func (biuo *BazelInvocationUpdateOne) ClearNumFetches() *BazelInvocationUpdateOn
 	return biuo
 }
 
+// SetBranch sets the "branch" field.
+func (biuo *BazelInvocationUpdateOne) SetBranch(s string) *BazelInvocationUpdateOne {
+	biuo.mutation.SetBranch(s)
+	return biuo
+}
+
+// SetNillableBranch sets the "branch" field if the given value is not nil.
+func (biuo *BazelInvocationUpdateOne) SetNillableBranch(s *string) *BazelInvocationUpdateOne {
+	if s != nil {
+		biuo.SetBranch(*s)
+	}
+	return biuo
+}
+
+// ClearBranch clears the value of the "branch" field.
+func (biuo *BazelInvocationUpdateOne) ClearBranch() *BazelInvocationUpdateOne {
+	biuo.mutation.ClearBranch()
+	return biuo
+}
+
+// SetCommit sets the "commit" field.
+func (biuo *BazelInvocationUpdateOne) SetCommit(s string) *BazelInvocationUpdateOne {
+	biuo.mutation.SetCommit(s)
+	return biuo
+}
+
+// SetNillableCommit sets the "commit" field if the given value is not nil.
+func (biuo *BazelInvocationUpdateOne) SetNillableCommit(s *string) *BazelInvocationUpdateOne {
+	if s != nil {
+		biuo.SetCommit(*s)
+	}
+	return biuo
+}
+
+// ClearCommit clears the value of the "commit" field.
+func (biuo *BazelInvocationUpdateOne) ClearCommit() *BazelInvocationUpdateOne {
+	biuo.mutation.ClearCommit()
+	return biuo
+}
+
+// SetFailureClassification sets the "failure_classification" field.
+func (biuo *BazelInvocationUpdateOne) SetFailureClassification(bc bazelinvocation.FailureClassification) *BazelInvocationUpdateOne {
+	biuo.mutation.SetFailureClassification(bc)
+	return biuo
+}
+
+// SetNillableFailureClassification sets the "failure_classification" field if the given value is not nil.
+func (biuo *BazelInvocationUpdateOne) SetNillableFailureClassification(bc *bazelinvocation.FailureClassification) *BazelInvocationUpdateOne {
+	if bc != nil {
+		biuo.SetFailureClassification(*bc)
+	}
+	return biuo
+}
+
+// ClearFailureClassification clears the value of the "failure_classification" field.
+func (biuo *BazelInvocationUpdateOne) ClearFailureClassification() *BazelInvocationUpdateOne {
+	biuo.mutation.ClearFailureClassification()
+	return biuo
+}
+
+// SetPinned sets the "pinned" field.
+func (biuo *BazelInvocationUpdateOne) SetPinned(b bool) *BazelInvocationUpdateOne {
+	biuo.mutation.SetPinned(b)
+	return biuo
+}
+
+// SetNillablePinned sets the "pinned" field if the given value is not nil.
+func (biuo *BazelInvocationUpdateOne) SetNillablePinned(b *bool) *BazelInvocationUpdateOne {
+	if b != nil {
+		biuo.SetPinned(*b)
+	}
+	return biuo
+}
+
 // SetEventFileID sets the "event_file" edge to the EventFile entity by ID.
 func (biuo *BazelInvocationUpdateOne) SetEventFileID(id int) *BazelInvocationUpdateOne {
 	biuo.mutation.SetEventFileID(id)
@@ -1263,6 +1601,36 @@ func (biuo *BazelInvocationUpdateOne) AddTargets(t ...*TargetPair) *BazelInvocat
 	return biuo.AddTargetIDs(ids...)
 }
 
+// AddTestHealthReportIDs adds the "test_health_reports" edge to the TestHealthReport entity by IDs.
+func (biuo *BazelInvocationUpdateOne) AddTestHealthReportIDs(ids ...int) *BazelInvocationUpdateOne {
+	biuo.mutation.AddTestHealthReportIDs(ids...)
+	return biuo
+}
+
+// AddTestHealthReports adds the "test_health_reports" edges to the TestHealthReport entity.
+func (biuo *BazelInvocationUpdateOne) AddTestHealthReports(t ...*TestHealthReport) *BazelInvocationUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return biuo.AddTestHealthReportIDs(ids...)
+}
+
+// AddSearchChunkIDs adds the "search_chunks" edge to the SearchChunk entity by IDs.
+func (biuo *BazelInvocationUpdateOne) AddSearchChunkIDs(ids ...int) *BazelInvocationUpdateOne {
+	biuo.mutation.AddSearchChunkIDs(ids...)
+	return biuo
+}
+
+// AddSearchChunks adds the "search_chunks" edges to the SearchChunk entity.
+func (biuo *BazelInvocationUpdateOne) AddSearchChunks(s ...*SearchChunk) *BazelInvocationUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return biuo.AddSearchChunkIDs(ids...)
+}
+
 // Mutation returns the BazelInvocationMutation object of the builder.
 func (biuo *BazelInvocationUpdateOne) Mutation() *BazelInvocationMutation {
 	return biuo.mutation
@@ -1349,6 +1717,48 @@ func (biuo *BazelInvocationUpdateOne) RemoveTargets(t ...*TargetPair) *BazelInvo
 	return biuo.RemoveTargetIDs(ids...)
 }
 
+// ClearTestHealthReports clears all "test_health_reports" edges to the TestHealthReport entity.
+func (biuo *BazelInvocationUpdateOne) ClearTestHealthReports() *BazelInvocationUpdateOne {
+	biuo.mutation.ClearTestHealthReports()
+	return biuo
+}
+
+// RemoveTestHealthReportIDs removes the "test_health_reports" edge to TestHealthReport entities by IDs.
+func (biuo *BazelInvocationUpdateOne) RemoveTestHealthReportIDs(ids ...int) *BazelInvocationUpdateOne {
+	biuo.mutation.RemoveTestHealthReportIDs(ids...)
+	return biuo
+}
+
+// RemoveTestHealthReports removes "test_health_reports" edges to TestHealthReport entities.
+func (biuo *BazelInvocationUpdateOne) RemoveTestHealthReports(t ...*TestHealthReport) *BazelInvocationUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return biuo.RemoveTestHealthReportIDs(ids...)
+}
+
+// ClearSearchChunks clears all "search_chunks" edges to the SearchChunk entity.
+func (biuo *BazelInvocationUpdateOne) ClearSearchChunks() *BazelInvocationUpdateOne {
+	biuo.mutation.ClearSearchChunks()
+	return biuo
+}
+
+// RemoveSearchChunkIDs removes the "search_chunks" edge to SearchChunk entities by IDs.
+func (biuo *BazelInvocationUpdateOne) RemoveSearchChunkIDs(ids ...int) *BazelInvocationUpdateOne {
+	biuo.mutation.RemoveSearchChunkIDs(ids...)
+	return biuo
+}
+
+// RemoveSearchChunks removes "search_chunks" edges to SearchChunk entities.
+func (biuo *BazelInvocationUpdateOne) RemoveSearchChunks(s ...*SearchChunk) *BazelInvocationUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return biuo.RemoveSearchChunkIDs(ids...)
+}
+
 // Where appends a list predicates to the BazelInvocationUpdate builder.
 func (biuo *BazelInvocationUpdateOne) Where(ps ...predicate.BazelInvocation) *BazelInvocationUpdateOne {
 	biuo.mutation.Where(ps...)
@@ -1391,6 +1801,11 @@ func (biuo *BazelInvocationUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (biuo *BazelInvocationUpdateOne) check() error {
+	if v, ok := biuo.mutation.FailureClassification(); ok {
+		if err := bazelinvocation.FailureClassificationValidator(v); err != nil {
+			return &ValidationError{Name: "failure_classification", err: fmt.Errorf(`ent: validator failed for field "BazelInvocation.failure_classification": %w`, err)}
+		}
+	}
 	if _, ok := biuo.mutation.EventFileID(); biuo.mutation.EventFileCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "BazelInvocation.event_file"`)
 	}
@@ -1513,6 +1928,27 @@ func (biuo *BazelInvocationUpdateOne) sqlSave(ctx context.Context) (_node *Bazel
 	if biuo.mutation.NumFetchesCleared() {
 		_spec.ClearField(bazelinvocation.FieldNumFetches, field.TypeInt64)
 	}
+	if value, ok := biuo.mutation.Branch(); ok {
+		_spec.SetField(bazelinvocation.FieldBranch, field.TypeString, value)
+	}
+	if biuo.mutation.BranchCleared() {
+		_spec.ClearField(bazelinvocation.FieldBranch, field.TypeString)
+	}
+	if value, ok := biuo.mutation.Commit(); ok {
+		_spec.SetField(bazelinvocation.FieldCommit, field.TypeString, value)
+	}
+	if biuo.mutation.CommitCleared() {
+		_spec.ClearField(bazelinvocation.FieldCommit, field.TypeString)
+	}
+	if value, ok := biuo.mutation.FailureClassification(); ok {
+		_spec.SetField(bazelinvocation.FieldFailureClassification, field.TypeEnum, value)
+	}
+	if biuo.mutation.FailureClassificationCleared() {
+		_spec.ClearField(bazelinvocation.FieldFailureClassification, field.TypeEnum)
+	}
+	if value, ok := biuo.mutation.Pinned(); ok {
+		_spec.SetField(bazelinvocation.FieldPinned, field.TypeBool, value)
+	}
 	if biuo.mutation.EventFileCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -1735,6 +2171,96 @@ func (biuo *BazelInvocationUpdateOne) sqlSave(ctx context.Context) (_node *Bazel
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if biuo.mutation.TestHealthReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bazelinvocation.TestHealthReportsTable,
+			Columns: []string{bazelinvocation.TestHealthReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testhealthreport.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := biuo.mutation.RemovedTestHealthReportsIDs(); len(nodes) > 0 && !biuo.mutation.TestHealthReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bazelinvocation.TestHealthReportsTable,
+			Columns: []string{bazelinvocation.TestHealthReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testhealthreport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := biuo.mutation.TestHealthReportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bazelinvocation.TestHealthReportsTable,
+			Columns: []string{bazelinvocation.TestHealthReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(testhealthreport.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if biuo.mutation.SearchChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bazelinvocation.SearchChunksTable,
+			Columns: []string{bazelinvocation.SearchChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(searchchunk.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := biuo.mutation.RemovedSearchChunksIDs(); len(nodes) > 0 && !biuo.mutation.SearchChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bazelinvocation.SearchChunksTable,
+			Columns: []string{bazelinvocation.SearchChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(searchchunk.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := biuo.mutation.SearchChunksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bazelinvocation.SearchChunksTable,
+			Columns: []string{bazelinvocation.SearchChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(searchchunk.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &BazelInvocation{config: biuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocationproblem"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/knownproblem"
 )
 
 // BazelInvocationProblem is the model entity for the BazelInvocationProblem schema.
@@ -24,10 +25,17 @@ type BazelInvocationProblem struct {
 	Label string `json:"label,omitempty"`
 	// BepEvents holds the value of the "bep_events" field.
 	BepEvents json.RawMessage `json:"bep_events,omitempty"`
+	// Fingerprint holds the value of the "fingerprint" field.
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// FailureClassification holds the value of the "failure_classification" field.
+	FailureClassification bazelinvocationproblem.FailureClassification `json:"failure_classification,omitempty"`
+	// NewlyFailing holds the value of the "newly_failing" field.
+	NewlyFailing *bool `json:"newly_failing,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the BazelInvocationProblemQuery when eager-loading is set.
 	Edges                     BazelInvocationProblemEdges `json:"edges"`
 	bazel_invocation_problems *int
+	known_problem_problems    *int
 	selectValues              sql.SelectValues
 }
 
@@ -35,11 +43,20 @@ type BazelInvocationProblem struct {
 type BazelInvocationProblemEdges struct {
 	// BazelInvocation holds the value of the bazel_invocation edge.
 	BazelInvocation *BazelInvocation `json:"bazel_invocation,omitempty"`
+	// KnownProblem holds the value of the known_problem edge.
+	KnownProblem *KnownProblem `json:"known_problem,omitempty"`
+	// Blobs holds the value of the blobs edge.
+	Blobs []*Blob `json:"blobs,omitempty"`
+	// SearchChunks holds the value of the search_chunks edge.
+	SearchChunks []*SearchChunk `json:"search_chunks,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [4]bool
 	// totalCount holds the count of the edges above.
-	totalCount [1]map[string]int
+	totalCount [3]map[string]int
+
+	namedBlobs        map[string][]*Blob
+	namedSearchChunks map[string][]*SearchChunk
 }
 
 // BazelInvocationOrErr returns the BazelInvocation value or an error if the edge
@@ -53,6 +70,35 @@ func (e BazelInvocationProblemEdges) BazelInvocationOrErr() (*BazelInvocation, e
 	return nil, &NotLoadedError{edge: "bazel_invocation"}
 }
 
+// KnownProblemOrErr returns the KnownProblem value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BazelInvocationProblemEdges) KnownProblemOrErr() (*KnownProblem, error) {
+	if e.KnownProblem != nil {
+		return e.KnownProblem, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: knownproblem.Label}
+	}
+	return nil, &NotLoadedError{edge: "known_problem"}
+}
+
+// BlobsOrErr returns the Blobs value or an error if the edge
+// was not loaded in eager-loading.
+func (e BazelInvocationProblemEdges) BlobsOrErr() ([]*Blob, error) {
+	if e.loadedTypes[2] {
+		return e.Blobs, nil
+	}
+	return nil, &NotLoadedError{edge: "blobs"}
+}
+
+// SearchChunksOrErr returns the SearchChunks value or an error if the edge
+// was not loaded in eager-loading.
+func (e BazelInvocationProblemEdges) SearchChunksOrErr() ([]*SearchChunk, error) {
+	if e.loadedTypes[3] {
+		return e.SearchChunks, nil
+	}
+	return nil, &NotLoadedError{edge: "search_chunks"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*BazelInvocationProblem) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -60,12 +106,16 @@ func (*BazelInvocationProblem) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case bazelinvocationproblem.FieldBepEvents:
 			values[i] = new([]byte)
+		case bazelinvocationproblem.FieldNewlyFailing:
+			values[i] = new(sql.NullBool)
 		case bazelinvocationproblem.FieldID:
 			values[i] = new(sql.NullInt64)
-		case bazelinvocationproblem.FieldProblemType, bazelinvocationproblem.FieldLabel:
+		case bazelinvocationproblem.FieldProblemType, bazelinvocationproblem.FieldLabel, bazelinvocationproblem.FieldFingerprint, bazelinvocationproblem.FieldFailureClassification:
 			values[i] = new(sql.NullString)
 		case bazelinvocationproblem.ForeignKeys[0]: // bazel_invocation_problems
 			values[i] = new(sql.NullInt64)
+		case bazelinvocationproblem.ForeignKeys[1]: // known_problem_problems
+			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -107,6 +157,25 @@ func (bip *BazelInvocationProblem) assignValues(columns []string, values []any)
 					return fmt.Errorf("unmarshal field bep_events: %w", err)
 				}
 			}
+		case bazelinvocationproblem.FieldFingerprint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fingerprint", values[i])
+			} else if value.Valid {
+				bip.Fingerprint = value.String
+			}
+		case bazelinvocationproblem.FieldFailureClassification:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_classification", values[i])
+			} else if value.Valid {
+				bip.FailureClassification = bazelinvocationproblem.FailureClassification(value.String)
+			}
+		case bazelinvocationproblem.FieldNewlyFailing:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field newly_failing", values[i])
+			} else if value.Valid {
+				bip.NewlyFailing = new(bool)
+				*bip.NewlyFailing = value.Bool
+			}
 		case bazelinvocationproblem.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field bazel_invocation_problems", value)
@@ -114,6 +183,13 @@ func (bip *BazelInvocationProblem) assignValues(columns []string, values []any)
 				bip.bazel_invocation_problems = new(int)
 				*bip.bazel_invocation_problems = int(value.Int64)
 			}
+		case bazelinvocationproblem.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field known_problem_problems", value)
+			} else if value.Valid {
+				bip.known_problem_problems = new(int)
+				*bip.known_problem_problems = int(value.Int64)
+			}
 		default:
 			bip.selectValues.Set(columns[i], values[i])
 		}
@@ -132,6 +208,21 @@ func (bip *BazelInvocationProblem) QueryBazelInvocation() *BazelInvocationQuery
 	return NewBazelInvocationProblemClient(bip.config).QueryBazelInvocation(bip)
 }
 
+// QueryKnownProblem queries the "known_problem" edge of the BazelInvocationProblem entity.
+func (bip *BazelInvocationProblem) QueryKnownProblem() *KnownProblemQuery {
+	return NewBazelInvocationProblemClient(bip.config).QueryKnownProblem(bip)
+}
+
+// QueryBlobs queries the "blobs" edge of the BazelInvocationProblem entity.
+func (bip *BazelInvocationProblem) QueryBlobs() *BlobQuery {
+	return NewBazelInvocationProblemClient(bip.config).QueryBlobs(bip)
+}
+
+// QuerySearchChunks queries the "search_chunks" edge of the BazelInvocationProblem entity.
+func (bip *BazelInvocationProblem) QuerySearchChunks() *SearchChunkQuery {
+	return NewBazelInvocationProblemClient(bip.config).QuerySearchChunks(bip)
+}
+
 // Update returns a builder for updating this BazelInvocationProblem.
 // Note that you need to call BazelInvocationProblem.Unwrap() before calling this method if this BazelInvocationProblem
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -163,9 +254,68 @@ func (bip *BazelInvocationProblem) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("bep_events=")
 	builder.WriteString(fmt.Sprintf("%v", bip.BepEvents))
+	builder.WriteString(", ")
+	builder.WriteString("fingerprint=")
+	builder.WriteString(bip.Fingerprint)
+	builder.WriteString(", ")
+	builder.WriteString("failure_classification=")
+	builder.WriteString(fmt.Sprintf("%v", bip.FailureClassification))
+	builder.WriteString(", ")
+	if v := bip.NewlyFailing; v != nil {
+		builder.WriteString("newly_failing=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// NamedBlobs returns the Blobs named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (bip *BazelInvocationProblem) NamedBlobs(name string) ([]*Blob, error) {
+	if bip.Edges.namedBlobs == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := bip.Edges.namedBlobs[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (bip *BazelInvocationProblem) appendNamedBlobs(name string, edges ...*Blob) {
+	if bip.Edges.namedBlobs == nil {
+		bip.Edges.namedBlobs = make(map[string][]*Blob)
+	}
+	if len(edges) == 0 {
+		bip.Edges.namedBlobs[name] = []*Blob{}
+	} else {
+		bip.Edges.namedBlobs[name] = append(bip.Edges.namedBlobs[name], edges...)
+	}
+}
+
+// NamedSearchChunks returns the SearchChunks named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (bip *BazelInvocationProblem) NamedSearchChunks(name string) ([]*SearchChunk, error) {
+	if bip.Edges.namedSearchChunks == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := bip.Edges.namedSearchChunks[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (bip *BazelInvocationProblem) appendNamedSearchChunks(name string, edges ...*SearchChunk) {
+	if bip.Edges.namedSearchChunks == nil {
+		bip.Edges.namedSearchChunks = make(map[string][]*SearchChunk)
+	}
+	if len(edges) == 0 {
+		bip.Edges.namedSearchChunks[name] = []*SearchChunk{}
+	} else {
+		bip.Edges.namedSearchChunks[name] = append(bip.Edges.namedSearchChunks[name], edges...)
+	}
+}
+
 // BazelInvocationProblems is a parsable slice of BazelInvocationProblem.
 type BazelInvocationProblems []*BazelInvocationProblem
@@ -3,6 +3,10 @@
 package bazelinvocationproblem
 
 import (
+	"fmt"
+	"io"
+	"strconv"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -18,8 +22,20 @@ const (
 	FieldLabel = "label"
 	// FieldBepEvents holds the string denoting the bep_events field in the database.
 	FieldBepEvents = "bep_events"
+	// FieldFingerprint holds the string denoting the fingerprint field in the database.
+	FieldFingerprint = "fingerprint"
+	// FieldFailureClassification holds the string denoting the failure_classification field in the database.
+	FieldFailureClassification = "failure_classification"
+	// FieldNewlyFailing holds the string denoting the newly_failing field in the database.
+	FieldNewlyFailing = "newly_failing"
 	// EdgeBazelInvocation holds the string denoting the bazel_invocation edge name in mutations.
 	EdgeBazelInvocation = "bazel_invocation"
+	// EdgeKnownProblem holds the string denoting the known_problem edge name in mutations.
+	EdgeKnownProblem = "known_problem"
+	// EdgeBlobs holds the string denoting the blobs edge name in mutations.
+	EdgeBlobs = "blobs"
+	// EdgeSearchChunks holds the string denoting the search_chunks edge name in mutations.
+	EdgeSearchChunks = "search_chunks"
 	// Table holds the table name of the bazelinvocationproblem in the database.
 	Table = "bazel_invocation_problems"
 	// BazelInvocationTable is the table that holds the bazel_invocation relation/edge.
@@ -29,6 +45,25 @@ const (
 	BazelInvocationInverseTable = "bazel_invocations"
 	// BazelInvocationColumn is the table column denoting the bazel_invocation relation/edge.
 	BazelInvocationColumn = "bazel_invocation_problems"
+	// KnownProblemTable is the table that holds the known_problem relation/edge.
+	KnownProblemTable = "bazel_invocation_problems"
+	// KnownProblemInverseTable is the table name for the KnownProblem entity.
+	// It exists in this package in order to avoid circular dependency with the "knownproblem" package.
+	KnownProblemInverseTable = "known_problems"
+	// KnownProblemColumn is the table column denoting the known_problem relation/edge.
+	KnownProblemColumn = "known_problem_problems"
+	// BlobsTable is the table that holds the blobs relation/edge. The primary key declared below.
+	BlobsTable = "bazel_invocation_problem_blobs"
+	// BlobsInverseTable is the table name for the Blob entity.
+	// It exists in this package in order to avoid circular dependency with the "blob" package.
+	BlobsInverseTable = "blobs"
+	// SearchChunksTable is the table that holds the search_chunks relation/edge.
+	SearchChunksTable = "search_chunks"
+	// SearchChunksInverseTable is the table name for the SearchChunk entity.
+	// It exists in this package in order to avoid circular dependency with the "searchchunk" package.
+	SearchChunksInverseTable = "search_chunks"
+	// SearchChunksColumn is the table column denoting the search_chunks relation/edge.
+	SearchChunksColumn = "bazel_invocation_problem_search_chunks"
 )
 
 // Columns holds all SQL columns for bazelinvocationproblem fields.
@@ -37,14 +72,24 @@ var Columns = []string{
 	FieldProblemType,
 	FieldLabel,
 	FieldBepEvents,
+	FieldFingerprint,
+	FieldFailureClassification,
+	FieldNewlyFailing,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "bazel_invocation_problems"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
 	"bazel_invocation_problems",
+	"known_problem_problems",
 }
 
+var (
+	// BlobsPrimaryKey and BlobsColumn2 are the table columns denoting the
+	// primary key for the blobs relation (M2M).
+	BlobsPrimaryKey = []string{"bazel_invocation_problem_id", "blob_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -60,6 +105,31 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// FailureClassification defines the type for the "failure_classification" enum field.
+type FailureClassification string
+
+// FailureClassification values.
+const (
+	FailureClassificationUSER_ERROR  FailureClassification = "USER_ERROR"
+	FailureClassificationINFRA_ERROR FailureClassification = "INFRA_ERROR"
+	FailureClassificationFLAKY       FailureClassification = "FLAKY"
+	FailureClassificationCANCELLED   FailureClassification = "CANCELLED"
+)
+
+func (fc FailureClassification) String() string {
+	return string(fc)
+}
+
+// FailureClassificationValidator is a validator for the "failure_classification" field enum values. It is called by the builders before save.
+func FailureClassificationValidator(fc FailureClassification) error {
+	switch fc {
+	case FailureClassificationUSER_ERROR, FailureClassificationINFRA_ERROR, FailureClassificationFLAKY, FailureClassificationCANCELLED:
+		return nil
+	default:
+		return fmt.Errorf("bazelinvocationproblem: invalid enum value for failure_classification field: %q", fc)
+	}
+}
+
 // OrderOption defines the ordering options for the BazelInvocationProblem queries.
 type OrderOption func(*sql.Selector)
 
@@ -78,12 +148,62 @@ func ByLabel(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLabel, opts...).ToFunc()
 }
 
+// ByFingerprint orders the results by the fingerprint field.
+func ByFingerprint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFingerprint, opts...).ToFunc()
+}
+
+// ByFailureClassification orders the results by the failure_classification field.
+func ByFailureClassification(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailureClassification, opts...).ToFunc()
+}
+
+// ByNewlyFailing orders the results by the newly_failing field.
+func ByNewlyFailing(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNewlyFailing, opts...).ToFunc()
+}
+
 // ByBazelInvocationField orders the results by bazel_invocation field.
 func ByBazelInvocationField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
 		sqlgraph.OrderByNeighborTerms(s, newBazelInvocationStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByKnownProblemField orders the results by known_problem field.
+func ByKnownProblemField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newKnownProblemStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByBlobsCount orders the results by blobs count.
+func ByBlobsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlobsStep(), opts...)
+	}
+}
+
+// ByBlobs orders the results by blobs terms.
+func ByBlobs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlobsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySearchChunksCount orders the results by search_chunks count.
+func BySearchChunksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSearchChunksStep(), opts...)
+	}
+}
+
+// BySearchChunks orders the results by search_chunks terms.
+func BySearchChunks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSearchChunksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newBazelInvocationStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -91,3 +211,42 @@ func newBazelInvocationStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, BazelInvocationTable, BazelInvocationColumn),
 	)
 }
+func newKnownProblemStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(KnownProblemInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, KnownProblemTable, KnownProblemColumn),
+	)
+}
+func newBlobsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BlobsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, BlobsTable, BlobsPrimaryKey...),
+	)
+}
+func newSearchChunksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SearchChunksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SearchChunksTable, SearchChunksColumn),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e FailureClassification) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *FailureClassification) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = FailureClassification(str)
+	if err := FailureClassificationValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid FailureClassification", str)
+	}
+	return nil
+}
@@ -58,6 +58,16 @@ func ProblemType(v string) predicate.BazelInvocationProblem {
 	return predicate.BazelInvocationProblem(sql.FieldEQ(FieldProblemType, v))
 }
 
+// Fingerprint applies equality check predicate on the "fingerprint" field. It's identical to FingerprintEQ.
+func Fingerprint(v string) predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// NewlyFailing applies equality check predicate on the "newly_failing" field. It's identical to NewlyFailingEQ.
+func NewlyFailing(v bool) predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldEQ(FieldNewlyFailing, v))
+}
+
 // ProblemTypeEQ applies the EQ predicate on the "problem_type" field.
 func ProblemTypeEQ(v string) predicate.BazelInvocationProblem {
 	return predicate.BazelInvocationProblem(sql.FieldEQ(FieldProblemType, v))
@@ -188,6 +198,131 @@ func LabelContainsFold(v string) predicate.BazelInvocationProblem {
 	return predicate.BazelInvocationProblem(sql.FieldContainsFold(FieldLabel, v))
 }
 
+// FingerprintEQ applies the EQ predicate on the "fingerprint" field.
+func FingerprintEQ(v string) predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// FingerprintNEQ applies the NEQ predicate on the "fingerprint" field.
+func FingerprintNEQ(v string) predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldNEQ(FieldFingerprint, v))
+}
+
+// FingerprintIn applies the In predicate on the "fingerprint" field.
+func FingerprintIn(vs ...string) predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldIn(FieldFingerprint, vs...))
+}
+
+// FingerprintNotIn applies the NotIn predicate on the "fingerprint" field.
+func FingerprintNotIn(vs ...string) predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldNotIn(FieldFingerprint, vs...))
+}
+
+// FingerprintGT applies the GT predicate on the "fingerprint" field.
+func FingerprintGT(v string) predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldGT(FieldFingerprint, v))
+}
+
+// FingerprintGTE applies the GTE predicate on the "fingerprint" field.
+func FingerprintGTE(v string) predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldGTE(FieldFingerprint, v))
+}
+
+// FingerprintLT applies the LT predicate on the "fingerprint" field.
+func FingerprintLT(v string) predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldLT(FieldFingerprint, v))
+}
+
+// FingerprintLTE applies the LTE predicate on the "fingerprint" field.
+func FingerprintLTE(v string) predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldLTE(FieldFingerprint, v))
+}
+
+// FingerprintContains applies the Contains predicate on the "fingerprint" field.
+func FingerprintContains(v string) predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldContains(FieldFingerprint, v))
+}
+
+// FingerprintHasPrefix applies the HasPrefix predicate on the "fingerprint" field.
+func FingerprintHasPrefix(v string) predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldHasPrefix(FieldFingerprint, v))
+}
+
+// FingerprintHasSuffix applies the HasSuffix predicate on the "fingerprint" field.
+func FingerprintHasSuffix(v string) predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldHasSuffix(FieldFingerprint, v))
+}
+
+// FingerprintIsNil applies the IsNil predicate on the "fingerprint" field.
+func FingerprintIsNil() predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldIsNull(FieldFingerprint))
+}
+
+// FingerprintNotNil applies the NotNil predicate on the "fingerprint" field.
+func FingerprintNotNil() predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldNotNull(FieldFingerprint))
+}
+
+// FingerprintEqualFold applies the EqualFold predicate on the "fingerprint" field.
+func FingerprintEqualFold(v string) predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldEqualFold(FieldFingerprint, v))
+}
+
+// FingerprintContainsFold applies the ContainsFold predicate on the "fingerprint" field.
+func FingerprintContainsFold(v string) predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldContainsFold(FieldFingerprint, v))
+}
+
+// FailureClassificationEQ applies the EQ predicate on the "failure_classification" field.
+func FailureClassificationEQ(v FailureClassification) predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldEQ(FieldFailureClassification, v))
+}
+
+// FailureClassificationNEQ applies the NEQ predicate on the "failure_classification" field.
+func FailureClassificationNEQ(v FailureClassification) predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldNEQ(FieldFailureClassification, v))
+}
+
+// FailureClassificationIn applies the In predicate on the "failure_classification" field.
+func FailureClassificationIn(vs ...FailureClassification) predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldIn(FieldFailureClassification, vs...))
+}
+
+// FailureClassificationNotIn applies the NotIn predicate on the "failure_classification" field.
+func FailureClassificationNotIn(vs ...FailureClassification) predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldNotIn(FieldFailureClassification, vs...))
+}
+
+// FailureClassificationIsNil applies the IsNil predicate on the "failure_classification" field.
+func FailureClassificationIsNil() predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldIsNull(FieldFailureClassification))
+}
+
+// FailureClassificationNotNil applies the NotNil predicate on the "failure_classification" field.
+func FailureClassificationNotNil() predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldNotNull(FieldFailureClassification))
+}
+
+// NewlyFailingEQ applies the EQ predicate on the "newly_failing" field.
+func NewlyFailingEQ(v bool) predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldEQ(FieldNewlyFailing, v))
+}
+
+// NewlyFailingNEQ applies the NEQ predicate on the "newly_failing" field.
+func NewlyFailingNEQ(v bool) predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldNEQ(FieldNewlyFailing, v))
+}
+
+// NewlyFailingIsNil applies the IsNil predicate on the "newly_failing" field.
+func NewlyFailingIsNil() predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldIsNull(FieldNewlyFailing))
+}
+
+// NewlyFailingNotNil applies the NotNil predicate on the "newly_failing" field.
+func NewlyFailingNotNil() predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(sql.FieldNotNull(FieldNewlyFailing))
+}
+
 // HasBazelInvocation applies the HasEdge predicate on the "bazel_invocation" edge.
 func HasBazelInvocation() predicate.BazelInvocationProblem {
 	return predicate.BazelInvocationProblem(func(s *sql.Selector) {
@@ -211,6 +346,75 @@ func HasBazelInvocationWith(preds ...predicate.BazelInvocation) predicate.BazelI
 	})
 }
 
+// HasKnownProblem applies the HasEdge predicate on the "known_problem" edge.
+func HasKnownProblem() predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, KnownProblemTable, KnownProblemColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasKnownProblemWith applies the HasEdge predicate on the "known_problem" edge with a given conditions (other predicates).
+func HasKnownProblemWith(preds ...predicate.KnownProblem) predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(func(s *sql.Selector) {
+		step := newKnownProblemStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlobs applies the HasEdge predicate on the "blobs" edge.
+func HasBlobs() predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, BlobsTable, BlobsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlobsWith applies the HasEdge predicate on the "blobs" edge with a given conditions (other predicates).
+func HasBlobsWith(preds ...predicate.Blob) predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(func(s *sql.Selector) {
+		step := newBlobsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSearchChunks applies the HasEdge predicate on the "search_chunks" edge.
+func HasSearchChunks() predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SearchChunksTable, SearchChunksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSearchChunksWith applies the HasEdge predicate on the "search_chunks" edge with a given conditions (other predicates).
+func HasSearchChunksWith(preds ...predicate.SearchChunk) predicate.BazelInvocationProblem {
+	return predicate.BazelInvocationProblem(func(s *sql.Selector) {
+		step := newSearchChunksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.BazelInvocationProblem) predicate.BazelInvocationProblem {
 	return predicate.BazelInvocationProblem(sql.AndPredicates(predicates...))
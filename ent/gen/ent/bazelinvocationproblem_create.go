@@ -8,10 +8,14 @@ import (
 	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocationproblem"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/knownproblem"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/searchchunk"
 )
 
 // BazelInvocationProblemCreate is the builder for creating a BazelInvocationProblem entity.
@@ -19,6 +23,7 @@ type BazelInvocationProblemCreate struct {
 	config
 	mutation *BazelInvocationProblemMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetProblemType sets the "problem_type" field.
@@ -39,6 +44,48 @@ func (bipc *BazelInvocationProblemCreate) SetBepEvents(jm json.RawMessage) *Baze
 	return bipc
 }
 
+// SetFingerprint sets the "fingerprint" field.
+func (bipc *BazelInvocationProblemCreate) SetFingerprint(s string) *BazelInvocationProblemCreate {
+	bipc.mutation.SetFingerprint(s)
+	return bipc
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (bipc *BazelInvocationProblemCreate) SetNillableFingerprint(s *string) *BazelInvocationProblemCreate {
+	if s != nil {
+		bipc.SetFingerprint(*s)
+	}
+	return bipc
+}
+
+// SetFailureClassification sets the "failure_classification" field.
+func (bipc *BazelInvocationProblemCreate) SetFailureClassification(bc bazelinvocationproblem.FailureClassification) *BazelInvocationProblemCreate {
+	bipc.mutation.SetFailureClassification(bc)
+	return bipc
+}
+
+// SetNillableFailureClassification sets the "failure_classification" field if the given value is not nil.
+func (bipc *BazelInvocationProblemCreate) SetNillableFailureClassification(bc *bazelinvocationproblem.FailureClassification) *BazelInvocationProblemCreate {
+	if bc != nil {
+		bipc.SetFailureClassification(*bc)
+	}
+	return bipc
+}
+
+// SetNewlyFailing sets the "newly_failing" field.
+func (bipc *BazelInvocationProblemCreate) SetNewlyFailing(b bool) *BazelInvocationProblemCreate {
+	bipc.mutation.SetNewlyFailing(b)
+	return bipc
+}
+
+// SetNillableNewlyFailing sets the "newly_failing" field if the given value is not nil.
+func (bipc *BazelInvocationProblemCreate) SetNillableNewlyFailing(b *bool) *BazelInvocationProblemCreate {
+	if b != nil {
+		bipc.SetNewlyFailing(*b)
+	}
+	return bipc
+}
+
 // SetBazelInvocationID sets the "bazel_invocation" edge to the BazelInvocation entity by ID.
 func (bipc *BazelInvocationProblemCreate) SetBazelInvocationID(id int) *BazelInvocationProblemCreate {
 	bipc.mutation.SetBazelInvocationID(id)
@@ -58,6 +105,55 @@ func (bipc *BazelInvocationProblemCreate) SetBazelInvocation(b *BazelInvocation)
 	return bipc.SetBazelInvocationID(b.ID)
 }
 
+// SetKnownProblemID sets the "known_problem" edge to the KnownProblem entity by ID.
+func (bipc *BazelInvocationProblemCreate) SetKnownProblemID(id int) *BazelInvocationProblemCreate {
+	bipc.mutation.SetKnownProblemID(id)
+	return bipc
+}
+
+// SetNillableKnownProblemID sets the "known_problem" edge to the KnownProblem entity by ID if the given value is not nil.
+func (bipc *BazelInvocationProblemCreate) SetNillableKnownProblemID(id *int) *BazelInvocationProblemCreate {
+	if id != nil {
+		bipc = bipc.SetKnownProblemID(*id)
+	}
+	return bipc
+}
+
+// SetKnownProblem sets the "known_problem" edge to the KnownProblem entity.
+func (bipc *BazelInvocationProblemCreate) SetKnownProblem(k *KnownProblem) *BazelInvocationProblemCreate {
+	return bipc.SetKnownProblemID(k.ID)
+}
+
+// AddBlobIDs adds the "blobs" edge to the Blob entity by IDs.
+func (bipc *BazelInvocationProblemCreate) AddBlobIDs(ids ...int) *BazelInvocationProblemCreate {
+	bipc.mutation.AddBlobIDs(ids...)
+	return bipc
+}
+
+// AddBlobs adds the "blobs" edges to the Blob entity.
+func (bipc *BazelInvocationProblemCreate) AddBlobs(b ...*Blob) *BazelInvocationProblemCreate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bipc.AddBlobIDs(ids...)
+}
+
+// AddSearchChunkIDs adds the "search_chunks" edge to the SearchChunk entity by IDs.
+func (bipc *BazelInvocationProblemCreate) AddSearchChunkIDs(ids ...int) *BazelInvocationProblemCreate {
+	bipc.mutation.AddSearchChunkIDs(ids...)
+	return bipc
+}
+
+// AddSearchChunks adds the "search_chunks" edges to the SearchChunk entity.
+func (bipc *BazelInvocationProblemCreate) AddSearchChunks(s ...*SearchChunk) *BazelInvocationProblemCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bipc.AddSearchChunkIDs(ids...)
+}
+
 // Mutation returns the BazelInvocationProblemMutation object of the builder.
 func (bipc *BazelInvocationProblemCreate) Mutation() *BazelInvocationProblemMutation {
 	return bipc.mutation
@@ -101,6 +197,11 @@ func (bipc *BazelInvocationProblemCreate) check() error {
 	if _, ok := bipc.mutation.BepEvents(); !ok {
 		return &ValidationError{Name: "bep_events", err: errors.New(`ent: missing required field "BazelInvocationProblem.bep_events"`)}
 	}
+	if v, ok := bipc.mutation.FailureClassification(); ok {
+		if err := bazelinvocationproblem.FailureClassificationValidator(v); err != nil {
+			return &ValidationError{Name: "failure_classification", err: fmt.Errorf(`ent: validator failed for field "BazelInvocationProblem.failure_classification": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -127,6 +228,7 @@ func (bipc *BazelInvocationProblemCreate) createSpec() (*BazelInvocationProblem,
 		_node = &BazelInvocationProblem{config: bipc.config}
 		_spec = sqlgraph.NewCreateSpec(bazelinvocationproblem.Table, sqlgraph.NewFieldSpec(bazelinvocationproblem.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = bipc.conflict
 	if value, ok := bipc.mutation.ProblemType(); ok {
 		_spec.SetField(bazelinvocationproblem.FieldProblemType, field.TypeString, value)
 		_node.ProblemType = value
@@ -139,6 +241,18 @@ func (bipc *BazelInvocationProblemCreate) createSpec() (*BazelInvocationProblem,
 		_spec.SetField(bazelinvocationproblem.FieldBepEvents, field.TypeJSON, value)
 		_node.BepEvents = value
 	}
+	if value, ok := bipc.mutation.Fingerprint(); ok {
+		_spec.SetField(bazelinvocationproblem.FieldFingerprint, field.TypeString, value)
+		_node.Fingerprint = value
+	}
+	if value, ok := bipc.mutation.FailureClassification(); ok {
+		_spec.SetField(bazelinvocationproblem.FieldFailureClassification, field.TypeEnum, value)
+		_node.FailureClassification = value
+	}
+	if value, ok := bipc.mutation.NewlyFailing(); ok {
+		_spec.SetField(bazelinvocationproblem.FieldNewlyFailing, field.TypeBool, value)
+		_node.NewlyFailing = &value
+	}
 	if nodes := bipc.mutation.BazelInvocationIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -156,14 +270,381 @@ func (bipc *BazelInvocationProblemCreate) createSpec() (*BazelInvocationProblem,
 		_node.bazel_invocation_problems = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := bipc.mutation.KnownProblemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bazelinvocationproblem.KnownProblemTable,
+			Columns: []string{bazelinvocationproblem.KnownProblemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knownproblem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.known_problem_problems = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bipc.mutation.BlobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   bazelinvocationproblem.BlobsTable,
+			Columns: bazelinvocationproblem.BlobsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bipc.mutation.SearchChunksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bazelinvocationproblem.SearchChunksTable,
+			Columns: []string{bazelinvocationproblem.SearchChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(searchchunk.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BazelInvocationProblem.Create().
+//		SetProblemType(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BazelInvocationProblemUpsert) {
+//			SetProblemType(v+v).
+//		}).
+//		Exec(ctx)
+func (bipc *BazelInvocationProblemCreate) OnConflict(opts ...sql.ConflictOption) *BazelInvocationProblemUpsertOne {
+	bipc.conflict = opts
+	return &BazelInvocationProblemUpsertOne{
+		create: bipc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BazelInvocationProblem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bipc *BazelInvocationProblemCreate) OnConflictColumns(columns ...string) *BazelInvocationProblemUpsertOne {
+	bipc.conflict = append(bipc.conflict, sql.ConflictColumns(columns...))
+	return &BazelInvocationProblemUpsertOne{
+		create: bipc,
+	}
+}
+
+type (
+	// BazelInvocationProblemUpsertOne is the builder for "upsert"-ing
+	//  one BazelInvocationProblem node.
+	BazelInvocationProblemUpsertOne struct {
+		create *BazelInvocationProblemCreate
+	}
+
+	// BazelInvocationProblemUpsert is the "OnConflict" setter.
+	BazelInvocationProblemUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProblemType sets the "problem_type" field.
+func (u *BazelInvocationProblemUpsert) SetProblemType(v string) *BazelInvocationProblemUpsert {
+	u.Set(bazelinvocationproblem.FieldProblemType, v)
+	return u
+}
+
+// UpdateProblemType sets the "problem_type" field to the value that was provided on create.
+func (u *BazelInvocationProblemUpsert) UpdateProblemType() *BazelInvocationProblemUpsert {
+	u.SetExcluded(bazelinvocationproblem.FieldProblemType)
+	return u
+}
+
+// SetLabel sets the "label" field.
+func (u *BazelInvocationProblemUpsert) SetLabel(v string) *BazelInvocationProblemUpsert {
+	u.Set(bazelinvocationproblem.FieldLabel, v)
+	return u
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *BazelInvocationProblemUpsert) UpdateLabel() *BazelInvocationProblemUpsert {
+	u.SetExcluded(bazelinvocationproblem.FieldLabel)
+	return u
+}
+
+// SetBepEvents sets the "bep_events" field.
+func (u *BazelInvocationProblemUpsert) SetBepEvents(v json.RawMessage) *BazelInvocationProblemUpsert {
+	u.Set(bazelinvocationproblem.FieldBepEvents, v)
+	return u
+}
+
+// UpdateBepEvents sets the "bep_events" field to the value that was provided on create.
+func (u *BazelInvocationProblemUpsert) UpdateBepEvents() *BazelInvocationProblemUpsert {
+	u.SetExcluded(bazelinvocationproblem.FieldBepEvents)
+	return u
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (u *BazelInvocationProblemUpsert) SetFingerprint(v string) *BazelInvocationProblemUpsert {
+	u.Set(bazelinvocationproblem.FieldFingerprint, v)
+	return u
+}
+
+// UpdateFingerprint sets the "fingerprint" field to the value that was provided on create.
+func (u *BazelInvocationProblemUpsert) UpdateFingerprint() *BazelInvocationProblemUpsert {
+	u.SetExcluded(bazelinvocationproblem.FieldFingerprint)
+	return u
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (u *BazelInvocationProblemUpsert) ClearFingerprint() *BazelInvocationProblemUpsert {
+	u.SetNull(bazelinvocationproblem.FieldFingerprint)
+	return u
+}
+
+// SetFailureClassification sets the "failure_classification" field.
+func (u *BazelInvocationProblemUpsert) SetFailureClassification(v bazelinvocationproblem.FailureClassification) *BazelInvocationProblemUpsert {
+	u.Set(bazelinvocationproblem.FieldFailureClassification, v)
+	return u
+}
+
+// UpdateFailureClassification sets the "failure_classification" field to the value that was provided on create.
+func (u *BazelInvocationProblemUpsert) UpdateFailureClassification() *BazelInvocationProblemUpsert {
+	u.SetExcluded(bazelinvocationproblem.FieldFailureClassification)
+	return u
+}
+
+// ClearFailureClassification clears the value of the "failure_classification" field.
+func (u *BazelInvocationProblemUpsert) ClearFailureClassification() *BazelInvocationProblemUpsert {
+	u.SetNull(bazelinvocationproblem.FieldFailureClassification)
+	return u
+}
+
+// SetNewlyFailing sets the "newly_failing" field.
+func (u *BazelInvocationProblemUpsert) SetNewlyFailing(v bool) *BazelInvocationProblemUpsert {
+	u.Set(bazelinvocationproblem.FieldNewlyFailing, v)
+	return u
+}
+
+// UpdateNewlyFailing sets the "newly_failing" field to the value that was provided on create.
+func (u *BazelInvocationProblemUpsert) UpdateNewlyFailing() *BazelInvocationProblemUpsert {
+	u.SetExcluded(bazelinvocationproblem.FieldNewlyFailing)
+	return u
+}
+
+// ClearNewlyFailing clears the value of the "newly_failing" field.
+func (u *BazelInvocationProblemUpsert) ClearNewlyFailing() *BazelInvocationProblemUpsert {
+	u.SetNull(bazelinvocationproblem.FieldNewlyFailing)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.BazelInvocationProblem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BazelInvocationProblemUpsertOne) UpdateNewValues() *BazelInvocationProblemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BazelInvocationProblem.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BazelInvocationProblemUpsertOne) Ignore() *BazelInvocationProblemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BazelInvocationProblemUpsertOne) DoNothing() *BazelInvocationProblemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BazelInvocationProblemCreate.OnConflict
+// documentation for more info.
+func (u *BazelInvocationProblemUpsertOne) Update(set func(*BazelInvocationProblemUpsert)) *BazelInvocationProblemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BazelInvocationProblemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProblemType sets the "problem_type" field.
+func (u *BazelInvocationProblemUpsertOne) SetProblemType(v string) *BazelInvocationProblemUpsertOne {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.SetProblemType(v)
+	})
+}
+
+// UpdateProblemType sets the "problem_type" field to the value that was provided on create.
+func (u *BazelInvocationProblemUpsertOne) UpdateProblemType() *BazelInvocationProblemUpsertOne {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.UpdateProblemType()
+	})
+}
+
+// SetLabel sets the "label" field.
+func (u *BazelInvocationProblemUpsertOne) SetLabel(v string) *BazelInvocationProblemUpsertOne {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.SetLabel(v)
+	})
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *BazelInvocationProblemUpsertOne) UpdateLabel() *BazelInvocationProblemUpsertOne {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.UpdateLabel()
+	})
+}
+
+// SetBepEvents sets the "bep_events" field.
+func (u *BazelInvocationProblemUpsertOne) SetBepEvents(v json.RawMessage) *BazelInvocationProblemUpsertOne {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.SetBepEvents(v)
+	})
+}
+
+// UpdateBepEvents sets the "bep_events" field to the value that was provided on create.
+func (u *BazelInvocationProblemUpsertOne) UpdateBepEvents() *BazelInvocationProblemUpsertOne {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.UpdateBepEvents()
+	})
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (u *BazelInvocationProblemUpsertOne) SetFingerprint(v string) *BazelInvocationProblemUpsertOne {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.SetFingerprint(v)
+	})
+}
+
+// UpdateFingerprint sets the "fingerprint" field to the value that was provided on create.
+func (u *BazelInvocationProblemUpsertOne) UpdateFingerprint() *BazelInvocationProblemUpsertOne {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.UpdateFingerprint()
+	})
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (u *BazelInvocationProblemUpsertOne) ClearFingerprint() *BazelInvocationProblemUpsertOne {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.ClearFingerprint()
+	})
+}
+
+// SetFailureClassification sets the "failure_classification" field.
+func (u *BazelInvocationProblemUpsertOne) SetFailureClassification(v bazelinvocationproblem.FailureClassification) *BazelInvocationProblemUpsertOne {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.SetFailureClassification(v)
+	})
+}
+
+// UpdateFailureClassification sets the "failure_classification" field to the value that was provided on create.
+func (u *BazelInvocationProblemUpsertOne) UpdateFailureClassification() *BazelInvocationProblemUpsertOne {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.UpdateFailureClassification()
+	})
+}
+
+// ClearFailureClassification clears the value of the "failure_classification" field.
+func (u *BazelInvocationProblemUpsertOne) ClearFailureClassification() *BazelInvocationProblemUpsertOne {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.ClearFailureClassification()
+	})
+}
+
+// SetNewlyFailing sets the "newly_failing" field.
+func (u *BazelInvocationProblemUpsertOne) SetNewlyFailing(v bool) *BazelInvocationProblemUpsertOne {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.SetNewlyFailing(v)
+	})
+}
+
+// UpdateNewlyFailing sets the "newly_failing" field to the value that was provided on create.
+func (u *BazelInvocationProblemUpsertOne) UpdateNewlyFailing() *BazelInvocationProblemUpsertOne {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.UpdateNewlyFailing()
+	})
+}
+
+// ClearNewlyFailing clears the value of the "newly_failing" field.
+func (u *BazelInvocationProblemUpsertOne) ClearNewlyFailing() *BazelInvocationProblemUpsertOne {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.ClearNewlyFailing()
+	})
+}
+
+// Exec executes the query.
+func (u *BazelInvocationProblemUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BazelInvocationProblemCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BazelInvocationProblemUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BazelInvocationProblemUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BazelInvocationProblemUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // BazelInvocationProblemCreateBulk is the builder for creating many BazelInvocationProblem entities in bulk.
 type BazelInvocationProblemCreateBulk struct {
 	config
 	err      error
 	builders []*BazelInvocationProblemCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the BazelInvocationProblem entities in the database.
@@ -192,6 +673,7 @@ func (bipcb *BazelInvocationProblemCreateBulk) Save(ctx context.Context) ([]*Baz
 					_, err = mutators[i+1].Mutate(root, bipcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = bipcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, bipcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -245,3 +727,212 @@ func (bipcb *BazelInvocationProblemCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BazelInvocationProblem.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BazelInvocationProblemUpsert) {
+//			SetProblemType(v+v).
+//		}).
+//		Exec(ctx)
+func (bipcb *BazelInvocationProblemCreateBulk) OnConflict(opts ...sql.ConflictOption) *BazelInvocationProblemUpsertBulk {
+	bipcb.conflict = opts
+	return &BazelInvocationProblemUpsertBulk{
+		create: bipcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BazelInvocationProblem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bipcb *BazelInvocationProblemCreateBulk) OnConflictColumns(columns ...string) *BazelInvocationProblemUpsertBulk {
+	bipcb.conflict = append(bipcb.conflict, sql.ConflictColumns(columns...))
+	return &BazelInvocationProblemUpsertBulk{
+		create: bipcb,
+	}
+}
+
+// BazelInvocationProblemUpsertBulk is the builder for "upsert"-ing
+// a bulk of BazelInvocationProblem nodes.
+type BazelInvocationProblemUpsertBulk struct {
+	create *BazelInvocationProblemCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BazelInvocationProblem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BazelInvocationProblemUpsertBulk) UpdateNewValues() *BazelInvocationProblemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BazelInvocationProblem.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BazelInvocationProblemUpsertBulk) Ignore() *BazelInvocationProblemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BazelInvocationProblemUpsertBulk) DoNothing() *BazelInvocationProblemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BazelInvocationProblemCreateBulk.OnConflict
+// documentation for more info.
+func (u *BazelInvocationProblemUpsertBulk) Update(set func(*BazelInvocationProblemUpsert)) *BazelInvocationProblemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BazelInvocationProblemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProblemType sets the "problem_type" field.
+func (u *BazelInvocationProblemUpsertBulk) SetProblemType(v string) *BazelInvocationProblemUpsertBulk {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.SetProblemType(v)
+	})
+}
+
+// UpdateProblemType sets the "problem_type" field to the value that was provided on create.
+func (u *BazelInvocationProblemUpsertBulk) UpdateProblemType() *BazelInvocationProblemUpsertBulk {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.UpdateProblemType()
+	})
+}
+
+// SetLabel sets the "label" field.
+func (u *BazelInvocationProblemUpsertBulk) SetLabel(v string) *BazelInvocationProblemUpsertBulk {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.SetLabel(v)
+	})
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *BazelInvocationProblemUpsertBulk) UpdateLabel() *BazelInvocationProblemUpsertBulk {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.UpdateLabel()
+	})
+}
+
+// SetBepEvents sets the "bep_events" field.
+func (u *BazelInvocationProblemUpsertBulk) SetBepEvents(v json.RawMessage) *BazelInvocationProblemUpsertBulk {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.SetBepEvents(v)
+	})
+}
+
+// UpdateBepEvents sets the "bep_events" field to the value that was provided on create.
+func (u *BazelInvocationProblemUpsertBulk) UpdateBepEvents() *BazelInvocationProblemUpsertBulk {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.UpdateBepEvents()
+	})
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (u *BazelInvocationProblemUpsertBulk) SetFingerprint(v string) *BazelInvocationProblemUpsertBulk {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.SetFingerprint(v)
+	})
+}
+
+// UpdateFingerprint sets the "fingerprint" field to the value that was provided on create.
+func (u *BazelInvocationProblemUpsertBulk) UpdateFingerprint() *BazelInvocationProblemUpsertBulk {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.UpdateFingerprint()
+	})
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (u *BazelInvocationProblemUpsertBulk) ClearFingerprint() *BazelInvocationProblemUpsertBulk {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.ClearFingerprint()
+	})
+}
+
+// SetFailureClassification sets the "failure_classification" field.
+func (u *BazelInvocationProblemUpsertBulk) SetFailureClassification(v bazelinvocationproblem.FailureClassification) *BazelInvocationProblemUpsertBulk {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.SetFailureClassification(v)
+	})
+}
+
+// UpdateFailureClassification sets the "failure_classification" field to the value that was provided on create.
+func (u *BazelInvocationProblemUpsertBulk) UpdateFailureClassification() *BazelInvocationProblemUpsertBulk {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.UpdateFailureClassification()
+	})
+}
+
+// ClearFailureClassification clears the value of the "failure_classification" field.
+func (u *BazelInvocationProblemUpsertBulk) ClearFailureClassification() *BazelInvocationProblemUpsertBulk {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.ClearFailureClassification()
+	})
+}
+
+// SetNewlyFailing sets the "newly_failing" field.
+func (u *BazelInvocationProblemUpsertBulk) SetNewlyFailing(v bool) *BazelInvocationProblemUpsertBulk {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.SetNewlyFailing(v)
+	})
+}
+
+// UpdateNewlyFailing sets the "newly_failing" field to the value that was provided on create.
+func (u *BazelInvocationProblemUpsertBulk) UpdateNewlyFailing() *BazelInvocationProblemUpsertBulk {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.UpdateNewlyFailing()
+	})
+}
+
+// ClearNewlyFailing clears the value of the "newly_failing" field.
+func (u *BazelInvocationProblemUpsertBulk) ClearNewlyFailing() *BazelInvocationProblemUpsertBulk {
+	return u.Update(func(s *BazelInvocationProblemUpsert) {
+		s.ClearNewlyFailing()
+	})
+}
+
+// Exec executes the query.
+func (u *BazelInvocationProblemUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BazelInvocationProblemCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BazelInvocationProblemCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BazelInvocationProblemUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
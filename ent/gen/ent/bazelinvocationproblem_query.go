@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -12,20 +13,28 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocationproblem"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/knownproblem"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/predicate"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/searchchunk"
 )
 
 // BazelInvocationProblemQuery is the builder for querying BazelInvocationProblem entities.
 type BazelInvocationProblemQuery struct {
 	config
-	ctx                 *QueryContext
-	order               []bazelinvocationproblem.OrderOption
-	inters              []Interceptor
-	predicates          []predicate.BazelInvocationProblem
-	withBazelInvocation *BazelInvocationQuery
-	withFKs             bool
-	modifiers           []func(*sql.Selector)
-	loadTotal           []func(context.Context, []*BazelInvocationProblem) error
+	ctx                   *QueryContext
+	order                 []bazelinvocationproblem.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.BazelInvocationProblem
+	withBazelInvocation   *BazelInvocationQuery
+	withKnownProblem      *KnownProblemQuery
+	withBlobs             *BlobQuery
+	withSearchChunks      *SearchChunkQuery
+	withFKs               bool
+	modifiers             []func(*sql.Selector)
+	loadTotal             []func(context.Context, []*BazelInvocationProblem) error
+	withNamedBlobs        map[string]*BlobQuery
+	withNamedSearchChunks map[string]*SearchChunkQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,6 +93,72 @@ func (bipq *BazelInvocationProblemQuery) QueryBazelInvocation() *BazelInvocation
 	return query
 }
 
+// QueryKnownProblem chains the current query on the "known_problem" edge.
+func (bipq *BazelInvocationProblemQuery) QueryKnownProblem() *KnownProblemQuery {
+	query := (&KnownProblemClient{config: bipq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bipq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bipq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bazelinvocationproblem.Table, bazelinvocationproblem.FieldID, selector),
+			sqlgraph.To(knownproblem.Table, knownproblem.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, bazelinvocationproblem.KnownProblemTable, bazelinvocationproblem.KnownProblemColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bipq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBlobs chains the current query on the "blobs" edge.
+func (bipq *BazelInvocationProblemQuery) QueryBlobs() *BlobQuery {
+	query := (&BlobClient{config: bipq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bipq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bipq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bazelinvocationproblem.Table, bazelinvocationproblem.FieldID, selector),
+			sqlgraph.To(blob.Table, blob.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, bazelinvocationproblem.BlobsTable, bazelinvocationproblem.BlobsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(bipq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySearchChunks chains the current query on the "search_chunks" edge.
+func (bipq *BazelInvocationProblemQuery) QuerySearchChunks() *SearchChunkQuery {
+	query := (&SearchChunkClient{config: bipq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bipq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bipq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bazelinvocationproblem.Table, bazelinvocationproblem.FieldID, selector),
+			sqlgraph.To(searchchunk.Table, searchchunk.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, bazelinvocationproblem.SearchChunksTable, bazelinvocationproblem.SearchChunksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bipq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first BazelInvocationProblem entity from the query.
 // Returns a *NotFoundError when no BazelInvocationProblem was found.
 func (bipq *BazelInvocationProblemQuery) First(ctx context.Context) (*BazelInvocationProblem, error) {
@@ -277,6 +352,9 @@ func (bipq *BazelInvocationProblemQuery) Clone() *BazelInvocationProblemQuery {
 		inters:              append([]Interceptor{}, bipq.inters...),
 		predicates:          append([]predicate.BazelInvocationProblem{}, bipq.predicates...),
 		withBazelInvocation: bipq.withBazelInvocation.Clone(),
+		withKnownProblem:    bipq.withKnownProblem.Clone(),
+		withBlobs:           bipq.withBlobs.Clone(),
+		withSearchChunks:    bipq.withSearchChunks.Clone(),
 		// clone intermediate query.
 		sql:  bipq.sql.Clone(),
 		path: bipq.path,
@@ -294,6 +372,39 @@ func (bipq *BazelInvocationProblemQuery) WithBazelInvocation(opts ...func(*Bazel
 	return bipq
 }
 
+// WithKnownProblem tells the query-builder to eager-load the nodes that are connected to
+// the "known_problem" edge. The optional arguments are used to configure the query builder of the edge.
+func (bipq *BazelInvocationProblemQuery) WithKnownProblem(opts ...func(*KnownProblemQuery)) *BazelInvocationProblemQuery {
+	query := (&KnownProblemClient{config: bipq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bipq.withKnownProblem = query
+	return bipq
+}
+
+// WithBlobs tells the query-builder to eager-load the nodes that are connected to
+// the "blobs" edge. The optional arguments are used to configure the query builder of the edge.
+func (bipq *BazelInvocationProblemQuery) WithBlobs(opts ...func(*BlobQuery)) *BazelInvocationProblemQuery {
+	query := (&BlobClient{config: bipq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bipq.withBlobs = query
+	return bipq
+}
+
+// WithSearchChunks tells the query-builder to eager-load the nodes that are connected to
+// the "search_chunks" edge. The optional arguments are used to configure the query builder of the edge.
+func (bipq *BazelInvocationProblemQuery) WithSearchChunks(opts ...func(*SearchChunkQuery)) *BazelInvocationProblemQuery {
+	query := (&SearchChunkClient{config: bipq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bipq.withSearchChunks = query
+	return bipq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -373,11 +484,14 @@ func (bipq *BazelInvocationProblemQuery) sqlAll(ctx context.Context, hooks ...qu
 		nodes       = []*BazelInvocationProblem{}
 		withFKs     = bipq.withFKs
 		_spec       = bipq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [4]bool{
 			bipq.withBazelInvocation != nil,
+			bipq.withKnownProblem != nil,
+			bipq.withBlobs != nil,
+			bipq.withSearchChunks != nil,
 		}
 	)
-	if bipq.withBazelInvocation != nil {
+	if bipq.withBazelInvocation != nil || bipq.withKnownProblem != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -410,6 +524,42 @@ func (bipq *BazelInvocationProblemQuery) sqlAll(ctx context.Context, hooks ...qu
 			return nil, err
 		}
 	}
+	if query := bipq.withKnownProblem; query != nil {
+		if err := bipq.loadKnownProblem(ctx, query, nodes, nil,
+			func(n *BazelInvocationProblem, e *KnownProblem) { n.Edges.KnownProblem = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := bipq.withBlobs; query != nil {
+		if err := bipq.loadBlobs(ctx, query, nodes,
+			func(n *BazelInvocationProblem) { n.Edges.Blobs = []*Blob{} },
+			func(n *BazelInvocationProblem, e *Blob) { n.Edges.Blobs = append(n.Edges.Blobs, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := bipq.withSearchChunks; query != nil {
+		if err := bipq.loadSearchChunks(ctx, query, nodes,
+			func(n *BazelInvocationProblem) { n.Edges.SearchChunks = []*SearchChunk{} },
+			func(n *BazelInvocationProblem, e *SearchChunk) {
+				n.Edges.SearchChunks = append(n.Edges.SearchChunks, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range bipq.withNamedBlobs {
+		if err := bipq.loadBlobs(ctx, query, nodes,
+			func(n *BazelInvocationProblem) { n.appendNamedBlobs(name) },
+			func(n *BazelInvocationProblem, e *Blob) { n.appendNamedBlobs(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range bipq.withNamedSearchChunks {
+		if err := bipq.loadSearchChunks(ctx, query, nodes,
+			func(n *BazelInvocationProblem) { n.appendNamedSearchChunks(name) },
+			func(n *BazelInvocationProblem, e *SearchChunk) { n.appendNamedSearchChunks(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for i := range bipq.loadTotal {
 		if err := bipq.loadTotal[i](ctx, nodes); err != nil {
 			return nil, err
@@ -450,6 +600,130 @@ func (bipq *BazelInvocationProblemQuery) loadBazelInvocation(ctx context.Context
 	}
 	return nil
 }
+func (bipq *BazelInvocationProblemQuery) loadKnownProblem(ctx context.Context, query *KnownProblemQuery, nodes []*BazelInvocationProblem, init func(*BazelInvocationProblem), assign func(*BazelInvocationProblem, *KnownProblem)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*BazelInvocationProblem)
+	for i := range nodes {
+		if nodes[i].known_problem_problems == nil {
+			continue
+		}
+		fk := *nodes[i].known_problem_problems
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(knownproblem.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "known_problem_problems" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (bipq *BazelInvocationProblemQuery) loadBlobs(ctx context.Context, query *BlobQuery, nodes []*BazelInvocationProblem, init func(*BazelInvocationProblem), assign func(*BazelInvocationProblem, *Blob)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*BazelInvocationProblem)
+	nids := make(map[int]map[*BazelInvocationProblem]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(bazelinvocationproblem.BlobsTable)
+		s.Join(joinT).On(s.C(blob.FieldID), joinT.C(bazelinvocationproblem.BlobsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(bazelinvocationproblem.BlobsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(bazelinvocationproblem.BlobsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*BazelInvocationProblem]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Blob](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "blobs" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (bipq *BazelInvocationProblemQuery) loadSearchChunks(ctx context.Context, query *SearchChunkQuery, nodes []*BazelInvocationProblem, init func(*BazelInvocationProblem), assign func(*BazelInvocationProblem, *SearchChunk)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*BazelInvocationProblem)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SearchChunk(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(bazelinvocationproblem.SearchChunksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.bazel_invocation_problem_search_chunks
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "bazel_invocation_problem_search_chunks" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "bazel_invocation_problem_search_chunks" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (bipq *BazelInvocationProblemQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := bipq.querySpec()
@@ -535,6 +809,34 @@ func (bipq *BazelInvocationProblemQuery) sqlQuery(ctx context.Context) *sql.Sele
 	return selector
 }
 
+// WithNamedBlobs tells the query-builder to eager-load the nodes that are connected to the "blobs"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (bipq *BazelInvocationProblemQuery) WithNamedBlobs(name string, opts ...func(*BlobQuery)) *BazelInvocationProblemQuery {
+	query := (&BlobClient{config: bipq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if bipq.withNamedBlobs == nil {
+		bipq.withNamedBlobs = make(map[string]*BlobQuery)
+	}
+	bipq.withNamedBlobs[name] = query
+	return bipq
+}
+
+// WithNamedSearchChunks tells the query-builder to eager-load the nodes that are connected to the "search_chunks"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (bipq *BazelInvocationProblemQuery) WithNamedSearchChunks(name string, opts ...func(*SearchChunkQuery)) *BazelInvocationProblemQuery {
+	query := (&SearchChunkClient{config: bipq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if bipq.withNamedSearchChunks == nil {
+		bipq.withNamedSearchChunks = make(map[string]*SearchChunkQuery)
+	}
+	bipq.withNamedSearchChunks[name] = query
+	return bipq
+}
+
 // BazelInvocationProblemGroupBy is the group-by builder for BazelInvocationProblem entities.
 type BazelInvocationProblemGroupBy struct {
 	selector
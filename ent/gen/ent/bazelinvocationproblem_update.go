@@ -14,7 +14,10 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocationproblem"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/knownproblem"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/predicate"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/searchchunk"
 )
 
 // BazelInvocationProblemUpdate is the builder for updating BazelInvocationProblem entities.
@@ -70,6 +73,66 @@ func (bipu *BazelInvocationProblemUpdate) AppendBepEvents(jm json.RawMessage) *B
 	return bipu
 }
 
+// SetFingerprint sets the "fingerprint" field.
+func (bipu *BazelInvocationProblemUpdate) SetFingerprint(s string) *BazelInvocationProblemUpdate {
+	bipu.mutation.SetFingerprint(s)
+	return bipu
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (bipu *BazelInvocationProblemUpdate) SetNillableFingerprint(s *string) *BazelInvocationProblemUpdate {
+	if s != nil {
+		bipu.SetFingerprint(*s)
+	}
+	return bipu
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (bipu *BazelInvocationProblemUpdate) ClearFingerprint() *BazelInvocationProblemUpdate {
+	bipu.mutation.ClearFingerprint()
+	return bipu
+}
+
+// SetFailureClassification sets the "failure_classification" field.
+func (bipu *BazelInvocationProblemUpdate) SetFailureClassification(bc bazelinvocationproblem.FailureClassification) *BazelInvocationProblemUpdate {
+	bipu.mutation.SetFailureClassification(bc)
+	return bipu
+}
+
+// SetNillableFailureClassification sets the "failure_classification" field if the given value is not nil.
+func (bipu *BazelInvocationProblemUpdate) SetNillableFailureClassification(bc *bazelinvocationproblem.FailureClassification) *BazelInvocationProblemUpdate {
+	if bc != nil {
+		bipu.SetFailureClassification(*bc)
+	}
+	return bipu
+}
+
+// ClearFailureClassification clears the value of the "failure_classification" field.
+func (bipu *BazelInvocationProblemUpdate) ClearFailureClassification() *BazelInvocationProblemUpdate {
+	bipu.mutation.ClearFailureClassification()
+	return bipu
+}
+
+// SetNewlyFailing sets the "newly_failing" field.
+func (bipu *BazelInvocationProblemUpdate) SetNewlyFailing(b bool) *BazelInvocationProblemUpdate {
+	bipu.mutation.SetNewlyFailing(b)
+	return bipu
+}
+
+// SetNillableNewlyFailing sets the "newly_failing" field if the given value is not nil.
+func (bipu *BazelInvocationProblemUpdate) SetNillableNewlyFailing(b *bool) *BazelInvocationProblemUpdate {
+	if b != nil {
+		bipu.SetNewlyFailing(*b)
+	}
+	return bipu
+}
+
+// ClearNewlyFailing clears the value of the "newly_failing" field.
+func (bipu *BazelInvocationProblemUpdate) ClearNewlyFailing() *BazelInvocationProblemUpdate {
+	bipu.mutation.ClearNewlyFailing()
+	return bipu
+}
+
 // SetBazelInvocationID sets the "bazel_invocation" edge to the BazelInvocation entity by ID.
 func (bipu *BazelInvocationProblemUpdate) SetBazelInvocationID(id int) *BazelInvocationProblemUpdate {
 	bipu.mutation.SetBazelInvocationID(id)
@@ -89,6 +152,55 @@ func (bipu *BazelInvocationProblemUpdate) SetBazelInvocation(b *BazelInvocation)
 	return bipu.SetBazelInvocationID(b.ID)
 }
 
+// SetKnownProblemID sets the "known_problem" edge to the KnownProblem entity by ID.
+func (bipu *BazelInvocationProblemUpdate) SetKnownProblemID(id int) *BazelInvocationProblemUpdate {
+	bipu.mutation.SetKnownProblemID(id)
+	return bipu
+}
+
+// SetNillableKnownProblemID sets the "known_problem" edge to the KnownProblem entity by ID if the given value is not nil.
+func (bipu *BazelInvocationProblemUpdate) SetNillableKnownProblemID(id *int) *BazelInvocationProblemUpdate {
+	if id != nil {
+		bipu = bipu.SetKnownProblemID(*id)
+	}
+	return bipu
+}
+
+// SetKnownProblem sets the "known_problem" edge to the KnownProblem entity.
+func (bipu *BazelInvocationProblemUpdate) SetKnownProblem(k *KnownProblem) *BazelInvocationProblemUpdate {
+	return bipu.SetKnownProblemID(k.ID)
+}
+
+// AddBlobIDs adds the "blobs" edge to the Blob entity by IDs.
+func (bipu *BazelInvocationProblemUpdate) AddBlobIDs(ids ...int) *BazelInvocationProblemUpdate {
+	bipu.mutation.AddBlobIDs(ids...)
+	return bipu
+}
+
+// AddBlobs adds the "blobs" edges to the Blob entity.
+func (bipu *BazelInvocationProblemUpdate) AddBlobs(b ...*Blob) *BazelInvocationProblemUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bipu.AddBlobIDs(ids...)
+}
+
+// AddSearchChunkIDs adds the "search_chunks" edge to the SearchChunk entity by IDs.
+func (bipu *BazelInvocationProblemUpdate) AddSearchChunkIDs(ids ...int) *BazelInvocationProblemUpdate {
+	bipu.mutation.AddSearchChunkIDs(ids...)
+	return bipu
+}
+
+// AddSearchChunks adds the "search_chunks" edges to the SearchChunk entity.
+func (bipu *BazelInvocationProblemUpdate) AddSearchChunks(s ...*SearchChunk) *BazelInvocationProblemUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bipu.AddSearchChunkIDs(ids...)
+}
+
 // Mutation returns the BazelInvocationProblemMutation object of the builder.
 func (bipu *BazelInvocationProblemUpdate) Mutation() *BazelInvocationProblemMutation {
 	return bipu.mutation
@@ -100,6 +212,54 @@ func (bipu *BazelInvocationProblemUpdate) ClearBazelInvocation() *BazelInvocatio
 	return bipu
 }
 
+// ClearKnownProblem clears the "known_problem" edge to the KnownProblem entity.
+func (bipu *BazelInvocationProblemUpdate) ClearKnownProblem() *BazelInvocationProblemUpdate {
+	bipu.mutation.ClearKnownProblem()
+	return bipu
+}
+
+// ClearBlobs clears all "blobs" edges to the Blob entity.
+func (bipu *BazelInvocationProblemUpdate) ClearBlobs() *BazelInvocationProblemUpdate {
+	bipu.mutation.ClearBlobs()
+	return bipu
+}
+
+// RemoveBlobIDs removes the "blobs" edge to Blob entities by IDs.
+func (bipu *BazelInvocationProblemUpdate) RemoveBlobIDs(ids ...int) *BazelInvocationProblemUpdate {
+	bipu.mutation.RemoveBlobIDs(ids...)
+	return bipu
+}
+
+// RemoveBlobs removes "blobs" edges to Blob entities.
+func (bipu *BazelInvocationProblemUpdate) RemoveBlobs(b ...*Blob) *BazelInvocationProblemUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bipu.RemoveBlobIDs(ids...)
+}
+
+// ClearSearchChunks clears all "search_chunks" edges to the SearchChunk entity.
+func (bipu *BazelInvocationProblemUpdate) ClearSearchChunks() *BazelInvocationProblemUpdate {
+	bipu.mutation.ClearSearchChunks()
+	return bipu
+}
+
+// RemoveSearchChunkIDs removes the "search_chunks" edge to SearchChunk entities by IDs.
+func (bipu *BazelInvocationProblemUpdate) RemoveSearchChunkIDs(ids ...int) *BazelInvocationProblemUpdate {
+	bipu.mutation.RemoveSearchChunkIDs(ids...)
+	return bipu
+}
+
+// RemoveSearchChunks removes "search_chunks" edges to SearchChunk entities.
+func (bipu *BazelInvocationProblemUpdate) RemoveSearchChunks(s ...*SearchChunk) *BazelInvocationProblemUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bipu.RemoveSearchChunkIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (bipu *BazelInvocationProblemUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, bipu.sqlSave, bipu.mutation, bipu.hooks)
@@ -127,7 +287,20 @@ func (bipu *BazelInvocationProblemUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (bipu *BazelInvocationProblemUpdate) check() error {
+	if v, ok := bipu.mutation.FailureClassification(); ok {
+		if err := bazelinvocationproblem.FailureClassificationValidator(v); err != nil {
+			return &ValidationError{Name: "failure_classification", err: fmt.Errorf(`ent: validator failed for field "BazelInvocationProblem.failure_classification": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (bipu *BazelInvocationProblemUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bipu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(bazelinvocationproblem.Table, bazelinvocationproblem.Columns, sqlgraph.NewFieldSpec(bazelinvocationproblem.FieldID, field.TypeInt))
 	if ps := bipu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -150,6 +323,24 @@ func (bipu *BazelInvocationProblemUpdate) sqlSave(ctx context.Context) (n int, e
 			sqljson.Append(u, bazelinvocationproblem.FieldBepEvents, value)
 		})
 	}
+	if value, ok := bipu.mutation.Fingerprint(); ok {
+		_spec.SetField(bazelinvocationproblem.FieldFingerprint, field.TypeString, value)
+	}
+	if bipu.mutation.FingerprintCleared() {
+		_spec.ClearField(bazelinvocationproblem.FieldFingerprint, field.TypeString)
+	}
+	if value, ok := bipu.mutation.FailureClassification(); ok {
+		_spec.SetField(bazelinvocationproblem.FieldFailureClassification, field.TypeEnum, value)
+	}
+	if bipu.mutation.FailureClassificationCleared() {
+		_spec.ClearField(bazelinvocationproblem.FieldFailureClassification, field.TypeEnum)
+	}
+	if value, ok := bipu.mutation.NewlyFailing(); ok {
+		_spec.SetField(bazelinvocationproblem.FieldNewlyFailing, field.TypeBool, value)
+	}
+	if bipu.mutation.NewlyFailingCleared() {
+		_spec.ClearField(bazelinvocationproblem.FieldNewlyFailing, field.TypeBool)
+	}
 	if bipu.mutation.BazelInvocationCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -179,6 +370,125 @@ func (bipu *BazelInvocationProblemUpdate) sqlSave(ctx context.Context) (n int, e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if bipu.mutation.KnownProblemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bazelinvocationproblem.KnownProblemTable,
+			Columns: []string{bazelinvocationproblem.KnownProblemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knownproblem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bipu.mutation.KnownProblemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bazelinvocationproblem.KnownProblemTable,
+			Columns: []string{bazelinvocationproblem.KnownProblemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knownproblem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bipu.mutation.BlobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   bazelinvocationproblem.BlobsTable,
+			Columns: bazelinvocationproblem.BlobsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blob.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bipu.mutation.RemovedBlobsIDs(); len(nodes) > 0 && !bipu.mutation.BlobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   bazelinvocationproblem.BlobsTable,
+			Columns: bazelinvocationproblem.BlobsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bipu.mutation.BlobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   bazelinvocationproblem.BlobsTable,
+			Columns: bazelinvocationproblem.BlobsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bipu.mutation.SearchChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bazelinvocationproblem.SearchChunksTable,
+			Columns: []string{bazelinvocationproblem.SearchChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(searchchunk.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bipu.mutation.RemovedSearchChunksIDs(); len(nodes) > 0 && !bipu.mutation.SearchChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bazelinvocationproblem.SearchChunksTable,
+			Columns: []string{bazelinvocationproblem.SearchChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(searchchunk.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bipu.mutation.SearchChunksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bazelinvocationproblem.SearchChunksTable,
+			Columns: []string{bazelinvocationproblem.SearchChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(searchchunk.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, bipu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{bazelinvocationproblem.Label}
@@ -239,6 +549,66 @@ func (bipuo *BazelInvocationProblemUpdateOne) AppendBepEvents(jm json.RawMessage
 	return bipuo
 }
 
+// SetFingerprint sets the "fingerprint" field.
+func (bipuo *BazelInvocationProblemUpdateOne) SetFingerprint(s string) *BazelInvocationProblemUpdateOne {
+	bipuo.mutation.SetFingerprint(s)
+	return bipuo
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (bipuo *BazelInvocationProblemUpdateOne) SetNillableFingerprint(s *string) *BazelInvocationProblemUpdateOne {
+	if s != nil {
+		bipuo.SetFingerprint(*s)
+	}
+	return bipuo
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (bipuo *BazelInvocationProblemUpdateOne) ClearFingerprint() *BazelInvocationProblemUpdateOne {
+	bipuo.mutation.ClearFingerprint()
+	return bipuo
+}
+
+// SetFailureClassification sets the "failure_classification" field.
+func (bipuo *BazelInvocationProblemUpdateOne) SetFailureClassification(bc bazelinvocationproblem.FailureClassification) *BazelInvocationProblemUpdateOne {
+	bipuo.mutation.SetFailureClassification(bc)
+	return bipuo
+}
+
+// SetNillableFailureClassification sets the "failure_classification" field if the given value is not nil.
+func (bipuo *BazelInvocationProblemUpdateOne) SetNillableFailureClassification(bc *bazelinvocationproblem.FailureClassification) *BazelInvocationProblemUpdateOne {
+	if bc != nil {
+		bipuo.SetFailureClassification(*bc)
+	}
+	return bipuo
+}
+
+// ClearFailureClassification clears the value of the "failure_classification" field.
+func (bipuo *BazelInvocationProblemUpdateOne) ClearFailureClassification() *BazelInvocationProblemUpdateOne {
+	bipuo.mutation.ClearFailureClassification()
+	return bipuo
+}
+
+// SetNewlyFailing sets the "newly_failing" field.
+func (bipuo *BazelInvocationProblemUpdateOne) SetNewlyFailing(b bool) *BazelInvocationProblemUpdateOne {
+	bipuo.mutation.SetNewlyFailing(b)
+	return bipuo
+}
+
+// SetNillableNewlyFailing sets the "newly_failing" field if the given value is not nil.
+func (bipuo *BazelInvocationProblemUpdateOne) SetNillableNewlyFailing(b *bool) *BazelInvocationProblemUpdateOne {
+	if b != nil {
+		bipuo.SetNewlyFailing(*b)
+	}
+	return bipuo
+}
+
+// ClearNewlyFailing clears the value of the "newly_failing" field.
+func (bipuo *BazelInvocationProblemUpdateOne) ClearNewlyFailing() *BazelInvocationProblemUpdateOne {
+	bipuo.mutation.ClearNewlyFailing()
+	return bipuo
+}
+
 // SetBazelInvocationID sets the "bazel_invocation" edge to the BazelInvocation entity by ID.
 func (bipuo *BazelInvocationProblemUpdateOne) SetBazelInvocationID(id int) *BazelInvocationProblemUpdateOne {
 	bipuo.mutation.SetBazelInvocationID(id)
@@ -258,6 +628,55 @@ func (bipuo *BazelInvocationProblemUpdateOne) SetBazelInvocation(b *BazelInvocat
 	return bipuo.SetBazelInvocationID(b.ID)
 }
 
+// SetKnownProblemID sets the "known_problem" edge to the KnownProblem entity by ID.
+func (bipuo *BazelInvocationProblemUpdateOne) SetKnownProblemID(id int) *BazelInvocationProblemUpdateOne {
+	bipuo.mutation.SetKnownProblemID(id)
+	return bipuo
+}
+
+// SetNillableKnownProblemID sets the "known_problem" edge to the KnownProblem entity by ID if the given value is not nil.
+func (bipuo *BazelInvocationProblemUpdateOne) SetNillableKnownProblemID(id *int) *BazelInvocationProblemUpdateOne {
+	if id != nil {
+		bipuo = bipuo.SetKnownProblemID(*id)
+	}
+	return bipuo
+}
+
+// SetKnownProblem sets the "known_problem" edge to the KnownProblem entity.
+func (bipuo *BazelInvocationProblemUpdateOne) SetKnownProblem(k *KnownProblem) *BazelInvocationProblemUpdateOne {
+	return bipuo.SetKnownProblemID(k.ID)
+}
+
+// AddBlobIDs adds the "blobs" edge to the Blob entity by IDs.
+func (bipuo *BazelInvocationProblemUpdateOne) AddBlobIDs(ids ...int) *BazelInvocationProblemUpdateOne {
+	bipuo.mutation.AddBlobIDs(ids...)
+	return bipuo
+}
+
+// AddBlobs adds the "blobs" edges to the Blob entity.
+func (bipuo *BazelInvocationProblemUpdateOne) AddBlobs(b ...*Blob) *BazelInvocationProblemUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bipuo.AddBlobIDs(ids...)
+}
+
+// AddSearchChunkIDs adds the "search_chunks" edge to the SearchChunk entity by IDs.
+func (bipuo *BazelInvocationProblemUpdateOne) AddSearchChunkIDs(ids ...int) *BazelInvocationProblemUpdateOne {
+	bipuo.mutation.AddSearchChunkIDs(ids...)
+	return bipuo
+}
+
+// AddSearchChunks adds the "search_chunks" edges to the SearchChunk entity.
+func (bipuo *BazelInvocationProblemUpdateOne) AddSearchChunks(s ...*SearchChunk) *BazelInvocationProblemUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bipuo.AddSearchChunkIDs(ids...)
+}
+
 // Mutation returns the BazelInvocationProblemMutation object of the builder.
 func (bipuo *BazelInvocationProblemUpdateOne) Mutation() *BazelInvocationProblemMutation {
 	return bipuo.mutation
@@ -269,6 +688,54 @@ func (bipuo *BazelInvocationProblemUpdateOne) ClearBazelInvocation() *BazelInvoc
 	return bipuo
 }
 
+// ClearKnownProblem clears the "known_problem" edge to the KnownProblem entity.
+func (bipuo *BazelInvocationProblemUpdateOne) ClearKnownProblem() *BazelInvocationProblemUpdateOne {
+	bipuo.mutation.ClearKnownProblem()
+	return bipuo
+}
+
+// ClearBlobs clears all "blobs" edges to the Blob entity.
+func (bipuo *BazelInvocationProblemUpdateOne) ClearBlobs() *BazelInvocationProblemUpdateOne {
+	bipuo.mutation.ClearBlobs()
+	return bipuo
+}
+
+// RemoveBlobIDs removes the "blobs" edge to Blob entities by IDs.
+func (bipuo *BazelInvocationProblemUpdateOne) RemoveBlobIDs(ids ...int) *BazelInvocationProblemUpdateOne {
+	bipuo.mutation.RemoveBlobIDs(ids...)
+	return bipuo
+}
+
+// RemoveBlobs removes "blobs" edges to Blob entities.
+func (bipuo *BazelInvocationProblemUpdateOne) RemoveBlobs(b ...*Blob) *BazelInvocationProblemUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bipuo.RemoveBlobIDs(ids...)
+}
+
+// ClearSearchChunks clears all "search_chunks" edges to the SearchChunk entity.
+func (bipuo *BazelInvocationProblemUpdateOne) ClearSearchChunks() *BazelInvocationProblemUpdateOne {
+	bipuo.mutation.ClearSearchChunks()
+	return bipuo
+}
+
+// RemoveSearchChunkIDs removes the "search_chunks" edge to SearchChunk entities by IDs.
+func (bipuo *BazelInvocationProblemUpdateOne) RemoveSearchChunkIDs(ids ...int) *BazelInvocationProblemUpdateOne {
+	bipuo.mutation.RemoveSearchChunkIDs(ids...)
+	return bipuo
+}
+
+// RemoveSearchChunks removes "search_chunks" edges to SearchChunk entities.
+func (bipuo *BazelInvocationProblemUpdateOne) RemoveSearchChunks(s ...*SearchChunk) *BazelInvocationProblemUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bipuo.RemoveSearchChunkIDs(ids...)
+}
+
 // Where appends a list predicates to the BazelInvocationProblemUpdate builder.
 func (bipuo *BazelInvocationProblemUpdateOne) Where(ps ...predicate.BazelInvocationProblem) *BazelInvocationProblemUpdateOne {
 	bipuo.mutation.Where(ps...)
@@ -309,7 +776,20 @@ func (bipuo *BazelInvocationProblemUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (bipuo *BazelInvocationProblemUpdateOne) check() error {
+	if v, ok := bipuo.mutation.FailureClassification(); ok {
+		if err := bazelinvocationproblem.FailureClassificationValidator(v); err != nil {
+			return &ValidationError{Name: "failure_classification", err: fmt.Errorf(`ent: validator failed for field "BazelInvocationProblem.failure_classification": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (bipuo *BazelInvocationProblemUpdateOne) sqlSave(ctx context.Context) (_node *BazelInvocationProblem, err error) {
+	if err := bipuo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(bazelinvocationproblem.Table, bazelinvocationproblem.Columns, sqlgraph.NewFieldSpec(bazelinvocationproblem.FieldID, field.TypeInt))
 	id, ok := bipuo.mutation.ID()
 	if !ok {
@@ -349,6 +829,24 @@ func (bipuo *BazelInvocationProblemUpdateOne) sqlSave(ctx context.Context) (_nod
 			sqljson.Append(u, bazelinvocationproblem.FieldBepEvents, value)
 		})
 	}
+	if value, ok := bipuo.mutation.Fingerprint(); ok {
+		_spec.SetField(bazelinvocationproblem.FieldFingerprint, field.TypeString, value)
+	}
+	if bipuo.mutation.FingerprintCleared() {
+		_spec.ClearField(bazelinvocationproblem.FieldFingerprint, field.TypeString)
+	}
+	if value, ok := bipuo.mutation.FailureClassification(); ok {
+		_spec.SetField(bazelinvocationproblem.FieldFailureClassification, field.TypeEnum, value)
+	}
+	if bipuo.mutation.FailureClassificationCleared() {
+		_spec.ClearField(bazelinvocationproblem.FieldFailureClassification, field.TypeEnum)
+	}
+	if value, ok := bipuo.mutation.NewlyFailing(); ok {
+		_spec.SetField(bazelinvocationproblem.FieldNewlyFailing, field.TypeBool, value)
+	}
+	if bipuo.mutation.NewlyFailingCleared() {
+		_spec.ClearField(bazelinvocationproblem.FieldNewlyFailing, field.TypeBool)
+	}
 	if bipuo.mutation.BazelInvocationCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -378,6 +876,125 @@ func (bipuo *BazelInvocationProblemUpdateOne) sqlSave(ctx context.Context) (_nod
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if bipuo.mutation.KnownProblemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bazelinvocationproblem.KnownProblemTable,
+			Columns: []string{bazelinvocationproblem.KnownProblemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knownproblem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bipuo.mutation.KnownProblemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bazelinvocationproblem.KnownProblemTable,
+			Columns: []string{bazelinvocationproblem.KnownProblemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(knownproblem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bipuo.mutation.BlobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   bazelinvocationproblem.BlobsTable,
+			Columns: bazelinvocationproblem.BlobsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blob.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bipuo.mutation.RemovedBlobsIDs(); len(nodes) > 0 && !bipuo.mutation.BlobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   bazelinvocationproblem.BlobsTable,
+			Columns: bazelinvocationproblem.BlobsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bipuo.mutation.BlobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   bazelinvocationproblem.BlobsTable,
+			Columns: bazelinvocationproblem.BlobsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bipuo.mutation.SearchChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bazelinvocationproblem.SearchChunksTable,
+			Columns: []string{bazelinvocationproblem.SearchChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(searchchunk.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bipuo.mutation.RemovedSearchChunksIDs(); len(nodes) > 0 && !bipuo.mutation.SearchChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bazelinvocationproblem.SearchChunksTable,
+			Columns: []string{bazelinvocationproblem.SearchChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(searchchunk.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bipuo.mutation.SearchChunksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bazelinvocationproblem.SearchChunksTable,
+			Columns: []string{bazelinvocationproblem.SearchChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(searchchunk.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &BazelInvocationProblem{config: bipuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
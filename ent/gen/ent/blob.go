@@ -18,6 +18,8 @@ type Blob struct {
 	ID int `json:"id,omitempty"`
 	// URI holds the value of the "uri" field.
 	URI string `json:"uri,omitempty"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key,omitempty"`
 	// SizeBytes holds the value of the "size_bytes" field.
 	SizeBytes int64 `json:"size_bytes,omitempty"`
 	// ArchivingStatus holds the value of the "archiving_status" field.
@@ -25,10 +27,45 @@ type Blob struct {
 	// Reason holds the value of the "reason" field.
 	Reason string `json:"reason,omitempty"`
 	// ArchiveURL holds the value of the "archive_url" field.
-	ArchiveURL   string `json:"archive_url,omitempty"`
+	ArchiveURL string `json:"archive_url,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BlobQuery when eager-loading is set.
+	Edges        BlobEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// BlobEdges holds the relations/edges for other nodes in the graph.
+type BlobEdges struct {
+	// Problems holds the value of the problems edge.
+	Problems []*BazelInvocationProblem `json:"problems,omitempty"`
+	// SearchChunks holds the value of the search_chunks edge.
+	SearchChunks []*SearchChunk `json:"search_chunks,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+
+	namedProblems     map[string][]*BazelInvocationProblem
+	namedSearchChunks map[string][]*SearchChunk
+}
+
+// ProblemsOrErr returns the Problems value or an error if the edge
+// was not loaded in eager-loading.
+func (e BlobEdges) ProblemsOrErr() ([]*BazelInvocationProblem, error) {
+	if e.loadedTypes[0] {
+		return e.Problems, nil
+	}
+	return nil, &NotLoadedError{edge: "problems"}
+}
+
+// SearchChunksOrErr returns the SearchChunks value or an error if the edge
+// was not loaded in eager-loading.
+func (e BlobEdges) SearchChunksOrErr() ([]*SearchChunk, error) {
+	if e.loadedTypes[1] {
+		return e.SearchChunks, nil
+	}
+	return nil, &NotLoadedError{edge: "search_chunks"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Blob) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -36,7 +73,7 @@ func (*Blob) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case blob.FieldID, blob.FieldSizeBytes:
 			values[i] = new(sql.NullInt64)
-		case blob.FieldURI, blob.FieldArchivingStatus, blob.FieldReason, blob.FieldArchiveURL:
+		case blob.FieldURI, blob.FieldKey, blob.FieldArchivingStatus, blob.FieldReason, blob.FieldArchiveURL:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -65,6 +102,12 @@ func (b *Blob) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				b.URI = value.String
 			}
+		case blob.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				b.Key = value.String
+			}
 		case blob.FieldSizeBytes:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field size_bytes", values[i])
@@ -102,6 +145,16 @@ func (b *Blob) Value(name string) (ent.Value, error) {
 	return b.selectValues.Get(name)
 }
 
+// QueryProblems queries the "problems" edge of the Blob entity.
+func (b *Blob) QueryProblems() *BazelInvocationProblemQuery {
+	return NewBlobClient(b.config).QueryProblems(b)
+}
+
+// QuerySearchChunks queries the "search_chunks" edge of the Blob entity.
+func (b *Blob) QuerySearchChunks() *SearchChunkQuery {
+	return NewBlobClient(b.config).QuerySearchChunks(b)
+}
+
 // Update returns a builder for updating this Blob.
 // Note that you need to call Blob.Unwrap() before calling this method if this Blob
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -128,6 +181,9 @@ func (b *Blob) String() string {
 	builder.WriteString("uri=")
 	builder.WriteString(b.URI)
 	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(b.Key)
+	builder.WriteString(", ")
 	builder.WriteString("size_bytes=")
 	builder.WriteString(fmt.Sprintf("%v", b.SizeBytes))
 	builder.WriteString(", ")
@@ -143,5 +199,53 @@ func (b *Blob) String() string {
 	return builder.String()
 }
 
+// NamedProblems returns the Problems named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (b *Blob) NamedProblems(name string) ([]*BazelInvocationProblem, error) {
+	if b.Edges.namedProblems == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := b.Edges.namedProblems[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (b *Blob) appendNamedProblems(name string, edges ...*BazelInvocationProblem) {
+	if b.Edges.namedProblems == nil {
+		b.Edges.namedProblems = make(map[string][]*BazelInvocationProblem)
+	}
+	if len(edges) == 0 {
+		b.Edges.namedProblems[name] = []*BazelInvocationProblem{}
+	} else {
+		b.Edges.namedProblems[name] = append(b.Edges.namedProblems[name], edges...)
+	}
+}
+
+// NamedSearchChunks returns the SearchChunks named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (b *Blob) NamedSearchChunks(name string) ([]*SearchChunk, error) {
+	if b.Edges.namedSearchChunks == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := b.Edges.namedSearchChunks[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (b *Blob) appendNamedSearchChunks(name string, edges ...*SearchChunk) {
+	if b.Edges.namedSearchChunks == nil {
+		b.Edges.namedSearchChunks = make(map[string][]*SearchChunk)
+	}
+	if len(edges) == 0 {
+		b.Edges.namedSearchChunks[name] = []*SearchChunk{}
+	} else {
+		b.Edges.namedSearchChunks[name] = append(b.Edges.namedSearchChunks[name], edges...)
+	}
+}
+
 // Blobs is a parsable slice of Blob.
 type Blobs []*Blob
@@ -8,6 +8,7 @@ import (
 	"strconv"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -17,6 +18,8 @@ const (
 	FieldID = "id"
 	// FieldURI holds the string denoting the uri field in the database.
 	FieldURI = "uri"
+	// FieldKey holds the string denoting the key field in the database.
+	FieldKey = "key"
 	// FieldSizeBytes holds the string denoting the size_bytes field in the database.
 	FieldSizeBytes = "size_bytes"
 	// FieldArchivingStatus holds the string denoting the archiving_status field in the database.
@@ -25,20 +28,43 @@ const (
 	FieldReason = "reason"
 	// FieldArchiveURL holds the string denoting the archive_url field in the database.
 	FieldArchiveURL = "archive_url"
+	// EdgeProblems holds the string denoting the problems edge name in mutations.
+	EdgeProblems = "problems"
+	// EdgeSearchChunks holds the string denoting the search_chunks edge name in mutations.
+	EdgeSearchChunks = "search_chunks"
 	// Table holds the table name of the blob in the database.
 	Table = "blobs"
+	// ProblemsTable is the table that holds the problems relation/edge. The primary key declared below.
+	ProblemsTable = "bazel_invocation_problem_blobs"
+	// ProblemsInverseTable is the table name for the BazelInvocationProblem entity.
+	// It exists in this package in order to avoid circular dependency with the "bazelinvocationproblem" package.
+	ProblemsInverseTable = "bazel_invocation_problems"
+	// SearchChunksTable is the table that holds the search_chunks relation/edge.
+	SearchChunksTable = "search_chunks"
+	// SearchChunksInverseTable is the table name for the SearchChunk entity.
+	// It exists in this package in order to avoid circular dependency with the "searchchunk" package.
+	SearchChunksInverseTable = "search_chunks"
+	// SearchChunksColumn is the table column denoting the search_chunks relation/edge.
+	SearchChunksColumn = "blob_search_chunks"
 )
 
 // Columns holds all SQL columns for blob fields.
 var Columns = []string{
 	FieldID,
 	FieldURI,
+	FieldKey,
 	FieldSizeBytes,
 	FieldArchivingStatus,
 	FieldReason,
 	FieldArchiveURL,
 }
 
+var (
+	// ProblemsPrimaryKey and ProblemsColumn2 are the table columns denoting the
+	// primary key for the problems relation (M2M).
+	ProblemsPrimaryKey = []string{"bazel_invocation_problem_id", "blob_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -90,6 +116,11 @@ func ByURI(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldURI, opts...).ToFunc()
 }
 
+// ByKey orders the results by the key field.
+func ByKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKey, opts...).ToFunc()
+}
+
 // BySizeBytes orders the results by the size_bytes field.
 func BySizeBytes(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSizeBytes, opts...).ToFunc()
@@ -110,6 +141,48 @@ func ByArchiveURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldArchiveURL, opts...).ToFunc()
 }
 
+// ByProblemsCount orders the results by problems count.
+func ByProblemsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newProblemsStep(), opts...)
+	}
+}
+
+// ByProblems orders the results by problems terms.
+func ByProblems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProblemsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySearchChunksCount orders the results by search_chunks count.
+func BySearchChunksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSearchChunksStep(), opts...)
+	}
+}
+
+// BySearchChunks orders the results by search_chunks terms.
+func BySearchChunks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSearchChunksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newProblemsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProblemsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, ProblemsTable, ProblemsPrimaryKey...),
+	)
+}
+func newSearchChunksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SearchChunksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SearchChunksTable, SearchChunksColumn),
+	)
+}
+
 // MarshalGQL implements graphql.Marshaler interface.
 func (e ArchivingStatus) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(e.String()))
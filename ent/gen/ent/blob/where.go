@@ -4,6 +4,7 @@ package blob
 
 import (
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/predicate"
 )
 
@@ -57,6 +58,11 @@ func URI(v string) predicate.Blob {
 	return predicate.Blob(sql.FieldEQ(FieldURI, v))
 }
 
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldKey, v))
+}
+
 // SizeBytes applies equality check predicate on the "size_bytes" field. It's identical to SizeBytesEQ.
 func SizeBytes(v int64) predicate.Blob {
 	return predicate.Blob(sql.FieldEQ(FieldSizeBytes, v))
@@ -137,6 +143,81 @@ func URIContainsFold(v string) predicate.Blob {
 	return predicate.Blob(sql.FieldContainsFold(FieldURI, v))
 }
 
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.Blob {
+	return predicate.Blob(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.Blob {
+	return predicate.Blob(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyIsNil applies the IsNil predicate on the "key" field.
+func KeyIsNil() predicate.Blob {
+	return predicate.Blob(sql.FieldIsNull(FieldKey))
+}
+
+// KeyNotNil applies the NotNil predicate on the "key" field.
+func KeyNotNil() predicate.Blob {
+	return predicate.Blob(sql.FieldNotNull(FieldKey))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.Blob {
+	return predicate.Blob(sql.FieldContainsFold(FieldKey, v))
+}
+
 // SizeBytesEQ applies the EQ predicate on the "size_bytes" field.
 func SizeBytesEQ(v int64) predicate.Blob {
 	return predicate.Blob(sql.FieldEQ(FieldSizeBytes, v))
@@ -357,6 +438,52 @@ func ArchiveURLContainsFold(v string) predicate.Blob {
 	return predicate.Blob(sql.FieldContainsFold(FieldArchiveURL, v))
 }
 
+// HasProblems applies the HasEdge predicate on the "problems" edge.
+func HasProblems() predicate.Blob {
+	return predicate.Blob(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, ProblemsTable, ProblemsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProblemsWith applies the HasEdge predicate on the "problems" edge with a given conditions (other predicates).
+func HasProblemsWith(preds ...predicate.BazelInvocationProblem) predicate.Blob {
+	return predicate.Blob(func(s *sql.Selector) {
+		step := newProblemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSearchChunks applies the HasEdge predicate on the "search_chunks" edge.
+func HasSearchChunks() predicate.Blob {
+	return predicate.Blob(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SearchChunksTable, SearchChunksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSearchChunksWith applies the HasEdge predicate on the "search_chunks" edge with a given conditions (other predicates).
+func HasSearchChunksWith(preds ...predicate.SearchChunk) predicate.Blob {
+	return predicate.Blob(func(s *sql.Selector) {
+		step := newSearchChunksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Blob) predicate.Blob {
 	return predicate.Blob(sql.AndPredicates(predicates...))
@@ -7,9 +7,12 @@ import (
 	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocationproblem"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/searchchunk"
 )
 
 // BlobCreate is the builder for creating a Blob entity.
@@ -17,6 +20,7 @@ type BlobCreate struct {
 	config
 	mutation *BlobMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetURI sets the "uri" field.
@@ -25,6 +29,20 @@ func (bc *BlobCreate) SetURI(s string) *BlobCreate {
 	return bc
 }
 
+// SetKey sets the "key" field.
+func (bc *BlobCreate) SetKey(s string) *BlobCreate {
+	bc.mutation.SetKey(s)
+	return bc
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (bc *BlobCreate) SetNillableKey(s *string) *BlobCreate {
+	if s != nil {
+		bc.SetKey(*s)
+	}
+	return bc
+}
+
 // SetSizeBytes sets the "size_bytes" field.
 func (bc *BlobCreate) SetSizeBytes(i int64) *BlobCreate {
 	bc.mutation.SetSizeBytes(i)
@@ -81,6 +99,36 @@ func (bc *BlobCreate) SetNillableArchiveURL(s *string) *BlobCreate {
 	return bc
 }
 
+// AddProblemIDs adds the "problems" edge to the BazelInvocationProblem entity by IDs.
+func (bc *BlobCreate) AddProblemIDs(ids ...int) *BlobCreate {
+	bc.mutation.AddProblemIDs(ids...)
+	return bc
+}
+
+// AddProblems adds the "problems" edges to the BazelInvocationProblem entity.
+func (bc *BlobCreate) AddProblems(b ...*BazelInvocationProblem) *BlobCreate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bc.AddProblemIDs(ids...)
+}
+
+// AddSearchChunkIDs adds the "search_chunks" edge to the SearchChunk entity by IDs.
+func (bc *BlobCreate) AddSearchChunkIDs(ids ...int) *BlobCreate {
+	bc.mutation.AddSearchChunkIDs(ids...)
+	return bc
+}
+
+// AddSearchChunks adds the "search_chunks" edges to the SearchChunk entity.
+func (bc *BlobCreate) AddSearchChunks(s ...*SearchChunk) *BlobCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bc.AddSearchChunkIDs(ids...)
+}
+
 // Mutation returns the BlobMutation object of the builder.
 func (bc *BlobCreate) Mutation() *BlobMutation {
 	return bc.mutation
@@ -161,10 +209,15 @@ func (bc *BlobCreate) createSpec() (*Blob, *sqlgraph.CreateSpec) {
 		_node = &Blob{config: bc.config}
 		_spec = sqlgraph.NewCreateSpec(blob.Table, sqlgraph.NewFieldSpec(blob.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = bc.conflict
 	if value, ok := bc.mutation.URI(); ok {
 		_spec.SetField(blob.FieldURI, field.TypeString, value)
 		_node.URI = value
 	}
+	if value, ok := bc.mutation.Key(); ok {
+		_spec.SetField(blob.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
 	if value, ok := bc.mutation.SizeBytes(); ok {
 		_spec.SetField(blob.FieldSizeBytes, field.TypeInt64, value)
 		_node.SizeBytes = value
@@ -181,14 +234,333 @@ func (bc *BlobCreate) createSpec() (*Blob, *sqlgraph.CreateSpec) {
 		_spec.SetField(blob.FieldArchiveURL, field.TypeString, value)
 		_node.ArchiveURL = value
 	}
+	if nodes := bc.mutation.ProblemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   blob.ProblemsTable,
+			Columns: blob.ProblemsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocationproblem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.SearchChunksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blob.SearchChunksTable,
+			Columns: []string{blob.SearchChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(searchchunk.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Blob.Create().
+//		SetURI(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BlobUpsert) {
+//			SetURI(v+v).
+//		}).
+//		Exec(ctx)
+func (bc *BlobCreate) OnConflict(opts ...sql.ConflictOption) *BlobUpsertOne {
+	bc.conflict = opts
+	return &BlobUpsertOne{
+		create: bc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Blob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bc *BlobCreate) OnConflictColumns(columns ...string) *BlobUpsertOne {
+	bc.conflict = append(bc.conflict, sql.ConflictColumns(columns...))
+	return &BlobUpsertOne{
+		create: bc,
+	}
+}
+
+type (
+	// BlobUpsertOne is the builder for "upsert"-ing
+	//  one Blob node.
+	BlobUpsertOne struct {
+		create *BlobCreate
+	}
+
+	// BlobUpsert is the "OnConflict" setter.
+	BlobUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetSizeBytes sets the "size_bytes" field.
+func (u *BlobUpsert) SetSizeBytes(v int64) *BlobUpsert {
+	u.Set(blob.FieldSizeBytes, v)
+	return u
+}
+
+// UpdateSizeBytes sets the "size_bytes" field to the value that was provided on create.
+func (u *BlobUpsert) UpdateSizeBytes() *BlobUpsert {
+	u.SetExcluded(blob.FieldSizeBytes)
+	return u
+}
+
+// AddSizeBytes adds v to the "size_bytes" field.
+func (u *BlobUpsert) AddSizeBytes(v int64) *BlobUpsert {
+	u.Add(blob.FieldSizeBytes, v)
+	return u
+}
+
+// ClearSizeBytes clears the value of the "size_bytes" field.
+func (u *BlobUpsert) ClearSizeBytes() *BlobUpsert {
+	u.SetNull(blob.FieldSizeBytes)
+	return u
+}
+
+// SetArchivingStatus sets the "archiving_status" field.
+func (u *BlobUpsert) SetArchivingStatus(v blob.ArchivingStatus) *BlobUpsert {
+	u.Set(blob.FieldArchivingStatus, v)
+	return u
+}
+
+// UpdateArchivingStatus sets the "archiving_status" field to the value that was provided on create.
+func (u *BlobUpsert) UpdateArchivingStatus() *BlobUpsert {
+	u.SetExcluded(blob.FieldArchivingStatus)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *BlobUpsert) SetReason(v string) *BlobUpsert {
+	u.Set(blob.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *BlobUpsert) UpdateReason() *BlobUpsert {
+	u.SetExcluded(blob.FieldReason)
+	return u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *BlobUpsert) ClearReason() *BlobUpsert {
+	u.SetNull(blob.FieldReason)
+	return u
+}
+
+// SetArchiveURL sets the "archive_url" field.
+func (u *BlobUpsert) SetArchiveURL(v string) *BlobUpsert {
+	u.Set(blob.FieldArchiveURL, v)
+	return u
+}
+
+// UpdateArchiveURL sets the "archive_url" field to the value that was provided on create.
+func (u *BlobUpsert) UpdateArchiveURL() *BlobUpsert {
+	u.SetExcluded(blob.FieldArchiveURL)
+	return u
+}
+
+// ClearArchiveURL clears the value of the "archive_url" field.
+func (u *BlobUpsert) ClearArchiveURL() *BlobUpsert {
+	u.SetNull(blob.FieldArchiveURL)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Blob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BlobUpsertOne) UpdateNewValues() *BlobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.URI(); exists {
+			s.SetIgnore(blob.FieldURI)
+		}
+		if _, exists := u.create.mutation.Key(); exists {
+			s.SetIgnore(blob.FieldKey)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Blob.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BlobUpsertOne) Ignore() *BlobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BlobUpsertOne) DoNothing() *BlobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BlobCreate.OnConflict
+// documentation for more info.
+func (u *BlobUpsertOne) Update(set func(*BlobUpsert)) *BlobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BlobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (u *BlobUpsertOne) SetSizeBytes(v int64) *BlobUpsertOne {
+	return u.Update(func(s *BlobUpsert) {
+		s.SetSizeBytes(v)
+	})
+}
+
+// AddSizeBytes adds v to the "size_bytes" field.
+func (u *BlobUpsertOne) AddSizeBytes(v int64) *BlobUpsertOne {
+	return u.Update(func(s *BlobUpsert) {
+		s.AddSizeBytes(v)
+	})
+}
+
+// UpdateSizeBytes sets the "size_bytes" field to the value that was provided on create.
+func (u *BlobUpsertOne) UpdateSizeBytes() *BlobUpsertOne {
+	return u.Update(func(s *BlobUpsert) {
+		s.UpdateSizeBytes()
+	})
+}
+
+// ClearSizeBytes clears the value of the "size_bytes" field.
+func (u *BlobUpsertOne) ClearSizeBytes() *BlobUpsertOne {
+	return u.Update(func(s *BlobUpsert) {
+		s.ClearSizeBytes()
+	})
+}
+
+// SetArchivingStatus sets the "archiving_status" field.
+func (u *BlobUpsertOne) SetArchivingStatus(v blob.ArchivingStatus) *BlobUpsertOne {
+	return u.Update(func(s *BlobUpsert) {
+		s.SetArchivingStatus(v)
+	})
+}
+
+// UpdateArchivingStatus sets the "archiving_status" field to the value that was provided on create.
+func (u *BlobUpsertOne) UpdateArchivingStatus() *BlobUpsertOne {
+	return u.Update(func(s *BlobUpsert) {
+		s.UpdateArchivingStatus()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *BlobUpsertOne) SetReason(v string) *BlobUpsertOne {
+	return u.Update(func(s *BlobUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *BlobUpsertOne) UpdateReason() *BlobUpsertOne {
+	return u.Update(func(s *BlobUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *BlobUpsertOne) ClearReason() *BlobUpsertOne {
+	return u.Update(func(s *BlobUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetArchiveURL sets the "archive_url" field.
+func (u *BlobUpsertOne) SetArchiveURL(v string) *BlobUpsertOne {
+	return u.Update(func(s *BlobUpsert) {
+		s.SetArchiveURL(v)
+	})
+}
+
+// UpdateArchiveURL sets the "archive_url" field to the value that was provided on create.
+func (u *BlobUpsertOne) UpdateArchiveURL() *BlobUpsertOne {
+	return u.Update(func(s *BlobUpsert) {
+		s.UpdateArchiveURL()
+	})
+}
+
+// ClearArchiveURL clears the value of the "archive_url" field.
+func (u *BlobUpsertOne) ClearArchiveURL() *BlobUpsertOne {
+	return u.Update(func(s *BlobUpsert) {
+		s.ClearArchiveURL()
+	})
+}
+
+// Exec executes the query.
+func (u *BlobUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BlobCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BlobUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BlobUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BlobUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // BlobCreateBulk is the builder for creating many Blob entities in bulk.
 type BlobCreateBulk struct {
 	config
 	err      error
 	builders []*BlobCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Blob entities in the database.
@@ -218,6 +590,7 @@ func (bcb *BlobCreateBulk) Save(ctx context.Context) ([]*Blob, error) {
 					_, err = mutators[i+1].Mutate(root, bcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = bcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, bcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -271,3 +644,201 @@ func (bcb *BlobCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Blob.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BlobUpsert) {
+//			SetURI(v+v).
+//		}).
+//		Exec(ctx)
+func (bcb *BlobCreateBulk) OnConflict(opts ...sql.ConflictOption) *BlobUpsertBulk {
+	bcb.conflict = opts
+	return &BlobUpsertBulk{
+		create: bcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Blob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bcb *BlobCreateBulk) OnConflictColumns(columns ...string) *BlobUpsertBulk {
+	bcb.conflict = append(bcb.conflict, sql.ConflictColumns(columns...))
+	return &BlobUpsertBulk{
+		create: bcb,
+	}
+}
+
+// BlobUpsertBulk is the builder for "upsert"-ing
+// a bulk of Blob nodes.
+type BlobUpsertBulk struct {
+	create *BlobCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Blob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BlobUpsertBulk) UpdateNewValues() *BlobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.URI(); exists {
+				s.SetIgnore(blob.FieldURI)
+			}
+			if _, exists := b.mutation.Key(); exists {
+				s.SetIgnore(blob.FieldKey)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Blob.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BlobUpsertBulk) Ignore() *BlobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BlobUpsertBulk) DoNothing() *BlobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BlobCreateBulk.OnConflict
+// documentation for more info.
+func (u *BlobUpsertBulk) Update(set func(*BlobUpsert)) *BlobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BlobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSizeBytes sets the "size_bytes" field.
+func (u *BlobUpsertBulk) SetSizeBytes(v int64) *BlobUpsertBulk {
+	return u.Update(func(s *BlobUpsert) {
+		s.SetSizeBytes(v)
+	})
+}
+
+// AddSizeBytes adds v to the "size_bytes" field.
+func (u *BlobUpsertBulk) AddSizeBytes(v int64) *BlobUpsertBulk {
+	return u.Update(func(s *BlobUpsert) {
+		s.AddSizeBytes(v)
+	})
+}
+
+// UpdateSizeBytes sets the "size_bytes" field to the value that was provided on create.
+func (u *BlobUpsertBulk) UpdateSizeBytes() *BlobUpsertBulk {
+	return u.Update(func(s *BlobUpsert) {
+		s.UpdateSizeBytes()
+	})
+}
+
+// ClearSizeBytes clears the value of the "size_bytes" field.
+func (u *BlobUpsertBulk) ClearSizeBytes() *BlobUpsertBulk {
+	return u.Update(func(s *BlobUpsert) {
+		s.ClearSizeBytes()
+	})
+}
+
+// SetArchivingStatus sets the "archiving_status" field.
+func (u *BlobUpsertBulk) SetArchivingStatus(v blob.ArchivingStatus) *BlobUpsertBulk {
+	return u.Update(func(s *BlobUpsert) {
+		s.SetArchivingStatus(v)
+	})
+}
+
+// UpdateArchivingStatus sets the "archiving_status" field to the value that was provided on create.
+func (u *BlobUpsertBulk) UpdateArchivingStatus() *BlobUpsertBulk {
+	return u.Update(func(s *BlobUpsert) {
+		s.UpdateArchivingStatus()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *BlobUpsertBulk) SetReason(v string) *BlobUpsertBulk {
+	return u.Update(func(s *BlobUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *BlobUpsertBulk) UpdateReason() *BlobUpsertBulk {
+	return u.Update(func(s *BlobUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *BlobUpsertBulk) ClearReason() *BlobUpsertBulk {
+	return u.Update(func(s *BlobUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetArchiveURL sets the "archive_url" field.
+func (u *BlobUpsertBulk) SetArchiveURL(v string) *BlobUpsertBulk {
+	return u.Update(func(s *BlobUpsert) {
+		s.SetArchiveURL(v)
+	})
+}
+
+// UpdateArchiveURL sets the "archive_url" field to the value that was provided on create.
+func (u *BlobUpsertBulk) UpdateArchiveURL() *BlobUpsertBulk {
+	return u.Update(func(s *BlobUpsert) {
+		s.UpdateArchiveURL()
+	})
+}
+
+// ClearArchiveURL clears the value of the "archive_url" field.
+func (u *BlobUpsertBulk) ClearArchiveURL() *BlobUpsertBulk {
+	return u.Update(func(s *BlobUpsert) {
+		s.ClearArchiveURL()
+	})
+}
+
+// Exec executes the query.
+func (u *BlobUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BlobCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BlobCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BlobUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -4,25 +4,32 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocationproblem"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/predicate"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/searchchunk"
 )
 
 // BlobQuery is the builder for querying Blob entities.
 type BlobQuery struct {
 	config
-	ctx        *QueryContext
-	order      []blob.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Blob
-	modifiers  []func(*sql.Selector)
-	loadTotal  []func(context.Context, []*Blob) error
+	ctx                   *QueryContext
+	order                 []blob.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.Blob
+	withProblems          *BazelInvocationProblemQuery
+	withSearchChunks      *SearchChunkQuery
+	modifiers             []func(*sql.Selector)
+	loadTotal             []func(context.Context, []*Blob) error
+	withNamedProblems     map[string]*BazelInvocationProblemQuery
+	withNamedSearchChunks map[string]*SearchChunkQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -59,6 +66,50 @@ func (bq *BlobQuery) Order(o ...blob.OrderOption) *BlobQuery {
 	return bq
 }
 
+// QueryProblems chains the current query on the "problems" edge.
+func (bq *BlobQuery) QueryProblems() *BazelInvocationProblemQuery {
+	query := (&BazelInvocationProblemClient{config: bq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blob.Table, blob.FieldID, selector),
+			sqlgraph.To(bazelinvocationproblem.Table, bazelinvocationproblem.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, blob.ProblemsTable, blob.ProblemsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySearchChunks chains the current query on the "search_chunks" edge.
+func (bq *BlobQuery) QuerySearchChunks() *SearchChunkQuery {
+	query := (&SearchChunkClient{config: bq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blob.Table, blob.FieldID, selector),
+			sqlgraph.To(searchchunk.Table, searchchunk.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, blob.SearchChunksTable, blob.SearchChunksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Blob entity from the query.
 // Returns a *NotFoundError when no Blob was found.
 func (bq *BlobQuery) First(ctx context.Context) (*Blob, error) {
@@ -246,17 +297,41 @@ func (bq *BlobQuery) Clone() *BlobQuery {
 		return nil
 	}
 	return &BlobQuery{
-		config:     bq.config,
-		ctx:        bq.ctx.Clone(),
-		order:      append([]blob.OrderOption{}, bq.order...),
-		inters:     append([]Interceptor{}, bq.inters...),
-		predicates: append([]predicate.Blob{}, bq.predicates...),
+		config:           bq.config,
+		ctx:              bq.ctx.Clone(),
+		order:            append([]blob.OrderOption{}, bq.order...),
+		inters:           append([]Interceptor{}, bq.inters...),
+		predicates:       append([]predicate.Blob{}, bq.predicates...),
+		withProblems:     bq.withProblems.Clone(),
+		withSearchChunks: bq.withSearchChunks.Clone(),
 		// clone intermediate query.
 		sql:  bq.sql.Clone(),
 		path: bq.path,
 	}
 }
 
+// WithProblems tells the query-builder to eager-load the nodes that are connected to
+// the "problems" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BlobQuery) WithProblems(opts ...func(*BazelInvocationProblemQuery)) *BlobQuery {
+	query := (&BazelInvocationProblemClient{config: bq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withProblems = query
+	return bq
+}
+
+// WithSearchChunks tells the query-builder to eager-load the nodes that are connected to
+// the "search_chunks" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BlobQuery) WithSearchChunks(opts ...func(*SearchChunkQuery)) *BlobQuery {
+	query := (&SearchChunkClient{config: bq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withSearchChunks = query
+	return bq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -333,8 +408,12 @@ func (bq *BlobQuery) prepareQuery(ctx context.Context) error {
 
 func (bq *BlobQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Blob, error) {
 	var (
-		nodes = []*Blob{}
-		_spec = bq.querySpec()
+		nodes       = []*Blob{}
+		_spec       = bq.querySpec()
+		loadedTypes = [2]bool{
+			bq.withProblems != nil,
+			bq.withSearchChunks != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Blob).scanValues(nil, columns)
@@ -342,6 +421,7 @@ func (bq *BlobQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Blob, e
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &Blob{config: bq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	if len(bq.modifiers) > 0 {
@@ -356,6 +436,34 @@ func (bq *BlobQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Blob, e
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := bq.withProblems; query != nil {
+		if err := bq.loadProblems(ctx, query, nodes,
+			func(n *Blob) { n.Edges.Problems = []*BazelInvocationProblem{} },
+			func(n *Blob, e *BazelInvocationProblem) { n.Edges.Problems = append(n.Edges.Problems, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := bq.withSearchChunks; query != nil {
+		if err := bq.loadSearchChunks(ctx, query, nodes,
+			func(n *Blob) { n.Edges.SearchChunks = []*SearchChunk{} },
+			func(n *Blob, e *SearchChunk) { n.Edges.SearchChunks = append(n.Edges.SearchChunks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range bq.withNamedProblems {
+		if err := bq.loadProblems(ctx, query, nodes,
+			func(n *Blob) { n.appendNamedProblems(name) },
+			func(n *Blob, e *BazelInvocationProblem) { n.appendNamedProblems(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range bq.withNamedSearchChunks {
+		if err := bq.loadSearchChunks(ctx, query, nodes,
+			func(n *Blob) { n.appendNamedSearchChunks(name) },
+			func(n *Blob, e *SearchChunk) { n.appendNamedSearchChunks(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for i := range bq.loadTotal {
 		if err := bq.loadTotal[i](ctx, nodes); err != nil {
 			return nil, err
@@ -364,6 +472,99 @@ func (bq *BlobQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Blob, e
 	return nodes, nil
 }
 
+func (bq *BlobQuery) loadProblems(ctx context.Context, query *BazelInvocationProblemQuery, nodes []*Blob, init func(*Blob), assign func(*Blob, *BazelInvocationProblem)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Blob)
+	nids := make(map[int]map[*Blob]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(blob.ProblemsTable)
+		s.Join(joinT).On(s.C(bazelinvocationproblem.FieldID), joinT.C(blob.ProblemsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(blob.ProblemsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(blob.ProblemsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Blob]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*BazelInvocationProblem](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "problems" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (bq *BlobQuery) loadSearchChunks(ctx context.Context, query *SearchChunkQuery, nodes []*Blob, init func(*Blob), assign func(*Blob, *SearchChunk)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Blob)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SearchChunk(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(blob.SearchChunksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.blob_search_chunks
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "blob_search_chunks" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "blob_search_chunks" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (bq *BlobQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := bq.querySpec()
 	if len(bq.modifiers) > 0 {
@@ -448,6 +649,34 @@ func (bq *BlobQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// WithNamedProblems tells the query-builder to eager-load the nodes that are connected to the "problems"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (bq *BlobQuery) WithNamedProblems(name string, opts ...func(*BazelInvocationProblemQuery)) *BlobQuery {
+	query := (&BazelInvocationProblemClient{config: bq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if bq.withNamedProblems == nil {
+		bq.withNamedProblems = make(map[string]*BazelInvocationProblemQuery)
+	}
+	bq.withNamedProblems[name] = query
+	return bq
+}
+
+// WithNamedSearchChunks tells the query-builder to eager-load the nodes that are connected to the "search_chunks"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (bq *BlobQuery) WithNamedSearchChunks(name string, opts ...func(*SearchChunkQuery)) *BlobQuery {
+	query := (&SearchChunkClient{config: bq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if bq.withNamedSearchChunks == nil {
+		bq.withNamedSearchChunks = make(map[string]*SearchChunkQuery)
+	}
+	bq.withNamedSearchChunks[name] = query
+	return bq
+}
+
 // BlobGroupBy is the group-by builder for Blob entities.
 type BlobGroupBy struct {
 	selector
@@ -10,8 +10,10 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocationproblem"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/predicate"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/searchchunk"
 )
 
 // BlobUpdate is the builder for updating Blob entities.
@@ -108,11 +110,83 @@ func (bu *BlobUpdate) ClearArchiveURL() *BlobUpdate {
 	return bu
 }
 
+// AddProblemIDs adds the "problems" edge to the BazelInvocationProblem entity by IDs.
+func (bu *BlobUpdate) AddProblemIDs(ids ...int) *BlobUpdate {
+	bu.mutation.AddProblemIDs(ids...)
+	return bu
+}
+
+// AddProblems adds the "problems" edges to the BazelInvocationProblem entity.
+func (bu *BlobUpdate) AddProblems(b ...*BazelInvocationProblem) *BlobUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bu.AddProblemIDs(ids...)
+}
+
+// AddSearchChunkIDs adds the "search_chunks" edge to the SearchChunk entity by IDs.
+func (bu *BlobUpdate) AddSearchChunkIDs(ids ...int) *BlobUpdate {
+	bu.mutation.AddSearchChunkIDs(ids...)
+	return bu
+}
+
+// AddSearchChunks adds the "search_chunks" edges to the SearchChunk entity.
+func (bu *BlobUpdate) AddSearchChunks(s ...*SearchChunk) *BlobUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bu.AddSearchChunkIDs(ids...)
+}
+
 // Mutation returns the BlobMutation object of the builder.
 func (bu *BlobUpdate) Mutation() *BlobMutation {
 	return bu.mutation
 }
 
+// ClearProblems clears all "problems" edges to the BazelInvocationProblem entity.
+func (bu *BlobUpdate) ClearProblems() *BlobUpdate {
+	bu.mutation.ClearProblems()
+	return bu
+}
+
+// RemoveProblemIDs removes the "problems" edge to BazelInvocationProblem entities by IDs.
+func (bu *BlobUpdate) RemoveProblemIDs(ids ...int) *BlobUpdate {
+	bu.mutation.RemoveProblemIDs(ids...)
+	return bu
+}
+
+// RemoveProblems removes "problems" edges to BazelInvocationProblem entities.
+func (bu *BlobUpdate) RemoveProblems(b ...*BazelInvocationProblem) *BlobUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bu.RemoveProblemIDs(ids...)
+}
+
+// ClearSearchChunks clears all "search_chunks" edges to the SearchChunk entity.
+func (bu *BlobUpdate) ClearSearchChunks() *BlobUpdate {
+	bu.mutation.ClearSearchChunks()
+	return bu
+}
+
+// RemoveSearchChunkIDs removes the "search_chunks" edge to SearchChunk entities by IDs.
+func (bu *BlobUpdate) RemoveSearchChunkIDs(ids ...int) *BlobUpdate {
+	bu.mutation.RemoveSearchChunkIDs(ids...)
+	return bu
+}
+
+// RemoveSearchChunks removes "search_chunks" edges to SearchChunk entities.
+func (bu *BlobUpdate) RemoveSearchChunks(s ...*SearchChunk) *BlobUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return bu.RemoveSearchChunkIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (bu *BlobUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, bu.sqlSave, bu.mutation, bu.hooks)
@@ -162,6 +236,9 @@ func (bu *BlobUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if bu.mutation.KeyCleared() {
+		_spec.ClearField(blob.FieldKey, field.TypeString)
+	}
 	if value, ok := bu.mutation.SizeBytes(); ok {
 		_spec.SetField(blob.FieldSizeBytes, field.TypeInt64, value)
 	}
@@ -186,6 +263,96 @@ func (bu *BlobUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if bu.mutation.ArchiveURLCleared() {
 		_spec.ClearField(blob.FieldArchiveURL, field.TypeString)
 	}
+	if bu.mutation.ProblemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   blob.ProblemsTable,
+			Columns: blob.ProblemsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocationproblem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedProblemsIDs(); len(nodes) > 0 && !bu.mutation.ProblemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   blob.ProblemsTable,
+			Columns: blob.ProblemsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocationproblem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.ProblemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   blob.ProblemsTable,
+			Columns: blob.ProblemsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocationproblem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.SearchChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blob.SearchChunksTable,
+			Columns: []string{blob.SearchChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(searchchunk.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedSearchChunksIDs(); len(nodes) > 0 && !bu.mutation.SearchChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blob.SearchChunksTable,
+			Columns: []string{blob.SearchChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(searchchunk.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.SearchChunksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blob.SearchChunksTable,
+			Columns: []string{blob.SearchChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(searchchunk.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{blob.Label}
@@ -287,11 +454,83 @@ func (buo *BlobUpdateOne) ClearArchiveURL() *BlobUpdateOne {
 	return buo
 }
 
+// AddProblemIDs adds the "problems" edge to the BazelInvocationProblem entity by IDs.
+func (buo *BlobUpdateOne) AddProblemIDs(ids ...int) *BlobUpdateOne {
+	buo.mutation.AddProblemIDs(ids...)
+	return buo
+}
+
+// AddProblems adds the "problems" edges to the BazelInvocationProblem entity.
+func (buo *BlobUpdateOne) AddProblems(b ...*BazelInvocationProblem) *BlobUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return buo.AddProblemIDs(ids...)
+}
+
+// AddSearchChunkIDs adds the "search_chunks" edge to the SearchChunk entity by IDs.
+func (buo *BlobUpdateOne) AddSearchChunkIDs(ids ...int) *BlobUpdateOne {
+	buo.mutation.AddSearchChunkIDs(ids...)
+	return buo
+}
+
+// AddSearchChunks adds the "search_chunks" edges to the SearchChunk entity.
+func (buo *BlobUpdateOne) AddSearchChunks(s ...*SearchChunk) *BlobUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return buo.AddSearchChunkIDs(ids...)
+}
+
 // Mutation returns the BlobMutation object of the builder.
 func (buo *BlobUpdateOne) Mutation() *BlobMutation {
 	return buo.mutation
 }
 
+// ClearProblems clears all "problems" edges to the BazelInvocationProblem entity.
+func (buo *BlobUpdateOne) ClearProblems() *BlobUpdateOne {
+	buo.mutation.ClearProblems()
+	return buo
+}
+
+// RemoveProblemIDs removes the "problems" edge to BazelInvocationProblem entities by IDs.
+func (buo *BlobUpdateOne) RemoveProblemIDs(ids ...int) *BlobUpdateOne {
+	buo.mutation.RemoveProblemIDs(ids...)
+	return buo
+}
+
+// RemoveProblems removes "problems" edges to BazelInvocationProblem entities.
+func (buo *BlobUpdateOne) RemoveProblems(b ...*BazelInvocationProblem) *BlobUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return buo.RemoveProblemIDs(ids...)
+}
+
+// ClearSearchChunks clears all "search_chunks" edges to the SearchChunk entity.
+func (buo *BlobUpdateOne) ClearSearchChunks() *BlobUpdateOne {
+	buo.mutation.ClearSearchChunks()
+	return buo
+}
+
+// RemoveSearchChunkIDs removes the "search_chunks" edge to SearchChunk entities by IDs.
+func (buo *BlobUpdateOne) RemoveSearchChunkIDs(ids ...int) *BlobUpdateOne {
+	buo.mutation.RemoveSearchChunkIDs(ids...)
+	return buo
+}
+
+// RemoveSearchChunks removes "search_chunks" edges to SearchChunk entities.
+func (buo *BlobUpdateOne) RemoveSearchChunks(s ...*SearchChunk) *BlobUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return buo.RemoveSearchChunkIDs(ids...)
+}
+
 // Where appends a list predicates to the BlobUpdate builder.
 func (buo *BlobUpdateOne) Where(ps ...predicate.Blob) *BlobUpdateOne {
 	buo.mutation.Where(ps...)
@@ -371,6 +610,9 @@ func (buo *BlobUpdateOne) sqlSave(ctx context.Context) (_node *Blob, err error)
 			}
 		}
 	}
+	if buo.mutation.KeyCleared() {
+		_spec.ClearField(blob.FieldKey, field.TypeString)
+	}
 	if value, ok := buo.mutation.SizeBytes(); ok {
 		_spec.SetField(blob.FieldSizeBytes, field.TypeInt64, value)
 	}
@@ -395,6 +637,96 @@ func (buo *BlobUpdateOne) sqlSave(ctx context.Context) (_node *Blob, err error)
 	if buo.mutation.ArchiveURLCleared() {
 		_spec.ClearField(blob.FieldArchiveURL, field.TypeString)
 	}
+	if buo.mutation.ProblemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   blob.ProblemsTable,
+			Columns: blob.ProblemsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocationproblem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedProblemsIDs(); len(nodes) > 0 && !buo.mutation.ProblemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   blob.ProblemsTable,
+			Columns: blob.ProblemsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocationproblem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.ProblemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   blob.ProblemsTable,
+			Columns: blob.ProblemsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocationproblem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.SearchChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blob.SearchChunksTable,
+			Columns: []string{blob.SearchChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(searchchunk.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedSearchChunksIDs(); len(nodes) > 0 && !buo.mutation.SearchChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blob.SearchChunksTable,
+			Columns: []string{blob.SearchChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(searchchunk.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.SearchChunksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blob.SearchChunksTable,
+			Columns: []string{blob.SearchChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(searchchunk.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Blob{config: buo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
@@ -19,6 +20,7 @@ type BuildCreate struct {
 	config
 	mutation *BuildMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetBuildURL sets the "build_url" field.
@@ -123,6 +125,7 @@ func (bc *BuildCreate) createSpec() (*Build, *sqlgraph.CreateSpec) {
 		_node = &Build{config: bc.config}
 		_spec = sqlgraph.NewCreateSpec(build.Table, sqlgraph.NewFieldSpec(build.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = bc.conflict
 	if value, ok := bc.mutation.BuildURL(); ok {
 		_spec.SetField(build.FieldBuildURL, field.TypeString, value)
 		_node.BuildURL = value
@@ -154,11 +157,168 @@ func (bc *BuildCreate) createSpec() (*Build, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Build.Create().
+//		SetBuildURL(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BuildUpsert) {
+//			SetBuildURL(v+v).
+//		}).
+//		Exec(ctx)
+func (bc *BuildCreate) OnConflict(opts ...sql.ConflictOption) *BuildUpsertOne {
+	bc.conflict = opts
+	return &BuildUpsertOne{
+		create: bc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Build.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bc *BuildCreate) OnConflictColumns(columns ...string) *BuildUpsertOne {
+	bc.conflict = append(bc.conflict, sql.ConflictColumns(columns...))
+	return &BuildUpsertOne{
+		create: bc,
+	}
+}
+
+type (
+	// BuildUpsertOne is the builder for "upsert"-ing
+	//  one Build node.
+	BuildUpsertOne struct {
+		create *BuildCreate
+	}
+
+	// BuildUpsert is the "OnConflict" setter.
+	BuildUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetEnv sets the "env" field.
+func (u *BuildUpsert) SetEnv(v map[string]string) *BuildUpsert {
+	u.Set(build.FieldEnv, v)
+	return u
+}
+
+// UpdateEnv sets the "env" field to the value that was provided on create.
+func (u *BuildUpsert) UpdateEnv() *BuildUpsert {
+	u.SetExcluded(build.FieldEnv)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Build.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BuildUpsertOne) UpdateNewValues() *BuildUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.BuildURL(); exists {
+			s.SetIgnore(build.FieldBuildURL)
+		}
+		if _, exists := u.create.mutation.BuildUUID(); exists {
+			s.SetIgnore(build.FieldBuildUUID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Build.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BuildUpsertOne) Ignore() *BuildUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BuildUpsertOne) DoNothing() *BuildUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BuildCreate.OnConflict
+// documentation for more info.
+func (u *BuildUpsertOne) Update(set func(*BuildUpsert)) *BuildUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BuildUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEnv sets the "env" field.
+func (u *BuildUpsertOne) SetEnv(v map[string]string) *BuildUpsertOne {
+	return u.Update(func(s *BuildUpsert) {
+		s.SetEnv(v)
+	})
+}
+
+// UpdateEnv sets the "env" field to the value that was provided on create.
+func (u *BuildUpsertOne) UpdateEnv() *BuildUpsertOne {
+	return u.Update(func(s *BuildUpsert) {
+		s.UpdateEnv()
+	})
+}
+
+// Exec executes the query.
+func (u *BuildUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BuildCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BuildUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BuildUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BuildUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // BuildCreateBulk is the builder for creating many Build entities in bulk.
 type BuildCreateBulk struct {
 	config
 	err      error
 	builders []*BuildCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Build entities in the database.
@@ -187,6 +347,7 @@ func (bcb *BuildCreateBulk) Save(ctx context.Context) ([]*Build, error) {
 					_, err = mutators[i+1].Mutate(root, bcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = bcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, bcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -240,3 +401,131 @@ func (bcb *BuildCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Build.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BuildUpsert) {
+//			SetBuildURL(v+v).
+//		}).
+//		Exec(ctx)
+func (bcb *BuildCreateBulk) OnConflict(opts ...sql.ConflictOption) *BuildUpsertBulk {
+	bcb.conflict = opts
+	return &BuildUpsertBulk{
+		create: bcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Build.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bcb *BuildCreateBulk) OnConflictColumns(columns ...string) *BuildUpsertBulk {
+	bcb.conflict = append(bcb.conflict, sql.ConflictColumns(columns...))
+	return &BuildUpsertBulk{
+		create: bcb,
+	}
+}
+
+// BuildUpsertBulk is the builder for "upsert"-ing
+// a bulk of Build nodes.
+type BuildUpsertBulk struct {
+	create *BuildCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Build.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BuildUpsertBulk) UpdateNewValues() *BuildUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.BuildURL(); exists {
+				s.SetIgnore(build.FieldBuildURL)
+			}
+			if _, exists := b.mutation.BuildUUID(); exists {
+				s.SetIgnore(build.FieldBuildUUID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Build.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BuildUpsertBulk) Ignore() *BuildUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BuildUpsertBulk) DoNothing() *BuildUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BuildCreateBulk.OnConflict
+// documentation for more info.
+func (u *BuildUpsertBulk) Update(set func(*BuildUpsert)) *BuildUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BuildUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEnv sets the "env" field.
+func (u *BuildUpsertBulk) SetEnv(v map[string]string) *BuildUpsertBulk {
+	return u.Update(func(s *BuildUpsert) {
+		s.SetEnv(v)
+	})
+}
+
+// UpdateEnv sets the "env" field to the value that was provided on create.
+func (u *BuildUpsertBulk) UpdateEnv() *BuildUpsertBulk {
+	return u.Update(func(s *BuildUpsert) {
+		s.UpdateEnv()
+	})
+}
+
+// Exec executes the query.
+func (u *BuildUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BuildCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BuildCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BuildUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -31,8 +31,10 @@ import (
 	"github.com/buildbarn/bb-portal/ent/gen/ent/exectioninfo"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/filesmetric"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/garbagemetrics"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/knownproblem"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/memorymetrics"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/metrics"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/metricsrollup"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/missdetail"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/namedsetoffiles"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/networkmetrics"
@@ -42,6 +44,7 @@ import (
 	"github.com/buildbarn/bb-portal/ent/gen/ent/racestatistics"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/resourceusage"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/runnercount"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/searchchunk"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/systemnetworkstats"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/targetcomplete"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/targetconfigured"
@@ -49,11 +52,14 @@ import (
 	"github.com/buildbarn/bb-portal/ent/gen/ent/targetpair"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testcollection"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testfile"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/testhealthreport"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testresultbes"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testsummary"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/timingbreakdown"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/timingchild"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/timingmetrics"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -93,10 +99,14 @@ type Client struct {
 	FilesMetric *FilesMetricClient
 	// GarbageMetrics is the client for interacting with the GarbageMetrics builders.
 	GarbageMetrics *GarbageMetricsClient
+	// KnownProblem is the client for interacting with the KnownProblem builders.
+	KnownProblem *KnownProblemClient
 	// MemoryMetrics is the client for interacting with the MemoryMetrics builders.
 	MemoryMetrics *MemoryMetricsClient
 	// Metrics is the client for interacting with the Metrics builders.
 	Metrics *MetricsClient
+	// MetricsRollup is the client for interacting with the MetricsRollup builders.
+	MetricsRollup *MetricsRollupClient
 	// MissDetail is the client for interacting with the MissDetail builders.
 	MissDetail *MissDetailClient
 	// NamedSetOfFiles is the client for interacting with the NamedSetOfFiles builders.
@@ -115,6 +125,8 @@ type Client struct {
 	ResourceUsage *ResourceUsageClient
 	// RunnerCount is the client for interacting with the RunnerCount builders.
 	RunnerCount *RunnerCountClient
+	// SearchChunk is the client for interacting with the SearchChunk builders.
+	SearchChunk *SearchChunkClient
 	// SystemNetworkStats is the client for interacting with the SystemNetworkStats builders.
 	SystemNetworkStats *SystemNetworkStatsClient
 	// TargetComplete is the client for interacting with the TargetComplete builders.
@@ -129,6 +141,8 @@ type Client struct {
 	TestCollection *TestCollectionClient
 	// TestFile is the client for interacting with the TestFile builders.
 	TestFile *TestFileClient
+	// TestHealthReport is the client for interacting with the TestHealthReport builders.
+	TestHealthReport *TestHealthReportClient
 	// TestResultBES is the client for interacting with the TestResultBES builders.
 	TestResultBES *TestResultBESClient
 	// TestSummary is the client for interacting with the TestSummary builders.
@@ -168,8 +182,10 @@ func (c *Client) init() {
 	c.ExectionInfo = NewExectionInfoClient(c.config)
 	c.FilesMetric = NewFilesMetricClient(c.config)
 	c.GarbageMetrics = NewGarbageMetricsClient(c.config)
+	c.KnownProblem = NewKnownProblemClient(c.config)
 	c.MemoryMetrics = NewMemoryMetricsClient(c.config)
 	c.Metrics = NewMetricsClient(c.config)
+	c.MetricsRollup = NewMetricsRollupClient(c.config)
 	c.MissDetail = NewMissDetailClient(c.config)
 	c.NamedSetOfFiles = NewNamedSetOfFilesClient(c.config)
 	c.NetworkMetrics = NewNetworkMetricsClient(c.config)
@@ -179,6 +195,7 @@ func (c *Client) init() {
 	c.RaceStatistics = NewRaceStatisticsClient(c.config)
 	c.ResourceUsage = NewResourceUsageClient(c.config)
 	c.RunnerCount = NewRunnerCountClient(c.config)
+	c.SearchChunk = NewSearchChunkClient(c.config)
 	c.SystemNetworkStats = NewSystemNetworkStatsClient(c.config)
 	c.TargetComplete = NewTargetCompleteClient(c.config)
 	c.TargetConfigured = NewTargetConfiguredClient(c.config)
@@ -186,6 +203,7 @@ func (c *Client) init() {
 	c.TargetPair = NewTargetPairClient(c.config)
 	c.TestCollection = NewTestCollectionClient(c.config)
 	c.TestFile = NewTestFileClient(c.config)
+	c.TestHealthReport = NewTestHealthReportClient(c.config)
 	c.TestResultBES = NewTestResultBESClient(c.config)
 	c.TestSummary = NewTestSummaryClient(c.config)
 	c.TimingBreakdown = NewTimingBreakdownClient(c.config)
@@ -299,8 +317,10 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ExectionInfo:            NewExectionInfoClient(cfg),
 		FilesMetric:             NewFilesMetricClient(cfg),
 		GarbageMetrics:          NewGarbageMetricsClient(cfg),
+		KnownProblem:            NewKnownProblemClient(cfg),
 		MemoryMetrics:           NewMemoryMetricsClient(cfg),
 		Metrics:                 NewMetricsClient(cfg),
+		MetricsRollup:           NewMetricsRollupClient(cfg),
 		MissDetail:              NewMissDetailClient(cfg),
 		NamedSetOfFiles:         NewNamedSetOfFilesClient(cfg),
 		NetworkMetrics:          NewNetworkMetricsClient(cfg),
@@ -310,6 +330,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		RaceStatistics:          NewRaceStatisticsClient(cfg),
 		ResourceUsage:           NewResourceUsageClient(cfg),
 		RunnerCount:             NewRunnerCountClient(cfg),
+		SearchChunk:             NewSearchChunkClient(cfg),
 		SystemNetworkStats:      NewSystemNetworkStatsClient(cfg),
 		TargetComplete:          NewTargetCompleteClient(cfg),
 		TargetConfigured:        NewTargetConfiguredClient(cfg),
@@ -317,6 +338,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		TargetPair:              NewTargetPairClient(cfg),
 		TestCollection:          NewTestCollectionClient(cfg),
 		TestFile:                NewTestFileClient(cfg),
+		TestHealthReport:        NewTestHealthReportClient(cfg),
 		TestResultBES:           NewTestResultBESClient(cfg),
 		TestSummary:             NewTestSummaryClient(cfg),
 		TimingBreakdown:         NewTimingBreakdownClient(cfg),
@@ -357,8 +379,10 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ExectionInfo:            NewExectionInfoClient(cfg),
 		FilesMetric:             NewFilesMetricClient(cfg),
 		GarbageMetrics:          NewGarbageMetricsClient(cfg),
+		KnownProblem:            NewKnownProblemClient(cfg),
 		MemoryMetrics:           NewMemoryMetricsClient(cfg),
 		Metrics:                 NewMetricsClient(cfg),
+		MetricsRollup:           NewMetricsRollupClient(cfg),
 		MissDetail:              NewMissDetailClient(cfg),
 		NamedSetOfFiles:         NewNamedSetOfFilesClient(cfg),
 		NetworkMetrics:          NewNetworkMetricsClient(cfg),
@@ -368,6 +392,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		RaceStatistics:          NewRaceStatisticsClient(cfg),
 		ResourceUsage:           NewResourceUsageClient(cfg),
 		RunnerCount:             NewRunnerCountClient(cfg),
+		SearchChunk:             NewSearchChunkClient(cfg),
 		SystemNetworkStats:      NewSystemNetworkStatsClient(cfg),
 		TargetComplete:          NewTargetCompleteClient(cfg),
 		TargetConfigured:        NewTargetConfiguredClient(cfg),
@@ -375,6 +400,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		TargetPair:              NewTargetPairClient(cfg),
 		TestCollection:          NewTestCollectionClient(cfg),
 		TestFile:                NewTestFileClient(cfg),
+		TestHealthReport:        NewTestHealthReportClient(cfg),
 		TestResultBES:           NewTestResultBESClient(cfg),
 		TestSummary:             NewTestSummaryClient(cfg),
 		TimingBreakdown:         NewTimingBreakdownClient(cfg),
@@ -413,12 +439,13 @@ func (c *Client) Use(hooks ...Hook) {
 		c.BazelInvocation, c.BazelInvocationProblem, c.Blob, c.Build,
 		c.BuildGraphMetrics, c.CumulativeMetrics, c.DynamicExecutionMetrics,
 		c.EvaluationStat, c.EventFile, c.ExectionInfo, c.FilesMetric, c.GarbageMetrics,
-		c.MemoryMetrics, c.Metrics, c.MissDetail, c.NamedSetOfFiles, c.NetworkMetrics,
-		c.OutputGroup, c.PackageLoadMetrics, c.PackageMetrics, c.RaceStatistics,
-		c.ResourceUsage, c.RunnerCount, c.SystemNetworkStats, c.TargetComplete,
-		c.TargetConfigured, c.TargetMetrics, c.TargetPair, c.TestCollection,
-		c.TestFile, c.TestResultBES, c.TestSummary, c.TimingBreakdown, c.TimingChild,
-		c.TimingMetrics,
+		c.KnownProblem, c.MemoryMetrics, c.Metrics, c.MetricsRollup, c.MissDetail,
+		c.NamedSetOfFiles, c.NetworkMetrics, c.OutputGroup, c.PackageLoadMetrics,
+		c.PackageMetrics, c.RaceStatistics, c.ResourceUsage, c.RunnerCount,
+		c.SearchChunk, c.SystemNetworkStats, c.TargetComplete, c.TargetConfigured,
+		c.TargetMetrics, c.TargetPair, c.TestCollection, c.TestFile,
+		c.TestHealthReport, c.TestResultBES, c.TestSummary, c.TimingBreakdown,
+		c.TimingChild, c.TimingMetrics,
 	} {
 		n.Use(hooks...)
 	}
@@ -432,17 +459,23 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 		c.BazelInvocation, c.BazelInvocationProblem, c.Blob, c.Build,
 		c.BuildGraphMetrics, c.CumulativeMetrics, c.DynamicExecutionMetrics,
 		c.EvaluationStat, c.EventFile, c.ExectionInfo, c.FilesMetric, c.GarbageMetrics,
-		c.MemoryMetrics, c.Metrics, c.MissDetail, c.NamedSetOfFiles, c.NetworkMetrics,
-		c.OutputGroup, c.PackageLoadMetrics, c.PackageMetrics, c.RaceStatistics,
-		c.ResourceUsage, c.RunnerCount, c.SystemNetworkStats, c.TargetComplete,
-		c.TargetConfigured, c.TargetMetrics, c.TargetPair, c.TestCollection,
-		c.TestFile, c.TestResultBES, c.TestSummary, c.TimingBreakdown, c.TimingChild,
-		c.TimingMetrics,
+		c.KnownProblem, c.MemoryMetrics, c.Metrics, c.MetricsRollup, c.MissDetail,
+		c.NamedSetOfFiles, c.NetworkMetrics, c.OutputGroup, c.PackageLoadMetrics,
+		c.PackageMetrics, c.RaceStatistics, c.ResourceUsage, c.RunnerCount,
+		c.SearchChunk, c.SystemNetworkStats, c.TargetComplete, c.TargetConfigured,
+		c.TargetMetrics, c.TargetPair, c.TestCollection, c.TestFile,
+		c.TestHealthReport, c.TestResultBES, c.TestSummary, c.TimingBreakdown,
+		c.TimingChild, c.TimingMetrics,
 	} {
 		n.Intercept(interceptors...)
 	}
 }
 
+// Dialect returns the SQL dialect of the database of the client.
+func (c *Client) Dialect() string {
+	return c.driver.Dialect()
+}
+
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
@@ -478,10 +511,14 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.FilesMetric.mutate(ctx, m)
 	case *GarbageMetricsMutation:
 		return c.GarbageMetrics.mutate(ctx, m)
+	case *KnownProblemMutation:
+		return c.KnownProblem.mutate(ctx, m)
 	case *MemoryMetricsMutation:
 		return c.MemoryMetrics.mutate(ctx, m)
 	case *MetricsMutation:
 		return c.Metrics.mutate(ctx, m)
+	case *MetricsRollupMutation:
+		return c.MetricsRollup.mutate(ctx, m)
 	case *MissDetailMutation:
 		return c.MissDetail.mutate(ctx, m)
 	case *NamedSetOfFilesMutation:
@@ -500,6 +537,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.ResourceUsage.mutate(ctx, m)
 	case *RunnerCountMutation:
 		return c.RunnerCount.mutate(ctx, m)
+	case *SearchChunkMutation:
+		return c.SearchChunk.mutate(ctx, m)
 	case *SystemNetworkStatsMutation:
 		return c.SystemNetworkStats.mutate(ctx, m)
 	case *TargetCompleteMutation:
@@ -514,6 +553,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.TestCollection.mutate(ctx, m)
 	case *TestFileMutation:
 		return c.TestFile.mutate(ctx, m)
+	case *TestHealthReportMutation:
+		return c.TestHealthReport.mutate(ctx, m)
 	case *TestResultBESMutation:
 		return c.TestResultBES.mutate(ctx, m)
 	case *TestSummaryMutation:
@@ -1457,6 +1498,38 @@ func (c *BazelInvocationClient) QueryTargets(bi *BazelInvocation) *TargetPairQue
 	return query
 }
 
+// QueryTestHealthReports queries the test_health_reports edge of a BazelInvocation.
+func (c *BazelInvocationClient) QueryTestHealthReports(bi *BazelInvocation) *TestHealthReportQuery {
+	query := (&TestHealthReportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := bi.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bazelinvocation.Table, bazelinvocation.FieldID, id),
+			sqlgraph.To(testhealthreport.Table, testhealthreport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, bazelinvocation.TestHealthReportsTable, bazelinvocation.TestHealthReportsColumn),
+		)
+		fromV = sqlgraph.Neighbors(bi.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySearchChunks queries the search_chunks edge of a BazelInvocation.
+func (c *BazelInvocationClient) QuerySearchChunks(bi *BazelInvocation) *SearchChunkQuery {
+	query := (&SearchChunkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := bi.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bazelinvocation.Table, bazelinvocation.FieldID, id),
+			sqlgraph.To(searchchunk.Table, searchchunk.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, bazelinvocation.SearchChunksTable, bazelinvocation.SearchChunksColumn),
+		)
+		fromV = sqlgraph.Neighbors(bi.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *BazelInvocationClient) Hooks() []Hook {
 	return c.hooks.BazelInvocation
@@ -1606,6 +1679,54 @@ func (c *BazelInvocationProblemClient) QueryBazelInvocation(bip *BazelInvocation
 	return query
 }
 
+// QueryKnownProblem queries the known_problem edge of a BazelInvocationProblem.
+func (c *BazelInvocationProblemClient) QueryKnownProblem(bip *BazelInvocationProblem) *KnownProblemQuery {
+	query := (&KnownProblemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := bip.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bazelinvocationproblem.Table, bazelinvocationproblem.FieldID, id),
+			sqlgraph.To(knownproblem.Table, knownproblem.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, bazelinvocationproblem.KnownProblemTable, bazelinvocationproblem.KnownProblemColumn),
+		)
+		fromV = sqlgraph.Neighbors(bip.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBlobs queries the blobs edge of a BazelInvocationProblem.
+func (c *BazelInvocationProblemClient) QueryBlobs(bip *BazelInvocationProblem) *BlobQuery {
+	query := (&BlobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := bip.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bazelinvocationproblem.Table, bazelinvocationproblem.FieldID, id),
+			sqlgraph.To(blob.Table, blob.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, bazelinvocationproblem.BlobsTable, bazelinvocationproblem.BlobsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(bip.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySearchChunks queries the search_chunks edge of a BazelInvocationProblem.
+func (c *BazelInvocationProblemClient) QuerySearchChunks(bip *BazelInvocationProblem) *SearchChunkQuery {
+	query := (&SearchChunkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := bip.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bazelinvocationproblem.Table, bazelinvocationproblem.FieldID, id),
+			sqlgraph.To(searchchunk.Table, searchchunk.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, bazelinvocationproblem.SearchChunksTable, bazelinvocationproblem.SearchChunksColumn),
+		)
+		fromV = sqlgraph.Neighbors(bip.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *BazelInvocationProblemClient) Hooks() []Hook {
 	return c.hooks.BazelInvocationProblem
@@ -1739,6 +1860,38 @@ func (c *BlobClient) GetX(ctx context.Context, id int) *Blob {
 	return obj
 }
 
+// QueryProblems queries the problems edge of a Blob.
+func (c *BlobClient) QueryProblems(b *Blob) *BazelInvocationProblemQuery {
+	query := (&BazelInvocationProblemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := b.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blob.Table, blob.FieldID, id),
+			sqlgraph.To(bazelinvocationproblem.Table, bazelinvocationproblem.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, blob.ProblemsTable, blob.ProblemsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySearchChunks queries the search_chunks edge of a Blob.
+func (c *BlobClient) QuerySearchChunks(b *Blob) *SearchChunkQuery {
+	query := (&SearchChunkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := b.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blob.Table, blob.FieldID, id),
+			sqlgraph.To(searchchunk.Table, searchchunk.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, blob.SearchChunksTable, blob.SearchChunksColumn),
+		)
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *BlobClient) Hooks() []Hook {
 	return c.hooks.Blob
@@ -3233,6 +3386,155 @@ func (c *GarbageMetricsClient) mutate(ctx context.Context, m *GarbageMetricsMuta
 	}
 }
 
+// KnownProblemClient is a client for the KnownProblem schema.
+type KnownProblemClient struct {
+	config
+}
+
+// NewKnownProblemClient returns a client for the KnownProblem from the given config.
+func NewKnownProblemClient(c config) *KnownProblemClient {
+	return &KnownProblemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `knownproblem.Hooks(f(g(h())))`.
+func (c *KnownProblemClient) Use(hooks ...Hook) {
+	c.hooks.KnownProblem = append(c.hooks.KnownProblem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `knownproblem.Intercept(f(g(h())))`.
+func (c *KnownProblemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.KnownProblem = append(c.inters.KnownProblem, interceptors...)
+}
+
+// Create returns a builder for creating a KnownProblem entity.
+func (c *KnownProblemClient) Create() *KnownProblemCreate {
+	mutation := newKnownProblemMutation(c.config, OpCreate)
+	return &KnownProblemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of KnownProblem entities.
+func (c *KnownProblemClient) CreateBulk(builders ...*KnownProblemCreate) *KnownProblemCreateBulk {
+	return &KnownProblemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *KnownProblemClient) MapCreateBulk(slice any, setFunc func(*KnownProblemCreate, int)) *KnownProblemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &KnownProblemCreateBulk{err: fmt.Errorf("calling to KnownProblemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*KnownProblemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &KnownProblemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for KnownProblem.
+func (c *KnownProblemClient) Update() *KnownProblemUpdate {
+	mutation := newKnownProblemMutation(c.config, OpUpdate)
+	return &KnownProblemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *KnownProblemClient) UpdateOne(kp *KnownProblem) *KnownProblemUpdateOne {
+	mutation := newKnownProblemMutation(c.config, OpUpdateOne, withKnownProblem(kp))
+	return &KnownProblemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *KnownProblemClient) UpdateOneID(id int) *KnownProblemUpdateOne {
+	mutation := newKnownProblemMutation(c.config, OpUpdateOne, withKnownProblemID(id))
+	return &KnownProblemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for KnownProblem.
+func (c *KnownProblemClient) Delete() *KnownProblemDelete {
+	mutation := newKnownProblemMutation(c.config, OpDelete)
+	return &KnownProblemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *KnownProblemClient) DeleteOne(kp *KnownProblem) *KnownProblemDeleteOne {
+	return c.DeleteOneID(kp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *KnownProblemClient) DeleteOneID(id int) *KnownProblemDeleteOne {
+	builder := c.Delete().Where(knownproblem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &KnownProblemDeleteOne{builder}
+}
+
+// Query returns a query builder for KnownProblem.
+func (c *KnownProblemClient) Query() *KnownProblemQuery {
+	return &KnownProblemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeKnownProblem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a KnownProblem entity by its id.
+func (c *KnownProblemClient) Get(ctx context.Context, id int) (*KnownProblem, error) {
+	return c.Query().Where(knownproblem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *KnownProblemClient) GetX(ctx context.Context, id int) *KnownProblem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProblems queries the problems edge of a KnownProblem.
+func (c *KnownProblemClient) QueryProblems(kp *KnownProblem) *BazelInvocationProblemQuery {
+	query := (&BazelInvocationProblemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := kp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(knownproblem.Table, knownproblem.FieldID, id),
+			sqlgraph.To(bazelinvocationproblem.Table, bazelinvocationproblem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, knownproblem.ProblemsTable, knownproblem.ProblemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(kp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *KnownProblemClient) Hooks() []Hook {
+	return c.hooks.KnownProblem
+}
+
+// Interceptors returns the client interceptors.
+func (c *KnownProblemClient) Interceptors() []Interceptor {
+	return c.inters.KnownProblem
+}
+
+func (c *KnownProblemClient) mutate(ctx context.Context, m *KnownProblemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&KnownProblemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&KnownProblemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&KnownProblemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&KnownProblemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown KnownProblem mutation op: %q", m.Op())
+	}
+}
+
 // MemoryMetricsClient is a client for the MemoryMetrics schema.
 type MemoryMetricsClient struct {
 	config
@@ -3707,6 +4009,139 @@ func (c *MetricsClient) mutate(ctx context.Context, m *MetricsMutation) (Value,
 	}
 }
 
+// MetricsRollupClient is a client for the MetricsRollup schema.
+type MetricsRollupClient struct {
+	config
+}
+
+// NewMetricsRollupClient returns a client for the MetricsRollup from the given config.
+func NewMetricsRollupClient(c config) *MetricsRollupClient {
+	return &MetricsRollupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `metricsrollup.Hooks(f(g(h())))`.
+func (c *MetricsRollupClient) Use(hooks ...Hook) {
+	c.hooks.MetricsRollup = append(c.hooks.MetricsRollup, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `metricsrollup.Intercept(f(g(h())))`.
+func (c *MetricsRollupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MetricsRollup = append(c.inters.MetricsRollup, interceptors...)
+}
+
+// Create returns a builder for creating a MetricsRollup entity.
+func (c *MetricsRollupClient) Create() *MetricsRollupCreate {
+	mutation := newMetricsRollupMutation(c.config, OpCreate)
+	return &MetricsRollupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MetricsRollup entities.
+func (c *MetricsRollupClient) CreateBulk(builders ...*MetricsRollupCreate) *MetricsRollupCreateBulk {
+	return &MetricsRollupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MetricsRollupClient) MapCreateBulk(slice any, setFunc func(*MetricsRollupCreate, int)) *MetricsRollupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MetricsRollupCreateBulk{err: fmt.Errorf("calling to MetricsRollupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MetricsRollupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MetricsRollupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MetricsRollup.
+func (c *MetricsRollupClient) Update() *MetricsRollupUpdate {
+	mutation := newMetricsRollupMutation(c.config, OpUpdate)
+	return &MetricsRollupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MetricsRollupClient) UpdateOne(mr *MetricsRollup) *MetricsRollupUpdateOne {
+	mutation := newMetricsRollupMutation(c.config, OpUpdateOne, withMetricsRollup(mr))
+	return &MetricsRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MetricsRollupClient) UpdateOneID(id int) *MetricsRollupUpdateOne {
+	mutation := newMetricsRollupMutation(c.config, OpUpdateOne, withMetricsRollupID(id))
+	return &MetricsRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MetricsRollup.
+func (c *MetricsRollupClient) Delete() *MetricsRollupDelete {
+	mutation := newMetricsRollupMutation(c.config, OpDelete)
+	return &MetricsRollupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MetricsRollupClient) DeleteOne(mr *MetricsRollup) *MetricsRollupDeleteOne {
+	return c.DeleteOneID(mr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MetricsRollupClient) DeleteOneID(id int) *MetricsRollupDeleteOne {
+	builder := c.Delete().Where(metricsrollup.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MetricsRollupDeleteOne{builder}
+}
+
+// Query returns a query builder for MetricsRollup.
+func (c *MetricsRollupClient) Query() *MetricsRollupQuery {
+	return &MetricsRollupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMetricsRollup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MetricsRollup entity by its id.
+func (c *MetricsRollupClient) Get(ctx context.Context, id int) (*MetricsRollup, error) {
+	return c.Query().Where(metricsrollup.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MetricsRollupClient) GetX(ctx context.Context, id int) *MetricsRollup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MetricsRollupClient) Hooks() []Hook {
+	return c.hooks.MetricsRollup
+}
+
+// Interceptors returns the client interceptors.
+func (c *MetricsRollupClient) Interceptors() []Interceptor {
+	return c.inters.MetricsRollup
+}
+
+func (c *MetricsRollupClient) mutate(ctx context.Context, m *MetricsRollupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MetricsRollupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MetricsRollupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MetricsRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MetricsRollupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MetricsRollup mutation op: %q", m.Op())
+	}
+}
+
 // MissDetailClient is a client for the MissDetail schema.
 type MissDetailClient struct {
 	config
@@ -5144,6 +5579,187 @@ func (c *RunnerCountClient) mutate(ctx context.Context, m *RunnerCountMutation)
 	}
 }
 
+// SearchChunkClient is a client for the SearchChunk schema.
+type SearchChunkClient struct {
+	config
+}
+
+// NewSearchChunkClient returns a client for the SearchChunk from the given config.
+func NewSearchChunkClient(c config) *SearchChunkClient {
+	return &SearchChunkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `searchchunk.Hooks(f(g(h())))`.
+func (c *SearchChunkClient) Use(hooks ...Hook) {
+	c.hooks.SearchChunk = append(c.hooks.SearchChunk, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `searchchunk.Intercept(f(g(h())))`.
+func (c *SearchChunkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SearchChunk = append(c.inters.SearchChunk, interceptors...)
+}
+
+// Create returns a builder for creating a SearchChunk entity.
+func (c *SearchChunkClient) Create() *SearchChunkCreate {
+	mutation := newSearchChunkMutation(c.config, OpCreate)
+	return &SearchChunkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SearchChunk entities.
+func (c *SearchChunkClient) CreateBulk(builders ...*SearchChunkCreate) *SearchChunkCreateBulk {
+	return &SearchChunkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SearchChunkClient) MapCreateBulk(slice any, setFunc func(*SearchChunkCreate, int)) *SearchChunkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SearchChunkCreateBulk{err: fmt.Errorf("calling to SearchChunkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SearchChunkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SearchChunkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SearchChunk.
+func (c *SearchChunkClient) Update() *SearchChunkUpdate {
+	mutation := newSearchChunkMutation(c.config, OpUpdate)
+	return &SearchChunkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SearchChunkClient) UpdateOne(sc *SearchChunk) *SearchChunkUpdateOne {
+	mutation := newSearchChunkMutation(c.config, OpUpdateOne, withSearchChunk(sc))
+	return &SearchChunkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SearchChunkClient) UpdateOneID(id int) *SearchChunkUpdateOne {
+	mutation := newSearchChunkMutation(c.config, OpUpdateOne, withSearchChunkID(id))
+	return &SearchChunkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SearchChunk.
+func (c *SearchChunkClient) Delete() *SearchChunkDelete {
+	mutation := newSearchChunkMutation(c.config, OpDelete)
+	return &SearchChunkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SearchChunkClient) DeleteOne(sc *SearchChunk) *SearchChunkDeleteOne {
+	return c.DeleteOneID(sc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SearchChunkClient) DeleteOneID(id int) *SearchChunkDeleteOne {
+	builder := c.Delete().Where(searchchunk.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SearchChunkDeleteOne{builder}
+}
+
+// Query returns a query builder for SearchChunk.
+func (c *SearchChunkClient) Query() *SearchChunkQuery {
+	return &SearchChunkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSearchChunk},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SearchChunk entity by its id.
+func (c *SearchChunkClient) Get(ctx context.Context, id int) (*SearchChunk, error) {
+	return c.Query().Where(searchchunk.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SearchChunkClient) GetX(ctx context.Context, id int) *SearchChunk {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBazelInvocation queries the bazel_invocation edge of a SearchChunk.
+func (c *SearchChunkClient) QueryBazelInvocation(sc *SearchChunk) *BazelInvocationQuery {
+	query := (&BazelInvocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(searchchunk.Table, searchchunk.FieldID, id),
+			sqlgraph.To(bazelinvocation.Table, bazelinvocation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, searchchunk.BazelInvocationTable, searchchunk.BazelInvocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(sc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProblem queries the problem edge of a SearchChunk.
+func (c *SearchChunkClient) QueryProblem(sc *SearchChunk) *BazelInvocationProblemQuery {
+	query := (&BazelInvocationProblemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(searchchunk.Table, searchchunk.FieldID, id),
+			sqlgraph.To(bazelinvocationproblem.Table, bazelinvocationproblem.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, searchchunk.ProblemTable, searchchunk.ProblemColumn),
+		)
+		fromV = sqlgraph.Neighbors(sc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBlob queries the blob edge of a SearchChunk.
+func (c *SearchChunkClient) QueryBlob(sc *SearchChunk) *BlobQuery {
+	query := (&BlobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(searchchunk.Table, searchchunk.FieldID, id),
+			sqlgraph.To(blob.Table, blob.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, searchchunk.BlobTable, searchchunk.BlobColumn),
+		)
+		fromV = sqlgraph.Neighbors(sc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SearchChunkClient) Hooks() []Hook {
+	return c.hooks.SearchChunk
+}
+
+// Interceptors returns the client interceptors.
+func (c *SearchChunkClient) Interceptors() []Interceptor {
+	return c.inters.SearchChunk
+}
+
+func (c *SearchChunkClient) mutate(ctx context.Context, m *SearchChunkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SearchChunkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SearchChunkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SearchChunkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SearchChunkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SearchChunk mutation op: %q", m.Op())
+	}
+}
+
 // SystemNetworkStatsClient is a client for the SystemNetworkStats schema.
 type SystemNetworkStatsClient struct {
 	config
@@ -6299,6 +6915,155 @@ func (c *TestFileClient) mutate(ctx context.Context, m *TestFileMutation) (Value
 	}
 }
 
+// TestHealthReportClient is a client for the TestHealthReport schema.
+type TestHealthReportClient struct {
+	config
+}
+
+// NewTestHealthReportClient returns a client for the TestHealthReport from the given config.
+func NewTestHealthReportClient(c config) *TestHealthReportClient {
+	return &TestHealthReportClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `testhealthreport.Hooks(f(g(h())))`.
+func (c *TestHealthReportClient) Use(hooks ...Hook) {
+	c.hooks.TestHealthReport = append(c.hooks.TestHealthReport, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `testhealthreport.Intercept(f(g(h())))`.
+func (c *TestHealthReportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TestHealthReport = append(c.inters.TestHealthReport, interceptors...)
+}
+
+// Create returns a builder for creating a TestHealthReport entity.
+func (c *TestHealthReportClient) Create() *TestHealthReportCreate {
+	mutation := newTestHealthReportMutation(c.config, OpCreate)
+	return &TestHealthReportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TestHealthReport entities.
+func (c *TestHealthReportClient) CreateBulk(builders ...*TestHealthReportCreate) *TestHealthReportCreateBulk {
+	return &TestHealthReportCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TestHealthReportClient) MapCreateBulk(slice any, setFunc func(*TestHealthReportCreate, int)) *TestHealthReportCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TestHealthReportCreateBulk{err: fmt.Errorf("calling to TestHealthReportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TestHealthReportCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TestHealthReportCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TestHealthReport.
+func (c *TestHealthReportClient) Update() *TestHealthReportUpdate {
+	mutation := newTestHealthReportMutation(c.config, OpUpdate)
+	return &TestHealthReportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TestHealthReportClient) UpdateOne(thr *TestHealthReport) *TestHealthReportUpdateOne {
+	mutation := newTestHealthReportMutation(c.config, OpUpdateOne, withTestHealthReport(thr))
+	return &TestHealthReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TestHealthReportClient) UpdateOneID(id int) *TestHealthReportUpdateOne {
+	mutation := newTestHealthReportMutation(c.config, OpUpdateOne, withTestHealthReportID(id))
+	return &TestHealthReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TestHealthReport.
+func (c *TestHealthReportClient) Delete() *TestHealthReportDelete {
+	mutation := newTestHealthReportMutation(c.config, OpDelete)
+	return &TestHealthReportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TestHealthReportClient) DeleteOne(thr *TestHealthReport) *TestHealthReportDeleteOne {
+	return c.DeleteOneID(thr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TestHealthReportClient) DeleteOneID(id int) *TestHealthReportDeleteOne {
+	builder := c.Delete().Where(testhealthreport.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TestHealthReportDeleteOne{builder}
+}
+
+// Query returns a query builder for TestHealthReport.
+func (c *TestHealthReportClient) Query() *TestHealthReportQuery {
+	return &TestHealthReportQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTestHealthReport},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TestHealthReport entity by its id.
+func (c *TestHealthReportClient) Get(ctx context.Context, id int) (*TestHealthReport, error) {
+	return c.Query().Where(testhealthreport.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TestHealthReportClient) GetX(ctx context.Context, id int) *TestHealthReport {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBazelInvocation queries the bazel_invocation edge of a TestHealthReport.
+func (c *TestHealthReportClient) QueryBazelInvocation(thr *TestHealthReport) *BazelInvocationQuery {
+	query := (&BazelInvocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := thr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testhealthreport.Table, testhealthreport.FieldID, id),
+			sqlgraph.To(bazelinvocation.Table, bazelinvocation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, testhealthreport.BazelInvocationTable, testhealthreport.BazelInvocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(thr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TestHealthReportClient) Hooks() []Hook {
+	return c.hooks.TestHealthReport
+}
+
+// Interceptors returns the client interceptors.
+func (c *TestHealthReportClient) Interceptors() []Interceptor {
+	return c.inters.TestHealthReport
+}
+
+func (c *TestHealthReportClient) mutate(ctx context.Context, m *TestHealthReportMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TestHealthReportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TestHealthReportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TestHealthReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TestHealthReportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TestHealthReport mutation op: %q", m.Op())
+	}
+}
+
 // TestResultBESClient is a client for the TestResultBES schema.
 type TestResultBESClient struct {
 	config
@@ -7130,22 +7895,48 @@ type (
 		ActionCacheStatistics, ActionData, ActionSummary, ArtifactMetrics,
 		BazelInvocation, BazelInvocationProblem, Blob, Build, BuildGraphMetrics,
 		CumulativeMetrics, DynamicExecutionMetrics, EvaluationStat, EventFile,
-		ExectionInfo, FilesMetric, GarbageMetrics, MemoryMetrics, Metrics, MissDetail,
-		NamedSetOfFiles, NetworkMetrics, OutputGroup, PackageLoadMetrics,
-		PackageMetrics, RaceStatistics, ResourceUsage, RunnerCount, SystemNetworkStats,
-		TargetComplete, TargetConfigured, TargetMetrics, TargetPair, TestCollection,
-		TestFile, TestResultBES, TestSummary, TimingBreakdown, TimingChild,
+		ExectionInfo, FilesMetric, GarbageMetrics, KnownProblem, MemoryMetrics,
+		Metrics, MetricsRollup, MissDetail, NamedSetOfFiles, NetworkMetrics,
+		OutputGroup, PackageLoadMetrics, PackageMetrics, RaceStatistics, ResourceUsage,
+		RunnerCount, SearchChunk, SystemNetworkStats, TargetComplete, TargetConfigured,
+		TargetMetrics, TargetPair, TestCollection, TestFile, TestHealthReport,
+		TestResultBES, TestSummary, TimingBreakdown, TimingChild,
 		TimingMetrics []ent.Hook
 	}
 	inters struct {
 		ActionCacheStatistics, ActionData, ActionSummary, ArtifactMetrics,
 		BazelInvocation, BazelInvocationProblem, Blob, Build, BuildGraphMetrics,
 		CumulativeMetrics, DynamicExecutionMetrics, EvaluationStat, EventFile,
-		ExectionInfo, FilesMetric, GarbageMetrics, MemoryMetrics, Metrics, MissDetail,
-		NamedSetOfFiles, NetworkMetrics, OutputGroup, PackageLoadMetrics,
-		PackageMetrics, RaceStatistics, ResourceUsage, RunnerCount, SystemNetworkStats,
-		TargetComplete, TargetConfigured, TargetMetrics, TargetPair, TestCollection,
-		TestFile, TestResultBES, TestSummary, TimingBreakdown, TimingChild,
+		ExectionInfo, FilesMetric, GarbageMetrics, KnownProblem, MemoryMetrics,
+		Metrics, MetricsRollup, MissDetail, NamedSetOfFiles, NetworkMetrics,
+		OutputGroup, PackageLoadMetrics, PackageMetrics, RaceStatistics, ResourceUsage,
+		RunnerCount, SearchChunk, SystemNetworkStats, TargetComplete, TargetConfigured,
+		TargetMetrics, TargetPair, TestCollection, TestFile, TestHealthReport,
+		TestResultBES, TestSummary, TimingBreakdown, TimingChild,
 		TimingMetrics []ent.Interceptor
 	}
 )
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -4,8 +4,10 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/dynamicexecutionmetrics"
@@ -18,6 +20,7 @@ type DynamicExecutionMetricsCreate struct {
 	config
 	mutation *DynamicExecutionMetricsMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // AddMetricIDs adds the "metrics" edge to the Metrics entity by IDs.
@@ -110,6 +113,7 @@ func (demc *DynamicExecutionMetricsCreate) createSpec() (*DynamicExecutionMetric
 		_node = &DynamicExecutionMetrics{config: demc.config}
 		_spec = sqlgraph.NewCreateSpec(dynamicexecutionmetrics.Table, sqlgraph.NewFieldSpec(dynamicexecutionmetrics.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = demc.conflict
 	if nodes := demc.mutation.MetricsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -145,11 +149,128 @@ func (demc *DynamicExecutionMetricsCreate) createSpec() (*DynamicExecutionMetric
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DynamicExecutionMetrics.Create().
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (demc *DynamicExecutionMetricsCreate) OnConflict(opts ...sql.ConflictOption) *DynamicExecutionMetricsUpsertOne {
+	demc.conflict = opts
+	return &DynamicExecutionMetricsUpsertOne{
+		create: demc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DynamicExecutionMetrics.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (demc *DynamicExecutionMetricsCreate) OnConflictColumns(columns ...string) *DynamicExecutionMetricsUpsertOne {
+	demc.conflict = append(demc.conflict, sql.ConflictColumns(columns...))
+	return &DynamicExecutionMetricsUpsertOne{
+		create: demc,
+	}
+}
+
+type (
+	// DynamicExecutionMetricsUpsertOne is the builder for "upsert"-ing
+	//  one DynamicExecutionMetrics node.
+	DynamicExecutionMetricsUpsertOne struct {
+		create *DynamicExecutionMetricsCreate
+	}
+
+	// DynamicExecutionMetricsUpsert is the "OnConflict" setter.
+	DynamicExecutionMetricsUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.DynamicExecutionMetrics.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DynamicExecutionMetricsUpsertOne) UpdateNewValues() *DynamicExecutionMetricsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DynamicExecutionMetrics.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DynamicExecutionMetricsUpsertOne) Ignore() *DynamicExecutionMetricsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DynamicExecutionMetricsUpsertOne) DoNothing() *DynamicExecutionMetricsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DynamicExecutionMetricsCreate.OnConflict
+// documentation for more info.
+func (u *DynamicExecutionMetricsUpsertOne) Update(set func(*DynamicExecutionMetricsUpsert)) *DynamicExecutionMetricsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DynamicExecutionMetricsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *DynamicExecutionMetricsUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DynamicExecutionMetricsCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DynamicExecutionMetricsUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DynamicExecutionMetricsUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DynamicExecutionMetricsUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // DynamicExecutionMetricsCreateBulk is the builder for creating many DynamicExecutionMetrics entities in bulk.
 type DynamicExecutionMetricsCreateBulk struct {
 	config
 	err      error
 	builders []*DynamicExecutionMetricsCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the DynamicExecutionMetrics entities in the database.
@@ -178,6 +299,7 @@ func (demcb *DynamicExecutionMetricsCreateBulk) Save(ctx context.Context) ([]*Dy
 					_, err = mutators[i+1].Mutate(root, demcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = demcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, demcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -231,3 +353,102 @@ func (demcb *DynamicExecutionMetricsCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DynamicExecutionMetrics.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (demcb *DynamicExecutionMetricsCreateBulk) OnConflict(opts ...sql.ConflictOption) *DynamicExecutionMetricsUpsertBulk {
+	demcb.conflict = opts
+	return &DynamicExecutionMetricsUpsertBulk{
+		create: demcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DynamicExecutionMetrics.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (demcb *DynamicExecutionMetricsCreateBulk) OnConflictColumns(columns ...string) *DynamicExecutionMetricsUpsertBulk {
+	demcb.conflict = append(demcb.conflict, sql.ConflictColumns(columns...))
+	return &DynamicExecutionMetricsUpsertBulk{
+		create: demcb,
+	}
+}
+
+// DynamicExecutionMetricsUpsertBulk is the builder for "upsert"-ing
+// a bulk of DynamicExecutionMetrics nodes.
+type DynamicExecutionMetricsUpsertBulk struct {
+	create *DynamicExecutionMetricsCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DynamicExecutionMetrics.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DynamicExecutionMetricsUpsertBulk) UpdateNewValues() *DynamicExecutionMetricsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DynamicExecutionMetrics.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DynamicExecutionMetricsUpsertBulk) Ignore() *DynamicExecutionMetricsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DynamicExecutionMetricsUpsertBulk) DoNothing() *DynamicExecutionMetricsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DynamicExecutionMetricsCreateBulk.OnConflict
+// documentation for more info.
+func (u *DynamicExecutionMetricsUpsertBulk) Update(set func(*DynamicExecutionMetricsUpsert)) *DynamicExecutionMetricsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DynamicExecutionMetricsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *DynamicExecutionMetricsUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DynamicExecutionMetricsCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DynamicExecutionMetricsCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DynamicExecutionMetricsUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -28,8 +28,10 @@ import (
 	"github.com/buildbarn/bb-portal/ent/gen/ent/exectioninfo"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/filesmetric"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/garbagemetrics"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/knownproblem"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/memorymetrics"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/metrics"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/metricsrollup"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/missdetail"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/namedsetoffiles"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/networkmetrics"
@@ -39,6 +41,7 @@ import (
 	"github.com/buildbarn/bb-portal/ent/gen/ent/racestatistics"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/resourceusage"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/runnercount"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/searchchunk"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/systemnetworkstats"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/targetcomplete"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/targetconfigured"
@@ -46,6 +49,7 @@ import (
 	"github.com/buildbarn/bb-portal/ent/gen/ent/targetpair"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testcollection"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testfile"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/testhealthreport"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testresultbes"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testsummary"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/timingbreakdown"
@@ -127,8 +131,10 @@ func checkColumn(table, column string) error {
 			exectioninfo.Table:            exectioninfo.ValidColumn,
 			filesmetric.Table:             filesmetric.ValidColumn,
 			garbagemetrics.Table:          garbagemetrics.ValidColumn,
+			knownproblem.Table:            knownproblem.ValidColumn,
 			memorymetrics.Table:           memorymetrics.ValidColumn,
 			metrics.Table:                 metrics.ValidColumn,
+			metricsrollup.Table:           metricsrollup.ValidColumn,
 			missdetail.Table:              missdetail.ValidColumn,
 			namedsetoffiles.Table:         namedsetoffiles.ValidColumn,
 			networkmetrics.Table:          networkmetrics.ValidColumn,
@@ -138,6 +144,7 @@ func checkColumn(table, column string) error {
 			racestatistics.Table:          racestatistics.ValidColumn,
 			resourceusage.Table:           resourceusage.ValidColumn,
 			runnercount.Table:             runnercount.ValidColumn,
+			searchchunk.Table:             searchchunk.ValidColumn,
 			systemnetworkstats.Table:      systemnetworkstats.ValidColumn,
 			targetcomplete.Table:          targetcomplete.ValidColumn,
 			targetconfigured.Table:        targetconfigured.ValidColumn,
@@ -145,6 +152,7 @@ func checkColumn(table, column string) error {
 			targetpair.Table:              targetpair.ValidColumn,
 			testcollection.Table:          testcollection.ValidColumn,
 			testfile.Table:                testfile.ValidColumn,
+			testhealthreport.Table:        testhealthreport.ValidColumn,
 			testresultbes.Table:           testresultbes.ValidColumn,
 			testsummary.Table:             testsummary.ValidColumn,
 			timingbreakdown.Table:         timingbreakdown.ValidColumn,
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
@@ -19,6 +20,7 @@ type EventFileCreate struct {
 	config
 	mutation *EventFileMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetURL sets the "url" field.
@@ -176,6 +178,7 @@ func (efc *EventFileCreate) createSpec() (*EventFile, *sqlgraph.CreateSpec) {
 		_node = &EventFile{config: efc.config}
 		_spec = sqlgraph.NewCreateSpec(eventfile.Table, sqlgraph.NewFieldSpec(eventfile.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = efc.conflict
 	if value, ok := efc.mutation.URL(); ok {
 		_spec.SetField(eventfile.FieldURL, field.TypeString, value)
 		_node.URL = value
@@ -219,11 +222,282 @@ func (efc *EventFileCreate) createSpec() (*EventFile, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EventFile.Create().
+//		SetURL(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EventFileUpsert) {
+//			SetURL(v+v).
+//		}).
+//		Exec(ctx)
+func (efc *EventFileCreate) OnConflict(opts ...sql.ConflictOption) *EventFileUpsertOne {
+	efc.conflict = opts
+	return &EventFileUpsertOne{
+		create: efc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EventFile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (efc *EventFileCreate) OnConflictColumns(columns ...string) *EventFileUpsertOne {
+	efc.conflict = append(efc.conflict, sql.ConflictColumns(columns...))
+	return &EventFileUpsertOne{
+		create: efc,
+	}
+}
+
+type (
+	// EventFileUpsertOne is the builder for "upsert"-ing
+	//  one EventFile node.
+	EventFileUpsertOne struct {
+		create *EventFileCreate
+	}
+
+	// EventFileUpsert is the "OnConflict" setter.
+	EventFileUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetModTime sets the "mod_time" field.
+func (u *EventFileUpsert) SetModTime(v time.Time) *EventFileUpsert {
+	u.Set(eventfile.FieldModTime, v)
+	return u
+}
+
+// UpdateModTime sets the "mod_time" field to the value that was provided on create.
+func (u *EventFileUpsert) UpdateModTime() *EventFileUpsert {
+	u.SetExcluded(eventfile.FieldModTime)
+	return u
+}
+
+// SetProtocol sets the "protocol" field.
+func (u *EventFileUpsert) SetProtocol(v string) *EventFileUpsert {
+	u.Set(eventfile.FieldProtocol, v)
+	return u
+}
+
+// UpdateProtocol sets the "protocol" field to the value that was provided on create.
+func (u *EventFileUpsert) UpdateProtocol() *EventFileUpsert {
+	u.SetExcluded(eventfile.FieldProtocol)
+	return u
+}
+
+// SetMimeType sets the "mime_type" field.
+func (u *EventFileUpsert) SetMimeType(v string) *EventFileUpsert {
+	u.Set(eventfile.FieldMimeType, v)
+	return u
+}
+
+// UpdateMimeType sets the "mime_type" field to the value that was provided on create.
+func (u *EventFileUpsert) UpdateMimeType() *EventFileUpsert {
+	u.SetExcluded(eventfile.FieldMimeType)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *EventFileUpsert) SetStatus(v string) *EventFileUpsert {
+	u.Set(eventfile.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EventFileUpsert) UpdateStatus() *EventFileUpsert {
+	u.SetExcluded(eventfile.FieldStatus)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *EventFileUpsert) SetReason(v string) *EventFileUpsert {
+	u.Set(eventfile.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *EventFileUpsert) UpdateReason() *EventFileUpsert {
+	u.SetExcluded(eventfile.FieldReason)
+	return u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *EventFileUpsert) ClearReason() *EventFileUpsert {
+	u.SetNull(eventfile.FieldReason)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.EventFile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *EventFileUpsertOne) UpdateNewValues() *EventFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.URL(); exists {
+			s.SetIgnore(eventfile.FieldURL)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EventFile.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EventFileUpsertOne) Ignore() *EventFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EventFileUpsertOne) DoNothing() *EventFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EventFileCreate.OnConflict
+// documentation for more info.
+func (u *EventFileUpsertOne) Update(set func(*EventFileUpsert)) *EventFileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EventFileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetModTime sets the "mod_time" field.
+func (u *EventFileUpsertOne) SetModTime(v time.Time) *EventFileUpsertOne {
+	return u.Update(func(s *EventFileUpsert) {
+		s.SetModTime(v)
+	})
+}
+
+// UpdateModTime sets the "mod_time" field to the value that was provided on create.
+func (u *EventFileUpsertOne) UpdateModTime() *EventFileUpsertOne {
+	return u.Update(func(s *EventFileUpsert) {
+		s.UpdateModTime()
+	})
+}
+
+// SetProtocol sets the "protocol" field.
+func (u *EventFileUpsertOne) SetProtocol(v string) *EventFileUpsertOne {
+	return u.Update(func(s *EventFileUpsert) {
+		s.SetProtocol(v)
+	})
+}
+
+// UpdateProtocol sets the "protocol" field to the value that was provided on create.
+func (u *EventFileUpsertOne) UpdateProtocol() *EventFileUpsertOne {
+	return u.Update(func(s *EventFileUpsert) {
+		s.UpdateProtocol()
+	})
+}
+
+// SetMimeType sets the "mime_type" field.
+func (u *EventFileUpsertOne) SetMimeType(v string) *EventFileUpsertOne {
+	return u.Update(func(s *EventFileUpsert) {
+		s.SetMimeType(v)
+	})
+}
+
+// UpdateMimeType sets the "mime_type" field to the value that was provided on create.
+func (u *EventFileUpsertOne) UpdateMimeType() *EventFileUpsertOne {
+	return u.Update(func(s *EventFileUpsert) {
+		s.UpdateMimeType()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *EventFileUpsertOne) SetStatus(v string) *EventFileUpsertOne {
+	return u.Update(func(s *EventFileUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EventFileUpsertOne) UpdateStatus() *EventFileUpsertOne {
+	return u.Update(func(s *EventFileUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *EventFileUpsertOne) SetReason(v string) *EventFileUpsertOne {
+	return u.Update(func(s *EventFileUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *EventFileUpsertOne) UpdateReason() *EventFileUpsertOne {
+	return u.Update(func(s *EventFileUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *EventFileUpsertOne) ClearReason() *EventFileUpsertOne {
+	return u.Update(func(s *EventFileUpsert) {
+		s.ClearReason()
+	})
+}
+
+// Exec executes the query.
+func (u *EventFileUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EventFileCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EventFileUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EventFileUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EventFileUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // EventFileCreateBulk is the builder for creating many EventFile entities in bulk.
 type EventFileCreateBulk struct {
 	config
 	err      error
 	builders []*EventFileCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the EventFile entities in the database.
@@ -253,6 +527,7 @@ func (efcb *EventFileCreateBulk) Save(ctx context.Context) ([]*EventFile, error)
 					_, err = mutators[i+1].Mutate(root, efcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = efcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, efcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -306,3 +581,191 @@ func (efcb *EventFileCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EventFile.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EventFileUpsert) {
+//			SetURL(v+v).
+//		}).
+//		Exec(ctx)
+func (efcb *EventFileCreateBulk) OnConflict(opts ...sql.ConflictOption) *EventFileUpsertBulk {
+	efcb.conflict = opts
+	return &EventFileUpsertBulk{
+		create: efcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EventFile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (efcb *EventFileCreateBulk) OnConflictColumns(columns ...string) *EventFileUpsertBulk {
+	efcb.conflict = append(efcb.conflict, sql.ConflictColumns(columns...))
+	return &EventFileUpsertBulk{
+		create: efcb,
+	}
+}
+
+// EventFileUpsertBulk is the builder for "upsert"-ing
+// a bulk of EventFile nodes.
+type EventFileUpsertBulk struct {
+	create *EventFileCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EventFile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *EventFileUpsertBulk) UpdateNewValues() *EventFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.URL(); exists {
+				s.SetIgnore(eventfile.FieldURL)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EventFile.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EventFileUpsertBulk) Ignore() *EventFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EventFileUpsertBulk) DoNothing() *EventFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EventFileCreateBulk.OnConflict
+// documentation for more info.
+func (u *EventFileUpsertBulk) Update(set func(*EventFileUpsert)) *EventFileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EventFileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetModTime sets the "mod_time" field.
+func (u *EventFileUpsertBulk) SetModTime(v time.Time) *EventFileUpsertBulk {
+	return u.Update(func(s *EventFileUpsert) {
+		s.SetModTime(v)
+	})
+}
+
+// UpdateModTime sets the "mod_time" field to the value that was provided on create.
+func (u *EventFileUpsertBulk) UpdateModTime() *EventFileUpsertBulk {
+	return u.Update(func(s *EventFileUpsert) {
+		s.UpdateModTime()
+	})
+}
+
+// SetProtocol sets the "protocol" field.
+func (u *EventFileUpsertBulk) SetProtocol(v string) *EventFileUpsertBulk {
+	return u.Update(func(s *EventFileUpsert) {
+		s.SetProtocol(v)
+	})
+}
+
+// UpdateProtocol sets the "protocol" field to the value that was provided on create.
+func (u *EventFileUpsertBulk) UpdateProtocol() *EventFileUpsertBulk {
+	return u.Update(func(s *EventFileUpsert) {
+		s.UpdateProtocol()
+	})
+}
+
+// SetMimeType sets the "mime_type" field.
+func (u *EventFileUpsertBulk) SetMimeType(v string) *EventFileUpsertBulk {
+	return u.Update(func(s *EventFileUpsert) {
+		s.SetMimeType(v)
+	})
+}
+
+// UpdateMimeType sets the "mime_type" field to the value that was provided on create.
+func (u *EventFileUpsertBulk) UpdateMimeType() *EventFileUpsertBulk {
+	return u.Update(func(s *EventFileUpsert) {
+		s.UpdateMimeType()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *EventFileUpsertBulk) SetStatus(v string) *EventFileUpsertBulk {
+	return u.Update(func(s *EventFileUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *EventFileUpsertBulk) UpdateStatus() *EventFileUpsertBulk {
+	return u.Update(func(s *EventFileUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *EventFileUpsertBulk) SetReason(v string) *EventFileUpsertBulk {
+	return u.Update(func(s *EventFileUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *EventFileUpsertBulk) UpdateReason() *EventFileUpsertBulk {
+	return u.Update(func(s *EventFileUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *EventFileUpsertBulk) ClearReason() *EventFileUpsertBulk {
+	return u.Update(func(s *EventFileUpsert) {
+		s.ClearReason()
+	})
+}
+
+// Exec executes the query.
+func (u *EventFileUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EventFileCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EventFileCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EventFileUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
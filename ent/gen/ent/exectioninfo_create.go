@@ -4,8 +4,10 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/exectioninfo"
@@ -19,6 +21,7 @@ type ExectionInfoCreate struct {
 	config
 	mutation *ExectionInfoMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetTimeoutSeconds sets the "timeout_seconds" field.
@@ -200,6 +203,7 @@ func (eic *ExectionInfoCreate) createSpec() (*ExectionInfo, *sqlgraph.CreateSpec
 		_node = &ExectionInfo{config: eic.config}
 		_spec = sqlgraph.NewCreateSpec(exectioninfo.Table, sqlgraph.NewFieldSpec(exectioninfo.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = eic.conflict
 	if value, ok := eic.mutation.TimeoutSeconds(); ok {
 		_spec.SetField(exectioninfo.FieldTimeoutSeconds, field.TypeInt32, value)
 		_node.TimeoutSeconds = value
@@ -272,11 +276,355 @@ func (eic *ExectionInfoCreate) createSpec() (*ExectionInfo, *sqlgraph.CreateSpec
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExectionInfo.Create().
+//		SetTimeoutSeconds(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExectionInfoUpsert) {
+//			SetTimeoutSeconds(v+v).
+//		}).
+//		Exec(ctx)
+func (eic *ExectionInfoCreate) OnConflict(opts ...sql.ConflictOption) *ExectionInfoUpsertOne {
+	eic.conflict = opts
+	return &ExectionInfoUpsertOne{
+		create: eic,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExectionInfo.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (eic *ExectionInfoCreate) OnConflictColumns(columns ...string) *ExectionInfoUpsertOne {
+	eic.conflict = append(eic.conflict, sql.ConflictColumns(columns...))
+	return &ExectionInfoUpsertOne{
+		create: eic,
+	}
+}
+
+type (
+	// ExectionInfoUpsertOne is the builder for "upsert"-ing
+	//  one ExectionInfo node.
+	ExectionInfoUpsertOne struct {
+		create *ExectionInfoCreate
+	}
+
+	// ExectionInfoUpsert is the "OnConflict" setter.
+	ExectionInfoUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (u *ExectionInfoUpsert) SetTimeoutSeconds(v int32) *ExectionInfoUpsert {
+	u.Set(exectioninfo.FieldTimeoutSeconds, v)
+	return u
+}
+
+// UpdateTimeoutSeconds sets the "timeout_seconds" field to the value that was provided on create.
+func (u *ExectionInfoUpsert) UpdateTimeoutSeconds() *ExectionInfoUpsert {
+	u.SetExcluded(exectioninfo.FieldTimeoutSeconds)
+	return u
+}
+
+// AddTimeoutSeconds adds v to the "timeout_seconds" field.
+func (u *ExectionInfoUpsert) AddTimeoutSeconds(v int32) *ExectionInfoUpsert {
+	u.Add(exectioninfo.FieldTimeoutSeconds, v)
+	return u
+}
+
+// ClearTimeoutSeconds clears the value of the "timeout_seconds" field.
+func (u *ExectionInfoUpsert) ClearTimeoutSeconds() *ExectionInfoUpsert {
+	u.SetNull(exectioninfo.FieldTimeoutSeconds)
+	return u
+}
+
+// SetStrategy sets the "strategy" field.
+func (u *ExectionInfoUpsert) SetStrategy(v string) *ExectionInfoUpsert {
+	u.Set(exectioninfo.FieldStrategy, v)
+	return u
+}
+
+// UpdateStrategy sets the "strategy" field to the value that was provided on create.
+func (u *ExectionInfoUpsert) UpdateStrategy() *ExectionInfoUpsert {
+	u.SetExcluded(exectioninfo.FieldStrategy)
+	return u
+}
+
+// ClearStrategy clears the value of the "strategy" field.
+func (u *ExectionInfoUpsert) ClearStrategy() *ExectionInfoUpsert {
+	u.SetNull(exectioninfo.FieldStrategy)
+	return u
+}
+
+// SetCachedRemotely sets the "cached_remotely" field.
+func (u *ExectionInfoUpsert) SetCachedRemotely(v bool) *ExectionInfoUpsert {
+	u.Set(exectioninfo.FieldCachedRemotely, v)
+	return u
+}
+
+// UpdateCachedRemotely sets the "cached_remotely" field to the value that was provided on create.
+func (u *ExectionInfoUpsert) UpdateCachedRemotely() *ExectionInfoUpsert {
+	u.SetExcluded(exectioninfo.FieldCachedRemotely)
+	return u
+}
+
+// ClearCachedRemotely clears the value of the "cached_remotely" field.
+func (u *ExectionInfoUpsert) ClearCachedRemotely() *ExectionInfoUpsert {
+	u.SetNull(exectioninfo.FieldCachedRemotely)
+	return u
+}
+
+// SetExitCode sets the "exit_code" field.
+func (u *ExectionInfoUpsert) SetExitCode(v int32) *ExectionInfoUpsert {
+	u.Set(exectioninfo.FieldExitCode, v)
+	return u
+}
+
+// UpdateExitCode sets the "exit_code" field to the value that was provided on create.
+func (u *ExectionInfoUpsert) UpdateExitCode() *ExectionInfoUpsert {
+	u.SetExcluded(exectioninfo.FieldExitCode)
+	return u
+}
+
+// AddExitCode adds v to the "exit_code" field.
+func (u *ExectionInfoUpsert) AddExitCode(v int32) *ExectionInfoUpsert {
+	u.Add(exectioninfo.FieldExitCode, v)
+	return u
+}
+
+// ClearExitCode clears the value of the "exit_code" field.
+func (u *ExectionInfoUpsert) ClearExitCode() *ExectionInfoUpsert {
+	u.SetNull(exectioninfo.FieldExitCode)
+	return u
+}
+
+// SetHostname sets the "hostname" field.
+func (u *ExectionInfoUpsert) SetHostname(v string) *ExectionInfoUpsert {
+	u.Set(exectioninfo.FieldHostname, v)
+	return u
+}
+
+// UpdateHostname sets the "hostname" field to the value that was provided on create.
+func (u *ExectionInfoUpsert) UpdateHostname() *ExectionInfoUpsert {
+	u.SetExcluded(exectioninfo.FieldHostname)
+	return u
+}
+
+// ClearHostname clears the value of the "hostname" field.
+func (u *ExectionInfoUpsert) ClearHostname() *ExectionInfoUpsert {
+	u.SetNull(exectioninfo.FieldHostname)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ExectionInfo.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ExectionInfoUpsertOne) UpdateNewValues() *ExectionInfoUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExectionInfo.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ExectionInfoUpsertOne) Ignore() *ExectionInfoUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExectionInfoUpsertOne) DoNothing() *ExectionInfoUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExectionInfoCreate.OnConflict
+// documentation for more info.
+func (u *ExectionInfoUpsertOne) Update(set func(*ExectionInfoUpsert)) *ExectionInfoUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExectionInfoUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (u *ExectionInfoUpsertOne) SetTimeoutSeconds(v int32) *ExectionInfoUpsertOne {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.SetTimeoutSeconds(v)
+	})
+}
+
+// AddTimeoutSeconds adds v to the "timeout_seconds" field.
+func (u *ExectionInfoUpsertOne) AddTimeoutSeconds(v int32) *ExectionInfoUpsertOne {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.AddTimeoutSeconds(v)
+	})
+}
+
+// UpdateTimeoutSeconds sets the "timeout_seconds" field to the value that was provided on create.
+func (u *ExectionInfoUpsertOne) UpdateTimeoutSeconds() *ExectionInfoUpsertOne {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.UpdateTimeoutSeconds()
+	})
+}
+
+// ClearTimeoutSeconds clears the value of the "timeout_seconds" field.
+func (u *ExectionInfoUpsertOne) ClearTimeoutSeconds() *ExectionInfoUpsertOne {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.ClearTimeoutSeconds()
+	})
+}
+
+// SetStrategy sets the "strategy" field.
+func (u *ExectionInfoUpsertOne) SetStrategy(v string) *ExectionInfoUpsertOne {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.SetStrategy(v)
+	})
+}
+
+// UpdateStrategy sets the "strategy" field to the value that was provided on create.
+func (u *ExectionInfoUpsertOne) UpdateStrategy() *ExectionInfoUpsertOne {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.UpdateStrategy()
+	})
+}
+
+// ClearStrategy clears the value of the "strategy" field.
+func (u *ExectionInfoUpsertOne) ClearStrategy() *ExectionInfoUpsertOne {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.ClearStrategy()
+	})
+}
+
+// SetCachedRemotely sets the "cached_remotely" field.
+func (u *ExectionInfoUpsertOne) SetCachedRemotely(v bool) *ExectionInfoUpsertOne {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.SetCachedRemotely(v)
+	})
+}
+
+// UpdateCachedRemotely sets the "cached_remotely" field to the value that was provided on create.
+func (u *ExectionInfoUpsertOne) UpdateCachedRemotely() *ExectionInfoUpsertOne {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.UpdateCachedRemotely()
+	})
+}
+
+// ClearCachedRemotely clears the value of the "cached_remotely" field.
+func (u *ExectionInfoUpsertOne) ClearCachedRemotely() *ExectionInfoUpsertOne {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.ClearCachedRemotely()
+	})
+}
+
+// SetExitCode sets the "exit_code" field.
+func (u *ExectionInfoUpsertOne) SetExitCode(v int32) *ExectionInfoUpsertOne {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.SetExitCode(v)
+	})
+}
+
+// AddExitCode adds v to the "exit_code" field.
+func (u *ExectionInfoUpsertOne) AddExitCode(v int32) *ExectionInfoUpsertOne {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.AddExitCode(v)
+	})
+}
+
+// UpdateExitCode sets the "exit_code" field to the value that was provided on create.
+func (u *ExectionInfoUpsertOne) UpdateExitCode() *ExectionInfoUpsertOne {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.UpdateExitCode()
+	})
+}
+
+// ClearExitCode clears the value of the "exit_code" field.
+func (u *ExectionInfoUpsertOne) ClearExitCode() *ExectionInfoUpsertOne {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.ClearExitCode()
+	})
+}
+
+// SetHostname sets the "hostname" field.
+func (u *ExectionInfoUpsertOne) SetHostname(v string) *ExectionInfoUpsertOne {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.SetHostname(v)
+	})
+}
+
+// UpdateHostname sets the "hostname" field to the value that was provided on create.
+func (u *ExectionInfoUpsertOne) UpdateHostname() *ExectionInfoUpsertOne {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.UpdateHostname()
+	})
+}
+
+// ClearHostname clears the value of the "hostname" field.
+func (u *ExectionInfoUpsertOne) ClearHostname() *ExectionInfoUpsertOne {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.ClearHostname()
+	})
+}
+
+// Exec executes the query.
+func (u *ExectionInfoUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExectionInfoCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExectionInfoUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ExectionInfoUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ExectionInfoUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // ExectionInfoCreateBulk is the builder for creating many ExectionInfo entities in bulk.
 type ExectionInfoCreateBulk struct {
 	config
 	err      error
 	builders []*ExectionInfoCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the ExectionInfo entities in the database.
@@ -305,6 +653,7 @@ func (eicb *ExectionInfoCreateBulk) Save(ctx context.Context) ([]*ExectionInfo,
 					_, err = mutators[i+1].Mutate(root, eicb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = eicb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, eicb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -358,3 +707,226 @@ func (eicb *ExectionInfoCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExectionInfo.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExectionInfoUpsert) {
+//			SetTimeoutSeconds(v+v).
+//		}).
+//		Exec(ctx)
+func (eicb *ExectionInfoCreateBulk) OnConflict(opts ...sql.ConflictOption) *ExectionInfoUpsertBulk {
+	eicb.conflict = opts
+	return &ExectionInfoUpsertBulk{
+		create: eicb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExectionInfo.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (eicb *ExectionInfoCreateBulk) OnConflictColumns(columns ...string) *ExectionInfoUpsertBulk {
+	eicb.conflict = append(eicb.conflict, sql.ConflictColumns(columns...))
+	return &ExectionInfoUpsertBulk{
+		create: eicb,
+	}
+}
+
+// ExectionInfoUpsertBulk is the builder for "upsert"-ing
+// a bulk of ExectionInfo nodes.
+type ExectionInfoUpsertBulk struct {
+	create *ExectionInfoCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ExectionInfo.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ExectionInfoUpsertBulk) UpdateNewValues() *ExectionInfoUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExectionInfo.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ExectionInfoUpsertBulk) Ignore() *ExectionInfoUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExectionInfoUpsertBulk) DoNothing() *ExectionInfoUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExectionInfoCreateBulk.OnConflict
+// documentation for more info.
+func (u *ExectionInfoUpsertBulk) Update(set func(*ExectionInfoUpsert)) *ExectionInfoUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExectionInfoUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (u *ExectionInfoUpsertBulk) SetTimeoutSeconds(v int32) *ExectionInfoUpsertBulk {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.SetTimeoutSeconds(v)
+	})
+}
+
+// AddTimeoutSeconds adds v to the "timeout_seconds" field.
+func (u *ExectionInfoUpsertBulk) AddTimeoutSeconds(v int32) *ExectionInfoUpsertBulk {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.AddTimeoutSeconds(v)
+	})
+}
+
+// UpdateTimeoutSeconds sets the "timeout_seconds" field to the value that was provided on create.
+func (u *ExectionInfoUpsertBulk) UpdateTimeoutSeconds() *ExectionInfoUpsertBulk {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.UpdateTimeoutSeconds()
+	})
+}
+
+// ClearTimeoutSeconds clears the value of the "timeout_seconds" field.
+func (u *ExectionInfoUpsertBulk) ClearTimeoutSeconds() *ExectionInfoUpsertBulk {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.ClearTimeoutSeconds()
+	})
+}
+
+// SetStrategy sets the "strategy" field.
+func (u *ExectionInfoUpsertBulk) SetStrategy(v string) *ExectionInfoUpsertBulk {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.SetStrategy(v)
+	})
+}
+
+// UpdateStrategy sets the "strategy" field to the value that was provided on create.
+func (u *ExectionInfoUpsertBulk) UpdateStrategy() *ExectionInfoUpsertBulk {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.UpdateStrategy()
+	})
+}
+
+// ClearStrategy clears the value of the "strategy" field.
+func (u *ExectionInfoUpsertBulk) ClearStrategy() *ExectionInfoUpsertBulk {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.ClearStrategy()
+	})
+}
+
+// SetCachedRemotely sets the "cached_remotely" field.
+func (u *ExectionInfoUpsertBulk) SetCachedRemotely(v bool) *ExectionInfoUpsertBulk {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.SetCachedRemotely(v)
+	})
+}
+
+// UpdateCachedRemotely sets the "cached_remotely" field to the value that was provided on create.
+func (u *ExectionInfoUpsertBulk) UpdateCachedRemotely() *ExectionInfoUpsertBulk {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.UpdateCachedRemotely()
+	})
+}
+
+// ClearCachedRemotely clears the value of the "cached_remotely" field.
+func (u *ExectionInfoUpsertBulk) ClearCachedRemotely() *ExectionInfoUpsertBulk {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.ClearCachedRemotely()
+	})
+}
+
+// SetExitCode sets the "exit_code" field.
+func (u *ExectionInfoUpsertBulk) SetExitCode(v int32) *ExectionInfoUpsertBulk {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.SetExitCode(v)
+	})
+}
+
+// AddExitCode adds v to the "exit_code" field.
+func (u *ExectionInfoUpsertBulk) AddExitCode(v int32) *ExectionInfoUpsertBulk {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.AddExitCode(v)
+	})
+}
+
+// UpdateExitCode sets the "exit_code" field to the value that was provided on create.
+func (u *ExectionInfoUpsertBulk) UpdateExitCode() *ExectionInfoUpsertBulk {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.UpdateExitCode()
+	})
+}
+
+// ClearExitCode clears the value of the "exit_code" field.
+func (u *ExectionInfoUpsertBulk) ClearExitCode() *ExectionInfoUpsertBulk {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.ClearExitCode()
+	})
+}
+
+// SetHostname sets the "hostname" field.
+func (u *ExectionInfoUpsertBulk) SetHostname(v string) *ExectionInfoUpsertBulk {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.SetHostname(v)
+	})
+}
+
+// UpdateHostname sets the "hostname" field to the value that was provided on create.
+func (u *ExectionInfoUpsertBulk) UpdateHostname() *ExectionInfoUpsertBulk {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.UpdateHostname()
+	})
+}
+
+// ClearHostname clears the value of the "hostname" field.
+func (u *ExectionInfoUpsertBulk) ClearHostname() *ExectionInfoUpsertBulk {
+	return u.Update(func(s *ExectionInfoUpsert) {
+		s.ClearHostname()
+	})
+}
+
+// Exec executes the query.
+func (u *ExectionInfoUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ExectionInfoCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExectionInfoCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExectionInfoUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/buildbarn/bb-portal/ent/gen/ent/exectioninfo"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/filesmetric"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/garbagemetrics"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/knownproblem"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/memorymetrics"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/missdetail"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/outputgroup"
@@ -35,6 +36,7 @@ import (
 	"github.com/buildbarn/bb-portal/ent/gen/ent/targetpair"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testcollection"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testfile"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/testhealthreport"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testresultbes"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testsummary"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/timingbreakdown"
@@ -592,6 +594,19 @@ func (bi *BazelInvocationQuery) collectField(ctx context.Context, oneNode bool,
 			bi.WithNamedTargets(alias, func(wq *TargetPairQuery) {
 				*wq = *query
 			})
+
+		case "testHealthReports":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&TestHealthReportClient{config: bi.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, testhealthreportImplementors)...); err != nil {
+				return err
+			}
+			bi.WithNamedTestHealthReports(alias, func(wq *TestHealthReportQuery) {
+				*wq = *query
+			})
 		case "invocationID":
 			if _, ok := fieldSeen[bazelinvocation.FieldInvocationID]; !ok {
 				selectedFields = append(selectedFields, bazelinvocation.FieldInvocationID)
@@ -662,6 +677,26 @@ func (bi *BazelInvocationQuery) collectField(ctx context.Context, oneNode bool,
 				selectedFields = append(selectedFields, bazelinvocation.FieldNumFetches)
 				fieldSeen[bazelinvocation.FieldNumFetches] = struct{}{}
 			}
+		case "branch":
+			if _, ok := fieldSeen[bazelinvocation.FieldBranch]; !ok {
+				selectedFields = append(selectedFields, bazelinvocation.FieldBranch)
+				fieldSeen[bazelinvocation.FieldBranch] = struct{}{}
+			}
+		case "commit":
+			if _, ok := fieldSeen[bazelinvocation.FieldCommit]; !ok {
+				selectedFields = append(selectedFields, bazelinvocation.FieldCommit)
+				fieldSeen[bazelinvocation.FieldCommit] = struct{}{}
+			}
+		case "failureClassification":
+			if _, ok := fieldSeen[bazelinvocation.FieldFailureClassification]; !ok {
+				selectedFields = append(selectedFields, bazelinvocation.FieldFailureClassification)
+				fieldSeen[bazelinvocation.FieldFailureClassification] = struct{}{}
+			}
+		case "pinned":
+			if _, ok := fieldSeen[bazelinvocation.FieldPinned]; !ok {
+				selectedFields = append(selectedFields, bazelinvocation.FieldPinned)
+				fieldSeen[bazelinvocation.FieldPinned] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -735,6 +770,30 @@ func (bip *BazelInvocationProblemQuery) collectField(ctx context.Context, oneNod
 				return err
 			}
 			bip.withBazelInvocation = query
+
+		case "knownProblem":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&KnownProblemClient{config: bip.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, knownproblemImplementors)...); err != nil {
+				return err
+			}
+			bip.withKnownProblem = query
+
+		case "blobs":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&BlobClient{config: bip.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, blobImplementors)...); err != nil {
+				return err
+			}
+			bip.WithNamedBlobs(alias, func(wq *BlobQuery) {
+				*wq = *query
+			})
 		case "problemType":
 			if _, ok := fieldSeen[bazelinvocationproblem.FieldProblemType]; !ok {
 				selectedFields = append(selectedFields, bazelinvocationproblem.FieldProblemType)
@@ -745,6 +804,21 @@ func (bip *BazelInvocationProblemQuery) collectField(ctx context.Context, oneNod
 				selectedFields = append(selectedFields, bazelinvocationproblem.FieldLabel)
 				fieldSeen[bazelinvocationproblem.FieldLabel] = struct{}{}
 			}
+		case "fingerprint":
+			if _, ok := fieldSeen[bazelinvocationproblem.FieldFingerprint]; !ok {
+				selectedFields = append(selectedFields, bazelinvocationproblem.FieldFingerprint)
+				fieldSeen[bazelinvocationproblem.FieldFingerprint] = struct{}{}
+			}
+		case "failureClassification":
+			if _, ok := fieldSeen[bazelinvocationproblem.FieldFailureClassification]; !ok {
+				selectedFields = append(selectedFields, bazelinvocationproblem.FieldFailureClassification)
+				fieldSeen[bazelinvocationproblem.FieldFailureClassification] = struct{}{}
+			}
+		case "newlyFailing":
+			if _, ok := fieldSeen[bazelinvocationproblem.FieldNewlyFailing]; !ok {
+				selectedFields = append(selectedFields, bazelinvocationproblem.FieldNewlyFailing)
+				fieldSeen[bazelinvocationproblem.FieldNewlyFailing] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -812,6 +886,11 @@ func (b *BlobQuery) collectField(ctx context.Context, oneNode bool, opCtx *graph
 				selectedFields = append(selectedFields, blob.FieldURI)
 				fieldSeen[blob.FieldURI] = struct{}{}
 			}
+		case "key":
+			if _, ok := fieldSeen[blob.FieldKey]; !ok {
+				selectedFields = append(selectedFields, blob.FieldKey)
+				fieldSeen[blob.FieldKey] = struct{}{}
+			}
 		case "sizeBytes":
 			if _, ok := fieldSeen[blob.FieldSizeBytes]; !ok {
 				selectedFields = append(selectedFields, blob.FieldSizeBytes)
@@ -1786,6 +1865,103 @@ func newGarbageMetricsPaginateArgs(rv map[string]any) *garbagemetricsPaginateArg
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (kp *KnownProblemQuery) CollectFields(ctx context.Context, satisfies ...string) (*KnownProblemQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return kp, nil
+	}
+	if err := kp.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return kp, nil
+}
+
+func (kp *KnownProblemQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(knownproblem.Columns))
+		selectedFields = []string{knownproblem.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "fingerprint":
+			if _, ok := fieldSeen[knownproblem.FieldFingerprint]; !ok {
+				selectedFields = append(selectedFields, knownproblem.FieldFingerprint)
+				fieldSeen[knownproblem.FieldFingerprint] = struct{}{}
+			}
+		case "problemType":
+			if _, ok := fieldSeen[knownproblem.FieldProblemType]; !ok {
+				selectedFields = append(selectedFields, knownproblem.FieldProblemType)
+				fieldSeen[knownproblem.FieldProblemType] = struct{}{}
+			}
+		case "label":
+			if _, ok := fieldSeen[knownproblem.FieldLabel]; !ok {
+				selectedFields = append(selectedFields, knownproblem.FieldLabel)
+				fieldSeen[knownproblem.FieldLabel] = struct{}{}
+			}
+		case "firstSeen":
+			if _, ok := fieldSeen[knownproblem.FieldFirstSeen]; !ok {
+				selectedFields = append(selectedFields, knownproblem.FieldFirstSeen)
+				fieldSeen[knownproblem.FieldFirstSeen] = struct{}{}
+			}
+		case "lastSeen":
+			if _, ok := fieldSeen[knownproblem.FieldLastSeen]; !ok {
+				selectedFields = append(selectedFields, knownproblem.FieldLastSeen)
+				fieldSeen[knownproblem.FieldLastSeen] = struct{}{}
+			}
+		case "occurrences":
+			if _, ok := fieldSeen[knownproblem.FieldOccurrences]; !ok {
+				selectedFields = append(selectedFields, knownproblem.FieldOccurrences)
+				fieldSeen[knownproblem.FieldOccurrences] = struct{}{}
+			}
+		case "branches":
+			if _, ok := fieldSeen[knownproblem.FieldBranches]; !ok {
+				selectedFields = append(selectedFields, knownproblem.FieldBranches)
+				fieldSeen[knownproblem.FieldBranches] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		kp.Select(selectedFields...)
+	}
+	return nil
+}
+
+type knownproblemPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []KnownProblemPaginateOption
+}
+
+func newKnownProblemPaginateArgs(rv map[string]any) *knownproblemPaginateArgs {
+	args := &knownproblemPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*KnownProblemWhereInput); ok {
+		args.opts = append(args.opts, WithKnownProblemFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (mm *MemoryMetricsQuery) CollectFields(ctx context.Context, satisfies ...string) (*MemoryMetricsQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -3711,6 +3887,124 @@ func newTestFilePaginateArgs(rv map[string]any) *testfilePaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (thr *TestHealthReportQuery) CollectFields(ctx context.Context, satisfies ...string) (*TestHealthReportQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return thr, nil
+	}
+	if err := thr.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return thr, nil
+}
+
+func (thr *TestHealthReportQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(testhealthreport.Columns))
+		selectedFields = []string{testhealthreport.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "bazelInvocation":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&BazelInvocationClient{config: thr.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, bazelinvocationImplementors)...); err != nil {
+				return err
+			}
+			thr.withBazelInvocation = query
+		case "label":
+			if _, ok := fieldSeen[testhealthreport.FieldLabel]; !ok {
+				selectedFields = append(selectedFields, testhealthreport.FieldLabel)
+				fieldSeen[testhealthreport.FieldLabel] = struct{}{}
+			}
+		case "issue":
+			if _, ok := fieldSeen[testhealthreport.FieldIssue]; !ok {
+				selectedFields = append(selectedFields, testhealthreport.FieldIssue)
+				fieldSeen[testhealthreport.FieldIssue] = struct{}{}
+			}
+		case "testSize":
+			if _, ok := fieldSeen[testhealthreport.FieldTestSize]; !ok {
+				selectedFields = append(selectedFields, testhealthreport.FieldTestSize)
+				fieldSeen[testhealthreport.FieldTestSize] = struct{}{}
+			}
+		case "shardCount":
+			if _, ok := fieldSeen[testhealthreport.FieldShardCount]; !ok {
+				selectedFields = append(selectedFields, testhealthreport.FieldShardCount)
+				fieldSeen[testhealthreport.FieldShardCount] = struct{}{}
+			}
+		case "maxDurationInMs":
+			if _, ok := fieldSeen[testhealthreport.FieldMaxDurationInMs]; !ok {
+				selectedFields = append(selectedFields, testhealthreport.FieldMaxDurationInMs)
+				fieldSeen[testhealthreport.FieldMaxDurationInMs] = struct{}{}
+			}
+		case "medianShardDurationInMs":
+			if _, ok := fieldSeen[testhealthreport.FieldMedianShardDurationInMs]; !ok {
+				selectedFields = append(selectedFields, testhealthreport.FieldMedianShardDurationInMs)
+				fieldSeen[testhealthreport.FieldMedianShardDurationInMs] = struct{}{}
+			}
+		case "timeoutInMs":
+			if _, ok := fieldSeen[testhealthreport.FieldTimeoutInMs]; !ok {
+				selectedFields = append(selectedFields, testhealthreport.FieldTimeoutInMs)
+				fieldSeen[testhealthreport.FieldTimeoutInMs] = struct{}{}
+			}
+		case "suggestedShardCount":
+			if _, ok := fieldSeen[testhealthreport.FieldSuggestedShardCount]; !ok {
+				selectedFields = append(selectedFields, testhealthreport.FieldSuggestedShardCount)
+				fieldSeen[testhealthreport.FieldSuggestedShardCount] = struct{}{}
+			}
+		case "suggestedSize":
+			if _, ok := fieldSeen[testhealthreport.FieldSuggestedSize]; !ok {
+				selectedFields = append(selectedFields, testhealthreport.FieldSuggestedSize)
+				fieldSeen[testhealthreport.FieldSuggestedSize] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		thr.Select(selectedFields...)
+	}
+	return nil
+}
+
+type testhealthreportPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []TestHealthReportPaginateOption
+}
+
+func newTestHealthReportPaginateArgs(rv map[string]any) *testhealthreportPaginateArgs {
+	args := &testhealthreportPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[whereField].(*TestHealthReportWhereInput); ok {
+		args.opts = append(args.opts, WithTestHealthReportFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (trb *TestResultBESQuery) CollectFields(ctx context.Context, satisfies ...string) (*TestResultBESQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -196,6 +196,18 @@ func (bi *BazelInvocation) Targets(ctx context.Context) (result []*TargetPair, e
 	return result, err
 }
 
+func (bi *BazelInvocation) TestHealthReports(ctx context.Context) (result []*TestHealthReport, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = bi.NamedTestHealthReports(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = bi.Edges.TestHealthReportsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = bi.QueryTestHealthReports().All(ctx)
+	}
+	return result, err
+}
+
 func (bip *BazelInvocationProblem) BazelInvocation(ctx context.Context) (*BazelInvocation, error) {
 	result, err := bip.Edges.BazelInvocationOrErr()
 	if IsNotLoaded(err) {
@@ -204,6 +216,26 @@ func (bip *BazelInvocationProblem) BazelInvocation(ctx context.Context) (*BazelI
 	return result, MaskNotFound(err)
 }
 
+func (bip *BazelInvocationProblem) KnownProblem(ctx context.Context) (*KnownProblem, error) {
+	result, err := bip.Edges.KnownProblemOrErr()
+	if IsNotLoaded(err) {
+		result, err = bip.QueryKnownProblem().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (bip *BazelInvocationProblem) Blobs(ctx context.Context) (result []*Blob, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = bip.NamedBlobs(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = bip.Edges.BlobsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = bip.QueryBlobs().All(ctx)
+	}
+	return result, err
+}
+
 func (b *Build) Invocations(ctx context.Context) (result []*BazelInvocation, err error) {
 	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
 		result, err = b.NamedInvocations(graphql.GetFieldContext(ctx).Field.Alias)
@@ -872,6 +904,14 @@ func (tf *TestFile) TestResult(ctx context.Context) (result []*TestResultBES, er
 	return result, err
 }
 
+func (thr *TestHealthReport) BazelInvocation(ctx context.Context) (*BazelInvocation, error) {
+	result, err := thr.Edges.BazelInvocationOrErr()
+	if IsNotLoaded(err) {
+		result, err = thr.QueryBazelInvocation().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
 func (trb *TestResultBES) TestCollection(ctx context.Context) (*TestCollection, error) {
 	result, err := trb.Edges.TestCollectionOrErr()
 	if IsNotLoaded(err) {
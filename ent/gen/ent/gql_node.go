@@ -29,6 +29,7 @@ import (
 	"github.com/buildbarn/bb-portal/ent/gen/ent/exectioninfo"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/filesmetric"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/garbagemetrics"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/knownproblem"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/memorymetrics"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/metrics"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/missdetail"
@@ -47,6 +48,7 @@ import (
 	"github.com/buildbarn/bb-portal/ent/gen/ent/targetpair"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testcollection"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testfile"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/testhealthreport"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testresultbes"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testsummary"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/timingbreakdown"
@@ -141,6 +143,11 @@ var garbagemetricsImplementors = []string{"GarbageMetrics", "Node"}
 // IsNode implements the Node interface check for GQLGen.
 func (*GarbageMetrics) IsNode() {}
 
+var knownproblemImplementors = []string{"KnownProblem", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*KnownProblem) IsNode() {}
+
 var memorymetricsImplementors = []string{"MemoryMetrics", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
@@ -231,6 +238,11 @@ var testfileImplementors = []string{"TestFile", "Node"}
 // IsNode implements the Node interface check for GQLGen.
 func (*TestFile) IsNode() {}
 
+var testhealthreportImplementors = []string{"TestHealthReport", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*TestHealthReport) IsNode() {}
+
 var testresultbesImplementors = []string{"TestResultBES", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
@@ -458,6 +470,15 @@ func (c *Client) noder(ctx context.Context, table string, id int) (Noder, error)
 			}
 		}
 		return query.Only(ctx)
+	case knownproblem.Table:
+		query := c.KnownProblem.Query().
+			Where(knownproblem.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, knownproblemImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case memorymetrics.Table:
 		query := c.MemoryMetrics.Query().
 			Where(memorymetrics.ID(id))
@@ -620,6 +641,15 @@ func (c *Client) noder(ctx context.Context, table string, id int) (Noder, error)
 			}
 		}
 		return query.Only(ctx)
+	case testhealthreport.Table:
+		query := c.TestHealthReport.Query().
+			Where(testhealthreport.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, testhealthreportImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case testresultbes.Table:
 		query := c.TestResultBES.Query().
 			Where(testresultbes.ID(id))
@@ -994,6 +1024,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []int) ([]Noder,
 				*noder = node
 			}
 		}
+	case knownproblem.Table:
+		query := c.KnownProblem.Query().
+			Where(knownproblem.IDIn(ids...))
+		query, err := query.CollectFields(ctx, knownproblemImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case memorymetrics.Table:
 		query := c.MemoryMetrics.Query().
 			Where(memorymetrics.IDIn(ids...))
@@ -1282,6 +1328,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []int) ([]Noder,
 				*noder = node
 			}
 		}
+	case testhealthreport.Table:
+		query := c.TestHealthReport.Query().
+			Where(testhealthreport.IDIn(ids...))
+		query, err := query.CollectFields(ctx, testhealthreportImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case testresultbes.Table:
 		query := c.TestResultBES.Query().
 			Where(testresultbes.IDIn(ids...))
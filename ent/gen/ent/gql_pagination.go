@@ -27,6 +27,7 @@ import (
 	"github.com/buildbarn/bb-portal/ent/gen/ent/exectioninfo"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/filesmetric"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/garbagemetrics"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/knownproblem"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/memorymetrics"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/metrics"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/missdetail"
@@ -45,6 +46,7 @@ import (
 	"github.com/buildbarn/bb-portal/ent/gen/ent/targetpair"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testcollection"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testfile"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/testhealthreport"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testresultbes"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testsummary"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/timingbreakdown"
@@ -4117,6 +4119,255 @@ func (gm *GarbageMetrics) ToEdge(order *GarbageMetricsOrder) *GarbageMetricsEdge
 	}
 }
 
+// KnownProblemEdge is the edge representation of KnownProblem.
+type KnownProblemEdge struct {
+	Node   *KnownProblem `json:"node"`
+	Cursor Cursor        `json:"cursor"`
+}
+
+// KnownProblemConnection is the connection containing edges to KnownProblem.
+type KnownProblemConnection struct {
+	Edges      []*KnownProblemEdge `json:"edges"`
+	PageInfo   PageInfo            `json:"pageInfo"`
+	TotalCount int                 `json:"totalCount"`
+}
+
+func (c *KnownProblemConnection) build(nodes []*KnownProblem, pager *knownproblemPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *KnownProblem
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *KnownProblem {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *KnownProblem {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*KnownProblemEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &KnownProblemEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// KnownProblemPaginateOption enables pagination customization.
+type KnownProblemPaginateOption func(*knownproblemPager) error
+
+// WithKnownProblemOrder configures pagination ordering.
+func WithKnownProblemOrder(order *KnownProblemOrder) KnownProblemPaginateOption {
+	if order == nil {
+		order = DefaultKnownProblemOrder
+	}
+	o := *order
+	return func(pager *knownproblemPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultKnownProblemOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithKnownProblemFilter configures pagination filter.
+func WithKnownProblemFilter(filter func(*KnownProblemQuery) (*KnownProblemQuery, error)) KnownProblemPaginateOption {
+	return func(pager *knownproblemPager) error {
+		if filter == nil {
+			return errors.New("KnownProblemQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type knownproblemPager struct {
+	reverse bool
+	order   *KnownProblemOrder
+	filter  func(*KnownProblemQuery) (*KnownProblemQuery, error)
+}
+
+func newKnownProblemPager(opts []KnownProblemPaginateOption, reverse bool) (*knownproblemPager, error) {
+	pager := &knownproblemPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultKnownProblemOrder
+	}
+	return pager, nil
+}
+
+func (p *knownproblemPager) applyFilter(query *KnownProblemQuery) (*KnownProblemQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *knownproblemPager) toCursor(kp *KnownProblem) Cursor {
+	return p.order.Field.toCursor(kp)
+}
+
+func (p *knownproblemPager) applyCursors(query *KnownProblemQuery, after, before *Cursor) (*KnownProblemQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultKnownProblemOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *knownproblemPager) applyOrder(query *KnownProblemQuery) *KnownProblemQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultKnownProblemOrder.Field {
+		query = query.Order(DefaultKnownProblemOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *knownproblemPager) orderExpr(query *KnownProblemQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultKnownProblemOrder.Field {
+			b.Comma().Ident(DefaultKnownProblemOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to KnownProblem.
+func (kp *KnownProblemQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...KnownProblemPaginateOption,
+) (*KnownProblemConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newKnownProblemPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if kp, err = pager.applyFilter(kp); err != nil {
+		return nil, err
+	}
+	conn := &KnownProblemConnection{Edges: []*KnownProblemEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := kp.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if kp, err = pager.applyCursors(kp, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		kp.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := kp.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	kp = pager.applyOrder(kp)
+	nodes, err := kp.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// KnownProblemOrderField defines the ordering field of KnownProblem.
+type KnownProblemOrderField struct {
+	// Value extracts the ordering value from the given KnownProblem.
+	Value    func(*KnownProblem) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) knownproblem.OrderOption
+	toCursor func(*KnownProblem) Cursor
+}
+
+// KnownProblemOrder defines the ordering of KnownProblem.
+type KnownProblemOrder struct {
+	Direction OrderDirection          `json:"direction"`
+	Field     *KnownProblemOrderField `json:"field"`
+}
+
+// DefaultKnownProblemOrder is the default ordering of KnownProblem.
+var DefaultKnownProblemOrder = &KnownProblemOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &KnownProblemOrderField{
+		Value: func(kp *KnownProblem) (ent.Value, error) {
+			return kp.ID, nil
+		},
+		column: knownproblem.FieldID,
+		toTerm: knownproblem.ByID,
+		toCursor: func(kp *KnownProblem) Cursor {
+			return Cursor{ID: kp.ID}
+		},
+	},
+}
+
+// ToEdge converts KnownProblem into KnownProblemEdge.
+func (kp *KnownProblem) ToEdge(order *KnownProblemOrder) *KnownProblemEdge {
+	if order == nil {
+		order = DefaultKnownProblemOrder
+	}
+	return &KnownProblemEdge{
+		Node:   kp,
+		Cursor: order.Field.toCursor(kp),
+	}
+}
+
 // MemoryMetricsEdge is the edge representation of MemoryMetrics.
 type MemoryMetricsEdge struct {
 	Node   *MemoryMetrics `json:"node"`
@@ -8599,6 +8850,255 @@ func (tf *TestFile) ToEdge(order *TestFileOrder) *TestFileEdge {
 	}
 }
 
+// TestHealthReportEdge is the edge representation of TestHealthReport.
+type TestHealthReportEdge struct {
+	Node   *TestHealthReport `json:"node"`
+	Cursor Cursor            `json:"cursor"`
+}
+
+// TestHealthReportConnection is the connection containing edges to TestHealthReport.
+type TestHealthReportConnection struct {
+	Edges      []*TestHealthReportEdge `json:"edges"`
+	PageInfo   PageInfo                `json:"pageInfo"`
+	TotalCount int                     `json:"totalCount"`
+}
+
+func (c *TestHealthReportConnection) build(nodes []*TestHealthReport, pager *testhealthreportPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *TestHealthReport
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *TestHealthReport {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *TestHealthReport {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*TestHealthReportEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &TestHealthReportEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// TestHealthReportPaginateOption enables pagination customization.
+type TestHealthReportPaginateOption func(*testhealthreportPager) error
+
+// WithTestHealthReportOrder configures pagination ordering.
+func WithTestHealthReportOrder(order *TestHealthReportOrder) TestHealthReportPaginateOption {
+	if order == nil {
+		order = DefaultTestHealthReportOrder
+	}
+	o := *order
+	return func(pager *testhealthreportPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultTestHealthReportOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithTestHealthReportFilter configures pagination filter.
+func WithTestHealthReportFilter(filter func(*TestHealthReportQuery) (*TestHealthReportQuery, error)) TestHealthReportPaginateOption {
+	return func(pager *testhealthreportPager) error {
+		if filter == nil {
+			return errors.New("TestHealthReportQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type testhealthreportPager struct {
+	reverse bool
+	order   *TestHealthReportOrder
+	filter  func(*TestHealthReportQuery) (*TestHealthReportQuery, error)
+}
+
+func newTestHealthReportPager(opts []TestHealthReportPaginateOption, reverse bool) (*testhealthreportPager, error) {
+	pager := &testhealthreportPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultTestHealthReportOrder
+	}
+	return pager, nil
+}
+
+func (p *testhealthreportPager) applyFilter(query *TestHealthReportQuery) (*TestHealthReportQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *testhealthreportPager) toCursor(thr *TestHealthReport) Cursor {
+	return p.order.Field.toCursor(thr)
+}
+
+func (p *testhealthreportPager) applyCursors(query *TestHealthReportQuery, after, before *Cursor) (*TestHealthReportQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultTestHealthReportOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *testhealthreportPager) applyOrder(query *TestHealthReportQuery) *TestHealthReportQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultTestHealthReportOrder.Field {
+		query = query.Order(DefaultTestHealthReportOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *testhealthreportPager) orderExpr(query *TestHealthReportQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultTestHealthReportOrder.Field {
+			b.Comma().Ident(DefaultTestHealthReportOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to TestHealthReport.
+func (thr *TestHealthReportQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...TestHealthReportPaginateOption,
+) (*TestHealthReportConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newTestHealthReportPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if thr, err = pager.applyFilter(thr); err != nil {
+		return nil, err
+	}
+	conn := &TestHealthReportConnection{Edges: []*TestHealthReportEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := thr.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if thr, err = pager.applyCursors(thr, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		thr.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := thr.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	thr = pager.applyOrder(thr)
+	nodes, err := thr.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// TestHealthReportOrderField defines the ordering field of TestHealthReport.
+type TestHealthReportOrderField struct {
+	// Value extracts the ordering value from the given TestHealthReport.
+	Value    func(*TestHealthReport) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) testhealthreport.OrderOption
+	toCursor func(*TestHealthReport) Cursor
+}
+
+// TestHealthReportOrder defines the ordering of TestHealthReport.
+type TestHealthReportOrder struct {
+	Direction OrderDirection              `json:"direction"`
+	Field     *TestHealthReportOrderField `json:"field"`
+}
+
+// DefaultTestHealthReportOrder is the default ordering of TestHealthReport.
+var DefaultTestHealthReportOrder = &TestHealthReportOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &TestHealthReportOrderField{
+		Value: func(thr *TestHealthReport) (ent.Value, error) {
+			return thr.ID, nil
+		},
+		column: testhealthreport.FieldID,
+		toTerm: testhealthreport.ByID,
+		toCursor: func(thr *TestHealthReport) Cursor {
+			return Cursor{ID: thr.ID}
+		},
+	},
+}
+
+// ToEdge converts TestHealthReport into TestHealthReportEdge.
+func (thr *TestHealthReport) ToEdge(order *TestHealthReportOrder) *TestHealthReportEdge {
+	if order == nil {
+		order = DefaultTestHealthReportOrder
+	}
+	return &TestHealthReportEdge{
+		Node:   thr,
+		Cursor: order.Field.toCursor(thr),
+	}
+}
+
 // TestResultBESEdge is the edge representation of TestResultBES.
 type TestResultBESEdge struct {
 	Node   *TestResultBES `json:"node"`
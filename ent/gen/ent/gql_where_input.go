@@ -23,6 +23,7 @@ import (
 	"github.com/buildbarn/bb-portal/ent/gen/ent/exectioninfo"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/filesmetric"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/garbagemetrics"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/knownproblem"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/memorymetrics"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/metrics"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/missdetail"
@@ -42,6 +43,7 @@ import (
 	"github.com/buildbarn/bb-portal/ent/gen/ent/targetpair"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testcollection"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testfile"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/testhealthreport"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testresultbes"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testsummary"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/timingbreakdown"
@@ -1710,6 +1712,52 @@ type BazelInvocationWhereInput struct {
 	NumFetchesIsNil  bool    `json:"numFetchesIsNil,omitempty"`
 	NumFetchesNotNil bool    `json:"numFetchesNotNil,omitempty"`
 
+	// "branch" field predicates.
+	Branch             *string  `json:"branch,omitempty"`
+	BranchNEQ          *string  `json:"branchNEQ,omitempty"`
+	BranchIn           []string `json:"branchIn,omitempty"`
+	BranchNotIn        []string `json:"branchNotIn,omitempty"`
+	BranchGT           *string  `json:"branchGT,omitempty"`
+	BranchGTE          *string  `json:"branchGTE,omitempty"`
+	BranchLT           *string  `json:"branchLT,omitempty"`
+	BranchLTE          *string  `json:"branchLTE,omitempty"`
+	BranchContains     *string  `json:"branchContains,omitempty"`
+	BranchHasPrefix    *string  `json:"branchHasPrefix,omitempty"`
+	BranchHasSuffix    *string  `json:"branchHasSuffix,omitempty"`
+	BranchIsNil        bool     `json:"branchIsNil,omitempty"`
+	BranchNotNil       bool     `json:"branchNotNil,omitempty"`
+	BranchEqualFold    *string  `json:"branchEqualFold,omitempty"`
+	BranchContainsFold *string  `json:"branchContainsFold,omitempty"`
+
+	// "commit" field predicates.
+	Commit             *string  `json:"commit,omitempty"`
+	CommitNEQ          *string  `json:"commitNEQ,omitempty"`
+	CommitIn           []string `json:"commitIn,omitempty"`
+	CommitNotIn        []string `json:"commitNotIn,omitempty"`
+	CommitGT           *string  `json:"commitGT,omitempty"`
+	CommitGTE          *string  `json:"commitGTE,omitempty"`
+	CommitLT           *string  `json:"commitLT,omitempty"`
+	CommitLTE          *string  `json:"commitLTE,omitempty"`
+	CommitContains     *string  `json:"commitContains,omitempty"`
+	CommitHasPrefix    *string  `json:"commitHasPrefix,omitempty"`
+	CommitHasSuffix    *string  `json:"commitHasSuffix,omitempty"`
+	CommitIsNil        bool     `json:"commitIsNil,omitempty"`
+	CommitNotNil       bool     `json:"commitNotNil,omitempty"`
+	CommitEqualFold    *string  `json:"commitEqualFold,omitempty"`
+	CommitContainsFold *string  `json:"commitContainsFold,omitempty"`
+
+	// "failure_classification" field predicates.
+	FailureClassification       *bazelinvocation.FailureClassification  `json:"failureClassification,omitempty"`
+	FailureClassificationNEQ    *bazelinvocation.FailureClassification  `json:"failureClassificationNEQ,omitempty"`
+	FailureClassificationIn     []bazelinvocation.FailureClassification `json:"failureClassificationIn,omitempty"`
+	FailureClassificationNotIn  []bazelinvocation.FailureClassification `json:"failureClassificationNotIn,omitempty"`
+	FailureClassificationIsNil  bool                                    `json:"failureClassificationIsNil,omitempty"`
+	FailureClassificationNotNil bool                                    `json:"failureClassificationNotNil,omitempty"`
+
+	// "pinned" field predicates.
+	Pinned    *bool `json:"pinned,omitempty"`
+	PinnedNEQ *bool `json:"pinnedNEQ,omitempty"`
+
 	// "event_file" edge predicates.
 	HasEventFile     *bool                  `json:"hasEventFile,omitempty"`
 	HasEventFileWith []*EventFileWhereInput `json:"hasEventFileWith,omitempty"`
@@ -1733,6 +1781,10 @@ type BazelInvocationWhereInput struct {
 	// "targets" edge predicates.
 	HasTargets     *bool                   `json:"hasTargets,omitempty"`
 	HasTargetsWith []*TargetPairWhereInput `json:"hasTargetsWith,omitempty"`
+
+	// "test_health_reports" edge predicates.
+	HasTestHealthReports     *bool                         `json:"hasTestHealthReports,omitempty"`
+	HasTestHealthReportsWith []*TestHealthReportWhereInput `json:"hasTestHealthReportsWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
@@ -2319,6 +2371,120 @@ func (i *BazelInvocationWhereInput) P() (predicate.BazelInvocation, error) {
 	if i.NumFetchesNotNil {
 		predicates = append(predicates, bazelinvocation.NumFetchesNotNil())
 	}
+	if i.Branch != nil {
+		predicates = append(predicates, bazelinvocation.BranchEQ(*i.Branch))
+	}
+	if i.BranchNEQ != nil {
+		predicates = append(predicates, bazelinvocation.BranchNEQ(*i.BranchNEQ))
+	}
+	if len(i.BranchIn) > 0 {
+		predicates = append(predicates, bazelinvocation.BranchIn(i.BranchIn...))
+	}
+	if len(i.BranchNotIn) > 0 {
+		predicates = append(predicates, bazelinvocation.BranchNotIn(i.BranchNotIn...))
+	}
+	if i.BranchGT != nil {
+		predicates = append(predicates, bazelinvocation.BranchGT(*i.BranchGT))
+	}
+	if i.BranchGTE != nil {
+		predicates = append(predicates, bazelinvocation.BranchGTE(*i.BranchGTE))
+	}
+	if i.BranchLT != nil {
+		predicates = append(predicates, bazelinvocation.BranchLT(*i.BranchLT))
+	}
+	if i.BranchLTE != nil {
+		predicates = append(predicates, bazelinvocation.BranchLTE(*i.BranchLTE))
+	}
+	if i.BranchContains != nil {
+		predicates = append(predicates, bazelinvocation.BranchContains(*i.BranchContains))
+	}
+	if i.BranchHasPrefix != nil {
+		predicates = append(predicates, bazelinvocation.BranchHasPrefix(*i.BranchHasPrefix))
+	}
+	if i.BranchHasSuffix != nil {
+		predicates = append(predicates, bazelinvocation.BranchHasSuffix(*i.BranchHasSuffix))
+	}
+	if i.BranchIsNil {
+		predicates = append(predicates, bazelinvocation.BranchIsNil())
+	}
+	if i.BranchNotNil {
+		predicates = append(predicates, bazelinvocation.BranchNotNil())
+	}
+	if i.BranchEqualFold != nil {
+		predicates = append(predicates, bazelinvocation.BranchEqualFold(*i.BranchEqualFold))
+	}
+	if i.BranchContainsFold != nil {
+		predicates = append(predicates, bazelinvocation.BranchContainsFold(*i.BranchContainsFold))
+	}
+	if i.Commit != nil {
+		predicates = append(predicates, bazelinvocation.CommitEQ(*i.Commit))
+	}
+	if i.CommitNEQ != nil {
+		predicates = append(predicates, bazelinvocation.CommitNEQ(*i.CommitNEQ))
+	}
+	if len(i.CommitIn) > 0 {
+		predicates = append(predicates, bazelinvocation.CommitIn(i.CommitIn...))
+	}
+	if len(i.CommitNotIn) > 0 {
+		predicates = append(predicates, bazelinvocation.CommitNotIn(i.CommitNotIn...))
+	}
+	if i.CommitGT != nil {
+		predicates = append(predicates, bazelinvocation.CommitGT(*i.CommitGT))
+	}
+	if i.CommitGTE != nil {
+		predicates = append(predicates, bazelinvocation.CommitGTE(*i.CommitGTE))
+	}
+	if i.CommitLT != nil {
+		predicates = append(predicates, bazelinvocation.CommitLT(*i.CommitLT))
+	}
+	if i.CommitLTE != nil {
+		predicates = append(predicates, bazelinvocation.CommitLTE(*i.CommitLTE))
+	}
+	if i.CommitContains != nil {
+		predicates = append(predicates, bazelinvocation.CommitContains(*i.CommitContains))
+	}
+	if i.CommitHasPrefix != nil {
+		predicates = append(predicates, bazelinvocation.CommitHasPrefix(*i.CommitHasPrefix))
+	}
+	if i.CommitHasSuffix != nil {
+		predicates = append(predicates, bazelinvocation.CommitHasSuffix(*i.CommitHasSuffix))
+	}
+	if i.CommitIsNil {
+		predicates = append(predicates, bazelinvocation.CommitIsNil())
+	}
+	if i.CommitNotNil {
+		predicates = append(predicates, bazelinvocation.CommitNotNil())
+	}
+	if i.CommitEqualFold != nil {
+		predicates = append(predicates, bazelinvocation.CommitEqualFold(*i.CommitEqualFold))
+	}
+	if i.CommitContainsFold != nil {
+		predicates = append(predicates, bazelinvocation.CommitContainsFold(*i.CommitContainsFold))
+	}
+	if i.FailureClassification != nil {
+		predicates = append(predicates, bazelinvocation.FailureClassificationEQ(*i.FailureClassification))
+	}
+	if i.FailureClassificationNEQ != nil {
+		predicates = append(predicates, bazelinvocation.FailureClassificationNEQ(*i.FailureClassificationNEQ))
+	}
+	if len(i.FailureClassificationIn) > 0 {
+		predicates = append(predicates, bazelinvocation.FailureClassificationIn(i.FailureClassificationIn...))
+	}
+	if len(i.FailureClassificationNotIn) > 0 {
+		predicates = append(predicates, bazelinvocation.FailureClassificationNotIn(i.FailureClassificationNotIn...))
+	}
+	if i.FailureClassificationIsNil {
+		predicates = append(predicates, bazelinvocation.FailureClassificationIsNil())
+	}
+	if i.FailureClassificationNotNil {
+		predicates = append(predicates, bazelinvocation.FailureClassificationNotNil())
+	}
+	if i.Pinned != nil {
+		predicates = append(predicates, bazelinvocation.PinnedEQ(*i.Pinned))
+	}
+	if i.PinnedNEQ != nil {
+		predicates = append(predicates, bazelinvocation.PinnedNEQ(*i.PinnedNEQ))
+	}
 
 	if i.HasEventFile != nil {
 		p := bazelinvocation.HasEventFile()
@@ -2428,6 +2594,24 @@ func (i *BazelInvocationWhereInput) P() (predicate.BazelInvocation, error) {
 		}
 		predicates = append(predicates, bazelinvocation.HasTargetsWith(with...))
 	}
+	if i.HasTestHealthReports != nil {
+		p := bazelinvocation.HasTestHealthReports()
+		if !*i.HasTestHealthReports {
+			p = bazelinvocation.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasTestHealthReportsWith) > 0 {
+		with := make([]predicate.TestHealthReport, 0, len(i.HasTestHealthReportsWith))
+		for _, w := range i.HasTestHealthReportsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasTestHealthReportsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, bazelinvocation.HasTestHealthReportsWith(with...))
+	}
 	switch len(predicates) {
 	case 0:
 		return nil, ErrEmptyBazelInvocationWhereInput
@@ -2485,9 +2669,48 @@ type BazelInvocationProblemWhereInput struct {
 	LabelEqualFold    *string  `json:"labelEqualFold,omitempty"`
 	LabelContainsFold *string  `json:"labelContainsFold,omitempty"`
 
+	// "fingerprint" field predicates.
+	Fingerprint             *string  `json:"fingerprint,omitempty"`
+	FingerprintNEQ          *string  `json:"fingerprintNEQ,omitempty"`
+	FingerprintIn           []string `json:"fingerprintIn,omitempty"`
+	FingerprintNotIn        []string `json:"fingerprintNotIn,omitempty"`
+	FingerprintGT           *string  `json:"fingerprintGT,omitempty"`
+	FingerprintGTE          *string  `json:"fingerprintGTE,omitempty"`
+	FingerprintLT           *string  `json:"fingerprintLT,omitempty"`
+	FingerprintLTE          *string  `json:"fingerprintLTE,omitempty"`
+	FingerprintContains     *string  `json:"fingerprintContains,omitempty"`
+	FingerprintHasPrefix    *string  `json:"fingerprintHasPrefix,omitempty"`
+	FingerprintHasSuffix    *string  `json:"fingerprintHasSuffix,omitempty"`
+	FingerprintIsNil        bool     `json:"fingerprintIsNil,omitempty"`
+	FingerprintNotNil       bool     `json:"fingerprintNotNil,omitempty"`
+	FingerprintEqualFold    *string  `json:"fingerprintEqualFold,omitempty"`
+	FingerprintContainsFold *string  `json:"fingerprintContainsFold,omitempty"`
+
+	// "failure_classification" field predicates.
+	FailureClassification       *bazelinvocationproblem.FailureClassification  `json:"failureClassification,omitempty"`
+	FailureClassificationNEQ    *bazelinvocationproblem.FailureClassification  `json:"failureClassificationNEQ,omitempty"`
+	FailureClassificationIn     []bazelinvocationproblem.FailureClassification `json:"failureClassificationIn,omitempty"`
+	FailureClassificationNotIn  []bazelinvocationproblem.FailureClassification `json:"failureClassificationNotIn,omitempty"`
+	FailureClassificationIsNil  bool                                           `json:"failureClassificationIsNil,omitempty"`
+	FailureClassificationNotNil bool                                           `json:"failureClassificationNotNil,omitempty"`
+
+	// "newly_failing" field predicates.
+	NewlyFailing       *bool `json:"newlyFailing,omitempty"`
+	NewlyFailingNEQ    *bool `json:"newlyFailingNEQ,omitempty"`
+	NewlyFailingIsNil  bool  `json:"newlyFailingIsNil,omitempty"`
+	NewlyFailingNotNil bool  `json:"newlyFailingNotNil,omitempty"`
+
 	// "bazel_invocation" edge predicates.
 	HasBazelInvocation     *bool                        `json:"hasBazelInvocation,omitempty"`
 	HasBazelInvocationWith []*BazelInvocationWhereInput `json:"hasBazelInvocationWith,omitempty"`
+
+	// "known_problem" edge predicates.
+	HasKnownProblem     *bool                     `json:"hasKnownProblem,omitempty"`
+	HasKnownProblemWith []*KnownProblemWhereInput `json:"hasKnownProblemWith,omitempty"`
+
+	// "blobs" edge predicates.
+	HasBlobs     *bool             `json:"hasBlobs,omitempty"`
+	HasBlobsWith []*BlobWhereInput `json:"hasBlobsWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
@@ -2663,6 +2886,81 @@ func (i *BazelInvocationProblemWhereInput) P() (predicate.BazelInvocationProblem
 	if i.LabelContainsFold != nil {
 		predicates = append(predicates, bazelinvocationproblem.LabelContainsFold(*i.LabelContainsFold))
 	}
+	if i.Fingerprint != nil {
+		predicates = append(predicates, bazelinvocationproblem.FingerprintEQ(*i.Fingerprint))
+	}
+	if i.FingerprintNEQ != nil {
+		predicates = append(predicates, bazelinvocationproblem.FingerprintNEQ(*i.FingerprintNEQ))
+	}
+	if len(i.FingerprintIn) > 0 {
+		predicates = append(predicates, bazelinvocationproblem.FingerprintIn(i.FingerprintIn...))
+	}
+	if len(i.FingerprintNotIn) > 0 {
+		predicates = append(predicates, bazelinvocationproblem.FingerprintNotIn(i.FingerprintNotIn...))
+	}
+	if i.FingerprintGT != nil {
+		predicates = append(predicates, bazelinvocationproblem.FingerprintGT(*i.FingerprintGT))
+	}
+	if i.FingerprintGTE != nil {
+		predicates = append(predicates, bazelinvocationproblem.FingerprintGTE(*i.FingerprintGTE))
+	}
+	if i.FingerprintLT != nil {
+		predicates = append(predicates, bazelinvocationproblem.FingerprintLT(*i.FingerprintLT))
+	}
+	if i.FingerprintLTE != nil {
+		predicates = append(predicates, bazelinvocationproblem.FingerprintLTE(*i.FingerprintLTE))
+	}
+	if i.FingerprintContains != nil {
+		predicates = append(predicates, bazelinvocationproblem.FingerprintContains(*i.FingerprintContains))
+	}
+	if i.FingerprintHasPrefix != nil {
+		predicates = append(predicates, bazelinvocationproblem.FingerprintHasPrefix(*i.FingerprintHasPrefix))
+	}
+	if i.FingerprintHasSuffix != nil {
+		predicates = append(predicates, bazelinvocationproblem.FingerprintHasSuffix(*i.FingerprintHasSuffix))
+	}
+	if i.FingerprintIsNil {
+		predicates = append(predicates, bazelinvocationproblem.FingerprintIsNil())
+	}
+	if i.FingerprintNotNil {
+		predicates = append(predicates, bazelinvocationproblem.FingerprintNotNil())
+	}
+	if i.FingerprintEqualFold != nil {
+		predicates = append(predicates, bazelinvocationproblem.FingerprintEqualFold(*i.FingerprintEqualFold))
+	}
+	if i.FingerprintContainsFold != nil {
+		predicates = append(predicates, bazelinvocationproblem.FingerprintContainsFold(*i.FingerprintContainsFold))
+	}
+	if i.FailureClassification != nil {
+		predicates = append(predicates, bazelinvocationproblem.FailureClassificationEQ(*i.FailureClassification))
+	}
+	if i.FailureClassificationNEQ != nil {
+		predicates = append(predicates, bazelinvocationproblem.FailureClassificationNEQ(*i.FailureClassificationNEQ))
+	}
+	if len(i.FailureClassificationIn) > 0 {
+		predicates = append(predicates, bazelinvocationproblem.FailureClassificationIn(i.FailureClassificationIn...))
+	}
+	if len(i.FailureClassificationNotIn) > 0 {
+		predicates = append(predicates, bazelinvocationproblem.FailureClassificationNotIn(i.FailureClassificationNotIn...))
+	}
+	if i.FailureClassificationIsNil {
+		predicates = append(predicates, bazelinvocationproblem.FailureClassificationIsNil())
+	}
+	if i.FailureClassificationNotNil {
+		predicates = append(predicates, bazelinvocationproblem.FailureClassificationNotNil())
+	}
+	if i.NewlyFailing != nil {
+		predicates = append(predicates, bazelinvocationproblem.NewlyFailingEQ(*i.NewlyFailing))
+	}
+	if i.NewlyFailingNEQ != nil {
+		predicates = append(predicates, bazelinvocationproblem.NewlyFailingNEQ(*i.NewlyFailingNEQ))
+	}
+	if i.NewlyFailingIsNil {
+		predicates = append(predicates, bazelinvocationproblem.NewlyFailingIsNil())
+	}
+	if i.NewlyFailingNotNil {
+		predicates = append(predicates, bazelinvocationproblem.NewlyFailingNotNil())
+	}
 
 	if i.HasBazelInvocation != nil {
 		p := bazelinvocationproblem.HasBazelInvocation()
@@ -2682,6 +2980,42 @@ func (i *BazelInvocationProblemWhereInput) P() (predicate.BazelInvocationProblem
 		}
 		predicates = append(predicates, bazelinvocationproblem.HasBazelInvocationWith(with...))
 	}
+	if i.HasKnownProblem != nil {
+		p := bazelinvocationproblem.HasKnownProblem()
+		if !*i.HasKnownProblem {
+			p = bazelinvocationproblem.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasKnownProblemWith) > 0 {
+		with := make([]predicate.KnownProblem, 0, len(i.HasKnownProblemWith))
+		for _, w := range i.HasKnownProblemWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasKnownProblemWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, bazelinvocationproblem.HasKnownProblemWith(with...))
+	}
+	if i.HasBlobs != nil {
+		p := bazelinvocationproblem.HasBlobs()
+		if !*i.HasBlobs {
+			p = bazelinvocationproblem.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasBlobsWith) > 0 {
+		with := make([]predicate.Blob, 0, len(i.HasBlobsWith))
+		for _, w := range i.HasBlobsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasBlobsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, bazelinvocationproblem.HasBlobsWith(with...))
+	}
 	switch len(predicates) {
 	case 0:
 		return nil, ErrEmptyBazelInvocationProblemWhereInput
@@ -2724,6 +3058,23 @@ type BlobWhereInput struct {
 	URIEqualFold    *string  `json:"uriEqualFold,omitempty"`
 	URIContainsFold *string  `json:"uriContainsFold,omitempty"`
 
+	// "key" field predicates.
+	Key             *string  `json:"key,omitempty"`
+	KeyNEQ          *string  `json:"keyNEQ,omitempty"`
+	KeyIn           []string `json:"keyIn,omitempty"`
+	KeyNotIn        []string `json:"keyNotIn,omitempty"`
+	KeyGT           *string  `json:"keyGT,omitempty"`
+	KeyGTE          *string  `json:"keyGTE,omitempty"`
+	KeyLT           *string  `json:"keyLT,omitempty"`
+	KeyLTE          *string  `json:"keyLTE,omitempty"`
+	KeyContains     *string  `json:"keyContains,omitempty"`
+	KeyHasPrefix    *string  `json:"keyHasPrefix,omitempty"`
+	KeyHasSuffix    *string  `json:"keyHasSuffix,omitempty"`
+	KeyIsNil        bool     `json:"keyIsNil,omitempty"`
+	KeyNotNil       bool     `json:"keyNotNil,omitempty"`
+	KeyEqualFold    *string  `json:"keyEqualFold,omitempty"`
+	KeyContainsFold *string  `json:"keyContainsFold,omitempty"`
+
 	// "size_bytes" field predicates.
 	SizeBytes       *int64  `json:"sizeBytes,omitempty"`
 	SizeBytesNEQ    *int64  `json:"sizeBytesNEQ,omitempty"`
@@ -2911,6 +3262,51 @@ func (i *BlobWhereInput) P() (predicate.Blob, error) {
 	if i.URIContainsFold != nil {
 		predicates = append(predicates, blob.URIContainsFold(*i.URIContainsFold))
 	}
+	if i.Key != nil {
+		predicates = append(predicates, blob.KeyEQ(*i.Key))
+	}
+	if i.KeyNEQ != nil {
+		predicates = append(predicates, blob.KeyNEQ(*i.KeyNEQ))
+	}
+	if len(i.KeyIn) > 0 {
+		predicates = append(predicates, blob.KeyIn(i.KeyIn...))
+	}
+	if len(i.KeyNotIn) > 0 {
+		predicates = append(predicates, blob.KeyNotIn(i.KeyNotIn...))
+	}
+	if i.KeyGT != nil {
+		predicates = append(predicates, blob.KeyGT(*i.KeyGT))
+	}
+	if i.KeyGTE != nil {
+		predicates = append(predicates, blob.KeyGTE(*i.KeyGTE))
+	}
+	if i.KeyLT != nil {
+		predicates = append(predicates, blob.KeyLT(*i.KeyLT))
+	}
+	if i.KeyLTE != nil {
+		predicates = append(predicates, blob.KeyLTE(*i.KeyLTE))
+	}
+	if i.KeyContains != nil {
+		predicates = append(predicates, blob.KeyContains(*i.KeyContains))
+	}
+	if i.KeyHasPrefix != nil {
+		predicates = append(predicates, blob.KeyHasPrefix(*i.KeyHasPrefix))
+	}
+	if i.KeyHasSuffix != nil {
+		predicates = append(predicates, blob.KeyHasSuffix(*i.KeyHasSuffix))
+	}
+	if i.KeyIsNil {
+		predicates = append(predicates, blob.KeyIsNil())
+	}
+	if i.KeyNotNil {
+		predicates = append(predicates, blob.KeyNotNil())
+	}
+	if i.KeyEqualFold != nil {
+		predicates = append(predicates, blob.KeyEqualFold(*i.KeyEqualFold))
+	}
+	if i.KeyContainsFold != nil {
+		predicates = append(predicates, blob.KeyContainsFold(*i.KeyContainsFold))
+	}
 	if i.SizeBytes != nil {
 		predicates = append(predicates, blob.SizeBytesEQ(*i.SizeBytes))
 	}
@@ -5871,56 +6267,466 @@ func (i *GarbageMetricsWhereInput) P() (predicate.GarbageMetrics, error) {
 	if i.GarbageCollectedNEQ != nil {
 		predicates = append(predicates, garbagemetrics.GarbageCollectedNEQ(*i.GarbageCollectedNEQ))
 	}
-	if len(i.GarbageCollectedIn) > 0 {
-		predicates = append(predicates, garbagemetrics.GarbageCollectedIn(i.GarbageCollectedIn...))
+	if len(i.GarbageCollectedIn) > 0 {
+		predicates = append(predicates, garbagemetrics.GarbageCollectedIn(i.GarbageCollectedIn...))
+	}
+	if len(i.GarbageCollectedNotIn) > 0 {
+		predicates = append(predicates, garbagemetrics.GarbageCollectedNotIn(i.GarbageCollectedNotIn...))
+	}
+	if i.GarbageCollectedGT != nil {
+		predicates = append(predicates, garbagemetrics.GarbageCollectedGT(*i.GarbageCollectedGT))
+	}
+	if i.GarbageCollectedGTE != nil {
+		predicates = append(predicates, garbagemetrics.GarbageCollectedGTE(*i.GarbageCollectedGTE))
+	}
+	if i.GarbageCollectedLT != nil {
+		predicates = append(predicates, garbagemetrics.GarbageCollectedLT(*i.GarbageCollectedLT))
+	}
+	if i.GarbageCollectedLTE != nil {
+		predicates = append(predicates, garbagemetrics.GarbageCollectedLTE(*i.GarbageCollectedLTE))
+	}
+	if i.GarbageCollectedIsNil {
+		predicates = append(predicates, garbagemetrics.GarbageCollectedIsNil())
+	}
+	if i.GarbageCollectedNotNil {
+		predicates = append(predicates, garbagemetrics.GarbageCollectedNotNil())
+	}
+
+	if i.HasMemoryMetrics != nil {
+		p := garbagemetrics.HasMemoryMetrics()
+		if !*i.HasMemoryMetrics {
+			p = garbagemetrics.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasMemoryMetricsWith) > 0 {
+		with := make([]predicate.MemoryMetrics, 0, len(i.HasMemoryMetricsWith))
+		for _, w := range i.HasMemoryMetricsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasMemoryMetricsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, garbagemetrics.HasMemoryMetricsWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyGarbageMetricsWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return garbagemetrics.And(predicates...), nil
+	}
+}
+
+// KnownProblemWhereInput represents a where input for filtering KnownProblem queries.
+type KnownProblemWhereInput struct {
+	Predicates []predicate.KnownProblem  `json:"-"`
+	Not        *KnownProblemWhereInput   `json:"not,omitempty"`
+	Or         []*KnownProblemWhereInput `json:"or,omitempty"`
+	And        []*KnownProblemWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "fingerprint" field predicates.
+	Fingerprint             *string  `json:"fingerprint,omitempty"`
+	FingerprintNEQ          *string  `json:"fingerprintNEQ,omitempty"`
+	FingerprintIn           []string `json:"fingerprintIn,omitempty"`
+	FingerprintNotIn        []string `json:"fingerprintNotIn,omitempty"`
+	FingerprintGT           *string  `json:"fingerprintGT,omitempty"`
+	FingerprintGTE          *string  `json:"fingerprintGTE,omitempty"`
+	FingerprintLT           *string  `json:"fingerprintLT,omitempty"`
+	FingerprintLTE          *string  `json:"fingerprintLTE,omitempty"`
+	FingerprintContains     *string  `json:"fingerprintContains,omitempty"`
+	FingerprintHasPrefix    *string  `json:"fingerprintHasPrefix,omitempty"`
+	FingerprintHasSuffix    *string  `json:"fingerprintHasSuffix,omitempty"`
+	FingerprintEqualFold    *string  `json:"fingerprintEqualFold,omitempty"`
+	FingerprintContainsFold *string  `json:"fingerprintContainsFold,omitempty"`
+
+	// "problem_type" field predicates.
+	ProblemType             *string  `json:"problemType,omitempty"`
+	ProblemTypeNEQ          *string  `json:"problemTypeNEQ,omitempty"`
+	ProblemTypeIn           []string `json:"problemTypeIn,omitempty"`
+	ProblemTypeNotIn        []string `json:"problemTypeNotIn,omitempty"`
+	ProblemTypeGT           *string  `json:"problemTypeGT,omitempty"`
+	ProblemTypeGTE          *string  `json:"problemTypeGTE,omitempty"`
+	ProblemTypeLT           *string  `json:"problemTypeLT,omitempty"`
+	ProblemTypeLTE          *string  `json:"problemTypeLTE,omitempty"`
+	ProblemTypeContains     *string  `json:"problemTypeContains,omitempty"`
+	ProblemTypeHasPrefix    *string  `json:"problemTypeHasPrefix,omitempty"`
+	ProblemTypeHasSuffix    *string  `json:"problemTypeHasSuffix,omitempty"`
+	ProblemTypeEqualFold    *string  `json:"problemTypeEqualFold,omitempty"`
+	ProblemTypeContainsFold *string  `json:"problemTypeContainsFold,omitempty"`
+
+	// "label" field predicates.
+	Label             *string  `json:"label,omitempty"`
+	LabelNEQ          *string  `json:"labelNEQ,omitempty"`
+	LabelIn           []string `json:"labelIn,omitempty"`
+	LabelNotIn        []string `json:"labelNotIn,omitempty"`
+	LabelGT           *string  `json:"labelGT,omitempty"`
+	LabelGTE          *string  `json:"labelGTE,omitempty"`
+	LabelLT           *string  `json:"labelLT,omitempty"`
+	LabelLTE          *string  `json:"labelLTE,omitempty"`
+	LabelContains     *string  `json:"labelContains,omitempty"`
+	LabelHasPrefix    *string  `json:"labelHasPrefix,omitempty"`
+	LabelHasSuffix    *string  `json:"labelHasSuffix,omitempty"`
+	LabelEqualFold    *string  `json:"labelEqualFold,omitempty"`
+	LabelContainsFold *string  `json:"labelContainsFold,omitempty"`
+
+	// "first_seen" field predicates.
+	FirstSeen      *time.Time  `json:"firstSeen,omitempty"`
+	FirstSeenNEQ   *time.Time  `json:"firstSeenNEQ,omitempty"`
+	FirstSeenIn    []time.Time `json:"firstSeenIn,omitempty"`
+	FirstSeenNotIn []time.Time `json:"firstSeenNotIn,omitempty"`
+	FirstSeenGT    *time.Time  `json:"firstSeenGT,omitempty"`
+	FirstSeenGTE   *time.Time  `json:"firstSeenGTE,omitempty"`
+	FirstSeenLT    *time.Time  `json:"firstSeenLT,omitempty"`
+	FirstSeenLTE   *time.Time  `json:"firstSeenLTE,omitempty"`
+
+	// "last_seen" field predicates.
+	LastSeen      *time.Time  `json:"lastSeen,omitempty"`
+	LastSeenNEQ   *time.Time  `json:"lastSeenNEQ,omitempty"`
+	LastSeenIn    []time.Time `json:"lastSeenIn,omitempty"`
+	LastSeenNotIn []time.Time `json:"lastSeenNotIn,omitempty"`
+	LastSeenGT    *time.Time  `json:"lastSeenGT,omitempty"`
+	LastSeenGTE   *time.Time  `json:"lastSeenGTE,omitempty"`
+	LastSeenLT    *time.Time  `json:"lastSeenLT,omitempty"`
+	LastSeenLTE   *time.Time  `json:"lastSeenLTE,omitempty"`
+
+	// "occurrences" field predicates.
+	Occurrences      *int  `json:"occurrences,omitempty"`
+	OccurrencesNEQ   *int  `json:"occurrencesNEQ,omitempty"`
+	OccurrencesIn    []int `json:"occurrencesIn,omitempty"`
+	OccurrencesNotIn []int `json:"occurrencesNotIn,omitempty"`
+	OccurrencesGT    *int  `json:"occurrencesGT,omitempty"`
+	OccurrencesGTE   *int  `json:"occurrencesGTE,omitempty"`
+	OccurrencesLT    *int  `json:"occurrencesLT,omitempty"`
+	OccurrencesLTE   *int  `json:"occurrencesLTE,omitempty"`
+
+	// "problems" edge predicates.
+	HasProblems     *bool                               `json:"hasProblems,omitempty"`
+	HasProblemsWith []*BazelInvocationProblemWhereInput `json:"hasProblemsWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *KnownProblemWhereInput) AddPredicates(predicates ...predicate.KnownProblem) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the KnownProblemWhereInput filter on the KnownProblemQuery builder.
+func (i *KnownProblemWhereInput) Filter(q *KnownProblemQuery) (*KnownProblemQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyKnownProblemWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyKnownProblemWhereInput is returned in case the KnownProblemWhereInput is empty.
+var ErrEmptyKnownProblemWhereInput = errors.New("ent: empty predicate KnownProblemWhereInput")
+
+// P returns a predicate for filtering knownproblems.
+// An error is returned if the input is empty or invalid.
+func (i *KnownProblemWhereInput) P() (predicate.KnownProblem, error) {
+	var predicates []predicate.KnownProblem
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, knownproblem.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.KnownProblem, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, knownproblem.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.KnownProblem, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, knownproblem.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, knownproblem.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, knownproblem.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, knownproblem.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, knownproblem.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, knownproblem.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, knownproblem.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, knownproblem.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, knownproblem.IDLTE(*i.IDLTE))
+	}
+	if i.Fingerprint != nil {
+		predicates = append(predicates, knownproblem.FingerprintEQ(*i.Fingerprint))
+	}
+	if i.FingerprintNEQ != nil {
+		predicates = append(predicates, knownproblem.FingerprintNEQ(*i.FingerprintNEQ))
+	}
+	if len(i.FingerprintIn) > 0 {
+		predicates = append(predicates, knownproblem.FingerprintIn(i.FingerprintIn...))
+	}
+	if len(i.FingerprintNotIn) > 0 {
+		predicates = append(predicates, knownproblem.FingerprintNotIn(i.FingerprintNotIn...))
+	}
+	if i.FingerprintGT != nil {
+		predicates = append(predicates, knownproblem.FingerprintGT(*i.FingerprintGT))
+	}
+	if i.FingerprintGTE != nil {
+		predicates = append(predicates, knownproblem.FingerprintGTE(*i.FingerprintGTE))
+	}
+	if i.FingerprintLT != nil {
+		predicates = append(predicates, knownproblem.FingerprintLT(*i.FingerprintLT))
+	}
+	if i.FingerprintLTE != nil {
+		predicates = append(predicates, knownproblem.FingerprintLTE(*i.FingerprintLTE))
+	}
+	if i.FingerprintContains != nil {
+		predicates = append(predicates, knownproblem.FingerprintContains(*i.FingerprintContains))
+	}
+	if i.FingerprintHasPrefix != nil {
+		predicates = append(predicates, knownproblem.FingerprintHasPrefix(*i.FingerprintHasPrefix))
+	}
+	if i.FingerprintHasSuffix != nil {
+		predicates = append(predicates, knownproblem.FingerprintHasSuffix(*i.FingerprintHasSuffix))
+	}
+	if i.FingerprintEqualFold != nil {
+		predicates = append(predicates, knownproblem.FingerprintEqualFold(*i.FingerprintEqualFold))
+	}
+	if i.FingerprintContainsFold != nil {
+		predicates = append(predicates, knownproblem.FingerprintContainsFold(*i.FingerprintContainsFold))
+	}
+	if i.ProblemType != nil {
+		predicates = append(predicates, knownproblem.ProblemTypeEQ(*i.ProblemType))
+	}
+	if i.ProblemTypeNEQ != nil {
+		predicates = append(predicates, knownproblem.ProblemTypeNEQ(*i.ProblemTypeNEQ))
+	}
+	if len(i.ProblemTypeIn) > 0 {
+		predicates = append(predicates, knownproblem.ProblemTypeIn(i.ProblemTypeIn...))
+	}
+	if len(i.ProblemTypeNotIn) > 0 {
+		predicates = append(predicates, knownproblem.ProblemTypeNotIn(i.ProblemTypeNotIn...))
+	}
+	if i.ProblemTypeGT != nil {
+		predicates = append(predicates, knownproblem.ProblemTypeGT(*i.ProblemTypeGT))
+	}
+	if i.ProblemTypeGTE != nil {
+		predicates = append(predicates, knownproblem.ProblemTypeGTE(*i.ProblemTypeGTE))
+	}
+	if i.ProblemTypeLT != nil {
+		predicates = append(predicates, knownproblem.ProblemTypeLT(*i.ProblemTypeLT))
+	}
+	if i.ProblemTypeLTE != nil {
+		predicates = append(predicates, knownproblem.ProblemTypeLTE(*i.ProblemTypeLTE))
+	}
+	if i.ProblemTypeContains != nil {
+		predicates = append(predicates, knownproblem.ProblemTypeContains(*i.ProblemTypeContains))
+	}
+	if i.ProblemTypeHasPrefix != nil {
+		predicates = append(predicates, knownproblem.ProblemTypeHasPrefix(*i.ProblemTypeHasPrefix))
+	}
+	if i.ProblemTypeHasSuffix != nil {
+		predicates = append(predicates, knownproblem.ProblemTypeHasSuffix(*i.ProblemTypeHasSuffix))
+	}
+	if i.ProblemTypeEqualFold != nil {
+		predicates = append(predicates, knownproblem.ProblemTypeEqualFold(*i.ProblemTypeEqualFold))
+	}
+	if i.ProblemTypeContainsFold != nil {
+		predicates = append(predicates, knownproblem.ProblemTypeContainsFold(*i.ProblemTypeContainsFold))
+	}
+	if i.Label != nil {
+		predicates = append(predicates, knownproblem.LabelEQ(*i.Label))
+	}
+	if i.LabelNEQ != nil {
+		predicates = append(predicates, knownproblem.LabelNEQ(*i.LabelNEQ))
+	}
+	if len(i.LabelIn) > 0 {
+		predicates = append(predicates, knownproblem.LabelIn(i.LabelIn...))
+	}
+	if len(i.LabelNotIn) > 0 {
+		predicates = append(predicates, knownproblem.LabelNotIn(i.LabelNotIn...))
+	}
+	if i.LabelGT != nil {
+		predicates = append(predicates, knownproblem.LabelGT(*i.LabelGT))
+	}
+	if i.LabelGTE != nil {
+		predicates = append(predicates, knownproblem.LabelGTE(*i.LabelGTE))
+	}
+	if i.LabelLT != nil {
+		predicates = append(predicates, knownproblem.LabelLT(*i.LabelLT))
+	}
+	if i.LabelLTE != nil {
+		predicates = append(predicates, knownproblem.LabelLTE(*i.LabelLTE))
+	}
+	if i.LabelContains != nil {
+		predicates = append(predicates, knownproblem.LabelContains(*i.LabelContains))
+	}
+	if i.LabelHasPrefix != nil {
+		predicates = append(predicates, knownproblem.LabelHasPrefix(*i.LabelHasPrefix))
+	}
+	if i.LabelHasSuffix != nil {
+		predicates = append(predicates, knownproblem.LabelHasSuffix(*i.LabelHasSuffix))
+	}
+	if i.LabelEqualFold != nil {
+		predicates = append(predicates, knownproblem.LabelEqualFold(*i.LabelEqualFold))
+	}
+	if i.LabelContainsFold != nil {
+		predicates = append(predicates, knownproblem.LabelContainsFold(*i.LabelContainsFold))
+	}
+	if i.FirstSeen != nil {
+		predicates = append(predicates, knownproblem.FirstSeenEQ(*i.FirstSeen))
+	}
+	if i.FirstSeenNEQ != nil {
+		predicates = append(predicates, knownproblem.FirstSeenNEQ(*i.FirstSeenNEQ))
+	}
+	if len(i.FirstSeenIn) > 0 {
+		predicates = append(predicates, knownproblem.FirstSeenIn(i.FirstSeenIn...))
+	}
+	if len(i.FirstSeenNotIn) > 0 {
+		predicates = append(predicates, knownproblem.FirstSeenNotIn(i.FirstSeenNotIn...))
+	}
+	if i.FirstSeenGT != nil {
+		predicates = append(predicates, knownproblem.FirstSeenGT(*i.FirstSeenGT))
+	}
+	if i.FirstSeenGTE != nil {
+		predicates = append(predicates, knownproblem.FirstSeenGTE(*i.FirstSeenGTE))
+	}
+	if i.FirstSeenLT != nil {
+		predicates = append(predicates, knownproblem.FirstSeenLT(*i.FirstSeenLT))
+	}
+	if i.FirstSeenLTE != nil {
+		predicates = append(predicates, knownproblem.FirstSeenLTE(*i.FirstSeenLTE))
+	}
+	if i.LastSeen != nil {
+		predicates = append(predicates, knownproblem.LastSeenEQ(*i.LastSeen))
+	}
+	if i.LastSeenNEQ != nil {
+		predicates = append(predicates, knownproblem.LastSeenNEQ(*i.LastSeenNEQ))
+	}
+	if len(i.LastSeenIn) > 0 {
+		predicates = append(predicates, knownproblem.LastSeenIn(i.LastSeenIn...))
+	}
+	if len(i.LastSeenNotIn) > 0 {
+		predicates = append(predicates, knownproblem.LastSeenNotIn(i.LastSeenNotIn...))
+	}
+	if i.LastSeenGT != nil {
+		predicates = append(predicates, knownproblem.LastSeenGT(*i.LastSeenGT))
+	}
+	if i.LastSeenGTE != nil {
+		predicates = append(predicates, knownproblem.LastSeenGTE(*i.LastSeenGTE))
+	}
+	if i.LastSeenLT != nil {
+		predicates = append(predicates, knownproblem.LastSeenLT(*i.LastSeenLT))
+	}
+	if i.LastSeenLTE != nil {
+		predicates = append(predicates, knownproblem.LastSeenLTE(*i.LastSeenLTE))
+	}
+	if i.Occurrences != nil {
+		predicates = append(predicates, knownproblem.OccurrencesEQ(*i.Occurrences))
 	}
-	if len(i.GarbageCollectedNotIn) > 0 {
-		predicates = append(predicates, garbagemetrics.GarbageCollectedNotIn(i.GarbageCollectedNotIn...))
+	if i.OccurrencesNEQ != nil {
+		predicates = append(predicates, knownproblem.OccurrencesNEQ(*i.OccurrencesNEQ))
 	}
-	if i.GarbageCollectedGT != nil {
-		predicates = append(predicates, garbagemetrics.GarbageCollectedGT(*i.GarbageCollectedGT))
+	if len(i.OccurrencesIn) > 0 {
+		predicates = append(predicates, knownproblem.OccurrencesIn(i.OccurrencesIn...))
 	}
-	if i.GarbageCollectedGTE != nil {
-		predicates = append(predicates, garbagemetrics.GarbageCollectedGTE(*i.GarbageCollectedGTE))
+	if len(i.OccurrencesNotIn) > 0 {
+		predicates = append(predicates, knownproblem.OccurrencesNotIn(i.OccurrencesNotIn...))
 	}
-	if i.GarbageCollectedLT != nil {
-		predicates = append(predicates, garbagemetrics.GarbageCollectedLT(*i.GarbageCollectedLT))
+	if i.OccurrencesGT != nil {
+		predicates = append(predicates, knownproblem.OccurrencesGT(*i.OccurrencesGT))
 	}
-	if i.GarbageCollectedLTE != nil {
-		predicates = append(predicates, garbagemetrics.GarbageCollectedLTE(*i.GarbageCollectedLTE))
+	if i.OccurrencesGTE != nil {
+		predicates = append(predicates, knownproblem.OccurrencesGTE(*i.OccurrencesGTE))
 	}
-	if i.GarbageCollectedIsNil {
-		predicates = append(predicates, garbagemetrics.GarbageCollectedIsNil())
+	if i.OccurrencesLT != nil {
+		predicates = append(predicates, knownproblem.OccurrencesLT(*i.OccurrencesLT))
 	}
-	if i.GarbageCollectedNotNil {
-		predicates = append(predicates, garbagemetrics.GarbageCollectedNotNil())
+	if i.OccurrencesLTE != nil {
+		predicates = append(predicates, knownproblem.OccurrencesLTE(*i.OccurrencesLTE))
 	}
 
-	if i.HasMemoryMetrics != nil {
-		p := garbagemetrics.HasMemoryMetrics()
-		if !*i.HasMemoryMetrics {
-			p = garbagemetrics.Not(p)
+	if i.HasProblems != nil {
+		p := knownproblem.HasProblems()
+		if !*i.HasProblems {
+			p = knownproblem.Not(p)
 		}
 		predicates = append(predicates, p)
 	}
-	if len(i.HasMemoryMetricsWith) > 0 {
-		with := make([]predicate.MemoryMetrics, 0, len(i.HasMemoryMetricsWith))
-		for _, w := range i.HasMemoryMetricsWith {
+	if len(i.HasProblemsWith) > 0 {
+		with := make([]predicate.BazelInvocationProblem, 0, len(i.HasProblemsWith))
+		for _, w := range i.HasProblemsWith {
 			p, err := w.P()
 			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasMemoryMetricsWith'", err)
+				return nil, fmt.Errorf("%w: field 'HasProblemsWith'", err)
 			}
 			with = append(with, p)
 		}
-		predicates = append(predicates, garbagemetrics.HasMemoryMetricsWith(with...))
+		predicates = append(predicates, knownproblem.HasProblemsWith(with...))
 	}
 	switch len(predicates) {
 	case 0:
-		return nil, ErrEmptyGarbageMetricsWhereInput
+		return nil, ErrEmptyKnownProblemWhereInput
 	case 1:
 		return predicates[0], nil
 	default:
-		return garbagemetrics.And(predicates...), nil
+		return knownproblem.And(predicates...), nil
 	}
 }
 
@@ -11744,6 +12550,430 @@ func (i *TestFileWhereInput) P() (predicate.TestFile, error) {
 	}
 }
 
+// TestHealthReportWhereInput represents a where input for filtering TestHealthReport queries.
+type TestHealthReportWhereInput struct {
+	Predicates []predicate.TestHealthReport  `json:"-"`
+	Not        *TestHealthReportWhereInput   `json:"not,omitempty"`
+	Or         []*TestHealthReportWhereInput `json:"or,omitempty"`
+	And        []*TestHealthReportWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *int  `json:"id,omitempty"`
+	IDNEQ   *int  `json:"idNEQ,omitempty"`
+	IDIn    []int `json:"idIn,omitempty"`
+	IDNotIn []int `json:"idNotIn,omitempty"`
+	IDGT    *int  `json:"idGT,omitempty"`
+	IDGTE   *int  `json:"idGTE,omitempty"`
+	IDLT    *int  `json:"idLT,omitempty"`
+	IDLTE   *int  `json:"idLTE,omitempty"`
+
+	// "label" field predicates.
+	Label             *string  `json:"label,omitempty"`
+	LabelNEQ          *string  `json:"labelNEQ,omitempty"`
+	LabelIn           []string `json:"labelIn,omitempty"`
+	LabelNotIn        []string `json:"labelNotIn,omitempty"`
+	LabelGT           *string  `json:"labelGT,omitempty"`
+	LabelGTE          *string  `json:"labelGTE,omitempty"`
+	LabelLT           *string  `json:"labelLT,omitempty"`
+	LabelLTE          *string  `json:"labelLTE,omitempty"`
+	LabelContains     *string  `json:"labelContains,omitempty"`
+	LabelHasPrefix    *string  `json:"labelHasPrefix,omitempty"`
+	LabelHasSuffix    *string  `json:"labelHasSuffix,omitempty"`
+	LabelEqualFold    *string  `json:"labelEqualFold,omitempty"`
+	LabelContainsFold *string  `json:"labelContainsFold,omitempty"`
+
+	// "issue" field predicates.
+	Issue      *testhealthreport.Issue  `json:"issue,omitempty"`
+	IssueNEQ   *testhealthreport.Issue  `json:"issueNEQ,omitempty"`
+	IssueIn    []testhealthreport.Issue `json:"issueIn,omitempty"`
+	IssueNotIn []testhealthreport.Issue `json:"issueNotIn,omitempty"`
+
+	// "test_size" field predicates.
+	TestSize      *testhealthreport.TestSize  `json:"testSize,omitempty"`
+	TestSizeNEQ   *testhealthreport.TestSize  `json:"testSizeNEQ,omitempty"`
+	TestSizeIn    []testhealthreport.TestSize `json:"testSizeIn,omitempty"`
+	TestSizeNotIn []testhealthreport.TestSize `json:"testSizeNotIn,omitempty"`
+
+	// "shard_count" field predicates.
+	ShardCount      *int32  `json:"shardCount,omitempty"`
+	ShardCountNEQ   *int32  `json:"shardCountNEQ,omitempty"`
+	ShardCountIn    []int32 `json:"shardCountIn,omitempty"`
+	ShardCountNotIn []int32 `json:"shardCountNotIn,omitempty"`
+	ShardCountGT    *int32  `json:"shardCountGT,omitempty"`
+	ShardCountGTE   *int32  `json:"shardCountGTE,omitempty"`
+	ShardCountLT    *int32  `json:"shardCountLT,omitempty"`
+	ShardCountLTE   *int32  `json:"shardCountLTE,omitempty"`
+
+	// "max_duration_in_ms" field predicates.
+	MaxDurationInMs      *int64  `json:"maxDurationInMs,omitempty"`
+	MaxDurationInMsNEQ   *int64  `json:"maxDurationInMsNEQ,omitempty"`
+	MaxDurationInMsIn    []int64 `json:"maxDurationInMsIn,omitempty"`
+	MaxDurationInMsNotIn []int64 `json:"maxDurationInMsNotIn,omitempty"`
+	MaxDurationInMsGT    *int64  `json:"maxDurationInMsGT,omitempty"`
+	MaxDurationInMsGTE   *int64  `json:"maxDurationInMsGTE,omitempty"`
+	MaxDurationInMsLT    *int64  `json:"maxDurationInMsLT,omitempty"`
+	MaxDurationInMsLTE   *int64  `json:"maxDurationInMsLTE,omitempty"`
+
+	// "median_shard_duration_in_ms" field predicates.
+	MedianShardDurationInMs      *int64  `json:"medianShardDurationInMs,omitempty"`
+	MedianShardDurationInMsNEQ   *int64  `json:"medianShardDurationInMsNEQ,omitempty"`
+	MedianShardDurationInMsIn    []int64 `json:"medianShardDurationInMsIn,omitempty"`
+	MedianShardDurationInMsNotIn []int64 `json:"medianShardDurationInMsNotIn,omitempty"`
+	MedianShardDurationInMsGT    *int64  `json:"medianShardDurationInMsGT,omitempty"`
+	MedianShardDurationInMsGTE   *int64  `json:"medianShardDurationInMsGTE,omitempty"`
+	MedianShardDurationInMsLT    *int64  `json:"medianShardDurationInMsLT,omitempty"`
+	MedianShardDurationInMsLTE   *int64  `json:"medianShardDurationInMsLTE,omitempty"`
+
+	// "timeout_in_ms" field predicates.
+	TimeoutInMs      *int64  `json:"timeoutInMs,omitempty"`
+	TimeoutInMsNEQ   *int64  `json:"timeoutInMsNEQ,omitempty"`
+	TimeoutInMsIn    []int64 `json:"timeoutInMsIn,omitempty"`
+	TimeoutInMsNotIn []int64 `json:"timeoutInMsNotIn,omitempty"`
+	TimeoutInMsGT    *int64  `json:"timeoutInMsGT,omitempty"`
+	TimeoutInMsGTE   *int64  `json:"timeoutInMsGTE,omitempty"`
+	TimeoutInMsLT    *int64  `json:"timeoutInMsLT,omitempty"`
+	TimeoutInMsLTE   *int64  `json:"timeoutInMsLTE,omitempty"`
+
+	// "suggested_shard_count" field predicates.
+	SuggestedShardCount      *int32  `json:"suggestedShardCount,omitempty"`
+	SuggestedShardCountNEQ   *int32  `json:"suggestedShardCountNEQ,omitempty"`
+	SuggestedShardCountIn    []int32 `json:"suggestedShardCountIn,omitempty"`
+	SuggestedShardCountNotIn []int32 `json:"suggestedShardCountNotIn,omitempty"`
+	SuggestedShardCountGT    *int32  `json:"suggestedShardCountGT,omitempty"`
+	SuggestedShardCountGTE   *int32  `json:"suggestedShardCountGTE,omitempty"`
+	SuggestedShardCountLT    *int32  `json:"suggestedShardCountLT,omitempty"`
+	SuggestedShardCountLTE   *int32  `json:"suggestedShardCountLTE,omitempty"`
+
+	// "suggested_size" field predicates.
+	SuggestedSize      *testhealthreport.SuggestedSize  `json:"suggestedSize,omitempty"`
+	SuggestedSizeNEQ   *testhealthreport.SuggestedSize  `json:"suggestedSizeNEQ,omitempty"`
+	SuggestedSizeIn    []testhealthreport.SuggestedSize `json:"suggestedSizeIn,omitempty"`
+	SuggestedSizeNotIn []testhealthreport.SuggestedSize `json:"suggestedSizeNotIn,omitempty"`
+
+	// "bazel_invocation" edge predicates.
+	HasBazelInvocation     *bool                        `json:"hasBazelInvocation,omitempty"`
+	HasBazelInvocationWith []*BazelInvocationWhereInput `json:"hasBazelInvocationWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *TestHealthReportWhereInput) AddPredicates(predicates ...predicate.TestHealthReport) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the TestHealthReportWhereInput filter on the TestHealthReportQuery builder.
+func (i *TestHealthReportWhereInput) Filter(q *TestHealthReportQuery) (*TestHealthReportQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyTestHealthReportWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyTestHealthReportWhereInput is returned in case the TestHealthReportWhereInput is empty.
+var ErrEmptyTestHealthReportWhereInput = errors.New("ent: empty predicate TestHealthReportWhereInput")
+
+// P returns a predicate for filtering testhealthreports.
+// An error is returned if the input is empty or invalid.
+func (i *TestHealthReportWhereInput) P() (predicate.TestHealthReport, error) {
+	var predicates []predicate.TestHealthReport
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, testhealthreport.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.TestHealthReport, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, testhealthreport.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.TestHealthReport, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, testhealthreport.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, testhealthreport.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, testhealthreport.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, testhealthreport.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, testhealthreport.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, testhealthreport.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, testhealthreport.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, testhealthreport.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, testhealthreport.IDLTE(*i.IDLTE))
+	}
+	if i.Label != nil {
+		predicates = append(predicates, testhealthreport.LabelEQ(*i.Label))
+	}
+	if i.LabelNEQ != nil {
+		predicates = append(predicates, testhealthreport.LabelNEQ(*i.LabelNEQ))
+	}
+	if len(i.LabelIn) > 0 {
+		predicates = append(predicates, testhealthreport.LabelIn(i.LabelIn...))
+	}
+	if len(i.LabelNotIn) > 0 {
+		predicates = append(predicates, testhealthreport.LabelNotIn(i.LabelNotIn...))
+	}
+	if i.LabelGT != nil {
+		predicates = append(predicates, testhealthreport.LabelGT(*i.LabelGT))
+	}
+	if i.LabelGTE != nil {
+		predicates = append(predicates, testhealthreport.LabelGTE(*i.LabelGTE))
+	}
+	if i.LabelLT != nil {
+		predicates = append(predicates, testhealthreport.LabelLT(*i.LabelLT))
+	}
+	if i.LabelLTE != nil {
+		predicates = append(predicates, testhealthreport.LabelLTE(*i.LabelLTE))
+	}
+	if i.LabelContains != nil {
+		predicates = append(predicates, testhealthreport.LabelContains(*i.LabelContains))
+	}
+	if i.LabelHasPrefix != nil {
+		predicates = append(predicates, testhealthreport.LabelHasPrefix(*i.LabelHasPrefix))
+	}
+	if i.LabelHasSuffix != nil {
+		predicates = append(predicates, testhealthreport.LabelHasSuffix(*i.LabelHasSuffix))
+	}
+	if i.LabelEqualFold != nil {
+		predicates = append(predicates, testhealthreport.LabelEqualFold(*i.LabelEqualFold))
+	}
+	if i.LabelContainsFold != nil {
+		predicates = append(predicates, testhealthreport.LabelContainsFold(*i.LabelContainsFold))
+	}
+	if i.Issue != nil {
+		predicates = append(predicates, testhealthreport.IssueEQ(*i.Issue))
+	}
+	if i.IssueNEQ != nil {
+		predicates = append(predicates, testhealthreport.IssueNEQ(*i.IssueNEQ))
+	}
+	if len(i.IssueIn) > 0 {
+		predicates = append(predicates, testhealthreport.IssueIn(i.IssueIn...))
+	}
+	if len(i.IssueNotIn) > 0 {
+		predicates = append(predicates, testhealthreport.IssueNotIn(i.IssueNotIn...))
+	}
+	if i.TestSize != nil {
+		predicates = append(predicates, testhealthreport.TestSizeEQ(*i.TestSize))
+	}
+	if i.TestSizeNEQ != nil {
+		predicates = append(predicates, testhealthreport.TestSizeNEQ(*i.TestSizeNEQ))
+	}
+	if len(i.TestSizeIn) > 0 {
+		predicates = append(predicates, testhealthreport.TestSizeIn(i.TestSizeIn...))
+	}
+	if len(i.TestSizeNotIn) > 0 {
+		predicates = append(predicates, testhealthreport.TestSizeNotIn(i.TestSizeNotIn...))
+	}
+	if i.ShardCount != nil {
+		predicates = append(predicates, testhealthreport.ShardCountEQ(*i.ShardCount))
+	}
+	if i.ShardCountNEQ != nil {
+		predicates = append(predicates, testhealthreport.ShardCountNEQ(*i.ShardCountNEQ))
+	}
+	if len(i.ShardCountIn) > 0 {
+		predicates = append(predicates, testhealthreport.ShardCountIn(i.ShardCountIn...))
+	}
+	if len(i.ShardCountNotIn) > 0 {
+		predicates = append(predicates, testhealthreport.ShardCountNotIn(i.ShardCountNotIn...))
+	}
+	if i.ShardCountGT != nil {
+		predicates = append(predicates, testhealthreport.ShardCountGT(*i.ShardCountGT))
+	}
+	if i.ShardCountGTE != nil {
+		predicates = append(predicates, testhealthreport.ShardCountGTE(*i.ShardCountGTE))
+	}
+	if i.ShardCountLT != nil {
+		predicates = append(predicates, testhealthreport.ShardCountLT(*i.ShardCountLT))
+	}
+	if i.ShardCountLTE != nil {
+		predicates = append(predicates, testhealthreport.ShardCountLTE(*i.ShardCountLTE))
+	}
+	if i.MaxDurationInMs != nil {
+		predicates = append(predicates, testhealthreport.MaxDurationInMsEQ(*i.MaxDurationInMs))
+	}
+	if i.MaxDurationInMsNEQ != nil {
+		predicates = append(predicates, testhealthreport.MaxDurationInMsNEQ(*i.MaxDurationInMsNEQ))
+	}
+	if len(i.MaxDurationInMsIn) > 0 {
+		predicates = append(predicates, testhealthreport.MaxDurationInMsIn(i.MaxDurationInMsIn...))
+	}
+	if len(i.MaxDurationInMsNotIn) > 0 {
+		predicates = append(predicates, testhealthreport.MaxDurationInMsNotIn(i.MaxDurationInMsNotIn...))
+	}
+	if i.MaxDurationInMsGT != nil {
+		predicates = append(predicates, testhealthreport.MaxDurationInMsGT(*i.MaxDurationInMsGT))
+	}
+	if i.MaxDurationInMsGTE != nil {
+		predicates = append(predicates, testhealthreport.MaxDurationInMsGTE(*i.MaxDurationInMsGTE))
+	}
+	if i.MaxDurationInMsLT != nil {
+		predicates = append(predicates, testhealthreport.MaxDurationInMsLT(*i.MaxDurationInMsLT))
+	}
+	if i.MaxDurationInMsLTE != nil {
+		predicates = append(predicates, testhealthreport.MaxDurationInMsLTE(*i.MaxDurationInMsLTE))
+	}
+	if i.MedianShardDurationInMs != nil {
+		predicates = append(predicates, testhealthreport.MedianShardDurationInMsEQ(*i.MedianShardDurationInMs))
+	}
+	if i.MedianShardDurationInMsNEQ != nil {
+		predicates = append(predicates, testhealthreport.MedianShardDurationInMsNEQ(*i.MedianShardDurationInMsNEQ))
+	}
+	if len(i.MedianShardDurationInMsIn) > 0 {
+		predicates = append(predicates, testhealthreport.MedianShardDurationInMsIn(i.MedianShardDurationInMsIn...))
+	}
+	if len(i.MedianShardDurationInMsNotIn) > 0 {
+		predicates = append(predicates, testhealthreport.MedianShardDurationInMsNotIn(i.MedianShardDurationInMsNotIn...))
+	}
+	if i.MedianShardDurationInMsGT != nil {
+		predicates = append(predicates, testhealthreport.MedianShardDurationInMsGT(*i.MedianShardDurationInMsGT))
+	}
+	if i.MedianShardDurationInMsGTE != nil {
+		predicates = append(predicates, testhealthreport.MedianShardDurationInMsGTE(*i.MedianShardDurationInMsGTE))
+	}
+	if i.MedianShardDurationInMsLT != nil {
+		predicates = append(predicates, testhealthreport.MedianShardDurationInMsLT(*i.MedianShardDurationInMsLT))
+	}
+	if i.MedianShardDurationInMsLTE != nil {
+		predicates = append(predicates, testhealthreport.MedianShardDurationInMsLTE(*i.MedianShardDurationInMsLTE))
+	}
+	if i.TimeoutInMs != nil {
+		predicates = append(predicates, testhealthreport.TimeoutInMsEQ(*i.TimeoutInMs))
+	}
+	if i.TimeoutInMsNEQ != nil {
+		predicates = append(predicates, testhealthreport.TimeoutInMsNEQ(*i.TimeoutInMsNEQ))
+	}
+	if len(i.TimeoutInMsIn) > 0 {
+		predicates = append(predicates, testhealthreport.TimeoutInMsIn(i.TimeoutInMsIn...))
+	}
+	if len(i.TimeoutInMsNotIn) > 0 {
+		predicates = append(predicates, testhealthreport.TimeoutInMsNotIn(i.TimeoutInMsNotIn...))
+	}
+	if i.TimeoutInMsGT != nil {
+		predicates = append(predicates, testhealthreport.TimeoutInMsGT(*i.TimeoutInMsGT))
+	}
+	if i.TimeoutInMsGTE != nil {
+		predicates = append(predicates, testhealthreport.TimeoutInMsGTE(*i.TimeoutInMsGTE))
+	}
+	if i.TimeoutInMsLT != nil {
+		predicates = append(predicates, testhealthreport.TimeoutInMsLT(*i.TimeoutInMsLT))
+	}
+	if i.TimeoutInMsLTE != nil {
+		predicates = append(predicates, testhealthreport.TimeoutInMsLTE(*i.TimeoutInMsLTE))
+	}
+	if i.SuggestedShardCount != nil {
+		predicates = append(predicates, testhealthreport.SuggestedShardCountEQ(*i.SuggestedShardCount))
+	}
+	if i.SuggestedShardCountNEQ != nil {
+		predicates = append(predicates, testhealthreport.SuggestedShardCountNEQ(*i.SuggestedShardCountNEQ))
+	}
+	if len(i.SuggestedShardCountIn) > 0 {
+		predicates = append(predicates, testhealthreport.SuggestedShardCountIn(i.SuggestedShardCountIn...))
+	}
+	if len(i.SuggestedShardCountNotIn) > 0 {
+		predicates = append(predicates, testhealthreport.SuggestedShardCountNotIn(i.SuggestedShardCountNotIn...))
+	}
+	if i.SuggestedShardCountGT != nil {
+		predicates = append(predicates, testhealthreport.SuggestedShardCountGT(*i.SuggestedShardCountGT))
+	}
+	if i.SuggestedShardCountGTE != nil {
+		predicates = append(predicates, testhealthreport.SuggestedShardCountGTE(*i.SuggestedShardCountGTE))
+	}
+	if i.SuggestedShardCountLT != nil {
+		predicates = append(predicates, testhealthreport.SuggestedShardCountLT(*i.SuggestedShardCountLT))
+	}
+	if i.SuggestedShardCountLTE != nil {
+		predicates = append(predicates, testhealthreport.SuggestedShardCountLTE(*i.SuggestedShardCountLTE))
+	}
+	if i.SuggestedSize != nil {
+		predicates = append(predicates, testhealthreport.SuggestedSizeEQ(*i.SuggestedSize))
+	}
+	if i.SuggestedSizeNEQ != nil {
+		predicates = append(predicates, testhealthreport.SuggestedSizeNEQ(*i.SuggestedSizeNEQ))
+	}
+	if len(i.SuggestedSizeIn) > 0 {
+		predicates = append(predicates, testhealthreport.SuggestedSizeIn(i.SuggestedSizeIn...))
+	}
+	if len(i.SuggestedSizeNotIn) > 0 {
+		predicates = append(predicates, testhealthreport.SuggestedSizeNotIn(i.SuggestedSizeNotIn...))
+	}
+
+	if i.HasBazelInvocation != nil {
+		p := testhealthreport.HasBazelInvocation()
+		if !*i.HasBazelInvocation {
+			p = testhealthreport.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasBazelInvocationWith) > 0 {
+		with := make([]predicate.BazelInvocation, 0, len(i.HasBazelInvocationWith))
+		for _, w := range i.HasBazelInvocationWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasBazelInvocationWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, testhealthreport.HasBazelInvocationWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyTestHealthReportWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return testhealthreport.And(predicates...), nil
+	}
+}
+
 // TestResultBESWhereInput represents a where input for filtering TestResultBES queries.
 type TestResultBESWhereInput struct {
 	Predicates []predicate.TestResultBES  `json:"-"`
@@ -201,6 +201,18 @@ func (f GarbageMetricsFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GarbageMetricsMutation", m)
 }
 
+// The KnownProblemFunc type is an adapter to allow the use of ordinary
+// function as KnownProblem mutator.
+type KnownProblemFunc func(context.Context, *ent.KnownProblemMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f KnownProblemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.KnownProblemMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.KnownProblemMutation", m)
+}
+
 // The MemoryMetricsFunc type is an adapter to allow the use of ordinary
 // function as MemoryMetrics mutator.
 type MemoryMetricsFunc func(context.Context, *ent.MemoryMetricsMutation) (ent.Value, error)
@@ -225,6 +237,18 @@ func (f MetricsFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MetricsMutation", m)
 }
 
+// The MetricsRollupFunc type is an adapter to allow the use of ordinary
+// function as MetricsRollup mutator.
+type MetricsRollupFunc func(context.Context, *ent.MetricsRollupMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MetricsRollupFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MetricsRollupMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MetricsRollupMutation", m)
+}
+
 // The MissDetailFunc type is an adapter to allow the use of ordinary
 // function as MissDetail mutator.
 type MissDetailFunc func(context.Context, *ent.MissDetailMutation) (ent.Value, error)
@@ -333,6 +357,18 @@ func (f RunnerCountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RunnerCountMutation", m)
 }
 
+// The SearchChunkFunc type is an adapter to allow the use of ordinary
+// function as SearchChunk mutator.
+type SearchChunkFunc func(context.Context, *ent.SearchChunkMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SearchChunkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SearchChunkMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SearchChunkMutation", m)
+}
+
 // The SystemNetworkStatsFunc type is an adapter to allow the use of ordinary
 // function as SystemNetworkStats mutator.
 type SystemNetworkStatsFunc func(context.Context, *ent.SystemNetworkStatsMutation) (ent.Value, error)
@@ -417,6 +453,18 @@ func (f TestFileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TestFileMutation", m)
 }
 
+// The TestHealthReportFunc type is an adapter to allow the use of ordinary
+// function as TestHealthReport mutator.
+type TestHealthReportFunc func(context.Context, *ent.TestHealthReportMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TestHealthReportFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TestHealthReportMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TestHealthReportMutation", m)
+}
+
 // The TestResultBESFunc type is an adapter to allow the use of ordinary
 // function as TestResultBES mutator.
 type TestResultBESFunc func(context.Context, *ent.TestResultBESMutation) (ent.Value, error)
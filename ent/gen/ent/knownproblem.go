@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/knownproblem"
+)
+
+// KnownProblem is the model entity for the KnownProblem schema.
+type KnownProblem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Fingerprint holds the value of the "fingerprint" field.
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// ProblemType holds the value of the "problem_type" field.
+	ProblemType string `json:"problem_type,omitempty"`
+	// Label holds the value of the "label" field.
+	Label string `json:"label,omitempty"`
+	// FirstSeen holds the value of the "first_seen" field.
+	FirstSeen time.Time `json:"first_seen,omitempty"`
+	// LastSeen holds the value of the "last_seen" field.
+	LastSeen time.Time `json:"last_seen,omitempty"`
+	// Occurrences holds the value of the "occurrences" field.
+	Occurrences int `json:"occurrences,omitempty"`
+	// Branches holds the value of the "branches" field.
+	Branches []string `json:"branches,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the KnownProblemQuery when eager-loading is set.
+	Edges        KnownProblemEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// KnownProblemEdges holds the relations/edges for other nodes in the graph.
+type KnownProblemEdges struct {
+	// Problems holds the value of the problems edge.
+	Problems []*BazelInvocationProblem `json:"problems,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+
+	namedProblems map[string][]*BazelInvocationProblem
+}
+
+// ProblemsOrErr returns the Problems value or an error if the edge
+// was not loaded in eager-loading.
+func (e KnownProblemEdges) ProblemsOrErr() ([]*BazelInvocationProblem, error) {
+	if e.loadedTypes[0] {
+		return e.Problems, nil
+	}
+	return nil, &NotLoadedError{edge: "problems"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*KnownProblem) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case knownproblem.FieldBranches:
+			values[i] = new([]byte)
+		case knownproblem.FieldID, knownproblem.FieldOccurrences:
+			values[i] = new(sql.NullInt64)
+		case knownproblem.FieldFingerprint, knownproblem.FieldProblemType, knownproblem.FieldLabel:
+			values[i] = new(sql.NullString)
+		case knownproblem.FieldFirstSeen, knownproblem.FieldLastSeen:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the KnownProblem fields.
+func (kp *KnownProblem) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case knownproblem.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			kp.ID = int(value.Int64)
+		case knownproblem.FieldFingerprint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fingerprint", values[i])
+			} else if value.Valid {
+				kp.Fingerprint = value.String
+			}
+		case knownproblem.FieldProblemType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field problem_type", values[i])
+			} else if value.Valid {
+				kp.ProblemType = value.String
+			}
+		case knownproblem.FieldLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field label", values[i])
+			} else if value.Valid {
+				kp.Label = value.String
+			}
+		case knownproblem.FieldFirstSeen:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field first_seen", values[i])
+			} else if value.Valid {
+				kp.FirstSeen = value.Time
+			}
+		case knownproblem.FieldLastSeen:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen", values[i])
+			} else if value.Valid {
+				kp.LastSeen = value.Time
+			}
+		case knownproblem.FieldOccurrences:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field occurrences", values[i])
+			} else if value.Valid {
+				kp.Occurrences = int(value.Int64)
+			}
+		case knownproblem.FieldBranches:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field branches", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &kp.Branches); err != nil {
+					return fmt.Errorf("unmarshal field branches: %w", err)
+				}
+			}
+		default:
+			kp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the KnownProblem.
+// This includes values selected through modifiers, order, etc.
+func (kp *KnownProblem) Value(name string) (ent.Value, error) {
+	return kp.selectValues.Get(name)
+}
+
+// QueryProblems queries the "problems" edge of the KnownProblem entity.
+func (kp *KnownProblem) QueryProblems() *BazelInvocationProblemQuery {
+	return NewKnownProblemClient(kp.config).QueryProblems(kp)
+}
+
+// Update returns a builder for updating this KnownProblem.
+// Note that you need to call KnownProblem.Unwrap() before calling this method if this KnownProblem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (kp *KnownProblem) Update() *KnownProblemUpdateOne {
+	return NewKnownProblemClient(kp.config).UpdateOne(kp)
+}
+
+// Unwrap unwraps the KnownProblem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (kp *KnownProblem) Unwrap() *KnownProblem {
+	_tx, ok := kp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: KnownProblem is not a transactional entity")
+	}
+	kp.config.driver = _tx.drv
+	return kp
+}
+
+// String implements the fmt.Stringer.
+func (kp *KnownProblem) String() string {
+	var builder strings.Builder
+	builder.WriteString("KnownProblem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", kp.ID))
+	builder.WriteString("fingerprint=")
+	builder.WriteString(kp.Fingerprint)
+	builder.WriteString(", ")
+	builder.WriteString("problem_type=")
+	builder.WriteString(kp.ProblemType)
+	builder.WriteString(", ")
+	builder.WriteString("label=")
+	builder.WriteString(kp.Label)
+	builder.WriteString(", ")
+	builder.WriteString("first_seen=")
+	builder.WriteString(kp.FirstSeen.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_seen=")
+	builder.WriteString(kp.LastSeen.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("occurrences=")
+	builder.WriteString(fmt.Sprintf("%v", kp.Occurrences))
+	builder.WriteString(", ")
+	builder.WriteString("branches=")
+	builder.WriteString(fmt.Sprintf("%v", kp.Branches))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedProblems returns the Problems named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (kp *KnownProblem) NamedProblems(name string) ([]*BazelInvocationProblem, error) {
+	if kp.Edges.namedProblems == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := kp.Edges.namedProblems[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (kp *KnownProblem) appendNamedProblems(name string, edges ...*BazelInvocationProblem) {
+	if kp.Edges.namedProblems == nil {
+		kp.Edges.namedProblems = make(map[string][]*BazelInvocationProblem)
+	}
+	if len(edges) == 0 {
+		kp.Edges.namedProblems[name] = []*BazelInvocationProblem{}
+	} else {
+		kp.Edges.namedProblems[name] = append(kp.Edges.namedProblems[name], edges...)
+	}
+}
+
+// KnownProblems is a parsable slice of KnownProblem.
+type KnownProblems []*KnownProblem
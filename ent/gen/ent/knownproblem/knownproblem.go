@@ -0,0 +1,126 @@
+// Code generated by ent, DO NOT EDIT.
+
+package knownproblem
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the knownproblem type in the database.
+	Label = "known_problem"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldFingerprint holds the string denoting the fingerprint field in the database.
+	FieldFingerprint = "fingerprint"
+	// FieldProblemType holds the string denoting the problem_type field in the database.
+	FieldProblemType = "problem_type"
+	// FieldLabel holds the string denoting the label field in the database.
+	FieldLabel = "label"
+	// FieldFirstSeen holds the string denoting the first_seen field in the database.
+	FieldFirstSeen = "first_seen"
+	// FieldLastSeen holds the string denoting the last_seen field in the database.
+	FieldLastSeen = "last_seen"
+	// FieldOccurrences holds the string denoting the occurrences field in the database.
+	FieldOccurrences = "occurrences"
+	// FieldBranches holds the string denoting the branches field in the database.
+	FieldBranches = "branches"
+	// EdgeProblems holds the string denoting the problems edge name in mutations.
+	EdgeProblems = "problems"
+	// Table holds the table name of the knownproblem in the database.
+	Table = "known_problems"
+	// ProblemsTable is the table that holds the problems relation/edge.
+	ProblemsTable = "bazel_invocation_problems"
+	// ProblemsInverseTable is the table name for the BazelInvocationProblem entity.
+	// It exists in this package in order to avoid circular dependency with the "bazelinvocationproblem" package.
+	ProblemsInverseTable = "bazel_invocation_problems"
+	// ProblemsColumn is the table column denoting the problems relation/edge.
+	ProblemsColumn = "known_problem_problems"
+)
+
+// Columns holds all SQL columns for knownproblem fields.
+var Columns = []string{
+	FieldID,
+	FieldFingerprint,
+	FieldProblemType,
+	FieldLabel,
+	FieldFirstSeen,
+	FieldLastSeen,
+	FieldOccurrences,
+	FieldBranches,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultOccurrences holds the default value on creation for the "occurrences" field.
+	DefaultOccurrences int
+)
+
+// OrderOption defines the ordering options for the KnownProblem queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByFingerprint orders the results by the fingerprint field.
+func ByFingerprint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFingerprint, opts...).ToFunc()
+}
+
+// ByProblemType orders the results by the problem_type field.
+func ByProblemType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProblemType, opts...).ToFunc()
+}
+
+// ByLabel orders the results by the label field.
+func ByLabel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLabel, opts...).ToFunc()
+}
+
+// ByFirstSeen orders the results by the first_seen field.
+func ByFirstSeen(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstSeen, opts...).ToFunc()
+}
+
+// ByLastSeen orders the results by the last_seen field.
+func ByLastSeen(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeen, opts...).ToFunc()
+}
+
+// ByOccurrences orders the results by the occurrences field.
+func ByOccurrences(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOccurrences, opts...).ToFunc()
+}
+
+// ByProblemsCount orders the results by problems count.
+func ByProblemsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newProblemsStep(), opts...)
+	}
+}
+
+// ByProblems orders the results by problems terms.
+func ByProblems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProblemsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newProblemsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProblemsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ProblemsTable, ProblemsColumn),
+	)
+}
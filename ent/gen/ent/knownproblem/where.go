@@ -0,0 +1,444 @@
+// Code generated by ent, DO NOT EDIT.
+
+package knownproblem
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldLTE(FieldID, id))
+}
+
+// Fingerprint applies equality check predicate on the "fingerprint" field. It's identical to FingerprintEQ.
+func Fingerprint(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// ProblemType applies equality check predicate on the "problem_type" field. It's identical to ProblemTypeEQ.
+func ProblemType(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldEQ(FieldProblemType, v))
+}
+
+// FirstSeen applies equality check predicate on the "first_seen" field. It's identical to FirstSeenEQ.
+func FirstSeen(v time.Time) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldEQ(FieldFirstSeen, v))
+}
+
+// LastSeen applies equality check predicate on the "last_seen" field. It's identical to LastSeenEQ.
+func LastSeen(v time.Time) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldEQ(FieldLastSeen, v))
+}
+
+// Occurrences applies equality check predicate on the "occurrences" field. It's identical to OccurrencesEQ.
+func Occurrences(v int) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldEQ(FieldOccurrences, v))
+}
+
+// FingerprintEQ applies the EQ predicate on the "fingerprint" field.
+func FingerprintEQ(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// FingerprintNEQ applies the NEQ predicate on the "fingerprint" field.
+func FingerprintNEQ(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldNEQ(FieldFingerprint, v))
+}
+
+// FingerprintIn applies the In predicate on the "fingerprint" field.
+func FingerprintIn(vs ...string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldIn(FieldFingerprint, vs...))
+}
+
+// FingerprintNotIn applies the NotIn predicate on the "fingerprint" field.
+func FingerprintNotIn(vs ...string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldNotIn(FieldFingerprint, vs...))
+}
+
+// FingerprintGT applies the GT predicate on the "fingerprint" field.
+func FingerprintGT(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldGT(FieldFingerprint, v))
+}
+
+// FingerprintGTE applies the GTE predicate on the "fingerprint" field.
+func FingerprintGTE(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldGTE(FieldFingerprint, v))
+}
+
+// FingerprintLT applies the LT predicate on the "fingerprint" field.
+func FingerprintLT(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldLT(FieldFingerprint, v))
+}
+
+// FingerprintLTE applies the LTE predicate on the "fingerprint" field.
+func FingerprintLTE(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldLTE(FieldFingerprint, v))
+}
+
+// FingerprintContains applies the Contains predicate on the "fingerprint" field.
+func FingerprintContains(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldContains(FieldFingerprint, v))
+}
+
+// FingerprintHasPrefix applies the HasPrefix predicate on the "fingerprint" field.
+func FingerprintHasPrefix(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldHasPrefix(FieldFingerprint, v))
+}
+
+// FingerprintHasSuffix applies the HasSuffix predicate on the "fingerprint" field.
+func FingerprintHasSuffix(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldHasSuffix(FieldFingerprint, v))
+}
+
+// FingerprintEqualFold applies the EqualFold predicate on the "fingerprint" field.
+func FingerprintEqualFold(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldEqualFold(FieldFingerprint, v))
+}
+
+// FingerprintContainsFold applies the ContainsFold predicate on the "fingerprint" field.
+func FingerprintContainsFold(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldContainsFold(FieldFingerprint, v))
+}
+
+// ProblemTypeEQ applies the EQ predicate on the "problem_type" field.
+func ProblemTypeEQ(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldEQ(FieldProblemType, v))
+}
+
+// ProblemTypeNEQ applies the NEQ predicate on the "problem_type" field.
+func ProblemTypeNEQ(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldNEQ(FieldProblemType, v))
+}
+
+// ProblemTypeIn applies the In predicate on the "problem_type" field.
+func ProblemTypeIn(vs ...string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldIn(FieldProblemType, vs...))
+}
+
+// ProblemTypeNotIn applies the NotIn predicate on the "problem_type" field.
+func ProblemTypeNotIn(vs ...string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldNotIn(FieldProblemType, vs...))
+}
+
+// ProblemTypeGT applies the GT predicate on the "problem_type" field.
+func ProblemTypeGT(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldGT(FieldProblemType, v))
+}
+
+// ProblemTypeGTE applies the GTE predicate on the "problem_type" field.
+func ProblemTypeGTE(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldGTE(FieldProblemType, v))
+}
+
+// ProblemTypeLT applies the LT predicate on the "problem_type" field.
+func ProblemTypeLT(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldLT(FieldProblemType, v))
+}
+
+// ProblemTypeLTE applies the LTE predicate on the "problem_type" field.
+func ProblemTypeLTE(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldLTE(FieldProblemType, v))
+}
+
+// ProblemTypeContains applies the Contains predicate on the "problem_type" field.
+func ProblemTypeContains(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldContains(FieldProblemType, v))
+}
+
+// ProblemTypeHasPrefix applies the HasPrefix predicate on the "problem_type" field.
+func ProblemTypeHasPrefix(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldHasPrefix(FieldProblemType, v))
+}
+
+// ProblemTypeHasSuffix applies the HasSuffix predicate on the "problem_type" field.
+func ProblemTypeHasSuffix(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldHasSuffix(FieldProblemType, v))
+}
+
+// ProblemTypeEqualFold applies the EqualFold predicate on the "problem_type" field.
+func ProblemTypeEqualFold(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldEqualFold(FieldProblemType, v))
+}
+
+// ProblemTypeContainsFold applies the ContainsFold predicate on the "problem_type" field.
+func ProblemTypeContainsFold(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldContainsFold(FieldProblemType, v))
+}
+
+// LabelEQ applies the EQ predicate on the "label" field.
+func LabelEQ(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldEQ(FieldLabel, v))
+}
+
+// LabelNEQ applies the NEQ predicate on the "label" field.
+func LabelNEQ(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldNEQ(FieldLabel, v))
+}
+
+// LabelIn applies the In predicate on the "label" field.
+func LabelIn(vs ...string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldIn(FieldLabel, vs...))
+}
+
+// LabelNotIn applies the NotIn predicate on the "label" field.
+func LabelNotIn(vs ...string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldNotIn(FieldLabel, vs...))
+}
+
+// LabelGT applies the GT predicate on the "label" field.
+func LabelGT(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldGT(FieldLabel, v))
+}
+
+// LabelGTE applies the GTE predicate on the "label" field.
+func LabelGTE(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldGTE(FieldLabel, v))
+}
+
+// LabelLT applies the LT predicate on the "label" field.
+func LabelLT(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldLT(FieldLabel, v))
+}
+
+// LabelLTE applies the LTE predicate on the "label" field.
+func LabelLTE(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldLTE(FieldLabel, v))
+}
+
+// LabelContains applies the Contains predicate on the "label" field.
+func LabelContains(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldContains(FieldLabel, v))
+}
+
+// LabelHasPrefix applies the HasPrefix predicate on the "label" field.
+func LabelHasPrefix(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldHasPrefix(FieldLabel, v))
+}
+
+// LabelHasSuffix applies the HasSuffix predicate on the "label" field.
+func LabelHasSuffix(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldHasSuffix(FieldLabel, v))
+}
+
+// LabelEqualFold applies the EqualFold predicate on the "label" field.
+func LabelEqualFold(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldEqualFold(FieldLabel, v))
+}
+
+// LabelContainsFold applies the ContainsFold predicate on the "label" field.
+func LabelContainsFold(v string) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldContainsFold(FieldLabel, v))
+}
+
+// FirstSeenEQ applies the EQ predicate on the "first_seen" field.
+func FirstSeenEQ(v time.Time) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldEQ(FieldFirstSeen, v))
+}
+
+// FirstSeenNEQ applies the NEQ predicate on the "first_seen" field.
+func FirstSeenNEQ(v time.Time) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldNEQ(FieldFirstSeen, v))
+}
+
+// FirstSeenIn applies the In predicate on the "first_seen" field.
+func FirstSeenIn(vs ...time.Time) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldIn(FieldFirstSeen, vs...))
+}
+
+// FirstSeenNotIn applies the NotIn predicate on the "first_seen" field.
+func FirstSeenNotIn(vs ...time.Time) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldNotIn(FieldFirstSeen, vs...))
+}
+
+// FirstSeenGT applies the GT predicate on the "first_seen" field.
+func FirstSeenGT(v time.Time) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldGT(FieldFirstSeen, v))
+}
+
+// FirstSeenGTE applies the GTE predicate on the "first_seen" field.
+func FirstSeenGTE(v time.Time) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldGTE(FieldFirstSeen, v))
+}
+
+// FirstSeenLT applies the LT predicate on the "first_seen" field.
+func FirstSeenLT(v time.Time) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldLT(FieldFirstSeen, v))
+}
+
+// FirstSeenLTE applies the LTE predicate on the "first_seen" field.
+func FirstSeenLTE(v time.Time) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldLTE(FieldFirstSeen, v))
+}
+
+// LastSeenEQ applies the EQ predicate on the "last_seen" field.
+func LastSeenEQ(v time.Time) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldEQ(FieldLastSeen, v))
+}
+
+// LastSeenNEQ applies the NEQ predicate on the "last_seen" field.
+func LastSeenNEQ(v time.Time) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldNEQ(FieldLastSeen, v))
+}
+
+// LastSeenIn applies the In predicate on the "last_seen" field.
+func LastSeenIn(vs ...time.Time) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldIn(FieldLastSeen, vs...))
+}
+
+// LastSeenNotIn applies the NotIn predicate on the "last_seen" field.
+func LastSeenNotIn(vs ...time.Time) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldNotIn(FieldLastSeen, vs...))
+}
+
+// LastSeenGT applies the GT predicate on the "last_seen" field.
+func LastSeenGT(v time.Time) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldGT(FieldLastSeen, v))
+}
+
+// LastSeenGTE applies the GTE predicate on the "last_seen" field.
+func LastSeenGTE(v time.Time) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldGTE(FieldLastSeen, v))
+}
+
+// LastSeenLT applies the LT predicate on the "last_seen" field.
+func LastSeenLT(v time.Time) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldLT(FieldLastSeen, v))
+}
+
+// LastSeenLTE applies the LTE predicate on the "last_seen" field.
+func LastSeenLTE(v time.Time) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldLTE(FieldLastSeen, v))
+}
+
+// OccurrencesEQ applies the EQ predicate on the "occurrences" field.
+func OccurrencesEQ(v int) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldEQ(FieldOccurrences, v))
+}
+
+// OccurrencesNEQ applies the NEQ predicate on the "occurrences" field.
+func OccurrencesNEQ(v int) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldNEQ(FieldOccurrences, v))
+}
+
+// OccurrencesIn applies the In predicate on the "occurrences" field.
+func OccurrencesIn(vs ...int) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldIn(FieldOccurrences, vs...))
+}
+
+// OccurrencesNotIn applies the NotIn predicate on the "occurrences" field.
+func OccurrencesNotIn(vs ...int) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldNotIn(FieldOccurrences, vs...))
+}
+
+// OccurrencesGT applies the GT predicate on the "occurrences" field.
+func OccurrencesGT(v int) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldGT(FieldOccurrences, v))
+}
+
+// OccurrencesGTE applies the GTE predicate on the "occurrences" field.
+func OccurrencesGTE(v int) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldGTE(FieldOccurrences, v))
+}
+
+// OccurrencesLT applies the LT predicate on the "occurrences" field.
+func OccurrencesLT(v int) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldLT(FieldOccurrences, v))
+}
+
+// OccurrencesLTE applies the LTE predicate on the "occurrences" field.
+func OccurrencesLTE(v int) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldLTE(FieldOccurrences, v))
+}
+
+// BranchesIsNil applies the IsNil predicate on the "branches" field.
+func BranchesIsNil() predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldIsNull(FieldBranches))
+}
+
+// BranchesNotNil applies the NotNil predicate on the "branches" field.
+func BranchesNotNil() predicate.KnownProblem {
+	return predicate.KnownProblem(sql.FieldNotNull(FieldBranches))
+}
+
+// HasProblems applies the HasEdge predicate on the "problems" edge.
+func HasProblems() predicate.KnownProblem {
+	return predicate.KnownProblem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ProblemsTable, ProblemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProblemsWith applies the HasEdge predicate on the "problems" edge with a given conditions (other predicates).
+func HasProblemsWith(preds ...predicate.BazelInvocationProblem) predicate.KnownProblem {
+	return predicate.KnownProblem(func(s *sql.Selector) {
+		step := newProblemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.KnownProblem) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.KnownProblem) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.KnownProblem) predicate.KnownProblem {
+	return predicate.KnownProblem(sql.NotPredicates(p))
+}
@@ -0,0 +1,832 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocationproblem"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/knownproblem"
+)
+
+// KnownProblemCreate is the builder for creating a KnownProblem entity.
+type KnownProblemCreate struct {
+	config
+	mutation *KnownProblemMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (kpc *KnownProblemCreate) SetFingerprint(s string) *KnownProblemCreate {
+	kpc.mutation.SetFingerprint(s)
+	return kpc
+}
+
+// SetProblemType sets the "problem_type" field.
+func (kpc *KnownProblemCreate) SetProblemType(s string) *KnownProblemCreate {
+	kpc.mutation.SetProblemType(s)
+	return kpc
+}
+
+// SetLabel sets the "label" field.
+func (kpc *KnownProblemCreate) SetLabel(s string) *KnownProblemCreate {
+	kpc.mutation.SetLabel(s)
+	return kpc
+}
+
+// SetFirstSeen sets the "first_seen" field.
+func (kpc *KnownProblemCreate) SetFirstSeen(t time.Time) *KnownProblemCreate {
+	kpc.mutation.SetFirstSeen(t)
+	return kpc
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (kpc *KnownProblemCreate) SetLastSeen(t time.Time) *KnownProblemCreate {
+	kpc.mutation.SetLastSeen(t)
+	return kpc
+}
+
+// SetOccurrences sets the "occurrences" field.
+func (kpc *KnownProblemCreate) SetOccurrences(i int) *KnownProblemCreate {
+	kpc.mutation.SetOccurrences(i)
+	return kpc
+}
+
+// SetNillableOccurrences sets the "occurrences" field if the given value is not nil.
+func (kpc *KnownProblemCreate) SetNillableOccurrences(i *int) *KnownProblemCreate {
+	if i != nil {
+		kpc.SetOccurrences(*i)
+	}
+	return kpc
+}
+
+// SetBranches sets the "branches" field.
+func (kpc *KnownProblemCreate) SetBranches(s []string) *KnownProblemCreate {
+	kpc.mutation.SetBranches(s)
+	return kpc
+}
+
+// AddProblemIDs adds the "problems" edge to the BazelInvocationProblem entity by IDs.
+func (kpc *KnownProblemCreate) AddProblemIDs(ids ...int) *KnownProblemCreate {
+	kpc.mutation.AddProblemIDs(ids...)
+	return kpc
+}
+
+// AddProblems adds the "problems" edges to the BazelInvocationProblem entity.
+func (kpc *KnownProblemCreate) AddProblems(b ...*BazelInvocationProblem) *KnownProblemCreate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return kpc.AddProblemIDs(ids...)
+}
+
+// Mutation returns the KnownProblemMutation object of the builder.
+func (kpc *KnownProblemCreate) Mutation() *KnownProblemMutation {
+	return kpc.mutation
+}
+
+// Save creates the KnownProblem in the database.
+func (kpc *KnownProblemCreate) Save(ctx context.Context) (*KnownProblem, error) {
+	kpc.defaults()
+	return withHooks(ctx, kpc.sqlSave, kpc.mutation, kpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (kpc *KnownProblemCreate) SaveX(ctx context.Context) *KnownProblem {
+	v, err := kpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (kpc *KnownProblemCreate) Exec(ctx context.Context) error {
+	_, err := kpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kpc *KnownProblemCreate) ExecX(ctx context.Context) {
+	if err := kpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (kpc *KnownProblemCreate) defaults() {
+	if _, ok := kpc.mutation.Occurrences(); !ok {
+		v := knownproblem.DefaultOccurrences
+		kpc.mutation.SetOccurrences(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (kpc *KnownProblemCreate) check() error {
+	if _, ok := kpc.mutation.Fingerprint(); !ok {
+		return &ValidationError{Name: "fingerprint", err: errors.New(`ent: missing required field "KnownProblem.fingerprint"`)}
+	}
+	if _, ok := kpc.mutation.ProblemType(); !ok {
+		return &ValidationError{Name: "problem_type", err: errors.New(`ent: missing required field "KnownProblem.problem_type"`)}
+	}
+	if _, ok := kpc.mutation.Label(); !ok {
+		return &ValidationError{Name: "label", err: errors.New(`ent: missing required field "KnownProblem.label"`)}
+	}
+	if _, ok := kpc.mutation.FirstSeen(); !ok {
+		return &ValidationError{Name: "first_seen", err: errors.New(`ent: missing required field "KnownProblem.first_seen"`)}
+	}
+	if _, ok := kpc.mutation.LastSeen(); !ok {
+		return &ValidationError{Name: "last_seen", err: errors.New(`ent: missing required field "KnownProblem.last_seen"`)}
+	}
+	if _, ok := kpc.mutation.Occurrences(); !ok {
+		return &ValidationError{Name: "occurrences", err: errors.New(`ent: missing required field "KnownProblem.occurrences"`)}
+	}
+	return nil
+}
+
+func (kpc *KnownProblemCreate) sqlSave(ctx context.Context) (*KnownProblem, error) {
+	if err := kpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := kpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, kpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	kpc.mutation.id = &_node.ID
+	kpc.mutation.done = true
+	return _node, nil
+}
+
+func (kpc *KnownProblemCreate) createSpec() (*KnownProblem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &KnownProblem{config: kpc.config}
+		_spec = sqlgraph.NewCreateSpec(knownproblem.Table, sqlgraph.NewFieldSpec(knownproblem.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = kpc.conflict
+	if value, ok := kpc.mutation.Fingerprint(); ok {
+		_spec.SetField(knownproblem.FieldFingerprint, field.TypeString, value)
+		_node.Fingerprint = value
+	}
+	if value, ok := kpc.mutation.ProblemType(); ok {
+		_spec.SetField(knownproblem.FieldProblemType, field.TypeString, value)
+		_node.ProblemType = value
+	}
+	if value, ok := kpc.mutation.Label(); ok {
+		_spec.SetField(knownproblem.FieldLabel, field.TypeString, value)
+		_node.Label = value
+	}
+	if value, ok := kpc.mutation.FirstSeen(); ok {
+		_spec.SetField(knownproblem.FieldFirstSeen, field.TypeTime, value)
+		_node.FirstSeen = value
+	}
+	if value, ok := kpc.mutation.LastSeen(); ok {
+		_spec.SetField(knownproblem.FieldLastSeen, field.TypeTime, value)
+		_node.LastSeen = value
+	}
+	if value, ok := kpc.mutation.Occurrences(); ok {
+		_spec.SetField(knownproblem.FieldOccurrences, field.TypeInt, value)
+		_node.Occurrences = value
+	}
+	if value, ok := kpc.mutation.Branches(); ok {
+		_spec.SetField(knownproblem.FieldBranches, field.TypeJSON, value)
+		_node.Branches = value
+	}
+	if nodes := kpc.mutation.ProblemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   knownproblem.ProblemsTable,
+			Columns: []string{knownproblem.ProblemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocationproblem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.KnownProblem.Create().
+//		SetFingerprint(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.KnownProblemUpsert) {
+//			SetFingerprint(v+v).
+//		}).
+//		Exec(ctx)
+func (kpc *KnownProblemCreate) OnConflict(opts ...sql.ConflictOption) *KnownProblemUpsertOne {
+	kpc.conflict = opts
+	return &KnownProblemUpsertOne{
+		create: kpc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.KnownProblem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (kpc *KnownProblemCreate) OnConflictColumns(columns ...string) *KnownProblemUpsertOne {
+	kpc.conflict = append(kpc.conflict, sql.ConflictColumns(columns...))
+	return &KnownProblemUpsertOne{
+		create: kpc,
+	}
+}
+
+type (
+	// KnownProblemUpsertOne is the builder for "upsert"-ing
+	//  one KnownProblem node.
+	KnownProblemUpsertOne struct {
+		create *KnownProblemCreate
+	}
+
+	// KnownProblemUpsert is the "OnConflict" setter.
+	KnownProblemUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetProblemType sets the "problem_type" field.
+func (u *KnownProblemUpsert) SetProblemType(v string) *KnownProblemUpsert {
+	u.Set(knownproblem.FieldProblemType, v)
+	return u
+}
+
+// UpdateProblemType sets the "problem_type" field to the value that was provided on create.
+func (u *KnownProblemUpsert) UpdateProblemType() *KnownProblemUpsert {
+	u.SetExcluded(knownproblem.FieldProblemType)
+	return u
+}
+
+// SetLabel sets the "label" field.
+func (u *KnownProblemUpsert) SetLabel(v string) *KnownProblemUpsert {
+	u.Set(knownproblem.FieldLabel, v)
+	return u
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *KnownProblemUpsert) UpdateLabel() *KnownProblemUpsert {
+	u.SetExcluded(knownproblem.FieldLabel)
+	return u
+}
+
+// SetFirstSeen sets the "first_seen" field.
+func (u *KnownProblemUpsert) SetFirstSeen(v time.Time) *KnownProblemUpsert {
+	u.Set(knownproblem.FieldFirstSeen, v)
+	return u
+}
+
+// UpdateFirstSeen sets the "first_seen" field to the value that was provided on create.
+func (u *KnownProblemUpsert) UpdateFirstSeen() *KnownProblemUpsert {
+	u.SetExcluded(knownproblem.FieldFirstSeen)
+	return u
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (u *KnownProblemUpsert) SetLastSeen(v time.Time) *KnownProblemUpsert {
+	u.Set(knownproblem.FieldLastSeen, v)
+	return u
+}
+
+// UpdateLastSeen sets the "last_seen" field to the value that was provided on create.
+func (u *KnownProblemUpsert) UpdateLastSeen() *KnownProblemUpsert {
+	u.SetExcluded(knownproblem.FieldLastSeen)
+	return u
+}
+
+// SetOccurrences sets the "occurrences" field.
+func (u *KnownProblemUpsert) SetOccurrences(v int) *KnownProblemUpsert {
+	u.Set(knownproblem.FieldOccurrences, v)
+	return u
+}
+
+// UpdateOccurrences sets the "occurrences" field to the value that was provided on create.
+func (u *KnownProblemUpsert) UpdateOccurrences() *KnownProblemUpsert {
+	u.SetExcluded(knownproblem.FieldOccurrences)
+	return u
+}
+
+// AddOccurrences adds v to the "occurrences" field.
+func (u *KnownProblemUpsert) AddOccurrences(v int) *KnownProblemUpsert {
+	u.Add(knownproblem.FieldOccurrences, v)
+	return u
+}
+
+// SetBranches sets the "branches" field.
+func (u *KnownProblemUpsert) SetBranches(v []string) *KnownProblemUpsert {
+	u.Set(knownproblem.FieldBranches, v)
+	return u
+}
+
+// UpdateBranches sets the "branches" field to the value that was provided on create.
+func (u *KnownProblemUpsert) UpdateBranches() *KnownProblemUpsert {
+	u.SetExcluded(knownproblem.FieldBranches)
+	return u
+}
+
+// ClearBranches clears the value of the "branches" field.
+func (u *KnownProblemUpsert) ClearBranches() *KnownProblemUpsert {
+	u.SetNull(knownproblem.FieldBranches)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.KnownProblem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *KnownProblemUpsertOne) UpdateNewValues() *KnownProblemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.Fingerprint(); exists {
+			s.SetIgnore(knownproblem.FieldFingerprint)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.KnownProblem.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *KnownProblemUpsertOne) Ignore() *KnownProblemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *KnownProblemUpsertOne) DoNothing() *KnownProblemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the KnownProblemCreate.OnConflict
+// documentation for more info.
+func (u *KnownProblemUpsertOne) Update(set func(*KnownProblemUpsert)) *KnownProblemUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&KnownProblemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProblemType sets the "problem_type" field.
+func (u *KnownProblemUpsertOne) SetProblemType(v string) *KnownProblemUpsertOne {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.SetProblemType(v)
+	})
+}
+
+// UpdateProblemType sets the "problem_type" field to the value that was provided on create.
+func (u *KnownProblemUpsertOne) UpdateProblemType() *KnownProblemUpsertOne {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.UpdateProblemType()
+	})
+}
+
+// SetLabel sets the "label" field.
+func (u *KnownProblemUpsertOne) SetLabel(v string) *KnownProblemUpsertOne {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.SetLabel(v)
+	})
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *KnownProblemUpsertOne) UpdateLabel() *KnownProblemUpsertOne {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.UpdateLabel()
+	})
+}
+
+// SetFirstSeen sets the "first_seen" field.
+func (u *KnownProblemUpsertOne) SetFirstSeen(v time.Time) *KnownProblemUpsertOne {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.SetFirstSeen(v)
+	})
+}
+
+// UpdateFirstSeen sets the "first_seen" field to the value that was provided on create.
+func (u *KnownProblemUpsertOne) UpdateFirstSeen() *KnownProblemUpsertOne {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.UpdateFirstSeen()
+	})
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (u *KnownProblemUpsertOne) SetLastSeen(v time.Time) *KnownProblemUpsertOne {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.SetLastSeen(v)
+	})
+}
+
+// UpdateLastSeen sets the "last_seen" field to the value that was provided on create.
+func (u *KnownProblemUpsertOne) UpdateLastSeen() *KnownProblemUpsertOne {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.UpdateLastSeen()
+	})
+}
+
+// SetOccurrences sets the "occurrences" field.
+func (u *KnownProblemUpsertOne) SetOccurrences(v int) *KnownProblemUpsertOne {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.SetOccurrences(v)
+	})
+}
+
+// AddOccurrences adds v to the "occurrences" field.
+func (u *KnownProblemUpsertOne) AddOccurrences(v int) *KnownProblemUpsertOne {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.AddOccurrences(v)
+	})
+}
+
+// UpdateOccurrences sets the "occurrences" field to the value that was provided on create.
+func (u *KnownProblemUpsertOne) UpdateOccurrences() *KnownProblemUpsertOne {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.UpdateOccurrences()
+	})
+}
+
+// SetBranches sets the "branches" field.
+func (u *KnownProblemUpsertOne) SetBranches(v []string) *KnownProblemUpsertOne {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.SetBranches(v)
+	})
+}
+
+// UpdateBranches sets the "branches" field to the value that was provided on create.
+func (u *KnownProblemUpsertOne) UpdateBranches() *KnownProblemUpsertOne {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.UpdateBranches()
+	})
+}
+
+// ClearBranches clears the value of the "branches" field.
+func (u *KnownProblemUpsertOne) ClearBranches() *KnownProblemUpsertOne {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.ClearBranches()
+	})
+}
+
+// Exec executes the query.
+func (u *KnownProblemUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for KnownProblemCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *KnownProblemUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *KnownProblemUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *KnownProblemUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// KnownProblemCreateBulk is the builder for creating many KnownProblem entities in bulk.
+type KnownProblemCreateBulk struct {
+	config
+	err      error
+	builders []*KnownProblemCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the KnownProblem entities in the database.
+func (kpcb *KnownProblemCreateBulk) Save(ctx context.Context) ([]*KnownProblem, error) {
+	if kpcb.err != nil {
+		return nil, kpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(kpcb.builders))
+	nodes := make([]*KnownProblem, len(kpcb.builders))
+	mutators := make([]Mutator, len(kpcb.builders))
+	for i := range kpcb.builders {
+		func(i int, root context.Context) {
+			builder := kpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*KnownProblemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, kpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = kpcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, kpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, kpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (kpcb *KnownProblemCreateBulk) SaveX(ctx context.Context) []*KnownProblem {
+	v, err := kpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (kpcb *KnownProblemCreateBulk) Exec(ctx context.Context) error {
+	_, err := kpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kpcb *KnownProblemCreateBulk) ExecX(ctx context.Context) {
+	if err := kpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.KnownProblem.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.KnownProblemUpsert) {
+//			SetFingerprint(v+v).
+//		}).
+//		Exec(ctx)
+func (kpcb *KnownProblemCreateBulk) OnConflict(opts ...sql.ConflictOption) *KnownProblemUpsertBulk {
+	kpcb.conflict = opts
+	return &KnownProblemUpsertBulk{
+		create: kpcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.KnownProblem.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (kpcb *KnownProblemCreateBulk) OnConflictColumns(columns ...string) *KnownProblemUpsertBulk {
+	kpcb.conflict = append(kpcb.conflict, sql.ConflictColumns(columns...))
+	return &KnownProblemUpsertBulk{
+		create: kpcb,
+	}
+}
+
+// KnownProblemUpsertBulk is the builder for "upsert"-ing
+// a bulk of KnownProblem nodes.
+type KnownProblemUpsertBulk struct {
+	create *KnownProblemCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.KnownProblem.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *KnownProblemUpsertBulk) UpdateNewValues() *KnownProblemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.Fingerprint(); exists {
+				s.SetIgnore(knownproblem.FieldFingerprint)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.KnownProblem.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *KnownProblemUpsertBulk) Ignore() *KnownProblemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *KnownProblemUpsertBulk) DoNothing() *KnownProblemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the KnownProblemCreateBulk.OnConflict
+// documentation for more info.
+func (u *KnownProblemUpsertBulk) Update(set func(*KnownProblemUpsert)) *KnownProblemUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&KnownProblemUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetProblemType sets the "problem_type" field.
+func (u *KnownProblemUpsertBulk) SetProblemType(v string) *KnownProblemUpsertBulk {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.SetProblemType(v)
+	})
+}
+
+// UpdateProblemType sets the "problem_type" field to the value that was provided on create.
+func (u *KnownProblemUpsertBulk) UpdateProblemType() *KnownProblemUpsertBulk {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.UpdateProblemType()
+	})
+}
+
+// SetLabel sets the "label" field.
+func (u *KnownProblemUpsertBulk) SetLabel(v string) *KnownProblemUpsertBulk {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.SetLabel(v)
+	})
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *KnownProblemUpsertBulk) UpdateLabel() *KnownProblemUpsertBulk {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.UpdateLabel()
+	})
+}
+
+// SetFirstSeen sets the "first_seen" field.
+func (u *KnownProblemUpsertBulk) SetFirstSeen(v time.Time) *KnownProblemUpsertBulk {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.SetFirstSeen(v)
+	})
+}
+
+// UpdateFirstSeen sets the "first_seen" field to the value that was provided on create.
+func (u *KnownProblemUpsertBulk) UpdateFirstSeen() *KnownProblemUpsertBulk {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.UpdateFirstSeen()
+	})
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (u *KnownProblemUpsertBulk) SetLastSeen(v time.Time) *KnownProblemUpsertBulk {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.SetLastSeen(v)
+	})
+}
+
+// UpdateLastSeen sets the "last_seen" field to the value that was provided on create.
+func (u *KnownProblemUpsertBulk) UpdateLastSeen() *KnownProblemUpsertBulk {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.UpdateLastSeen()
+	})
+}
+
+// SetOccurrences sets the "occurrences" field.
+func (u *KnownProblemUpsertBulk) SetOccurrences(v int) *KnownProblemUpsertBulk {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.SetOccurrences(v)
+	})
+}
+
+// AddOccurrences adds v to the "occurrences" field.
+func (u *KnownProblemUpsertBulk) AddOccurrences(v int) *KnownProblemUpsertBulk {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.AddOccurrences(v)
+	})
+}
+
+// UpdateOccurrences sets the "occurrences" field to the value that was provided on create.
+func (u *KnownProblemUpsertBulk) UpdateOccurrences() *KnownProblemUpsertBulk {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.UpdateOccurrences()
+	})
+}
+
+// SetBranches sets the "branches" field.
+func (u *KnownProblemUpsertBulk) SetBranches(v []string) *KnownProblemUpsertBulk {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.SetBranches(v)
+	})
+}
+
+// UpdateBranches sets the "branches" field to the value that was provided on create.
+func (u *KnownProblemUpsertBulk) UpdateBranches() *KnownProblemUpsertBulk {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.UpdateBranches()
+	})
+}
+
+// ClearBranches clears the value of the "branches" field.
+func (u *KnownProblemUpsertBulk) ClearBranches() *KnownProblemUpsertBulk {
+	return u.Update(func(s *KnownProblemUpsert) {
+		s.ClearBranches()
+	})
+}
+
+// Exec executes the query.
+func (u *KnownProblemUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the KnownProblemCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for KnownProblemCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *KnownProblemUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
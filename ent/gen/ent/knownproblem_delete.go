@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/knownproblem"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/predicate"
+)
+
+// KnownProblemDelete is the builder for deleting a KnownProblem entity.
+type KnownProblemDelete struct {
+	config
+	hooks    []Hook
+	mutation *KnownProblemMutation
+}
+
+// Where appends a list predicates to the KnownProblemDelete builder.
+func (kpd *KnownProblemDelete) Where(ps ...predicate.KnownProblem) *KnownProblemDelete {
+	kpd.mutation.Where(ps...)
+	return kpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (kpd *KnownProblemDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, kpd.sqlExec, kpd.mutation, kpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kpd *KnownProblemDelete) ExecX(ctx context.Context) int {
+	n, err := kpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (kpd *KnownProblemDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(knownproblem.Table, sqlgraph.NewFieldSpec(knownproblem.FieldID, field.TypeInt))
+	if ps := kpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, kpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	kpd.mutation.done = true
+	return affected, err
+}
+
+// KnownProblemDeleteOne is the builder for deleting a single KnownProblem entity.
+type KnownProblemDeleteOne struct {
+	kpd *KnownProblemDelete
+}
+
+// Where appends a list predicates to the KnownProblemDelete builder.
+func (kpdo *KnownProblemDeleteOne) Where(ps ...predicate.KnownProblem) *KnownProblemDeleteOne {
+	kpdo.kpd.mutation.Where(ps...)
+	return kpdo
+}
+
+// Exec executes the deletion query.
+func (kpdo *KnownProblemDeleteOne) Exec(ctx context.Context) error {
+	n, err := kpdo.kpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{knownproblem.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kpdo *KnownProblemDeleteOne) ExecX(ctx context.Context) {
+	if err := kpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
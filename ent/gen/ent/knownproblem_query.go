@@ -0,0 +1,641 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocationproblem"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/knownproblem"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/predicate"
+)
+
+// KnownProblemQuery is the builder for querying KnownProblem entities.
+type KnownProblemQuery struct {
+	config
+	ctx               *QueryContext
+	order             []knownproblem.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.KnownProblem
+	withProblems      *BazelInvocationProblemQuery
+	modifiers         []func(*sql.Selector)
+	loadTotal         []func(context.Context, []*KnownProblem) error
+	withNamedProblems map[string]*BazelInvocationProblemQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the KnownProblemQuery builder.
+func (kpq *KnownProblemQuery) Where(ps ...predicate.KnownProblem) *KnownProblemQuery {
+	kpq.predicates = append(kpq.predicates, ps...)
+	return kpq
+}
+
+// Limit the number of records to be returned by this query.
+func (kpq *KnownProblemQuery) Limit(limit int) *KnownProblemQuery {
+	kpq.ctx.Limit = &limit
+	return kpq
+}
+
+// Offset to start from.
+func (kpq *KnownProblemQuery) Offset(offset int) *KnownProblemQuery {
+	kpq.ctx.Offset = &offset
+	return kpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (kpq *KnownProblemQuery) Unique(unique bool) *KnownProblemQuery {
+	kpq.ctx.Unique = &unique
+	return kpq
+}
+
+// Order specifies how the records should be ordered.
+func (kpq *KnownProblemQuery) Order(o ...knownproblem.OrderOption) *KnownProblemQuery {
+	kpq.order = append(kpq.order, o...)
+	return kpq
+}
+
+// QueryProblems chains the current query on the "problems" edge.
+func (kpq *KnownProblemQuery) QueryProblems() *BazelInvocationProblemQuery {
+	query := (&BazelInvocationProblemClient{config: kpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := kpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := kpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(knownproblem.Table, knownproblem.FieldID, selector),
+			sqlgraph.To(bazelinvocationproblem.Table, bazelinvocationproblem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, knownproblem.ProblemsTable, knownproblem.ProblemsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(kpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first KnownProblem entity from the query.
+// Returns a *NotFoundError when no KnownProblem was found.
+func (kpq *KnownProblemQuery) First(ctx context.Context) (*KnownProblem, error) {
+	nodes, err := kpq.Limit(1).All(setContextOp(ctx, kpq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{knownproblem.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (kpq *KnownProblemQuery) FirstX(ctx context.Context) *KnownProblem {
+	node, err := kpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first KnownProblem ID from the query.
+// Returns a *NotFoundError when no KnownProblem ID was found.
+func (kpq *KnownProblemQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = kpq.Limit(1).IDs(setContextOp(ctx, kpq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{knownproblem.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (kpq *KnownProblemQuery) FirstIDX(ctx context.Context) int {
+	id, err := kpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single KnownProblem entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one KnownProblem entity is found.
+// Returns a *NotFoundError when no KnownProblem entities are found.
+func (kpq *KnownProblemQuery) Only(ctx context.Context) (*KnownProblem, error) {
+	nodes, err := kpq.Limit(2).All(setContextOp(ctx, kpq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{knownproblem.Label}
+	default:
+		return nil, &NotSingularError{knownproblem.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (kpq *KnownProblemQuery) OnlyX(ctx context.Context) *KnownProblem {
+	node, err := kpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only KnownProblem ID in the query.
+// Returns a *NotSingularError when more than one KnownProblem ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (kpq *KnownProblemQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = kpq.Limit(2).IDs(setContextOp(ctx, kpq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{knownproblem.Label}
+	default:
+		err = &NotSingularError{knownproblem.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (kpq *KnownProblemQuery) OnlyIDX(ctx context.Context) int {
+	id, err := kpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of KnownProblems.
+func (kpq *KnownProblemQuery) All(ctx context.Context) ([]*KnownProblem, error) {
+	ctx = setContextOp(ctx, kpq.ctx, "All")
+	if err := kpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*KnownProblem, *KnownProblemQuery]()
+	return withInterceptors[[]*KnownProblem](ctx, kpq, qr, kpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (kpq *KnownProblemQuery) AllX(ctx context.Context) []*KnownProblem {
+	nodes, err := kpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of KnownProblem IDs.
+func (kpq *KnownProblemQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if kpq.ctx.Unique == nil && kpq.path != nil {
+		kpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, kpq.ctx, "IDs")
+	if err = kpq.Select(knownproblem.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (kpq *KnownProblemQuery) IDsX(ctx context.Context) []int {
+	ids, err := kpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (kpq *KnownProblemQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, kpq.ctx, "Count")
+	if err := kpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, kpq, querierCount[*KnownProblemQuery](), kpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (kpq *KnownProblemQuery) CountX(ctx context.Context) int {
+	count, err := kpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (kpq *KnownProblemQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, kpq.ctx, "Exist")
+	switch _, err := kpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (kpq *KnownProblemQuery) ExistX(ctx context.Context) bool {
+	exist, err := kpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the KnownProblemQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (kpq *KnownProblemQuery) Clone() *KnownProblemQuery {
+	if kpq == nil {
+		return nil
+	}
+	return &KnownProblemQuery{
+		config:       kpq.config,
+		ctx:          kpq.ctx.Clone(),
+		order:        append([]knownproblem.OrderOption{}, kpq.order...),
+		inters:       append([]Interceptor{}, kpq.inters...),
+		predicates:   append([]predicate.KnownProblem{}, kpq.predicates...),
+		withProblems: kpq.withProblems.Clone(),
+		// clone intermediate query.
+		sql:  kpq.sql.Clone(),
+		path: kpq.path,
+	}
+}
+
+// WithProblems tells the query-builder to eager-load the nodes that are connected to
+// the "problems" edge. The optional arguments are used to configure the query builder of the edge.
+func (kpq *KnownProblemQuery) WithProblems(opts ...func(*BazelInvocationProblemQuery)) *KnownProblemQuery {
+	query := (&BazelInvocationProblemClient{config: kpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	kpq.withProblems = query
+	return kpq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Fingerprint string `json:"fingerprint,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.KnownProblem.Query().
+//		GroupBy(knownproblem.FieldFingerprint).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (kpq *KnownProblemQuery) GroupBy(field string, fields ...string) *KnownProblemGroupBy {
+	kpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &KnownProblemGroupBy{build: kpq}
+	grbuild.flds = &kpq.ctx.Fields
+	grbuild.label = knownproblem.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Fingerprint string `json:"fingerprint,omitempty"`
+//	}
+//
+//	client.KnownProblem.Query().
+//		Select(knownproblem.FieldFingerprint).
+//		Scan(ctx, &v)
+func (kpq *KnownProblemQuery) Select(fields ...string) *KnownProblemSelect {
+	kpq.ctx.Fields = append(kpq.ctx.Fields, fields...)
+	sbuild := &KnownProblemSelect{KnownProblemQuery: kpq}
+	sbuild.label = knownproblem.Label
+	sbuild.flds, sbuild.scan = &kpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a KnownProblemSelect configured with the given aggregations.
+func (kpq *KnownProblemQuery) Aggregate(fns ...AggregateFunc) *KnownProblemSelect {
+	return kpq.Select().Aggregate(fns...)
+}
+
+func (kpq *KnownProblemQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range kpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, kpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range kpq.ctx.Fields {
+		if !knownproblem.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if kpq.path != nil {
+		prev, err := kpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		kpq.sql = prev
+	}
+	return nil
+}
+
+func (kpq *KnownProblemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*KnownProblem, error) {
+	var (
+		nodes       = []*KnownProblem{}
+		_spec       = kpq.querySpec()
+		loadedTypes = [1]bool{
+			kpq.withProblems != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*KnownProblem).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &KnownProblem{config: kpq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(kpq.modifiers) > 0 {
+		_spec.Modifiers = kpq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, kpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := kpq.withProblems; query != nil {
+		if err := kpq.loadProblems(ctx, query, nodes,
+			func(n *KnownProblem) { n.Edges.Problems = []*BazelInvocationProblem{} },
+			func(n *KnownProblem, e *BazelInvocationProblem) { n.Edges.Problems = append(n.Edges.Problems, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range kpq.withNamedProblems {
+		if err := kpq.loadProblems(ctx, query, nodes,
+			func(n *KnownProblem) { n.appendNamedProblems(name) },
+			func(n *KnownProblem, e *BazelInvocationProblem) { n.appendNamedProblems(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range kpq.loadTotal {
+		if err := kpq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (kpq *KnownProblemQuery) loadProblems(ctx context.Context, query *BazelInvocationProblemQuery, nodes []*KnownProblem, init func(*KnownProblem), assign func(*KnownProblem, *BazelInvocationProblem)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*KnownProblem)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.BazelInvocationProblem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(knownproblem.ProblemsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.known_problem_problems
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "known_problem_problems" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "known_problem_problems" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (kpq *KnownProblemQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := kpq.querySpec()
+	if len(kpq.modifiers) > 0 {
+		_spec.Modifiers = kpq.modifiers
+	}
+	_spec.Node.Columns = kpq.ctx.Fields
+	if len(kpq.ctx.Fields) > 0 {
+		_spec.Unique = kpq.ctx.Unique != nil && *kpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, kpq.driver, _spec)
+}
+
+func (kpq *KnownProblemQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(knownproblem.Table, knownproblem.Columns, sqlgraph.NewFieldSpec(knownproblem.FieldID, field.TypeInt))
+	_spec.From = kpq.sql
+	if unique := kpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if kpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := kpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, knownproblem.FieldID)
+		for i := range fields {
+			if fields[i] != knownproblem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := kpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := kpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := kpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := kpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (kpq *KnownProblemQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(kpq.driver.Dialect())
+	t1 := builder.Table(knownproblem.Table)
+	columns := kpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = knownproblem.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if kpq.sql != nil {
+		selector = kpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if kpq.ctx.Unique != nil && *kpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range kpq.predicates {
+		p(selector)
+	}
+	for _, p := range kpq.order {
+		p(selector)
+	}
+	if offset := kpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := kpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WithNamedProblems tells the query-builder to eager-load the nodes that are connected to the "problems"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (kpq *KnownProblemQuery) WithNamedProblems(name string, opts ...func(*BazelInvocationProblemQuery)) *KnownProblemQuery {
+	query := (&BazelInvocationProblemClient{config: kpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if kpq.withNamedProblems == nil {
+		kpq.withNamedProblems = make(map[string]*BazelInvocationProblemQuery)
+	}
+	kpq.withNamedProblems[name] = query
+	return kpq
+}
+
+// KnownProblemGroupBy is the group-by builder for KnownProblem entities.
+type KnownProblemGroupBy struct {
+	selector
+	build *KnownProblemQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (kpgb *KnownProblemGroupBy) Aggregate(fns ...AggregateFunc) *KnownProblemGroupBy {
+	kpgb.fns = append(kpgb.fns, fns...)
+	return kpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (kpgb *KnownProblemGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, kpgb.build.ctx, "GroupBy")
+	if err := kpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KnownProblemQuery, *KnownProblemGroupBy](ctx, kpgb.build, kpgb, kpgb.build.inters, v)
+}
+
+func (kpgb *KnownProblemGroupBy) sqlScan(ctx context.Context, root *KnownProblemQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(kpgb.fns))
+	for _, fn := range kpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*kpgb.flds)+len(kpgb.fns))
+		for _, f := range *kpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*kpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := kpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// KnownProblemSelect is the builder for selecting fields of KnownProblem entities.
+type KnownProblemSelect struct {
+	*KnownProblemQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (kps *KnownProblemSelect) Aggregate(fns ...AggregateFunc) *KnownProblemSelect {
+	kps.fns = append(kps.fns, fns...)
+	return kps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (kps *KnownProblemSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, kps.ctx, "Select")
+	if err := kps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KnownProblemQuery, *KnownProblemSelect](ctx, kps.KnownProblemQuery, kps, kps.inters, v)
+}
+
+func (kps *KnownProblemSelect) sqlScan(ctx context.Context, root *KnownProblemQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(kps.fns))
+	for _, fn := range kps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*kps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := kps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,588 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocationproblem"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/knownproblem"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/predicate"
+)
+
+// KnownProblemUpdate is the builder for updating KnownProblem entities.
+type KnownProblemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *KnownProblemMutation
+}
+
+// Where appends a list predicates to the KnownProblemUpdate builder.
+func (kpu *KnownProblemUpdate) Where(ps ...predicate.KnownProblem) *KnownProblemUpdate {
+	kpu.mutation.Where(ps...)
+	return kpu
+}
+
+// SetProblemType sets the "problem_type" field.
+func (kpu *KnownProblemUpdate) SetProblemType(s string) *KnownProblemUpdate {
+	kpu.mutation.SetProblemType(s)
+	return kpu
+}
+
+// SetNillableProblemType sets the "problem_type" field if the given value is not nil.
+func (kpu *KnownProblemUpdate) SetNillableProblemType(s *string) *KnownProblemUpdate {
+	if s != nil {
+		kpu.SetProblemType(*s)
+	}
+	return kpu
+}
+
+// SetLabel sets the "label" field.
+func (kpu *KnownProblemUpdate) SetLabel(s string) *KnownProblemUpdate {
+	kpu.mutation.SetLabel(s)
+	return kpu
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (kpu *KnownProblemUpdate) SetNillableLabel(s *string) *KnownProblemUpdate {
+	if s != nil {
+		kpu.SetLabel(*s)
+	}
+	return kpu
+}
+
+// SetFirstSeen sets the "first_seen" field.
+func (kpu *KnownProblemUpdate) SetFirstSeen(t time.Time) *KnownProblemUpdate {
+	kpu.mutation.SetFirstSeen(t)
+	return kpu
+}
+
+// SetNillableFirstSeen sets the "first_seen" field if the given value is not nil.
+func (kpu *KnownProblemUpdate) SetNillableFirstSeen(t *time.Time) *KnownProblemUpdate {
+	if t != nil {
+		kpu.SetFirstSeen(*t)
+	}
+	return kpu
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (kpu *KnownProblemUpdate) SetLastSeen(t time.Time) *KnownProblemUpdate {
+	kpu.mutation.SetLastSeen(t)
+	return kpu
+}
+
+// SetNillableLastSeen sets the "last_seen" field if the given value is not nil.
+func (kpu *KnownProblemUpdate) SetNillableLastSeen(t *time.Time) *KnownProblemUpdate {
+	if t != nil {
+		kpu.SetLastSeen(*t)
+	}
+	return kpu
+}
+
+// SetOccurrences sets the "occurrences" field.
+func (kpu *KnownProblemUpdate) SetOccurrences(i int) *KnownProblemUpdate {
+	kpu.mutation.ResetOccurrences()
+	kpu.mutation.SetOccurrences(i)
+	return kpu
+}
+
+// SetNillableOccurrences sets the "occurrences" field if the given value is not nil.
+func (kpu *KnownProblemUpdate) SetNillableOccurrences(i *int) *KnownProblemUpdate {
+	if i != nil {
+		kpu.SetOccurrences(*i)
+	}
+	return kpu
+}
+
+// AddOccurrences adds i to the "occurrences" field.
+func (kpu *KnownProblemUpdate) AddOccurrences(i int) *KnownProblemUpdate {
+	kpu.mutation.AddOccurrences(i)
+	return kpu
+}
+
+// SetBranches sets the "branches" field.
+func (kpu *KnownProblemUpdate) SetBranches(s []string) *KnownProblemUpdate {
+	kpu.mutation.SetBranches(s)
+	return kpu
+}
+
+// AppendBranches appends s to the "branches" field.
+func (kpu *KnownProblemUpdate) AppendBranches(s []string) *KnownProblemUpdate {
+	kpu.mutation.AppendBranches(s)
+	return kpu
+}
+
+// ClearBranches clears the value of the "branches" field.
+func (kpu *KnownProblemUpdate) ClearBranches() *KnownProblemUpdate {
+	kpu.mutation.ClearBranches()
+	return kpu
+}
+
+// AddProblemIDs adds the "problems" edge to the BazelInvocationProblem entity by IDs.
+func (kpu *KnownProblemUpdate) AddProblemIDs(ids ...int) *KnownProblemUpdate {
+	kpu.mutation.AddProblemIDs(ids...)
+	return kpu
+}
+
+// AddProblems adds the "problems" edges to the BazelInvocationProblem entity.
+func (kpu *KnownProblemUpdate) AddProblems(b ...*BazelInvocationProblem) *KnownProblemUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return kpu.AddProblemIDs(ids...)
+}
+
+// Mutation returns the KnownProblemMutation object of the builder.
+func (kpu *KnownProblemUpdate) Mutation() *KnownProblemMutation {
+	return kpu.mutation
+}
+
+// ClearProblems clears all "problems" edges to the BazelInvocationProblem entity.
+func (kpu *KnownProblemUpdate) ClearProblems() *KnownProblemUpdate {
+	kpu.mutation.ClearProblems()
+	return kpu
+}
+
+// RemoveProblemIDs removes the "problems" edge to BazelInvocationProblem entities by IDs.
+func (kpu *KnownProblemUpdate) RemoveProblemIDs(ids ...int) *KnownProblemUpdate {
+	kpu.mutation.RemoveProblemIDs(ids...)
+	return kpu
+}
+
+// RemoveProblems removes "problems" edges to BazelInvocationProblem entities.
+func (kpu *KnownProblemUpdate) RemoveProblems(b ...*BazelInvocationProblem) *KnownProblemUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return kpu.RemoveProblemIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (kpu *KnownProblemUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, kpu.sqlSave, kpu.mutation, kpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (kpu *KnownProblemUpdate) SaveX(ctx context.Context) int {
+	affected, err := kpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (kpu *KnownProblemUpdate) Exec(ctx context.Context) error {
+	_, err := kpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kpu *KnownProblemUpdate) ExecX(ctx context.Context) {
+	if err := kpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (kpu *KnownProblemUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(knownproblem.Table, knownproblem.Columns, sqlgraph.NewFieldSpec(knownproblem.FieldID, field.TypeInt))
+	if ps := kpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := kpu.mutation.ProblemType(); ok {
+		_spec.SetField(knownproblem.FieldProblemType, field.TypeString, value)
+	}
+	if value, ok := kpu.mutation.Label(); ok {
+		_spec.SetField(knownproblem.FieldLabel, field.TypeString, value)
+	}
+	if value, ok := kpu.mutation.FirstSeen(); ok {
+		_spec.SetField(knownproblem.FieldFirstSeen, field.TypeTime, value)
+	}
+	if value, ok := kpu.mutation.LastSeen(); ok {
+		_spec.SetField(knownproblem.FieldLastSeen, field.TypeTime, value)
+	}
+	if value, ok := kpu.mutation.Occurrences(); ok {
+		_spec.SetField(knownproblem.FieldOccurrences, field.TypeInt, value)
+	}
+	if value, ok := kpu.mutation.AddedOccurrences(); ok {
+		_spec.AddField(knownproblem.FieldOccurrences, field.TypeInt, value)
+	}
+	if value, ok := kpu.mutation.Branches(); ok {
+		_spec.SetField(knownproblem.FieldBranches, field.TypeJSON, value)
+	}
+	if value, ok := kpu.mutation.AppendedBranches(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, knownproblem.FieldBranches, value)
+		})
+	}
+	if kpu.mutation.BranchesCleared() {
+		_spec.ClearField(knownproblem.FieldBranches, field.TypeJSON)
+	}
+	if kpu.mutation.ProblemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   knownproblem.ProblemsTable,
+			Columns: []string{knownproblem.ProblemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocationproblem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := kpu.mutation.RemovedProblemsIDs(); len(nodes) > 0 && !kpu.mutation.ProblemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   knownproblem.ProblemsTable,
+			Columns: []string{knownproblem.ProblemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocationproblem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := kpu.mutation.ProblemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   knownproblem.ProblemsTable,
+			Columns: []string{knownproblem.ProblemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocationproblem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, kpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{knownproblem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	kpu.mutation.done = true
+	return n, nil
+}
+
+// KnownProblemUpdateOne is the builder for updating a single KnownProblem entity.
+type KnownProblemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *KnownProblemMutation
+}
+
+// SetProblemType sets the "problem_type" field.
+func (kpuo *KnownProblemUpdateOne) SetProblemType(s string) *KnownProblemUpdateOne {
+	kpuo.mutation.SetProblemType(s)
+	return kpuo
+}
+
+// SetNillableProblemType sets the "problem_type" field if the given value is not nil.
+func (kpuo *KnownProblemUpdateOne) SetNillableProblemType(s *string) *KnownProblemUpdateOne {
+	if s != nil {
+		kpuo.SetProblemType(*s)
+	}
+	return kpuo
+}
+
+// SetLabel sets the "label" field.
+func (kpuo *KnownProblemUpdateOne) SetLabel(s string) *KnownProblemUpdateOne {
+	kpuo.mutation.SetLabel(s)
+	return kpuo
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (kpuo *KnownProblemUpdateOne) SetNillableLabel(s *string) *KnownProblemUpdateOne {
+	if s != nil {
+		kpuo.SetLabel(*s)
+	}
+	return kpuo
+}
+
+// SetFirstSeen sets the "first_seen" field.
+func (kpuo *KnownProblemUpdateOne) SetFirstSeen(t time.Time) *KnownProblemUpdateOne {
+	kpuo.mutation.SetFirstSeen(t)
+	return kpuo
+}
+
+// SetNillableFirstSeen sets the "first_seen" field if the given value is not nil.
+func (kpuo *KnownProblemUpdateOne) SetNillableFirstSeen(t *time.Time) *KnownProblemUpdateOne {
+	if t != nil {
+		kpuo.SetFirstSeen(*t)
+	}
+	return kpuo
+}
+
+// SetLastSeen sets the "last_seen" field.
+func (kpuo *KnownProblemUpdateOne) SetLastSeen(t time.Time) *KnownProblemUpdateOne {
+	kpuo.mutation.SetLastSeen(t)
+	return kpuo
+}
+
+// SetNillableLastSeen sets the "last_seen" field if the given value is not nil.
+func (kpuo *KnownProblemUpdateOne) SetNillableLastSeen(t *time.Time) *KnownProblemUpdateOne {
+	if t != nil {
+		kpuo.SetLastSeen(*t)
+	}
+	return kpuo
+}
+
+// SetOccurrences sets the "occurrences" field.
+func (kpuo *KnownProblemUpdateOne) SetOccurrences(i int) *KnownProblemUpdateOne {
+	kpuo.mutation.ResetOccurrences()
+	kpuo.mutation.SetOccurrences(i)
+	return kpuo
+}
+
+// SetNillableOccurrences sets the "occurrences" field if the given value is not nil.
+func (kpuo *KnownProblemUpdateOne) SetNillableOccurrences(i *int) *KnownProblemUpdateOne {
+	if i != nil {
+		kpuo.SetOccurrences(*i)
+	}
+	return kpuo
+}
+
+// AddOccurrences adds i to the "occurrences" field.
+func (kpuo *KnownProblemUpdateOne) AddOccurrences(i int) *KnownProblemUpdateOne {
+	kpuo.mutation.AddOccurrences(i)
+	return kpuo
+}
+
+// SetBranches sets the "branches" field.
+func (kpuo *KnownProblemUpdateOne) SetBranches(s []string) *KnownProblemUpdateOne {
+	kpuo.mutation.SetBranches(s)
+	return kpuo
+}
+
+// AppendBranches appends s to the "branches" field.
+func (kpuo *KnownProblemUpdateOne) AppendBranches(s []string) *KnownProblemUpdateOne {
+	kpuo.mutation.AppendBranches(s)
+	return kpuo
+}
+
+// ClearBranches clears the value of the "branches" field.
+func (kpuo *KnownProblemUpdateOne) ClearBranches() *KnownProblemUpdateOne {
+	kpuo.mutation.ClearBranches()
+	return kpuo
+}
+
+// AddProblemIDs adds the "problems" edge to the BazelInvocationProblem entity by IDs.
+func (kpuo *KnownProblemUpdateOne) AddProblemIDs(ids ...int) *KnownProblemUpdateOne {
+	kpuo.mutation.AddProblemIDs(ids...)
+	return kpuo
+}
+
+// AddProblems adds the "problems" edges to the BazelInvocationProblem entity.
+func (kpuo *KnownProblemUpdateOne) AddProblems(b ...*BazelInvocationProblem) *KnownProblemUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return kpuo.AddProblemIDs(ids...)
+}
+
+// Mutation returns the KnownProblemMutation object of the builder.
+func (kpuo *KnownProblemUpdateOne) Mutation() *KnownProblemMutation {
+	return kpuo.mutation
+}
+
+// ClearProblems clears all "problems" edges to the BazelInvocationProblem entity.
+func (kpuo *KnownProblemUpdateOne) ClearProblems() *KnownProblemUpdateOne {
+	kpuo.mutation.ClearProblems()
+	return kpuo
+}
+
+// RemoveProblemIDs removes the "problems" edge to BazelInvocationProblem entities by IDs.
+func (kpuo *KnownProblemUpdateOne) RemoveProblemIDs(ids ...int) *KnownProblemUpdateOne {
+	kpuo.mutation.RemoveProblemIDs(ids...)
+	return kpuo
+}
+
+// RemoveProblems removes "problems" edges to BazelInvocationProblem entities.
+func (kpuo *KnownProblemUpdateOne) RemoveProblems(b ...*BazelInvocationProblem) *KnownProblemUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return kpuo.RemoveProblemIDs(ids...)
+}
+
+// Where appends a list predicates to the KnownProblemUpdate builder.
+func (kpuo *KnownProblemUpdateOne) Where(ps ...predicate.KnownProblem) *KnownProblemUpdateOne {
+	kpuo.mutation.Where(ps...)
+	return kpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (kpuo *KnownProblemUpdateOne) Select(field string, fields ...string) *KnownProblemUpdateOne {
+	kpuo.fields = append([]string{field}, fields...)
+	return kpuo
+}
+
+// Save executes the query and returns the updated KnownProblem entity.
+func (kpuo *KnownProblemUpdateOne) Save(ctx context.Context) (*KnownProblem, error) {
+	return withHooks(ctx, kpuo.sqlSave, kpuo.mutation, kpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (kpuo *KnownProblemUpdateOne) SaveX(ctx context.Context) *KnownProblem {
+	node, err := kpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (kpuo *KnownProblemUpdateOne) Exec(ctx context.Context) error {
+	_, err := kpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kpuo *KnownProblemUpdateOne) ExecX(ctx context.Context) {
+	if err := kpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (kpuo *KnownProblemUpdateOne) sqlSave(ctx context.Context) (_node *KnownProblem, err error) {
+	_spec := sqlgraph.NewUpdateSpec(knownproblem.Table, knownproblem.Columns, sqlgraph.NewFieldSpec(knownproblem.FieldID, field.TypeInt))
+	id, ok := kpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "KnownProblem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := kpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, knownproblem.FieldID)
+		for _, f := range fields {
+			if !knownproblem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != knownproblem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := kpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := kpuo.mutation.ProblemType(); ok {
+		_spec.SetField(knownproblem.FieldProblemType, field.TypeString, value)
+	}
+	if value, ok := kpuo.mutation.Label(); ok {
+		_spec.SetField(knownproblem.FieldLabel, field.TypeString, value)
+	}
+	if value, ok := kpuo.mutation.FirstSeen(); ok {
+		_spec.SetField(knownproblem.FieldFirstSeen, field.TypeTime, value)
+	}
+	if value, ok := kpuo.mutation.LastSeen(); ok {
+		_spec.SetField(knownproblem.FieldLastSeen, field.TypeTime, value)
+	}
+	if value, ok := kpuo.mutation.Occurrences(); ok {
+		_spec.SetField(knownproblem.FieldOccurrences, field.TypeInt, value)
+	}
+	if value, ok := kpuo.mutation.AddedOccurrences(); ok {
+		_spec.AddField(knownproblem.FieldOccurrences, field.TypeInt, value)
+	}
+	if value, ok := kpuo.mutation.Branches(); ok {
+		_spec.SetField(knownproblem.FieldBranches, field.TypeJSON, value)
+	}
+	if value, ok := kpuo.mutation.AppendedBranches(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, knownproblem.FieldBranches, value)
+		})
+	}
+	if kpuo.mutation.BranchesCleared() {
+		_spec.ClearField(knownproblem.FieldBranches, field.TypeJSON)
+	}
+	if kpuo.mutation.ProblemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   knownproblem.ProblemsTable,
+			Columns: []string{knownproblem.ProblemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocationproblem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := kpuo.mutation.RemovedProblemsIDs(); len(nodes) > 0 && !kpuo.mutation.ProblemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   knownproblem.ProblemsTable,
+			Columns: []string{knownproblem.ProblemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocationproblem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := kpuo.mutation.ProblemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   knownproblem.ProblemsTable,
+			Columns: []string{knownproblem.ProblemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocationproblem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &KnownProblem{config: kpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, kpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{knownproblem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	kpuo.mutation.done = true
+	return _node, nil
+}
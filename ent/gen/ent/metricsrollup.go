@@ -0,0 +1,267 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/metricsrollup"
+)
+
+// MetricsRollup is the model entity for the MetricsRollup schema.
+type MetricsRollup struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Granularity holds the value of the "granularity" field.
+	Granularity metricsrollup.Granularity `json:"granularity,omitempty"`
+	// BucketStart holds the value of the "bucket_start" field.
+	BucketStart time.Time `json:"bucket_start,omitempty"`
+	// Metric holds the value of the "metric" field.
+	Metric string `json:"metric,omitempty"`
+	// Branch holds the value of the "branch" field.
+	Branch string `json:"branch,omitempty"`
+	// Command holds the value of the "command" field.
+	Command string `json:"command,omitempty"`
+	// StepLabel holds the value of the "step_label" field.
+	StepLabel string `json:"step_label,omitempty"`
+	// UserLdap holds the value of the "user_ldap" field.
+	UserLdap string `json:"user_ldap,omitempty"`
+	// UserEmail holds the value of the "user_email" field.
+	UserEmail string `json:"user_email,omitempty"`
+	// Invocations holds the value of the "invocations" field.
+	Invocations int64 `json:"invocations,omitempty"`
+	// Numerator holds the value of the "numerator" field.
+	Numerator float64 `json:"numerator,omitempty"`
+	// Denominator holds the value of the "denominator" field.
+	Denominator float64 `json:"denominator,omitempty"`
+	// Min holds the value of the "min" field.
+	Min float64 `json:"min,omitempty"`
+	// Max holds the value of the "max" field.
+	Max float64 `json:"max,omitempty"`
+	// Histogram holds the value of the "histogram" field.
+	Histogram map[int]int64 `json:"histogram,omitempty"`
+	// Revision holds the value of the "revision" field.
+	Revision     int64 `json:"revision,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MetricsRollup) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case metricsrollup.FieldHistogram:
+			values[i] = new([]byte)
+		case metricsrollup.FieldNumerator, metricsrollup.FieldDenominator, metricsrollup.FieldMin, metricsrollup.FieldMax:
+			values[i] = new(sql.NullFloat64)
+		case metricsrollup.FieldID, metricsrollup.FieldInvocations, metricsrollup.FieldRevision:
+			values[i] = new(sql.NullInt64)
+		case metricsrollup.FieldGranularity, metricsrollup.FieldMetric, metricsrollup.FieldBranch, metricsrollup.FieldCommand, metricsrollup.FieldStepLabel, metricsrollup.FieldUserLdap, metricsrollup.FieldUserEmail:
+			values[i] = new(sql.NullString)
+		case metricsrollup.FieldBucketStart:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MetricsRollup fields.
+func (mr *MetricsRollup) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case metricsrollup.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mr.ID = int(value.Int64)
+		case metricsrollup.FieldGranularity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field granularity", values[i])
+			} else if value.Valid {
+				mr.Granularity = metricsrollup.Granularity(value.String)
+			}
+		case metricsrollup.FieldBucketStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field bucket_start", values[i])
+			} else if value.Valid {
+				mr.BucketStart = value.Time
+			}
+		case metricsrollup.FieldMetric:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field metric", values[i])
+			} else if value.Valid {
+				mr.Metric = value.String
+			}
+		case metricsrollup.FieldBranch:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field branch", values[i])
+			} else if value.Valid {
+				mr.Branch = value.String
+			}
+		case metricsrollup.FieldCommand:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field command", values[i])
+			} else if value.Valid {
+				mr.Command = value.String
+			}
+		case metricsrollup.FieldStepLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field step_label", values[i])
+			} else if value.Valid {
+				mr.StepLabel = value.String
+			}
+		case metricsrollup.FieldUserLdap:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_ldap", values[i])
+			} else if value.Valid {
+				mr.UserLdap = value.String
+			}
+		case metricsrollup.FieldUserEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_email", values[i])
+			} else if value.Valid {
+				mr.UserEmail = value.String
+			}
+		case metricsrollup.FieldInvocations:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field invocations", values[i])
+			} else if value.Valid {
+				mr.Invocations = value.Int64
+			}
+		case metricsrollup.FieldNumerator:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field numerator", values[i])
+			} else if value.Valid {
+				mr.Numerator = value.Float64
+			}
+		case metricsrollup.FieldDenominator:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field denominator", values[i])
+			} else if value.Valid {
+				mr.Denominator = value.Float64
+			}
+		case metricsrollup.FieldMin:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field min", values[i])
+			} else if value.Valid {
+				mr.Min = value.Float64
+			}
+		case metricsrollup.FieldMax:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field max", values[i])
+			} else if value.Valid {
+				mr.Max = value.Float64
+			}
+		case metricsrollup.FieldHistogram:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field histogram", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mr.Histogram); err != nil {
+					return fmt.Errorf("unmarshal field histogram: %w", err)
+				}
+			}
+		case metricsrollup.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				mr.Revision = value.Int64
+			}
+		default:
+			mr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MetricsRollup.
+// This includes values selected through modifiers, order, etc.
+func (mr *MetricsRollup) Value(name string) (ent.Value, error) {
+	return mr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this MetricsRollup.
+// Note that you need to call MetricsRollup.Unwrap() before calling this method if this MetricsRollup
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mr *MetricsRollup) Update() *MetricsRollupUpdateOne {
+	return NewMetricsRollupClient(mr.config).UpdateOne(mr)
+}
+
+// Unwrap unwraps the MetricsRollup entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mr *MetricsRollup) Unwrap() *MetricsRollup {
+	_tx, ok := mr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MetricsRollup is not a transactional entity")
+	}
+	mr.config.driver = _tx.drv
+	return mr
+}
+
+// String implements the fmt.Stringer.
+func (mr *MetricsRollup) String() string {
+	var builder strings.Builder
+	builder.WriteString("MetricsRollup(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mr.ID))
+	builder.WriteString("granularity=")
+	builder.WriteString(fmt.Sprintf("%v", mr.Granularity))
+	builder.WriteString(", ")
+	builder.WriteString("bucket_start=")
+	builder.WriteString(mr.BucketStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("metric=")
+	builder.WriteString(mr.Metric)
+	builder.WriteString(", ")
+	builder.WriteString("branch=")
+	builder.WriteString(mr.Branch)
+	builder.WriteString(", ")
+	builder.WriteString("command=")
+	builder.WriteString(mr.Command)
+	builder.WriteString(", ")
+	builder.WriteString("step_label=")
+	builder.WriteString(mr.StepLabel)
+	builder.WriteString(", ")
+	builder.WriteString("user_ldap=")
+	builder.WriteString(mr.UserLdap)
+	builder.WriteString(", ")
+	builder.WriteString("user_email=")
+	builder.WriteString(mr.UserEmail)
+	builder.WriteString(", ")
+	builder.WriteString("invocations=")
+	builder.WriteString(fmt.Sprintf("%v", mr.Invocations))
+	builder.WriteString(", ")
+	builder.WriteString("numerator=")
+	builder.WriteString(fmt.Sprintf("%v", mr.Numerator))
+	builder.WriteString(", ")
+	builder.WriteString("denominator=")
+	builder.WriteString(fmt.Sprintf("%v", mr.Denominator))
+	builder.WriteString(", ")
+	builder.WriteString("min=")
+	builder.WriteString(fmt.Sprintf("%v", mr.Min))
+	builder.WriteString(", ")
+	builder.WriteString("max=")
+	builder.WriteString(fmt.Sprintf("%v", mr.Max))
+	builder.WriteString(", ")
+	builder.WriteString("histogram=")
+	builder.WriteString(fmt.Sprintf("%v", mr.Histogram))
+	builder.WriteString(", ")
+	builder.WriteString("revision=")
+	builder.WriteString(fmt.Sprintf("%v", mr.Revision))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MetricsRollups is a parsable slice of MetricsRollup.
+type MetricsRollups []*MetricsRollup
@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package metricsrollup
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the metricsrollup type in the database.
+	Label = "metrics_rollup"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldGranularity holds the string denoting the granularity field in the database.
+	FieldGranularity = "granularity"
+	// FieldBucketStart holds the string denoting the bucket_start field in the database.
+	FieldBucketStart = "bucket_start"
+	// FieldMetric holds the string denoting the metric field in the database.
+	FieldMetric = "metric"
+	// FieldBranch holds the string denoting the branch field in the database.
+	FieldBranch = "branch"
+	// FieldCommand holds the string denoting the command field in the database.
+	FieldCommand = "command"
+	// FieldStepLabel holds the string denoting the step_label field in the database.
+	FieldStepLabel = "step_label"
+	// FieldUserLdap holds the string denoting the user_ldap field in the database.
+	FieldUserLdap = "user_ldap"
+	// FieldUserEmail holds the string denoting the user_email field in the database.
+	FieldUserEmail = "user_email"
+	// FieldInvocations holds the string denoting the invocations field in the database.
+	FieldInvocations = "invocations"
+	// FieldNumerator holds the string denoting the numerator field in the database.
+	FieldNumerator = "numerator"
+	// FieldDenominator holds the string denoting the denominator field in the database.
+	FieldDenominator = "denominator"
+	// FieldMin holds the string denoting the min field in the database.
+	FieldMin = "min"
+	// FieldMax holds the string denoting the max field in the database.
+	FieldMax = "max"
+	// FieldHistogram holds the string denoting the histogram field in the database.
+	FieldHistogram = "histogram"
+	// FieldRevision holds the string denoting the revision field in the database.
+	FieldRevision = "revision"
+	// Table holds the table name of the metricsrollup in the database.
+	Table = "metrics_rollups"
+)
+
+// Columns holds all SQL columns for metricsrollup fields.
+var Columns = []string{
+	FieldID,
+	FieldGranularity,
+	FieldBucketStart,
+	FieldMetric,
+	FieldBranch,
+	FieldCommand,
+	FieldStepLabel,
+	FieldUserLdap,
+	FieldUserEmail,
+	FieldInvocations,
+	FieldNumerator,
+	FieldDenominator,
+	FieldMin,
+	FieldMax,
+	FieldHistogram,
+	FieldRevision,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultBranch holds the default value on creation for the "branch" field.
+	DefaultBranch string
+	// DefaultCommand holds the default value on creation for the "command" field.
+	DefaultCommand string
+	// DefaultStepLabel holds the default value on creation for the "step_label" field.
+	DefaultStepLabel string
+	// DefaultUserLdap holds the default value on creation for the "user_ldap" field.
+	DefaultUserLdap string
+	// DefaultUserEmail holds the default value on creation for the "user_email" field.
+	DefaultUserEmail string
+	// DefaultInvocations holds the default value on creation for the "invocations" field.
+	DefaultInvocations int64
+	// DefaultNumerator holds the default value on creation for the "numerator" field.
+	DefaultNumerator float64
+	// DefaultDenominator holds the default value on creation for the "denominator" field.
+	DefaultDenominator float64
+	// DefaultRevision holds the default value on creation for the "revision" field.
+	DefaultRevision int64
+)
+
+// Granularity defines the type for the "granularity" enum field.
+type Granularity string
+
+// Granularity values.
+const (
+	GranularityHOUR Granularity = "HOUR"
+	GranularityDAY  Granularity = "DAY"
+)
+
+func (gr Granularity) String() string {
+	return string(gr)
+}
+
+// GranularityValidator is a validator for the "granularity" field enum values. It is called by the builders before save.
+func GranularityValidator(gr Granularity) error {
+	switch gr {
+	case GranularityHOUR, GranularityDAY:
+		return nil
+	default:
+		return fmt.Errorf("metricsrollup: invalid enum value for granularity field: %q", gr)
+	}
+}
+
+// OrderOption defines the ordering options for the MetricsRollup queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByGranularity orders the results by the granularity field.
+func ByGranularity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGranularity, opts...).ToFunc()
+}
+
+// ByBucketStart orders the results by the bucket_start field.
+func ByBucketStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBucketStart, opts...).ToFunc()
+}
+
+// ByMetric orders the results by the metric field.
+func ByMetric(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMetric, opts...).ToFunc()
+}
+
+// ByBranch orders the results by the branch field.
+func ByBranch(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBranch, opts...).ToFunc()
+}
+
+// ByCommand orders the results by the command field.
+func ByCommand(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommand, opts...).ToFunc()
+}
+
+// ByStepLabel orders the results by the step_label field.
+func ByStepLabel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStepLabel, opts...).ToFunc()
+}
+
+// ByUserLdap orders the results by the user_ldap field.
+func ByUserLdap(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserLdap, opts...).ToFunc()
+}
+
+// ByUserEmail orders the results by the user_email field.
+func ByUserEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserEmail, opts...).ToFunc()
+}
+
+// ByInvocations orders the results by the invocations field.
+func ByInvocations(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvocations, opts...).ToFunc()
+}
+
+// ByNumerator orders the results by the numerator field.
+func ByNumerator(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNumerator, opts...).ToFunc()
+}
+
+// ByDenominator orders the results by the denominator field.
+func ByDenominator(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDenominator, opts...).ToFunc()
+}
+
+// ByMin orders the results by the min field.
+func ByMin(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMin, opts...).ToFunc()
+}
+
+// ByMax orders the results by the max field.
+func ByMax(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMax, opts...).ToFunc()
+}
+
+// ByRevision orders the results by the revision field.
+func ByRevision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevision, opts...).ToFunc()
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Granularity) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Granularity) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Granularity(str)
+	if err := GranularityValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Granularity", str)
+	}
+	return nil
+}
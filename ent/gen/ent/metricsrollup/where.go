@@ -0,0 +1,825 @@
+// Code generated by ent, DO NOT EDIT.
+
+package metricsrollup
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLTE(FieldID, id))
+}
+
+// BucketStart applies equality check predicate on the "bucket_start" field. It's identical to BucketStartEQ.
+func BucketStart(v time.Time) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldBucketStart, v))
+}
+
+// Metric applies equality check predicate on the "metric" field. It's identical to MetricEQ.
+func Metric(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldMetric, v))
+}
+
+// Branch applies equality check predicate on the "branch" field. It's identical to BranchEQ.
+func Branch(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldBranch, v))
+}
+
+// Command applies equality check predicate on the "command" field. It's identical to CommandEQ.
+func Command(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldCommand, v))
+}
+
+// StepLabel applies equality check predicate on the "step_label" field. It's identical to StepLabelEQ.
+func StepLabel(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldStepLabel, v))
+}
+
+// UserLdap applies equality check predicate on the "user_ldap" field. It's identical to UserLdapEQ.
+func UserLdap(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldUserLdap, v))
+}
+
+// UserEmail applies equality check predicate on the "user_email" field. It's identical to UserEmailEQ.
+func UserEmail(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldUserEmail, v))
+}
+
+// Invocations applies equality check predicate on the "invocations" field. It's identical to InvocationsEQ.
+func Invocations(v int64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldInvocations, v))
+}
+
+// Numerator applies equality check predicate on the "numerator" field. It's identical to NumeratorEQ.
+func Numerator(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldNumerator, v))
+}
+
+// Denominator applies equality check predicate on the "denominator" field. It's identical to DenominatorEQ.
+func Denominator(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldDenominator, v))
+}
+
+// Min applies equality check predicate on the "min" field. It's identical to MinEQ.
+func Min(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldMin, v))
+}
+
+// Max applies equality check predicate on the "max" field. It's identical to MaxEQ.
+func Max(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldMax, v))
+}
+
+// Revision applies equality check predicate on the "revision" field. It's identical to RevisionEQ.
+func Revision(v int64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldRevision, v))
+}
+
+// GranularityEQ applies the EQ predicate on the "granularity" field.
+func GranularityEQ(v Granularity) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldGranularity, v))
+}
+
+// GranularityNEQ applies the NEQ predicate on the "granularity" field.
+func GranularityNEQ(v Granularity) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNEQ(FieldGranularity, v))
+}
+
+// GranularityIn applies the In predicate on the "granularity" field.
+func GranularityIn(vs ...Granularity) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldIn(FieldGranularity, vs...))
+}
+
+// GranularityNotIn applies the NotIn predicate on the "granularity" field.
+func GranularityNotIn(vs ...Granularity) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNotIn(FieldGranularity, vs...))
+}
+
+// BucketStartEQ applies the EQ predicate on the "bucket_start" field.
+func BucketStartEQ(v time.Time) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldBucketStart, v))
+}
+
+// BucketStartNEQ applies the NEQ predicate on the "bucket_start" field.
+func BucketStartNEQ(v time.Time) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNEQ(FieldBucketStart, v))
+}
+
+// BucketStartIn applies the In predicate on the "bucket_start" field.
+func BucketStartIn(vs ...time.Time) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldIn(FieldBucketStart, vs...))
+}
+
+// BucketStartNotIn applies the NotIn predicate on the "bucket_start" field.
+func BucketStartNotIn(vs ...time.Time) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNotIn(FieldBucketStart, vs...))
+}
+
+// BucketStartGT applies the GT predicate on the "bucket_start" field.
+func BucketStartGT(v time.Time) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGT(FieldBucketStart, v))
+}
+
+// BucketStartGTE applies the GTE predicate on the "bucket_start" field.
+func BucketStartGTE(v time.Time) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGTE(FieldBucketStart, v))
+}
+
+// BucketStartLT applies the LT predicate on the "bucket_start" field.
+func BucketStartLT(v time.Time) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLT(FieldBucketStart, v))
+}
+
+// BucketStartLTE applies the LTE predicate on the "bucket_start" field.
+func BucketStartLTE(v time.Time) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLTE(FieldBucketStart, v))
+}
+
+// MetricEQ applies the EQ predicate on the "metric" field.
+func MetricEQ(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldMetric, v))
+}
+
+// MetricNEQ applies the NEQ predicate on the "metric" field.
+func MetricNEQ(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNEQ(FieldMetric, v))
+}
+
+// MetricIn applies the In predicate on the "metric" field.
+func MetricIn(vs ...string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldIn(FieldMetric, vs...))
+}
+
+// MetricNotIn applies the NotIn predicate on the "metric" field.
+func MetricNotIn(vs ...string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNotIn(FieldMetric, vs...))
+}
+
+// MetricGT applies the GT predicate on the "metric" field.
+func MetricGT(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGT(FieldMetric, v))
+}
+
+// MetricGTE applies the GTE predicate on the "metric" field.
+func MetricGTE(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGTE(FieldMetric, v))
+}
+
+// MetricLT applies the LT predicate on the "metric" field.
+func MetricLT(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLT(FieldMetric, v))
+}
+
+// MetricLTE applies the LTE predicate on the "metric" field.
+func MetricLTE(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLTE(FieldMetric, v))
+}
+
+// MetricContains applies the Contains predicate on the "metric" field.
+func MetricContains(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldContains(FieldMetric, v))
+}
+
+// MetricHasPrefix applies the HasPrefix predicate on the "metric" field.
+func MetricHasPrefix(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldHasPrefix(FieldMetric, v))
+}
+
+// MetricHasSuffix applies the HasSuffix predicate on the "metric" field.
+func MetricHasSuffix(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldHasSuffix(FieldMetric, v))
+}
+
+// MetricEqualFold applies the EqualFold predicate on the "metric" field.
+func MetricEqualFold(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEqualFold(FieldMetric, v))
+}
+
+// MetricContainsFold applies the ContainsFold predicate on the "metric" field.
+func MetricContainsFold(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldContainsFold(FieldMetric, v))
+}
+
+// BranchEQ applies the EQ predicate on the "branch" field.
+func BranchEQ(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldBranch, v))
+}
+
+// BranchNEQ applies the NEQ predicate on the "branch" field.
+func BranchNEQ(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNEQ(FieldBranch, v))
+}
+
+// BranchIn applies the In predicate on the "branch" field.
+func BranchIn(vs ...string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldIn(FieldBranch, vs...))
+}
+
+// BranchNotIn applies the NotIn predicate on the "branch" field.
+func BranchNotIn(vs ...string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNotIn(FieldBranch, vs...))
+}
+
+// BranchGT applies the GT predicate on the "branch" field.
+func BranchGT(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGT(FieldBranch, v))
+}
+
+// BranchGTE applies the GTE predicate on the "branch" field.
+func BranchGTE(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGTE(FieldBranch, v))
+}
+
+// BranchLT applies the LT predicate on the "branch" field.
+func BranchLT(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLT(FieldBranch, v))
+}
+
+// BranchLTE applies the LTE predicate on the "branch" field.
+func BranchLTE(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLTE(FieldBranch, v))
+}
+
+// BranchContains applies the Contains predicate on the "branch" field.
+func BranchContains(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldContains(FieldBranch, v))
+}
+
+// BranchHasPrefix applies the HasPrefix predicate on the "branch" field.
+func BranchHasPrefix(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldHasPrefix(FieldBranch, v))
+}
+
+// BranchHasSuffix applies the HasSuffix predicate on the "branch" field.
+func BranchHasSuffix(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldHasSuffix(FieldBranch, v))
+}
+
+// BranchEqualFold applies the EqualFold predicate on the "branch" field.
+func BranchEqualFold(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEqualFold(FieldBranch, v))
+}
+
+// BranchContainsFold applies the ContainsFold predicate on the "branch" field.
+func BranchContainsFold(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldContainsFold(FieldBranch, v))
+}
+
+// CommandEQ applies the EQ predicate on the "command" field.
+func CommandEQ(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldCommand, v))
+}
+
+// CommandNEQ applies the NEQ predicate on the "command" field.
+func CommandNEQ(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNEQ(FieldCommand, v))
+}
+
+// CommandIn applies the In predicate on the "command" field.
+func CommandIn(vs ...string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldIn(FieldCommand, vs...))
+}
+
+// CommandNotIn applies the NotIn predicate on the "command" field.
+func CommandNotIn(vs ...string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNotIn(FieldCommand, vs...))
+}
+
+// CommandGT applies the GT predicate on the "command" field.
+func CommandGT(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGT(FieldCommand, v))
+}
+
+// CommandGTE applies the GTE predicate on the "command" field.
+func CommandGTE(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGTE(FieldCommand, v))
+}
+
+// CommandLT applies the LT predicate on the "command" field.
+func CommandLT(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLT(FieldCommand, v))
+}
+
+// CommandLTE applies the LTE predicate on the "command" field.
+func CommandLTE(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLTE(FieldCommand, v))
+}
+
+// CommandContains applies the Contains predicate on the "command" field.
+func CommandContains(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldContains(FieldCommand, v))
+}
+
+// CommandHasPrefix applies the HasPrefix predicate on the "command" field.
+func CommandHasPrefix(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldHasPrefix(FieldCommand, v))
+}
+
+// CommandHasSuffix applies the HasSuffix predicate on the "command" field.
+func CommandHasSuffix(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldHasSuffix(FieldCommand, v))
+}
+
+// CommandEqualFold applies the EqualFold predicate on the "command" field.
+func CommandEqualFold(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEqualFold(FieldCommand, v))
+}
+
+// CommandContainsFold applies the ContainsFold predicate on the "command" field.
+func CommandContainsFold(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldContainsFold(FieldCommand, v))
+}
+
+// StepLabelEQ applies the EQ predicate on the "step_label" field.
+func StepLabelEQ(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldStepLabel, v))
+}
+
+// StepLabelNEQ applies the NEQ predicate on the "step_label" field.
+func StepLabelNEQ(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNEQ(FieldStepLabel, v))
+}
+
+// StepLabelIn applies the In predicate on the "step_label" field.
+func StepLabelIn(vs ...string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldIn(FieldStepLabel, vs...))
+}
+
+// StepLabelNotIn applies the NotIn predicate on the "step_label" field.
+func StepLabelNotIn(vs ...string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNotIn(FieldStepLabel, vs...))
+}
+
+// StepLabelGT applies the GT predicate on the "step_label" field.
+func StepLabelGT(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGT(FieldStepLabel, v))
+}
+
+// StepLabelGTE applies the GTE predicate on the "step_label" field.
+func StepLabelGTE(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGTE(FieldStepLabel, v))
+}
+
+// StepLabelLT applies the LT predicate on the "step_label" field.
+func StepLabelLT(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLT(FieldStepLabel, v))
+}
+
+// StepLabelLTE applies the LTE predicate on the "step_label" field.
+func StepLabelLTE(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLTE(FieldStepLabel, v))
+}
+
+// StepLabelContains applies the Contains predicate on the "step_label" field.
+func StepLabelContains(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldContains(FieldStepLabel, v))
+}
+
+// StepLabelHasPrefix applies the HasPrefix predicate on the "step_label" field.
+func StepLabelHasPrefix(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldHasPrefix(FieldStepLabel, v))
+}
+
+// StepLabelHasSuffix applies the HasSuffix predicate on the "step_label" field.
+func StepLabelHasSuffix(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldHasSuffix(FieldStepLabel, v))
+}
+
+// StepLabelEqualFold applies the EqualFold predicate on the "step_label" field.
+func StepLabelEqualFold(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEqualFold(FieldStepLabel, v))
+}
+
+// StepLabelContainsFold applies the ContainsFold predicate on the "step_label" field.
+func StepLabelContainsFold(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldContainsFold(FieldStepLabel, v))
+}
+
+// UserLdapEQ applies the EQ predicate on the "user_ldap" field.
+func UserLdapEQ(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldUserLdap, v))
+}
+
+// UserLdapNEQ applies the NEQ predicate on the "user_ldap" field.
+func UserLdapNEQ(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNEQ(FieldUserLdap, v))
+}
+
+// UserLdapIn applies the In predicate on the "user_ldap" field.
+func UserLdapIn(vs ...string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldIn(FieldUserLdap, vs...))
+}
+
+// UserLdapNotIn applies the NotIn predicate on the "user_ldap" field.
+func UserLdapNotIn(vs ...string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNotIn(FieldUserLdap, vs...))
+}
+
+// UserLdapGT applies the GT predicate on the "user_ldap" field.
+func UserLdapGT(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGT(FieldUserLdap, v))
+}
+
+// UserLdapGTE applies the GTE predicate on the "user_ldap" field.
+func UserLdapGTE(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGTE(FieldUserLdap, v))
+}
+
+// UserLdapLT applies the LT predicate on the "user_ldap" field.
+func UserLdapLT(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLT(FieldUserLdap, v))
+}
+
+// UserLdapLTE applies the LTE predicate on the "user_ldap" field.
+func UserLdapLTE(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLTE(FieldUserLdap, v))
+}
+
+// UserLdapContains applies the Contains predicate on the "user_ldap" field.
+func UserLdapContains(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldContains(FieldUserLdap, v))
+}
+
+// UserLdapHasPrefix applies the HasPrefix predicate on the "user_ldap" field.
+func UserLdapHasPrefix(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldHasPrefix(FieldUserLdap, v))
+}
+
+// UserLdapHasSuffix applies the HasSuffix predicate on the "user_ldap" field.
+func UserLdapHasSuffix(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldHasSuffix(FieldUserLdap, v))
+}
+
+// UserLdapEqualFold applies the EqualFold predicate on the "user_ldap" field.
+func UserLdapEqualFold(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEqualFold(FieldUserLdap, v))
+}
+
+// UserLdapContainsFold applies the ContainsFold predicate on the "user_ldap" field.
+func UserLdapContainsFold(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldContainsFold(FieldUserLdap, v))
+}
+
+// UserEmailEQ applies the EQ predicate on the "user_email" field.
+func UserEmailEQ(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldUserEmail, v))
+}
+
+// UserEmailNEQ applies the NEQ predicate on the "user_email" field.
+func UserEmailNEQ(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNEQ(FieldUserEmail, v))
+}
+
+// UserEmailIn applies the In predicate on the "user_email" field.
+func UserEmailIn(vs ...string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldIn(FieldUserEmail, vs...))
+}
+
+// UserEmailNotIn applies the NotIn predicate on the "user_email" field.
+func UserEmailNotIn(vs ...string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNotIn(FieldUserEmail, vs...))
+}
+
+// UserEmailGT applies the GT predicate on the "user_email" field.
+func UserEmailGT(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGT(FieldUserEmail, v))
+}
+
+// UserEmailGTE applies the GTE predicate on the "user_email" field.
+func UserEmailGTE(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGTE(FieldUserEmail, v))
+}
+
+// UserEmailLT applies the LT predicate on the "user_email" field.
+func UserEmailLT(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLT(FieldUserEmail, v))
+}
+
+// UserEmailLTE applies the LTE predicate on the "user_email" field.
+func UserEmailLTE(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLTE(FieldUserEmail, v))
+}
+
+// UserEmailContains applies the Contains predicate on the "user_email" field.
+func UserEmailContains(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldContains(FieldUserEmail, v))
+}
+
+// UserEmailHasPrefix applies the HasPrefix predicate on the "user_email" field.
+func UserEmailHasPrefix(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldHasPrefix(FieldUserEmail, v))
+}
+
+// UserEmailHasSuffix applies the HasSuffix predicate on the "user_email" field.
+func UserEmailHasSuffix(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldHasSuffix(FieldUserEmail, v))
+}
+
+// UserEmailEqualFold applies the EqualFold predicate on the "user_email" field.
+func UserEmailEqualFold(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEqualFold(FieldUserEmail, v))
+}
+
+// UserEmailContainsFold applies the ContainsFold predicate on the "user_email" field.
+func UserEmailContainsFold(v string) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldContainsFold(FieldUserEmail, v))
+}
+
+// InvocationsEQ applies the EQ predicate on the "invocations" field.
+func InvocationsEQ(v int64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldInvocations, v))
+}
+
+// InvocationsNEQ applies the NEQ predicate on the "invocations" field.
+func InvocationsNEQ(v int64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNEQ(FieldInvocations, v))
+}
+
+// InvocationsIn applies the In predicate on the "invocations" field.
+func InvocationsIn(vs ...int64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldIn(FieldInvocations, vs...))
+}
+
+// InvocationsNotIn applies the NotIn predicate on the "invocations" field.
+func InvocationsNotIn(vs ...int64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNotIn(FieldInvocations, vs...))
+}
+
+// InvocationsGT applies the GT predicate on the "invocations" field.
+func InvocationsGT(v int64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGT(FieldInvocations, v))
+}
+
+// InvocationsGTE applies the GTE predicate on the "invocations" field.
+func InvocationsGTE(v int64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGTE(FieldInvocations, v))
+}
+
+// InvocationsLT applies the LT predicate on the "invocations" field.
+func InvocationsLT(v int64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLT(FieldInvocations, v))
+}
+
+// InvocationsLTE applies the LTE predicate on the "invocations" field.
+func InvocationsLTE(v int64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLTE(FieldInvocations, v))
+}
+
+// NumeratorEQ applies the EQ predicate on the "numerator" field.
+func NumeratorEQ(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldNumerator, v))
+}
+
+// NumeratorNEQ applies the NEQ predicate on the "numerator" field.
+func NumeratorNEQ(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNEQ(FieldNumerator, v))
+}
+
+// NumeratorIn applies the In predicate on the "numerator" field.
+func NumeratorIn(vs ...float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldIn(FieldNumerator, vs...))
+}
+
+// NumeratorNotIn applies the NotIn predicate on the "numerator" field.
+func NumeratorNotIn(vs ...float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNotIn(FieldNumerator, vs...))
+}
+
+// NumeratorGT applies the GT predicate on the "numerator" field.
+func NumeratorGT(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGT(FieldNumerator, v))
+}
+
+// NumeratorGTE applies the GTE predicate on the "numerator" field.
+func NumeratorGTE(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGTE(FieldNumerator, v))
+}
+
+// NumeratorLT applies the LT predicate on the "numerator" field.
+func NumeratorLT(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLT(FieldNumerator, v))
+}
+
+// NumeratorLTE applies the LTE predicate on the "numerator" field.
+func NumeratorLTE(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLTE(FieldNumerator, v))
+}
+
+// DenominatorEQ applies the EQ predicate on the "denominator" field.
+func DenominatorEQ(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldDenominator, v))
+}
+
+// DenominatorNEQ applies the NEQ predicate on the "denominator" field.
+func DenominatorNEQ(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNEQ(FieldDenominator, v))
+}
+
+// DenominatorIn applies the In predicate on the "denominator" field.
+func DenominatorIn(vs ...float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldIn(FieldDenominator, vs...))
+}
+
+// DenominatorNotIn applies the NotIn predicate on the "denominator" field.
+func DenominatorNotIn(vs ...float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNotIn(FieldDenominator, vs...))
+}
+
+// DenominatorGT applies the GT predicate on the "denominator" field.
+func DenominatorGT(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGT(FieldDenominator, v))
+}
+
+// DenominatorGTE applies the GTE predicate on the "denominator" field.
+func DenominatorGTE(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGTE(FieldDenominator, v))
+}
+
+// DenominatorLT applies the LT predicate on the "denominator" field.
+func DenominatorLT(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLT(FieldDenominator, v))
+}
+
+// DenominatorLTE applies the LTE predicate on the "denominator" field.
+func DenominatorLTE(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLTE(FieldDenominator, v))
+}
+
+// MinEQ applies the EQ predicate on the "min" field.
+func MinEQ(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldMin, v))
+}
+
+// MinNEQ applies the NEQ predicate on the "min" field.
+func MinNEQ(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNEQ(FieldMin, v))
+}
+
+// MinIn applies the In predicate on the "min" field.
+func MinIn(vs ...float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldIn(FieldMin, vs...))
+}
+
+// MinNotIn applies the NotIn predicate on the "min" field.
+func MinNotIn(vs ...float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNotIn(FieldMin, vs...))
+}
+
+// MinGT applies the GT predicate on the "min" field.
+func MinGT(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGT(FieldMin, v))
+}
+
+// MinGTE applies the GTE predicate on the "min" field.
+func MinGTE(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGTE(FieldMin, v))
+}
+
+// MinLT applies the LT predicate on the "min" field.
+func MinLT(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLT(FieldMin, v))
+}
+
+// MinLTE applies the LTE predicate on the "min" field.
+func MinLTE(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLTE(FieldMin, v))
+}
+
+// MaxEQ applies the EQ predicate on the "max" field.
+func MaxEQ(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldMax, v))
+}
+
+// MaxNEQ applies the NEQ predicate on the "max" field.
+func MaxNEQ(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNEQ(FieldMax, v))
+}
+
+// MaxIn applies the In predicate on the "max" field.
+func MaxIn(vs ...float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldIn(FieldMax, vs...))
+}
+
+// MaxNotIn applies the NotIn predicate on the "max" field.
+func MaxNotIn(vs ...float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNotIn(FieldMax, vs...))
+}
+
+// MaxGT applies the GT predicate on the "max" field.
+func MaxGT(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGT(FieldMax, v))
+}
+
+// MaxGTE applies the GTE predicate on the "max" field.
+func MaxGTE(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGTE(FieldMax, v))
+}
+
+// MaxLT applies the LT predicate on the "max" field.
+func MaxLT(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLT(FieldMax, v))
+}
+
+// MaxLTE applies the LTE predicate on the "max" field.
+func MaxLTE(v float64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLTE(FieldMax, v))
+}
+
+// RevisionEQ applies the EQ predicate on the "revision" field.
+func RevisionEQ(v int64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldEQ(FieldRevision, v))
+}
+
+// RevisionNEQ applies the NEQ predicate on the "revision" field.
+func RevisionNEQ(v int64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNEQ(FieldRevision, v))
+}
+
+// RevisionIn applies the In predicate on the "revision" field.
+func RevisionIn(vs ...int64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldIn(FieldRevision, vs...))
+}
+
+// RevisionNotIn applies the NotIn predicate on the "revision" field.
+func RevisionNotIn(vs ...int64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldNotIn(FieldRevision, vs...))
+}
+
+// RevisionGT applies the GT predicate on the "revision" field.
+func RevisionGT(v int64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGT(FieldRevision, v))
+}
+
+// RevisionGTE applies the GTE predicate on the "revision" field.
+func RevisionGTE(v int64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldGTE(FieldRevision, v))
+}
+
+// RevisionLT applies the LT predicate on the "revision" field.
+func RevisionLT(v int64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLT(FieldRevision, v))
+}
+
+// RevisionLTE applies the LTE predicate on the "revision" field.
+func RevisionLTE(v int64) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.FieldLTE(FieldRevision, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MetricsRollup) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MetricsRollup) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MetricsRollup) predicate.MetricsRollup {
+	return predicate.MetricsRollup(sql.NotPredicates(p))
+}
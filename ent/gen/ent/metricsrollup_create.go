@@ -0,0 +1,1170 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/metricsrollup"
+)
+
+// MetricsRollupCreate is the builder for creating a MetricsRollup entity.
+type MetricsRollupCreate struct {
+	config
+	mutation *MetricsRollupMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetGranularity sets the "granularity" field.
+func (mrc *MetricsRollupCreate) SetGranularity(m metricsrollup.Granularity) *MetricsRollupCreate {
+	mrc.mutation.SetGranularity(m)
+	return mrc
+}
+
+// SetBucketStart sets the "bucket_start" field.
+func (mrc *MetricsRollupCreate) SetBucketStart(t time.Time) *MetricsRollupCreate {
+	mrc.mutation.SetBucketStart(t)
+	return mrc
+}
+
+// SetMetric sets the "metric" field.
+func (mrc *MetricsRollupCreate) SetMetric(s string) *MetricsRollupCreate {
+	mrc.mutation.SetMetric(s)
+	return mrc
+}
+
+// SetBranch sets the "branch" field.
+func (mrc *MetricsRollupCreate) SetBranch(s string) *MetricsRollupCreate {
+	mrc.mutation.SetBranch(s)
+	return mrc
+}
+
+// SetNillableBranch sets the "branch" field if the given value is not nil.
+func (mrc *MetricsRollupCreate) SetNillableBranch(s *string) *MetricsRollupCreate {
+	if s != nil {
+		mrc.SetBranch(*s)
+	}
+	return mrc
+}
+
+// SetCommand sets the "command" field.
+func (mrc *MetricsRollupCreate) SetCommand(s string) *MetricsRollupCreate {
+	mrc.mutation.SetCommand(s)
+	return mrc
+}
+
+// SetNillableCommand sets the "command" field if the given value is not nil.
+func (mrc *MetricsRollupCreate) SetNillableCommand(s *string) *MetricsRollupCreate {
+	if s != nil {
+		mrc.SetCommand(*s)
+	}
+	return mrc
+}
+
+// SetStepLabel sets the "step_label" field.
+func (mrc *MetricsRollupCreate) SetStepLabel(s string) *MetricsRollupCreate {
+	mrc.mutation.SetStepLabel(s)
+	return mrc
+}
+
+// SetNillableStepLabel sets the "step_label" field if the given value is not nil.
+func (mrc *MetricsRollupCreate) SetNillableStepLabel(s *string) *MetricsRollupCreate {
+	if s != nil {
+		mrc.SetStepLabel(*s)
+	}
+	return mrc
+}
+
+// SetUserLdap sets the "user_ldap" field.
+func (mrc *MetricsRollupCreate) SetUserLdap(s string) *MetricsRollupCreate {
+	mrc.mutation.SetUserLdap(s)
+	return mrc
+}
+
+// SetNillableUserLdap sets the "user_ldap" field if the given value is not nil.
+func (mrc *MetricsRollupCreate) SetNillableUserLdap(s *string) *MetricsRollupCreate {
+	if s != nil {
+		mrc.SetUserLdap(*s)
+	}
+	return mrc
+}
+
+// SetUserEmail sets the "user_email" field.
+func (mrc *MetricsRollupCreate) SetUserEmail(s string) *MetricsRollupCreate {
+	mrc.mutation.SetUserEmail(s)
+	return mrc
+}
+
+// SetNillableUserEmail sets the "user_email" field if the given value is not nil.
+func (mrc *MetricsRollupCreate) SetNillableUserEmail(s *string) *MetricsRollupCreate {
+	if s != nil {
+		mrc.SetUserEmail(*s)
+	}
+	return mrc
+}
+
+// SetInvocations sets the "invocations" field.
+func (mrc *MetricsRollupCreate) SetInvocations(i int64) *MetricsRollupCreate {
+	mrc.mutation.SetInvocations(i)
+	return mrc
+}
+
+// SetNillableInvocations sets the "invocations" field if the given value is not nil.
+func (mrc *MetricsRollupCreate) SetNillableInvocations(i *int64) *MetricsRollupCreate {
+	if i != nil {
+		mrc.SetInvocations(*i)
+	}
+	return mrc
+}
+
+// SetNumerator sets the "numerator" field.
+func (mrc *MetricsRollupCreate) SetNumerator(f float64) *MetricsRollupCreate {
+	mrc.mutation.SetNumerator(f)
+	return mrc
+}
+
+// SetNillableNumerator sets the "numerator" field if the given value is not nil.
+func (mrc *MetricsRollupCreate) SetNillableNumerator(f *float64) *MetricsRollupCreate {
+	if f != nil {
+		mrc.SetNumerator(*f)
+	}
+	return mrc
+}
+
+// SetDenominator sets the "denominator" field.
+func (mrc *MetricsRollupCreate) SetDenominator(f float64) *MetricsRollupCreate {
+	mrc.mutation.SetDenominator(f)
+	return mrc
+}
+
+// SetNillableDenominator sets the "denominator" field if the given value is not nil.
+func (mrc *MetricsRollupCreate) SetNillableDenominator(f *float64) *MetricsRollupCreate {
+	if f != nil {
+		mrc.SetDenominator(*f)
+	}
+	return mrc
+}
+
+// SetMin sets the "min" field.
+func (mrc *MetricsRollupCreate) SetMin(f float64) *MetricsRollupCreate {
+	mrc.mutation.SetMin(f)
+	return mrc
+}
+
+// SetMax sets the "max" field.
+func (mrc *MetricsRollupCreate) SetMax(f float64) *MetricsRollupCreate {
+	mrc.mutation.SetMax(f)
+	return mrc
+}
+
+// SetHistogram sets the "histogram" field.
+func (mrc *MetricsRollupCreate) SetHistogram(m map[int]int64) *MetricsRollupCreate {
+	mrc.mutation.SetHistogram(m)
+	return mrc
+}
+
+// SetRevision sets the "revision" field.
+func (mrc *MetricsRollupCreate) SetRevision(i int64) *MetricsRollupCreate {
+	mrc.mutation.SetRevision(i)
+	return mrc
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (mrc *MetricsRollupCreate) SetNillableRevision(i *int64) *MetricsRollupCreate {
+	if i != nil {
+		mrc.SetRevision(*i)
+	}
+	return mrc
+}
+
+// Mutation returns the MetricsRollupMutation object of the builder.
+func (mrc *MetricsRollupCreate) Mutation() *MetricsRollupMutation {
+	return mrc.mutation
+}
+
+// Save creates the MetricsRollup in the database.
+func (mrc *MetricsRollupCreate) Save(ctx context.Context) (*MetricsRollup, error) {
+	mrc.defaults()
+	return withHooks(ctx, mrc.sqlSave, mrc.mutation, mrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mrc *MetricsRollupCreate) SaveX(ctx context.Context) *MetricsRollup {
+	v, err := mrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mrc *MetricsRollupCreate) Exec(ctx context.Context) error {
+	_, err := mrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrc *MetricsRollupCreate) ExecX(ctx context.Context) {
+	if err := mrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mrc *MetricsRollupCreate) defaults() {
+	if _, ok := mrc.mutation.Branch(); !ok {
+		v := metricsrollup.DefaultBranch
+		mrc.mutation.SetBranch(v)
+	}
+	if _, ok := mrc.mutation.Command(); !ok {
+		v := metricsrollup.DefaultCommand
+		mrc.mutation.SetCommand(v)
+	}
+	if _, ok := mrc.mutation.StepLabel(); !ok {
+		v := metricsrollup.DefaultStepLabel
+		mrc.mutation.SetStepLabel(v)
+	}
+	if _, ok := mrc.mutation.UserLdap(); !ok {
+		v := metricsrollup.DefaultUserLdap
+		mrc.mutation.SetUserLdap(v)
+	}
+	if _, ok := mrc.mutation.UserEmail(); !ok {
+		v := metricsrollup.DefaultUserEmail
+		mrc.mutation.SetUserEmail(v)
+	}
+	if _, ok := mrc.mutation.Invocations(); !ok {
+		v := metricsrollup.DefaultInvocations
+		mrc.mutation.SetInvocations(v)
+	}
+	if _, ok := mrc.mutation.Numerator(); !ok {
+		v := metricsrollup.DefaultNumerator
+		mrc.mutation.SetNumerator(v)
+	}
+	if _, ok := mrc.mutation.Denominator(); !ok {
+		v := metricsrollup.DefaultDenominator
+		mrc.mutation.SetDenominator(v)
+	}
+	if _, ok := mrc.mutation.Revision(); !ok {
+		v := metricsrollup.DefaultRevision
+		mrc.mutation.SetRevision(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mrc *MetricsRollupCreate) check() error {
+	if _, ok := mrc.mutation.Granularity(); !ok {
+		return &ValidationError{Name: "granularity", err: errors.New(`ent: missing required field "MetricsRollup.granularity"`)}
+	}
+	if v, ok := mrc.mutation.Granularity(); ok {
+		if err := metricsrollup.GranularityValidator(v); err != nil {
+			return &ValidationError{Name: "granularity", err: fmt.Errorf(`ent: validator failed for field "MetricsRollup.granularity": %w`, err)}
+		}
+	}
+	if _, ok := mrc.mutation.BucketStart(); !ok {
+		return &ValidationError{Name: "bucket_start", err: errors.New(`ent: missing required field "MetricsRollup.bucket_start"`)}
+	}
+	if _, ok := mrc.mutation.Metric(); !ok {
+		return &ValidationError{Name: "metric", err: errors.New(`ent: missing required field "MetricsRollup.metric"`)}
+	}
+	if _, ok := mrc.mutation.Branch(); !ok {
+		return &ValidationError{Name: "branch", err: errors.New(`ent: missing required field "MetricsRollup.branch"`)}
+	}
+	if _, ok := mrc.mutation.Command(); !ok {
+		return &ValidationError{Name: "command", err: errors.New(`ent: missing required field "MetricsRollup.command"`)}
+	}
+	if _, ok := mrc.mutation.StepLabel(); !ok {
+		return &ValidationError{Name: "step_label", err: errors.New(`ent: missing required field "MetricsRollup.step_label"`)}
+	}
+	if _, ok := mrc.mutation.UserLdap(); !ok {
+		return &ValidationError{Name: "user_ldap", err: errors.New(`ent: missing required field "MetricsRollup.user_ldap"`)}
+	}
+	if _, ok := mrc.mutation.UserEmail(); !ok {
+		return &ValidationError{Name: "user_email", err: errors.New(`ent: missing required field "MetricsRollup.user_email"`)}
+	}
+	if _, ok := mrc.mutation.Invocations(); !ok {
+		return &ValidationError{Name: "invocations", err: errors.New(`ent: missing required field "MetricsRollup.invocations"`)}
+	}
+	if _, ok := mrc.mutation.Numerator(); !ok {
+		return &ValidationError{Name: "numerator", err: errors.New(`ent: missing required field "MetricsRollup.numerator"`)}
+	}
+	if _, ok := mrc.mutation.Denominator(); !ok {
+		return &ValidationError{Name: "denominator", err: errors.New(`ent: missing required field "MetricsRollup.denominator"`)}
+	}
+	if _, ok := mrc.mutation.Min(); !ok {
+		return &ValidationError{Name: "min", err: errors.New(`ent: missing required field "MetricsRollup.min"`)}
+	}
+	if _, ok := mrc.mutation.Max(); !ok {
+		return &ValidationError{Name: "max", err: errors.New(`ent: missing required field "MetricsRollup.max"`)}
+	}
+	if _, ok := mrc.mutation.Histogram(); !ok {
+		return &ValidationError{Name: "histogram", err: errors.New(`ent: missing required field "MetricsRollup.histogram"`)}
+	}
+	if _, ok := mrc.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "MetricsRollup.revision"`)}
+	}
+	return nil
+}
+
+func (mrc *MetricsRollupCreate) sqlSave(ctx context.Context) (*MetricsRollup, error) {
+	if err := mrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mrc.mutation.id = &_node.ID
+	mrc.mutation.done = true
+	return _node, nil
+}
+
+func (mrc *MetricsRollupCreate) createSpec() (*MetricsRollup, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MetricsRollup{config: mrc.config}
+		_spec = sqlgraph.NewCreateSpec(metricsrollup.Table, sqlgraph.NewFieldSpec(metricsrollup.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = mrc.conflict
+	if value, ok := mrc.mutation.Granularity(); ok {
+		_spec.SetField(metricsrollup.FieldGranularity, field.TypeEnum, value)
+		_node.Granularity = value
+	}
+	if value, ok := mrc.mutation.BucketStart(); ok {
+		_spec.SetField(metricsrollup.FieldBucketStart, field.TypeTime, value)
+		_node.BucketStart = value
+	}
+	if value, ok := mrc.mutation.Metric(); ok {
+		_spec.SetField(metricsrollup.FieldMetric, field.TypeString, value)
+		_node.Metric = value
+	}
+	if value, ok := mrc.mutation.Branch(); ok {
+		_spec.SetField(metricsrollup.FieldBranch, field.TypeString, value)
+		_node.Branch = value
+	}
+	if value, ok := mrc.mutation.Command(); ok {
+		_spec.SetField(metricsrollup.FieldCommand, field.TypeString, value)
+		_node.Command = value
+	}
+	if value, ok := mrc.mutation.StepLabel(); ok {
+		_spec.SetField(metricsrollup.FieldStepLabel, field.TypeString, value)
+		_node.StepLabel = value
+	}
+	if value, ok := mrc.mutation.UserLdap(); ok {
+		_spec.SetField(metricsrollup.FieldUserLdap, field.TypeString, value)
+		_node.UserLdap = value
+	}
+	if value, ok := mrc.mutation.UserEmail(); ok {
+		_spec.SetField(metricsrollup.FieldUserEmail, field.TypeString, value)
+		_node.UserEmail = value
+	}
+	if value, ok := mrc.mutation.Invocations(); ok {
+		_spec.SetField(metricsrollup.FieldInvocations, field.TypeInt64, value)
+		_node.Invocations = value
+	}
+	if value, ok := mrc.mutation.Numerator(); ok {
+		_spec.SetField(metricsrollup.FieldNumerator, field.TypeFloat64, value)
+		_node.Numerator = value
+	}
+	if value, ok := mrc.mutation.Denominator(); ok {
+		_spec.SetField(metricsrollup.FieldDenominator, field.TypeFloat64, value)
+		_node.Denominator = value
+	}
+	if value, ok := mrc.mutation.Min(); ok {
+		_spec.SetField(metricsrollup.FieldMin, field.TypeFloat64, value)
+		_node.Min = value
+	}
+	if value, ok := mrc.mutation.Max(); ok {
+		_spec.SetField(metricsrollup.FieldMax, field.TypeFloat64, value)
+		_node.Max = value
+	}
+	if value, ok := mrc.mutation.Histogram(); ok {
+		_spec.SetField(metricsrollup.FieldHistogram, field.TypeJSON, value)
+		_node.Histogram = value
+	}
+	if value, ok := mrc.mutation.Revision(); ok {
+		_spec.SetField(metricsrollup.FieldRevision, field.TypeInt64, value)
+		_node.Revision = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MetricsRollup.Create().
+//		SetGranularity(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MetricsRollupUpsert) {
+//			SetGranularity(v+v).
+//		}).
+//		Exec(ctx)
+func (mrc *MetricsRollupCreate) OnConflict(opts ...sql.ConflictOption) *MetricsRollupUpsertOne {
+	mrc.conflict = opts
+	return &MetricsRollupUpsertOne{
+		create: mrc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MetricsRollup.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mrc *MetricsRollupCreate) OnConflictColumns(columns ...string) *MetricsRollupUpsertOne {
+	mrc.conflict = append(mrc.conflict, sql.ConflictColumns(columns...))
+	return &MetricsRollupUpsertOne{
+		create: mrc,
+	}
+}
+
+type (
+	// MetricsRollupUpsertOne is the builder for "upsert"-ing
+	//  one MetricsRollup node.
+	MetricsRollupUpsertOne struct {
+		create *MetricsRollupCreate
+	}
+
+	// MetricsRollupUpsert is the "OnConflict" setter.
+	MetricsRollupUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetInvocations sets the "invocations" field.
+func (u *MetricsRollupUpsert) SetInvocations(v int64) *MetricsRollupUpsert {
+	u.Set(metricsrollup.FieldInvocations, v)
+	return u
+}
+
+// UpdateInvocations sets the "invocations" field to the value that was provided on create.
+func (u *MetricsRollupUpsert) UpdateInvocations() *MetricsRollupUpsert {
+	u.SetExcluded(metricsrollup.FieldInvocations)
+	return u
+}
+
+// AddInvocations adds v to the "invocations" field.
+func (u *MetricsRollupUpsert) AddInvocations(v int64) *MetricsRollupUpsert {
+	u.Add(metricsrollup.FieldInvocations, v)
+	return u
+}
+
+// SetNumerator sets the "numerator" field.
+func (u *MetricsRollupUpsert) SetNumerator(v float64) *MetricsRollupUpsert {
+	u.Set(metricsrollup.FieldNumerator, v)
+	return u
+}
+
+// UpdateNumerator sets the "numerator" field to the value that was provided on create.
+func (u *MetricsRollupUpsert) UpdateNumerator() *MetricsRollupUpsert {
+	u.SetExcluded(metricsrollup.FieldNumerator)
+	return u
+}
+
+// AddNumerator adds v to the "numerator" field.
+func (u *MetricsRollupUpsert) AddNumerator(v float64) *MetricsRollupUpsert {
+	u.Add(metricsrollup.FieldNumerator, v)
+	return u
+}
+
+// SetDenominator sets the "denominator" field.
+func (u *MetricsRollupUpsert) SetDenominator(v float64) *MetricsRollupUpsert {
+	u.Set(metricsrollup.FieldDenominator, v)
+	return u
+}
+
+// UpdateDenominator sets the "denominator" field to the value that was provided on create.
+func (u *MetricsRollupUpsert) UpdateDenominator() *MetricsRollupUpsert {
+	u.SetExcluded(metricsrollup.FieldDenominator)
+	return u
+}
+
+// AddDenominator adds v to the "denominator" field.
+func (u *MetricsRollupUpsert) AddDenominator(v float64) *MetricsRollupUpsert {
+	u.Add(metricsrollup.FieldDenominator, v)
+	return u
+}
+
+// SetMin sets the "min" field.
+func (u *MetricsRollupUpsert) SetMin(v float64) *MetricsRollupUpsert {
+	u.Set(metricsrollup.FieldMin, v)
+	return u
+}
+
+// UpdateMin sets the "min" field to the value that was provided on create.
+func (u *MetricsRollupUpsert) UpdateMin() *MetricsRollupUpsert {
+	u.SetExcluded(metricsrollup.FieldMin)
+	return u
+}
+
+// AddMin adds v to the "min" field.
+func (u *MetricsRollupUpsert) AddMin(v float64) *MetricsRollupUpsert {
+	u.Add(metricsrollup.FieldMin, v)
+	return u
+}
+
+// SetMax sets the "max" field.
+func (u *MetricsRollupUpsert) SetMax(v float64) *MetricsRollupUpsert {
+	u.Set(metricsrollup.FieldMax, v)
+	return u
+}
+
+// UpdateMax sets the "max" field to the value that was provided on create.
+func (u *MetricsRollupUpsert) UpdateMax() *MetricsRollupUpsert {
+	u.SetExcluded(metricsrollup.FieldMax)
+	return u
+}
+
+// AddMax adds v to the "max" field.
+func (u *MetricsRollupUpsert) AddMax(v float64) *MetricsRollupUpsert {
+	u.Add(metricsrollup.FieldMax, v)
+	return u
+}
+
+// SetHistogram sets the "histogram" field.
+func (u *MetricsRollupUpsert) SetHistogram(v map[int]int64) *MetricsRollupUpsert {
+	u.Set(metricsrollup.FieldHistogram, v)
+	return u
+}
+
+// UpdateHistogram sets the "histogram" field to the value that was provided on create.
+func (u *MetricsRollupUpsert) UpdateHistogram() *MetricsRollupUpsert {
+	u.SetExcluded(metricsrollup.FieldHistogram)
+	return u
+}
+
+// SetRevision sets the "revision" field.
+func (u *MetricsRollupUpsert) SetRevision(v int64) *MetricsRollupUpsert {
+	u.Set(metricsrollup.FieldRevision, v)
+	return u
+}
+
+// UpdateRevision sets the "revision" field to the value that was provided on create.
+func (u *MetricsRollupUpsert) UpdateRevision() *MetricsRollupUpsert {
+	u.SetExcluded(metricsrollup.FieldRevision)
+	return u
+}
+
+// AddRevision adds v to the "revision" field.
+func (u *MetricsRollupUpsert) AddRevision(v int64) *MetricsRollupUpsert {
+	u.Add(metricsrollup.FieldRevision, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.MetricsRollup.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *MetricsRollupUpsertOne) UpdateNewValues() *MetricsRollupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.Granularity(); exists {
+			s.SetIgnore(metricsrollup.FieldGranularity)
+		}
+		if _, exists := u.create.mutation.BucketStart(); exists {
+			s.SetIgnore(metricsrollup.FieldBucketStart)
+		}
+		if _, exists := u.create.mutation.Metric(); exists {
+			s.SetIgnore(metricsrollup.FieldMetric)
+		}
+		if _, exists := u.create.mutation.Branch(); exists {
+			s.SetIgnore(metricsrollup.FieldBranch)
+		}
+		if _, exists := u.create.mutation.Command(); exists {
+			s.SetIgnore(metricsrollup.FieldCommand)
+		}
+		if _, exists := u.create.mutation.StepLabel(); exists {
+			s.SetIgnore(metricsrollup.FieldStepLabel)
+		}
+		if _, exists := u.create.mutation.UserLdap(); exists {
+			s.SetIgnore(metricsrollup.FieldUserLdap)
+		}
+		if _, exists := u.create.mutation.UserEmail(); exists {
+			s.SetIgnore(metricsrollup.FieldUserEmail)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MetricsRollup.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MetricsRollupUpsertOne) Ignore() *MetricsRollupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MetricsRollupUpsertOne) DoNothing() *MetricsRollupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MetricsRollupCreate.OnConflict
+// documentation for more info.
+func (u *MetricsRollupUpsertOne) Update(set func(*MetricsRollupUpsert)) *MetricsRollupUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MetricsRollupUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetInvocations sets the "invocations" field.
+func (u *MetricsRollupUpsertOne) SetInvocations(v int64) *MetricsRollupUpsertOne {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.SetInvocations(v)
+	})
+}
+
+// AddInvocations adds v to the "invocations" field.
+func (u *MetricsRollupUpsertOne) AddInvocations(v int64) *MetricsRollupUpsertOne {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.AddInvocations(v)
+	})
+}
+
+// UpdateInvocations sets the "invocations" field to the value that was provided on create.
+func (u *MetricsRollupUpsertOne) UpdateInvocations() *MetricsRollupUpsertOne {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.UpdateInvocations()
+	})
+}
+
+// SetNumerator sets the "numerator" field.
+func (u *MetricsRollupUpsertOne) SetNumerator(v float64) *MetricsRollupUpsertOne {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.SetNumerator(v)
+	})
+}
+
+// AddNumerator adds v to the "numerator" field.
+func (u *MetricsRollupUpsertOne) AddNumerator(v float64) *MetricsRollupUpsertOne {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.AddNumerator(v)
+	})
+}
+
+// UpdateNumerator sets the "numerator" field to the value that was provided on create.
+func (u *MetricsRollupUpsertOne) UpdateNumerator() *MetricsRollupUpsertOne {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.UpdateNumerator()
+	})
+}
+
+// SetDenominator sets the "denominator" field.
+func (u *MetricsRollupUpsertOne) SetDenominator(v float64) *MetricsRollupUpsertOne {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.SetDenominator(v)
+	})
+}
+
+// AddDenominator adds v to the "denominator" field.
+func (u *MetricsRollupUpsertOne) AddDenominator(v float64) *MetricsRollupUpsertOne {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.AddDenominator(v)
+	})
+}
+
+// UpdateDenominator sets the "denominator" field to the value that was provided on create.
+func (u *MetricsRollupUpsertOne) UpdateDenominator() *MetricsRollupUpsertOne {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.UpdateDenominator()
+	})
+}
+
+// SetMin sets the "min" field.
+func (u *MetricsRollupUpsertOne) SetMin(v float64) *MetricsRollupUpsertOne {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.SetMin(v)
+	})
+}
+
+// AddMin adds v to the "min" field.
+func (u *MetricsRollupUpsertOne) AddMin(v float64) *MetricsRollupUpsertOne {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.AddMin(v)
+	})
+}
+
+// UpdateMin sets the "min" field to the value that was provided on create.
+func (u *MetricsRollupUpsertOne) UpdateMin() *MetricsRollupUpsertOne {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.UpdateMin()
+	})
+}
+
+// SetMax sets the "max" field.
+func (u *MetricsRollupUpsertOne) SetMax(v float64) *MetricsRollupUpsertOne {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.SetMax(v)
+	})
+}
+
+// AddMax adds v to the "max" field.
+func (u *MetricsRollupUpsertOne) AddMax(v float64) *MetricsRollupUpsertOne {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.AddMax(v)
+	})
+}
+
+// UpdateMax sets the "max" field to the value that was provided on create.
+func (u *MetricsRollupUpsertOne) UpdateMax() *MetricsRollupUpsertOne {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.UpdateMax()
+	})
+}
+
+// SetHistogram sets the "histogram" field.
+func (u *MetricsRollupUpsertOne) SetHistogram(v map[int]int64) *MetricsRollupUpsertOne {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.SetHistogram(v)
+	})
+}
+
+// UpdateHistogram sets the "histogram" field to the value that was provided on create.
+func (u *MetricsRollupUpsertOne) UpdateHistogram() *MetricsRollupUpsertOne {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.UpdateHistogram()
+	})
+}
+
+// SetRevision sets the "revision" field.
+func (u *MetricsRollupUpsertOne) SetRevision(v int64) *MetricsRollupUpsertOne {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.SetRevision(v)
+	})
+}
+
+// AddRevision adds v to the "revision" field.
+func (u *MetricsRollupUpsertOne) AddRevision(v int64) *MetricsRollupUpsertOne {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.AddRevision(v)
+	})
+}
+
+// UpdateRevision sets the "revision" field to the value that was provided on create.
+func (u *MetricsRollupUpsertOne) UpdateRevision() *MetricsRollupUpsertOne {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.UpdateRevision()
+	})
+}
+
+// Exec executes the query.
+func (u *MetricsRollupUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MetricsRollupCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MetricsRollupUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MetricsRollupUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MetricsRollupUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MetricsRollupCreateBulk is the builder for creating many MetricsRollup entities in bulk.
+type MetricsRollupCreateBulk struct {
+	config
+	err      error
+	builders []*MetricsRollupCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MetricsRollup entities in the database.
+func (mrcb *MetricsRollupCreateBulk) Save(ctx context.Context) ([]*MetricsRollup, error) {
+	if mrcb.err != nil {
+		return nil, mrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mrcb.builders))
+	nodes := make([]*MetricsRollup, len(mrcb.builders))
+	mutators := make([]Mutator, len(mrcb.builders))
+	for i := range mrcb.builders {
+		func(i int, root context.Context) {
+			builder := mrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MetricsRollupMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mrcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mrcb *MetricsRollupCreateBulk) SaveX(ctx context.Context) []*MetricsRollup {
+	v, err := mrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mrcb *MetricsRollupCreateBulk) Exec(ctx context.Context) error {
+	_, err := mrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrcb *MetricsRollupCreateBulk) ExecX(ctx context.Context) {
+	if err := mrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MetricsRollup.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MetricsRollupUpsert) {
+//			SetGranularity(v+v).
+//		}).
+//		Exec(ctx)
+func (mrcb *MetricsRollupCreateBulk) OnConflict(opts ...sql.ConflictOption) *MetricsRollupUpsertBulk {
+	mrcb.conflict = opts
+	return &MetricsRollupUpsertBulk{
+		create: mrcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MetricsRollup.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mrcb *MetricsRollupCreateBulk) OnConflictColumns(columns ...string) *MetricsRollupUpsertBulk {
+	mrcb.conflict = append(mrcb.conflict, sql.ConflictColumns(columns...))
+	return &MetricsRollupUpsertBulk{
+		create: mrcb,
+	}
+}
+
+// MetricsRollupUpsertBulk is the builder for "upsert"-ing
+// a bulk of MetricsRollup nodes.
+type MetricsRollupUpsertBulk struct {
+	create *MetricsRollupCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MetricsRollup.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *MetricsRollupUpsertBulk) UpdateNewValues() *MetricsRollupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.Granularity(); exists {
+				s.SetIgnore(metricsrollup.FieldGranularity)
+			}
+			if _, exists := b.mutation.BucketStart(); exists {
+				s.SetIgnore(metricsrollup.FieldBucketStart)
+			}
+			if _, exists := b.mutation.Metric(); exists {
+				s.SetIgnore(metricsrollup.FieldMetric)
+			}
+			if _, exists := b.mutation.Branch(); exists {
+				s.SetIgnore(metricsrollup.FieldBranch)
+			}
+			if _, exists := b.mutation.Command(); exists {
+				s.SetIgnore(metricsrollup.FieldCommand)
+			}
+			if _, exists := b.mutation.StepLabel(); exists {
+				s.SetIgnore(metricsrollup.FieldStepLabel)
+			}
+			if _, exists := b.mutation.UserLdap(); exists {
+				s.SetIgnore(metricsrollup.FieldUserLdap)
+			}
+			if _, exists := b.mutation.UserEmail(); exists {
+				s.SetIgnore(metricsrollup.FieldUserEmail)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MetricsRollup.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MetricsRollupUpsertBulk) Ignore() *MetricsRollupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MetricsRollupUpsertBulk) DoNothing() *MetricsRollupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MetricsRollupCreateBulk.OnConflict
+// documentation for more info.
+func (u *MetricsRollupUpsertBulk) Update(set func(*MetricsRollupUpsert)) *MetricsRollupUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MetricsRollupUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetInvocations sets the "invocations" field.
+func (u *MetricsRollupUpsertBulk) SetInvocations(v int64) *MetricsRollupUpsertBulk {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.SetInvocations(v)
+	})
+}
+
+// AddInvocations adds v to the "invocations" field.
+func (u *MetricsRollupUpsertBulk) AddInvocations(v int64) *MetricsRollupUpsertBulk {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.AddInvocations(v)
+	})
+}
+
+// UpdateInvocations sets the "invocations" field to the value that was provided on create.
+func (u *MetricsRollupUpsertBulk) UpdateInvocations() *MetricsRollupUpsertBulk {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.UpdateInvocations()
+	})
+}
+
+// SetNumerator sets the "numerator" field.
+func (u *MetricsRollupUpsertBulk) SetNumerator(v float64) *MetricsRollupUpsertBulk {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.SetNumerator(v)
+	})
+}
+
+// AddNumerator adds v to the "numerator" field.
+func (u *MetricsRollupUpsertBulk) AddNumerator(v float64) *MetricsRollupUpsertBulk {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.AddNumerator(v)
+	})
+}
+
+// UpdateNumerator sets the "numerator" field to the value that was provided on create.
+func (u *MetricsRollupUpsertBulk) UpdateNumerator() *MetricsRollupUpsertBulk {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.UpdateNumerator()
+	})
+}
+
+// SetDenominator sets the "denominator" field.
+func (u *MetricsRollupUpsertBulk) SetDenominator(v float64) *MetricsRollupUpsertBulk {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.SetDenominator(v)
+	})
+}
+
+// AddDenominator adds v to the "denominator" field.
+func (u *MetricsRollupUpsertBulk) AddDenominator(v float64) *MetricsRollupUpsertBulk {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.AddDenominator(v)
+	})
+}
+
+// UpdateDenominator sets the "denominator" field to the value that was provided on create.
+func (u *MetricsRollupUpsertBulk) UpdateDenominator() *MetricsRollupUpsertBulk {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.UpdateDenominator()
+	})
+}
+
+// SetMin sets the "min" field.
+func (u *MetricsRollupUpsertBulk) SetMin(v float64) *MetricsRollupUpsertBulk {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.SetMin(v)
+	})
+}
+
+// AddMin adds v to the "min" field.
+func (u *MetricsRollupUpsertBulk) AddMin(v float64) *MetricsRollupUpsertBulk {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.AddMin(v)
+	})
+}
+
+// UpdateMin sets the "min" field to the value that was provided on create.
+func (u *MetricsRollupUpsertBulk) UpdateMin() *MetricsRollupUpsertBulk {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.UpdateMin()
+	})
+}
+
+// SetMax sets the "max" field.
+func (u *MetricsRollupUpsertBulk) SetMax(v float64) *MetricsRollupUpsertBulk {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.SetMax(v)
+	})
+}
+
+// AddMax adds v to the "max" field.
+func (u *MetricsRollupUpsertBulk) AddMax(v float64) *MetricsRollupUpsertBulk {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.AddMax(v)
+	})
+}
+
+// UpdateMax sets the "max" field to the value that was provided on create.
+func (u *MetricsRollupUpsertBulk) UpdateMax() *MetricsRollupUpsertBulk {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.UpdateMax()
+	})
+}
+
+// SetHistogram sets the "histogram" field.
+func (u *MetricsRollupUpsertBulk) SetHistogram(v map[int]int64) *MetricsRollupUpsertBulk {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.SetHistogram(v)
+	})
+}
+
+// UpdateHistogram sets the "histogram" field to the value that was provided on create.
+func (u *MetricsRollupUpsertBulk) UpdateHistogram() *MetricsRollupUpsertBulk {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.UpdateHistogram()
+	})
+}
+
+// SetRevision sets the "revision" field.
+func (u *MetricsRollupUpsertBulk) SetRevision(v int64) *MetricsRollupUpsertBulk {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.SetRevision(v)
+	})
+}
+
+// AddRevision adds v to the "revision" field.
+func (u *MetricsRollupUpsertBulk) AddRevision(v int64) *MetricsRollupUpsertBulk {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.AddRevision(v)
+	})
+}
+
+// UpdateRevision sets the "revision" field to the value that was provided on create.
+func (u *MetricsRollupUpsertBulk) UpdateRevision() *MetricsRollupUpsertBulk {
+	return u.Update(func(s *MetricsRollupUpsert) {
+		s.UpdateRevision()
+	})
+}
+
+// Exec executes the query.
+func (u *MetricsRollupUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MetricsRollupCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MetricsRollupCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MetricsRollupUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/metricsrollup"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/predicate"
+)
+
+// MetricsRollupDelete is the builder for deleting a MetricsRollup entity.
+type MetricsRollupDelete struct {
+	config
+	hooks    []Hook
+	mutation *MetricsRollupMutation
+}
+
+// Where appends a list predicates to the MetricsRollupDelete builder.
+func (mrd *MetricsRollupDelete) Where(ps ...predicate.MetricsRollup) *MetricsRollupDelete {
+	mrd.mutation.Where(ps...)
+	return mrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mrd *MetricsRollupDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mrd.sqlExec, mrd.mutation, mrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrd *MetricsRollupDelete) ExecX(ctx context.Context) int {
+	n, err := mrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mrd *MetricsRollupDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(metricsrollup.Table, sqlgraph.NewFieldSpec(metricsrollup.FieldID, field.TypeInt))
+	if ps := mrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mrd.mutation.done = true
+	return affected, err
+}
+
+// MetricsRollupDeleteOne is the builder for deleting a single MetricsRollup entity.
+type MetricsRollupDeleteOne struct {
+	mrd *MetricsRollupDelete
+}
+
+// Where appends a list predicates to the MetricsRollupDelete builder.
+func (mrdo *MetricsRollupDeleteOne) Where(ps ...predicate.MetricsRollup) *MetricsRollupDeleteOne {
+	mrdo.mrd.mutation.Where(ps...)
+	return mrdo
+}
+
+// Exec executes the deletion query.
+func (mrdo *MetricsRollupDeleteOne) Exec(ctx context.Context) error {
+	n, err := mrdo.mrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{metricsrollup.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mrdo *MetricsRollupDeleteOne) ExecX(ctx context.Context) {
+	if err := mrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,517 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/metricsrollup"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/predicate"
+)
+
+// MetricsRollupUpdate is the builder for updating MetricsRollup entities.
+type MetricsRollupUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MetricsRollupMutation
+}
+
+// Where appends a list predicates to the MetricsRollupUpdate builder.
+func (mru *MetricsRollupUpdate) Where(ps ...predicate.MetricsRollup) *MetricsRollupUpdate {
+	mru.mutation.Where(ps...)
+	return mru
+}
+
+// SetInvocations sets the "invocations" field.
+func (mru *MetricsRollupUpdate) SetInvocations(i int64) *MetricsRollupUpdate {
+	mru.mutation.ResetInvocations()
+	mru.mutation.SetInvocations(i)
+	return mru
+}
+
+// SetNillableInvocations sets the "invocations" field if the given value is not nil.
+func (mru *MetricsRollupUpdate) SetNillableInvocations(i *int64) *MetricsRollupUpdate {
+	if i != nil {
+		mru.SetInvocations(*i)
+	}
+	return mru
+}
+
+// AddInvocations adds i to the "invocations" field.
+func (mru *MetricsRollupUpdate) AddInvocations(i int64) *MetricsRollupUpdate {
+	mru.mutation.AddInvocations(i)
+	return mru
+}
+
+// SetNumerator sets the "numerator" field.
+func (mru *MetricsRollupUpdate) SetNumerator(f float64) *MetricsRollupUpdate {
+	mru.mutation.ResetNumerator()
+	mru.mutation.SetNumerator(f)
+	return mru
+}
+
+// SetNillableNumerator sets the "numerator" field if the given value is not nil.
+func (mru *MetricsRollupUpdate) SetNillableNumerator(f *float64) *MetricsRollupUpdate {
+	if f != nil {
+		mru.SetNumerator(*f)
+	}
+	return mru
+}
+
+// AddNumerator adds f to the "numerator" field.
+func (mru *MetricsRollupUpdate) AddNumerator(f float64) *MetricsRollupUpdate {
+	mru.mutation.AddNumerator(f)
+	return mru
+}
+
+// SetDenominator sets the "denominator" field.
+func (mru *MetricsRollupUpdate) SetDenominator(f float64) *MetricsRollupUpdate {
+	mru.mutation.ResetDenominator()
+	mru.mutation.SetDenominator(f)
+	return mru
+}
+
+// SetNillableDenominator sets the "denominator" field if the given value is not nil.
+func (mru *MetricsRollupUpdate) SetNillableDenominator(f *float64) *MetricsRollupUpdate {
+	if f != nil {
+		mru.SetDenominator(*f)
+	}
+	return mru
+}
+
+// AddDenominator adds f to the "denominator" field.
+func (mru *MetricsRollupUpdate) AddDenominator(f float64) *MetricsRollupUpdate {
+	mru.mutation.AddDenominator(f)
+	return mru
+}
+
+// SetMin sets the "min" field.
+func (mru *MetricsRollupUpdate) SetMin(f float64) *MetricsRollupUpdate {
+	mru.mutation.ResetMin()
+	mru.mutation.SetMin(f)
+	return mru
+}
+
+// SetNillableMin sets the "min" field if the given value is not nil.
+func (mru *MetricsRollupUpdate) SetNillableMin(f *float64) *MetricsRollupUpdate {
+	if f != nil {
+		mru.SetMin(*f)
+	}
+	return mru
+}
+
+// AddMin adds f to the "min" field.
+func (mru *MetricsRollupUpdate) AddMin(f float64) *MetricsRollupUpdate {
+	mru.mutation.AddMin(f)
+	return mru
+}
+
+// SetMax sets the "max" field.
+func (mru *MetricsRollupUpdate) SetMax(f float64) *MetricsRollupUpdate {
+	mru.mutation.ResetMax()
+	mru.mutation.SetMax(f)
+	return mru
+}
+
+// SetNillableMax sets the "max" field if the given value is not nil.
+func (mru *MetricsRollupUpdate) SetNillableMax(f *float64) *MetricsRollupUpdate {
+	if f != nil {
+		mru.SetMax(*f)
+	}
+	return mru
+}
+
+// AddMax adds f to the "max" field.
+func (mru *MetricsRollupUpdate) AddMax(f float64) *MetricsRollupUpdate {
+	mru.mutation.AddMax(f)
+	return mru
+}
+
+// SetHistogram sets the "histogram" field.
+func (mru *MetricsRollupUpdate) SetHistogram(m map[int]int64) *MetricsRollupUpdate {
+	mru.mutation.SetHistogram(m)
+	return mru
+}
+
+// SetRevision sets the "revision" field.
+func (mru *MetricsRollupUpdate) SetRevision(i int64) *MetricsRollupUpdate {
+	mru.mutation.ResetRevision()
+	mru.mutation.SetRevision(i)
+	return mru
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (mru *MetricsRollupUpdate) SetNillableRevision(i *int64) *MetricsRollupUpdate {
+	if i != nil {
+		mru.SetRevision(*i)
+	}
+	return mru
+}
+
+// AddRevision adds i to the "revision" field.
+func (mru *MetricsRollupUpdate) AddRevision(i int64) *MetricsRollupUpdate {
+	mru.mutation.AddRevision(i)
+	return mru
+}
+
+// Mutation returns the MetricsRollupMutation object of the builder.
+func (mru *MetricsRollupUpdate) Mutation() *MetricsRollupMutation {
+	return mru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mru *MetricsRollupUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mru.sqlSave, mru.mutation, mru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mru *MetricsRollupUpdate) SaveX(ctx context.Context) int {
+	affected, err := mru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mru *MetricsRollupUpdate) Exec(ctx context.Context) error {
+	_, err := mru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mru *MetricsRollupUpdate) ExecX(ctx context.Context) {
+	if err := mru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (mru *MetricsRollupUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(metricsrollup.Table, metricsrollup.Columns, sqlgraph.NewFieldSpec(metricsrollup.FieldID, field.TypeInt))
+	if ps := mru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mru.mutation.Invocations(); ok {
+		_spec.SetField(metricsrollup.FieldInvocations, field.TypeInt64, value)
+	}
+	if value, ok := mru.mutation.AddedInvocations(); ok {
+		_spec.AddField(metricsrollup.FieldInvocations, field.TypeInt64, value)
+	}
+	if value, ok := mru.mutation.Numerator(); ok {
+		_spec.SetField(metricsrollup.FieldNumerator, field.TypeFloat64, value)
+	}
+	if value, ok := mru.mutation.AddedNumerator(); ok {
+		_spec.AddField(metricsrollup.FieldNumerator, field.TypeFloat64, value)
+	}
+	if value, ok := mru.mutation.Denominator(); ok {
+		_spec.SetField(metricsrollup.FieldDenominator, field.TypeFloat64, value)
+	}
+	if value, ok := mru.mutation.AddedDenominator(); ok {
+		_spec.AddField(metricsrollup.FieldDenominator, field.TypeFloat64, value)
+	}
+	if value, ok := mru.mutation.Min(); ok {
+		_spec.SetField(metricsrollup.FieldMin, field.TypeFloat64, value)
+	}
+	if value, ok := mru.mutation.AddedMin(); ok {
+		_spec.AddField(metricsrollup.FieldMin, field.TypeFloat64, value)
+	}
+	if value, ok := mru.mutation.Max(); ok {
+		_spec.SetField(metricsrollup.FieldMax, field.TypeFloat64, value)
+	}
+	if value, ok := mru.mutation.AddedMax(); ok {
+		_spec.AddField(metricsrollup.FieldMax, field.TypeFloat64, value)
+	}
+	if value, ok := mru.mutation.Histogram(); ok {
+		_spec.SetField(metricsrollup.FieldHistogram, field.TypeJSON, value)
+	}
+	if value, ok := mru.mutation.Revision(); ok {
+		_spec.SetField(metricsrollup.FieldRevision, field.TypeInt64, value)
+	}
+	if value, ok := mru.mutation.AddedRevision(); ok {
+		_spec.AddField(metricsrollup.FieldRevision, field.TypeInt64, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{metricsrollup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mru.mutation.done = true
+	return n, nil
+}
+
+// MetricsRollupUpdateOne is the builder for updating a single MetricsRollup entity.
+type MetricsRollupUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MetricsRollupMutation
+}
+
+// SetInvocations sets the "invocations" field.
+func (mruo *MetricsRollupUpdateOne) SetInvocations(i int64) *MetricsRollupUpdateOne {
+	mruo.mutation.ResetInvocations()
+	mruo.mutation.SetInvocations(i)
+	return mruo
+}
+
+// SetNillableInvocations sets the "invocations" field if the given value is not nil.
+func (mruo *MetricsRollupUpdateOne) SetNillableInvocations(i *int64) *MetricsRollupUpdateOne {
+	if i != nil {
+		mruo.SetInvocations(*i)
+	}
+	return mruo
+}
+
+// AddInvocations adds i to the "invocations" field.
+func (mruo *MetricsRollupUpdateOne) AddInvocations(i int64) *MetricsRollupUpdateOne {
+	mruo.mutation.AddInvocations(i)
+	return mruo
+}
+
+// SetNumerator sets the "numerator" field.
+func (mruo *MetricsRollupUpdateOne) SetNumerator(f float64) *MetricsRollupUpdateOne {
+	mruo.mutation.ResetNumerator()
+	mruo.mutation.SetNumerator(f)
+	return mruo
+}
+
+// SetNillableNumerator sets the "numerator" field if the given value is not nil.
+func (mruo *MetricsRollupUpdateOne) SetNillableNumerator(f *float64) *MetricsRollupUpdateOne {
+	if f != nil {
+		mruo.SetNumerator(*f)
+	}
+	return mruo
+}
+
+// AddNumerator adds f to the "numerator" field.
+func (mruo *MetricsRollupUpdateOne) AddNumerator(f float64) *MetricsRollupUpdateOne {
+	mruo.mutation.AddNumerator(f)
+	return mruo
+}
+
+// SetDenominator sets the "denominator" field.
+func (mruo *MetricsRollupUpdateOne) SetDenominator(f float64) *MetricsRollupUpdateOne {
+	mruo.mutation.ResetDenominator()
+	mruo.mutation.SetDenominator(f)
+	return mruo
+}
+
+// SetNillableDenominator sets the "denominator" field if the given value is not nil.
+func (mruo *MetricsRollupUpdateOne) SetNillableDenominator(f *float64) *MetricsRollupUpdateOne {
+	if f != nil {
+		mruo.SetDenominator(*f)
+	}
+	return mruo
+}
+
+// AddDenominator adds f to the "denominator" field.
+func (mruo *MetricsRollupUpdateOne) AddDenominator(f float64) *MetricsRollupUpdateOne {
+	mruo.mutation.AddDenominator(f)
+	return mruo
+}
+
+// SetMin sets the "min" field.
+func (mruo *MetricsRollupUpdateOne) SetMin(f float64) *MetricsRollupUpdateOne {
+	mruo.mutation.ResetMin()
+	mruo.mutation.SetMin(f)
+	return mruo
+}
+
+// SetNillableMin sets the "min" field if the given value is not nil.
+func (mruo *MetricsRollupUpdateOne) SetNillableMin(f *float64) *MetricsRollupUpdateOne {
+	if f != nil {
+		mruo.SetMin(*f)
+	}
+	return mruo
+}
+
+// AddMin adds f to the "min" field.
+func (mruo *MetricsRollupUpdateOne) AddMin(f float64) *MetricsRollupUpdateOne {
+	mruo.mutation.AddMin(f)
+	return mruo
+}
+
+// SetMax sets the "max" field.
+func (mruo *MetricsRollupUpdateOne) SetMax(f float64) *MetricsRollupUpdateOne {
+	mruo.mutation.ResetMax()
+	mruo.mutation.SetMax(f)
+	return mruo
+}
+
+// SetNillableMax sets the "max" field if the given value is not nil.
+func (mruo *MetricsRollupUpdateOne) SetNillableMax(f *float64) *MetricsRollupUpdateOne {
+	if f != nil {
+		mruo.SetMax(*f)
+	}
+	return mruo
+}
+
+// AddMax adds f to the "max" field.
+func (mruo *MetricsRollupUpdateOne) AddMax(f float64) *MetricsRollupUpdateOne {
+	mruo.mutation.AddMax(f)
+	return mruo
+}
+
+// SetHistogram sets the "histogram" field.
+func (mruo *MetricsRollupUpdateOne) SetHistogram(m map[int]int64) *MetricsRollupUpdateOne {
+	mruo.mutation.SetHistogram(m)
+	return mruo
+}
+
+// SetRevision sets the "revision" field.
+func (mruo *MetricsRollupUpdateOne) SetRevision(i int64) *MetricsRollupUpdateOne {
+	mruo.mutation.ResetRevision()
+	mruo.mutation.SetRevision(i)
+	return mruo
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (mruo *MetricsRollupUpdateOne) SetNillableRevision(i *int64) *MetricsRollupUpdateOne {
+	if i != nil {
+		mruo.SetRevision(*i)
+	}
+	return mruo
+}
+
+// AddRevision adds i to the "revision" field.
+func (mruo *MetricsRollupUpdateOne) AddRevision(i int64) *MetricsRollupUpdateOne {
+	mruo.mutation.AddRevision(i)
+	return mruo
+}
+
+// Mutation returns the MetricsRollupMutation object of the builder.
+func (mruo *MetricsRollupUpdateOne) Mutation() *MetricsRollupMutation {
+	return mruo.mutation
+}
+
+// Where appends a list predicates to the MetricsRollupUpdate builder.
+func (mruo *MetricsRollupUpdateOne) Where(ps ...predicate.MetricsRollup) *MetricsRollupUpdateOne {
+	mruo.mutation.Where(ps...)
+	return mruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mruo *MetricsRollupUpdateOne) Select(field string, fields ...string) *MetricsRollupUpdateOne {
+	mruo.fields = append([]string{field}, fields...)
+	return mruo
+}
+
+// Save executes the query and returns the updated MetricsRollup entity.
+func (mruo *MetricsRollupUpdateOne) Save(ctx context.Context) (*MetricsRollup, error) {
+	return withHooks(ctx, mruo.sqlSave, mruo.mutation, mruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mruo *MetricsRollupUpdateOne) SaveX(ctx context.Context) *MetricsRollup {
+	node, err := mruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mruo *MetricsRollupUpdateOne) Exec(ctx context.Context) error {
+	_, err := mruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mruo *MetricsRollupUpdateOne) ExecX(ctx context.Context) {
+	if err := mruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (mruo *MetricsRollupUpdateOne) sqlSave(ctx context.Context) (_node *MetricsRollup, err error) {
+	_spec := sqlgraph.NewUpdateSpec(metricsrollup.Table, metricsrollup.Columns, sqlgraph.NewFieldSpec(metricsrollup.FieldID, field.TypeInt))
+	id, ok := mruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MetricsRollup.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, metricsrollup.FieldID)
+		for _, f := range fields {
+			if !metricsrollup.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != metricsrollup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mruo.mutation.Invocations(); ok {
+		_spec.SetField(metricsrollup.FieldInvocations, field.TypeInt64, value)
+	}
+	if value, ok := mruo.mutation.AddedInvocations(); ok {
+		_spec.AddField(metricsrollup.FieldInvocations, field.TypeInt64, value)
+	}
+	if value, ok := mruo.mutation.Numerator(); ok {
+		_spec.SetField(metricsrollup.FieldNumerator, field.TypeFloat64, value)
+	}
+	if value, ok := mruo.mutation.AddedNumerator(); ok {
+		_spec.AddField(metricsrollup.FieldNumerator, field.TypeFloat64, value)
+	}
+	if value, ok := mruo.mutation.Denominator(); ok {
+		_spec.SetField(metricsrollup.FieldDenominator, field.TypeFloat64, value)
+	}
+	if value, ok := mruo.mutation.AddedDenominator(); ok {
+		_spec.AddField(metricsrollup.FieldDenominator, field.TypeFloat64, value)
+	}
+	if value, ok := mruo.mutation.Min(); ok {
+		_spec.SetField(metricsrollup.FieldMin, field.TypeFloat64, value)
+	}
+	if value, ok := mruo.mutation.AddedMin(); ok {
+		_spec.AddField(metricsrollup.FieldMin, field.TypeFloat64, value)
+	}
+	if value, ok := mruo.mutation.Max(); ok {
+		_spec.SetField(metricsrollup.FieldMax, field.TypeFloat64, value)
+	}
+	if value, ok := mruo.mutation.AddedMax(); ok {
+		_spec.AddField(metricsrollup.FieldMax, field.TypeFloat64, value)
+	}
+	if value, ok := mruo.mutation.Histogram(); ok {
+		_spec.SetField(metricsrollup.FieldHistogram, field.TypeJSON, value)
+	}
+	if value, ok := mruo.mutation.Revision(); ok {
+		_spec.SetField(metricsrollup.FieldRevision, field.TypeInt64, value)
+	}
+	if value, ok := mruo.mutation.AddedRevision(); ok {
+		_spec.AddField(metricsrollup.FieldRevision, field.TypeInt64, value)
+	}
+	_node = &MetricsRollup{config: mruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{metricsrollup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mruo.mutation.done = true
+	return _node, nil
+}
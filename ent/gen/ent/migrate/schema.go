@@ -92,6 +92,10 @@ var (
 		{Name: "platform_name", Type: field.TypeString, Nullable: true},
 		{Name: "configuration_mnemonic", Type: field.TypeString, Nullable: true},
 		{Name: "num_fetches", Type: field.TypeInt64, Nullable: true},
+		{Name: "branch", Type: field.TypeString, Nullable: true},
+		{Name: "commit", Type: field.TypeString, Nullable: true},
+		{Name: "failure_classification", Type: field.TypeEnum, Nullable: true, Enums: []string{"USER_ERROR", "INFRA_ERROR", "FLAKY", "CANCELLED"}},
+		{Name: "pinned", Type: field.TypeBool, Default: false},
 		{Name: "build_invocations", Type: field.TypeInt, Nullable: true},
 		{Name: "event_file_bazel_invocation", Type: field.TypeInt, Unique: true},
 	}
@@ -103,13 +107,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "bazel_invocations_builds_invocations",
-				Columns:    []*schema.Column{BazelInvocationsColumns[17]},
+				Columns:    []*schema.Column{BazelInvocationsColumns[21]},
 				RefColumns: []*schema.Column{BuildsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "bazel_invocations_event_files_bazel_invocation",
-				Columns:    []*schema.Column{BazelInvocationsColumns[18]},
+				Columns:    []*schema.Column{BazelInvocationsColumns[22]},
 				RefColumns: []*schema.Column{EventFilesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -120,6 +124,16 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{BazelInvocationsColumns[4], BazelInvocationsColumns[5]},
 			},
+			{
+				Name:    "bazelinvocation_failure_classification",
+				Unique:  false,
+				Columns: []*schema.Column{BazelInvocationsColumns[19]},
+			},
+			{
+				Name:    "bazelinvocation_branch_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{BazelInvocationsColumns[17], BazelInvocationsColumns[2]},
+			},
 		},
 	}
 	// BazelInvocationProblemsColumns holds the columns for the "bazel_invocation_problems" table.
@@ -128,7 +142,11 @@ var (
 		{Name: "problem_type", Type: field.TypeString},
 		{Name: "label", Type: field.TypeString},
 		{Name: "bep_events", Type: field.TypeJSON},
+		{Name: "fingerprint", Type: field.TypeString, Nullable: true},
+		{Name: "failure_classification", Type: field.TypeEnum, Nullable: true, Enums: []string{"USER_ERROR", "INFRA_ERROR", "FLAKY", "CANCELLED"}},
+		{Name: "newly_failing", Type: field.TypeBool, Nullable: true},
 		{Name: "bazel_invocation_problems", Type: field.TypeInt, Nullable: true},
+		{Name: "known_problem_problems", Type: field.TypeInt, Nullable: true},
 	}
 	// BazelInvocationProblemsTable holds the schema information for the "bazel_invocation_problems" table.
 	BazelInvocationProblemsTable = &schema.Table{
@@ -138,16 +156,30 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "bazel_invocation_problems_bazel_invocations_problems",
-				Columns:    []*schema.Column{BazelInvocationProblemsColumns[4]},
+				Columns:    []*schema.Column{BazelInvocationProblemsColumns[7]},
 				RefColumns: []*schema.Column{BazelInvocationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "bazel_invocation_problems_known_problems_problems",
+				Columns:    []*schema.Column{BazelInvocationProblemsColumns[8]},
+				RefColumns: []*schema.Column{KnownProblemsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bazelinvocationproblem_fingerprint",
+				Unique:  false,
+				Columns: []*schema.Column{BazelInvocationProblemsColumns[4]},
+			},
 		},
 	}
 	// BlobsColumns holds the columns for the "blobs" table.
 	BlobsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "uri", Type: field.TypeString, Unique: true},
+		{Name: "key", Type: field.TypeString, Nullable: true},
 		{Name: "size_bytes", Type: field.TypeInt64, Nullable: true},
 		{Name: "archiving_status", Type: field.TypeEnum, Enums: []string{"QUEUED", "ARCHIVING", "SUCCESS", "FAILED"}, Default: "QUEUED"},
 		{Name: "reason", Type: field.TypeString, Nullable: true},
@@ -158,6 +190,13 @@ var (
 		Name:       "blobs",
 		Columns:    BlobsColumns,
 		PrimaryKey: []*schema.Column{BlobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "blob_key",
+				Unique:  false,
+				Columns: []*schema.Column{BlobsColumns[2]},
+			},
+		},
 	}
 	// BuildsColumns holds the columns for the "builds" table.
 	BuildsColumns = []*schema.Column{
@@ -356,6 +395,30 @@ var (
 		Columns:    GarbageMetricsColumns,
 		PrimaryKey: []*schema.Column{GarbageMetricsColumns[0]},
 	}
+	// KnownProblemsColumns holds the columns for the "known_problems" table.
+	KnownProblemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "fingerprint", Type: field.TypeString, Unique: true},
+		{Name: "problem_type", Type: field.TypeString},
+		{Name: "label", Type: field.TypeString},
+		{Name: "first_seen", Type: field.TypeTime},
+		{Name: "last_seen", Type: field.TypeTime},
+		{Name: "occurrences", Type: field.TypeInt, Default: 0},
+		{Name: "branches", Type: field.TypeJSON, Nullable: true},
+	}
+	// KnownProblemsTable holds the schema information for the "known_problems" table.
+	KnownProblemsTable = &schema.Table{
+		Name:       "known_problems",
+		Columns:    KnownProblemsColumns,
+		PrimaryKey: []*schema.Column{KnownProblemsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "knownproblem_last_seen",
+				Unique:  false,
+				Columns: []*schema.Column{KnownProblemsColumns[5]},
+			},
+		},
+	}
 	// MemoryMetricsColumns holds the columns for the "memory_metrics" table.
 	MemoryMetricsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -388,6 +451,38 @@ var (
 			},
 		},
 	}
+	// MetricsRollupsColumns holds the columns for the "metrics_rollups" table.
+	MetricsRollupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "granularity", Type: field.TypeEnum, Enums: []string{"HOUR", "DAY"}},
+		{Name: "bucket_start", Type: field.TypeTime},
+		{Name: "metric", Type: field.TypeString},
+		{Name: "branch", Type: field.TypeString, Default: ""},
+		{Name: "command", Type: field.TypeString, Default: ""},
+		{Name: "step_label", Type: field.TypeString, Default: ""},
+		{Name: "user_ldap", Type: field.TypeString, Default: ""},
+		{Name: "user_email", Type: field.TypeString, Default: ""},
+		{Name: "invocations", Type: field.TypeInt64, Default: 0},
+		{Name: "numerator", Type: field.TypeFloat64, Default: 0},
+		{Name: "denominator", Type: field.TypeFloat64, Default: 0},
+		{Name: "min", Type: field.TypeFloat64},
+		{Name: "max", Type: field.TypeFloat64},
+		{Name: "histogram", Type: field.TypeJSON},
+		{Name: "revision", Type: field.TypeInt64, Default: 0},
+	}
+	// MetricsRollupsTable holds the schema information for the "metrics_rollups" table.
+	MetricsRollupsTable = &schema.Table{
+		Name:       "metrics_rollups",
+		Columns:    MetricsRollupsColumns,
+		PrimaryKey: []*schema.Column{MetricsRollupsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "metricsrollup_granularity_metric_bucket_start_branch_command_step_label_user_ldap_user_email",
+				Unique:  true,
+				Columns: []*schema.Column{MetricsRollupsColumns[1], MetricsRollupsColumns[3], MetricsRollupsColumns[2], MetricsRollupsColumns[4], MetricsRollupsColumns[5], MetricsRollupsColumns[6], MetricsRollupsColumns[7], MetricsRollupsColumns[8]},
+			},
+		},
+	}
 	// MissDetailsColumns holds the columns for the "miss_details" table.
 	MissDetailsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -517,6 +612,42 @@ var (
 		Columns:    RunnerCountsColumns,
 		PrimaryKey: []*schema.Column{RunnerCountsColumns[0]},
 	}
+	// SearchChunksColumns holds the columns for the "search_chunks" table.
+	SearchChunksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"BUILD_LOGS", "PROBLEM_OUTPUT", "BLOB"}},
+		{Name: "first_line", Type: field.TypeInt},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "bazel_invocation_search_chunks", Type: field.TypeInt, Nullable: true},
+		{Name: "bazel_invocation_problem_search_chunks", Type: field.TypeInt, Nullable: true},
+		{Name: "blob_search_chunks", Type: field.TypeInt, Nullable: true},
+	}
+	// SearchChunksTable holds the schema information for the "search_chunks" table.
+	SearchChunksTable = &schema.Table{
+		Name:       "search_chunks",
+		Columns:    SearchChunksColumns,
+		PrimaryKey: []*schema.Column{SearchChunksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "search_chunks_bazel_invocations_search_chunks",
+				Columns:    []*schema.Column{SearchChunksColumns[4]},
+				RefColumns: []*schema.Column{BazelInvocationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "search_chunks_bazel_invocation_problems_search_chunks",
+				Columns:    []*schema.Column{SearchChunksColumns[5]},
+				RefColumns: []*schema.Column{BazelInvocationProblemsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "search_chunks_blobs_search_chunks",
+				Columns:    []*schema.Column{SearchChunksColumns[6]},
+				RefColumns: []*schema.Column{BlobsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// SystemNetworkStatsColumns holds the columns for the "system_network_stats" table.
 	SystemNetworkStatsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -713,6 +844,41 @@ var (
 			},
 		},
 	}
+	// TestHealthReportsColumns holds the columns for the "test_health_reports" table.
+	TestHealthReportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "label", Type: field.TypeString},
+		{Name: "issue", Type: field.TypeEnum, Enums: []string{"IMBALANCED_SHARDS", "NEAR_TIMEOUT", "OVERSIZED"}},
+		{Name: "test_size", Type: field.TypeEnum, Enums: []string{"UNKNOWN", "SMALL", "MEDIUM", "LARGE", "ENORMOUS"}, Default: "UNKNOWN"},
+		{Name: "shard_count", Type: field.TypeInt32},
+		{Name: "max_duration_in_ms", Type: field.TypeInt64},
+		{Name: "median_shard_duration_in_ms", Type: field.TypeInt64},
+		{Name: "timeout_in_ms", Type: field.TypeInt64},
+		{Name: "suggested_shard_count", Type: field.TypeInt32},
+		{Name: "suggested_size", Type: field.TypeEnum, Enums: []string{"UNKNOWN", "SMALL", "MEDIUM", "LARGE", "ENORMOUS"}, Default: "UNKNOWN"},
+		{Name: "bazel_invocation_test_health_reports", Type: field.TypeInt, Nullable: true},
+	}
+	// TestHealthReportsTable holds the schema information for the "test_health_reports" table.
+	TestHealthReportsTable = &schema.Table{
+		Name:       "test_health_reports",
+		Columns:    TestHealthReportsColumns,
+		PrimaryKey: []*schema.Column{TestHealthReportsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "test_health_reports_bazel_invocations_test_health_reports",
+				Columns:    []*schema.Column{TestHealthReportsColumns[10]},
+				RefColumns: []*schema.Column{BazelInvocationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "testhealthreport_label",
+				Unique:  false,
+				Columns: []*schema.Column{TestHealthReportsColumns[1]},
+			},
+		},
+	}
 	// TestResultBeSsColumns holds the columns for the "test_result_be_ss" table.
 	TestResultBeSsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -982,6 +1148,31 @@ var (
 			},
 		},
 	}
+	// BazelInvocationProblemBlobsColumns holds the columns for the "bazel_invocation_problem_blobs" table.
+	BazelInvocationProblemBlobsColumns = []*schema.Column{
+		{Name: "bazel_invocation_problem_id", Type: field.TypeInt},
+		{Name: "blob_id", Type: field.TypeInt},
+	}
+	// BazelInvocationProblemBlobsTable holds the schema information for the "bazel_invocation_problem_blobs" table.
+	BazelInvocationProblemBlobsTable = &schema.Table{
+		Name:       "bazel_invocation_problem_blobs",
+		Columns:    BazelInvocationProblemBlobsColumns,
+		PrimaryKey: []*schema.Column{BazelInvocationProblemBlobsColumns[0], BazelInvocationProblemBlobsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "bazel_invocation_problem_blobs_bazel_invocation_problem_id",
+				Columns:    []*schema.Column{BazelInvocationProblemBlobsColumns[0]},
+				RefColumns: []*schema.Column{BazelInvocationProblemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "bazel_invocation_problem_blobs_blob_id",
+				Columns:    []*schema.Column{BazelInvocationProblemBlobsColumns[1]},
+				RefColumns: []*schema.Column{BlobsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// BuildGraphMetricsEvaluatedValuesColumns holds the columns for the "build_graph_metrics_evaluated_values" table.
 	BuildGraphMetricsEvaluatedValuesColumns = []*schema.Column{
 		{Name: "build_graph_metrics_id", Type: field.TypeInt},
@@ -1400,8 +1591,10 @@ var (
 		ExectionInfosTable,
 		FilesMetricsTable,
 		GarbageMetricsTable,
+		KnownProblemsTable,
 		MemoryMetricsTable,
 		MetricsTable,
+		MetricsRollupsTable,
 		MissDetailsTable,
 		NamedSetOfFilesTable,
 		NetworkMetricsTable,
@@ -1411,6 +1604,7 @@ var (
 		RaceStatisticsTable,
 		ResourceUsagesTable,
 		RunnerCountsTable,
+		SearchChunksTable,
 		SystemNetworkStatsTable,
 		TargetCompletesTable,
 		TargetConfiguredsTable,
@@ -1418,6 +1612,7 @@ var (
 		TargetPairsTable,
 		TestCollectionsTable,
 		TestFilesTable,
+		TestHealthReportsTable,
 		TestResultBeSsTable,
 		TestSummariesTable,
 		TimingBreakdownsTable,
@@ -1430,6 +1625,7 @@ var (
 		ArtifactMetricsTopLevelArtifactsTable,
 		BazelInvocationTestCollectionTable,
 		BazelInvocationTargetsTable,
+		BazelInvocationProblemBlobsTable,
 		BuildGraphMetricsEvaluatedValuesTable,
 		DynamicExecutionMetricsRaceStatisticsTable,
 		ExectionInfoResourceUsageTable,
@@ -1454,6 +1650,7 @@ func init() {
 	BazelInvocationsTable.ForeignKeys[0].RefTable = BuildsTable
 	BazelInvocationsTable.ForeignKeys[1].RefTable = EventFilesTable
 	BazelInvocationProblemsTable.ForeignKeys[0].RefTable = BazelInvocationsTable
+	BazelInvocationProblemsTable.ForeignKeys[1].RefTable = KnownProblemsTable
 	EvaluationStatsTable.ForeignKeys[0].RefTable = BuildGraphMetricsTable
 	EvaluationStatsTable.ForeignKeys[1].RefTable = BuildGraphMetricsTable
 	EvaluationStatsTable.ForeignKeys[2].RefTable = BuildGraphMetricsTable
@@ -1465,6 +1662,9 @@ func init() {
 	MetricsTable.ForeignKeys[0].RefTable = BazelInvocationsTable
 	NamedSetOfFilesTable.ForeignKeys[0].RefTable = NamedSetOfFilesTable
 	OutputGroupsTable.ForeignKeys[0].RefTable = NamedSetOfFilesTable
+	SearchChunksTable.ForeignKeys[0].RefTable = BazelInvocationsTable
+	SearchChunksTable.ForeignKeys[1].RefTable = BazelInvocationProblemsTable
+	SearchChunksTable.ForeignKeys[2].RefTable = BlobsTable
 	SystemNetworkStatsTable.ForeignKeys[0].RefTable = NetworkMetricsTable
 	TargetCompletesTable.ForeignKeys[0].RefTable = OutputGroupsTable
 	TargetPairsTable.ForeignKeys[0].RefTable = TargetConfiguredsTable
@@ -1476,6 +1676,7 @@ func init() {
 	TestFilesTable.ForeignKeys[3].RefTable = TargetCompletesTable
 	TestFilesTable.ForeignKeys[4].RefTable = TestSummariesTable
 	TestFilesTable.ForeignKeys[5].RefTable = TestSummariesTable
+	TestHealthReportsTable.ForeignKeys[0].RefTable = BazelInvocationsTable
 	TestResultBeSsTable.ForeignKeys[0].RefTable = TestCollectionsTable
 	TestResultBeSsTable.ForeignKeys[1].RefTable = ExectionInfosTable
 	ActionCacheStatisticsMissDetailsTable.ForeignKeys[0].RefTable = ActionCacheStatisticsTable
@@ -1492,6 +1693,8 @@ func init() {
 	BazelInvocationTestCollectionTable.ForeignKeys[1].RefTable = TestCollectionsTable
 	BazelInvocationTargetsTable.ForeignKeys[0].RefTable = BazelInvocationsTable
 	BazelInvocationTargetsTable.ForeignKeys[1].RefTable = TargetPairsTable
+	BazelInvocationProblemBlobsTable.ForeignKeys[0].RefTable = BazelInvocationProblemsTable
+	BazelInvocationProblemBlobsTable.ForeignKeys[1].RefTable = BlobsTable
 	BuildGraphMetricsEvaluatedValuesTable.ForeignKeys[0].RefTable = BuildGraphMetricsTable
 	BuildGraphMetricsEvaluatedValuesTable.ForeignKeys[1].RefTable = EvaluationStatsTable
 	DynamicExecutionMetricsRaceStatisticsTable.ForeignKeys[0].RefTable = DynamicExecutionMetricsTable
@@ -4,8 +4,10 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/namedsetoffiles"
@@ -18,6 +20,7 @@ type NamedSetOfFilesCreate struct {
 	config
 	mutation *NamedSetOfFilesMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // AddOutputGroupIDs adds the "output_group" edge to the OutputGroup entity by IDs.
@@ -129,6 +132,7 @@ func (nsofc *NamedSetOfFilesCreate) createSpec() (*NamedSetOfFiles, *sqlgraph.Cr
 		_node = &NamedSetOfFiles{config: nsofc.config}
 		_spec = sqlgraph.NewCreateSpec(namedsetoffiles.Table, sqlgraph.NewFieldSpec(namedsetoffiles.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = nsofc.conflict
 	if nodes := nsofc.mutation.OutputGroupIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -181,11 +185,128 @@ func (nsofc *NamedSetOfFilesCreate) createSpec() (*NamedSetOfFiles, *sqlgraph.Cr
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NamedSetOfFiles.Create().
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (nsofc *NamedSetOfFilesCreate) OnConflict(opts ...sql.ConflictOption) *NamedSetOfFilesUpsertOne {
+	nsofc.conflict = opts
+	return &NamedSetOfFilesUpsertOne{
+		create: nsofc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NamedSetOfFiles.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (nsofc *NamedSetOfFilesCreate) OnConflictColumns(columns ...string) *NamedSetOfFilesUpsertOne {
+	nsofc.conflict = append(nsofc.conflict, sql.ConflictColumns(columns...))
+	return &NamedSetOfFilesUpsertOne{
+		create: nsofc,
+	}
+}
+
+type (
+	// NamedSetOfFilesUpsertOne is the builder for "upsert"-ing
+	//  one NamedSetOfFiles node.
+	NamedSetOfFilesUpsertOne struct {
+		create *NamedSetOfFilesCreate
+	}
+
+	// NamedSetOfFilesUpsert is the "OnConflict" setter.
+	NamedSetOfFilesUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.NamedSetOfFiles.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *NamedSetOfFilesUpsertOne) UpdateNewValues() *NamedSetOfFilesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NamedSetOfFiles.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NamedSetOfFilesUpsertOne) Ignore() *NamedSetOfFilesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NamedSetOfFilesUpsertOne) DoNothing() *NamedSetOfFilesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NamedSetOfFilesCreate.OnConflict
+// documentation for more info.
+func (u *NamedSetOfFilesUpsertOne) Update(set func(*NamedSetOfFilesUpsert)) *NamedSetOfFilesUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NamedSetOfFilesUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *NamedSetOfFilesUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NamedSetOfFilesCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NamedSetOfFilesUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NamedSetOfFilesUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NamedSetOfFilesUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // NamedSetOfFilesCreateBulk is the builder for creating many NamedSetOfFiles entities in bulk.
 type NamedSetOfFilesCreateBulk struct {
 	config
 	err      error
 	builders []*NamedSetOfFilesCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the NamedSetOfFiles entities in the database.
@@ -214,6 +335,7 @@ func (nsofcb *NamedSetOfFilesCreateBulk) Save(ctx context.Context) ([]*NamedSetO
 					_, err = mutators[i+1].Mutate(root, nsofcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = nsofcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, nsofcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -267,3 +389,102 @@ func (nsofcb *NamedSetOfFilesCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NamedSetOfFiles.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (nsofcb *NamedSetOfFilesCreateBulk) OnConflict(opts ...sql.ConflictOption) *NamedSetOfFilesUpsertBulk {
+	nsofcb.conflict = opts
+	return &NamedSetOfFilesUpsertBulk{
+		create: nsofcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NamedSetOfFiles.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (nsofcb *NamedSetOfFilesCreateBulk) OnConflictColumns(columns ...string) *NamedSetOfFilesUpsertBulk {
+	nsofcb.conflict = append(nsofcb.conflict, sql.ConflictColumns(columns...))
+	return &NamedSetOfFilesUpsertBulk{
+		create: nsofcb,
+	}
+}
+
+// NamedSetOfFilesUpsertBulk is the builder for "upsert"-ing
+// a bulk of NamedSetOfFiles nodes.
+type NamedSetOfFilesUpsertBulk struct {
+	create *NamedSetOfFilesCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.NamedSetOfFiles.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *NamedSetOfFilesUpsertBulk) UpdateNewValues() *NamedSetOfFilesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NamedSetOfFiles.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NamedSetOfFilesUpsertBulk) Ignore() *NamedSetOfFilesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NamedSetOfFilesUpsertBulk) DoNothing() *NamedSetOfFilesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NamedSetOfFilesCreateBulk.OnConflict
+// documentation for more info.
+func (u *NamedSetOfFilesUpsertBulk) Update(set func(*NamedSetOfFilesUpsert)) *NamedSetOfFilesUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NamedSetOfFilesUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *NamedSetOfFilesUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NamedSetOfFilesCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NamedSetOfFilesCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NamedSetOfFilesUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
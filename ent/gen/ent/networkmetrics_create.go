@@ -4,8 +4,10 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/metrics"
@@ -18,6 +20,7 @@ type NetworkMetricsCreate struct {
 	config
 	mutation *NetworkMetricsMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // AddMetricIDs adds the "metrics" edge to the Metrics entity by IDs.
@@ -110,6 +113,7 @@ func (nmc *NetworkMetricsCreate) createSpec() (*NetworkMetrics, *sqlgraph.Create
 		_node = &NetworkMetrics{config: nmc.config}
 		_spec = sqlgraph.NewCreateSpec(networkmetrics.Table, sqlgraph.NewFieldSpec(networkmetrics.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = nmc.conflict
 	if nodes := nmc.mutation.MetricsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -145,11 +149,128 @@ func (nmc *NetworkMetricsCreate) createSpec() (*NetworkMetrics, *sqlgraph.Create
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NetworkMetrics.Create().
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (nmc *NetworkMetricsCreate) OnConflict(opts ...sql.ConflictOption) *NetworkMetricsUpsertOne {
+	nmc.conflict = opts
+	return &NetworkMetricsUpsertOne{
+		create: nmc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NetworkMetrics.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (nmc *NetworkMetricsCreate) OnConflictColumns(columns ...string) *NetworkMetricsUpsertOne {
+	nmc.conflict = append(nmc.conflict, sql.ConflictColumns(columns...))
+	return &NetworkMetricsUpsertOne{
+		create: nmc,
+	}
+}
+
+type (
+	// NetworkMetricsUpsertOne is the builder for "upsert"-ing
+	//  one NetworkMetrics node.
+	NetworkMetricsUpsertOne struct {
+		create *NetworkMetricsCreate
+	}
+
+	// NetworkMetricsUpsert is the "OnConflict" setter.
+	NetworkMetricsUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.NetworkMetrics.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *NetworkMetricsUpsertOne) UpdateNewValues() *NetworkMetricsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NetworkMetrics.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NetworkMetricsUpsertOne) Ignore() *NetworkMetricsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NetworkMetricsUpsertOne) DoNothing() *NetworkMetricsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NetworkMetricsCreate.OnConflict
+// documentation for more info.
+func (u *NetworkMetricsUpsertOne) Update(set func(*NetworkMetricsUpsert)) *NetworkMetricsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NetworkMetricsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *NetworkMetricsUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NetworkMetricsCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NetworkMetricsUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NetworkMetricsUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NetworkMetricsUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // NetworkMetricsCreateBulk is the builder for creating many NetworkMetrics entities in bulk.
 type NetworkMetricsCreateBulk struct {
 	config
 	err      error
 	builders []*NetworkMetricsCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the NetworkMetrics entities in the database.
@@ -178,6 +299,7 @@ func (nmcb *NetworkMetricsCreateBulk) Save(ctx context.Context) ([]*NetworkMetri
 					_, err = mutators[i+1].Mutate(root, nmcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = nmcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, nmcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -231,3 +353,102 @@ func (nmcb *NetworkMetricsCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NetworkMetrics.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (nmcb *NetworkMetricsCreateBulk) OnConflict(opts ...sql.ConflictOption) *NetworkMetricsUpsertBulk {
+	nmcb.conflict = opts
+	return &NetworkMetricsUpsertBulk{
+		create: nmcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NetworkMetrics.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (nmcb *NetworkMetricsCreateBulk) OnConflictColumns(columns ...string) *NetworkMetricsUpsertBulk {
+	nmcb.conflict = append(nmcb.conflict, sql.ConflictColumns(columns...))
+	return &NetworkMetricsUpsertBulk{
+		create: nmcb,
+	}
+}
+
+// NetworkMetricsUpsertBulk is the builder for "upsert"-ing
+// a bulk of NetworkMetrics nodes.
+type NetworkMetricsUpsertBulk struct {
+	create *NetworkMetricsCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.NetworkMetrics.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *NetworkMetricsUpsertBulk) UpdateNewValues() *NetworkMetricsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NetworkMetrics.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NetworkMetricsUpsertBulk) Ignore() *NetworkMetricsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NetworkMetricsUpsertBulk) DoNothing() *NetworkMetricsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NetworkMetricsCreateBulk.OnConflict
+// documentation for more info.
+func (u *NetworkMetricsUpsertBulk) Update(set func(*NetworkMetricsUpsert)) *NetworkMetricsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NetworkMetricsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *NetworkMetricsUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NetworkMetricsCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NetworkMetricsCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NetworkMetricsUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
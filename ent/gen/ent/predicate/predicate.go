@@ -54,12 +54,18 @@ type FilesMetric func(*sql.Selector)
 // GarbageMetrics is the predicate function for garbagemetrics builders.
 type GarbageMetrics func(*sql.Selector)
 
+// KnownProblem is the predicate function for knownproblem builders.
+type KnownProblem func(*sql.Selector)
+
 // MemoryMetrics is the predicate function for memorymetrics builders.
 type MemoryMetrics func(*sql.Selector)
 
 // Metrics is the predicate function for metrics builders.
 type Metrics func(*sql.Selector)
 
+// MetricsRollup is the predicate function for metricsrollup builders.
+type MetricsRollup func(*sql.Selector)
+
 // MissDetail is the predicate function for missdetail builders.
 type MissDetail func(*sql.Selector)
 
@@ -87,6 +93,9 @@ type ResourceUsage func(*sql.Selector)
 // RunnerCount is the predicate function for runnercount builders.
 type RunnerCount func(*sql.Selector)
 
+// SearchChunk is the predicate function for searchchunk builders.
+type SearchChunk func(*sql.Selector)
+
 // SystemNetworkStats is the predicate function for systemnetworkstats builders.
 type SystemNetworkStats func(*sql.Selector)
 
@@ -108,6 +117,9 @@ type TestCollection func(*sql.Selector)
 // TestFile is the predicate function for testfile builders.
 type TestFile func(*sql.Selector)
 
+// TestHealthReport is the predicate function for testhealthreport builders.
+type TestHealthReport func(*sql.Selector)
+
 // TestResultBES is the predicate function for testresultbes builders.
 type TestResultBES func(*sql.Selector)
 
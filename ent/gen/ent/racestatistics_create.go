@@ -4,8 +4,10 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/dynamicexecutionmetrics"
@@ -17,6 +19,7 @@ type RaceStatisticsCreate struct {
 	config
 	mutation *RaceStatisticsMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetMnemonic sets the "mnemonic" field.
@@ -164,6 +167,7 @@ func (rsc *RaceStatisticsCreate) createSpec() (*RaceStatistics, *sqlgraph.Create
 		_node = &RaceStatistics{config: rsc.config}
 		_spec = sqlgraph.NewCreateSpec(racestatistics.Table, sqlgraph.NewFieldSpec(racestatistics.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = rsc.conflict
 	if value, ok := rsc.mutation.Mnemonic(); ok {
 		_spec.SetField(racestatistics.FieldMnemonic, field.TypeString, value)
 		_node.Mnemonic = value
@@ -203,11 +207,355 @@ func (rsc *RaceStatisticsCreate) createSpec() (*RaceStatistics, *sqlgraph.Create
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RaceStatistics.Create().
+//		SetMnemonic(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RaceStatisticsUpsert) {
+//			SetMnemonic(v+v).
+//		}).
+//		Exec(ctx)
+func (rsc *RaceStatisticsCreate) OnConflict(opts ...sql.ConflictOption) *RaceStatisticsUpsertOne {
+	rsc.conflict = opts
+	return &RaceStatisticsUpsertOne{
+		create: rsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RaceStatistics.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rsc *RaceStatisticsCreate) OnConflictColumns(columns ...string) *RaceStatisticsUpsertOne {
+	rsc.conflict = append(rsc.conflict, sql.ConflictColumns(columns...))
+	return &RaceStatisticsUpsertOne{
+		create: rsc,
+	}
+}
+
+type (
+	// RaceStatisticsUpsertOne is the builder for "upsert"-ing
+	//  one RaceStatistics node.
+	RaceStatisticsUpsertOne struct {
+		create *RaceStatisticsCreate
+	}
+
+	// RaceStatisticsUpsert is the "OnConflict" setter.
+	RaceStatisticsUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetMnemonic sets the "mnemonic" field.
+func (u *RaceStatisticsUpsert) SetMnemonic(v string) *RaceStatisticsUpsert {
+	u.Set(racestatistics.FieldMnemonic, v)
+	return u
+}
+
+// UpdateMnemonic sets the "mnemonic" field to the value that was provided on create.
+func (u *RaceStatisticsUpsert) UpdateMnemonic() *RaceStatisticsUpsert {
+	u.SetExcluded(racestatistics.FieldMnemonic)
+	return u
+}
+
+// ClearMnemonic clears the value of the "mnemonic" field.
+func (u *RaceStatisticsUpsert) ClearMnemonic() *RaceStatisticsUpsert {
+	u.SetNull(racestatistics.FieldMnemonic)
+	return u
+}
+
+// SetLocalRunner sets the "local_runner" field.
+func (u *RaceStatisticsUpsert) SetLocalRunner(v string) *RaceStatisticsUpsert {
+	u.Set(racestatistics.FieldLocalRunner, v)
+	return u
+}
+
+// UpdateLocalRunner sets the "local_runner" field to the value that was provided on create.
+func (u *RaceStatisticsUpsert) UpdateLocalRunner() *RaceStatisticsUpsert {
+	u.SetExcluded(racestatistics.FieldLocalRunner)
+	return u
+}
+
+// ClearLocalRunner clears the value of the "local_runner" field.
+func (u *RaceStatisticsUpsert) ClearLocalRunner() *RaceStatisticsUpsert {
+	u.SetNull(racestatistics.FieldLocalRunner)
+	return u
+}
+
+// SetRemoteRunner sets the "remote_runner" field.
+func (u *RaceStatisticsUpsert) SetRemoteRunner(v string) *RaceStatisticsUpsert {
+	u.Set(racestatistics.FieldRemoteRunner, v)
+	return u
+}
+
+// UpdateRemoteRunner sets the "remote_runner" field to the value that was provided on create.
+func (u *RaceStatisticsUpsert) UpdateRemoteRunner() *RaceStatisticsUpsert {
+	u.SetExcluded(racestatistics.FieldRemoteRunner)
+	return u
+}
+
+// ClearRemoteRunner clears the value of the "remote_runner" field.
+func (u *RaceStatisticsUpsert) ClearRemoteRunner() *RaceStatisticsUpsert {
+	u.SetNull(racestatistics.FieldRemoteRunner)
+	return u
+}
+
+// SetLocalWins sets the "local_wins" field.
+func (u *RaceStatisticsUpsert) SetLocalWins(v int64) *RaceStatisticsUpsert {
+	u.Set(racestatistics.FieldLocalWins, v)
+	return u
+}
+
+// UpdateLocalWins sets the "local_wins" field to the value that was provided on create.
+func (u *RaceStatisticsUpsert) UpdateLocalWins() *RaceStatisticsUpsert {
+	u.SetExcluded(racestatistics.FieldLocalWins)
+	return u
+}
+
+// AddLocalWins adds v to the "local_wins" field.
+func (u *RaceStatisticsUpsert) AddLocalWins(v int64) *RaceStatisticsUpsert {
+	u.Add(racestatistics.FieldLocalWins, v)
+	return u
+}
+
+// ClearLocalWins clears the value of the "local_wins" field.
+func (u *RaceStatisticsUpsert) ClearLocalWins() *RaceStatisticsUpsert {
+	u.SetNull(racestatistics.FieldLocalWins)
+	return u
+}
+
+// SetRenoteWins sets the "renote_wins" field.
+func (u *RaceStatisticsUpsert) SetRenoteWins(v int64) *RaceStatisticsUpsert {
+	u.Set(racestatistics.FieldRenoteWins, v)
+	return u
+}
+
+// UpdateRenoteWins sets the "renote_wins" field to the value that was provided on create.
+func (u *RaceStatisticsUpsert) UpdateRenoteWins() *RaceStatisticsUpsert {
+	u.SetExcluded(racestatistics.FieldRenoteWins)
+	return u
+}
+
+// AddRenoteWins adds v to the "renote_wins" field.
+func (u *RaceStatisticsUpsert) AddRenoteWins(v int64) *RaceStatisticsUpsert {
+	u.Add(racestatistics.FieldRenoteWins, v)
+	return u
+}
+
+// ClearRenoteWins clears the value of the "renote_wins" field.
+func (u *RaceStatisticsUpsert) ClearRenoteWins() *RaceStatisticsUpsert {
+	u.SetNull(racestatistics.FieldRenoteWins)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.RaceStatistics.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RaceStatisticsUpsertOne) UpdateNewValues() *RaceStatisticsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RaceStatistics.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RaceStatisticsUpsertOne) Ignore() *RaceStatisticsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RaceStatisticsUpsertOne) DoNothing() *RaceStatisticsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RaceStatisticsCreate.OnConflict
+// documentation for more info.
+func (u *RaceStatisticsUpsertOne) Update(set func(*RaceStatisticsUpsert)) *RaceStatisticsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RaceStatisticsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetMnemonic sets the "mnemonic" field.
+func (u *RaceStatisticsUpsertOne) SetMnemonic(v string) *RaceStatisticsUpsertOne {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.SetMnemonic(v)
+	})
+}
+
+// UpdateMnemonic sets the "mnemonic" field to the value that was provided on create.
+func (u *RaceStatisticsUpsertOne) UpdateMnemonic() *RaceStatisticsUpsertOne {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.UpdateMnemonic()
+	})
+}
+
+// ClearMnemonic clears the value of the "mnemonic" field.
+func (u *RaceStatisticsUpsertOne) ClearMnemonic() *RaceStatisticsUpsertOne {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.ClearMnemonic()
+	})
+}
+
+// SetLocalRunner sets the "local_runner" field.
+func (u *RaceStatisticsUpsertOne) SetLocalRunner(v string) *RaceStatisticsUpsertOne {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.SetLocalRunner(v)
+	})
+}
+
+// UpdateLocalRunner sets the "local_runner" field to the value that was provided on create.
+func (u *RaceStatisticsUpsertOne) UpdateLocalRunner() *RaceStatisticsUpsertOne {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.UpdateLocalRunner()
+	})
+}
+
+// ClearLocalRunner clears the value of the "local_runner" field.
+func (u *RaceStatisticsUpsertOne) ClearLocalRunner() *RaceStatisticsUpsertOne {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.ClearLocalRunner()
+	})
+}
+
+// SetRemoteRunner sets the "remote_runner" field.
+func (u *RaceStatisticsUpsertOne) SetRemoteRunner(v string) *RaceStatisticsUpsertOne {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.SetRemoteRunner(v)
+	})
+}
+
+// UpdateRemoteRunner sets the "remote_runner" field to the value that was provided on create.
+func (u *RaceStatisticsUpsertOne) UpdateRemoteRunner() *RaceStatisticsUpsertOne {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.UpdateRemoteRunner()
+	})
+}
+
+// ClearRemoteRunner clears the value of the "remote_runner" field.
+func (u *RaceStatisticsUpsertOne) ClearRemoteRunner() *RaceStatisticsUpsertOne {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.ClearRemoteRunner()
+	})
+}
+
+// SetLocalWins sets the "local_wins" field.
+func (u *RaceStatisticsUpsertOne) SetLocalWins(v int64) *RaceStatisticsUpsertOne {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.SetLocalWins(v)
+	})
+}
+
+// AddLocalWins adds v to the "local_wins" field.
+func (u *RaceStatisticsUpsertOne) AddLocalWins(v int64) *RaceStatisticsUpsertOne {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.AddLocalWins(v)
+	})
+}
+
+// UpdateLocalWins sets the "local_wins" field to the value that was provided on create.
+func (u *RaceStatisticsUpsertOne) UpdateLocalWins() *RaceStatisticsUpsertOne {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.UpdateLocalWins()
+	})
+}
+
+// ClearLocalWins clears the value of the "local_wins" field.
+func (u *RaceStatisticsUpsertOne) ClearLocalWins() *RaceStatisticsUpsertOne {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.ClearLocalWins()
+	})
+}
+
+// SetRenoteWins sets the "renote_wins" field.
+func (u *RaceStatisticsUpsertOne) SetRenoteWins(v int64) *RaceStatisticsUpsertOne {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.SetRenoteWins(v)
+	})
+}
+
+// AddRenoteWins adds v to the "renote_wins" field.
+func (u *RaceStatisticsUpsertOne) AddRenoteWins(v int64) *RaceStatisticsUpsertOne {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.AddRenoteWins(v)
+	})
+}
+
+// UpdateRenoteWins sets the "renote_wins" field to the value that was provided on create.
+func (u *RaceStatisticsUpsertOne) UpdateRenoteWins() *RaceStatisticsUpsertOne {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.UpdateRenoteWins()
+	})
+}
+
+// ClearRenoteWins clears the value of the "renote_wins" field.
+func (u *RaceStatisticsUpsertOne) ClearRenoteWins() *RaceStatisticsUpsertOne {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.ClearRenoteWins()
+	})
+}
+
+// Exec executes the query.
+func (u *RaceStatisticsUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RaceStatisticsCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RaceStatisticsUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RaceStatisticsUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RaceStatisticsUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // RaceStatisticsCreateBulk is the builder for creating many RaceStatistics entities in bulk.
 type RaceStatisticsCreateBulk struct {
 	config
 	err      error
 	builders []*RaceStatisticsCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the RaceStatistics entities in the database.
@@ -236,6 +584,7 @@ func (rscb *RaceStatisticsCreateBulk) Save(ctx context.Context) ([]*RaceStatisti
 					_, err = mutators[i+1].Mutate(root, rscb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rscb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, rscb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -289,3 +638,226 @@ func (rscb *RaceStatisticsCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RaceStatistics.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RaceStatisticsUpsert) {
+//			SetMnemonic(v+v).
+//		}).
+//		Exec(ctx)
+func (rscb *RaceStatisticsCreateBulk) OnConflict(opts ...sql.ConflictOption) *RaceStatisticsUpsertBulk {
+	rscb.conflict = opts
+	return &RaceStatisticsUpsertBulk{
+		create: rscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RaceStatistics.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rscb *RaceStatisticsCreateBulk) OnConflictColumns(columns ...string) *RaceStatisticsUpsertBulk {
+	rscb.conflict = append(rscb.conflict, sql.ConflictColumns(columns...))
+	return &RaceStatisticsUpsertBulk{
+		create: rscb,
+	}
+}
+
+// RaceStatisticsUpsertBulk is the builder for "upsert"-ing
+// a bulk of RaceStatistics nodes.
+type RaceStatisticsUpsertBulk struct {
+	create *RaceStatisticsCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RaceStatistics.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RaceStatisticsUpsertBulk) UpdateNewValues() *RaceStatisticsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RaceStatistics.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RaceStatisticsUpsertBulk) Ignore() *RaceStatisticsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RaceStatisticsUpsertBulk) DoNothing() *RaceStatisticsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RaceStatisticsCreateBulk.OnConflict
+// documentation for more info.
+func (u *RaceStatisticsUpsertBulk) Update(set func(*RaceStatisticsUpsert)) *RaceStatisticsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RaceStatisticsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetMnemonic sets the "mnemonic" field.
+func (u *RaceStatisticsUpsertBulk) SetMnemonic(v string) *RaceStatisticsUpsertBulk {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.SetMnemonic(v)
+	})
+}
+
+// UpdateMnemonic sets the "mnemonic" field to the value that was provided on create.
+func (u *RaceStatisticsUpsertBulk) UpdateMnemonic() *RaceStatisticsUpsertBulk {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.UpdateMnemonic()
+	})
+}
+
+// ClearMnemonic clears the value of the "mnemonic" field.
+func (u *RaceStatisticsUpsertBulk) ClearMnemonic() *RaceStatisticsUpsertBulk {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.ClearMnemonic()
+	})
+}
+
+// SetLocalRunner sets the "local_runner" field.
+func (u *RaceStatisticsUpsertBulk) SetLocalRunner(v string) *RaceStatisticsUpsertBulk {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.SetLocalRunner(v)
+	})
+}
+
+// UpdateLocalRunner sets the "local_runner" field to the value that was provided on create.
+func (u *RaceStatisticsUpsertBulk) UpdateLocalRunner() *RaceStatisticsUpsertBulk {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.UpdateLocalRunner()
+	})
+}
+
+// ClearLocalRunner clears the value of the "local_runner" field.
+func (u *RaceStatisticsUpsertBulk) ClearLocalRunner() *RaceStatisticsUpsertBulk {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.ClearLocalRunner()
+	})
+}
+
+// SetRemoteRunner sets the "remote_runner" field.
+func (u *RaceStatisticsUpsertBulk) SetRemoteRunner(v string) *RaceStatisticsUpsertBulk {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.SetRemoteRunner(v)
+	})
+}
+
+// UpdateRemoteRunner sets the "remote_runner" field to the value that was provided on create.
+func (u *RaceStatisticsUpsertBulk) UpdateRemoteRunner() *RaceStatisticsUpsertBulk {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.UpdateRemoteRunner()
+	})
+}
+
+// ClearRemoteRunner clears the value of the "remote_runner" field.
+func (u *RaceStatisticsUpsertBulk) ClearRemoteRunner() *RaceStatisticsUpsertBulk {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.ClearRemoteRunner()
+	})
+}
+
+// SetLocalWins sets the "local_wins" field.
+func (u *RaceStatisticsUpsertBulk) SetLocalWins(v int64) *RaceStatisticsUpsertBulk {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.SetLocalWins(v)
+	})
+}
+
+// AddLocalWins adds v to the "local_wins" field.
+func (u *RaceStatisticsUpsertBulk) AddLocalWins(v int64) *RaceStatisticsUpsertBulk {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.AddLocalWins(v)
+	})
+}
+
+// UpdateLocalWins sets the "local_wins" field to the value that was provided on create.
+func (u *RaceStatisticsUpsertBulk) UpdateLocalWins() *RaceStatisticsUpsertBulk {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.UpdateLocalWins()
+	})
+}
+
+// ClearLocalWins clears the value of the "local_wins" field.
+func (u *RaceStatisticsUpsertBulk) ClearLocalWins() *RaceStatisticsUpsertBulk {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.ClearLocalWins()
+	})
+}
+
+// SetRenoteWins sets the "renote_wins" field.
+func (u *RaceStatisticsUpsertBulk) SetRenoteWins(v int64) *RaceStatisticsUpsertBulk {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.SetRenoteWins(v)
+	})
+}
+
+// AddRenoteWins adds v to the "renote_wins" field.
+func (u *RaceStatisticsUpsertBulk) AddRenoteWins(v int64) *RaceStatisticsUpsertBulk {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.AddRenoteWins(v)
+	})
+}
+
+// UpdateRenoteWins sets the "renote_wins" field to the value that was provided on create.
+func (u *RaceStatisticsUpsertBulk) UpdateRenoteWins() *RaceStatisticsUpsertBulk {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.UpdateRenoteWins()
+	})
+}
+
+// ClearRenoteWins clears the value of the "renote_wins" field.
+func (u *RaceStatisticsUpsertBulk) ClearRenoteWins() *RaceStatisticsUpsertBulk {
+	return u.Update(func(s *RaceStatisticsUpsert) {
+		s.ClearRenoteWins()
+	})
+}
+
+// Exec executes the query.
+func (u *RaceStatisticsUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RaceStatisticsCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RaceStatisticsCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RaceStatisticsUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -4,8 +4,10 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/exectioninfo"
@@ -17,6 +19,7 @@ type ResourceUsageCreate struct {
 	config
 	mutation *ResourceUsageMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetName sets the "name" field.
@@ -122,6 +125,7 @@ func (ruc *ResourceUsageCreate) createSpec() (*ResourceUsage, *sqlgraph.CreateSp
 		_node = &ResourceUsage{config: ruc.config}
 		_spec = sqlgraph.NewCreateSpec(resourceusage.Table, sqlgraph.NewFieldSpec(resourceusage.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = ruc.conflict
 	if value, ok := ruc.mutation.Name(); ok {
 		_spec.SetField(resourceusage.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -149,11 +153,212 @@ func (ruc *ResourceUsageCreate) createSpec() (*ResourceUsage, *sqlgraph.CreateSp
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ResourceUsage.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResourceUsageUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (ruc *ResourceUsageCreate) OnConflict(opts ...sql.ConflictOption) *ResourceUsageUpsertOne {
+	ruc.conflict = opts
+	return &ResourceUsageUpsertOne{
+		create: ruc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ResourceUsage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ruc *ResourceUsageCreate) OnConflictColumns(columns ...string) *ResourceUsageUpsertOne {
+	ruc.conflict = append(ruc.conflict, sql.ConflictColumns(columns...))
+	return &ResourceUsageUpsertOne{
+		create: ruc,
+	}
+}
+
+type (
+	// ResourceUsageUpsertOne is the builder for "upsert"-ing
+	//  one ResourceUsage node.
+	ResourceUsageUpsertOne struct {
+		create *ResourceUsageCreate
+	}
+
+	// ResourceUsageUpsert is the "OnConflict" setter.
+	ResourceUsageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *ResourceUsageUpsert) SetName(v string) *ResourceUsageUpsert {
+	u.Set(resourceusage.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ResourceUsageUpsert) UpdateName() *ResourceUsageUpsert {
+	u.SetExcluded(resourceusage.FieldName)
+	return u
+}
+
+// ClearName clears the value of the "name" field.
+func (u *ResourceUsageUpsert) ClearName() *ResourceUsageUpsert {
+	u.SetNull(resourceusage.FieldName)
+	return u
+}
+
+// SetValue sets the "value" field.
+func (u *ResourceUsageUpsert) SetValue(v string) *ResourceUsageUpsert {
+	u.Set(resourceusage.FieldValue, v)
+	return u
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *ResourceUsageUpsert) UpdateValue() *ResourceUsageUpsert {
+	u.SetExcluded(resourceusage.FieldValue)
+	return u
+}
+
+// ClearValue clears the value of the "value" field.
+func (u *ResourceUsageUpsert) ClearValue() *ResourceUsageUpsert {
+	u.SetNull(resourceusage.FieldValue)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ResourceUsage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ResourceUsageUpsertOne) UpdateNewValues() *ResourceUsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ResourceUsage.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ResourceUsageUpsertOne) Ignore() *ResourceUsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResourceUsageUpsertOne) DoNothing() *ResourceUsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResourceUsageCreate.OnConflict
+// documentation for more info.
+func (u *ResourceUsageUpsertOne) Update(set func(*ResourceUsageUpsert)) *ResourceUsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResourceUsageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ResourceUsageUpsertOne) SetName(v string) *ResourceUsageUpsertOne {
+	return u.Update(func(s *ResourceUsageUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ResourceUsageUpsertOne) UpdateName() *ResourceUsageUpsertOne {
+	return u.Update(func(s *ResourceUsageUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *ResourceUsageUpsertOne) ClearName() *ResourceUsageUpsertOne {
+	return u.Update(func(s *ResourceUsageUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetValue sets the "value" field.
+func (u *ResourceUsageUpsertOne) SetValue(v string) *ResourceUsageUpsertOne {
+	return u.Update(func(s *ResourceUsageUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *ResourceUsageUpsertOne) UpdateValue() *ResourceUsageUpsertOne {
+	return u.Update(func(s *ResourceUsageUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// ClearValue clears the value of the "value" field.
+func (u *ResourceUsageUpsertOne) ClearValue() *ResourceUsageUpsertOne {
+	return u.Update(func(s *ResourceUsageUpsert) {
+		s.ClearValue()
+	})
+}
+
+// Exec executes the query.
+func (u *ResourceUsageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ResourceUsageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResourceUsageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ResourceUsageUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ResourceUsageUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // ResourceUsageCreateBulk is the builder for creating many ResourceUsage entities in bulk.
 type ResourceUsageCreateBulk struct {
 	config
 	err      error
 	builders []*ResourceUsageCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the ResourceUsage entities in the database.
@@ -182,6 +387,7 @@ func (rucb *ResourceUsageCreateBulk) Save(ctx context.Context) ([]*ResourceUsage
 					_, err = mutators[i+1].Mutate(root, rucb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rucb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, rucb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -235,3 +441,149 @@ func (rucb *ResourceUsageCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ResourceUsage.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResourceUsageUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (rucb *ResourceUsageCreateBulk) OnConflict(opts ...sql.ConflictOption) *ResourceUsageUpsertBulk {
+	rucb.conflict = opts
+	return &ResourceUsageUpsertBulk{
+		create: rucb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ResourceUsage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rucb *ResourceUsageCreateBulk) OnConflictColumns(columns ...string) *ResourceUsageUpsertBulk {
+	rucb.conflict = append(rucb.conflict, sql.ConflictColumns(columns...))
+	return &ResourceUsageUpsertBulk{
+		create: rucb,
+	}
+}
+
+// ResourceUsageUpsertBulk is the builder for "upsert"-ing
+// a bulk of ResourceUsage nodes.
+type ResourceUsageUpsertBulk struct {
+	create *ResourceUsageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ResourceUsage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ResourceUsageUpsertBulk) UpdateNewValues() *ResourceUsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ResourceUsage.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ResourceUsageUpsertBulk) Ignore() *ResourceUsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResourceUsageUpsertBulk) DoNothing() *ResourceUsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResourceUsageCreateBulk.OnConflict
+// documentation for more info.
+func (u *ResourceUsageUpsertBulk) Update(set func(*ResourceUsageUpsert)) *ResourceUsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResourceUsageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ResourceUsageUpsertBulk) SetName(v string) *ResourceUsageUpsertBulk {
+	return u.Update(func(s *ResourceUsageUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ResourceUsageUpsertBulk) UpdateName() *ResourceUsageUpsertBulk {
+	return u.Update(func(s *ResourceUsageUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *ResourceUsageUpsertBulk) ClearName() *ResourceUsageUpsertBulk {
+	return u.Update(func(s *ResourceUsageUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetValue sets the "value" field.
+func (u *ResourceUsageUpsertBulk) SetValue(v string) *ResourceUsageUpsertBulk {
+	return u.Update(func(s *ResourceUsageUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *ResourceUsageUpsertBulk) UpdateValue() *ResourceUsageUpsertBulk {
+	return u.Update(func(s *ResourceUsageUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// ClearValue clears the value of the "value" field.
+func (u *ResourceUsageUpsertBulk) ClearValue() *ResourceUsageUpsertBulk {
+	return u.Update(func(s *ResourceUsageUpsert) {
+		s.ClearValue()
+	})
+}
+
+// Exec executes the query.
+func (u *ResourceUsageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ResourceUsageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ResourceUsageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResourceUsageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -3,7 +3,10 @@
 package ent
 
 import (
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/eventfile"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/knownproblem"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/metricsrollup"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/targetpair"
 	"github.com/buildbarn/bb-portal/ent/schema"
 )
@@ -12,6 +15,12 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	bazelinvocationFields := schema.BazelInvocation{}.Fields()
+	_ = bazelinvocationFields
+	// bazelinvocationDescPinned is the schema descriptor for pinned field.
+	bazelinvocationDescPinned := bazelinvocationFields[19].Descriptor()
+	// bazelinvocation.DefaultPinned holds the default value on creation for the pinned field.
+	bazelinvocation.DefaultPinned = bazelinvocationDescPinned.Default.(bool)
 	blobFields := schema.Blob{}.Fields()
 	_ = blobFields
 	eventfileFields := schema.EventFile{}.Fields()
@@ -20,6 +29,50 @@ func init() {
 	eventfileDescStatus := eventfileFields[4].Descriptor()
 	// eventfile.DefaultStatus holds the default value on creation for the status field.
 	eventfile.DefaultStatus = eventfileDescStatus.Default.(string)
+	knownproblemFields := schema.KnownProblem{}.Fields()
+	_ = knownproblemFields
+	// knownproblemDescOccurrences is the schema descriptor for occurrences field.
+	knownproblemDescOccurrences := knownproblemFields[5].Descriptor()
+	// knownproblem.DefaultOccurrences holds the default value on creation for the occurrences field.
+	knownproblem.DefaultOccurrences = knownproblemDescOccurrences.Default.(int)
+	metricsrollupFields := schema.MetricsRollup{}.Fields()
+	_ = metricsrollupFields
+	// metricsrollupDescBranch is the schema descriptor for branch field.
+	metricsrollupDescBranch := metricsrollupFields[3].Descriptor()
+	// metricsrollup.DefaultBranch holds the default value on creation for the branch field.
+	metricsrollup.DefaultBranch = metricsrollupDescBranch.Default.(string)
+	// metricsrollupDescCommand is the schema descriptor for command field.
+	metricsrollupDescCommand := metricsrollupFields[4].Descriptor()
+	// metricsrollup.DefaultCommand holds the default value on creation for the command field.
+	metricsrollup.DefaultCommand = metricsrollupDescCommand.Default.(string)
+	// metricsrollupDescStepLabel is the schema descriptor for step_label field.
+	metricsrollupDescStepLabel := metricsrollupFields[5].Descriptor()
+	// metricsrollup.DefaultStepLabel holds the default value on creation for the step_label field.
+	metricsrollup.DefaultStepLabel = metricsrollupDescStepLabel.Default.(string)
+	// metricsrollupDescUserLdap is the schema descriptor for user_ldap field.
+	metricsrollupDescUserLdap := metricsrollupFields[6].Descriptor()
+	// metricsrollup.DefaultUserLdap holds the default value on creation for the user_ldap field.
+	metricsrollup.DefaultUserLdap = metricsrollupDescUserLdap.Default.(string)
+	// metricsrollupDescUserEmail is the schema descriptor for user_email field.
+	metricsrollupDescUserEmail := metricsrollupFields[7].Descriptor()
+	// metricsrollup.DefaultUserEmail holds the default value on creation for the user_email field.
+	metricsrollup.DefaultUserEmail = metricsrollupDescUserEmail.Default.(string)
+	// metricsrollupDescInvocations is the schema descriptor for invocations field.
+	metricsrollupDescInvocations := metricsrollupFields[8].Descriptor()
+	// metricsrollup.DefaultInvocations holds the default value on creation for the invocations field.
+	metricsrollup.DefaultInvocations = metricsrollupDescInvocations.Default.(int64)
+	// metricsrollupDescNumerator is the schema descriptor for numerator field.
+	metricsrollupDescNumerator := metricsrollupFields[9].Descriptor()
+	// metricsrollup.DefaultNumerator holds the default value on creation for the numerator field.
+	metricsrollup.DefaultNumerator = metricsrollupDescNumerator.Default.(float64)
+	// metricsrollupDescDenominator is the schema descriptor for denominator field.
+	metricsrollupDescDenominator := metricsrollupFields[10].Descriptor()
+	// metricsrollup.DefaultDenominator holds the default value on creation for the denominator field.
+	metricsrollup.DefaultDenominator = metricsrollupDescDenominator.Default.(float64)
+	// metricsrollupDescRevision is the schema descriptor for revision field.
+	metricsrollupDescRevision := metricsrollupFields[14].Descriptor()
+	// metricsrollup.DefaultRevision holds the default value on creation for the revision field.
+	metricsrollup.DefaultRevision = metricsrollupDescRevision.Default.(int64)
 	missdetailFields := schema.MissDetail{}.Fields()
 	_ = missdetailFields
 	targetpairFields := schema.TargetPair{}.Fields()
@@ -30,6 +83,8 @@ func init() {
 	targetpair.DefaultSuccess = targetpairDescSuccess.Default.(bool)
 	testcollectionFields := schema.TestCollection{}.Fields()
 	_ = testcollectionFields
+	testhealthreportFields := schema.TestHealthReport{}.Fields()
+	_ = testhealthreportFields
 	testresultbesFields := schema.TestResultBES{}.Fields()
 	_ = testresultbesFields
 	testsummaryFields := schema.TestSummary{}.Fields()
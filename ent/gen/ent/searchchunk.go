@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocationproblem"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/searchchunk"
+)
+
+// SearchChunk is the model entity for the SearchChunk schema.
+type SearchChunk struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Source holds the value of the "source" field.
+	Source searchchunk.Source `json:"source,omitempty"`
+	// FirstLine holds the value of the "first_line" field.
+	FirstLine int `json:"first_line,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SearchChunkQuery when eager-loading is set.
+	Edges                                  SearchChunkEdges `json:"edges"`
+	bazel_invocation_search_chunks         *int
+	bazel_invocation_problem_search_chunks *int
+	blob_search_chunks                     *int
+	selectValues                           sql.SelectValues
+}
+
+// SearchChunkEdges holds the relations/edges for other nodes in the graph.
+type SearchChunkEdges struct {
+	// BazelInvocation holds the value of the bazel_invocation edge.
+	BazelInvocation *BazelInvocation `json:"bazel_invocation,omitempty"`
+	// Problem holds the value of the problem edge.
+	Problem *BazelInvocationProblem `json:"problem,omitempty"`
+	// Blob holds the value of the blob edge.
+	Blob *Blob `json:"blob,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+	// totalCount holds the count of the edges above.
+	totalCount [3]map[string]int
+}
+
+// BazelInvocationOrErr returns the BazelInvocation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SearchChunkEdges) BazelInvocationOrErr() (*BazelInvocation, error) {
+	if e.BazelInvocation != nil {
+		return e.BazelInvocation, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: bazelinvocation.Label}
+	}
+	return nil, &NotLoadedError{edge: "bazel_invocation"}
+}
+
+// ProblemOrErr returns the Problem value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SearchChunkEdges) ProblemOrErr() (*BazelInvocationProblem, error) {
+	if e.Problem != nil {
+		return e.Problem, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: bazelinvocationproblem.Label}
+	}
+	return nil, &NotLoadedError{edge: "problem"}
+}
+
+// BlobOrErr returns the Blob value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SearchChunkEdges) BlobOrErr() (*Blob, error) {
+	if e.Blob != nil {
+		return e.Blob, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: blob.Label}
+	}
+	return nil, &NotLoadedError{edge: "blob"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SearchChunk) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case searchchunk.FieldID, searchchunk.FieldFirstLine:
+			values[i] = new(sql.NullInt64)
+		case searchchunk.FieldSource, searchchunk.FieldContent:
+			values[i] = new(sql.NullString)
+		case searchchunk.ForeignKeys[0]: // bazel_invocation_search_chunks
+			values[i] = new(sql.NullInt64)
+		case searchchunk.ForeignKeys[1]: // bazel_invocation_problem_search_chunks
+			values[i] = new(sql.NullInt64)
+		case searchchunk.ForeignKeys[2]: // blob_search_chunks
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SearchChunk fields.
+func (sc *SearchChunk) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case searchchunk.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sc.ID = int(value.Int64)
+		case searchchunk.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				sc.Source = searchchunk.Source(value.String)
+			}
+		case searchchunk.FieldFirstLine:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field first_line", values[i])
+			} else if value.Valid {
+				sc.FirstLine = int(value.Int64)
+			}
+		case searchchunk.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				sc.Content = value.String
+			}
+		case searchchunk.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field bazel_invocation_search_chunks", value)
+			} else if value.Valid {
+				sc.bazel_invocation_search_chunks = new(int)
+				*sc.bazel_invocation_search_chunks = int(value.Int64)
+			}
+		case searchchunk.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field bazel_invocation_problem_search_chunks", value)
+			} else if value.Valid {
+				sc.bazel_invocation_problem_search_chunks = new(int)
+				*sc.bazel_invocation_problem_search_chunks = int(value.Int64)
+			}
+		case searchchunk.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field blob_search_chunks", value)
+			} else if value.Valid {
+				sc.blob_search_chunks = new(int)
+				*sc.blob_search_chunks = int(value.Int64)
+			}
+		default:
+			sc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SearchChunk.
+// This includes values selected through modifiers, order, etc.
+func (sc *SearchChunk) Value(name string) (ent.Value, error) {
+	return sc.selectValues.Get(name)
+}
+
+// QueryBazelInvocation queries the "bazel_invocation" edge of the SearchChunk entity.
+func (sc *SearchChunk) QueryBazelInvocation() *BazelInvocationQuery {
+	return NewSearchChunkClient(sc.config).QueryBazelInvocation(sc)
+}
+
+// QueryProblem queries the "problem" edge of the SearchChunk entity.
+func (sc *SearchChunk) QueryProblem() *BazelInvocationProblemQuery {
+	return NewSearchChunkClient(sc.config).QueryProblem(sc)
+}
+
+// QueryBlob queries the "blob" edge of the SearchChunk entity.
+func (sc *SearchChunk) QueryBlob() *BlobQuery {
+	return NewSearchChunkClient(sc.config).QueryBlob(sc)
+}
+
+// Update returns a builder for updating this SearchChunk.
+// Note that you need to call SearchChunk.Unwrap() before calling this method if this SearchChunk
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sc *SearchChunk) Update() *SearchChunkUpdateOne {
+	return NewSearchChunkClient(sc.config).UpdateOne(sc)
+}
+
+// Unwrap unwraps the SearchChunk entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sc *SearchChunk) Unwrap() *SearchChunk {
+	_tx, ok := sc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SearchChunk is not a transactional entity")
+	}
+	sc.config.driver = _tx.drv
+	return sc
+}
+
+// String implements the fmt.Stringer.
+func (sc *SearchChunk) String() string {
+	var builder strings.Builder
+	builder.WriteString("SearchChunk(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sc.ID))
+	builder.WriteString("source=")
+	builder.WriteString(fmt.Sprintf("%v", sc.Source))
+	builder.WriteString(", ")
+	builder.WriteString("first_line=")
+	builder.WriteString(fmt.Sprintf("%v", sc.FirstLine))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(sc.Content)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SearchChunks is a parsable slice of SearchChunk.
+type SearchChunks []*SearchChunk
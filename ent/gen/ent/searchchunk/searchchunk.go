@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package searchchunk
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the searchchunk type in the database.
+	Label = "search_chunk"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldFirstLine holds the string denoting the first_line field in the database.
+	FieldFirstLine = "first_line"
+	// FieldContent holds the string denoting the content field in the database.
+	FieldContent = "content"
+	// EdgeBazelInvocation holds the string denoting the bazel_invocation edge name in mutations.
+	EdgeBazelInvocation = "bazel_invocation"
+	// EdgeProblem holds the string denoting the problem edge name in mutations.
+	EdgeProblem = "problem"
+	// EdgeBlob holds the string denoting the blob edge name in mutations.
+	EdgeBlob = "blob"
+	// Table holds the table name of the searchchunk in the database.
+	Table = "search_chunks"
+	// BazelInvocationTable is the table that holds the bazel_invocation relation/edge.
+	BazelInvocationTable = "search_chunks"
+	// BazelInvocationInverseTable is the table name for the BazelInvocation entity.
+	// It exists in this package in order to avoid circular dependency with the "bazelinvocation" package.
+	BazelInvocationInverseTable = "bazel_invocations"
+	// BazelInvocationColumn is the table column denoting the bazel_invocation relation/edge.
+	BazelInvocationColumn = "bazel_invocation_search_chunks"
+	// ProblemTable is the table that holds the problem relation/edge.
+	ProblemTable = "search_chunks"
+	// ProblemInverseTable is the table name for the BazelInvocationProblem entity.
+	// It exists in this package in order to avoid circular dependency with the "bazelinvocationproblem" package.
+	ProblemInverseTable = "bazel_invocation_problems"
+	// ProblemColumn is the table column denoting the problem relation/edge.
+	ProblemColumn = "bazel_invocation_problem_search_chunks"
+	// BlobTable is the table that holds the blob relation/edge.
+	BlobTable = "search_chunks"
+	// BlobInverseTable is the table name for the Blob entity.
+	// It exists in this package in order to avoid circular dependency with the "blob" package.
+	BlobInverseTable = "blobs"
+	// BlobColumn is the table column denoting the blob relation/edge.
+	BlobColumn = "blob_search_chunks"
+)
+
+// Columns holds all SQL columns for searchchunk fields.
+var Columns = []string{
+	FieldID,
+	FieldSource,
+	FieldFirstLine,
+	FieldContent,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "search_chunks"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"bazel_invocation_search_chunks",
+	"bazel_invocation_problem_search_chunks",
+	"blob_search_chunks",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Source defines the type for the "source" enum field.
+type Source string
+
+// Source values.
+const (
+	SourceBUILD_LOGS     Source = "BUILD_LOGS"
+	SourcePROBLEM_OUTPUT Source = "PROBLEM_OUTPUT"
+	SourceBLOB           Source = "BLOB"
+)
+
+func (s Source) String() string {
+	return string(s)
+}
+
+// SourceValidator is a validator for the "source" field enum values. It is called by the builders before save.
+func SourceValidator(s Source) error {
+	switch s {
+	case SourceBUILD_LOGS, SourcePROBLEM_OUTPUT, SourceBLOB:
+		return nil
+	default:
+		return fmt.Errorf("searchchunk: invalid enum value for source field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the SearchChunk queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByFirstLine orders the results by the first_line field.
+func ByFirstLine(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstLine, opts...).ToFunc()
+}
+
+// ByContent orders the results by the content field.
+func ByContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContent, opts...).ToFunc()
+}
+
+// ByBazelInvocationField orders the results by bazel_invocation field.
+func ByBazelInvocationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBazelInvocationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByProblemField orders the results by problem field.
+func ByProblemField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProblemStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByBlobField orders the results by blob field.
+func ByBlobField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlobStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newBazelInvocationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BazelInvocationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BazelInvocationTable, BazelInvocationColumn),
+	)
+}
+func newProblemStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProblemInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProblemTable, ProblemColumn),
+	)
+}
+func newBlobStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BlobInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BlobTable, BlobColumn),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Source) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Source) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Source(str)
+	if err := SourceValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Source", str)
+	}
+	return nil
+}
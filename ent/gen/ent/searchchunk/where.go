@@ -0,0 +1,273 @@
+// Code generated by ent, DO NOT EDIT.
+
+package searchchunk
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldLTE(FieldID, id))
+}
+
+// FirstLine applies equality check predicate on the "first_line" field. It's identical to FirstLineEQ.
+func FirstLine(v int) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldEQ(FieldFirstLine, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldEQ(FieldContent, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v Source) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v Source) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...Source) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...Source) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// FirstLineEQ applies the EQ predicate on the "first_line" field.
+func FirstLineEQ(v int) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldEQ(FieldFirstLine, v))
+}
+
+// FirstLineNEQ applies the NEQ predicate on the "first_line" field.
+func FirstLineNEQ(v int) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldNEQ(FieldFirstLine, v))
+}
+
+// FirstLineIn applies the In predicate on the "first_line" field.
+func FirstLineIn(vs ...int) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldIn(FieldFirstLine, vs...))
+}
+
+// FirstLineNotIn applies the NotIn predicate on the "first_line" field.
+func FirstLineNotIn(vs ...int) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldNotIn(FieldFirstLine, vs...))
+}
+
+// FirstLineGT applies the GT predicate on the "first_line" field.
+func FirstLineGT(v int) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldGT(FieldFirstLine, v))
+}
+
+// FirstLineGTE applies the GTE predicate on the "first_line" field.
+func FirstLineGTE(v int) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldGTE(FieldFirstLine, v))
+}
+
+// FirstLineLT applies the LT predicate on the "first_line" field.
+func FirstLineLT(v int) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldLT(FieldFirstLine, v))
+}
+
+// FirstLineLTE applies the LTE predicate on the "first_line" field.
+func FirstLineLTE(v int) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldLTE(FieldFirstLine, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.FieldContainsFold(FieldContent, v))
+}
+
+// HasBazelInvocation applies the HasEdge predicate on the "bazel_invocation" edge.
+func HasBazelInvocation() predicate.SearchChunk {
+	return predicate.SearchChunk(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BazelInvocationTable, BazelInvocationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBazelInvocationWith applies the HasEdge predicate on the "bazel_invocation" edge with a given conditions (other predicates).
+func HasBazelInvocationWith(preds ...predicate.BazelInvocation) predicate.SearchChunk {
+	return predicate.SearchChunk(func(s *sql.Selector) {
+		step := newBazelInvocationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProblem applies the HasEdge predicate on the "problem" edge.
+func HasProblem() predicate.SearchChunk {
+	return predicate.SearchChunk(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProblemTable, ProblemColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProblemWith applies the HasEdge predicate on the "problem" edge with a given conditions (other predicates).
+func HasProblemWith(preds ...predicate.BazelInvocationProblem) predicate.SearchChunk {
+	return predicate.SearchChunk(func(s *sql.Selector) {
+		step := newProblemStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlob applies the HasEdge predicate on the "blob" edge.
+func HasBlob() predicate.SearchChunk {
+	return predicate.SearchChunk(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BlobTable, BlobColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlobWith applies the HasEdge predicate on the "blob" edge with a given conditions (other predicates).
+func HasBlobWith(preds ...predicate.Blob) predicate.SearchChunk {
+	return predicate.SearchChunk(func(s *sql.Selector) {
+		step := newBlobStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SearchChunk) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SearchChunk) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SearchChunk) predicate.SearchChunk {
+	return predicate.SearchChunk(sql.NotPredicates(p))
+}
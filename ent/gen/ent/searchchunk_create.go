@@ -0,0 +1,580 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocationproblem"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/searchchunk"
+)
+
+// SearchChunkCreate is the builder for creating a SearchChunk entity.
+type SearchChunkCreate struct {
+	config
+	mutation *SearchChunkMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetSource sets the "source" field.
+func (scc *SearchChunkCreate) SetSource(s searchchunk.Source) *SearchChunkCreate {
+	scc.mutation.SetSource(s)
+	return scc
+}
+
+// SetFirstLine sets the "first_line" field.
+func (scc *SearchChunkCreate) SetFirstLine(i int) *SearchChunkCreate {
+	scc.mutation.SetFirstLine(i)
+	return scc
+}
+
+// SetContent sets the "content" field.
+func (scc *SearchChunkCreate) SetContent(s string) *SearchChunkCreate {
+	scc.mutation.SetContent(s)
+	return scc
+}
+
+// SetBazelInvocationID sets the "bazel_invocation" edge to the BazelInvocation entity by ID.
+func (scc *SearchChunkCreate) SetBazelInvocationID(id int) *SearchChunkCreate {
+	scc.mutation.SetBazelInvocationID(id)
+	return scc
+}
+
+// SetNillableBazelInvocationID sets the "bazel_invocation" edge to the BazelInvocation entity by ID if the given value is not nil.
+func (scc *SearchChunkCreate) SetNillableBazelInvocationID(id *int) *SearchChunkCreate {
+	if id != nil {
+		scc = scc.SetBazelInvocationID(*id)
+	}
+	return scc
+}
+
+// SetBazelInvocation sets the "bazel_invocation" edge to the BazelInvocation entity.
+func (scc *SearchChunkCreate) SetBazelInvocation(b *BazelInvocation) *SearchChunkCreate {
+	return scc.SetBazelInvocationID(b.ID)
+}
+
+// SetProblemID sets the "problem" edge to the BazelInvocationProblem entity by ID.
+func (scc *SearchChunkCreate) SetProblemID(id int) *SearchChunkCreate {
+	scc.mutation.SetProblemID(id)
+	return scc
+}
+
+// SetNillableProblemID sets the "problem" edge to the BazelInvocationProblem entity by ID if the given value is not nil.
+func (scc *SearchChunkCreate) SetNillableProblemID(id *int) *SearchChunkCreate {
+	if id != nil {
+		scc = scc.SetProblemID(*id)
+	}
+	return scc
+}
+
+// SetProblem sets the "problem" edge to the BazelInvocationProblem entity.
+func (scc *SearchChunkCreate) SetProblem(b *BazelInvocationProblem) *SearchChunkCreate {
+	return scc.SetProblemID(b.ID)
+}
+
+// SetBlobID sets the "blob" edge to the Blob entity by ID.
+func (scc *SearchChunkCreate) SetBlobID(id int) *SearchChunkCreate {
+	scc.mutation.SetBlobID(id)
+	return scc
+}
+
+// SetNillableBlobID sets the "blob" edge to the Blob entity by ID if the given value is not nil.
+func (scc *SearchChunkCreate) SetNillableBlobID(id *int) *SearchChunkCreate {
+	if id != nil {
+		scc = scc.SetBlobID(*id)
+	}
+	return scc
+}
+
+// SetBlob sets the "blob" edge to the Blob entity.
+func (scc *SearchChunkCreate) SetBlob(b *Blob) *SearchChunkCreate {
+	return scc.SetBlobID(b.ID)
+}
+
+// Mutation returns the SearchChunkMutation object of the builder.
+func (scc *SearchChunkCreate) Mutation() *SearchChunkMutation {
+	return scc.mutation
+}
+
+// Save creates the SearchChunk in the database.
+func (scc *SearchChunkCreate) Save(ctx context.Context) (*SearchChunk, error) {
+	return withHooks(ctx, scc.sqlSave, scc.mutation, scc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (scc *SearchChunkCreate) SaveX(ctx context.Context) *SearchChunk {
+	v, err := scc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scc *SearchChunkCreate) Exec(ctx context.Context) error {
+	_, err := scc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scc *SearchChunkCreate) ExecX(ctx context.Context) {
+	if err := scc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scc *SearchChunkCreate) check() error {
+	if _, ok := scc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "SearchChunk.source"`)}
+	}
+	if v, ok := scc.mutation.Source(); ok {
+		if err := searchchunk.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "SearchChunk.source": %w`, err)}
+		}
+	}
+	if _, ok := scc.mutation.FirstLine(); !ok {
+		return &ValidationError{Name: "first_line", err: errors.New(`ent: missing required field "SearchChunk.first_line"`)}
+	}
+	if _, ok := scc.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "SearchChunk.content"`)}
+	}
+	return nil
+}
+
+func (scc *SearchChunkCreate) sqlSave(ctx context.Context) (*SearchChunk, error) {
+	if err := scc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := scc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, scc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	scc.mutation.id = &_node.ID
+	scc.mutation.done = true
+	return _node, nil
+}
+
+func (scc *SearchChunkCreate) createSpec() (*SearchChunk, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SearchChunk{config: scc.config}
+		_spec = sqlgraph.NewCreateSpec(searchchunk.Table, sqlgraph.NewFieldSpec(searchchunk.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = scc.conflict
+	if value, ok := scc.mutation.Source(); ok {
+		_spec.SetField(searchchunk.FieldSource, field.TypeEnum, value)
+		_node.Source = value
+	}
+	if value, ok := scc.mutation.FirstLine(); ok {
+		_spec.SetField(searchchunk.FieldFirstLine, field.TypeInt, value)
+		_node.FirstLine = value
+	}
+	if value, ok := scc.mutation.Content(); ok {
+		_spec.SetField(searchchunk.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if nodes := scc.mutation.BazelInvocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   searchchunk.BazelInvocationTable,
+			Columns: []string{searchchunk.BazelInvocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.bazel_invocation_search_chunks = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := scc.mutation.ProblemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   searchchunk.ProblemTable,
+			Columns: []string{searchchunk.ProblemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocationproblem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.bazel_invocation_problem_search_chunks = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := scc.mutation.BlobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   searchchunk.BlobTable,
+			Columns: []string{searchchunk.BlobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.blob_search_chunks = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SearchChunk.Create().
+//		SetSource(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SearchChunkUpsert) {
+//			SetSource(v+v).
+//		}).
+//		Exec(ctx)
+func (scc *SearchChunkCreate) OnConflict(opts ...sql.ConflictOption) *SearchChunkUpsertOne {
+	scc.conflict = opts
+	return &SearchChunkUpsertOne{
+		create: scc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SearchChunk.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (scc *SearchChunkCreate) OnConflictColumns(columns ...string) *SearchChunkUpsertOne {
+	scc.conflict = append(scc.conflict, sql.ConflictColumns(columns...))
+	return &SearchChunkUpsertOne{
+		create: scc,
+	}
+}
+
+type (
+	// SearchChunkUpsertOne is the builder for "upsert"-ing
+	//  one SearchChunk node.
+	SearchChunkUpsertOne struct {
+		create *SearchChunkCreate
+	}
+
+	// SearchChunkUpsert is the "OnConflict" setter.
+	SearchChunkUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.SearchChunk.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SearchChunkUpsertOne) UpdateNewValues() *SearchChunkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.Source(); exists {
+			s.SetIgnore(searchchunk.FieldSource)
+		}
+		if _, exists := u.create.mutation.FirstLine(); exists {
+			s.SetIgnore(searchchunk.FieldFirstLine)
+		}
+		if _, exists := u.create.mutation.Content(); exists {
+			s.SetIgnore(searchchunk.FieldContent)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SearchChunk.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SearchChunkUpsertOne) Ignore() *SearchChunkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SearchChunkUpsertOne) DoNothing() *SearchChunkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SearchChunkCreate.OnConflict
+// documentation for more info.
+func (u *SearchChunkUpsertOne) Update(set func(*SearchChunkUpsert)) *SearchChunkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SearchChunkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *SearchChunkUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SearchChunkCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SearchChunkUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SearchChunkUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SearchChunkUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SearchChunkCreateBulk is the builder for creating many SearchChunk entities in bulk.
+type SearchChunkCreateBulk struct {
+	config
+	err      error
+	builders []*SearchChunkCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SearchChunk entities in the database.
+func (sccb *SearchChunkCreateBulk) Save(ctx context.Context) ([]*SearchChunk, error) {
+	if sccb.err != nil {
+		return nil, sccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sccb.builders))
+	nodes := make([]*SearchChunk, len(sccb.builders))
+	mutators := make([]Mutator, len(sccb.builders))
+	for i := range sccb.builders {
+		func(i int, root context.Context) {
+			builder := sccb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SearchChunkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = sccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sccb *SearchChunkCreateBulk) SaveX(ctx context.Context) []*SearchChunk {
+	v, err := sccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sccb *SearchChunkCreateBulk) Exec(ctx context.Context) error {
+	_, err := sccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sccb *SearchChunkCreateBulk) ExecX(ctx context.Context) {
+	if err := sccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SearchChunk.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SearchChunkUpsert) {
+//			SetSource(v+v).
+//		}).
+//		Exec(ctx)
+func (sccb *SearchChunkCreateBulk) OnConflict(opts ...sql.ConflictOption) *SearchChunkUpsertBulk {
+	sccb.conflict = opts
+	return &SearchChunkUpsertBulk{
+		create: sccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SearchChunk.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sccb *SearchChunkCreateBulk) OnConflictColumns(columns ...string) *SearchChunkUpsertBulk {
+	sccb.conflict = append(sccb.conflict, sql.ConflictColumns(columns...))
+	return &SearchChunkUpsertBulk{
+		create: sccb,
+	}
+}
+
+// SearchChunkUpsertBulk is the builder for "upsert"-ing
+// a bulk of SearchChunk nodes.
+type SearchChunkUpsertBulk struct {
+	create *SearchChunkCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SearchChunk.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SearchChunkUpsertBulk) UpdateNewValues() *SearchChunkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.Source(); exists {
+				s.SetIgnore(searchchunk.FieldSource)
+			}
+			if _, exists := b.mutation.FirstLine(); exists {
+				s.SetIgnore(searchchunk.FieldFirstLine)
+			}
+			if _, exists := b.mutation.Content(); exists {
+				s.SetIgnore(searchchunk.FieldContent)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SearchChunk.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SearchChunkUpsertBulk) Ignore() *SearchChunkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SearchChunkUpsertBulk) DoNothing() *SearchChunkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SearchChunkCreateBulk.OnConflict
+// documentation for more info.
+func (u *SearchChunkUpsertBulk) Update(set func(*SearchChunkUpsert)) *SearchChunkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SearchChunkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *SearchChunkUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SearchChunkCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SearchChunkCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SearchChunkUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,776 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocationproblem"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/predicate"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/searchchunk"
+)
+
+// SearchChunkQuery is the builder for querying SearchChunk entities.
+type SearchChunkQuery struct {
+	config
+	ctx                 *QueryContext
+	order               []searchchunk.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.SearchChunk
+	withBazelInvocation *BazelInvocationQuery
+	withProblem         *BazelInvocationProblemQuery
+	withBlob            *BlobQuery
+	withFKs             bool
+	modifiers           []func(*sql.Selector)
+	loadTotal           []func(context.Context, []*SearchChunk) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SearchChunkQuery builder.
+func (scq *SearchChunkQuery) Where(ps ...predicate.SearchChunk) *SearchChunkQuery {
+	scq.predicates = append(scq.predicates, ps...)
+	return scq
+}
+
+// Limit the number of records to be returned by this query.
+func (scq *SearchChunkQuery) Limit(limit int) *SearchChunkQuery {
+	scq.ctx.Limit = &limit
+	return scq
+}
+
+// Offset to start from.
+func (scq *SearchChunkQuery) Offset(offset int) *SearchChunkQuery {
+	scq.ctx.Offset = &offset
+	return scq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (scq *SearchChunkQuery) Unique(unique bool) *SearchChunkQuery {
+	scq.ctx.Unique = &unique
+	return scq
+}
+
+// Order specifies how the records should be ordered.
+func (scq *SearchChunkQuery) Order(o ...searchchunk.OrderOption) *SearchChunkQuery {
+	scq.order = append(scq.order, o...)
+	return scq
+}
+
+// QueryBazelInvocation chains the current query on the "bazel_invocation" edge.
+func (scq *SearchChunkQuery) QueryBazelInvocation() *BazelInvocationQuery {
+	query := (&BazelInvocationClient{config: scq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := scq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := scq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(searchchunk.Table, searchchunk.FieldID, selector),
+			sqlgraph.To(bazelinvocation.Table, bazelinvocation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, searchchunk.BazelInvocationTable, searchchunk.BazelInvocationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(scq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryProblem chains the current query on the "problem" edge.
+func (scq *SearchChunkQuery) QueryProblem() *BazelInvocationProblemQuery {
+	query := (&BazelInvocationProblemClient{config: scq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := scq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := scq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(searchchunk.Table, searchchunk.FieldID, selector),
+			sqlgraph.To(bazelinvocationproblem.Table, bazelinvocationproblem.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, searchchunk.ProblemTable, searchchunk.ProblemColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(scq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBlob chains the current query on the "blob" edge.
+func (scq *SearchChunkQuery) QueryBlob() *BlobQuery {
+	query := (&BlobClient{config: scq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := scq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := scq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(searchchunk.Table, searchchunk.FieldID, selector),
+			sqlgraph.To(blob.Table, blob.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, searchchunk.BlobTable, searchchunk.BlobColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(scq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SearchChunk entity from the query.
+// Returns a *NotFoundError when no SearchChunk was found.
+func (scq *SearchChunkQuery) First(ctx context.Context) (*SearchChunk, error) {
+	nodes, err := scq.Limit(1).All(setContextOp(ctx, scq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{searchchunk.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (scq *SearchChunkQuery) FirstX(ctx context.Context) *SearchChunk {
+	node, err := scq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SearchChunk ID from the query.
+// Returns a *NotFoundError when no SearchChunk ID was found.
+func (scq *SearchChunkQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = scq.Limit(1).IDs(setContextOp(ctx, scq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{searchchunk.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (scq *SearchChunkQuery) FirstIDX(ctx context.Context) int {
+	id, err := scq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SearchChunk entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SearchChunk entity is found.
+// Returns a *NotFoundError when no SearchChunk entities are found.
+func (scq *SearchChunkQuery) Only(ctx context.Context) (*SearchChunk, error) {
+	nodes, err := scq.Limit(2).All(setContextOp(ctx, scq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{searchchunk.Label}
+	default:
+		return nil, &NotSingularError{searchchunk.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (scq *SearchChunkQuery) OnlyX(ctx context.Context) *SearchChunk {
+	node, err := scq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SearchChunk ID in the query.
+// Returns a *NotSingularError when more than one SearchChunk ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (scq *SearchChunkQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = scq.Limit(2).IDs(setContextOp(ctx, scq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{searchchunk.Label}
+	default:
+		err = &NotSingularError{searchchunk.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (scq *SearchChunkQuery) OnlyIDX(ctx context.Context) int {
+	id, err := scq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SearchChunks.
+func (scq *SearchChunkQuery) All(ctx context.Context) ([]*SearchChunk, error) {
+	ctx = setContextOp(ctx, scq.ctx, "All")
+	if err := scq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SearchChunk, *SearchChunkQuery]()
+	return withInterceptors[[]*SearchChunk](ctx, scq, qr, scq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (scq *SearchChunkQuery) AllX(ctx context.Context) []*SearchChunk {
+	nodes, err := scq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SearchChunk IDs.
+func (scq *SearchChunkQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if scq.ctx.Unique == nil && scq.path != nil {
+		scq.Unique(true)
+	}
+	ctx = setContextOp(ctx, scq.ctx, "IDs")
+	if err = scq.Select(searchchunk.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (scq *SearchChunkQuery) IDsX(ctx context.Context) []int {
+	ids, err := scq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (scq *SearchChunkQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, scq.ctx, "Count")
+	if err := scq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, scq, querierCount[*SearchChunkQuery](), scq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (scq *SearchChunkQuery) CountX(ctx context.Context) int {
+	count, err := scq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (scq *SearchChunkQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, scq.ctx, "Exist")
+	switch _, err := scq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (scq *SearchChunkQuery) ExistX(ctx context.Context) bool {
+	exist, err := scq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SearchChunkQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (scq *SearchChunkQuery) Clone() *SearchChunkQuery {
+	if scq == nil {
+		return nil
+	}
+	return &SearchChunkQuery{
+		config:              scq.config,
+		ctx:                 scq.ctx.Clone(),
+		order:               append([]searchchunk.OrderOption{}, scq.order...),
+		inters:              append([]Interceptor{}, scq.inters...),
+		predicates:          append([]predicate.SearchChunk{}, scq.predicates...),
+		withBazelInvocation: scq.withBazelInvocation.Clone(),
+		withProblem:         scq.withProblem.Clone(),
+		withBlob:            scq.withBlob.Clone(),
+		// clone intermediate query.
+		sql:  scq.sql.Clone(),
+		path: scq.path,
+	}
+}
+
+// WithBazelInvocation tells the query-builder to eager-load the nodes that are connected to
+// the "bazel_invocation" edge. The optional arguments are used to configure the query builder of the edge.
+func (scq *SearchChunkQuery) WithBazelInvocation(opts ...func(*BazelInvocationQuery)) *SearchChunkQuery {
+	query := (&BazelInvocationClient{config: scq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	scq.withBazelInvocation = query
+	return scq
+}
+
+// WithProblem tells the query-builder to eager-load the nodes that are connected to
+// the "problem" edge. The optional arguments are used to configure the query builder of the edge.
+func (scq *SearchChunkQuery) WithProblem(opts ...func(*BazelInvocationProblemQuery)) *SearchChunkQuery {
+	query := (&BazelInvocationProblemClient{config: scq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	scq.withProblem = query
+	return scq
+}
+
+// WithBlob tells the query-builder to eager-load the nodes that are connected to
+// the "blob" edge. The optional arguments are used to configure the query builder of the edge.
+func (scq *SearchChunkQuery) WithBlob(opts ...func(*BlobQuery)) *SearchChunkQuery {
+	query := (&BlobClient{config: scq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	scq.withBlob = query
+	return scq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Source searchchunk.Source `json:"source,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SearchChunk.Query().
+//		GroupBy(searchchunk.FieldSource).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (scq *SearchChunkQuery) GroupBy(field string, fields ...string) *SearchChunkGroupBy {
+	scq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SearchChunkGroupBy{build: scq}
+	grbuild.flds = &scq.ctx.Fields
+	grbuild.label = searchchunk.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Source searchchunk.Source `json:"source,omitempty"`
+//	}
+//
+//	client.SearchChunk.Query().
+//		Select(searchchunk.FieldSource).
+//		Scan(ctx, &v)
+func (scq *SearchChunkQuery) Select(fields ...string) *SearchChunkSelect {
+	scq.ctx.Fields = append(scq.ctx.Fields, fields...)
+	sbuild := &SearchChunkSelect{SearchChunkQuery: scq}
+	sbuild.label = searchchunk.Label
+	sbuild.flds, sbuild.scan = &scq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SearchChunkSelect configured with the given aggregations.
+func (scq *SearchChunkQuery) Aggregate(fns ...AggregateFunc) *SearchChunkSelect {
+	return scq.Select().Aggregate(fns...)
+}
+
+func (scq *SearchChunkQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range scq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, scq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range scq.ctx.Fields {
+		if !searchchunk.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if scq.path != nil {
+		prev, err := scq.path(ctx)
+		if err != nil {
+			return err
+		}
+		scq.sql = prev
+	}
+	return nil
+}
+
+func (scq *SearchChunkQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SearchChunk, error) {
+	var (
+		nodes       = []*SearchChunk{}
+		withFKs     = scq.withFKs
+		_spec       = scq.querySpec()
+		loadedTypes = [3]bool{
+			scq.withBazelInvocation != nil,
+			scq.withProblem != nil,
+			scq.withBlob != nil,
+		}
+	)
+	if scq.withBazelInvocation != nil || scq.withProblem != nil || scq.withBlob != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, searchchunk.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SearchChunk).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SearchChunk{config: scq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(scq.modifiers) > 0 {
+		_spec.Modifiers = scq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, scq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := scq.withBazelInvocation; query != nil {
+		if err := scq.loadBazelInvocation(ctx, query, nodes, nil,
+			func(n *SearchChunk, e *BazelInvocation) { n.Edges.BazelInvocation = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := scq.withProblem; query != nil {
+		if err := scq.loadProblem(ctx, query, nodes, nil,
+			func(n *SearchChunk, e *BazelInvocationProblem) { n.Edges.Problem = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := scq.withBlob; query != nil {
+		if err := scq.loadBlob(ctx, query, nodes, nil,
+			func(n *SearchChunk, e *Blob) { n.Edges.Blob = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range scq.loadTotal {
+		if err := scq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (scq *SearchChunkQuery) loadBazelInvocation(ctx context.Context, query *BazelInvocationQuery, nodes []*SearchChunk, init func(*SearchChunk), assign func(*SearchChunk, *BazelInvocation)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SearchChunk)
+	for i := range nodes {
+		if nodes[i].bazel_invocation_search_chunks == nil {
+			continue
+		}
+		fk := *nodes[i].bazel_invocation_search_chunks
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(bazelinvocation.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "bazel_invocation_search_chunks" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (scq *SearchChunkQuery) loadProblem(ctx context.Context, query *BazelInvocationProblemQuery, nodes []*SearchChunk, init func(*SearchChunk), assign func(*SearchChunk, *BazelInvocationProblem)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SearchChunk)
+	for i := range nodes {
+		if nodes[i].bazel_invocation_problem_search_chunks == nil {
+			continue
+		}
+		fk := *nodes[i].bazel_invocation_problem_search_chunks
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(bazelinvocationproblem.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "bazel_invocation_problem_search_chunks" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (scq *SearchChunkQuery) loadBlob(ctx context.Context, query *BlobQuery, nodes []*SearchChunk, init func(*SearchChunk), assign func(*SearchChunk, *Blob)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SearchChunk)
+	for i := range nodes {
+		if nodes[i].blob_search_chunks == nil {
+			continue
+		}
+		fk := *nodes[i].blob_search_chunks
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(blob.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "blob_search_chunks" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (scq *SearchChunkQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := scq.querySpec()
+	if len(scq.modifiers) > 0 {
+		_spec.Modifiers = scq.modifiers
+	}
+	_spec.Node.Columns = scq.ctx.Fields
+	if len(scq.ctx.Fields) > 0 {
+		_spec.Unique = scq.ctx.Unique != nil && *scq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, scq.driver, _spec)
+}
+
+func (scq *SearchChunkQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(searchchunk.Table, searchchunk.Columns, sqlgraph.NewFieldSpec(searchchunk.FieldID, field.TypeInt))
+	_spec.From = scq.sql
+	if unique := scq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if scq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := scq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, searchchunk.FieldID)
+		for i := range fields {
+			if fields[i] != searchchunk.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := scq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := scq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := scq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := scq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (scq *SearchChunkQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(scq.driver.Dialect())
+	t1 := builder.Table(searchchunk.Table)
+	columns := scq.ctx.Fields
+	if len(columns) == 0 {
+		columns = searchchunk.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if scq.sql != nil {
+		selector = scq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if scq.ctx.Unique != nil && *scq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range scq.predicates {
+		p(selector)
+	}
+	for _, p := range scq.order {
+		p(selector)
+	}
+	if offset := scq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := scq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SearchChunkGroupBy is the group-by builder for SearchChunk entities.
+type SearchChunkGroupBy struct {
+	selector
+	build *SearchChunkQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (scgb *SearchChunkGroupBy) Aggregate(fns ...AggregateFunc) *SearchChunkGroupBy {
+	scgb.fns = append(scgb.fns, fns...)
+	return scgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (scgb *SearchChunkGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, scgb.build.ctx, "GroupBy")
+	if err := scgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SearchChunkQuery, *SearchChunkGroupBy](ctx, scgb.build, scgb, scgb.build.inters, v)
+}
+
+func (scgb *SearchChunkGroupBy) sqlScan(ctx context.Context, root *SearchChunkQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(scgb.fns))
+	for _, fn := range scgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*scgb.flds)+len(scgb.fns))
+		for _, f := range *scgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*scgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := scgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SearchChunkSelect is the builder for selecting fields of SearchChunk entities.
+type SearchChunkSelect struct {
+	*SearchChunkQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (scs *SearchChunkSelect) Aggregate(fns ...AggregateFunc) *SearchChunkSelect {
+	scs.fns = append(scs.fns, fns...)
+	return scs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (scs *SearchChunkSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, scs.ctx, "Select")
+	if err := scs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SearchChunkQuery, *SearchChunkSelect](ctx, scs.SearchChunkQuery, scs, scs.inters, v)
+}
+
+func (scs *SearchChunkSelect) sqlScan(ctx context.Context, root *SearchChunkQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(scs.fns))
+	for _, fn := range scs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*scs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := scs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,502 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocationproblem"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/predicate"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/searchchunk"
+)
+
+// SearchChunkUpdate is the builder for updating SearchChunk entities.
+type SearchChunkUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SearchChunkMutation
+}
+
+// Where appends a list predicates to the SearchChunkUpdate builder.
+func (scu *SearchChunkUpdate) Where(ps ...predicate.SearchChunk) *SearchChunkUpdate {
+	scu.mutation.Where(ps...)
+	return scu
+}
+
+// SetBazelInvocationID sets the "bazel_invocation" edge to the BazelInvocation entity by ID.
+func (scu *SearchChunkUpdate) SetBazelInvocationID(id int) *SearchChunkUpdate {
+	scu.mutation.SetBazelInvocationID(id)
+	return scu
+}
+
+// SetNillableBazelInvocationID sets the "bazel_invocation" edge to the BazelInvocation entity by ID if the given value is not nil.
+func (scu *SearchChunkUpdate) SetNillableBazelInvocationID(id *int) *SearchChunkUpdate {
+	if id != nil {
+		scu = scu.SetBazelInvocationID(*id)
+	}
+	return scu
+}
+
+// SetBazelInvocation sets the "bazel_invocation" edge to the BazelInvocation entity.
+func (scu *SearchChunkUpdate) SetBazelInvocation(b *BazelInvocation) *SearchChunkUpdate {
+	return scu.SetBazelInvocationID(b.ID)
+}
+
+// SetProblemID sets the "problem" edge to the BazelInvocationProblem entity by ID.
+func (scu *SearchChunkUpdate) SetProblemID(id int) *SearchChunkUpdate {
+	scu.mutation.SetProblemID(id)
+	return scu
+}
+
+// SetNillableProblemID sets the "problem" edge to the BazelInvocationProblem entity by ID if the given value is not nil.
+func (scu *SearchChunkUpdate) SetNillableProblemID(id *int) *SearchChunkUpdate {
+	if id != nil {
+		scu = scu.SetProblemID(*id)
+	}
+	return scu
+}
+
+// SetProblem sets the "problem" edge to the BazelInvocationProblem entity.
+func (scu *SearchChunkUpdate) SetProblem(b *BazelInvocationProblem) *SearchChunkUpdate {
+	return scu.SetProblemID(b.ID)
+}
+
+// SetBlobID sets the "blob" edge to the Blob entity by ID.
+func (scu *SearchChunkUpdate) SetBlobID(id int) *SearchChunkUpdate {
+	scu.mutation.SetBlobID(id)
+	return scu
+}
+
+// SetNillableBlobID sets the "blob" edge to the Blob entity by ID if the given value is not nil.
+func (scu *SearchChunkUpdate) SetNillableBlobID(id *int) *SearchChunkUpdate {
+	if id != nil {
+		scu = scu.SetBlobID(*id)
+	}
+	return scu
+}
+
+// SetBlob sets the "blob" edge to the Blob entity.
+func (scu *SearchChunkUpdate) SetBlob(b *Blob) *SearchChunkUpdate {
+	return scu.SetBlobID(b.ID)
+}
+
+// Mutation returns the SearchChunkMutation object of the builder.
+func (scu *SearchChunkUpdate) Mutation() *SearchChunkMutation {
+	return scu.mutation
+}
+
+// ClearBazelInvocation clears the "bazel_invocation" edge to the BazelInvocation entity.
+func (scu *SearchChunkUpdate) ClearBazelInvocation() *SearchChunkUpdate {
+	scu.mutation.ClearBazelInvocation()
+	return scu
+}
+
+// ClearProblem clears the "problem" edge to the BazelInvocationProblem entity.
+func (scu *SearchChunkUpdate) ClearProblem() *SearchChunkUpdate {
+	scu.mutation.ClearProblem()
+	return scu
+}
+
+// ClearBlob clears the "blob" edge to the Blob entity.
+func (scu *SearchChunkUpdate) ClearBlob() *SearchChunkUpdate {
+	scu.mutation.ClearBlob()
+	return scu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (scu *SearchChunkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, scu.sqlSave, scu.mutation, scu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scu *SearchChunkUpdate) SaveX(ctx context.Context) int {
+	affected, err := scu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (scu *SearchChunkUpdate) Exec(ctx context.Context) error {
+	_, err := scu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scu *SearchChunkUpdate) ExecX(ctx context.Context) {
+	if err := scu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (scu *SearchChunkUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(searchchunk.Table, searchchunk.Columns, sqlgraph.NewFieldSpec(searchchunk.FieldID, field.TypeInt))
+	if ps := scu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if scu.mutation.BazelInvocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   searchchunk.BazelInvocationTable,
+			Columns: []string{searchchunk.BazelInvocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scu.mutation.BazelInvocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   searchchunk.BazelInvocationTable,
+			Columns: []string{searchchunk.BazelInvocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if scu.mutation.ProblemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   searchchunk.ProblemTable,
+			Columns: []string{searchchunk.ProblemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocationproblem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scu.mutation.ProblemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   searchchunk.ProblemTable,
+			Columns: []string{searchchunk.ProblemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocationproblem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if scu.mutation.BlobCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   searchchunk.BlobTable,
+			Columns: []string{searchchunk.BlobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blob.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scu.mutation.BlobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   searchchunk.BlobTable,
+			Columns: []string{searchchunk.BlobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, scu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{searchchunk.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	scu.mutation.done = true
+	return n, nil
+}
+
+// SearchChunkUpdateOne is the builder for updating a single SearchChunk entity.
+type SearchChunkUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SearchChunkMutation
+}
+
+// SetBazelInvocationID sets the "bazel_invocation" edge to the BazelInvocation entity by ID.
+func (scuo *SearchChunkUpdateOne) SetBazelInvocationID(id int) *SearchChunkUpdateOne {
+	scuo.mutation.SetBazelInvocationID(id)
+	return scuo
+}
+
+// SetNillableBazelInvocationID sets the "bazel_invocation" edge to the BazelInvocation entity by ID if the given value is not nil.
+func (scuo *SearchChunkUpdateOne) SetNillableBazelInvocationID(id *int) *SearchChunkUpdateOne {
+	if id != nil {
+		scuo = scuo.SetBazelInvocationID(*id)
+	}
+	return scuo
+}
+
+// SetBazelInvocation sets the "bazel_invocation" edge to the BazelInvocation entity.
+func (scuo *SearchChunkUpdateOne) SetBazelInvocation(b *BazelInvocation) *SearchChunkUpdateOne {
+	return scuo.SetBazelInvocationID(b.ID)
+}
+
+// SetProblemID sets the "problem" edge to the BazelInvocationProblem entity by ID.
+func (scuo *SearchChunkUpdateOne) SetProblemID(id int) *SearchChunkUpdateOne {
+	scuo.mutation.SetProblemID(id)
+	return scuo
+}
+
+// SetNillableProblemID sets the "problem" edge to the BazelInvocationProblem entity by ID if the given value is not nil.
+func (scuo *SearchChunkUpdateOne) SetNillableProblemID(id *int) *SearchChunkUpdateOne {
+	if id != nil {
+		scuo = scuo.SetProblemID(*id)
+	}
+	return scuo
+}
+
+// SetProblem sets the "problem" edge to the BazelInvocationProblem entity.
+func (scuo *SearchChunkUpdateOne) SetProblem(b *BazelInvocationProblem) *SearchChunkUpdateOne {
+	return scuo.SetProblemID(b.ID)
+}
+
+// SetBlobID sets the "blob" edge to the Blob entity by ID.
+func (scuo *SearchChunkUpdateOne) SetBlobID(id int) *SearchChunkUpdateOne {
+	scuo.mutation.SetBlobID(id)
+	return scuo
+}
+
+// SetNillableBlobID sets the "blob" edge to the Blob entity by ID if the given value is not nil.
+func (scuo *SearchChunkUpdateOne) SetNillableBlobID(id *int) *SearchChunkUpdateOne {
+	if id != nil {
+		scuo = scuo.SetBlobID(*id)
+	}
+	return scuo
+}
+
+// SetBlob sets the "blob" edge to the Blob entity.
+func (scuo *SearchChunkUpdateOne) SetBlob(b *Blob) *SearchChunkUpdateOne {
+	return scuo.SetBlobID(b.ID)
+}
+
+// Mutation returns the SearchChunkMutation object of the builder.
+func (scuo *SearchChunkUpdateOne) Mutation() *SearchChunkMutation {
+	return scuo.mutation
+}
+
+// ClearBazelInvocation clears the "bazel_invocation" edge to the BazelInvocation entity.
+func (scuo *SearchChunkUpdateOne) ClearBazelInvocation() *SearchChunkUpdateOne {
+	scuo.mutation.ClearBazelInvocation()
+	return scuo
+}
+
+// ClearProblem clears the "problem" edge to the BazelInvocationProblem entity.
+func (scuo *SearchChunkUpdateOne) ClearProblem() *SearchChunkUpdateOne {
+	scuo.mutation.ClearProblem()
+	return scuo
+}
+
+// ClearBlob clears the "blob" edge to the Blob entity.
+func (scuo *SearchChunkUpdateOne) ClearBlob() *SearchChunkUpdateOne {
+	scuo.mutation.ClearBlob()
+	return scuo
+}
+
+// Where appends a list predicates to the SearchChunkUpdate builder.
+func (scuo *SearchChunkUpdateOne) Where(ps ...predicate.SearchChunk) *SearchChunkUpdateOne {
+	scuo.mutation.Where(ps...)
+	return scuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (scuo *SearchChunkUpdateOne) Select(field string, fields ...string) *SearchChunkUpdateOne {
+	scuo.fields = append([]string{field}, fields...)
+	return scuo
+}
+
+// Save executes the query and returns the updated SearchChunk entity.
+func (scuo *SearchChunkUpdateOne) Save(ctx context.Context) (*SearchChunk, error) {
+	return withHooks(ctx, scuo.sqlSave, scuo.mutation, scuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scuo *SearchChunkUpdateOne) SaveX(ctx context.Context) *SearchChunk {
+	node, err := scuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (scuo *SearchChunkUpdateOne) Exec(ctx context.Context) error {
+	_, err := scuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scuo *SearchChunkUpdateOne) ExecX(ctx context.Context) {
+	if err := scuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (scuo *SearchChunkUpdateOne) sqlSave(ctx context.Context) (_node *SearchChunk, err error) {
+	_spec := sqlgraph.NewUpdateSpec(searchchunk.Table, searchchunk.Columns, sqlgraph.NewFieldSpec(searchchunk.FieldID, field.TypeInt))
+	id, ok := scuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SearchChunk.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := scuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, searchchunk.FieldID)
+		for _, f := range fields {
+			if !searchchunk.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != searchchunk.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := scuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if scuo.mutation.BazelInvocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   searchchunk.BazelInvocationTable,
+			Columns: []string{searchchunk.BazelInvocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scuo.mutation.BazelInvocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   searchchunk.BazelInvocationTable,
+			Columns: []string{searchchunk.BazelInvocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if scuo.mutation.ProblemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   searchchunk.ProblemTable,
+			Columns: []string{searchchunk.ProblemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocationproblem.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scuo.mutation.ProblemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   searchchunk.ProblemTable,
+			Columns: []string{searchchunk.ProblemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocationproblem.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if scuo.mutation.BlobCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   searchchunk.BlobTable,
+			Columns: []string{searchchunk.BlobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blob.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := scuo.mutation.BlobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   searchchunk.BlobTable,
+			Columns: []string{searchchunk.BlobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blob.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SearchChunk{config: scuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, scuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{searchchunk.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	scuo.mutation.done = true
+	return _node, nil
+}
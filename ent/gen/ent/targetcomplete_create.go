@@ -4,8 +4,10 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/outputgroup"
@@ -19,6 +21,7 @@ type TargetCompleteCreate struct {
 	config
 	mutation *TargetCompleteMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetSuccess sets the "success" field.
@@ -240,6 +243,7 @@ func (tcc *TargetCompleteCreate) createSpec() (*TargetComplete, *sqlgraph.Create
 		_node = &TargetComplete{config: tcc.config}
 		_spec = sqlgraph.NewCreateSpec(targetcomplete.Table, sqlgraph.NewFieldSpec(targetcomplete.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = tcc.conflict
 	if value, ok := tcc.mutation.Success(); ok {
 		_spec.SetField(targetcomplete.FieldSuccess, field.TypeBool, value)
 		_node.Success = value
@@ -336,11 +340,446 @@ func (tcc *TargetCompleteCreate) createSpec() (*TargetComplete, *sqlgraph.Create
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TargetComplete.Create().
+//		SetSuccess(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TargetCompleteUpsert) {
+//			SetSuccess(v+v).
+//		}).
+//		Exec(ctx)
+func (tcc *TargetCompleteCreate) OnConflict(opts ...sql.ConflictOption) *TargetCompleteUpsertOne {
+	tcc.conflict = opts
+	return &TargetCompleteUpsertOne{
+		create: tcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TargetComplete.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tcc *TargetCompleteCreate) OnConflictColumns(columns ...string) *TargetCompleteUpsertOne {
+	tcc.conflict = append(tcc.conflict, sql.ConflictColumns(columns...))
+	return &TargetCompleteUpsertOne{
+		create: tcc,
+	}
+}
+
+type (
+	// TargetCompleteUpsertOne is the builder for "upsert"-ing
+	//  one TargetComplete node.
+	TargetCompleteUpsertOne struct {
+		create *TargetCompleteCreate
+	}
+
+	// TargetCompleteUpsert is the "OnConflict" setter.
+	TargetCompleteUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetSuccess sets the "success" field.
+func (u *TargetCompleteUpsert) SetSuccess(v bool) *TargetCompleteUpsert {
+	u.Set(targetcomplete.FieldSuccess, v)
+	return u
+}
+
+// UpdateSuccess sets the "success" field to the value that was provided on create.
+func (u *TargetCompleteUpsert) UpdateSuccess() *TargetCompleteUpsert {
+	u.SetExcluded(targetcomplete.FieldSuccess)
+	return u
+}
+
+// ClearSuccess clears the value of the "success" field.
+func (u *TargetCompleteUpsert) ClearSuccess() *TargetCompleteUpsert {
+	u.SetNull(targetcomplete.FieldSuccess)
+	return u
+}
+
+// SetTag sets the "tag" field.
+func (u *TargetCompleteUpsert) SetTag(v []string) *TargetCompleteUpsert {
+	u.Set(targetcomplete.FieldTag, v)
+	return u
+}
+
+// UpdateTag sets the "tag" field to the value that was provided on create.
+func (u *TargetCompleteUpsert) UpdateTag() *TargetCompleteUpsert {
+	u.SetExcluded(targetcomplete.FieldTag)
+	return u
+}
+
+// ClearTag clears the value of the "tag" field.
+func (u *TargetCompleteUpsert) ClearTag() *TargetCompleteUpsert {
+	u.SetNull(targetcomplete.FieldTag)
+	return u
+}
+
+// SetTargetKind sets the "target_kind" field.
+func (u *TargetCompleteUpsert) SetTargetKind(v string) *TargetCompleteUpsert {
+	u.Set(targetcomplete.FieldTargetKind, v)
+	return u
+}
+
+// UpdateTargetKind sets the "target_kind" field to the value that was provided on create.
+func (u *TargetCompleteUpsert) UpdateTargetKind() *TargetCompleteUpsert {
+	u.SetExcluded(targetcomplete.FieldTargetKind)
+	return u
+}
+
+// ClearTargetKind clears the value of the "target_kind" field.
+func (u *TargetCompleteUpsert) ClearTargetKind() *TargetCompleteUpsert {
+	u.SetNull(targetcomplete.FieldTargetKind)
+	return u
+}
+
+// SetEndTimeInMs sets the "end_time_in_ms" field.
+func (u *TargetCompleteUpsert) SetEndTimeInMs(v int64) *TargetCompleteUpsert {
+	u.Set(targetcomplete.FieldEndTimeInMs, v)
+	return u
+}
+
+// UpdateEndTimeInMs sets the "end_time_in_ms" field to the value that was provided on create.
+func (u *TargetCompleteUpsert) UpdateEndTimeInMs() *TargetCompleteUpsert {
+	u.SetExcluded(targetcomplete.FieldEndTimeInMs)
+	return u
+}
+
+// AddEndTimeInMs adds v to the "end_time_in_ms" field.
+func (u *TargetCompleteUpsert) AddEndTimeInMs(v int64) *TargetCompleteUpsert {
+	u.Add(targetcomplete.FieldEndTimeInMs, v)
+	return u
+}
+
+// ClearEndTimeInMs clears the value of the "end_time_in_ms" field.
+func (u *TargetCompleteUpsert) ClearEndTimeInMs() *TargetCompleteUpsert {
+	u.SetNull(targetcomplete.FieldEndTimeInMs)
+	return u
+}
+
+// SetTestTimeoutSeconds sets the "test_timeout_seconds" field.
+func (u *TargetCompleteUpsert) SetTestTimeoutSeconds(v int64) *TargetCompleteUpsert {
+	u.Set(targetcomplete.FieldTestTimeoutSeconds, v)
+	return u
+}
+
+// UpdateTestTimeoutSeconds sets the "test_timeout_seconds" field to the value that was provided on create.
+func (u *TargetCompleteUpsert) UpdateTestTimeoutSeconds() *TargetCompleteUpsert {
+	u.SetExcluded(targetcomplete.FieldTestTimeoutSeconds)
+	return u
+}
+
+// AddTestTimeoutSeconds adds v to the "test_timeout_seconds" field.
+func (u *TargetCompleteUpsert) AddTestTimeoutSeconds(v int64) *TargetCompleteUpsert {
+	u.Add(targetcomplete.FieldTestTimeoutSeconds, v)
+	return u
+}
+
+// ClearTestTimeoutSeconds clears the value of the "test_timeout_seconds" field.
+func (u *TargetCompleteUpsert) ClearTestTimeoutSeconds() *TargetCompleteUpsert {
+	u.SetNull(targetcomplete.FieldTestTimeoutSeconds)
+	return u
+}
+
+// SetTestTimeout sets the "test_timeout" field.
+func (u *TargetCompleteUpsert) SetTestTimeout(v int64) *TargetCompleteUpsert {
+	u.Set(targetcomplete.FieldTestTimeout, v)
+	return u
+}
+
+// UpdateTestTimeout sets the "test_timeout" field to the value that was provided on create.
+func (u *TargetCompleteUpsert) UpdateTestTimeout() *TargetCompleteUpsert {
+	u.SetExcluded(targetcomplete.FieldTestTimeout)
+	return u
+}
+
+// AddTestTimeout adds v to the "test_timeout" field.
+func (u *TargetCompleteUpsert) AddTestTimeout(v int64) *TargetCompleteUpsert {
+	u.Add(targetcomplete.FieldTestTimeout, v)
+	return u
+}
+
+// ClearTestTimeout clears the value of the "test_timeout" field.
+func (u *TargetCompleteUpsert) ClearTestTimeout() *TargetCompleteUpsert {
+	u.SetNull(targetcomplete.FieldTestTimeout)
+	return u
+}
+
+// SetTestSize sets the "test_size" field.
+func (u *TargetCompleteUpsert) SetTestSize(v targetcomplete.TestSize) *TargetCompleteUpsert {
+	u.Set(targetcomplete.FieldTestSize, v)
+	return u
+}
+
+// UpdateTestSize sets the "test_size" field to the value that was provided on create.
+func (u *TargetCompleteUpsert) UpdateTestSize() *TargetCompleteUpsert {
+	u.SetExcluded(targetcomplete.FieldTestSize)
+	return u
+}
+
+// ClearTestSize clears the value of the "test_size" field.
+func (u *TargetCompleteUpsert) ClearTestSize() *TargetCompleteUpsert {
+	u.SetNull(targetcomplete.FieldTestSize)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TargetComplete.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TargetCompleteUpsertOne) UpdateNewValues() *TargetCompleteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TargetComplete.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TargetCompleteUpsertOne) Ignore() *TargetCompleteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TargetCompleteUpsertOne) DoNothing() *TargetCompleteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TargetCompleteCreate.OnConflict
+// documentation for more info.
+func (u *TargetCompleteUpsertOne) Update(set func(*TargetCompleteUpsert)) *TargetCompleteUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TargetCompleteUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSuccess sets the "success" field.
+func (u *TargetCompleteUpsertOne) SetSuccess(v bool) *TargetCompleteUpsertOne {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.SetSuccess(v)
+	})
+}
+
+// UpdateSuccess sets the "success" field to the value that was provided on create.
+func (u *TargetCompleteUpsertOne) UpdateSuccess() *TargetCompleteUpsertOne {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.UpdateSuccess()
+	})
+}
+
+// ClearSuccess clears the value of the "success" field.
+func (u *TargetCompleteUpsertOne) ClearSuccess() *TargetCompleteUpsertOne {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.ClearSuccess()
+	})
+}
+
+// SetTag sets the "tag" field.
+func (u *TargetCompleteUpsertOne) SetTag(v []string) *TargetCompleteUpsertOne {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.SetTag(v)
+	})
+}
+
+// UpdateTag sets the "tag" field to the value that was provided on create.
+func (u *TargetCompleteUpsertOne) UpdateTag() *TargetCompleteUpsertOne {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.UpdateTag()
+	})
+}
+
+// ClearTag clears the value of the "tag" field.
+func (u *TargetCompleteUpsertOne) ClearTag() *TargetCompleteUpsertOne {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.ClearTag()
+	})
+}
+
+// SetTargetKind sets the "target_kind" field.
+func (u *TargetCompleteUpsertOne) SetTargetKind(v string) *TargetCompleteUpsertOne {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.SetTargetKind(v)
+	})
+}
+
+// UpdateTargetKind sets the "target_kind" field to the value that was provided on create.
+func (u *TargetCompleteUpsertOne) UpdateTargetKind() *TargetCompleteUpsertOne {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.UpdateTargetKind()
+	})
+}
+
+// ClearTargetKind clears the value of the "target_kind" field.
+func (u *TargetCompleteUpsertOne) ClearTargetKind() *TargetCompleteUpsertOne {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.ClearTargetKind()
+	})
+}
+
+// SetEndTimeInMs sets the "end_time_in_ms" field.
+func (u *TargetCompleteUpsertOne) SetEndTimeInMs(v int64) *TargetCompleteUpsertOne {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.SetEndTimeInMs(v)
+	})
+}
+
+// AddEndTimeInMs adds v to the "end_time_in_ms" field.
+func (u *TargetCompleteUpsertOne) AddEndTimeInMs(v int64) *TargetCompleteUpsertOne {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.AddEndTimeInMs(v)
+	})
+}
+
+// UpdateEndTimeInMs sets the "end_time_in_ms" field to the value that was provided on create.
+func (u *TargetCompleteUpsertOne) UpdateEndTimeInMs() *TargetCompleteUpsertOne {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.UpdateEndTimeInMs()
+	})
+}
+
+// ClearEndTimeInMs clears the value of the "end_time_in_ms" field.
+func (u *TargetCompleteUpsertOne) ClearEndTimeInMs() *TargetCompleteUpsertOne {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.ClearEndTimeInMs()
+	})
+}
+
+// SetTestTimeoutSeconds sets the "test_timeout_seconds" field.
+func (u *TargetCompleteUpsertOne) SetTestTimeoutSeconds(v int64) *TargetCompleteUpsertOne {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.SetTestTimeoutSeconds(v)
+	})
+}
+
+// AddTestTimeoutSeconds adds v to the "test_timeout_seconds" field.
+func (u *TargetCompleteUpsertOne) AddTestTimeoutSeconds(v int64) *TargetCompleteUpsertOne {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.AddTestTimeoutSeconds(v)
+	})
+}
+
+// UpdateTestTimeoutSeconds sets the "test_timeout_seconds" field to the value that was provided on create.
+func (u *TargetCompleteUpsertOne) UpdateTestTimeoutSeconds() *TargetCompleteUpsertOne {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.UpdateTestTimeoutSeconds()
+	})
+}
+
+// ClearTestTimeoutSeconds clears the value of the "test_timeout_seconds" field.
+func (u *TargetCompleteUpsertOne) ClearTestTimeoutSeconds() *TargetCompleteUpsertOne {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.ClearTestTimeoutSeconds()
+	})
+}
+
+// SetTestTimeout sets the "test_timeout" field.
+func (u *TargetCompleteUpsertOne) SetTestTimeout(v int64) *TargetCompleteUpsertOne {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.SetTestTimeout(v)
+	})
+}
+
+// AddTestTimeout adds v to the "test_timeout" field.
+func (u *TargetCompleteUpsertOne) AddTestTimeout(v int64) *TargetCompleteUpsertOne {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.AddTestTimeout(v)
+	})
+}
+
+// UpdateTestTimeout sets the "test_timeout" field to the value that was provided on create.
+func (u *TargetCompleteUpsertOne) UpdateTestTimeout() *TargetCompleteUpsertOne {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.UpdateTestTimeout()
+	})
+}
+
+// ClearTestTimeout clears the value of the "test_timeout" field.
+func (u *TargetCompleteUpsertOne) ClearTestTimeout() *TargetCompleteUpsertOne {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.ClearTestTimeout()
+	})
+}
+
+// SetTestSize sets the "test_size" field.
+func (u *TargetCompleteUpsertOne) SetTestSize(v targetcomplete.TestSize) *TargetCompleteUpsertOne {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.SetTestSize(v)
+	})
+}
+
+// UpdateTestSize sets the "test_size" field to the value that was provided on create.
+func (u *TargetCompleteUpsertOne) UpdateTestSize() *TargetCompleteUpsertOne {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.UpdateTestSize()
+	})
+}
+
+// ClearTestSize clears the value of the "test_size" field.
+func (u *TargetCompleteUpsertOne) ClearTestSize() *TargetCompleteUpsertOne {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.ClearTestSize()
+	})
+}
+
+// Exec executes the query.
+func (u *TargetCompleteUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TargetCompleteCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TargetCompleteUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TargetCompleteUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TargetCompleteUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // TargetCompleteCreateBulk is the builder for creating many TargetComplete entities in bulk.
 type TargetCompleteCreateBulk struct {
 	config
 	err      error
 	builders []*TargetCompleteCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the TargetComplete entities in the database.
@@ -369,6 +808,7 @@ func (tccb *TargetCompleteCreateBulk) Save(ctx context.Context) ([]*TargetComple
 					_, err = mutators[i+1].Mutate(root, tccb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tccb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, tccb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -422,3 +862,275 @@ func (tccb *TargetCompleteCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TargetComplete.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TargetCompleteUpsert) {
+//			SetSuccess(v+v).
+//		}).
+//		Exec(ctx)
+func (tccb *TargetCompleteCreateBulk) OnConflict(opts ...sql.ConflictOption) *TargetCompleteUpsertBulk {
+	tccb.conflict = opts
+	return &TargetCompleteUpsertBulk{
+		create: tccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TargetComplete.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tccb *TargetCompleteCreateBulk) OnConflictColumns(columns ...string) *TargetCompleteUpsertBulk {
+	tccb.conflict = append(tccb.conflict, sql.ConflictColumns(columns...))
+	return &TargetCompleteUpsertBulk{
+		create: tccb,
+	}
+}
+
+// TargetCompleteUpsertBulk is the builder for "upsert"-ing
+// a bulk of TargetComplete nodes.
+type TargetCompleteUpsertBulk struct {
+	create *TargetCompleteCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TargetComplete.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TargetCompleteUpsertBulk) UpdateNewValues() *TargetCompleteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TargetComplete.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TargetCompleteUpsertBulk) Ignore() *TargetCompleteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TargetCompleteUpsertBulk) DoNothing() *TargetCompleteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TargetCompleteCreateBulk.OnConflict
+// documentation for more info.
+func (u *TargetCompleteUpsertBulk) Update(set func(*TargetCompleteUpsert)) *TargetCompleteUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TargetCompleteUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSuccess sets the "success" field.
+func (u *TargetCompleteUpsertBulk) SetSuccess(v bool) *TargetCompleteUpsertBulk {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.SetSuccess(v)
+	})
+}
+
+// UpdateSuccess sets the "success" field to the value that was provided on create.
+func (u *TargetCompleteUpsertBulk) UpdateSuccess() *TargetCompleteUpsertBulk {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.UpdateSuccess()
+	})
+}
+
+// ClearSuccess clears the value of the "success" field.
+func (u *TargetCompleteUpsertBulk) ClearSuccess() *TargetCompleteUpsertBulk {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.ClearSuccess()
+	})
+}
+
+// SetTag sets the "tag" field.
+func (u *TargetCompleteUpsertBulk) SetTag(v []string) *TargetCompleteUpsertBulk {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.SetTag(v)
+	})
+}
+
+// UpdateTag sets the "tag" field to the value that was provided on create.
+func (u *TargetCompleteUpsertBulk) UpdateTag() *TargetCompleteUpsertBulk {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.UpdateTag()
+	})
+}
+
+// ClearTag clears the value of the "tag" field.
+func (u *TargetCompleteUpsertBulk) ClearTag() *TargetCompleteUpsertBulk {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.ClearTag()
+	})
+}
+
+// SetTargetKind sets the "target_kind" field.
+func (u *TargetCompleteUpsertBulk) SetTargetKind(v string) *TargetCompleteUpsertBulk {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.SetTargetKind(v)
+	})
+}
+
+// UpdateTargetKind sets the "target_kind" field to the value that was provided on create.
+func (u *TargetCompleteUpsertBulk) UpdateTargetKind() *TargetCompleteUpsertBulk {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.UpdateTargetKind()
+	})
+}
+
+// ClearTargetKind clears the value of the "target_kind" field.
+func (u *TargetCompleteUpsertBulk) ClearTargetKind() *TargetCompleteUpsertBulk {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.ClearTargetKind()
+	})
+}
+
+// SetEndTimeInMs sets the "end_time_in_ms" field.
+func (u *TargetCompleteUpsertBulk) SetEndTimeInMs(v int64) *TargetCompleteUpsertBulk {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.SetEndTimeInMs(v)
+	})
+}
+
+// AddEndTimeInMs adds v to the "end_time_in_ms" field.
+func (u *TargetCompleteUpsertBulk) AddEndTimeInMs(v int64) *TargetCompleteUpsertBulk {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.AddEndTimeInMs(v)
+	})
+}
+
+// UpdateEndTimeInMs sets the "end_time_in_ms" field to the value that was provided on create.
+func (u *TargetCompleteUpsertBulk) UpdateEndTimeInMs() *TargetCompleteUpsertBulk {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.UpdateEndTimeInMs()
+	})
+}
+
+// ClearEndTimeInMs clears the value of the "end_time_in_ms" field.
+func (u *TargetCompleteUpsertBulk) ClearEndTimeInMs() *TargetCompleteUpsertBulk {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.ClearEndTimeInMs()
+	})
+}
+
+// SetTestTimeoutSeconds sets the "test_timeout_seconds" field.
+func (u *TargetCompleteUpsertBulk) SetTestTimeoutSeconds(v int64) *TargetCompleteUpsertBulk {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.SetTestTimeoutSeconds(v)
+	})
+}
+
+// AddTestTimeoutSeconds adds v to the "test_timeout_seconds" field.
+func (u *TargetCompleteUpsertBulk) AddTestTimeoutSeconds(v int64) *TargetCompleteUpsertBulk {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.AddTestTimeoutSeconds(v)
+	})
+}
+
+// UpdateTestTimeoutSeconds sets the "test_timeout_seconds" field to the value that was provided on create.
+func (u *TargetCompleteUpsertBulk) UpdateTestTimeoutSeconds() *TargetCompleteUpsertBulk {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.UpdateTestTimeoutSeconds()
+	})
+}
+
+// ClearTestTimeoutSeconds clears the value of the "test_timeout_seconds" field.
+func (u *TargetCompleteUpsertBulk) ClearTestTimeoutSeconds() *TargetCompleteUpsertBulk {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.ClearTestTimeoutSeconds()
+	})
+}
+
+// SetTestTimeout sets the "test_timeout" field.
+func (u *TargetCompleteUpsertBulk) SetTestTimeout(v int64) *TargetCompleteUpsertBulk {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.SetTestTimeout(v)
+	})
+}
+
+// AddTestTimeout adds v to the "test_timeout" field.
+func (u *TargetCompleteUpsertBulk) AddTestTimeout(v int64) *TargetCompleteUpsertBulk {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.AddTestTimeout(v)
+	})
+}
+
+// UpdateTestTimeout sets the "test_timeout" field to the value that was provided on create.
+func (u *TargetCompleteUpsertBulk) UpdateTestTimeout() *TargetCompleteUpsertBulk {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.UpdateTestTimeout()
+	})
+}
+
+// ClearTestTimeout clears the value of the "test_timeout" field.
+func (u *TargetCompleteUpsertBulk) ClearTestTimeout() *TargetCompleteUpsertBulk {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.ClearTestTimeout()
+	})
+}
+
+// SetTestSize sets the "test_size" field.
+func (u *TargetCompleteUpsertBulk) SetTestSize(v targetcomplete.TestSize) *TargetCompleteUpsertBulk {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.SetTestSize(v)
+	})
+}
+
+// UpdateTestSize sets the "test_size" field to the value that was provided on create.
+func (u *TargetCompleteUpsertBulk) UpdateTestSize() *TargetCompleteUpsertBulk {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.UpdateTestSize()
+	})
+}
+
+// ClearTestSize clears the value of the "test_size" field.
+func (u *TargetCompleteUpsertBulk) ClearTestSize() *TargetCompleteUpsertBulk {
+	return u.Update(func(s *TargetCompleteUpsert) {
+		s.ClearTestSize()
+	})
+}
+
+// Exec executes the query.
+func (u *TargetCompleteUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TargetCompleteCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TargetCompleteCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TargetCompleteUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -4,8 +4,10 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/targetconfigured"
@@ -17,6 +19,7 @@ type TargetConfiguredCreate struct {
 	config
 	mutation *TargetConfiguredMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetTag sets the "tag" field.
@@ -147,6 +150,7 @@ func (tcc *TargetConfiguredCreate) createSpec() (*TargetConfigured, *sqlgraph.Cr
 		_node = &TargetConfigured{config: tcc.config}
 		_spec = sqlgraph.NewCreateSpec(targetconfigured.Table, sqlgraph.NewFieldSpec(targetconfigured.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = tcc.conflict
 	if value, ok := tcc.mutation.Tag(); ok {
 		_spec.SetField(targetconfigured.FieldTag, field.TypeJSON, value)
 		_node.Tag = value
@@ -182,11 +186,303 @@ func (tcc *TargetConfiguredCreate) createSpec() (*TargetConfigured, *sqlgraph.Cr
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TargetConfigured.Create().
+//		SetTag(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TargetConfiguredUpsert) {
+//			SetTag(v+v).
+//		}).
+//		Exec(ctx)
+func (tcc *TargetConfiguredCreate) OnConflict(opts ...sql.ConflictOption) *TargetConfiguredUpsertOne {
+	tcc.conflict = opts
+	return &TargetConfiguredUpsertOne{
+		create: tcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TargetConfigured.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tcc *TargetConfiguredCreate) OnConflictColumns(columns ...string) *TargetConfiguredUpsertOne {
+	tcc.conflict = append(tcc.conflict, sql.ConflictColumns(columns...))
+	return &TargetConfiguredUpsertOne{
+		create: tcc,
+	}
+}
+
+type (
+	// TargetConfiguredUpsertOne is the builder for "upsert"-ing
+	//  one TargetConfigured node.
+	TargetConfiguredUpsertOne struct {
+		create *TargetConfiguredCreate
+	}
+
+	// TargetConfiguredUpsert is the "OnConflict" setter.
+	TargetConfiguredUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTag sets the "tag" field.
+func (u *TargetConfiguredUpsert) SetTag(v []string) *TargetConfiguredUpsert {
+	u.Set(targetconfigured.FieldTag, v)
+	return u
+}
+
+// UpdateTag sets the "tag" field to the value that was provided on create.
+func (u *TargetConfiguredUpsert) UpdateTag() *TargetConfiguredUpsert {
+	u.SetExcluded(targetconfigured.FieldTag)
+	return u
+}
+
+// ClearTag clears the value of the "tag" field.
+func (u *TargetConfiguredUpsert) ClearTag() *TargetConfiguredUpsert {
+	u.SetNull(targetconfigured.FieldTag)
+	return u
+}
+
+// SetTargetKind sets the "target_kind" field.
+func (u *TargetConfiguredUpsert) SetTargetKind(v string) *TargetConfiguredUpsert {
+	u.Set(targetconfigured.FieldTargetKind, v)
+	return u
+}
+
+// UpdateTargetKind sets the "target_kind" field to the value that was provided on create.
+func (u *TargetConfiguredUpsert) UpdateTargetKind() *TargetConfiguredUpsert {
+	u.SetExcluded(targetconfigured.FieldTargetKind)
+	return u
+}
+
+// ClearTargetKind clears the value of the "target_kind" field.
+func (u *TargetConfiguredUpsert) ClearTargetKind() *TargetConfiguredUpsert {
+	u.SetNull(targetconfigured.FieldTargetKind)
+	return u
+}
+
+// SetStartTimeInMs sets the "start_time_in_ms" field.
+func (u *TargetConfiguredUpsert) SetStartTimeInMs(v int64) *TargetConfiguredUpsert {
+	u.Set(targetconfigured.FieldStartTimeInMs, v)
+	return u
+}
+
+// UpdateStartTimeInMs sets the "start_time_in_ms" field to the value that was provided on create.
+func (u *TargetConfiguredUpsert) UpdateStartTimeInMs() *TargetConfiguredUpsert {
+	u.SetExcluded(targetconfigured.FieldStartTimeInMs)
+	return u
+}
+
+// AddStartTimeInMs adds v to the "start_time_in_ms" field.
+func (u *TargetConfiguredUpsert) AddStartTimeInMs(v int64) *TargetConfiguredUpsert {
+	u.Add(targetconfigured.FieldStartTimeInMs, v)
+	return u
+}
+
+// ClearStartTimeInMs clears the value of the "start_time_in_ms" field.
+func (u *TargetConfiguredUpsert) ClearStartTimeInMs() *TargetConfiguredUpsert {
+	u.SetNull(targetconfigured.FieldStartTimeInMs)
+	return u
+}
+
+// SetTestSize sets the "test_size" field.
+func (u *TargetConfiguredUpsert) SetTestSize(v targetconfigured.TestSize) *TargetConfiguredUpsert {
+	u.Set(targetconfigured.FieldTestSize, v)
+	return u
+}
+
+// UpdateTestSize sets the "test_size" field to the value that was provided on create.
+func (u *TargetConfiguredUpsert) UpdateTestSize() *TargetConfiguredUpsert {
+	u.SetExcluded(targetconfigured.FieldTestSize)
+	return u
+}
+
+// ClearTestSize clears the value of the "test_size" field.
+func (u *TargetConfiguredUpsert) ClearTestSize() *TargetConfiguredUpsert {
+	u.SetNull(targetconfigured.FieldTestSize)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TargetConfigured.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TargetConfiguredUpsertOne) UpdateNewValues() *TargetConfiguredUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TargetConfigured.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TargetConfiguredUpsertOne) Ignore() *TargetConfiguredUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TargetConfiguredUpsertOne) DoNothing() *TargetConfiguredUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TargetConfiguredCreate.OnConflict
+// documentation for more info.
+func (u *TargetConfiguredUpsertOne) Update(set func(*TargetConfiguredUpsert)) *TargetConfiguredUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TargetConfiguredUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTag sets the "tag" field.
+func (u *TargetConfiguredUpsertOne) SetTag(v []string) *TargetConfiguredUpsertOne {
+	return u.Update(func(s *TargetConfiguredUpsert) {
+		s.SetTag(v)
+	})
+}
+
+// UpdateTag sets the "tag" field to the value that was provided on create.
+func (u *TargetConfiguredUpsertOne) UpdateTag() *TargetConfiguredUpsertOne {
+	return u.Update(func(s *TargetConfiguredUpsert) {
+		s.UpdateTag()
+	})
+}
+
+// ClearTag clears the value of the "tag" field.
+func (u *TargetConfiguredUpsertOne) ClearTag() *TargetConfiguredUpsertOne {
+	return u.Update(func(s *TargetConfiguredUpsert) {
+		s.ClearTag()
+	})
+}
+
+// SetTargetKind sets the "target_kind" field.
+func (u *TargetConfiguredUpsertOne) SetTargetKind(v string) *TargetConfiguredUpsertOne {
+	return u.Update(func(s *TargetConfiguredUpsert) {
+		s.SetTargetKind(v)
+	})
+}
+
+// UpdateTargetKind sets the "target_kind" field to the value that was provided on create.
+func (u *TargetConfiguredUpsertOne) UpdateTargetKind() *TargetConfiguredUpsertOne {
+	return u.Update(func(s *TargetConfiguredUpsert) {
+		s.UpdateTargetKind()
+	})
+}
+
+// ClearTargetKind clears the value of the "target_kind" field.
+func (u *TargetConfiguredUpsertOne) ClearTargetKind() *TargetConfiguredUpsertOne {
+	return u.Update(func(s *TargetConfiguredUpsert) {
+		s.ClearTargetKind()
+	})
+}
+
+// SetStartTimeInMs sets the "start_time_in_ms" field.
+func (u *TargetConfiguredUpsertOne) SetStartTimeInMs(v int64) *TargetConfiguredUpsertOne {
+	return u.Update(func(s *TargetConfiguredUpsert) {
+		s.SetStartTimeInMs(v)
+	})
+}
+
+// AddStartTimeInMs adds v to the "start_time_in_ms" field.
+func (u *TargetConfiguredUpsertOne) AddStartTimeInMs(v int64) *TargetConfiguredUpsertOne {
+	return u.Update(func(s *TargetConfiguredUpsert) {
+		s.AddStartTimeInMs(v)
+	})
+}
+
+// UpdateStartTimeInMs sets the "start_time_in_ms" field to the value that was provided on create.
+func (u *TargetConfiguredUpsertOne) UpdateStartTimeInMs() *TargetConfiguredUpsertOne {
+	return u.Update(func(s *TargetConfiguredUpsert) {
+		s.UpdateStartTimeInMs()
+	})
+}
+
+// ClearStartTimeInMs clears the value of the "start_time_in_ms" field.
+func (u *TargetConfiguredUpsertOne) ClearStartTimeInMs() *TargetConfiguredUpsertOne {
+	return u.Update(func(s *TargetConfiguredUpsert) {
+		s.ClearStartTimeInMs()
+	})
+}
+
+// SetTestSize sets the "test_size" field.
+func (u *TargetConfiguredUpsertOne) SetTestSize(v targetconfigured.TestSize) *TargetConfiguredUpsertOne {
+	return u.Update(func(s *TargetConfiguredUpsert) {
+		s.SetTestSize(v)
+	})
+}
+
+// UpdateTestSize sets the "test_size" field to the value that was provided on create.
+func (u *TargetConfiguredUpsertOne) UpdateTestSize() *TargetConfiguredUpsertOne {
+	return u.Update(func(s *TargetConfiguredUpsert) {
+		s.UpdateTestSize()
+	})
+}
+
+// ClearTestSize clears the value of the "test_size" field.
+func (u *TargetConfiguredUpsertOne) ClearTestSize() *TargetConfiguredUpsertOne {
+	return u.Update(func(s *TargetConfiguredUpsert) {
+		s.ClearTestSize()
+	})
+}
+
+// Exec executes the query.
+func (u *TargetConfiguredUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TargetConfiguredCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TargetConfiguredUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TargetConfiguredUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TargetConfiguredUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // TargetConfiguredCreateBulk is the builder for creating many TargetConfigured entities in bulk.
 type TargetConfiguredCreateBulk struct {
 	config
 	err      error
 	builders []*TargetConfiguredCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the TargetConfigured entities in the database.
@@ -215,6 +511,7 @@ func (tccb *TargetConfiguredCreateBulk) Save(ctx context.Context) ([]*TargetConf
 					_, err = mutators[i+1].Mutate(root, tccb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tccb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, tccb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -268,3 +565,198 @@ func (tccb *TargetConfiguredCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TargetConfigured.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TargetConfiguredUpsert) {
+//			SetTag(v+v).
+//		}).
+//		Exec(ctx)
+func (tccb *TargetConfiguredCreateBulk) OnConflict(opts ...sql.ConflictOption) *TargetConfiguredUpsertBulk {
+	tccb.conflict = opts
+	return &TargetConfiguredUpsertBulk{
+		create: tccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TargetConfigured.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tccb *TargetConfiguredCreateBulk) OnConflictColumns(columns ...string) *TargetConfiguredUpsertBulk {
+	tccb.conflict = append(tccb.conflict, sql.ConflictColumns(columns...))
+	return &TargetConfiguredUpsertBulk{
+		create: tccb,
+	}
+}
+
+// TargetConfiguredUpsertBulk is the builder for "upsert"-ing
+// a bulk of TargetConfigured nodes.
+type TargetConfiguredUpsertBulk struct {
+	create *TargetConfiguredCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TargetConfigured.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TargetConfiguredUpsertBulk) UpdateNewValues() *TargetConfiguredUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TargetConfigured.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TargetConfiguredUpsertBulk) Ignore() *TargetConfiguredUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TargetConfiguredUpsertBulk) DoNothing() *TargetConfiguredUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TargetConfiguredCreateBulk.OnConflict
+// documentation for more info.
+func (u *TargetConfiguredUpsertBulk) Update(set func(*TargetConfiguredUpsert)) *TargetConfiguredUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TargetConfiguredUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTag sets the "tag" field.
+func (u *TargetConfiguredUpsertBulk) SetTag(v []string) *TargetConfiguredUpsertBulk {
+	return u.Update(func(s *TargetConfiguredUpsert) {
+		s.SetTag(v)
+	})
+}
+
+// UpdateTag sets the "tag" field to the value that was provided on create.
+func (u *TargetConfiguredUpsertBulk) UpdateTag() *TargetConfiguredUpsertBulk {
+	return u.Update(func(s *TargetConfiguredUpsert) {
+		s.UpdateTag()
+	})
+}
+
+// ClearTag clears the value of the "tag" field.
+func (u *TargetConfiguredUpsertBulk) ClearTag() *TargetConfiguredUpsertBulk {
+	return u.Update(func(s *TargetConfiguredUpsert) {
+		s.ClearTag()
+	})
+}
+
+// SetTargetKind sets the "target_kind" field.
+func (u *TargetConfiguredUpsertBulk) SetTargetKind(v string) *TargetConfiguredUpsertBulk {
+	return u.Update(func(s *TargetConfiguredUpsert) {
+		s.SetTargetKind(v)
+	})
+}
+
+// UpdateTargetKind sets the "target_kind" field to the value that was provided on create.
+func (u *TargetConfiguredUpsertBulk) UpdateTargetKind() *TargetConfiguredUpsertBulk {
+	return u.Update(func(s *TargetConfiguredUpsert) {
+		s.UpdateTargetKind()
+	})
+}
+
+// ClearTargetKind clears the value of the "target_kind" field.
+func (u *TargetConfiguredUpsertBulk) ClearTargetKind() *TargetConfiguredUpsertBulk {
+	return u.Update(func(s *TargetConfiguredUpsert) {
+		s.ClearTargetKind()
+	})
+}
+
+// SetStartTimeInMs sets the "start_time_in_ms" field.
+func (u *TargetConfiguredUpsertBulk) SetStartTimeInMs(v int64) *TargetConfiguredUpsertBulk {
+	return u.Update(func(s *TargetConfiguredUpsert) {
+		s.SetStartTimeInMs(v)
+	})
+}
+
+// AddStartTimeInMs adds v to the "start_time_in_ms" field.
+func (u *TargetConfiguredUpsertBulk) AddStartTimeInMs(v int64) *TargetConfiguredUpsertBulk {
+	return u.Update(func(s *TargetConfiguredUpsert) {
+		s.AddStartTimeInMs(v)
+	})
+}
+
+// UpdateStartTimeInMs sets the "start_time_in_ms" field to the value that was provided on create.
+func (u *TargetConfiguredUpsertBulk) UpdateStartTimeInMs() *TargetConfiguredUpsertBulk {
+	return u.Update(func(s *TargetConfiguredUpsert) {
+		s.UpdateStartTimeInMs()
+	})
+}
+
+// ClearStartTimeInMs clears the value of the "start_time_in_ms" field.
+func (u *TargetConfiguredUpsertBulk) ClearStartTimeInMs() *TargetConfiguredUpsertBulk {
+	return u.Update(func(s *TargetConfiguredUpsert) {
+		s.ClearStartTimeInMs()
+	})
+}
+
+// SetTestSize sets the "test_size" field.
+func (u *TargetConfiguredUpsertBulk) SetTestSize(v targetconfigured.TestSize) *TargetConfiguredUpsertBulk {
+	return u.Update(func(s *TargetConfiguredUpsert) {
+		s.SetTestSize(v)
+	})
+}
+
+// UpdateTestSize sets the "test_size" field to the value that was provided on create.
+func (u *TargetConfiguredUpsertBulk) UpdateTestSize() *TargetConfiguredUpsertBulk {
+	return u.Update(func(s *TargetConfiguredUpsert) {
+		s.UpdateTestSize()
+	})
+}
+
+// ClearTestSize clears the value of the "test_size" field.
+func (u *TargetConfiguredUpsertBulk) ClearTestSize() *TargetConfiguredUpsertBulk {
+	return u.Update(func(s *TargetConfiguredUpsert) {
+		s.ClearTestSize()
+	})
+}
+
+// Exec executes the query.
+func (u *TargetConfiguredUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TargetConfiguredCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TargetConfiguredCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TargetConfiguredUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -4,8 +4,10 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
@@ -19,6 +21,7 @@ type TargetPairCreate struct {
 	config
 	mutation *TargetPairMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetLabel sets the "label" field.
@@ -241,6 +244,7 @@ func (tpc *TargetPairCreate) createSpec() (*TargetPair, *sqlgraph.CreateSpec) {
 		_node = &TargetPair{config: tpc.config}
 		_spec = sqlgraph.NewCreateSpec(targetpair.Table, sqlgraph.NewFieldSpec(targetpair.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = tpc.conflict
 	if value, ok := tpc.mutation.Label(); ok {
 		_spec.SetField(targetpair.FieldLabel, field.TypeString, value)
 		_node.Label = value
@@ -318,11 +322,381 @@ func (tpc *TargetPairCreate) createSpec() (*TargetPair, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TargetPair.Create().
+//		SetLabel(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TargetPairUpsert) {
+//			SetLabel(v+v).
+//		}).
+//		Exec(ctx)
+func (tpc *TargetPairCreate) OnConflict(opts ...sql.ConflictOption) *TargetPairUpsertOne {
+	tpc.conflict = opts
+	return &TargetPairUpsertOne{
+		create: tpc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TargetPair.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tpc *TargetPairCreate) OnConflictColumns(columns ...string) *TargetPairUpsertOne {
+	tpc.conflict = append(tpc.conflict, sql.ConflictColumns(columns...))
+	return &TargetPairUpsertOne{
+		create: tpc,
+	}
+}
+
+type (
+	// TargetPairUpsertOne is the builder for "upsert"-ing
+	//  one TargetPair node.
+	TargetPairUpsertOne struct {
+		create *TargetPairCreate
+	}
+
+	// TargetPairUpsert is the "OnConflict" setter.
+	TargetPairUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetLabel sets the "label" field.
+func (u *TargetPairUpsert) SetLabel(v string) *TargetPairUpsert {
+	u.Set(targetpair.FieldLabel, v)
+	return u
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *TargetPairUpsert) UpdateLabel() *TargetPairUpsert {
+	u.SetExcluded(targetpair.FieldLabel)
+	return u
+}
+
+// ClearLabel clears the value of the "label" field.
+func (u *TargetPairUpsert) ClearLabel() *TargetPairUpsert {
+	u.SetNull(targetpair.FieldLabel)
+	return u
+}
+
+// SetDurationInMs sets the "duration_in_ms" field.
+func (u *TargetPairUpsert) SetDurationInMs(v int64) *TargetPairUpsert {
+	u.Set(targetpair.FieldDurationInMs, v)
+	return u
+}
+
+// UpdateDurationInMs sets the "duration_in_ms" field to the value that was provided on create.
+func (u *TargetPairUpsert) UpdateDurationInMs() *TargetPairUpsert {
+	u.SetExcluded(targetpair.FieldDurationInMs)
+	return u
+}
+
+// AddDurationInMs adds v to the "duration_in_ms" field.
+func (u *TargetPairUpsert) AddDurationInMs(v int64) *TargetPairUpsert {
+	u.Add(targetpair.FieldDurationInMs, v)
+	return u
+}
+
+// ClearDurationInMs clears the value of the "duration_in_ms" field.
+func (u *TargetPairUpsert) ClearDurationInMs() *TargetPairUpsert {
+	u.SetNull(targetpair.FieldDurationInMs)
+	return u
+}
+
+// SetSuccess sets the "success" field.
+func (u *TargetPairUpsert) SetSuccess(v bool) *TargetPairUpsert {
+	u.Set(targetpair.FieldSuccess, v)
+	return u
+}
+
+// UpdateSuccess sets the "success" field to the value that was provided on create.
+func (u *TargetPairUpsert) UpdateSuccess() *TargetPairUpsert {
+	u.SetExcluded(targetpair.FieldSuccess)
+	return u
+}
+
+// ClearSuccess clears the value of the "success" field.
+func (u *TargetPairUpsert) ClearSuccess() *TargetPairUpsert {
+	u.SetNull(targetpair.FieldSuccess)
+	return u
+}
+
+// SetTargetKind sets the "target_kind" field.
+func (u *TargetPairUpsert) SetTargetKind(v string) *TargetPairUpsert {
+	u.Set(targetpair.FieldTargetKind, v)
+	return u
+}
+
+// UpdateTargetKind sets the "target_kind" field to the value that was provided on create.
+func (u *TargetPairUpsert) UpdateTargetKind() *TargetPairUpsert {
+	u.SetExcluded(targetpair.FieldTargetKind)
+	return u
+}
+
+// ClearTargetKind clears the value of the "target_kind" field.
+func (u *TargetPairUpsert) ClearTargetKind() *TargetPairUpsert {
+	u.SetNull(targetpair.FieldTargetKind)
+	return u
+}
+
+// SetTestSize sets the "test_size" field.
+func (u *TargetPairUpsert) SetTestSize(v targetpair.TestSize) *TargetPairUpsert {
+	u.Set(targetpair.FieldTestSize, v)
+	return u
+}
+
+// UpdateTestSize sets the "test_size" field to the value that was provided on create.
+func (u *TargetPairUpsert) UpdateTestSize() *TargetPairUpsert {
+	u.SetExcluded(targetpair.FieldTestSize)
+	return u
+}
+
+// ClearTestSize clears the value of the "test_size" field.
+func (u *TargetPairUpsert) ClearTestSize() *TargetPairUpsert {
+	u.SetNull(targetpair.FieldTestSize)
+	return u
+}
+
+// SetAbortReason sets the "abort_reason" field.
+func (u *TargetPairUpsert) SetAbortReason(v targetpair.AbortReason) *TargetPairUpsert {
+	u.Set(targetpair.FieldAbortReason, v)
+	return u
+}
+
+// UpdateAbortReason sets the "abort_reason" field to the value that was provided on create.
+func (u *TargetPairUpsert) UpdateAbortReason() *TargetPairUpsert {
+	u.SetExcluded(targetpair.FieldAbortReason)
+	return u
+}
+
+// ClearAbortReason clears the value of the "abort_reason" field.
+func (u *TargetPairUpsert) ClearAbortReason() *TargetPairUpsert {
+	u.SetNull(targetpair.FieldAbortReason)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TargetPair.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TargetPairUpsertOne) UpdateNewValues() *TargetPairUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TargetPair.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TargetPairUpsertOne) Ignore() *TargetPairUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TargetPairUpsertOne) DoNothing() *TargetPairUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TargetPairCreate.OnConflict
+// documentation for more info.
+func (u *TargetPairUpsertOne) Update(set func(*TargetPairUpsert)) *TargetPairUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TargetPairUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetLabel sets the "label" field.
+func (u *TargetPairUpsertOne) SetLabel(v string) *TargetPairUpsertOne {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.SetLabel(v)
+	})
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *TargetPairUpsertOne) UpdateLabel() *TargetPairUpsertOne {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.UpdateLabel()
+	})
+}
+
+// ClearLabel clears the value of the "label" field.
+func (u *TargetPairUpsertOne) ClearLabel() *TargetPairUpsertOne {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.ClearLabel()
+	})
+}
+
+// SetDurationInMs sets the "duration_in_ms" field.
+func (u *TargetPairUpsertOne) SetDurationInMs(v int64) *TargetPairUpsertOne {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.SetDurationInMs(v)
+	})
+}
+
+// AddDurationInMs adds v to the "duration_in_ms" field.
+func (u *TargetPairUpsertOne) AddDurationInMs(v int64) *TargetPairUpsertOne {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.AddDurationInMs(v)
+	})
+}
+
+// UpdateDurationInMs sets the "duration_in_ms" field to the value that was provided on create.
+func (u *TargetPairUpsertOne) UpdateDurationInMs() *TargetPairUpsertOne {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.UpdateDurationInMs()
+	})
+}
+
+// ClearDurationInMs clears the value of the "duration_in_ms" field.
+func (u *TargetPairUpsertOne) ClearDurationInMs() *TargetPairUpsertOne {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.ClearDurationInMs()
+	})
+}
+
+// SetSuccess sets the "success" field.
+func (u *TargetPairUpsertOne) SetSuccess(v bool) *TargetPairUpsertOne {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.SetSuccess(v)
+	})
+}
+
+// UpdateSuccess sets the "success" field to the value that was provided on create.
+func (u *TargetPairUpsertOne) UpdateSuccess() *TargetPairUpsertOne {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.UpdateSuccess()
+	})
+}
+
+// ClearSuccess clears the value of the "success" field.
+func (u *TargetPairUpsertOne) ClearSuccess() *TargetPairUpsertOne {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.ClearSuccess()
+	})
+}
+
+// SetTargetKind sets the "target_kind" field.
+func (u *TargetPairUpsertOne) SetTargetKind(v string) *TargetPairUpsertOne {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.SetTargetKind(v)
+	})
+}
+
+// UpdateTargetKind sets the "target_kind" field to the value that was provided on create.
+func (u *TargetPairUpsertOne) UpdateTargetKind() *TargetPairUpsertOne {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.UpdateTargetKind()
+	})
+}
+
+// ClearTargetKind clears the value of the "target_kind" field.
+func (u *TargetPairUpsertOne) ClearTargetKind() *TargetPairUpsertOne {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.ClearTargetKind()
+	})
+}
+
+// SetTestSize sets the "test_size" field.
+func (u *TargetPairUpsertOne) SetTestSize(v targetpair.TestSize) *TargetPairUpsertOne {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.SetTestSize(v)
+	})
+}
+
+// UpdateTestSize sets the "test_size" field to the value that was provided on create.
+func (u *TargetPairUpsertOne) UpdateTestSize() *TargetPairUpsertOne {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.UpdateTestSize()
+	})
+}
+
+// ClearTestSize clears the value of the "test_size" field.
+func (u *TargetPairUpsertOne) ClearTestSize() *TargetPairUpsertOne {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.ClearTestSize()
+	})
+}
+
+// SetAbortReason sets the "abort_reason" field.
+func (u *TargetPairUpsertOne) SetAbortReason(v targetpair.AbortReason) *TargetPairUpsertOne {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.SetAbortReason(v)
+	})
+}
+
+// UpdateAbortReason sets the "abort_reason" field to the value that was provided on create.
+func (u *TargetPairUpsertOne) UpdateAbortReason() *TargetPairUpsertOne {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.UpdateAbortReason()
+	})
+}
+
+// ClearAbortReason clears the value of the "abort_reason" field.
+func (u *TargetPairUpsertOne) ClearAbortReason() *TargetPairUpsertOne {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.ClearAbortReason()
+	})
+}
+
+// Exec executes the query.
+func (u *TargetPairUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TargetPairCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TargetPairUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TargetPairUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TargetPairUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // TargetPairCreateBulk is the builder for creating many TargetPair entities in bulk.
 type TargetPairCreateBulk struct {
 	config
 	err      error
 	builders []*TargetPairCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the TargetPair entities in the database.
@@ -352,6 +726,7 @@ func (tpcb *TargetPairCreateBulk) Save(ctx context.Context) ([]*TargetPair, erro
 					_, err = mutators[i+1].Mutate(root, tpcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tpcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, tpcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -405,3 +780,240 @@ func (tpcb *TargetPairCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TargetPair.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TargetPairUpsert) {
+//			SetLabel(v+v).
+//		}).
+//		Exec(ctx)
+func (tpcb *TargetPairCreateBulk) OnConflict(opts ...sql.ConflictOption) *TargetPairUpsertBulk {
+	tpcb.conflict = opts
+	return &TargetPairUpsertBulk{
+		create: tpcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TargetPair.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tpcb *TargetPairCreateBulk) OnConflictColumns(columns ...string) *TargetPairUpsertBulk {
+	tpcb.conflict = append(tpcb.conflict, sql.ConflictColumns(columns...))
+	return &TargetPairUpsertBulk{
+		create: tpcb,
+	}
+}
+
+// TargetPairUpsertBulk is the builder for "upsert"-ing
+// a bulk of TargetPair nodes.
+type TargetPairUpsertBulk struct {
+	create *TargetPairCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TargetPair.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TargetPairUpsertBulk) UpdateNewValues() *TargetPairUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TargetPair.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TargetPairUpsertBulk) Ignore() *TargetPairUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TargetPairUpsertBulk) DoNothing() *TargetPairUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TargetPairCreateBulk.OnConflict
+// documentation for more info.
+func (u *TargetPairUpsertBulk) Update(set func(*TargetPairUpsert)) *TargetPairUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TargetPairUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetLabel sets the "label" field.
+func (u *TargetPairUpsertBulk) SetLabel(v string) *TargetPairUpsertBulk {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.SetLabel(v)
+	})
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *TargetPairUpsertBulk) UpdateLabel() *TargetPairUpsertBulk {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.UpdateLabel()
+	})
+}
+
+// ClearLabel clears the value of the "label" field.
+func (u *TargetPairUpsertBulk) ClearLabel() *TargetPairUpsertBulk {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.ClearLabel()
+	})
+}
+
+// SetDurationInMs sets the "duration_in_ms" field.
+func (u *TargetPairUpsertBulk) SetDurationInMs(v int64) *TargetPairUpsertBulk {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.SetDurationInMs(v)
+	})
+}
+
+// AddDurationInMs adds v to the "duration_in_ms" field.
+func (u *TargetPairUpsertBulk) AddDurationInMs(v int64) *TargetPairUpsertBulk {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.AddDurationInMs(v)
+	})
+}
+
+// UpdateDurationInMs sets the "duration_in_ms" field to the value that was provided on create.
+func (u *TargetPairUpsertBulk) UpdateDurationInMs() *TargetPairUpsertBulk {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.UpdateDurationInMs()
+	})
+}
+
+// ClearDurationInMs clears the value of the "duration_in_ms" field.
+func (u *TargetPairUpsertBulk) ClearDurationInMs() *TargetPairUpsertBulk {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.ClearDurationInMs()
+	})
+}
+
+// SetSuccess sets the "success" field.
+func (u *TargetPairUpsertBulk) SetSuccess(v bool) *TargetPairUpsertBulk {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.SetSuccess(v)
+	})
+}
+
+// UpdateSuccess sets the "success" field to the value that was provided on create.
+func (u *TargetPairUpsertBulk) UpdateSuccess() *TargetPairUpsertBulk {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.UpdateSuccess()
+	})
+}
+
+// ClearSuccess clears the value of the "success" field.
+func (u *TargetPairUpsertBulk) ClearSuccess() *TargetPairUpsertBulk {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.ClearSuccess()
+	})
+}
+
+// SetTargetKind sets the "target_kind" field.
+func (u *TargetPairUpsertBulk) SetTargetKind(v string) *TargetPairUpsertBulk {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.SetTargetKind(v)
+	})
+}
+
+// UpdateTargetKind sets the "target_kind" field to the value that was provided on create.
+func (u *TargetPairUpsertBulk) UpdateTargetKind() *TargetPairUpsertBulk {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.UpdateTargetKind()
+	})
+}
+
+// ClearTargetKind clears the value of the "target_kind" field.
+func (u *TargetPairUpsertBulk) ClearTargetKind() *TargetPairUpsertBulk {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.ClearTargetKind()
+	})
+}
+
+// SetTestSize sets the "test_size" field.
+func (u *TargetPairUpsertBulk) SetTestSize(v targetpair.TestSize) *TargetPairUpsertBulk {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.SetTestSize(v)
+	})
+}
+
+// UpdateTestSize sets the "test_size" field to the value that was provided on create.
+func (u *TargetPairUpsertBulk) UpdateTestSize() *TargetPairUpsertBulk {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.UpdateTestSize()
+	})
+}
+
+// ClearTestSize clears the value of the "test_size" field.
+func (u *TargetPairUpsertBulk) ClearTestSize() *TargetPairUpsertBulk {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.ClearTestSize()
+	})
+}
+
+// SetAbortReason sets the "abort_reason" field.
+func (u *TargetPairUpsertBulk) SetAbortReason(v targetpair.AbortReason) *TargetPairUpsertBulk {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.SetAbortReason(v)
+	})
+}
+
+// UpdateAbortReason sets the "abort_reason" field to the value that was provided on create.
+func (u *TargetPairUpsertBulk) UpdateAbortReason() *TargetPairUpsertBulk {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.UpdateAbortReason()
+	})
+}
+
+// ClearAbortReason clears the value of the "abort_reason" field.
+func (u *TargetPairUpsertBulk) ClearAbortReason() *TargetPairUpsertBulk {
+	return u.Update(func(s *TargetPairUpsert) {
+		s.ClearAbortReason()
+	})
+}
+
+// Exec executes the query.
+func (u *TargetPairUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TargetPairCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TargetPairCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TargetPairUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
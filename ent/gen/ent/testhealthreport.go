@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/testhealthreport"
+)
+
+// TestHealthReport is the model entity for the TestHealthReport schema.
+type TestHealthReport struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Label holds the value of the "label" field.
+	Label string `json:"label,omitempty"`
+	// Issue holds the value of the "issue" field.
+	Issue testhealthreport.Issue `json:"issue,omitempty"`
+	// TestSize holds the value of the "test_size" field.
+	TestSize testhealthreport.TestSize `json:"test_size,omitempty"`
+	// ShardCount holds the value of the "shard_count" field.
+	ShardCount int32 `json:"shard_count,omitempty"`
+	// MaxDurationInMs holds the value of the "max_duration_in_ms" field.
+	MaxDurationInMs int64 `json:"max_duration_in_ms,omitempty"`
+	// MedianShardDurationInMs holds the value of the "median_shard_duration_in_ms" field.
+	MedianShardDurationInMs int64 `json:"median_shard_duration_in_ms,omitempty"`
+	// TimeoutInMs holds the value of the "timeout_in_ms" field.
+	TimeoutInMs int64 `json:"timeout_in_ms,omitempty"`
+	// SuggestedShardCount holds the value of the "suggested_shard_count" field.
+	SuggestedShardCount int32 `json:"suggested_shard_count,omitempty"`
+	// SuggestedSize holds the value of the "suggested_size" field.
+	SuggestedSize testhealthreport.SuggestedSize `json:"suggested_size,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TestHealthReportQuery when eager-loading is set.
+	Edges                                TestHealthReportEdges `json:"edges"`
+	bazel_invocation_test_health_reports *int
+	selectValues                         sql.SelectValues
+}
+
+// TestHealthReportEdges holds the relations/edges for other nodes in the graph.
+type TestHealthReportEdges struct {
+	// BazelInvocation holds the value of the bazel_invocation edge.
+	BazelInvocation *BazelInvocation `json:"bazel_invocation,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+}
+
+// BazelInvocationOrErr returns the BazelInvocation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TestHealthReportEdges) BazelInvocationOrErr() (*BazelInvocation, error) {
+	if e.BazelInvocation != nil {
+		return e.BazelInvocation, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: bazelinvocation.Label}
+	}
+	return nil, &NotLoadedError{edge: "bazel_invocation"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TestHealthReport) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case testhealthreport.FieldID, testhealthreport.FieldShardCount, testhealthreport.FieldMaxDurationInMs, testhealthreport.FieldMedianShardDurationInMs, testhealthreport.FieldTimeoutInMs, testhealthreport.FieldSuggestedShardCount:
+			values[i] = new(sql.NullInt64)
+		case testhealthreport.FieldLabel, testhealthreport.FieldIssue, testhealthreport.FieldTestSize, testhealthreport.FieldSuggestedSize:
+			values[i] = new(sql.NullString)
+		case testhealthreport.ForeignKeys[0]: // bazel_invocation_test_health_reports
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TestHealthReport fields.
+func (thr *TestHealthReport) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case testhealthreport.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			thr.ID = int(value.Int64)
+		case testhealthreport.FieldLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field label", values[i])
+			} else if value.Valid {
+				thr.Label = value.String
+			}
+		case testhealthreport.FieldIssue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field issue", values[i])
+			} else if value.Valid {
+				thr.Issue = testhealthreport.Issue(value.String)
+			}
+		case testhealthreport.FieldTestSize:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field test_size", values[i])
+			} else if value.Valid {
+				thr.TestSize = testhealthreport.TestSize(value.String)
+			}
+		case testhealthreport.FieldShardCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field shard_count", values[i])
+			} else if value.Valid {
+				thr.ShardCount = int32(value.Int64)
+			}
+		case testhealthreport.FieldMaxDurationInMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_duration_in_ms", values[i])
+			} else if value.Valid {
+				thr.MaxDurationInMs = value.Int64
+			}
+		case testhealthreport.FieldMedianShardDurationInMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field median_shard_duration_in_ms", values[i])
+			} else if value.Valid {
+				thr.MedianShardDurationInMs = value.Int64
+			}
+		case testhealthreport.FieldTimeoutInMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field timeout_in_ms", values[i])
+			} else if value.Valid {
+				thr.TimeoutInMs = value.Int64
+			}
+		case testhealthreport.FieldSuggestedShardCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field suggested_shard_count", values[i])
+			} else if value.Valid {
+				thr.SuggestedShardCount = int32(value.Int64)
+			}
+		case testhealthreport.FieldSuggestedSize:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field suggested_size", values[i])
+			} else if value.Valid {
+				thr.SuggestedSize = testhealthreport.SuggestedSize(value.String)
+			}
+		case testhealthreport.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field bazel_invocation_test_health_reports", value)
+			} else if value.Valid {
+				thr.bazel_invocation_test_health_reports = new(int)
+				*thr.bazel_invocation_test_health_reports = int(value.Int64)
+			}
+		default:
+			thr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TestHealthReport.
+// This includes values selected through modifiers, order, etc.
+func (thr *TestHealthReport) Value(name string) (ent.Value, error) {
+	return thr.selectValues.Get(name)
+}
+
+// QueryBazelInvocation queries the "bazel_invocation" edge of the TestHealthReport entity.
+func (thr *TestHealthReport) QueryBazelInvocation() *BazelInvocationQuery {
+	return NewTestHealthReportClient(thr.config).QueryBazelInvocation(thr)
+}
+
+// Update returns a builder for updating this TestHealthReport.
+// Note that you need to call TestHealthReport.Unwrap() before calling this method if this TestHealthReport
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (thr *TestHealthReport) Update() *TestHealthReportUpdateOne {
+	return NewTestHealthReportClient(thr.config).UpdateOne(thr)
+}
+
+// Unwrap unwraps the TestHealthReport entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (thr *TestHealthReport) Unwrap() *TestHealthReport {
+	_tx, ok := thr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TestHealthReport is not a transactional entity")
+	}
+	thr.config.driver = _tx.drv
+	return thr
+}
+
+// String implements the fmt.Stringer.
+func (thr *TestHealthReport) String() string {
+	var builder strings.Builder
+	builder.WriteString("TestHealthReport(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", thr.ID))
+	builder.WriteString("label=")
+	builder.WriteString(thr.Label)
+	builder.WriteString(", ")
+	builder.WriteString("issue=")
+	builder.WriteString(fmt.Sprintf("%v", thr.Issue))
+	builder.WriteString(", ")
+	builder.WriteString("test_size=")
+	builder.WriteString(fmt.Sprintf("%v", thr.TestSize))
+	builder.WriteString(", ")
+	builder.WriteString("shard_count=")
+	builder.WriteString(fmt.Sprintf("%v", thr.ShardCount))
+	builder.WriteString(", ")
+	builder.WriteString("max_duration_in_ms=")
+	builder.WriteString(fmt.Sprintf("%v", thr.MaxDurationInMs))
+	builder.WriteString(", ")
+	builder.WriteString("median_shard_duration_in_ms=")
+	builder.WriteString(fmt.Sprintf("%v", thr.MedianShardDurationInMs))
+	builder.WriteString(", ")
+	builder.WriteString("timeout_in_ms=")
+	builder.WriteString(fmt.Sprintf("%v", thr.TimeoutInMs))
+	builder.WriteString(", ")
+	builder.WriteString("suggested_shard_count=")
+	builder.WriteString(fmt.Sprintf("%v", thr.SuggestedShardCount))
+	builder.WriteString(", ")
+	builder.WriteString("suggested_size=")
+	builder.WriteString(fmt.Sprintf("%v", thr.SuggestedSize))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TestHealthReports is a parsable slice of TestHealthReport.
+type TestHealthReports []*TestHealthReport
@@ -0,0 +1,286 @@
+// Code generated by ent, DO NOT EDIT.
+
+package testhealthreport
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the testhealthreport type in the database.
+	Label = "test_health_report"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldLabel holds the string denoting the label field in the database.
+	FieldLabel = "label"
+	// FieldIssue holds the string denoting the issue field in the database.
+	FieldIssue = "issue"
+	// FieldTestSize holds the string denoting the test_size field in the database.
+	FieldTestSize = "test_size"
+	// FieldShardCount holds the string denoting the shard_count field in the database.
+	FieldShardCount = "shard_count"
+	// FieldMaxDurationInMs holds the string denoting the max_duration_in_ms field in the database.
+	FieldMaxDurationInMs = "max_duration_in_ms"
+	// FieldMedianShardDurationInMs holds the string denoting the median_shard_duration_in_ms field in the database.
+	FieldMedianShardDurationInMs = "median_shard_duration_in_ms"
+	// FieldTimeoutInMs holds the string denoting the timeout_in_ms field in the database.
+	FieldTimeoutInMs = "timeout_in_ms"
+	// FieldSuggestedShardCount holds the string denoting the suggested_shard_count field in the database.
+	FieldSuggestedShardCount = "suggested_shard_count"
+	// FieldSuggestedSize holds the string denoting the suggested_size field in the database.
+	FieldSuggestedSize = "suggested_size"
+	// EdgeBazelInvocation holds the string denoting the bazel_invocation edge name in mutations.
+	EdgeBazelInvocation = "bazel_invocation"
+	// Table holds the table name of the testhealthreport in the database.
+	Table = "test_health_reports"
+	// BazelInvocationTable is the table that holds the bazel_invocation relation/edge.
+	BazelInvocationTable = "test_health_reports"
+	// BazelInvocationInverseTable is the table name for the BazelInvocation entity.
+	// It exists in this package in order to avoid circular dependency with the "bazelinvocation" package.
+	BazelInvocationInverseTable = "bazel_invocations"
+	// BazelInvocationColumn is the table column denoting the bazel_invocation relation/edge.
+	BazelInvocationColumn = "bazel_invocation_test_health_reports"
+)
+
+// Columns holds all SQL columns for testhealthreport fields.
+var Columns = []string{
+	FieldID,
+	FieldLabel,
+	FieldIssue,
+	FieldTestSize,
+	FieldShardCount,
+	FieldMaxDurationInMs,
+	FieldMedianShardDurationInMs,
+	FieldTimeoutInMs,
+	FieldSuggestedShardCount,
+	FieldSuggestedSize,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "test_health_reports"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"bazel_invocation_test_health_reports",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Issue defines the type for the "issue" enum field.
+type Issue string
+
+// Issue values.
+const (
+	IssueIMBALANCED_SHARDS Issue = "IMBALANCED_SHARDS"
+	IssueNEAR_TIMEOUT      Issue = "NEAR_TIMEOUT"
+	IssueOVERSIZED         Issue = "OVERSIZED"
+)
+
+func (i Issue) String() string {
+	return string(i)
+}
+
+// IssueValidator is a validator for the "issue" field enum values. It is called by the builders before save.
+func IssueValidator(i Issue) error {
+	switch i {
+	case IssueIMBALANCED_SHARDS, IssueNEAR_TIMEOUT, IssueOVERSIZED:
+		return nil
+	default:
+		return fmt.Errorf("testhealthreport: invalid enum value for issue field: %q", i)
+	}
+}
+
+// TestSize defines the type for the "test_size" enum field.
+type TestSize string
+
+// TestSizeUNKNOWN is the default value of the TestSize enum.
+const DefaultTestSize = TestSizeUNKNOWN
+
+// TestSize values.
+const (
+	TestSizeUNKNOWN  TestSize = "UNKNOWN"
+	TestSizeSMALL    TestSize = "SMALL"
+	TestSizeMEDIUM   TestSize = "MEDIUM"
+	TestSizeLARGE    TestSize = "LARGE"
+	TestSizeENORMOUS TestSize = "ENORMOUS"
+)
+
+func (ts TestSize) String() string {
+	return string(ts)
+}
+
+// TestSizeValidator is a validator for the "test_size" field enum values. It is called by the builders before save.
+func TestSizeValidator(ts TestSize) error {
+	switch ts {
+	case TestSizeUNKNOWN, TestSizeSMALL, TestSizeMEDIUM, TestSizeLARGE, TestSizeENORMOUS:
+		return nil
+	default:
+		return fmt.Errorf("testhealthreport: invalid enum value for test_size field: %q", ts)
+	}
+}
+
+// SuggestedSize defines the type for the "suggested_size" enum field.
+type SuggestedSize string
+
+// SuggestedSizeUNKNOWN is the default value of the SuggestedSize enum.
+const DefaultSuggestedSize = SuggestedSizeUNKNOWN
+
+// SuggestedSize values.
+const (
+	SuggestedSizeUNKNOWN  SuggestedSize = "UNKNOWN"
+	SuggestedSizeSMALL    SuggestedSize = "SMALL"
+	SuggestedSizeMEDIUM   SuggestedSize = "MEDIUM"
+	SuggestedSizeLARGE    SuggestedSize = "LARGE"
+	SuggestedSizeENORMOUS SuggestedSize = "ENORMOUS"
+)
+
+func (ss SuggestedSize) String() string {
+	return string(ss)
+}
+
+// SuggestedSizeValidator is a validator for the "suggested_size" field enum values. It is called by the builders before save.
+func SuggestedSizeValidator(ss SuggestedSize) error {
+	switch ss {
+	case SuggestedSizeUNKNOWN, SuggestedSizeSMALL, SuggestedSizeMEDIUM, SuggestedSizeLARGE, SuggestedSizeENORMOUS:
+		return nil
+	default:
+		return fmt.Errorf("testhealthreport: invalid enum value for suggested_size field: %q", ss)
+	}
+}
+
+// OrderOption defines the ordering options for the TestHealthReport queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByLabel orders the results by the label field.
+func ByLabel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLabel, opts...).ToFunc()
+}
+
+// ByIssue orders the results by the issue field.
+func ByIssue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIssue, opts...).ToFunc()
+}
+
+// ByTestSize orders the results by the test_size field.
+func ByTestSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTestSize, opts...).ToFunc()
+}
+
+// ByShardCount orders the results by the shard_count field.
+func ByShardCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShardCount, opts...).ToFunc()
+}
+
+// ByMaxDurationInMs orders the results by the max_duration_in_ms field.
+func ByMaxDurationInMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxDurationInMs, opts...).ToFunc()
+}
+
+// ByMedianShardDurationInMs orders the results by the median_shard_duration_in_ms field.
+func ByMedianShardDurationInMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMedianShardDurationInMs, opts...).ToFunc()
+}
+
+// ByTimeoutInMs orders the results by the timeout_in_ms field.
+func ByTimeoutInMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeoutInMs, opts...).ToFunc()
+}
+
+// BySuggestedShardCount orders the results by the suggested_shard_count field.
+func BySuggestedShardCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuggestedShardCount, opts...).ToFunc()
+}
+
+// BySuggestedSize orders the results by the suggested_size field.
+func BySuggestedSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuggestedSize, opts...).ToFunc()
+}
+
+// ByBazelInvocationField orders the results by bazel_invocation field.
+func ByBazelInvocationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBazelInvocationStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newBazelInvocationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BazelInvocationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BazelInvocationTable, BazelInvocationColumn),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Issue) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Issue) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Issue(str)
+	if err := IssueValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Issue", str)
+	}
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e TestSize) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *TestSize) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = TestSize(str)
+	if err := TestSizeValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid TestSize", str)
+	}
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e SuggestedSize) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *SuggestedSize) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = SuggestedSize(str)
+	if err := SuggestedSizeValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid SuggestedSize", str)
+	}
+	return nil
+}
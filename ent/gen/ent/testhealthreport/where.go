@@ -0,0 +1,442 @@
+// Code generated by ent, DO NOT EDIT.
+
+package testhealthreport
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldLTE(FieldID, id))
+}
+
+// ShardCount applies equality check predicate on the "shard_count" field. It's identical to ShardCountEQ.
+func ShardCount(v int32) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldEQ(FieldShardCount, v))
+}
+
+// MaxDurationInMs applies equality check predicate on the "max_duration_in_ms" field. It's identical to MaxDurationInMsEQ.
+func MaxDurationInMs(v int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldEQ(FieldMaxDurationInMs, v))
+}
+
+// MedianShardDurationInMs applies equality check predicate on the "median_shard_duration_in_ms" field. It's identical to MedianShardDurationInMsEQ.
+func MedianShardDurationInMs(v int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldEQ(FieldMedianShardDurationInMs, v))
+}
+
+// TimeoutInMs applies equality check predicate on the "timeout_in_ms" field. It's identical to TimeoutInMsEQ.
+func TimeoutInMs(v int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldEQ(FieldTimeoutInMs, v))
+}
+
+// SuggestedShardCount applies equality check predicate on the "suggested_shard_count" field. It's identical to SuggestedShardCountEQ.
+func SuggestedShardCount(v int32) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldEQ(FieldSuggestedShardCount, v))
+}
+
+// LabelEQ applies the EQ predicate on the "label" field.
+func LabelEQ(v string) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldEQ(FieldLabel, v))
+}
+
+// LabelNEQ applies the NEQ predicate on the "label" field.
+func LabelNEQ(v string) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldNEQ(FieldLabel, v))
+}
+
+// LabelIn applies the In predicate on the "label" field.
+func LabelIn(vs ...string) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldIn(FieldLabel, vs...))
+}
+
+// LabelNotIn applies the NotIn predicate on the "label" field.
+func LabelNotIn(vs ...string) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldNotIn(FieldLabel, vs...))
+}
+
+// LabelGT applies the GT predicate on the "label" field.
+func LabelGT(v string) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldGT(FieldLabel, v))
+}
+
+// LabelGTE applies the GTE predicate on the "label" field.
+func LabelGTE(v string) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldGTE(FieldLabel, v))
+}
+
+// LabelLT applies the LT predicate on the "label" field.
+func LabelLT(v string) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldLT(FieldLabel, v))
+}
+
+// LabelLTE applies the LTE predicate on the "label" field.
+func LabelLTE(v string) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldLTE(FieldLabel, v))
+}
+
+// LabelContains applies the Contains predicate on the "label" field.
+func LabelContains(v string) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldContains(FieldLabel, v))
+}
+
+// LabelHasPrefix applies the HasPrefix predicate on the "label" field.
+func LabelHasPrefix(v string) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldHasPrefix(FieldLabel, v))
+}
+
+// LabelHasSuffix applies the HasSuffix predicate on the "label" field.
+func LabelHasSuffix(v string) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldHasSuffix(FieldLabel, v))
+}
+
+// LabelEqualFold applies the EqualFold predicate on the "label" field.
+func LabelEqualFold(v string) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldEqualFold(FieldLabel, v))
+}
+
+// LabelContainsFold applies the ContainsFold predicate on the "label" field.
+func LabelContainsFold(v string) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldContainsFold(FieldLabel, v))
+}
+
+// IssueEQ applies the EQ predicate on the "issue" field.
+func IssueEQ(v Issue) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldEQ(FieldIssue, v))
+}
+
+// IssueNEQ applies the NEQ predicate on the "issue" field.
+func IssueNEQ(v Issue) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldNEQ(FieldIssue, v))
+}
+
+// IssueIn applies the In predicate on the "issue" field.
+func IssueIn(vs ...Issue) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldIn(FieldIssue, vs...))
+}
+
+// IssueNotIn applies the NotIn predicate on the "issue" field.
+func IssueNotIn(vs ...Issue) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldNotIn(FieldIssue, vs...))
+}
+
+// TestSizeEQ applies the EQ predicate on the "test_size" field.
+func TestSizeEQ(v TestSize) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldEQ(FieldTestSize, v))
+}
+
+// TestSizeNEQ applies the NEQ predicate on the "test_size" field.
+func TestSizeNEQ(v TestSize) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldNEQ(FieldTestSize, v))
+}
+
+// TestSizeIn applies the In predicate on the "test_size" field.
+func TestSizeIn(vs ...TestSize) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldIn(FieldTestSize, vs...))
+}
+
+// TestSizeNotIn applies the NotIn predicate on the "test_size" field.
+func TestSizeNotIn(vs ...TestSize) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldNotIn(FieldTestSize, vs...))
+}
+
+// ShardCountEQ applies the EQ predicate on the "shard_count" field.
+func ShardCountEQ(v int32) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldEQ(FieldShardCount, v))
+}
+
+// ShardCountNEQ applies the NEQ predicate on the "shard_count" field.
+func ShardCountNEQ(v int32) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldNEQ(FieldShardCount, v))
+}
+
+// ShardCountIn applies the In predicate on the "shard_count" field.
+func ShardCountIn(vs ...int32) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldIn(FieldShardCount, vs...))
+}
+
+// ShardCountNotIn applies the NotIn predicate on the "shard_count" field.
+func ShardCountNotIn(vs ...int32) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldNotIn(FieldShardCount, vs...))
+}
+
+// ShardCountGT applies the GT predicate on the "shard_count" field.
+func ShardCountGT(v int32) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldGT(FieldShardCount, v))
+}
+
+// ShardCountGTE applies the GTE predicate on the "shard_count" field.
+func ShardCountGTE(v int32) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldGTE(FieldShardCount, v))
+}
+
+// ShardCountLT applies the LT predicate on the "shard_count" field.
+func ShardCountLT(v int32) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldLT(FieldShardCount, v))
+}
+
+// ShardCountLTE applies the LTE predicate on the "shard_count" field.
+func ShardCountLTE(v int32) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldLTE(FieldShardCount, v))
+}
+
+// MaxDurationInMsEQ applies the EQ predicate on the "max_duration_in_ms" field.
+func MaxDurationInMsEQ(v int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldEQ(FieldMaxDurationInMs, v))
+}
+
+// MaxDurationInMsNEQ applies the NEQ predicate on the "max_duration_in_ms" field.
+func MaxDurationInMsNEQ(v int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldNEQ(FieldMaxDurationInMs, v))
+}
+
+// MaxDurationInMsIn applies the In predicate on the "max_duration_in_ms" field.
+func MaxDurationInMsIn(vs ...int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldIn(FieldMaxDurationInMs, vs...))
+}
+
+// MaxDurationInMsNotIn applies the NotIn predicate on the "max_duration_in_ms" field.
+func MaxDurationInMsNotIn(vs ...int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldNotIn(FieldMaxDurationInMs, vs...))
+}
+
+// MaxDurationInMsGT applies the GT predicate on the "max_duration_in_ms" field.
+func MaxDurationInMsGT(v int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldGT(FieldMaxDurationInMs, v))
+}
+
+// MaxDurationInMsGTE applies the GTE predicate on the "max_duration_in_ms" field.
+func MaxDurationInMsGTE(v int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldGTE(FieldMaxDurationInMs, v))
+}
+
+// MaxDurationInMsLT applies the LT predicate on the "max_duration_in_ms" field.
+func MaxDurationInMsLT(v int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldLT(FieldMaxDurationInMs, v))
+}
+
+// MaxDurationInMsLTE applies the LTE predicate on the "max_duration_in_ms" field.
+func MaxDurationInMsLTE(v int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldLTE(FieldMaxDurationInMs, v))
+}
+
+// MedianShardDurationInMsEQ applies the EQ predicate on the "median_shard_duration_in_ms" field.
+func MedianShardDurationInMsEQ(v int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldEQ(FieldMedianShardDurationInMs, v))
+}
+
+// MedianShardDurationInMsNEQ applies the NEQ predicate on the "median_shard_duration_in_ms" field.
+func MedianShardDurationInMsNEQ(v int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldNEQ(FieldMedianShardDurationInMs, v))
+}
+
+// MedianShardDurationInMsIn applies the In predicate on the "median_shard_duration_in_ms" field.
+func MedianShardDurationInMsIn(vs ...int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldIn(FieldMedianShardDurationInMs, vs...))
+}
+
+// MedianShardDurationInMsNotIn applies the NotIn predicate on the "median_shard_duration_in_ms" field.
+func MedianShardDurationInMsNotIn(vs ...int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldNotIn(FieldMedianShardDurationInMs, vs...))
+}
+
+// MedianShardDurationInMsGT applies the GT predicate on the "median_shard_duration_in_ms" field.
+func MedianShardDurationInMsGT(v int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldGT(FieldMedianShardDurationInMs, v))
+}
+
+// MedianShardDurationInMsGTE applies the GTE predicate on the "median_shard_duration_in_ms" field.
+func MedianShardDurationInMsGTE(v int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldGTE(FieldMedianShardDurationInMs, v))
+}
+
+// MedianShardDurationInMsLT applies the LT predicate on the "median_shard_duration_in_ms" field.
+func MedianShardDurationInMsLT(v int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldLT(FieldMedianShardDurationInMs, v))
+}
+
+// MedianShardDurationInMsLTE applies the LTE predicate on the "median_shard_duration_in_ms" field.
+func MedianShardDurationInMsLTE(v int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldLTE(FieldMedianShardDurationInMs, v))
+}
+
+// TimeoutInMsEQ applies the EQ predicate on the "timeout_in_ms" field.
+func TimeoutInMsEQ(v int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldEQ(FieldTimeoutInMs, v))
+}
+
+// TimeoutInMsNEQ applies the NEQ predicate on the "timeout_in_ms" field.
+func TimeoutInMsNEQ(v int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldNEQ(FieldTimeoutInMs, v))
+}
+
+// TimeoutInMsIn applies the In predicate on the "timeout_in_ms" field.
+func TimeoutInMsIn(vs ...int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldIn(FieldTimeoutInMs, vs...))
+}
+
+// TimeoutInMsNotIn applies the NotIn predicate on the "timeout_in_ms" field.
+func TimeoutInMsNotIn(vs ...int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldNotIn(FieldTimeoutInMs, vs...))
+}
+
+// TimeoutInMsGT applies the GT predicate on the "timeout_in_ms" field.
+func TimeoutInMsGT(v int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldGT(FieldTimeoutInMs, v))
+}
+
+// TimeoutInMsGTE applies the GTE predicate on the "timeout_in_ms" field.
+func TimeoutInMsGTE(v int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldGTE(FieldTimeoutInMs, v))
+}
+
+// TimeoutInMsLT applies the LT predicate on the "timeout_in_ms" field.
+func TimeoutInMsLT(v int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldLT(FieldTimeoutInMs, v))
+}
+
+// TimeoutInMsLTE applies the LTE predicate on the "timeout_in_ms" field.
+func TimeoutInMsLTE(v int64) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldLTE(FieldTimeoutInMs, v))
+}
+
+// SuggestedShardCountEQ applies the EQ predicate on the "suggested_shard_count" field.
+func SuggestedShardCountEQ(v int32) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldEQ(FieldSuggestedShardCount, v))
+}
+
+// SuggestedShardCountNEQ applies the NEQ predicate on the "suggested_shard_count" field.
+func SuggestedShardCountNEQ(v int32) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldNEQ(FieldSuggestedShardCount, v))
+}
+
+// SuggestedShardCountIn applies the In predicate on the "suggested_shard_count" field.
+func SuggestedShardCountIn(vs ...int32) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldIn(FieldSuggestedShardCount, vs...))
+}
+
+// SuggestedShardCountNotIn applies the NotIn predicate on the "suggested_shard_count" field.
+func SuggestedShardCountNotIn(vs ...int32) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldNotIn(FieldSuggestedShardCount, vs...))
+}
+
+// SuggestedShardCountGT applies the GT predicate on the "suggested_shard_count" field.
+func SuggestedShardCountGT(v int32) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldGT(FieldSuggestedShardCount, v))
+}
+
+// SuggestedShardCountGTE applies the GTE predicate on the "suggested_shard_count" field.
+func SuggestedShardCountGTE(v int32) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldGTE(FieldSuggestedShardCount, v))
+}
+
+// SuggestedShardCountLT applies the LT predicate on the "suggested_shard_count" field.
+func SuggestedShardCountLT(v int32) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldLT(FieldSuggestedShardCount, v))
+}
+
+// SuggestedShardCountLTE applies the LTE predicate on the "suggested_shard_count" field.
+func SuggestedShardCountLTE(v int32) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldLTE(FieldSuggestedShardCount, v))
+}
+
+// SuggestedSizeEQ applies the EQ predicate on the "suggested_size" field.
+func SuggestedSizeEQ(v SuggestedSize) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldEQ(FieldSuggestedSize, v))
+}
+
+// SuggestedSizeNEQ applies the NEQ predicate on the "suggested_size" field.
+func SuggestedSizeNEQ(v SuggestedSize) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldNEQ(FieldSuggestedSize, v))
+}
+
+// SuggestedSizeIn applies the In predicate on the "suggested_size" field.
+func SuggestedSizeIn(vs ...SuggestedSize) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldIn(FieldSuggestedSize, vs...))
+}
+
+// SuggestedSizeNotIn applies the NotIn predicate on the "suggested_size" field.
+func SuggestedSizeNotIn(vs ...SuggestedSize) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.FieldNotIn(FieldSuggestedSize, vs...))
+}
+
+// HasBazelInvocation applies the HasEdge predicate on the "bazel_invocation" edge.
+func HasBazelInvocation() predicate.TestHealthReport {
+	return predicate.TestHealthReport(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BazelInvocationTable, BazelInvocationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBazelInvocationWith applies the HasEdge predicate on the "bazel_invocation" edge with a given conditions (other predicates).
+func HasBazelInvocationWith(preds ...predicate.BazelInvocation) predicate.TestHealthReport {
+	return predicate.TestHealthReport(func(s *sql.Selector) {
+		step := newBazelInvocationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TestHealthReport) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TestHealthReport) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TestHealthReport) predicate.TestHealthReport {
+	return predicate.TestHealthReport(sql.NotPredicates(p))
+}
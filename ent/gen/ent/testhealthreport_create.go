@@ -0,0 +1,1060 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/testhealthreport"
+)
+
+// TestHealthReportCreate is the builder for creating a TestHealthReport entity.
+type TestHealthReportCreate struct {
+	config
+	mutation *TestHealthReportMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetLabel sets the "label" field.
+func (thrc *TestHealthReportCreate) SetLabel(s string) *TestHealthReportCreate {
+	thrc.mutation.SetLabel(s)
+	return thrc
+}
+
+// SetIssue sets the "issue" field.
+func (thrc *TestHealthReportCreate) SetIssue(t testhealthreport.Issue) *TestHealthReportCreate {
+	thrc.mutation.SetIssue(t)
+	return thrc
+}
+
+// SetTestSize sets the "test_size" field.
+func (thrc *TestHealthReportCreate) SetTestSize(ts testhealthreport.TestSize) *TestHealthReportCreate {
+	thrc.mutation.SetTestSize(ts)
+	return thrc
+}
+
+// SetNillableTestSize sets the "test_size" field if the given value is not nil.
+func (thrc *TestHealthReportCreate) SetNillableTestSize(ts *testhealthreport.TestSize) *TestHealthReportCreate {
+	if ts != nil {
+		thrc.SetTestSize(*ts)
+	}
+	return thrc
+}
+
+// SetShardCount sets the "shard_count" field.
+func (thrc *TestHealthReportCreate) SetShardCount(i int32) *TestHealthReportCreate {
+	thrc.mutation.SetShardCount(i)
+	return thrc
+}
+
+// SetMaxDurationInMs sets the "max_duration_in_ms" field.
+func (thrc *TestHealthReportCreate) SetMaxDurationInMs(i int64) *TestHealthReportCreate {
+	thrc.mutation.SetMaxDurationInMs(i)
+	return thrc
+}
+
+// SetMedianShardDurationInMs sets the "median_shard_duration_in_ms" field.
+func (thrc *TestHealthReportCreate) SetMedianShardDurationInMs(i int64) *TestHealthReportCreate {
+	thrc.mutation.SetMedianShardDurationInMs(i)
+	return thrc
+}
+
+// SetTimeoutInMs sets the "timeout_in_ms" field.
+func (thrc *TestHealthReportCreate) SetTimeoutInMs(i int64) *TestHealthReportCreate {
+	thrc.mutation.SetTimeoutInMs(i)
+	return thrc
+}
+
+// SetSuggestedShardCount sets the "suggested_shard_count" field.
+func (thrc *TestHealthReportCreate) SetSuggestedShardCount(i int32) *TestHealthReportCreate {
+	thrc.mutation.SetSuggestedShardCount(i)
+	return thrc
+}
+
+// SetSuggestedSize sets the "suggested_size" field.
+func (thrc *TestHealthReportCreate) SetSuggestedSize(ts testhealthreport.SuggestedSize) *TestHealthReportCreate {
+	thrc.mutation.SetSuggestedSize(ts)
+	return thrc
+}
+
+// SetNillableSuggestedSize sets the "suggested_size" field if the given value is not nil.
+func (thrc *TestHealthReportCreate) SetNillableSuggestedSize(ts *testhealthreport.SuggestedSize) *TestHealthReportCreate {
+	if ts != nil {
+		thrc.SetSuggestedSize(*ts)
+	}
+	return thrc
+}
+
+// SetBazelInvocationID sets the "bazel_invocation" edge to the BazelInvocation entity by ID.
+func (thrc *TestHealthReportCreate) SetBazelInvocationID(id int) *TestHealthReportCreate {
+	thrc.mutation.SetBazelInvocationID(id)
+	return thrc
+}
+
+// SetNillableBazelInvocationID sets the "bazel_invocation" edge to the BazelInvocation entity by ID if the given value is not nil.
+func (thrc *TestHealthReportCreate) SetNillableBazelInvocationID(id *int) *TestHealthReportCreate {
+	if id != nil {
+		thrc = thrc.SetBazelInvocationID(*id)
+	}
+	return thrc
+}
+
+// SetBazelInvocation sets the "bazel_invocation" edge to the BazelInvocation entity.
+func (thrc *TestHealthReportCreate) SetBazelInvocation(b *BazelInvocation) *TestHealthReportCreate {
+	return thrc.SetBazelInvocationID(b.ID)
+}
+
+// Mutation returns the TestHealthReportMutation object of the builder.
+func (thrc *TestHealthReportCreate) Mutation() *TestHealthReportMutation {
+	return thrc.mutation
+}
+
+// Save creates the TestHealthReport in the database.
+func (thrc *TestHealthReportCreate) Save(ctx context.Context) (*TestHealthReport, error) {
+	thrc.defaults()
+	return withHooks(ctx, thrc.sqlSave, thrc.mutation, thrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (thrc *TestHealthReportCreate) SaveX(ctx context.Context) *TestHealthReport {
+	v, err := thrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (thrc *TestHealthReportCreate) Exec(ctx context.Context) error {
+	_, err := thrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (thrc *TestHealthReportCreate) ExecX(ctx context.Context) {
+	if err := thrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (thrc *TestHealthReportCreate) defaults() {
+	if _, ok := thrc.mutation.TestSize(); !ok {
+		v := testhealthreport.DefaultTestSize
+		thrc.mutation.SetTestSize(v)
+	}
+	if _, ok := thrc.mutation.SuggestedSize(); !ok {
+		v := testhealthreport.DefaultSuggestedSize
+		thrc.mutation.SetSuggestedSize(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (thrc *TestHealthReportCreate) check() error {
+	if _, ok := thrc.mutation.Label(); !ok {
+		return &ValidationError{Name: "label", err: errors.New(`ent: missing required field "TestHealthReport.label"`)}
+	}
+	if _, ok := thrc.mutation.Issue(); !ok {
+		return &ValidationError{Name: "issue", err: errors.New(`ent: missing required field "TestHealthReport.issue"`)}
+	}
+	if v, ok := thrc.mutation.Issue(); ok {
+		if err := testhealthreport.IssueValidator(v); err != nil {
+			return &ValidationError{Name: "issue", err: fmt.Errorf(`ent: validator failed for field "TestHealthReport.issue": %w`, err)}
+		}
+	}
+	if _, ok := thrc.mutation.TestSize(); !ok {
+		return &ValidationError{Name: "test_size", err: errors.New(`ent: missing required field "TestHealthReport.test_size"`)}
+	}
+	if v, ok := thrc.mutation.TestSize(); ok {
+		if err := testhealthreport.TestSizeValidator(v); err != nil {
+			return &ValidationError{Name: "test_size", err: fmt.Errorf(`ent: validator failed for field "TestHealthReport.test_size": %w`, err)}
+		}
+	}
+	if _, ok := thrc.mutation.ShardCount(); !ok {
+		return &ValidationError{Name: "shard_count", err: errors.New(`ent: missing required field "TestHealthReport.shard_count"`)}
+	}
+	if _, ok := thrc.mutation.MaxDurationInMs(); !ok {
+		return &ValidationError{Name: "max_duration_in_ms", err: errors.New(`ent: missing required field "TestHealthReport.max_duration_in_ms"`)}
+	}
+	if _, ok := thrc.mutation.MedianShardDurationInMs(); !ok {
+		return &ValidationError{Name: "median_shard_duration_in_ms", err: errors.New(`ent: missing required field "TestHealthReport.median_shard_duration_in_ms"`)}
+	}
+	if _, ok := thrc.mutation.TimeoutInMs(); !ok {
+		return &ValidationError{Name: "timeout_in_ms", err: errors.New(`ent: missing required field "TestHealthReport.timeout_in_ms"`)}
+	}
+	if _, ok := thrc.mutation.SuggestedShardCount(); !ok {
+		return &ValidationError{Name: "suggested_shard_count", err: errors.New(`ent: missing required field "TestHealthReport.suggested_shard_count"`)}
+	}
+	if _, ok := thrc.mutation.SuggestedSize(); !ok {
+		return &ValidationError{Name: "suggested_size", err: errors.New(`ent: missing required field "TestHealthReport.suggested_size"`)}
+	}
+	if v, ok := thrc.mutation.SuggestedSize(); ok {
+		if err := testhealthreport.SuggestedSizeValidator(v); err != nil {
+			return &ValidationError{Name: "suggested_size", err: fmt.Errorf(`ent: validator failed for field "TestHealthReport.suggested_size": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (thrc *TestHealthReportCreate) sqlSave(ctx context.Context) (*TestHealthReport, error) {
+	if err := thrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := thrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, thrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	thrc.mutation.id = &_node.ID
+	thrc.mutation.done = true
+	return _node, nil
+}
+
+func (thrc *TestHealthReportCreate) createSpec() (*TestHealthReport, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TestHealthReport{config: thrc.config}
+		_spec = sqlgraph.NewCreateSpec(testhealthreport.Table, sqlgraph.NewFieldSpec(testhealthreport.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = thrc.conflict
+	if value, ok := thrc.mutation.Label(); ok {
+		_spec.SetField(testhealthreport.FieldLabel, field.TypeString, value)
+		_node.Label = value
+	}
+	if value, ok := thrc.mutation.Issue(); ok {
+		_spec.SetField(testhealthreport.FieldIssue, field.TypeEnum, value)
+		_node.Issue = value
+	}
+	if value, ok := thrc.mutation.TestSize(); ok {
+		_spec.SetField(testhealthreport.FieldTestSize, field.TypeEnum, value)
+		_node.TestSize = value
+	}
+	if value, ok := thrc.mutation.ShardCount(); ok {
+		_spec.SetField(testhealthreport.FieldShardCount, field.TypeInt32, value)
+		_node.ShardCount = value
+	}
+	if value, ok := thrc.mutation.MaxDurationInMs(); ok {
+		_spec.SetField(testhealthreport.FieldMaxDurationInMs, field.TypeInt64, value)
+		_node.MaxDurationInMs = value
+	}
+	if value, ok := thrc.mutation.MedianShardDurationInMs(); ok {
+		_spec.SetField(testhealthreport.FieldMedianShardDurationInMs, field.TypeInt64, value)
+		_node.MedianShardDurationInMs = value
+	}
+	if value, ok := thrc.mutation.TimeoutInMs(); ok {
+		_spec.SetField(testhealthreport.FieldTimeoutInMs, field.TypeInt64, value)
+		_node.TimeoutInMs = value
+	}
+	if value, ok := thrc.mutation.SuggestedShardCount(); ok {
+		_spec.SetField(testhealthreport.FieldSuggestedShardCount, field.TypeInt32, value)
+		_node.SuggestedShardCount = value
+	}
+	if value, ok := thrc.mutation.SuggestedSize(); ok {
+		_spec.SetField(testhealthreport.FieldSuggestedSize, field.TypeEnum, value)
+		_node.SuggestedSize = value
+	}
+	if nodes := thrc.mutation.BazelInvocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testhealthreport.BazelInvocationTable,
+			Columns: []string{testhealthreport.BazelInvocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.bazel_invocation_test_health_reports = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TestHealthReport.Create().
+//		SetLabel(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TestHealthReportUpsert) {
+//			SetLabel(v+v).
+//		}).
+//		Exec(ctx)
+func (thrc *TestHealthReportCreate) OnConflict(opts ...sql.ConflictOption) *TestHealthReportUpsertOne {
+	thrc.conflict = opts
+	return &TestHealthReportUpsertOne{
+		create: thrc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TestHealthReport.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (thrc *TestHealthReportCreate) OnConflictColumns(columns ...string) *TestHealthReportUpsertOne {
+	thrc.conflict = append(thrc.conflict, sql.ConflictColumns(columns...))
+	return &TestHealthReportUpsertOne{
+		create: thrc,
+	}
+}
+
+type (
+	// TestHealthReportUpsertOne is the builder for "upsert"-ing
+	//  one TestHealthReport node.
+	TestHealthReportUpsertOne struct {
+		create *TestHealthReportCreate
+	}
+
+	// TestHealthReportUpsert is the "OnConflict" setter.
+	TestHealthReportUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetLabel sets the "label" field.
+func (u *TestHealthReportUpsert) SetLabel(v string) *TestHealthReportUpsert {
+	u.Set(testhealthreport.FieldLabel, v)
+	return u
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *TestHealthReportUpsert) UpdateLabel() *TestHealthReportUpsert {
+	u.SetExcluded(testhealthreport.FieldLabel)
+	return u
+}
+
+// SetIssue sets the "issue" field.
+func (u *TestHealthReportUpsert) SetIssue(v testhealthreport.Issue) *TestHealthReportUpsert {
+	u.Set(testhealthreport.FieldIssue, v)
+	return u
+}
+
+// UpdateIssue sets the "issue" field to the value that was provided on create.
+func (u *TestHealthReportUpsert) UpdateIssue() *TestHealthReportUpsert {
+	u.SetExcluded(testhealthreport.FieldIssue)
+	return u
+}
+
+// SetTestSize sets the "test_size" field.
+func (u *TestHealthReportUpsert) SetTestSize(v testhealthreport.TestSize) *TestHealthReportUpsert {
+	u.Set(testhealthreport.FieldTestSize, v)
+	return u
+}
+
+// UpdateTestSize sets the "test_size" field to the value that was provided on create.
+func (u *TestHealthReportUpsert) UpdateTestSize() *TestHealthReportUpsert {
+	u.SetExcluded(testhealthreport.FieldTestSize)
+	return u
+}
+
+// SetShardCount sets the "shard_count" field.
+func (u *TestHealthReportUpsert) SetShardCount(v int32) *TestHealthReportUpsert {
+	u.Set(testhealthreport.FieldShardCount, v)
+	return u
+}
+
+// UpdateShardCount sets the "shard_count" field to the value that was provided on create.
+func (u *TestHealthReportUpsert) UpdateShardCount() *TestHealthReportUpsert {
+	u.SetExcluded(testhealthreport.FieldShardCount)
+	return u
+}
+
+// AddShardCount adds v to the "shard_count" field.
+func (u *TestHealthReportUpsert) AddShardCount(v int32) *TestHealthReportUpsert {
+	u.Add(testhealthreport.FieldShardCount, v)
+	return u
+}
+
+// SetMaxDurationInMs sets the "max_duration_in_ms" field.
+func (u *TestHealthReportUpsert) SetMaxDurationInMs(v int64) *TestHealthReportUpsert {
+	u.Set(testhealthreport.FieldMaxDurationInMs, v)
+	return u
+}
+
+// UpdateMaxDurationInMs sets the "max_duration_in_ms" field to the value that was provided on create.
+func (u *TestHealthReportUpsert) UpdateMaxDurationInMs() *TestHealthReportUpsert {
+	u.SetExcluded(testhealthreport.FieldMaxDurationInMs)
+	return u
+}
+
+// AddMaxDurationInMs adds v to the "max_duration_in_ms" field.
+func (u *TestHealthReportUpsert) AddMaxDurationInMs(v int64) *TestHealthReportUpsert {
+	u.Add(testhealthreport.FieldMaxDurationInMs, v)
+	return u
+}
+
+// SetMedianShardDurationInMs sets the "median_shard_duration_in_ms" field.
+func (u *TestHealthReportUpsert) SetMedianShardDurationInMs(v int64) *TestHealthReportUpsert {
+	u.Set(testhealthreport.FieldMedianShardDurationInMs, v)
+	return u
+}
+
+// UpdateMedianShardDurationInMs sets the "median_shard_duration_in_ms" field to the value that was provided on create.
+func (u *TestHealthReportUpsert) UpdateMedianShardDurationInMs() *TestHealthReportUpsert {
+	u.SetExcluded(testhealthreport.FieldMedianShardDurationInMs)
+	return u
+}
+
+// AddMedianShardDurationInMs adds v to the "median_shard_duration_in_ms" field.
+func (u *TestHealthReportUpsert) AddMedianShardDurationInMs(v int64) *TestHealthReportUpsert {
+	u.Add(testhealthreport.FieldMedianShardDurationInMs, v)
+	return u
+}
+
+// SetTimeoutInMs sets the "timeout_in_ms" field.
+func (u *TestHealthReportUpsert) SetTimeoutInMs(v int64) *TestHealthReportUpsert {
+	u.Set(testhealthreport.FieldTimeoutInMs, v)
+	return u
+}
+
+// UpdateTimeoutInMs sets the "timeout_in_ms" field to the value that was provided on create.
+func (u *TestHealthReportUpsert) UpdateTimeoutInMs() *TestHealthReportUpsert {
+	u.SetExcluded(testhealthreport.FieldTimeoutInMs)
+	return u
+}
+
+// AddTimeoutInMs adds v to the "timeout_in_ms" field.
+func (u *TestHealthReportUpsert) AddTimeoutInMs(v int64) *TestHealthReportUpsert {
+	u.Add(testhealthreport.FieldTimeoutInMs, v)
+	return u
+}
+
+// SetSuggestedShardCount sets the "suggested_shard_count" field.
+func (u *TestHealthReportUpsert) SetSuggestedShardCount(v int32) *TestHealthReportUpsert {
+	u.Set(testhealthreport.FieldSuggestedShardCount, v)
+	return u
+}
+
+// UpdateSuggestedShardCount sets the "suggested_shard_count" field to the value that was provided on create.
+func (u *TestHealthReportUpsert) UpdateSuggestedShardCount() *TestHealthReportUpsert {
+	u.SetExcluded(testhealthreport.FieldSuggestedShardCount)
+	return u
+}
+
+// AddSuggestedShardCount adds v to the "suggested_shard_count" field.
+func (u *TestHealthReportUpsert) AddSuggestedShardCount(v int32) *TestHealthReportUpsert {
+	u.Add(testhealthreport.FieldSuggestedShardCount, v)
+	return u
+}
+
+// SetSuggestedSize sets the "suggested_size" field.
+func (u *TestHealthReportUpsert) SetSuggestedSize(v testhealthreport.SuggestedSize) *TestHealthReportUpsert {
+	u.Set(testhealthreport.FieldSuggestedSize, v)
+	return u
+}
+
+// UpdateSuggestedSize sets the "suggested_size" field to the value that was provided on create.
+func (u *TestHealthReportUpsert) UpdateSuggestedSize() *TestHealthReportUpsert {
+	u.SetExcluded(testhealthreport.FieldSuggestedSize)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TestHealthReport.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TestHealthReportUpsertOne) UpdateNewValues() *TestHealthReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TestHealthReport.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TestHealthReportUpsertOne) Ignore() *TestHealthReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TestHealthReportUpsertOne) DoNothing() *TestHealthReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TestHealthReportCreate.OnConflict
+// documentation for more info.
+func (u *TestHealthReportUpsertOne) Update(set func(*TestHealthReportUpsert)) *TestHealthReportUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TestHealthReportUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetLabel sets the "label" field.
+func (u *TestHealthReportUpsertOne) SetLabel(v string) *TestHealthReportUpsertOne {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.SetLabel(v)
+	})
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *TestHealthReportUpsertOne) UpdateLabel() *TestHealthReportUpsertOne {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.UpdateLabel()
+	})
+}
+
+// SetIssue sets the "issue" field.
+func (u *TestHealthReportUpsertOne) SetIssue(v testhealthreport.Issue) *TestHealthReportUpsertOne {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.SetIssue(v)
+	})
+}
+
+// UpdateIssue sets the "issue" field to the value that was provided on create.
+func (u *TestHealthReportUpsertOne) UpdateIssue() *TestHealthReportUpsertOne {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.UpdateIssue()
+	})
+}
+
+// SetTestSize sets the "test_size" field.
+func (u *TestHealthReportUpsertOne) SetTestSize(v testhealthreport.TestSize) *TestHealthReportUpsertOne {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.SetTestSize(v)
+	})
+}
+
+// UpdateTestSize sets the "test_size" field to the value that was provided on create.
+func (u *TestHealthReportUpsertOne) UpdateTestSize() *TestHealthReportUpsertOne {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.UpdateTestSize()
+	})
+}
+
+// SetShardCount sets the "shard_count" field.
+func (u *TestHealthReportUpsertOne) SetShardCount(v int32) *TestHealthReportUpsertOne {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.SetShardCount(v)
+	})
+}
+
+// AddShardCount adds v to the "shard_count" field.
+func (u *TestHealthReportUpsertOne) AddShardCount(v int32) *TestHealthReportUpsertOne {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.AddShardCount(v)
+	})
+}
+
+// UpdateShardCount sets the "shard_count" field to the value that was provided on create.
+func (u *TestHealthReportUpsertOne) UpdateShardCount() *TestHealthReportUpsertOne {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.UpdateShardCount()
+	})
+}
+
+// SetMaxDurationInMs sets the "max_duration_in_ms" field.
+func (u *TestHealthReportUpsertOne) SetMaxDurationInMs(v int64) *TestHealthReportUpsertOne {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.SetMaxDurationInMs(v)
+	})
+}
+
+// AddMaxDurationInMs adds v to the "max_duration_in_ms" field.
+func (u *TestHealthReportUpsertOne) AddMaxDurationInMs(v int64) *TestHealthReportUpsertOne {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.AddMaxDurationInMs(v)
+	})
+}
+
+// UpdateMaxDurationInMs sets the "max_duration_in_ms" field to the value that was provided on create.
+func (u *TestHealthReportUpsertOne) UpdateMaxDurationInMs() *TestHealthReportUpsertOne {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.UpdateMaxDurationInMs()
+	})
+}
+
+// SetMedianShardDurationInMs sets the "median_shard_duration_in_ms" field.
+func (u *TestHealthReportUpsertOne) SetMedianShardDurationInMs(v int64) *TestHealthReportUpsertOne {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.SetMedianShardDurationInMs(v)
+	})
+}
+
+// AddMedianShardDurationInMs adds v to the "median_shard_duration_in_ms" field.
+func (u *TestHealthReportUpsertOne) AddMedianShardDurationInMs(v int64) *TestHealthReportUpsertOne {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.AddMedianShardDurationInMs(v)
+	})
+}
+
+// UpdateMedianShardDurationInMs sets the "median_shard_duration_in_ms" field to the value that was provided on create.
+func (u *TestHealthReportUpsertOne) UpdateMedianShardDurationInMs() *TestHealthReportUpsertOne {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.UpdateMedianShardDurationInMs()
+	})
+}
+
+// SetTimeoutInMs sets the "timeout_in_ms" field.
+func (u *TestHealthReportUpsertOne) SetTimeoutInMs(v int64) *TestHealthReportUpsertOne {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.SetTimeoutInMs(v)
+	})
+}
+
+// AddTimeoutInMs adds v to the "timeout_in_ms" field.
+func (u *TestHealthReportUpsertOne) AddTimeoutInMs(v int64) *TestHealthReportUpsertOne {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.AddTimeoutInMs(v)
+	})
+}
+
+// UpdateTimeoutInMs sets the "timeout_in_ms" field to the value that was provided on create.
+func (u *TestHealthReportUpsertOne) UpdateTimeoutInMs() *TestHealthReportUpsertOne {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.UpdateTimeoutInMs()
+	})
+}
+
+// SetSuggestedShardCount sets the "suggested_shard_count" field.
+func (u *TestHealthReportUpsertOne) SetSuggestedShardCount(v int32) *TestHealthReportUpsertOne {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.SetSuggestedShardCount(v)
+	})
+}
+
+// AddSuggestedShardCount adds v to the "suggested_shard_count" field.
+func (u *TestHealthReportUpsertOne) AddSuggestedShardCount(v int32) *TestHealthReportUpsertOne {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.AddSuggestedShardCount(v)
+	})
+}
+
+// UpdateSuggestedShardCount sets the "suggested_shard_count" field to the value that was provided on create.
+func (u *TestHealthReportUpsertOne) UpdateSuggestedShardCount() *TestHealthReportUpsertOne {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.UpdateSuggestedShardCount()
+	})
+}
+
+// SetSuggestedSize sets the "suggested_size" field.
+func (u *TestHealthReportUpsertOne) SetSuggestedSize(v testhealthreport.SuggestedSize) *TestHealthReportUpsertOne {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.SetSuggestedSize(v)
+	})
+}
+
+// UpdateSuggestedSize sets the "suggested_size" field to the value that was provided on create.
+func (u *TestHealthReportUpsertOne) UpdateSuggestedSize() *TestHealthReportUpsertOne {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.UpdateSuggestedSize()
+	})
+}
+
+// Exec executes the query.
+func (u *TestHealthReportUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TestHealthReportCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TestHealthReportUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TestHealthReportUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TestHealthReportUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TestHealthReportCreateBulk is the builder for creating many TestHealthReport entities in bulk.
+type TestHealthReportCreateBulk struct {
+	config
+	err      error
+	builders []*TestHealthReportCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TestHealthReport entities in the database.
+func (thrcb *TestHealthReportCreateBulk) Save(ctx context.Context) ([]*TestHealthReport, error) {
+	if thrcb.err != nil {
+		return nil, thrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(thrcb.builders))
+	nodes := make([]*TestHealthReport, len(thrcb.builders))
+	mutators := make([]Mutator, len(thrcb.builders))
+	for i := range thrcb.builders {
+		func(i int, root context.Context) {
+			builder := thrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TestHealthReportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, thrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = thrcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, thrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, thrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (thrcb *TestHealthReportCreateBulk) SaveX(ctx context.Context) []*TestHealthReport {
+	v, err := thrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (thrcb *TestHealthReportCreateBulk) Exec(ctx context.Context) error {
+	_, err := thrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (thrcb *TestHealthReportCreateBulk) ExecX(ctx context.Context) {
+	if err := thrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TestHealthReport.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TestHealthReportUpsert) {
+//			SetLabel(v+v).
+//		}).
+//		Exec(ctx)
+func (thrcb *TestHealthReportCreateBulk) OnConflict(opts ...sql.ConflictOption) *TestHealthReportUpsertBulk {
+	thrcb.conflict = opts
+	return &TestHealthReportUpsertBulk{
+		create: thrcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TestHealthReport.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (thrcb *TestHealthReportCreateBulk) OnConflictColumns(columns ...string) *TestHealthReportUpsertBulk {
+	thrcb.conflict = append(thrcb.conflict, sql.ConflictColumns(columns...))
+	return &TestHealthReportUpsertBulk{
+		create: thrcb,
+	}
+}
+
+// TestHealthReportUpsertBulk is the builder for "upsert"-ing
+// a bulk of TestHealthReport nodes.
+type TestHealthReportUpsertBulk struct {
+	create *TestHealthReportCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TestHealthReport.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TestHealthReportUpsertBulk) UpdateNewValues() *TestHealthReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TestHealthReport.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TestHealthReportUpsertBulk) Ignore() *TestHealthReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TestHealthReportUpsertBulk) DoNothing() *TestHealthReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TestHealthReportCreateBulk.OnConflict
+// documentation for more info.
+func (u *TestHealthReportUpsertBulk) Update(set func(*TestHealthReportUpsert)) *TestHealthReportUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TestHealthReportUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetLabel sets the "label" field.
+func (u *TestHealthReportUpsertBulk) SetLabel(v string) *TestHealthReportUpsertBulk {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.SetLabel(v)
+	})
+}
+
+// UpdateLabel sets the "label" field to the value that was provided on create.
+func (u *TestHealthReportUpsertBulk) UpdateLabel() *TestHealthReportUpsertBulk {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.UpdateLabel()
+	})
+}
+
+// SetIssue sets the "issue" field.
+func (u *TestHealthReportUpsertBulk) SetIssue(v testhealthreport.Issue) *TestHealthReportUpsertBulk {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.SetIssue(v)
+	})
+}
+
+// UpdateIssue sets the "issue" field to the value that was provided on create.
+func (u *TestHealthReportUpsertBulk) UpdateIssue() *TestHealthReportUpsertBulk {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.UpdateIssue()
+	})
+}
+
+// SetTestSize sets the "test_size" field.
+func (u *TestHealthReportUpsertBulk) SetTestSize(v testhealthreport.TestSize) *TestHealthReportUpsertBulk {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.SetTestSize(v)
+	})
+}
+
+// UpdateTestSize sets the "test_size" field to the value that was provided on create.
+func (u *TestHealthReportUpsertBulk) UpdateTestSize() *TestHealthReportUpsertBulk {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.UpdateTestSize()
+	})
+}
+
+// SetShardCount sets the "shard_count" field.
+func (u *TestHealthReportUpsertBulk) SetShardCount(v int32) *TestHealthReportUpsertBulk {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.SetShardCount(v)
+	})
+}
+
+// AddShardCount adds v to the "shard_count" field.
+func (u *TestHealthReportUpsertBulk) AddShardCount(v int32) *TestHealthReportUpsertBulk {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.AddShardCount(v)
+	})
+}
+
+// UpdateShardCount sets the "shard_count" field to the value that was provided on create.
+func (u *TestHealthReportUpsertBulk) UpdateShardCount() *TestHealthReportUpsertBulk {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.UpdateShardCount()
+	})
+}
+
+// SetMaxDurationInMs sets the "max_duration_in_ms" field.
+func (u *TestHealthReportUpsertBulk) SetMaxDurationInMs(v int64) *TestHealthReportUpsertBulk {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.SetMaxDurationInMs(v)
+	})
+}
+
+// AddMaxDurationInMs adds v to the "max_duration_in_ms" field.
+func (u *TestHealthReportUpsertBulk) AddMaxDurationInMs(v int64) *TestHealthReportUpsertBulk {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.AddMaxDurationInMs(v)
+	})
+}
+
+// UpdateMaxDurationInMs sets the "max_duration_in_ms" field to the value that was provided on create.
+func (u *TestHealthReportUpsertBulk) UpdateMaxDurationInMs() *TestHealthReportUpsertBulk {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.UpdateMaxDurationInMs()
+	})
+}
+
+// SetMedianShardDurationInMs sets the "median_shard_duration_in_ms" field.
+func (u *TestHealthReportUpsertBulk) SetMedianShardDurationInMs(v int64) *TestHealthReportUpsertBulk {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.SetMedianShardDurationInMs(v)
+	})
+}
+
+// AddMedianShardDurationInMs adds v to the "median_shard_duration_in_ms" field.
+func (u *TestHealthReportUpsertBulk) AddMedianShardDurationInMs(v int64) *TestHealthReportUpsertBulk {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.AddMedianShardDurationInMs(v)
+	})
+}
+
+// UpdateMedianShardDurationInMs sets the "median_shard_duration_in_ms" field to the value that was provided on create.
+func (u *TestHealthReportUpsertBulk) UpdateMedianShardDurationInMs() *TestHealthReportUpsertBulk {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.UpdateMedianShardDurationInMs()
+	})
+}
+
+// SetTimeoutInMs sets the "timeout_in_ms" field.
+func (u *TestHealthReportUpsertBulk) SetTimeoutInMs(v int64) *TestHealthReportUpsertBulk {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.SetTimeoutInMs(v)
+	})
+}
+
+// AddTimeoutInMs adds v to the "timeout_in_ms" field.
+func (u *TestHealthReportUpsertBulk) AddTimeoutInMs(v int64) *TestHealthReportUpsertBulk {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.AddTimeoutInMs(v)
+	})
+}
+
+// UpdateTimeoutInMs sets the "timeout_in_ms" field to the value that was provided on create.
+func (u *TestHealthReportUpsertBulk) UpdateTimeoutInMs() *TestHealthReportUpsertBulk {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.UpdateTimeoutInMs()
+	})
+}
+
+// SetSuggestedShardCount sets the "suggested_shard_count" field.
+func (u *TestHealthReportUpsertBulk) SetSuggestedShardCount(v int32) *TestHealthReportUpsertBulk {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.SetSuggestedShardCount(v)
+	})
+}
+
+// AddSuggestedShardCount adds v to the "suggested_shard_count" field.
+func (u *TestHealthReportUpsertBulk) AddSuggestedShardCount(v int32) *TestHealthReportUpsertBulk {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.AddSuggestedShardCount(v)
+	})
+}
+
+// UpdateSuggestedShardCount sets the "suggested_shard_count" field to the value that was provided on create.
+func (u *TestHealthReportUpsertBulk) UpdateSuggestedShardCount() *TestHealthReportUpsertBulk {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.UpdateSuggestedShardCount()
+	})
+}
+
+// SetSuggestedSize sets the "suggested_size" field.
+func (u *TestHealthReportUpsertBulk) SetSuggestedSize(v testhealthreport.SuggestedSize) *TestHealthReportUpsertBulk {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.SetSuggestedSize(v)
+	})
+}
+
+// UpdateSuggestedSize sets the "suggested_size" field to the value that was provided on create.
+func (u *TestHealthReportUpsertBulk) UpdateSuggestedSize() *TestHealthReportUpsertBulk {
+	return u.Update(func(s *TestHealthReportUpsert) {
+		s.UpdateSuggestedSize()
+	})
+}
+
+// Exec executes the query.
+func (u *TestHealthReportUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TestHealthReportCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TestHealthReportCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TestHealthReportUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
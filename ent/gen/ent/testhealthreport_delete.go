@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/predicate"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/testhealthreport"
+)
+
+// TestHealthReportDelete is the builder for deleting a TestHealthReport entity.
+type TestHealthReportDelete struct {
+	config
+	hooks    []Hook
+	mutation *TestHealthReportMutation
+}
+
+// Where appends a list predicates to the TestHealthReportDelete builder.
+func (thrd *TestHealthReportDelete) Where(ps ...predicate.TestHealthReport) *TestHealthReportDelete {
+	thrd.mutation.Where(ps...)
+	return thrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (thrd *TestHealthReportDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, thrd.sqlExec, thrd.mutation, thrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (thrd *TestHealthReportDelete) ExecX(ctx context.Context) int {
+	n, err := thrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (thrd *TestHealthReportDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(testhealthreport.Table, sqlgraph.NewFieldSpec(testhealthreport.FieldID, field.TypeInt))
+	if ps := thrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, thrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	thrd.mutation.done = true
+	return affected, err
+}
+
+// TestHealthReportDeleteOne is the builder for deleting a single TestHealthReport entity.
+type TestHealthReportDeleteOne struct {
+	thrd *TestHealthReportDelete
+}
+
+// Where appends a list predicates to the TestHealthReportDelete builder.
+func (thrdo *TestHealthReportDeleteOne) Where(ps ...predicate.TestHealthReport) *TestHealthReportDeleteOne {
+	thrdo.thrd.mutation.Where(ps...)
+	return thrdo
+}
+
+// Exec executes the deletion query.
+func (thrdo *TestHealthReportDeleteOne) Exec(ctx context.Context) error {
+	n, err := thrdo.thrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{testhealthreport.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (thrdo *TestHealthReportDeleteOne) ExecX(ctx context.Context) {
+	if err := thrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,626 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/predicate"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/testhealthreport"
+)
+
+// TestHealthReportQuery is the builder for querying TestHealthReport entities.
+type TestHealthReportQuery struct {
+	config
+	ctx                 *QueryContext
+	order               []testhealthreport.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.TestHealthReport
+	withBazelInvocation *BazelInvocationQuery
+	withFKs             bool
+	modifiers           []func(*sql.Selector)
+	loadTotal           []func(context.Context, []*TestHealthReport) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TestHealthReportQuery builder.
+func (thrq *TestHealthReportQuery) Where(ps ...predicate.TestHealthReport) *TestHealthReportQuery {
+	thrq.predicates = append(thrq.predicates, ps...)
+	return thrq
+}
+
+// Limit the number of records to be returned by this query.
+func (thrq *TestHealthReportQuery) Limit(limit int) *TestHealthReportQuery {
+	thrq.ctx.Limit = &limit
+	return thrq
+}
+
+// Offset to start from.
+func (thrq *TestHealthReportQuery) Offset(offset int) *TestHealthReportQuery {
+	thrq.ctx.Offset = &offset
+	return thrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (thrq *TestHealthReportQuery) Unique(unique bool) *TestHealthReportQuery {
+	thrq.ctx.Unique = &unique
+	return thrq
+}
+
+// Order specifies how the records should be ordered.
+func (thrq *TestHealthReportQuery) Order(o ...testhealthreport.OrderOption) *TestHealthReportQuery {
+	thrq.order = append(thrq.order, o...)
+	return thrq
+}
+
+// QueryBazelInvocation chains the current query on the "bazel_invocation" edge.
+func (thrq *TestHealthReportQuery) QueryBazelInvocation() *BazelInvocationQuery {
+	query := (&BazelInvocationClient{config: thrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := thrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := thrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(testhealthreport.Table, testhealthreport.FieldID, selector),
+			sqlgraph.To(bazelinvocation.Table, bazelinvocation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, testhealthreport.BazelInvocationTable, testhealthreport.BazelInvocationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(thrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TestHealthReport entity from the query.
+// Returns a *NotFoundError when no TestHealthReport was found.
+func (thrq *TestHealthReportQuery) First(ctx context.Context) (*TestHealthReport, error) {
+	nodes, err := thrq.Limit(1).All(setContextOp(ctx, thrq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{testhealthreport.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (thrq *TestHealthReportQuery) FirstX(ctx context.Context) *TestHealthReport {
+	node, err := thrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TestHealthReport ID from the query.
+// Returns a *NotFoundError when no TestHealthReport ID was found.
+func (thrq *TestHealthReportQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = thrq.Limit(1).IDs(setContextOp(ctx, thrq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{testhealthreport.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (thrq *TestHealthReportQuery) FirstIDX(ctx context.Context) int {
+	id, err := thrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TestHealthReport entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TestHealthReport entity is found.
+// Returns a *NotFoundError when no TestHealthReport entities are found.
+func (thrq *TestHealthReportQuery) Only(ctx context.Context) (*TestHealthReport, error) {
+	nodes, err := thrq.Limit(2).All(setContextOp(ctx, thrq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{testhealthreport.Label}
+	default:
+		return nil, &NotSingularError{testhealthreport.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (thrq *TestHealthReportQuery) OnlyX(ctx context.Context) *TestHealthReport {
+	node, err := thrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TestHealthReport ID in the query.
+// Returns a *NotSingularError when more than one TestHealthReport ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (thrq *TestHealthReportQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = thrq.Limit(2).IDs(setContextOp(ctx, thrq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{testhealthreport.Label}
+	default:
+		err = &NotSingularError{testhealthreport.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (thrq *TestHealthReportQuery) OnlyIDX(ctx context.Context) int {
+	id, err := thrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TestHealthReports.
+func (thrq *TestHealthReportQuery) All(ctx context.Context) ([]*TestHealthReport, error) {
+	ctx = setContextOp(ctx, thrq.ctx, "All")
+	if err := thrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TestHealthReport, *TestHealthReportQuery]()
+	return withInterceptors[[]*TestHealthReport](ctx, thrq, qr, thrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (thrq *TestHealthReportQuery) AllX(ctx context.Context) []*TestHealthReport {
+	nodes, err := thrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TestHealthReport IDs.
+func (thrq *TestHealthReportQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if thrq.ctx.Unique == nil && thrq.path != nil {
+		thrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, thrq.ctx, "IDs")
+	if err = thrq.Select(testhealthreport.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (thrq *TestHealthReportQuery) IDsX(ctx context.Context) []int {
+	ids, err := thrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (thrq *TestHealthReportQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, thrq.ctx, "Count")
+	if err := thrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, thrq, querierCount[*TestHealthReportQuery](), thrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (thrq *TestHealthReportQuery) CountX(ctx context.Context) int {
+	count, err := thrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (thrq *TestHealthReportQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, thrq.ctx, "Exist")
+	switch _, err := thrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (thrq *TestHealthReportQuery) ExistX(ctx context.Context) bool {
+	exist, err := thrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TestHealthReportQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (thrq *TestHealthReportQuery) Clone() *TestHealthReportQuery {
+	if thrq == nil {
+		return nil
+	}
+	return &TestHealthReportQuery{
+		config:              thrq.config,
+		ctx:                 thrq.ctx.Clone(),
+		order:               append([]testhealthreport.OrderOption{}, thrq.order...),
+		inters:              append([]Interceptor{}, thrq.inters...),
+		predicates:          append([]predicate.TestHealthReport{}, thrq.predicates...),
+		withBazelInvocation: thrq.withBazelInvocation.Clone(),
+		// clone intermediate query.
+		sql:  thrq.sql.Clone(),
+		path: thrq.path,
+	}
+}
+
+// WithBazelInvocation tells the query-builder to eager-load the nodes that are connected to
+// the "bazel_invocation" edge. The optional arguments are used to configure the query builder of the edge.
+func (thrq *TestHealthReportQuery) WithBazelInvocation(opts ...func(*BazelInvocationQuery)) *TestHealthReportQuery {
+	query := (&BazelInvocationClient{config: thrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	thrq.withBazelInvocation = query
+	return thrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Label string `json:"label,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TestHealthReport.Query().
+//		GroupBy(testhealthreport.FieldLabel).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (thrq *TestHealthReportQuery) GroupBy(field string, fields ...string) *TestHealthReportGroupBy {
+	thrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TestHealthReportGroupBy{build: thrq}
+	grbuild.flds = &thrq.ctx.Fields
+	grbuild.label = testhealthreport.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Label string `json:"label,omitempty"`
+//	}
+//
+//	client.TestHealthReport.Query().
+//		Select(testhealthreport.FieldLabel).
+//		Scan(ctx, &v)
+func (thrq *TestHealthReportQuery) Select(fields ...string) *TestHealthReportSelect {
+	thrq.ctx.Fields = append(thrq.ctx.Fields, fields...)
+	sbuild := &TestHealthReportSelect{TestHealthReportQuery: thrq}
+	sbuild.label = testhealthreport.Label
+	sbuild.flds, sbuild.scan = &thrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TestHealthReportSelect configured with the given aggregations.
+func (thrq *TestHealthReportQuery) Aggregate(fns ...AggregateFunc) *TestHealthReportSelect {
+	return thrq.Select().Aggregate(fns...)
+}
+
+func (thrq *TestHealthReportQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range thrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, thrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range thrq.ctx.Fields {
+		if !testhealthreport.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if thrq.path != nil {
+		prev, err := thrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		thrq.sql = prev
+	}
+	return nil
+}
+
+func (thrq *TestHealthReportQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TestHealthReport, error) {
+	var (
+		nodes       = []*TestHealthReport{}
+		withFKs     = thrq.withFKs
+		_spec       = thrq.querySpec()
+		loadedTypes = [1]bool{
+			thrq.withBazelInvocation != nil,
+		}
+	)
+	if thrq.withBazelInvocation != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, testhealthreport.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TestHealthReport).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TestHealthReport{config: thrq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(thrq.modifiers) > 0 {
+		_spec.Modifiers = thrq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, thrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := thrq.withBazelInvocation; query != nil {
+		if err := thrq.loadBazelInvocation(ctx, query, nodes, nil,
+			func(n *TestHealthReport, e *BazelInvocation) { n.Edges.BazelInvocation = e }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range thrq.loadTotal {
+		if err := thrq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (thrq *TestHealthReportQuery) loadBazelInvocation(ctx context.Context, query *BazelInvocationQuery, nodes []*TestHealthReport, init func(*TestHealthReport), assign func(*TestHealthReport, *BazelInvocation)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TestHealthReport)
+	for i := range nodes {
+		if nodes[i].bazel_invocation_test_health_reports == nil {
+			continue
+		}
+		fk := *nodes[i].bazel_invocation_test_health_reports
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(bazelinvocation.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "bazel_invocation_test_health_reports" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (thrq *TestHealthReportQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := thrq.querySpec()
+	if len(thrq.modifiers) > 0 {
+		_spec.Modifiers = thrq.modifiers
+	}
+	_spec.Node.Columns = thrq.ctx.Fields
+	if len(thrq.ctx.Fields) > 0 {
+		_spec.Unique = thrq.ctx.Unique != nil && *thrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, thrq.driver, _spec)
+}
+
+func (thrq *TestHealthReportQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(testhealthreport.Table, testhealthreport.Columns, sqlgraph.NewFieldSpec(testhealthreport.FieldID, field.TypeInt))
+	_spec.From = thrq.sql
+	if unique := thrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if thrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := thrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, testhealthreport.FieldID)
+		for i := range fields {
+			if fields[i] != testhealthreport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := thrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := thrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := thrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := thrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (thrq *TestHealthReportQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(thrq.driver.Dialect())
+	t1 := builder.Table(testhealthreport.Table)
+	columns := thrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = testhealthreport.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if thrq.sql != nil {
+		selector = thrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if thrq.ctx.Unique != nil && *thrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range thrq.predicates {
+		p(selector)
+	}
+	for _, p := range thrq.order {
+		p(selector)
+	}
+	if offset := thrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := thrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TestHealthReportGroupBy is the group-by builder for TestHealthReport entities.
+type TestHealthReportGroupBy struct {
+	selector
+	build *TestHealthReportQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (thrgb *TestHealthReportGroupBy) Aggregate(fns ...AggregateFunc) *TestHealthReportGroupBy {
+	thrgb.fns = append(thrgb.fns, fns...)
+	return thrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (thrgb *TestHealthReportGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, thrgb.build.ctx, "GroupBy")
+	if err := thrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TestHealthReportQuery, *TestHealthReportGroupBy](ctx, thrgb.build, thrgb, thrgb.build.inters, v)
+}
+
+func (thrgb *TestHealthReportGroupBy) sqlScan(ctx context.Context, root *TestHealthReportQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(thrgb.fns))
+	for _, fn := range thrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*thrgb.flds)+len(thrgb.fns))
+		for _, f := range *thrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*thrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := thrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TestHealthReportSelect is the builder for selecting fields of TestHealthReport entities.
+type TestHealthReportSelect struct {
+	*TestHealthReportQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (thrs *TestHealthReportSelect) Aggregate(fns ...AggregateFunc) *TestHealthReportSelect {
+	thrs.fns = append(thrs.fns, fns...)
+	return thrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (thrs *TestHealthReportSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, thrs.ctx, "Select")
+	if err := thrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TestHealthReportQuery, *TestHealthReportSelect](ctx, thrs.TestHealthReportQuery, thrs, thrs.inters, v)
+}
+
+func (thrs *TestHealthReportSelect) sqlScan(ctx context.Context, root *TestHealthReportQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(thrs.fns))
+	for _, fn := range thrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*thrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := thrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
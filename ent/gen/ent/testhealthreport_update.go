@@ -0,0 +1,736 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/predicate"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/testhealthreport"
+)
+
+// TestHealthReportUpdate is the builder for updating TestHealthReport entities.
+type TestHealthReportUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TestHealthReportMutation
+}
+
+// Where appends a list predicates to the TestHealthReportUpdate builder.
+func (thru *TestHealthReportUpdate) Where(ps ...predicate.TestHealthReport) *TestHealthReportUpdate {
+	thru.mutation.Where(ps...)
+	return thru
+}
+
+// SetLabel sets the "label" field.
+func (thru *TestHealthReportUpdate) SetLabel(s string) *TestHealthReportUpdate {
+	thru.mutation.SetLabel(s)
+	return thru
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (thru *TestHealthReportUpdate) SetNillableLabel(s *string) *TestHealthReportUpdate {
+	if s != nil {
+		thru.SetLabel(*s)
+	}
+	return thru
+}
+
+// SetIssue sets the "issue" field.
+func (thru *TestHealthReportUpdate) SetIssue(t testhealthreport.Issue) *TestHealthReportUpdate {
+	thru.mutation.SetIssue(t)
+	return thru
+}
+
+// SetNillableIssue sets the "issue" field if the given value is not nil.
+func (thru *TestHealthReportUpdate) SetNillableIssue(t *testhealthreport.Issue) *TestHealthReportUpdate {
+	if t != nil {
+		thru.SetIssue(*t)
+	}
+	return thru
+}
+
+// SetTestSize sets the "test_size" field.
+func (thru *TestHealthReportUpdate) SetTestSize(ts testhealthreport.TestSize) *TestHealthReportUpdate {
+	thru.mutation.SetTestSize(ts)
+	return thru
+}
+
+// SetNillableTestSize sets the "test_size" field if the given value is not nil.
+func (thru *TestHealthReportUpdate) SetNillableTestSize(ts *testhealthreport.TestSize) *TestHealthReportUpdate {
+	if ts != nil {
+		thru.SetTestSize(*ts)
+	}
+	return thru
+}
+
+// SetShardCount sets the "shard_count" field.
+func (thru *TestHealthReportUpdate) SetShardCount(i int32) *TestHealthReportUpdate {
+	thru.mutation.ResetShardCount()
+	thru.mutation.SetShardCount(i)
+	return thru
+}
+
+// SetNillableShardCount sets the "shard_count" field if the given value is not nil.
+func (thru *TestHealthReportUpdate) SetNillableShardCount(i *int32) *TestHealthReportUpdate {
+	if i != nil {
+		thru.SetShardCount(*i)
+	}
+	return thru
+}
+
+// AddShardCount adds i to the "shard_count" field.
+func (thru *TestHealthReportUpdate) AddShardCount(i int32) *TestHealthReportUpdate {
+	thru.mutation.AddShardCount(i)
+	return thru
+}
+
+// SetMaxDurationInMs sets the "max_duration_in_ms" field.
+func (thru *TestHealthReportUpdate) SetMaxDurationInMs(i int64) *TestHealthReportUpdate {
+	thru.mutation.ResetMaxDurationInMs()
+	thru.mutation.SetMaxDurationInMs(i)
+	return thru
+}
+
+// SetNillableMaxDurationInMs sets the "max_duration_in_ms" field if the given value is not nil.
+func (thru *TestHealthReportUpdate) SetNillableMaxDurationInMs(i *int64) *TestHealthReportUpdate {
+	if i != nil {
+		thru.SetMaxDurationInMs(*i)
+	}
+	return thru
+}
+
+// AddMaxDurationInMs adds i to the "max_duration_in_ms" field.
+func (thru *TestHealthReportUpdate) AddMaxDurationInMs(i int64) *TestHealthReportUpdate {
+	thru.mutation.AddMaxDurationInMs(i)
+	return thru
+}
+
+// SetMedianShardDurationInMs sets the "median_shard_duration_in_ms" field.
+func (thru *TestHealthReportUpdate) SetMedianShardDurationInMs(i int64) *TestHealthReportUpdate {
+	thru.mutation.ResetMedianShardDurationInMs()
+	thru.mutation.SetMedianShardDurationInMs(i)
+	return thru
+}
+
+// SetNillableMedianShardDurationInMs sets the "median_shard_duration_in_ms" field if the given value is not nil.
+func (thru *TestHealthReportUpdate) SetNillableMedianShardDurationInMs(i *int64) *TestHealthReportUpdate {
+	if i != nil {
+		thru.SetMedianShardDurationInMs(*i)
+	}
+	return thru
+}
+
+// AddMedianShardDurationInMs adds i to the "median_shard_duration_in_ms" field.
+func (thru *TestHealthReportUpdate) AddMedianShardDurationInMs(i int64) *TestHealthReportUpdate {
+	thru.mutation.AddMedianShardDurationInMs(i)
+	return thru
+}
+
+// SetTimeoutInMs sets the "timeout_in_ms" field.
+func (thru *TestHealthReportUpdate) SetTimeoutInMs(i int64) *TestHealthReportUpdate {
+	thru.mutation.ResetTimeoutInMs()
+	thru.mutation.SetTimeoutInMs(i)
+	return thru
+}
+
+// SetNillableTimeoutInMs sets the "timeout_in_ms" field if the given value is not nil.
+func (thru *TestHealthReportUpdate) SetNillableTimeoutInMs(i *int64) *TestHealthReportUpdate {
+	if i != nil {
+		thru.SetTimeoutInMs(*i)
+	}
+	return thru
+}
+
+// AddTimeoutInMs adds i to the "timeout_in_ms" field.
+func (thru *TestHealthReportUpdate) AddTimeoutInMs(i int64) *TestHealthReportUpdate {
+	thru.mutation.AddTimeoutInMs(i)
+	return thru
+}
+
+// SetSuggestedShardCount sets the "suggested_shard_count" field.
+func (thru *TestHealthReportUpdate) SetSuggestedShardCount(i int32) *TestHealthReportUpdate {
+	thru.mutation.ResetSuggestedShardCount()
+	thru.mutation.SetSuggestedShardCount(i)
+	return thru
+}
+
+// SetNillableSuggestedShardCount sets the "suggested_shard_count" field if the given value is not nil.
+func (thru *TestHealthReportUpdate) SetNillableSuggestedShardCount(i *int32) *TestHealthReportUpdate {
+	if i != nil {
+		thru.SetSuggestedShardCount(*i)
+	}
+	return thru
+}
+
+// AddSuggestedShardCount adds i to the "suggested_shard_count" field.
+func (thru *TestHealthReportUpdate) AddSuggestedShardCount(i int32) *TestHealthReportUpdate {
+	thru.mutation.AddSuggestedShardCount(i)
+	return thru
+}
+
+// SetSuggestedSize sets the "suggested_size" field.
+func (thru *TestHealthReportUpdate) SetSuggestedSize(ts testhealthreport.SuggestedSize) *TestHealthReportUpdate {
+	thru.mutation.SetSuggestedSize(ts)
+	return thru
+}
+
+// SetNillableSuggestedSize sets the "suggested_size" field if the given value is not nil.
+func (thru *TestHealthReportUpdate) SetNillableSuggestedSize(ts *testhealthreport.SuggestedSize) *TestHealthReportUpdate {
+	if ts != nil {
+		thru.SetSuggestedSize(*ts)
+	}
+	return thru
+}
+
+// SetBazelInvocationID sets the "bazel_invocation" edge to the BazelInvocation entity by ID.
+func (thru *TestHealthReportUpdate) SetBazelInvocationID(id int) *TestHealthReportUpdate {
+	thru.mutation.SetBazelInvocationID(id)
+	return thru
+}
+
+// SetNillableBazelInvocationID sets the "bazel_invocation" edge to the BazelInvocation entity by ID if the given value is not nil.
+func (thru *TestHealthReportUpdate) SetNillableBazelInvocationID(id *int) *TestHealthReportUpdate {
+	if id != nil {
+		thru = thru.SetBazelInvocationID(*id)
+	}
+	return thru
+}
+
+// SetBazelInvocation sets the "bazel_invocation" edge to the BazelInvocation entity.
+func (thru *TestHealthReportUpdate) SetBazelInvocation(b *BazelInvocation) *TestHealthReportUpdate {
+	return thru.SetBazelInvocationID(b.ID)
+}
+
+// Mutation returns the TestHealthReportMutation object of the builder.
+func (thru *TestHealthReportUpdate) Mutation() *TestHealthReportMutation {
+	return thru.mutation
+}
+
+// ClearBazelInvocation clears the "bazel_invocation" edge to the BazelInvocation entity.
+func (thru *TestHealthReportUpdate) ClearBazelInvocation() *TestHealthReportUpdate {
+	thru.mutation.ClearBazelInvocation()
+	return thru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (thru *TestHealthReportUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, thru.sqlSave, thru.mutation, thru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (thru *TestHealthReportUpdate) SaveX(ctx context.Context) int {
+	affected, err := thru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (thru *TestHealthReportUpdate) Exec(ctx context.Context) error {
+	_, err := thru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (thru *TestHealthReportUpdate) ExecX(ctx context.Context) {
+	if err := thru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (thru *TestHealthReportUpdate) check() error {
+	if v, ok := thru.mutation.Issue(); ok {
+		if err := testhealthreport.IssueValidator(v); err != nil {
+			return &ValidationError{Name: "issue", err: fmt.Errorf(`ent: validator failed for field "TestHealthReport.issue": %w`, err)}
+		}
+	}
+	if v, ok := thru.mutation.TestSize(); ok {
+		if err := testhealthreport.TestSizeValidator(v); err != nil {
+			return &ValidationError{Name: "test_size", err: fmt.Errorf(`ent: validator failed for field "TestHealthReport.test_size": %w`, err)}
+		}
+	}
+	if v, ok := thru.mutation.SuggestedSize(); ok {
+		if err := testhealthreport.SuggestedSizeValidator(v); err != nil {
+			return &ValidationError{Name: "suggested_size", err: fmt.Errorf(`ent: validator failed for field "TestHealthReport.suggested_size": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (thru *TestHealthReportUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := thru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(testhealthreport.Table, testhealthreport.Columns, sqlgraph.NewFieldSpec(testhealthreport.FieldID, field.TypeInt))
+	if ps := thru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := thru.mutation.Label(); ok {
+		_spec.SetField(testhealthreport.FieldLabel, field.TypeString, value)
+	}
+	if value, ok := thru.mutation.Issue(); ok {
+		_spec.SetField(testhealthreport.FieldIssue, field.TypeEnum, value)
+	}
+	if value, ok := thru.mutation.TestSize(); ok {
+		_spec.SetField(testhealthreport.FieldTestSize, field.TypeEnum, value)
+	}
+	if value, ok := thru.mutation.ShardCount(); ok {
+		_spec.SetField(testhealthreport.FieldShardCount, field.TypeInt32, value)
+	}
+	if value, ok := thru.mutation.AddedShardCount(); ok {
+		_spec.AddField(testhealthreport.FieldShardCount, field.TypeInt32, value)
+	}
+	if value, ok := thru.mutation.MaxDurationInMs(); ok {
+		_spec.SetField(testhealthreport.FieldMaxDurationInMs, field.TypeInt64, value)
+	}
+	if value, ok := thru.mutation.AddedMaxDurationInMs(); ok {
+		_spec.AddField(testhealthreport.FieldMaxDurationInMs, field.TypeInt64, value)
+	}
+	if value, ok := thru.mutation.MedianShardDurationInMs(); ok {
+		_spec.SetField(testhealthreport.FieldMedianShardDurationInMs, field.TypeInt64, value)
+	}
+	if value, ok := thru.mutation.AddedMedianShardDurationInMs(); ok {
+		_spec.AddField(testhealthreport.FieldMedianShardDurationInMs, field.TypeInt64, value)
+	}
+	if value, ok := thru.mutation.TimeoutInMs(); ok {
+		_spec.SetField(testhealthreport.FieldTimeoutInMs, field.TypeInt64, value)
+	}
+	if value, ok := thru.mutation.AddedTimeoutInMs(); ok {
+		_spec.AddField(testhealthreport.FieldTimeoutInMs, field.TypeInt64, value)
+	}
+	if value, ok := thru.mutation.SuggestedShardCount(); ok {
+		_spec.SetField(testhealthreport.FieldSuggestedShardCount, field.TypeInt32, value)
+	}
+	if value, ok := thru.mutation.AddedSuggestedShardCount(); ok {
+		_spec.AddField(testhealthreport.FieldSuggestedShardCount, field.TypeInt32, value)
+	}
+	if value, ok := thru.mutation.SuggestedSize(); ok {
+		_spec.SetField(testhealthreport.FieldSuggestedSize, field.TypeEnum, value)
+	}
+	if thru.mutation.BazelInvocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testhealthreport.BazelInvocationTable,
+			Columns: []string{testhealthreport.BazelInvocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := thru.mutation.BazelInvocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testhealthreport.BazelInvocationTable,
+			Columns: []string{testhealthreport.BazelInvocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, thru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{testhealthreport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	thru.mutation.done = true
+	return n, nil
+}
+
+// TestHealthReportUpdateOne is the builder for updating a single TestHealthReport entity.
+type TestHealthReportUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TestHealthReportMutation
+}
+
+// SetLabel sets the "label" field.
+func (thruo *TestHealthReportUpdateOne) SetLabel(s string) *TestHealthReportUpdateOne {
+	thruo.mutation.SetLabel(s)
+	return thruo
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (thruo *TestHealthReportUpdateOne) SetNillableLabel(s *string) *TestHealthReportUpdateOne {
+	if s != nil {
+		thruo.SetLabel(*s)
+	}
+	return thruo
+}
+
+// SetIssue sets the "issue" field.
+func (thruo *TestHealthReportUpdateOne) SetIssue(t testhealthreport.Issue) *TestHealthReportUpdateOne {
+	thruo.mutation.SetIssue(t)
+	return thruo
+}
+
+// SetNillableIssue sets the "issue" field if the given value is not nil.
+func (thruo *TestHealthReportUpdateOne) SetNillableIssue(t *testhealthreport.Issue) *TestHealthReportUpdateOne {
+	if t != nil {
+		thruo.SetIssue(*t)
+	}
+	return thruo
+}
+
+// SetTestSize sets the "test_size" field.
+func (thruo *TestHealthReportUpdateOne) SetTestSize(ts testhealthreport.TestSize) *TestHealthReportUpdateOne {
+	thruo.mutation.SetTestSize(ts)
+	return thruo
+}
+
+// SetNillableTestSize sets the "test_size" field if the given value is not nil.
+func (thruo *TestHealthReportUpdateOne) SetNillableTestSize(ts *testhealthreport.TestSize) *TestHealthReportUpdateOne {
+	if ts != nil {
+		thruo.SetTestSize(*ts)
+	}
+	return thruo
+}
+
+// SetShardCount sets the "shard_count" field.
+func (thruo *TestHealthReportUpdateOne) SetShardCount(i int32) *TestHealthReportUpdateOne {
+	thruo.mutation.ResetShardCount()
+	thruo.mutation.SetShardCount(i)
+	return thruo
+}
+
+// SetNillableShardCount sets the "shard_count" field if the given value is not nil.
+func (thruo *TestHealthReportUpdateOne) SetNillableShardCount(i *int32) *TestHealthReportUpdateOne {
+	if i != nil {
+		thruo.SetShardCount(*i)
+	}
+	return thruo
+}
+
+// AddShardCount adds i to the "shard_count" field.
+func (thruo *TestHealthReportUpdateOne) AddShardCount(i int32) *TestHealthReportUpdateOne {
+	thruo.mutation.AddShardCount(i)
+	return thruo
+}
+
+// SetMaxDurationInMs sets the "max_duration_in_ms" field.
+func (thruo *TestHealthReportUpdateOne) SetMaxDurationInMs(i int64) *TestHealthReportUpdateOne {
+	thruo.mutation.ResetMaxDurationInMs()
+	thruo.mutation.SetMaxDurationInMs(i)
+	return thruo
+}
+
+// SetNillableMaxDurationInMs sets the "max_duration_in_ms" field if the given value is not nil.
+func (thruo *TestHealthReportUpdateOne) SetNillableMaxDurationInMs(i *int64) *TestHealthReportUpdateOne {
+	if i != nil {
+		thruo.SetMaxDurationInMs(*i)
+	}
+	return thruo
+}
+
+// AddMaxDurationInMs adds i to the "max_duration_in_ms" field.
+func (thruo *TestHealthReportUpdateOne) AddMaxDurationInMs(i int64) *TestHealthReportUpdateOne {
+	thruo.mutation.AddMaxDurationInMs(i)
+	return thruo
+}
+
+// SetMedianShardDurationInMs sets the "median_shard_duration_in_ms" field.
+func (thruo *TestHealthReportUpdateOne) SetMedianShardDurationInMs(i int64) *TestHealthReportUpdateOne {
+	thruo.mutation.ResetMedianShardDurationInMs()
+	thruo.mutation.SetMedianShardDurationInMs(i)
+	return thruo
+}
+
+// SetNillableMedianShardDurationInMs sets the "median_shard_duration_in_ms" field if the given value is not nil.
+func (thruo *TestHealthReportUpdateOne) SetNillableMedianShardDurationInMs(i *int64) *TestHealthReportUpdateOne {
+	if i != nil {
+		thruo.SetMedianShardDurationInMs(*i)
+	}
+	return thruo
+}
+
+// AddMedianShardDurationInMs adds i to the "median_shard_duration_in_ms" field.
+func (thruo *TestHealthReportUpdateOne) AddMedianShardDurationInMs(i int64) *TestHealthReportUpdateOne {
+	thruo.mutation.AddMedianShardDurationInMs(i)
+	return thruo
+}
+
+// SetTimeoutInMs sets the "timeout_in_ms" field.
+func (thruo *TestHealthReportUpdateOne) SetTimeoutInMs(i int64) *TestHealthReportUpdateOne {
+	thruo.mutation.ResetTimeoutInMs()
+	thruo.mutation.SetTimeoutInMs(i)
+	return thruo
+}
+
+// SetNillableTimeoutInMs sets the "timeout_in_ms" field if the given value is not nil.
+func (thruo *TestHealthReportUpdateOne) SetNillableTimeoutInMs(i *int64) *TestHealthReportUpdateOne {
+	if i != nil {
+		thruo.SetTimeoutInMs(*i)
+	}
+	return thruo
+}
+
+// AddTimeoutInMs adds i to the "timeout_in_ms" field.
+func (thruo *TestHealthReportUpdateOne) AddTimeoutInMs(i int64) *TestHealthReportUpdateOne {
+	thruo.mutation.AddTimeoutInMs(i)
+	return thruo
+}
+
+// SetSuggestedShardCount sets the "suggested_shard_count" field.
+func (thruo *TestHealthReportUpdateOne) SetSuggestedShardCount(i int32) *TestHealthReportUpdateOne {
+	thruo.mutation.ResetSuggestedShardCount()
+	thruo.mutation.SetSuggestedShardCount(i)
+	return thruo
+}
+
+// SetNillableSuggestedShardCount sets the "suggested_shard_count" field if the given value is not nil.
+func (thruo *TestHealthReportUpdateOne) SetNillableSuggestedShardCount(i *int32) *TestHealthReportUpdateOne {
+	if i != nil {
+		thruo.SetSuggestedShardCount(*i)
+	}
+	return thruo
+}
+
+// AddSuggestedShardCount adds i to the "suggested_shard_count" field.
+func (thruo *TestHealthReportUpdateOne) AddSuggestedShardCount(i int32) *TestHealthReportUpdateOne {
+	thruo.mutation.AddSuggestedShardCount(i)
+	return thruo
+}
+
+// SetSuggestedSize sets the "suggested_size" field.
+func (thruo *TestHealthReportUpdateOne) SetSuggestedSize(ts testhealthreport.SuggestedSize) *TestHealthReportUpdateOne {
+	thruo.mutation.SetSuggestedSize(ts)
+	return thruo
+}
+
+// SetNillableSuggestedSize sets the "suggested_size" field if the given value is not nil.
+func (thruo *TestHealthReportUpdateOne) SetNillableSuggestedSize(ts *testhealthreport.SuggestedSize) *TestHealthReportUpdateOne {
+	if ts != nil {
+		thruo.SetSuggestedSize(*ts)
+	}
+	return thruo
+}
+
+// SetBazelInvocationID sets the "bazel_invocation" edge to the BazelInvocation entity by ID.
+func (thruo *TestHealthReportUpdateOne) SetBazelInvocationID(id int) *TestHealthReportUpdateOne {
+	thruo.mutation.SetBazelInvocationID(id)
+	return thruo
+}
+
+// SetNillableBazelInvocationID sets the "bazel_invocation" edge to the BazelInvocation entity by ID if the given value is not nil.
+func (thruo *TestHealthReportUpdateOne) SetNillableBazelInvocationID(id *int) *TestHealthReportUpdateOne {
+	if id != nil {
+		thruo = thruo.SetBazelInvocationID(*id)
+	}
+	return thruo
+}
+
+// SetBazelInvocation sets the "bazel_invocation" edge to the BazelInvocation entity.
+func (thruo *TestHealthReportUpdateOne) SetBazelInvocation(b *BazelInvocation) *TestHealthReportUpdateOne {
+	return thruo.SetBazelInvocationID(b.ID)
+}
+
+// Mutation returns the TestHealthReportMutation object of the builder.
+func (thruo *TestHealthReportUpdateOne) Mutation() *TestHealthReportMutation {
+	return thruo.mutation
+}
+
+// ClearBazelInvocation clears the "bazel_invocation" edge to the BazelInvocation entity.
+func (thruo *TestHealthReportUpdateOne) ClearBazelInvocation() *TestHealthReportUpdateOne {
+	thruo.mutation.ClearBazelInvocation()
+	return thruo
+}
+
+// Where appends a list predicates to the TestHealthReportUpdate builder.
+func (thruo *TestHealthReportUpdateOne) Where(ps ...predicate.TestHealthReport) *TestHealthReportUpdateOne {
+	thruo.mutation.Where(ps...)
+	return thruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (thruo *TestHealthReportUpdateOne) Select(field string, fields ...string) *TestHealthReportUpdateOne {
+	thruo.fields = append([]string{field}, fields...)
+	return thruo
+}
+
+// Save executes the query and returns the updated TestHealthReport entity.
+func (thruo *TestHealthReportUpdateOne) Save(ctx context.Context) (*TestHealthReport, error) {
+	return withHooks(ctx, thruo.sqlSave, thruo.mutation, thruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (thruo *TestHealthReportUpdateOne) SaveX(ctx context.Context) *TestHealthReport {
+	node, err := thruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (thruo *TestHealthReportUpdateOne) Exec(ctx context.Context) error {
+	_, err := thruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (thruo *TestHealthReportUpdateOne) ExecX(ctx context.Context) {
+	if err := thruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (thruo *TestHealthReportUpdateOne) check() error {
+	if v, ok := thruo.mutation.Issue(); ok {
+		if err := testhealthreport.IssueValidator(v); err != nil {
+			return &ValidationError{Name: "issue", err: fmt.Errorf(`ent: validator failed for field "TestHealthReport.issue": %w`, err)}
+		}
+	}
+	if v, ok := thruo.mutation.TestSize(); ok {
+		if err := testhealthreport.TestSizeValidator(v); err != nil {
+			return &ValidationError{Name: "test_size", err: fmt.Errorf(`ent: validator failed for field "TestHealthReport.test_size": %w`, err)}
+		}
+	}
+	if v, ok := thruo.mutation.SuggestedSize(); ok {
+		if err := testhealthreport.SuggestedSizeValidator(v); err != nil {
+			return &ValidationError{Name: "suggested_size", err: fmt.Errorf(`ent: validator failed for field "TestHealthReport.suggested_size": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (thruo *TestHealthReportUpdateOne) sqlSave(ctx context.Context) (_node *TestHealthReport, err error) {
+	if err := thruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(testhealthreport.Table, testhealthreport.Columns, sqlgraph.NewFieldSpec(testhealthreport.FieldID, field.TypeInt))
+	id, ok := thruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TestHealthReport.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := thruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, testhealthreport.FieldID)
+		for _, f := range fields {
+			if !testhealthreport.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != testhealthreport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := thruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := thruo.mutation.Label(); ok {
+		_spec.SetField(testhealthreport.FieldLabel, field.TypeString, value)
+	}
+	if value, ok := thruo.mutation.Issue(); ok {
+		_spec.SetField(testhealthreport.FieldIssue, field.TypeEnum, value)
+	}
+	if value, ok := thruo.mutation.TestSize(); ok {
+		_spec.SetField(testhealthreport.FieldTestSize, field.TypeEnum, value)
+	}
+	if value, ok := thruo.mutation.ShardCount(); ok {
+		_spec.SetField(testhealthreport.FieldShardCount, field.TypeInt32, value)
+	}
+	if value, ok := thruo.mutation.AddedShardCount(); ok {
+		_spec.AddField(testhealthreport.FieldShardCount, field.TypeInt32, value)
+	}
+	if value, ok := thruo.mutation.MaxDurationInMs(); ok {
+		_spec.SetField(testhealthreport.FieldMaxDurationInMs, field.TypeInt64, value)
+	}
+	if value, ok := thruo.mutation.AddedMaxDurationInMs(); ok {
+		_spec.AddField(testhealthreport.FieldMaxDurationInMs, field.TypeInt64, value)
+	}
+	if value, ok := thruo.mutation.MedianShardDurationInMs(); ok {
+		_spec.SetField(testhealthreport.FieldMedianShardDurationInMs, field.TypeInt64, value)
+	}
+	if value, ok := thruo.mutation.AddedMedianShardDurationInMs(); ok {
+		_spec.AddField(testhealthreport.FieldMedianShardDurationInMs, field.TypeInt64, value)
+	}
+	if value, ok := thruo.mutation.TimeoutInMs(); ok {
+		_spec.SetField(testhealthreport.FieldTimeoutInMs, field.TypeInt64, value)
+	}
+	if value, ok := thruo.mutation.AddedTimeoutInMs(); ok {
+		_spec.AddField(testhealthreport.FieldTimeoutInMs, field.TypeInt64, value)
+	}
+	if value, ok := thruo.mutation.SuggestedShardCount(); ok {
+		_spec.SetField(testhealthreport.FieldSuggestedShardCount, field.TypeInt32, value)
+	}
+	if value, ok := thruo.mutation.AddedSuggestedShardCount(); ok {
+		_spec.AddField(testhealthreport.FieldSuggestedShardCount, field.TypeInt32, value)
+	}
+	if value, ok := thruo.mutation.SuggestedSize(); ok {
+		_spec.SetField(testhealthreport.FieldSuggestedSize, field.TypeEnum, value)
+	}
+	if thruo.mutation.BazelInvocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testhealthreport.BazelInvocationTable,
+			Columns: []string{testhealthreport.BazelInvocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := thruo.mutation.BazelInvocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   testhealthreport.BazelInvocationTable,
+			Columns: []string{testhealthreport.BazelInvocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bazelinvocation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TestHealthReport{config: thruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, thruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{testhealthreport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	thruo.mutation.done = true
+	return _node, nil
+}
@@ -4,8 +4,10 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/timingbreakdown"
@@ -17,6 +19,7 @@ type TimingChildCreate struct {
 	config
 	mutation *TimingChildMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetName sets the "name" field.
@@ -122,6 +125,7 @@ func (tcc *TimingChildCreate) createSpec() (*TimingChild, *sqlgraph.CreateSpec)
 		_node = &TimingChild{config: tcc.config}
 		_spec = sqlgraph.NewCreateSpec(timingchild.Table, sqlgraph.NewFieldSpec(timingchild.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = tcc.conflict
 	if value, ok := tcc.mutation.Name(); ok {
 		_spec.SetField(timingchild.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -149,11 +153,212 @@ func (tcc *TimingChildCreate) createSpec() (*TimingChild, *sqlgraph.CreateSpec)
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TimingChild.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TimingChildUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (tcc *TimingChildCreate) OnConflict(opts ...sql.ConflictOption) *TimingChildUpsertOne {
+	tcc.conflict = opts
+	return &TimingChildUpsertOne{
+		create: tcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TimingChild.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tcc *TimingChildCreate) OnConflictColumns(columns ...string) *TimingChildUpsertOne {
+	tcc.conflict = append(tcc.conflict, sql.ConflictColumns(columns...))
+	return &TimingChildUpsertOne{
+		create: tcc,
+	}
+}
+
+type (
+	// TimingChildUpsertOne is the builder for "upsert"-ing
+	//  one TimingChild node.
+	TimingChildUpsertOne struct {
+		create *TimingChildCreate
+	}
+
+	// TimingChildUpsert is the "OnConflict" setter.
+	TimingChildUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *TimingChildUpsert) SetName(v string) *TimingChildUpsert {
+	u.Set(timingchild.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TimingChildUpsert) UpdateName() *TimingChildUpsert {
+	u.SetExcluded(timingchild.FieldName)
+	return u
+}
+
+// ClearName clears the value of the "name" field.
+func (u *TimingChildUpsert) ClearName() *TimingChildUpsert {
+	u.SetNull(timingchild.FieldName)
+	return u
+}
+
+// SetTime sets the "time" field.
+func (u *TimingChildUpsert) SetTime(v string) *TimingChildUpsert {
+	u.Set(timingchild.FieldTime, v)
+	return u
+}
+
+// UpdateTime sets the "time" field to the value that was provided on create.
+func (u *TimingChildUpsert) UpdateTime() *TimingChildUpsert {
+	u.SetExcluded(timingchild.FieldTime)
+	return u
+}
+
+// ClearTime clears the value of the "time" field.
+func (u *TimingChildUpsert) ClearTime() *TimingChildUpsert {
+	u.SetNull(timingchild.FieldTime)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TimingChild.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TimingChildUpsertOne) UpdateNewValues() *TimingChildUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TimingChild.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TimingChildUpsertOne) Ignore() *TimingChildUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TimingChildUpsertOne) DoNothing() *TimingChildUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TimingChildCreate.OnConflict
+// documentation for more info.
+func (u *TimingChildUpsertOne) Update(set func(*TimingChildUpsert)) *TimingChildUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TimingChildUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TimingChildUpsertOne) SetName(v string) *TimingChildUpsertOne {
+	return u.Update(func(s *TimingChildUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TimingChildUpsertOne) UpdateName() *TimingChildUpsertOne {
+	return u.Update(func(s *TimingChildUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *TimingChildUpsertOne) ClearName() *TimingChildUpsertOne {
+	return u.Update(func(s *TimingChildUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetTime sets the "time" field.
+func (u *TimingChildUpsertOne) SetTime(v string) *TimingChildUpsertOne {
+	return u.Update(func(s *TimingChildUpsert) {
+		s.SetTime(v)
+	})
+}
+
+// UpdateTime sets the "time" field to the value that was provided on create.
+func (u *TimingChildUpsertOne) UpdateTime() *TimingChildUpsertOne {
+	return u.Update(func(s *TimingChildUpsert) {
+		s.UpdateTime()
+	})
+}
+
+// ClearTime clears the value of the "time" field.
+func (u *TimingChildUpsertOne) ClearTime() *TimingChildUpsertOne {
+	return u.Update(func(s *TimingChildUpsert) {
+		s.ClearTime()
+	})
+}
+
+// Exec executes the query.
+func (u *TimingChildUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TimingChildCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TimingChildUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TimingChildUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TimingChildUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // TimingChildCreateBulk is the builder for creating many TimingChild entities in bulk.
 type TimingChildCreateBulk struct {
 	config
 	err      error
 	builders []*TimingChildCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the TimingChild entities in the database.
@@ -182,6 +387,7 @@ func (tccb *TimingChildCreateBulk) Save(ctx context.Context) ([]*TimingChild, er
 					_, err = mutators[i+1].Mutate(root, tccb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tccb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, tccb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -235,3 +441,149 @@ func (tccb *TimingChildCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TimingChild.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TimingChildUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (tccb *TimingChildCreateBulk) OnConflict(opts ...sql.ConflictOption) *TimingChildUpsertBulk {
+	tccb.conflict = opts
+	return &TimingChildUpsertBulk{
+		create: tccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TimingChild.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tccb *TimingChildCreateBulk) OnConflictColumns(columns ...string) *TimingChildUpsertBulk {
+	tccb.conflict = append(tccb.conflict, sql.ConflictColumns(columns...))
+	return &TimingChildUpsertBulk{
+		create: tccb,
+	}
+}
+
+// TimingChildUpsertBulk is the builder for "upsert"-ing
+// a bulk of TimingChild nodes.
+type TimingChildUpsertBulk struct {
+	create *TimingChildCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TimingChild.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TimingChildUpsertBulk) UpdateNewValues() *TimingChildUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TimingChild.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TimingChildUpsertBulk) Ignore() *TimingChildUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TimingChildUpsertBulk) DoNothing() *TimingChildUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TimingChildCreateBulk.OnConflict
+// documentation for more info.
+func (u *TimingChildUpsertBulk) Update(set func(*TimingChildUpsert)) *TimingChildUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TimingChildUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TimingChildUpsertBulk) SetName(v string) *TimingChildUpsertBulk {
+	return u.Update(func(s *TimingChildUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TimingChildUpsertBulk) UpdateName() *TimingChildUpsertBulk {
+	return u.Update(func(s *TimingChildUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *TimingChildUpsertBulk) ClearName() *TimingChildUpsertBulk {
+	return u.Update(func(s *TimingChildUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetTime sets the "time" field.
+func (u *TimingChildUpsertBulk) SetTime(v string) *TimingChildUpsertBulk {
+	return u.Update(func(s *TimingChildUpsert) {
+		s.SetTime(v)
+	})
+}
+
+// UpdateTime sets the "time" field to the value that was provided on create.
+func (u *TimingChildUpsertBulk) UpdateTime() *TimingChildUpsertBulk {
+	return u.Update(func(s *TimingChildUpsert) {
+		s.UpdateTime()
+	})
+}
+
+// ClearTime clears the value of the "time" field.
+func (u *TimingChildUpsertBulk) ClearTime() *TimingChildUpsertBulk {
+	return u.Update(func(s *TimingChildUpsert) {
+		s.ClearTime()
+	})
+}
+
+// Exec executes the query.
+func (u *TimingChildUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TimingChildCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TimingChildCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TimingChildUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -4,8 +4,10 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/metrics"
@@ -17,6 +19,7 @@ type TimingMetricsCreate struct {
 	config
 	mutation *TimingMetricsMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCPUTimeInMs sets the "cpu_time_in_ms" field.
@@ -164,6 +167,7 @@ func (tmc *TimingMetricsCreate) createSpec() (*TimingMetrics, *sqlgraph.CreateSp
 		_node = &TimingMetrics{config: tmc.config}
 		_spec = sqlgraph.NewCreateSpec(timingmetrics.Table, sqlgraph.NewFieldSpec(timingmetrics.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = tmc.conflict
 	if value, ok := tmc.mutation.CPUTimeInMs(); ok {
 		_spec.SetField(timingmetrics.FieldCPUTimeInMs, field.TypeInt64, value)
 		_node.CPUTimeInMs = value
@@ -203,11 +207,394 @@ func (tmc *TimingMetricsCreate) createSpec() (*TimingMetrics, *sqlgraph.CreateSp
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TimingMetrics.Create().
+//		SetCPUTimeInMs(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TimingMetricsUpsert) {
+//			SetCPUTimeInMs(v+v).
+//		}).
+//		Exec(ctx)
+func (tmc *TimingMetricsCreate) OnConflict(opts ...sql.ConflictOption) *TimingMetricsUpsertOne {
+	tmc.conflict = opts
+	return &TimingMetricsUpsertOne{
+		create: tmc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TimingMetrics.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tmc *TimingMetricsCreate) OnConflictColumns(columns ...string) *TimingMetricsUpsertOne {
+	tmc.conflict = append(tmc.conflict, sql.ConflictColumns(columns...))
+	return &TimingMetricsUpsertOne{
+		create: tmc,
+	}
+}
+
+type (
+	// TimingMetricsUpsertOne is the builder for "upsert"-ing
+	//  one TimingMetrics node.
+	TimingMetricsUpsertOne struct {
+		create *TimingMetricsCreate
+	}
+
+	// TimingMetricsUpsert is the "OnConflict" setter.
+	TimingMetricsUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCPUTimeInMs sets the "cpu_time_in_ms" field.
+func (u *TimingMetricsUpsert) SetCPUTimeInMs(v int64) *TimingMetricsUpsert {
+	u.Set(timingmetrics.FieldCPUTimeInMs, v)
+	return u
+}
+
+// UpdateCPUTimeInMs sets the "cpu_time_in_ms" field to the value that was provided on create.
+func (u *TimingMetricsUpsert) UpdateCPUTimeInMs() *TimingMetricsUpsert {
+	u.SetExcluded(timingmetrics.FieldCPUTimeInMs)
+	return u
+}
+
+// AddCPUTimeInMs adds v to the "cpu_time_in_ms" field.
+func (u *TimingMetricsUpsert) AddCPUTimeInMs(v int64) *TimingMetricsUpsert {
+	u.Add(timingmetrics.FieldCPUTimeInMs, v)
+	return u
+}
+
+// ClearCPUTimeInMs clears the value of the "cpu_time_in_ms" field.
+func (u *TimingMetricsUpsert) ClearCPUTimeInMs() *TimingMetricsUpsert {
+	u.SetNull(timingmetrics.FieldCPUTimeInMs)
+	return u
+}
+
+// SetWallTimeInMs sets the "wall_time_in_ms" field.
+func (u *TimingMetricsUpsert) SetWallTimeInMs(v int64) *TimingMetricsUpsert {
+	u.Set(timingmetrics.FieldWallTimeInMs, v)
+	return u
+}
+
+// UpdateWallTimeInMs sets the "wall_time_in_ms" field to the value that was provided on create.
+func (u *TimingMetricsUpsert) UpdateWallTimeInMs() *TimingMetricsUpsert {
+	u.SetExcluded(timingmetrics.FieldWallTimeInMs)
+	return u
+}
+
+// AddWallTimeInMs adds v to the "wall_time_in_ms" field.
+func (u *TimingMetricsUpsert) AddWallTimeInMs(v int64) *TimingMetricsUpsert {
+	u.Add(timingmetrics.FieldWallTimeInMs, v)
+	return u
+}
+
+// ClearWallTimeInMs clears the value of the "wall_time_in_ms" field.
+func (u *TimingMetricsUpsert) ClearWallTimeInMs() *TimingMetricsUpsert {
+	u.SetNull(timingmetrics.FieldWallTimeInMs)
+	return u
+}
+
+// SetAnalysisPhaseTimeInMs sets the "analysis_phase_time_in_ms" field.
+func (u *TimingMetricsUpsert) SetAnalysisPhaseTimeInMs(v int64) *TimingMetricsUpsert {
+	u.Set(timingmetrics.FieldAnalysisPhaseTimeInMs, v)
+	return u
+}
+
+// UpdateAnalysisPhaseTimeInMs sets the "analysis_phase_time_in_ms" field to the value that was provided on create.
+func (u *TimingMetricsUpsert) UpdateAnalysisPhaseTimeInMs() *TimingMetricsUpsert {
+	u.SetExcluded(timingmetrics.FieldAnalysisPhaseTimeInMs)
+	return u
+}
+
+// AddAnalysisPhaseTimeInMs adds v to the "analysis_phase_time_in_ms" field.
+func (u *TimingMetricsUpsert) AddAnalysisPhaseTimeInMs(v int64) *TimingMetricsUpsert {
+	u.Add(timingmetrics.FieldAnalysisPhaseTimeInMs, v)
+	return u
+}
+
+// ClearAnalysisPhaseTimeInMs clears the value of the "analysis_phase_time_in_ms" field.
+func (u *TimingMetricsUpsert) ClearAnalysisPhaseTimeInMs() *TimingMetricsUpsert {
+	u.SetNull(timingmetrics.FieldAnalysisPhaseTimeInMs)
+	return u
+}
+
+// SetExecutionPhaseTimeInMs sets the "execution_phase_time_in_ms" field.
+func (u *TimingMetricsUpsert) SetExecutionPhaseTimeInMs(v int64) *TimingMetricsUpsert {
+	u.Set(timingmetrics.FieldExecutionPhaseTimeInMs, v)
+	return u
+}
+
+// UpdateExecutionPhaseTimeInMs sets the "execution_phase_time_in_ms" field to the value that was provided on create.
+func (u *TimingMetricsUpsert) UpdateExecutionPhaseTimeInMs() *TimingMetricsUpsert {
+	u.SetExcluded(timingmetrics.FieldExecutionPhaseTimeInMs)
+	return u
+}
+
+// AddExecutionPhaseTimeInMs adds v to the "execution_phase_time_in_ms" field.
+func (u *TimingMetricsUpsert) AddExecutionPhaseTimeInMs(v int64) *TimingMetricsUpsert {
+	u.Add(timingmetrics.FieldExecutionPhaseTimeInMs, v)
+	return u
+}
+
+// ClearExecutionPhaseTimeInMs clears the value of the "execution_phase_time_in_ms" field.
+func (u *TimingMetricsUpsert) ClearExecutionPhaseTimeInMs() *TimingMetricsUpsert {
+	u.SetNull(timingmetrics.FieldExecutionPhaseTimeInMs)
+	return u
+}
+
+// SetActionsExecutionStartInMs sets the "actions_execution_start_in_ms" field.
+func (u *TimingMetricsUpsert) SetActionsExecutionStartInMs(v int64) *TimingMetricsUpsert {
+	u.Set(timingmetrics.FieldActionsExecutionStartInMs, v)
+	return u
+}
+
+// UpdateActionsExecutionStartInMs sets the "actions_execution_start_in_ms" field to the value that was provided on create.
+func (u *TimingMetricsUpsert) UpdateActionsExecutionStartInMs() *TimingMetricsUpsert {
+	u.SetExcluded(timingmetrics.FieldActionsExecutionStartInMs)
+	return u
+}
+
+// AddActionsExecutionStartInMs adds v to the "actions_execution_start_in_ms" field.
+func (u *TimingMetricsUpsert) AddActionsExecutionStartInMs(v int64) *TimingMetricsUpsert {
+	u.Add(timingmetrics.FieldActionsExecutionStartInMs, v)
+	return u
+}
+
+// ClearActionsExecutionStartInMs clears the value of the "actions_execution_start_in_ms" field.
+func (u *TimingMetricsUpsert) ClearActionsExecutionStartInMs() *TimingMetricsUpsert {
+	u.SetNull(timingmetrics.FieldActionsExecutionStartInMs)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TimingMetrics.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TimingMetricsUpsertOne) UpdateNewValues() *TimingMetricsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TimingMetrics.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TimingMetricsUpsertOne) Ignore() *TimingMetricsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TimingMetricsUpsertOne) DoNothing() *TimingMetricsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TimingMetricsCreate.OnConflict
+// documentation for more info.
+func (u *TimingMetricsUpsertOne) Update(set func(*TimingMetricsUpsert)) *TimingMetricsUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TimingMetricsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCPUTimeInMs sets the "cpu_time_in_ms" field.
+func (u *TimingMetricsUpsertOne) SetCPUTimeInMs(v int64) *TimingMetricsUpsertOne {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.SetCPUTimeInMs(v)
+	})
+}
+
+// AddCPUTimeInMs adds v to the "cpu_time_in_ms" field.
+func (u *TimingMetricsUpsertOne) AddCPUTimeInMs(v int64) *TimingMetricsUpsertOne {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.AddCPUTimeInMs(v)
+	})
+}
+
+// UpdateCPUTimeInMs sets the "cpu_time_in_ms" field to the value that was provided on create.
+func (u *TimingMetricsUpsertOne) UpdateCPUTimeInMs() *TimingMetricsUpsertOne {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.UpdateCPUTimeInMs()
+	})
+}
+
+// ClearCPUTimeInMs clears the value of the "cpu_time_in_ms" field.
+func (u *TimingMetricsUpsertOne) ClearCPUTimeInMs() *TimingMetricsUpsertOne {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.ClearCPUTimeInMs()
+	})
+}
+
+// SetWallTimeInMs sets the "wall_time_in_ms" field.
+func (u *TimingMetricsUpsertOne) SetWallTimeInMs(v int64) *TimingMetricsUpsertOne {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.SetWallTimeInMs(v)
+	})
+}
+
+// AddWallTimeInMs adds v to the "wall_time_in_ms" field.
+func (u *TimingMetricsUpsertOne) AddWallTimeInMs(v int64) *TimingMetricsUpsertOne {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.AddWallTimeInMs(v)
+	})
+}
+
+// UpdateWallTimeInMs sets the "wall_time_in_ms" field to the value that was provided on create.
+func (u *TimingMetricsUpsertOne) UpdateWallTimeInMs() *TimingMetricsUpsertOne {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.UpdateWallTimeInMs()
+	})
+}
+
+// ClearWallTimeInMs clears the value of the "wall_time_in_ms" field.
+func (u *TimingMetricsUpsertOne) ClearWallTimeInMs() *TimingMetricsUpsertOne {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.ClearWallTimeInMs()
+	})
+}
+
+// SetAnalysisPhaseTimeInMs sets the "analysis_phase_time_in_ms" field.
+func (u *TimingMetricsUpsertOne) SetAnalysisPhaseTimeInMs(v int64) *TimingMetricsUpsertOne {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.SetAnalysisPhaseTimeInMs(v)
+	})
+}
+
+// AddAnalysisPhaseTimeInMs adds v to the "analysis_phase_time_in_ms" field.
+func (u *TimingMetricsUpsertOne) AddAnalysisPhaseTimeInMs(v int64) *TimingMetricsUpsertOne {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.AddAnalysisPhaseTimeInMs(v)
+	})
+}
+
+// UpdateAnalysisPhaseTimeInMs sets the "analysis_phase_time_in_ms" field to the value that was provided on create.
+func (u *TimingMetricsUpsertOne) UpdateAnalysisPhaseTimeInMs() *TimingMetricsUpsertOne {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.UpdateAnalysisPhaseTimeInMs()
+	})
+}
+
+// ClearAnalysisPhaseTimeInMs clears the value of the "analysis_phase_time_in_ms" field.
+func (u *TimingMetricsUpsertOne) ClearAnalysisPhaseTimeInMs() *TimingMetricsUpsertOne {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.ClearAnalysisPhaseTimeInMs()
+	})
+}
+
+// SetExecutionPhaseTimeInMs sets the "execution_phase_time_in_ms" field.
+func (u *TimingMetricsUpsertOne) SetExecutionPhaseTimeInMs(v int64) *TimingMetricsUpsertOne {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.SetExecutionPhaseTimeInMs(v)
+	})
+}
+
+// AddExecutionPhaseTimeInMs adds v to the "execution_phase_time_in_ms" field.
+func (u *TimingMetricsUpsertOne) AddExecutionPhaseTimeInMs(v int64) *TimingMetricsUpsertOne {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.AddExecutionPhaseTimeInMs(v)
+	})
+}
+
+// UpdateExecutionPhaseTimeInMs sets the "execution_phase_time_in_ms" field to the value that was provided on create.
+func (u *TimingMetricsUpsertOne) UpdateExecutionPhaseTimeInMs() *TimingMetricsUpsertOne {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.UpdateExecutionPhaseTimeInMs()
+	})
+}
+
+// ClearExecutionPhaseTimeInMs clears the value of the "execution_phase_time_in_ms" field.
+func (u *TimingMetricsUpsertOne) ClearExecutionPhaseTimeInMs() *TimingMetricsUpsertOne {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.ClearExecutionPhaseTimeInMs()
+	})
+}
+
+// SetActionsExecutionStartInMs sets the "actions_execution_start_in_ms" field.
+func (u *TimingMetricsUpsertOne) SetActionsExecutionStartInMs(v int64) *TimingMetricsUpsertOne {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.SetActionsExecutionStartInMs(v)
+	})
+}
+
+// AddActionsExecutionStartInMs adds v to the "actions_execution_start_in_ms" field.
+func (u *TimingMetricsUpsertOne) AddActionsExecutionStartInMs(v int64) *TimingMetricsUpsertOne {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.AddActionsExecutionStartInMs(v)
+	})
+}
+
+// UpdateActionsExecutionStartInMs sets the "actions_execution_start_in_ms" field to the value that was provided on create.
+func (u *TimingMetricsUpsertOne) UpdateActionsExecutionStartInMs() *TimingMetricsUpsertOne {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.UpdateActionsExecutionStartInMs()
+	})
+}
+
+// ClearActionsExecutionStartInMs clears the value of the "actions_execution_start_in_ms" field.
+func (u *TimingMetricsUpsertOne) ClearActionsExecutionStartInMs() *TimingMetricsUpsertOne {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.ClearActionsExecutionStartInMs()
+	})
+}
+
+// Exec executes the query.
+func (u *TimingMetricsUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TimingMetricsCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TimingMetricsUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TimingMetricsUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TimingMetricsUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // TimingMetricsCreateBulk is the builder for creating many TimingMetrics entities in bulk.
 type TimingMetricsCreateBulk struct {
 	config
 	err      error
 	builders []*TimingMetricsCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the TimingMetrics entities in the database.
@@ -236,6 +623,7 @@ func (tmcb *TimingMetricsCreateBulk) Save(ctx context.Context) ([]*TimingMetrics
 					_, err = mutators[i+1].Mutate(root, tmcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tmcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, tmcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -289,3 +677,247 @@ func (tmcb *TimingMetricsCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TimingMetrics.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TimingMetricsUpsert) {
+//			SetCPUTimeInMs(v+v).
+//		}).
+//		Exec(ctx)
+func (tmcb *TimingMetricsCreateBulk) OnConflict(opts ...sql.ConflictOption) *TimingMetricsUpsertBulk {
+	tmcb.conflict = opts
+	return &TimingMetricsUpsertBulk{
+		create: tmcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TimingMetrics.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tmcb *TimingMetricsCreateBulk) OnConflictColumns(columns ...string) *TimingMetricsUpsertBulk {
+	tmcb.conflict = append(tmcb.conflict, sql.ConflictColumns(columns...))
+	return &TimingMetricsUpsertBulk{
+		create: tmcb,
+	}
+}
+
+// TimingMetricsUpsertBulk is the builder for "upsert"-ing
+// a bulk of TimingMetrics nodes.
+type TimingMetricsUpsertBulk struct {
+	create *TimingMetricsCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TimingMetrics.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TimingMetricsUpsertBulk) UpdateNewValues() *TimingMetricsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TimingMetrics.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TimingMetricsUpsertBulk) Ignore() *TimingMetricsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TimingMetricsUpsertBulk) DoNothing() *TimingMetricsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TimingMetricsCreateBulk.OnConflict
+// documentation for more info.
+func (u *TimingMetricsUpsertBulk) Update(set func(*TimingMetricsUpsert)) *TimingMetricsUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TimingMetricsUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCPUTimeInMs sets the "cpu_time_in_ms" field.
+func (u *TimingMetricsUpsertBulk) SetCPUTimeInMs(v int64) *TimingMetricsUpsertBulk {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.SetCPUTimeInMs(v)
+	})
+}
+
+// AddCPUTimeInMs adds v to the "cpu_time_in_ms" field.
+func (u *TimingMetricsUpsertBulk) AddCPUTimeInMs(v int64) *TimingMetricsUpsertBulk {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.AddCPUTimeInMs(v)
+	})
+}
+
+// UpdateCPUTimeInMs sets the "cpu_time_in_ms" field to the value that was provided on create.
+func (u *TimingMetricsUpsertBulk) UpdateCPUTimeInMs() *TimingMetricsUpsertBulk {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.UpdateCPUTimeInMs()
+	})
+}
+
+// ClearCPUTimeInMs clears the value of the "cpu_time_in_ms" field.
+func (u *TimingMetricsUpsertBulk) ClearCPUTimeInMs() *TimingMetricsUpsertBulk {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.ClearCPUTimeInMs()
+	})
+}
+
+// SetWallTimeInMs sets the "wall_time_in_ms" field.
+func (u *TimingMetricsUpsertBulk) SetWallTimeInMs(v int64) *TimingMetricsUpsertBulk {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.SetWallTimeInMs(v)
+	})
+}
+
+// AddWallTimeInMs adds v to the "wall_time_in_ms" field.
+func (u *TimingMetricsUpsertBulk) AddWallTimeInMs(v int64) *TimingMetricsUpsertBulk {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.AddWallTimeInMs(v)
+	})
+}
+
+// UpdateWallTimeInMs sets the "wall_time_in_ms" field to the value that was provided on create.
+func (u *TimingMetricsUpsertBulk) UpdateWallTimeInMs() *TimingMetricsUpsertBulk {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.UpdateWallTimeInMs()
+	})
+}
+
+// ClearWallTimeInMs clears the value of the "wall_time_in_ms" field.
+func (u *TimingMetricsUpsertBulk) ClearWallTimeInMs() *TimingMetricsUpsertBulk {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.ClearWallTimeInMs()
+	})
+}
+
+// SetAnalysisPhaseTimeInMs sets the "analysis_phase_time_in_ms" field.
+func (u *TimingMetricsUpsertBulk) SetAnalysisPhaseTimeInMs(v int64) *TimingMetricsUpsertBulk {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.SetAnalysisPhaseTimeInMs(v)
+	})
+}
+
+// AddAnalysisPhaseTimeInMs adds v to the "analysis_phase_time_in_ms" field.
+func (u *TimingMetricsUpsertBulk) AddAnalysisPhaseTimeInMs(v int64) *TimingMetricsUpsertBulk {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.AddAnalysisPhaseTimeInMs(v)
+	})
+}
+
+// UpdateAnalysisPhaseTimeInMs sets the "analysis_phase_time_in_ms" field to the value that was provided on create.
+func (u *TimingMetricsUpsertBulk) UpdateAnalysisPhaseTimeInMs() *TimingMetricsUpsertBulk {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.UpdateAnalysisPhaseTimeInMs()
+	})
+}
+
+// ClearAnalysisPhaseTimeInMs clears the value of the "analysis_phase_time_in_ms" field.
+func (u *TimingMetricsUpsertBulk) ClearAnalysisPhaseTimeInMs() *TimingMetricsUpsertBulk {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.ClearAnalysisPhaseTimeInMs()
+	})
+}
+
+// SetExecutionPhaseTimeInMs sets the "execution_phase_time_in_ms" field.
+func (u *TimingMetricsUpsertBulk) SetExecutionPhaseTimeInMs(v int64) *TimingMetricsUpsertBulk {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.SetExecutionPhaseTimeInMs(v)
+	})
+}
+
+// AddExecutionPhaseTimeInMs adds v to the "execution_phase_time_in_ms" field.
+func (u *TimingMetricsUpsertBulk) AddExecutionPhaseTimeInMs(v int64) *TimingMetricsUpsertBulk {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.AddExecutionPhaseTimeInMs(v)
+	})
+}
+
+// UpdateExecutionPhaseTimeInMs sets the "execution_phase_time_in_ms" field to the value that was provided on create.
+func (u *TimingMetricsUpsertBulk) UpdateExecutionPhaseTimeInMs() *TimingMetricsUpsertBulk {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.UpdateExecutionPhaseTimeInMs()
+	})
+}
+
+// ClearExecutionPhaseTimeInMs clears the value of the "execution_phase_time_in_ms" field.
+func (u *TimingMetricsUpsertBulk) ClearExecutionPhaseTimeInMs() *TimingMetricsUpsertBulk {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.ClearExecutionPhaseTimeInMs()
+	})
+}
+
+// SetActionsExecutionStartInMs sets the "actions_execution_start_in_ms" field.
+func (u *TimingMetricsUpsertBulk) SetActionsExecutionStartInMs(v int64) *TimingMetricsUpsertBulk {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.SetActionsExecutionStartInMs(v)
+	})
+}
+
+// AddActionsExecutionStartInMs adds v to the "actions_execution_start_in_ms" field.
+func (u *TimingMetricsUpsertBulk) AddActionsExecutionStartInMs(v int64) *TimingMetricsUpsertBulk {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.AddActionsExecutionStartInMs(v)
+	})
+}
+
+// UpdateActionsExecutionStartInMs sets the "actions_execution_start_in_ms" field to the value that was provided on create.
+func (u *TimingMetricsUpsertBulk) UpdateActionsExecutionStartInMs() *TimingMetricsUpsertBulk {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.UpdateActionsExecutionStartInMs()
+	})
+}
+
+// ClearActionsExecutionStartInMs clears the value of the "actions_execution_start_in_ms" field.
+func (u *TimingMetricsUpsertBulk) ClearActionsExecutionStartInMs() *TimingMetricsUpsertBulk {
+	return u.Update(func(s *TimingMetricsUpsert) {
+		s.ClearActionsExecutionStartInMs()
+	})
+}
+
+// Exec executes the query.
+func (u *TimingMetricsUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TimingMetricsCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TimingMetricsCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TimingMetricsUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -44,10 +46,14 @@ type Tx struct {
 	FilesMetric *FilesMetricClient
 	// GarbageMetrics is the client for interacting with the GarbageMetrics builders.
 	GarbageMetrics *GarbageMetricsClient
+	// KnownProblem is the client for interacting with the KnownProblem builders.
+	KnownProblem *KnownProblemClient
 	// MemoryMetrics is the client for interacting with the MemoryMetrics builders.
 	MemoryMetrics *MemoryMetricsClient
 	// Metrics is the client for interacting with the Metrics builders.
 	Metrics *MetricsClient
+	// MetricsRollup is the client for interacting with the MetricsRollup builders.
+	MetricsRollup *MetricsRollupClient
 	// MissDetail is the client for interacting with the MissDetail builders.
 	MissDetail *MissDetailClient
 	// NamedSetOfFiles is the client for interacting with the NamedSetOfFiles builders.
@@ -66,6 +72,8 @@ type Tx struct {
 	ResourceUsage *ResourceUsageClient
 	// RunnerCount is the client for interacting with the RunnerCount builders.
 	RunnerCount *RunnerCountClient
+	// SearchChunk is the client for interacting with the SearchChunk builders.
+	SearchChunk *SearchChunkClient
 	// SystemNetworkStats is the client for interacting with the SystemNetworkStats builders.
 	SystemNetworkStats *SystemNetworkStatsClient
 	// TargetComplete is the client for interacting with the TargetComplete builders.
@@ -80,6 +88,8 @@ type Tx struct {
 	TestCollection *TestCollectionClient
 	// TestFile is the client for interacting with the TestFile builders.
 	TestFile *TestFileClient
+	// TestHealthReport is the client for interacting with the TestHealthReport builders.
+	TestHealthReport *TestHealthReportClient
 	// TestResultBES is the client for interacting with the TestResultBES builders.
 	TestResultBES *TestResultBESClient
 	// TestSummary is the client for interacting with the TestSummary builders.
@@ -237,8 +247,10 @@ func (tx *Tx) init() {
 	tx.ExectionInfo = NewExectionInfoClient(tx.config)
 	tx.FilesMetric = NewFilesMetricClient(tx.config)
 	tx.GarbageMetrics = NewGarbageMetricsClient(tx.config)
+	tx.KnownProblem = NewKnownProblemClient(tx.config)
 	tx.MemoryMetrics = NewMemoryMetricsClient(tx.config)
 	tx.Metrics = NewMetricsClient(tx.config)
+	tx.MetricsRollup = NewMetricsRollupClient(tx.config)
 	tx.MissDetail = NewMissDetailClient(tx.config)
 	tx.NamedSetOfFiles = NewNamedSetOfFilesClient(tx.config)
 	tx.NetworkMetrics = NewNetworkMetricsClient(tx.config)
@@ -248,6 +260,7 @@ func (tx *Tx) init() {
 	tx.RaceStatistics = NewRaceStatisticsClient(tx.config)
 	tx.ResourceUsage = NewResourceUsageClient(tx.config)
 	tx.RunnerCount = NewRunnerCountClient(tx.config)
+	tx.SearchChunk = NewSearchChunkClient(tx.config)
 	tx.SystemNetworkStats = NewSystemNetworkStatsClient(tx.config)
 	tx.TargetComplete = NewTargetCompleteClient(tx.config)
 	tx.TargetConfigured = NewTargetConfiguredClient(tx.config)
@@ -255,6 +268,7 @@ func (tx *Tx) init() {
 	tx.TargetPair = NewTargetPairClient(tx.config)
 	tx.TestCollection = NewTestCollectionClient(tx.config)
 	tx.TestFile = NewTestFileClient(tx.config)
+	tx.TestHealthReport = NewTestHealthReportClient(tx.config)
 	tx.TestResultBES = NewTestResultBESClient(tx.config)
 	tx.TestSummary = NewTestSummaryClient(tx.config)
 	tx.TimingBreakdown = NewTimingBreakdownClient(tx.config)
@@ -322,3 +336,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -70,6 +70,23 @@ func (BazelInvocation) Fields() []ent.Field {
 
 		// The number of successful fetch events seen.
 		field.Int64("num_fetches").Optional(),
+
+		// The branch the invocation was run on, from GIT_BRANCH or GERRIT_BRANCH.
+		field.String("branch").Optional(),
+
+		// The commit the invocation was run on, from GIT_COMMIT or GIT_SHA.
+		field.String("commit").Optional(),
+
+		// Who is responsible for the failure of the invocation, unset if it did not fail.
+		field.Enum("failure_classification").
+			Values("USER_ERROR",
+				"INFRA_ERROR",
+				"FLAKY",
+				"CANCELLED").
+			Optional(),
+
+		// Pinned invocations are never garbage collected.
+		field.Bool("pinned").Default(false),
 	}
 }
 
@@ -101,6 +118,13 @@ func (BazelInvocation) Edges() []ent.Edge {
 
 		// Target Data for the completed Invocation
 		edge.To("targets", TargetPair.Type),
+
+		// Test sharding and timeout issues found in the completed Invocation
+		edge.To("test_health_reports", TestHealthReport.Type),
+
+		// The build logs, split for the full-text search.
+		edge.To("search_chunks", SearchChunk.Type).
+			Annotations(entgql.Skip()),
 	}
 }
 
@@ -108,6 +132,8 @@ func (BazelInvocation) Edges() []ent.Edge {
 func (BazelInvocation) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("change_number", "patchset_number"),
+		index.Fields("failure_classification"),
+		index.Fields("branch", "started_at"),
 	}
 }
 
@@ -7,6 +7,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // BazelInvocationProblem holds the schema definition for the BazelInvocationProblem entity.
@@ -26,6 +27,20 @@ func (BazelInvocationProblem) Fields() []ent.Field {
 		// The bep_events raw message associated with the field.
 		// NOTE: Internal model, not exposed to API.
 		field.JSON("bep_events", json.RawMessage{}).Annotations(entgql.Skip()),
+
+		// Fingerprint identifying the problem across invocations.
+		field.String("fingerprint").Optional(),
+
+		// Who is responsible for the problem.
+		field.Enum("failure_classification").
+			Values("USER_ERROR",
+				"INFRA_ERROR",
+				"FLAKY",
+				"CANCELLED").
+			Optional(),
+
+		// If the problem was not seen in the previous invocations on the same branch, unset if unknown.
+		field.Bool("newly_failing").Optional().Nillable(),
 	}
 }
 
@@ -35,5 +50,24 @@ func (BazelInvocationProblem) Edges() []ent.Edge {
 		edge.From("bazel_invocation", BazelInvocation.Type).
 			Ref("problems").
 			Unique(),
+
+		// Edge back from the known problem aggregating all occurrences.
+		edge.From("known_problem", KnownProblem.Type).
+			Ref("problems").
+			Unique(),
+
+		// Blobs referenced from the problem, shared with other problems referencing the same URI.
+		edge.To("blobs", Blob.Type),
+
+		// The output of the problem, split for the full-text search.
+		edge.To("search_chunks", SearchChunk.Type).
+			Annotations(entgql.Skip()),
+	}
+}
+
+// Indexes of the BazelInvocationProblem.
+func (BazelInvocationProblem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("fingerprint"),
 	}
 }
@@ -1,8 +1,11 @@
 package schema
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Blob holds the schema definition for the Blob entity.
@@ -14,6 +17,8 @@ type Blob struct {
 func (Blob) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("uri").Unique().Immutable(),
+		// The stable key of the blob in URLs, see blobs.Key.
+		field.String("key").Optional().Immutable(),
 		field.Int64("size_bytes").Optional(),
 		field.Enum("archiving_status").
 			Values("QUEUED", "ARCHIVING", "SUCCESS", "FAILED").
@@ -25,5 +30,21 @@ func (Blob) Fields() []ent.Field {
 
 // Edges of the Blob.
 func (Blob) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		// Edge back from the problems referencing the blob, a blob without any can be garbage collected.
+		edge.From("problems", BazelInvocationProblem.Type).
+			Ref("blobs").
+			Annotations(entgql.Skip()),
+
+		// The archived text, split for the full-text search.
+		edge.To("search_chunks", SearchChunk.Type).
+			Annotations(entgql.Skip()),
+	}
+}
+
+// Indexes of the Blob.
+func (Blob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key"),
+	}
 }
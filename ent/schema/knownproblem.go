@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// KnownProblem holds the schema definition for the KnownProblem entity.
+// It aggregates all occurrences of problems sharing the same fingerprint.
+type KnownProblem struct {
+	ent.Schema
+}
+
+// Fields of the KnownProblem.
+func (KnownProblem) Fields() []ent.Field {
+	return []ent.Field{
+		// Fingerprint shared by all the problems, see detectors.Fingerprint.
+		field.String("fingerprint").Unique().Immutable(),
+
+		// The Problem Type.
+		field.String("problem_type"),
+
+		// The Problem Label.
+		field.String("label"),
+
+		// Start time of the first invocation the problem was seen in.
+		field.Time("first_seen"),
+
+		// Start time of the last invocation the problem was seen in.
+		field.Time("last_seen"),
+
+		// The number of problems seen with this fingerprint.
+		field.Int("occurrences").Default(0),
+
+		// The branches the problem was seen on.
+		field.Strings("branches").Optional(),
+	}
+}
+
+// Edges of the KnownProblem.
+func (KnownProblem) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Edge to the occurrences of the problem.
+		// NOTE: Uses custom resolver / types.
+		edge.To("problems", BazelInvocationProblem.Type).
+			Annotations(entgql.Skip(entgql.SkipType)),
+	}
+}
+
+// Indexes of the KnownProblem.
+func (KnownProblem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("last_seen"),
+	}
+}
+
+// Annotations of the KnownProblem.
+func (KnownProblem) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+		entgql.QueryField("findKnownProblems"),
+	}
+}
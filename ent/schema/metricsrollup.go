@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MetricsRollup holds the schema definition for the MetricsRollup entity.
+// It aggregates a metric over the invocations started in a bucket of time sharing the same branch, command, step
+// label and user, updated as invocations are saved. See the rollup package.
+type MetricsRollup struct {
+	ent.Schema
+}
+
+// Fields of the MetricsRollup.
+func (MetricsRollup) Fields() []ent.Field {
+	return []ent.Field{
+		// The duration of the bucket.
+		field.Enum("granularity").Values("HOUR", "DAY").Immutable(),
+
+		// The start of the bucket, in UTC.
+		field.Time("bucket_start").Immutable(),
+
+		// The metric, see rollup.Metric.
+		field.String("metric").Immutable(),
+
+		// The branch of the invocations.
+		field.String("branch").Default("").Immutable(),
+
+		// The Bazel command of the invocations.
+		field.String("command").Default("").Immutable(),
+
+		// The step label of the invocations.
+		field.String("step_label").Default("").Immutable(),
+
+		// The ldap of the user who launched the invocations.
+		field.String("user_ldap").Default("").Immutable(),
+
+		// The email of the user who launched the invocations.
+		field.String("user_email").Default("").Immutable(),
+
+		// The number of invocations with the metric.
+		field.Int64("invocations").Default(0),
+
+		// The total of the numerators of the invocations, the values of plain metrics.
+		field.Float("numerator").Default(0),
+
+		// The total of the denominators of the invocations, 1 per invocation for plain metrics.
+		field.Float("denominator").Default(0),
+
+		// The lowest value of an invocation. Not raised when invocations are summarized again.
+		field.Float("min"),
+
+		// The highest value of an invocation. Not lowered when invocations are summarized again.
+		field.Float("max"),
+
+		// The number of invocations per logarithmic bucket of their values, to estimate percentiles.
+		field.JSON("histogram", map[int]int64{}),
+
+		// Incremented by every update, for the updates made concurrently from the same values to be retried.
+		field.Int64("revision").Default(0),
+	}
+}
+
+// Indexes of the MetricsRollup.
+func (MetricsRollup) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("granularity", "metric", "bucket_start", "branch", "command", "step_label", "user_ldap", "user_email").
+			Unique(),
+	}
+}
+
+// Annotations of the MetricsRollup.
+func (MetricsRollup) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// NOTE: Read through the metricsTrends query.
+		entgql.Skip(entgql.SkipAll),
+	}
+}
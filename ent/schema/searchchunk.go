@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// SearchChunk holds the schema definition for the SearchChunk entity.
+// It is a run of consecutive lines of text searchable with the full-text index of the database, which is maintained
+// outside of ent. See the search package.
+type SearchChunk struct {
+	ent.Schema
+}
+
+// Fields of the SearchChunk.
+func (SearchChunk) Fields() []ent.Field {
+	return []ent.Field{
+		// What the text comes from.
+		field.Enum("source").Values("BUILD_LOGS", "PROBLEM_OUTPUT", "BLOB").Immutable(),
+
+		// The number of the first line of the chunk in its source, from 1.
+		field.Int("first_line").Immutable(),
+
+		// The lines.
+		field.Text("content").Immutable(),
+	}
+}
+
+// Edges of the SearchChunk.
+func (SearchChunk) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Edge back to the invocation of the build logs or the problem output.
+		edge.From("bazel_invocation", BazelInvocation.Type).
+			Ref("search_chunks").
+			Unique(),
+
+		// Edge back to the problem of the problem output.
+		edge.From("problem", BazelInvocationProblem.Type).
+			Ref("search_chunks").
+			Unique(),
+
+		// Edge back to the archived blob.
+		edge.From("blob", Blob.Type).
+			Ref("search_chunks").
+			Unique(),
+	}
+}
+
+// Annotations of the SearchChunk.
+func (SearchChunk) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// NOTE: Read through the searchInvocations query.
+		entgql.Skip(entgql.SkipAll),
+	}
+}
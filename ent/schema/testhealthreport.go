@@ -0,0 +1,88 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TestHealthReport holds the schema definition for the TestHealthReport entity.
+// It reports a test with imbalanced shards, close to its timeout or oversized, see summary.AnalyzeTestHealth.
+type TestHealthReport struct {
+	ent.Schema
+}
+
+// Fields of the TestHealthReport.
+func (TestHealthReport) Fields() []ent.Field {
+	return []ent.Field{
+		// The label of the test ex: //foo:bar_test.
+		field.String("label"),
+
+		// The issue found with the test.
+		field.Enum("issue").
+			Values("IMBALANCED_SHARDS",
+				"NEAR_TIMEOUT",
+				"OVERSIZED"),
+
+		// The declared size of the test.
+		field.Enum("test_size").
+			Values("UNKNOWN",
+				"SMALL",
+				"MEDIUM",
+				"LARGE",
+				"ENORMOUS").
+			Default("UNKNOWN"),
+
+		// The number of shards of the test.
+		field.Int32("shard_count"),
+
+		// Duration of the slowest shard in Milliseconds.
+		field.Int64("max_duration_in_ms"),
+
+		// Duration of the median shard in Milliseconds.
+		field.Int64("median_shard_duration_in_ms"),
+
+		// Timeout of the declared size in Milliseconds, 0 if unknown.
+		field.Int64("timeout_in_ms"),
+
+		// The suggested number of shards.
+		field.Int32("suggested_shard_count"),
+
+		// The suggested size.
+		field.Enum("suggested_size").
+			Values("UNKNOWN",
+				"SMALL",
+				"MEDIUM",
+				"LARGE",
+				"ENORMOUS").
+			Default("UNKNOWN"),
+	}
+}
+
+// Edges of the TestHealthReport.
+func (TestHealthReport) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Edge back to the bazel invocation.
+		edge.From("bazel_invocation", BazelInvocation.Type).
+			Ref("test_health_reports").
+			Unique(),
+	}
+}
+
+// Indexes of the TestHealthReport.
+func (TestHealthReport) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("label"),
+	}
+}
+
+// Annotations of the TestHealthReport.
+func (TestHealthReport) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+		entgql.QueryField("findTestHealthReports"),
+	}
+}
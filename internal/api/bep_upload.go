@@ -10,6 +10,8 @@ import (
 
 	"github.com/buildbarn/bb-portal/ent/gen/ent"
 	"github.com/buildbarn/bb-portal/pkg/processing"
+	"github.com/buildbarn/bb-portal/pkg/progress"
+	"github.com/buildbarn/bb-portal/pkg/summary"
 )
 
 // A constant for strong mb and upload size informations.
@@ -20,15 +22,19 @@ const (
 
 // Bep upload handler struct.
 type bepUploadHandler struct {
-	client       *ent.Client
-	blobArchiver processing.BlobMultiArchiver
+	client               *ent.Client
+	blobArchivingPool    *processing.BlobArchivingPool
+	progressHub          *progress.Hub
+	testHealthThresholds summary.TestHealthThresholds
 }
 
 // NewBEPUploadHandler Constructor function for BEP upload handler.
-func NewBEPUploadHandler(client *ent.Client, blobArchiver processing.BlobMultiArchiver) http.Handler {
+func NewBEPUploadHandler(client *ent.Client, blobArchivingPool *processing.BlobArchivingPool, progressHub *progress.Hub, testHealthThresholds summary.TestHealthThresholds) http.Handler {
 	return &bepUploadHandler{
-		client:       client,
-		blobArchiver: blobArchiver,
+		client:               client,
+		blobArchivingPool:    blobArchivingPool,
+		progressHub:          progressHub,
+		testHealthThresholds: testHealthThresholds,
 	}
 }
 
@@ -69,12 +75,16 @@ func (b bepUploadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 	defer os.Remove(tmpFile.Name())
 
-	workflow := processing.New(b.client, b.blobArchiver)
+	workflow := processing.New(b.client, b.blobArchivingPool, b.progressHub, b.testHealthThresholds)
 	invocation, err := workflow.ProcessFile(r.Context(), tmpFile.Name())
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	if invocation == nil {
+		http.Error(w, "The uploaded file does not have a final event", http.StatusBadRequest)
+		return
+	}
 
 	location := fmt.Sprintf("/bazel-invocations/%s", invocation.InvocationID)
 	// NOTE: Want to do http.Redirect(w, r, location, http.StatusSeeOther), but can't get it working with antd Upload widget.
@@ -1,113 +1,189 @@
 package api
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
+	"mime"
 	"net/http"
 	"net/url"
 	"os"
-	"path/filepath"
+	"path"
 	"strconv"
 	"time"
 
 	"github.com/buildbarn/bb-portal/ent/gen/ent"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
+	"github.com/buildbarn/bb-portal/pkg/blobs"
 	"github.com/buildbarn/bb-portal/pkg/cas"
+	"github.com/buildbarn/bb-portal/pkg/storage"
 )
 
+// errUnsupportedScheme An error helper.
+var errUnsupportedScheme = errors.New("unsupported URI scheme")
+
 // A struct to handle blobs.
 type blobHandler struct {
-	client     *ent.Client
-	casManager *cas.ConnectionManager
+	client      *ent.Client
+	casManager  *cas.ConnectionManager
+	blobStorage storage.BlobStorage
 }
 
-// NewBlobHandler Constructor functio for a blob hanlder.
-func NewBlobHandler(client *ent.Client, casManager *cas.ConnectionManager) http.Handler {
-	return &blobHandler{client: client, casManager: casManager}
+// NewBlobHandler Constructor functio for a blob hanlder, serving blobs by key with support for ranges and caching.
+func NewBlobHandler(client *ent.Client, casManager *cas.ConnectionManager, blobStorage storage.BlobStorage) http.Handler {
+	return &blobHandler{client: client, casManager: casManager, blobStorage: blobStorage}
 }
 
 // ServeHTTP Serve this over http.
 func (b *blobHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
-	blobIDPathValue := request.PathValue("blobID")
+	key := request.PathValue("key")
 	name := request.PathValue("name")
-	blobID, err := strconv.Atoi(blobIDPathValue)
+	blobRecord, err := findBlob(request.Context(), b.client, key)
 	if err != nil {
-		writeErr(writer, request, http.StatusBadRequest, fmt.Sprintf("Invalid blobID: %s", blobIDPathValue))
+		writeErr(writer, request, http.StatusNotFound, fmt.Sprintf("Could not find blob with key: %s", key))
 		return
 	}
 
-	// TODO: We probably want semantic IDs, not row IDs.
-	blobRecord, err := b.client.Blob.Get(request.Context(), blobID)
+	b.serveBlob(writer, request, name, blobRecord)
+}
+
+// Find a blob by key, preferring a blob which is archived, or else by row ID for URLs from before blobs had keys.
+func findBlob(ctx context.Context, client *ent.Client, key string) (*ent.Blob, error) {
+	blobRecord, err := client.Blob.Query().
+		Where(blob.Key(key), blob.ArchivingStatusEQ(blob.ArchivingStatusSUCCESS)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		blobRecord, err = client.Blob.Query().Where(blob.Key(key)).First(ctx)
+	}
+	if ent.IsNotFound(err) {
+		if blobID, atoiErr := strconv.Atoi(key); atoiErr == nil {
+			blobRecord, err = client.Blob.Get(ctx, blobID)
+		}
+	}
 	if err != nil {
-		writeErr(
-			writer,
-			request,
-			http.StatusNotFound,
-			fmt.Sprintf("Could not find blob with blobID: %s", blobIDPathValue),
-		)
-		return
+		return nil, fmt.Errorf("could not find blob %s: %w", key, err)
 	}
-
-	b.serveBlob(writer, request, name, blobRecord)
+	return blobRecord, nil
 }
 
 // Serve a blob.
 func (b *blobHandler) serveBlob(writer http.ResponseWriter, request *http.Request, name string, blobRecord *ent.Blob) {
-	if blobRecord.ArchivingStatus == blob.ArchivingStatusSUCCESS {
-		http.ServeFile(writer, request, blobRecord.ArchiveURL)
+	if notModified(request, blobRecord) {
+		setCacheHeaders(writer, blobRecord)
+		writer.WriteHeader(http.StatusNotModified)
+		return
+	}
+	content, modified, err := b.openBlob(request.Context(), blobRecord)
+	if errors.Is(err, storage.ErrNotFound) {
+		writeErr(writer, request, http.StatusNotFound, fmt.Sprintf("Blob %d is no longer archived", blobRecord.ID))
+		return
+	}
+	if err != nil {
+		writeErr(writer, request, http.StatusInternalServerError, err.Error())
 		return
 	}
+	defer content.Close()
 
-	// Fallback to reading original.
-	uri, err := url.Parse(blobRecord.URI)
+	contentType, err := blobs.DetectContentType(name, content)
+	if errors.Is(err, storage.ErrNotFound) {
+		writeErr(writer, request, http.StatusNotFound, fmt.Sprintf("Blob %d is no longer archived", blobRecord.ID))
+		return
+	}
 	if err != nil {
-		writeErr(
-			writer,
-			request,
-			http.StatusInternalServerError,
-			fmt.Sprintf("Blob %d had an invalid URI: %s", blobRecord.ID, blobRecord.URI),
-		)
+		writeErr(writer, request, http.StatusInternalServerError, err.Error())
 		return
 	}
+	setCacheHeaders(writer, blobRecord)
+	setContentHeaders(writer, request, name, contentType)
+	http.ServeContent(writer, request, name, modified, content)
+}
+
+// Open a blob from the blob storage once archived, or else from where it was uploaded. Blobs in a CAS are read with
+// ByteStream reads from the offset being served, instead of being downloaded whole.
+func (b *blobHandler) openBlob(ctx context.Context, blobRecord *ent.Blob) (io.ReadSeekCloser, time.Time, error) {
+	if blobRecord.ArchivingStatus == blob.ArchivingStatusSUCCESS {
+		reader, err := b.blobStorage.Get(ctx, blobRecord.ArchiveURL)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("could not get archived blob %d: %w", blobRecord.ID, err)
+		}
+		return reader, time.Time{}, nil
+	}
+
+	uri, err := url.Parse(blobRecord.URI)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("blob %d had an invalid URI: %s: %w", blobRecord.ID, blobRecord.URI, err)
+	}
 	switch uri.Scheme {
 	case "file":
-		http.ServeFile(writer, request, uri.Path)
+		file, err := os.Open(uri.Path)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("could not open blob %d: %w", blobRecord.ID, err)
+		}
+		info, err := file.Stat()
+		if err != nil {
+			return nil, time.Time{}, errors.Join(fmt.Errorf("could not open blob %d: %w", blobRecord.ID, err), file.Close())
+		}
+		return file, info.ModTime(), nil
 	case "bytestream":
-		b.serveFromBytestream(writer, request, name, uri)
+		d, err := cas.DigestFromURI(uri)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		casClient, err := b.casManager.GetClientForURI(ctx, uri)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		return casClient.NewBlobReader(ctx, d), time.Time{}, nil
 	default:
-		writeErr(writer, request, http.StatusInternalServerError, fmt.Sprintf("unsupported URI scheme: %s", uri.Scheme))
+		return nil, time.Time{}, fmt.Errorf("blob %d: %w: %s", blobRecord.ID, errUnsupportedScheme, uri.Scheme)
 	}
 }
 
-// Serve from bytestream function.
-func (b *blobHandler) serveFromBytestream(writer http.ResponseWriter, request *http.Request, name string, uri *url.URL) {
-	casClient, err := b.casManager.GetClientForURI(request.Context(), uri)
-	if err != nil {
-		writeErr(writer, request, http.StatusInternalServerError, err.Error())
-		return
+// Set the caching headers of a blob. The content of a blob keyed by its digest never changes, so it can be cached for
+// good, unless it was truncated when archived. Other blobs are revalidated.
+func setCacheHeaders(writer http.ResponseWriter, blobRecord *ent.Blob) {
+	etag, immutable := blobETag(blobRecord)
+	if etag != "" {
+		writer.Header().Set("ETag", etag)
 	}
-	defer casClient.Close()
-
-	tmpFile, err := os.CreateTemp("", filepath.Base(uri.Path))
-	if err != nil {
-		writeErr(writer, request, http.StatusInternalServerError, err.Error())
-		return
+	if immutable {
+		writer.Header().Set("Cache-Control", "private, max-age=31536000, immutable")
+	} else {
+		writer.Header().Set("Cache-Control", "no-cache")
 	}
-	defer tmpFile.Close()
-	defer os.Remove(tmpFile.Name())
+}
 
-	err = casClient.ReadBlobToFile(request.Context(), uri, tmpFile.Name())
-	if err != nil {
-		writeErr(writer, request, http.StatusInternalServerError, err.Error())
-		return
+// Get the ETag of a blob, if it is keyed by its digest, and whether its content never changes.
+func blobETag(blobRecord *ent.Blob) (string, bool) {
+	if !blobs.IsDigestKey(blobRecord.Key) {
+		return "", false
+	}
+	if blobRecord.ArchivingStatus == blob.ArchivingStatusSUCCESS && blobRecord.Reason != "" {
+		return strconv.Quote(blobRecord.Key + "-truncated-" + strconv.FormatInt(blobRecord.SizeBytes, 10)), false
 	}
+	return strconv.Quote(blobRecord.Key), true
+}
 
-	if _, err = tmpFile.Seek(0, io.SeekStart); err != nil {
-		writeErr(writer, request, http.StatusInternalServerError, err.Error())
-		return
+// Check if a request is conditional on an ETag the blob still has, so that it can be answered without opening the blob.
+func notModified(request *http.Request, blobRecord *ent.Blob) bool {
+	etag, _ := blobETag(blobRecord)
+	return etag != "" && request.Header.Get("If-None-Match") == etag
+}
+
+// Set the content headers of a file served from a blob. Files safe to preview in a browser are served inline, unless
+// downloaded with ?download=true, others as attachments.
+func setContentHeaders(writer http.ResponseWriter, request *http.Request, name, contentType string) {
+	contentType, previewable := blobs.PreviewContentType(contentType)
+	disposition := "inline"
+	if !previewable || request.URL.Query().Get("download") == "true" {
+		contentType = "application/octet-stream"
+		disposition = "attachment"
 	}
-	http.ServeContent(writer, request, name, time.Time{}, tmpFile)
+	writer.Header().Set("Content-Type", contentType)
+	writer.Header().Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": path.Base(name)}))
+	writer.Header().Set("X-Content-Type-Options", "nosniff")
 }
 
 // A function to write an error.
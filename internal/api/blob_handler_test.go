@@ -0,0 +1,93 @@
+package api_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/enttest"
+	"github.com/buildbarn/bb-portal/internal/api"
+	"github.com/buildbarn/bb-portal/pkg/blobs"
+	"github.com/buildbarn/bb-portal/pkg/storage"
+)
+
+func TestBlobHandler(t *testing.T) {
+	ctx := context.Background()
+	db := enttest.Open(t, "sqlite3", "file:blob_handler?mode=memory&_fk=1")
+	defer db.Close()
+	blobStorage, err := storage.NewLocalStorage(storage.LocalStorageParams{Folder: t.TempDir(), Compress: true})
+	require.NoError(t, err)
+
+	content := strings.Repeat("0123456789", 100)
+	hash := sha256.Sum256([]byte(content))
+	uri := fmt.Sprintf("bytestream://cas.example.com/blobs/%s/%d", hex.EncodeToString(hash[:]), len(content))
+	require.NoError(t, blobStorage.Put(ctx, "archived", strings.NewReader(content), int64(len(content))))
+	archived := db.Blob.Create().
+		SetURI(uri).
+		SetKey(blobs.Key(uri)).
+		SetArchivingStatus(blob.ArchivingStatusSUCCESS).
+		SetArchiveURL("archived").
+		SaveX(ctx)
+
+	logPath := filepath.Join(t.TempDir(), "test.log")
+	require.NoError(t, os.WriteFile(logPath, []byte("<html>not rendered</html>"), 0o600))
+	local := db.Blob.Create().SetURI("file://" + logPath).SaveX(ctx)
+
+	mux := http.NewServeMux()
+	mux.Handle("/api/v1/blobs/{key}/{name}", api.NewBlobHandler(db, nil, blobStorage))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	get := func(path string, header map[string]string) (*http.Response, string) {
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+path, nil)
+		require.NoError(t, err)
+		for name, value := range header {
+			request.Header.Set(name, value)
+		}
+		response, err := server.Client().Do(request)
+		require.NoError(t, err)
+		defer response.Body.Close()
+		body, err := io.ReadAll(response.Body)
+		require.NoError(t, err)
+		return response, string(body)
+	}
+
+	// Blobs keyed by digest are served by range, even compressed, and can be cached for good.
+	response, body := get("/api/v1/blobs/"+archived.Key+"/test.log", map[string]string{"Range": "bytes=995-"})
+	require.Equal(t, http.StatusPartialContent, response.StatusCode)
+	require.Equal(t, "56789", body)
+	require.Equal(t, strconv.Quote(archived.Key), response.Header.Get("ETag"))
+	require.Equal(t, "private, max-age=31536000, immutable", response.Header.Get("Cache-Control"))
+	require.Equal(t, "text/plain; charset=utf-8", response.Header.Get("Content-Type"))
+
+	response, _ = get("/api/v1/blobs/"+archived.Key+"/test.log", map[string]string{"If-None-Match": response.Header.Get("ETag")})
+	require.Equal(t, http.StatusNotModified, response.StatusCode)
+
+	// Blobs saved before blobs had keys are found by row ID, and their content type is detected but not rendered.
+	response, body = get(fmt.Sprintf("/api/v1/blobs/%d/test.log", local.ID), nil)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	require.Equal(t, "<html>not rendered</html>", body)
+	require.Equal(t, "text/plain; charset=utf-8", response.Header.Get("Content-Type"))
+	require.Equal(t, "nosniff", response.Header.Get("X-Content-Type-Options"))
+	require.Equal(t, "no-cache", response.Header.Get("Cache-Control"))
+	require.Empty(t, response.Header.Get("ETag"))
+
+	response, _ = get("/api/v1/blobs/"+archived.Key+"/test.log?download=true", nil)
+	require.Equal(t, "application/octet-stream", response.Header.Get("Content-Type"))
+	require.Equal(t, `attachment; filename=test.log`, response.Header.Get("Content-Disposition"))
+
+	response, _ = get("/api/v1/blobs/unknown/test.log", nil)
+	require.Equal(t, http.StatusNotFound, response.StatusCode)
+}
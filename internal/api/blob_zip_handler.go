@@ -0,0 +1,138 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/pkg/blobs"
+	"github.com/buildbarn/bb-portal/pkg/storage"
+)
+
+// A struct to handle the entries of zip blobs.
+type blobZipHandler struct {
+	client     *ent.Client
+	blobOpener *blobs.Opener
+}
+
+// A zip entry, as listed by the API.
+type zipEntryResponse struct {
+	Name                  string    `json:"name"`
+	SizeInBytes           int64     `json:"sizeInBytes"`
+	CompressedSizeInBytes int64     `json:"compressedSizeInBytes"`
+	Compression           string    `json:"compression"`
+	Modified              time.Time `json:"modified"`
+	ContentType           string    `json:"contentType"`
+	URL                   string    `json:"url"`
+}
+
+// NewBlobZipHandler Constructor function for a handler listing the entries of zip blobs, when the entry path value is
+// empty, and serving them.
+func NewBlobZipHandler(client *ent.Client, blobOpener *blobs.Opener) http.Handler {
+	return &blobZipHandler{client: client, blobOpener: blobOpener}
+}
+
+// ServeHTTP Serve this over http.
+func (b *blobZipHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	key := request.PathValue("key")
+	blobRecord, err := findBlob(request.Context(), b.client, key)
+	if err != nil {
+		writeErr(writer, request, http.StatusNotFound, fmt.Sprintf("Could not find blob with key: %s", key))
+		return
+	}
+	if notModified(request, blobRecord) {
+		setCacheHeaders(writer, blobRecord)
+		writer.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	file, err := b.blobOpener.Open(request.Context(), blobRecord)
+	if errors.Is(err, storage.ErrNotFound) {
+		writeErr(writer, request, http.StatusNotFound, fmt.Sprintf("Blob %d is no longer archived", blobRecord.ID))
+		return
+	}
+	if err != nil {
+		writeErr(writer, request, http.StatusInternalServerError, err.Error())
+		return
+	}
+	defer file.Close()
+
+	setCacheHeaders(writer, blobRecord)
+	entry := request.PathValue("entry")
+	if entry == "" {
+		b.serveEntries(writer, request, file)
+		return
+	}
+	b.serveEntry(writer, request, file, entry)
+}
+
+// Serve the list of the entries of a zip blob.
+func (b *blobZipHandler) serveEntries(writer http.ResponseWriter, request *http.Request, file *blobs.File) {
+	entries, err := blobs.ListZipEntries(file, file.Size())
+	if err != nil {
+		writeErr(writer, request, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	response := make([]zipEntryResponse, 0, len(entries))
+	for _, entry := range entries {
+		response = append(response, zipEntryResponse{
+			Name:                  entry.Name,
+			SizeInBytes:           entry.SizeBytes,
+			CompressedSizeInBytes: entry.CompressedSizeBytes,
+			Compression:           string(entry.Compression),
+			Modified:              entry.Modified,
+			ContentType:           entry.ContentType,
+			URL:                   path.Join(request.URL.EscapedPath(), (&url.URL{Path: entry.Name}).EscapedPath()),
+		})
+	}
+	writer.Header().Set("Content-Type", "application/json")
+	if err = json.NewEncoder(writer).Encode(response); err != nil {
+		slog.ErrorContext(request.Context(), "failed to write response", "err", err)
+	}
+}
+
+// Serve a single entry of a zip blob.
+func (b *blobZipHandler) serveEntry(writer http.ResponseWriter, request *http.Request, file *blobs.File, name string) {
+	entry, err := blobs.FindZipEntry(file, file.Size(), name)
+	if errors.Is(err, blobs.ErrZipEntryNotFound) {
+		writeErr(writer, request, http.StatusNotFound, err.Error())
+		return
+	}
+	if err != nil {
+		writeErr(writer, request, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+
+	stored, err := blobs.OpenStoredZipEntry(file, entry)
+	if err != nil {
+		writeErr(writer, request, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+
+	setContentHeaders(writer, request, entry.Name, blobs.ContentType(entry.Name))
+
+	// Entries stored uncompressed can be served by range.
+	if stored != nil {
+		http.ServeContent(writer, request, entry.Name, entry.Modified, stored)
+		return
+	}
+	reader, err := entry.Open()
+	if err != nil {
+		slog.ErrorContext(request.Context(), "could not open zip entry", "entry", entry.Name, "err", err)
+		writer.WriteHeader(http.StatusUnprocessableEntity)
+		return
+	}
+	defer reader.Close()
+	writer.Header().Set("Content-Length", strconv.FormatUint(entry.UncompressedSize64, 10))
+	if _, err = io.Copy(writer, reader); err != nil {
+		slog.ErrorContext(request.Context(), "could not stream zip entry", "entry", entry.Name, "err", err)
+	}
+}
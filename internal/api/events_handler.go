@@ -0,0 +1,64 @@
+package api
+
+import (
+	"bufio"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/pkg/events"
+	"github.com/buildbarn/bb-portal/pkg/export"
+)
+
+// A struct to serve the build events of an invocation.
+type invocationEventsHandler struct {
+	client *ent.Client
+	format events.Format
+}
+
+// NewInvocationEventsHandler Constructor function for a handler serving the build events of the invocation with the
+// uuid path value as a file of a format, which the BEP upload accepts. The X-BEP-Source header tells whether they are
+// those of the file it was imported from or were reconstructed.
+func NewInvocationEventsHandler(client *ent.Client, format events.Format) http.Handler {
+	return &invocationEventsHandler{client: client, format: format}
+}
+
+// ServeHTTP Serve this over http.
+func (h *invocationEventsHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	invocationID, err := uuid.Parse(request.PathValue("uuid"))
+	if err != nil {
+		writeErr(writer, request, http.StatusBadRequest, fmt.Sprintf("Invalid invocation ID: %s", request.PathValue("uuid")))
+		return
+	}
+	buildEvents, source, err := export.LoadEvents(request.Context(), h.client, invocationID)
+	if ent.IsNotFound(err) {
+		writeErr(writer, request, http.StatusNotFound, fmt.Sprintf("Could not find invocation with ID: %s", invocationID))
+		return
+	}
+	if err != nil {
+		writeErr(writer, request, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	contentType, extension := "application/x-ndjson", "ndjson"
+	if h.format == events.FormatBinary {
+		contentType, extension = "application/octet-stream", "bin"
+	}
+	writer.Header().Set("Content-Type", contentType)
+	writer.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.bep.%s\"", invocationID, extension))
+	writer.Header().Set("X-BEP-Source", string(source))
+	buffered := bufio.NewWriter(writer)
+	eventWriter := events.NewWriter(buffered, h.format)
+	for _, buildEvent := range buildEvents {
+		if err = eventWriter.Write(buildEvent); err != nil {
+			slog.ErrorContext(request.Context(), "failed to write response", "err", err)
+			return
+		}
+	}
+	if err = buffered.Flush(); err != nil {
+		slog.ErrorContext(request.Context(), "failed to write response", "err", err)
+	}
+}
@@ -0,0 +1,222 @@
+package api_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/enttest"
+	"github.com/buildbarn/bb-portal/internal/api"
+	"github.com/buildbarn/bb-portal/pkg/events"
+	"github.com/buildbarn/bb-portal/pkg/export"
+	"github.com/buildbarn/bb-portal/pkg/processing"
+	"github.com/buildbarn/bb-portal/pkg/summary"
+)
+
+// The parts of an invocation saved from its events which do not depend on when, where and next to which other
+// invocations they were imported, as JSON.
+func savedInvocation(t *testing.T, client *ent.Client, invocationID uuid.UUID) string {
+	ctx := context.Background()
+	document, err := export.LoadInvocation(ctx, client, invocationID)
+	require.NoError(t, err)
+	for i := range document.Targets {
+		document.Targets[i].DurationMs = 0
+	}
+	for i := range document.Problems {
+		document.Problems[i].NewlyFailing = nil
+	}
+	slices.SortFunc(document.Problems, func(a, b export.Problem) int {
+		return strings.Compare(a.Type+a.Label, b.Type+b.Label)
+	})
+
+	invocation, err := client.BazelInvocation.Query().
+		Where(bazelinvocation.InvocationID(invocationID)).
+		WithProblems().
+		WithTestCollection(func(query *ent.TestCollectionQuery) {
+			query.WithTestSummary().WithTestResults(func(query *ent.TestResultBESQuery) {
+				query.Order(ent.Asc("id")).WithExecutionInfo(func(query *ent.ExectionInfoQuery) {
+					query.WithTimingBreakdown(func(query *ent.TimingBreakdownQuery) {
+						query.WithChild()
+					})
+				})
+			})
+		}).
+		WithMetrics(func(query *ent.MetricsQuery) {
+			query.
+				WithActionSummary(func(query *ent.ActionSummaryQuery) {
+					query.WithActionData().WithRunnerCount().WithActionCacheStatistics(func(query *ent.ActionCacheStatisticsQuery) {
+						query.WithMissDetails()
+					})
+				}).
+				WithMemoryMetrics(func(query *ent.MemoryMetricsQuery) {
+					query.WithGarbageMetrics()
+				}).
+				WithTargetMetrics().
+				WithPackageMetrics(func(query *ent.PackageMetricsQuery) {
+					query.WithPackageLoadMetrics()
+				}).
+				WithTimingMetrics().
+				WithCumulativeMetrics().
+				WithArtifactMetrics(func(query *ent.ArtifactMetricsQuery) {
+					query.WithSourceArtifactsRead().WithOutputArtifactsSeen().WithOutputArtifactsFromActionCache().WithTopLevelArtifacts()
+				}).
+				WithNetworkMetrics(func(query *ent.NetworkMetricsQuery) {
+					query.WithSystemNetworkStats()
+				}).
+				WithBuildGraphMetrics()
+		}).
+		Only(ctx)
+	require.NoError(t, err)
+	slices.SortFunc(invocation.Edges.TestCollection, func(a, b *ent.TestCollection) int {
+		return strings.Compare(a.Label, b.Label)
+	})
+	problemEvents := map[string][]string{}
+	for _, problem := range invocation.Edges.Problems {
+		buildEvents, err := events.FromJSONArray(problem.BepEvents)
+		require.NoError(t, err)
+		for _, buildEvent := range buildEvents {
+			marshaled, err := protojson.Marshal(buildEvent.BuildEvent)
+			require.NoError(t, err)
+			key := problem.ProblemType + problem.Label
+			problemEvents[key] = append(problemEvents[key], string(marshaled))
+		}
+	}
+
+	saved, err := json.Marshal(map[string]any{
+		"document":              document,
+		"summary":               invocation.Summary,
+		"buildLogs":             invocation.BuildLogs,
+		"cpu":                   invocation.CPU,
+		"platformName":          invocation.PlatformName,
+		"configurationMnemonic": invocation.ConfigurationMnemonic,
+		"numFetches":            invocation.NumFetches,
+		"changeNumber":          invocation.ChangeNumber,
+		"patchsetNumber":        invocation.PatchsetNumber,
+		"problemEvents":         problemEvents,
+		"testCollections":       invocation.Edges.TestCollection,
+		"metrics":               invocation.Edges.Metrics,
+	})
+	require.NoError(t, err)
+	var withoutIDs any
+	require.NoError(t, json.Unmarshal(saved, &withoutIDs))
+	saved, err = json.Marshal(deleteIDs(withoutIDs))
+	require.NoError(t, err)
+	return string(saved)
+}
+
+// Delete the IDs of the entities in JSON, which differ between databases.
+func deleteIDs(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		delete(value, "id")
+		for key, field := range value {
+			value[key] = deleteIDs(field)
+		}
+	case []any:
+		for i, item := range value {
+			value[i] = deleteIDs(item)
+		}
+	}
+	return value
+}
+
+func TestInvocationEventsHandler(t *testing.T) {
+	ctx := context.Background()
+	db := enttest.Open(t, "sqlite3", "file:invocation_events?mode=memory&_fk=1")
+	defer db.Close()
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /invocations/{uuid}/events.ndjson", api.NewInvocationEventsHandler(db, events.FormatJSON))
+	mux.Handle("GET /invocations/{uuid}/events.bin", api.NewInvocationEventsHandler(db, events.FormatBinary))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	get := func(path string) (*http.Response, []byte) {
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+path, nil)
+		require.NoError(t, err)
+		response, err := server.Client().Do(request)
+		require.NoError(t, err)
+		defer response.Body.Close()
+		body, err := io.ReadAll(response.Body)
+		require.NoError(t, err)
+		return response, body
+	}
+	// Upload events to a portal with its own database, returning the invocation they were saved as.
+	upload := func(t *testing.T, dataSourceName string, body []byte) (*ent.Client, *ent.BazelInvocation) {
+		client := enttest.Open(t, "sqlite3", dataSourceName)
+		var form bytes.Buffer
+		multipartWriter := multipart.NewWriter(&form)
+		part, err := multipartWriter.CreateFormFile("file", "events")
+		require.NoError(t, err)
+		_, err = part.Write(body)
+		require.NoError(t, err)
+		require.NoError(t, multipartWriter.Close())
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/bep/upload", &form)
+		request.Header.Set("Content-Type", multipartWriter.FormDataContentType())
+		recorder := httptest.NewRecorder()
+		api.NewBEPUploadHandler(client, nil, nil, summary.DefaultTestHealthThresholds()).ServeHTTP(recorder, request)
+		require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+		invocation, err := client.BazelInvocation.Query().Only(ctx)
+		require.NoError(t, err)
+		return client, invocation
+	}
+
+	for _, fixture := range []string{
+		"nextjs_build.bep.ndjson",
+		"nextjs_build_fail.bep.ndjson",
+		"nextjs_error_progress.bep.ndjson",
+		"nextjs_test.bep.ndjson",
+		"nextjs_test_fail.bep.ndjson",
+	} {
+		t.Run(fixture, func(t *testing.T) {
+			original, err := os.ReadFile(filepath.Join("../../pkg/summary/testdata", fixture))
+			require.NoError(t, err)
+			eventFile := filepath.Join(t.TempDir(), fixture)
+			require.NoError(t, os.WriteFile(eventFile, original, 0o600))
+			invocation, err := processing.New(db, nil, nil, summary.DefaultTestHealthThresholds()).ProcessFile(ctx, eventFile)
+			require.NoError(t, err)
+			path := "/invocations/" + invocation.InvocationID.String()
+			saved := savedInvocation(t, db, invocation.InvocationID)
+
+			// The events of the file are served while it is on disk.
+			response, body := get(path + "/events.ndjson")
+			require.Equal(t, http.StatusOK, response.StatusCode, string(body))
+			require.Equal(t, "application/x-ndjson", response.Header.Get("Content-Type"))
+			require.Equal(t, string(export.BEPSourceEventFile), response.Header.Get("X-BEP-Source"))
+			require.Equal(t, bytes.Count(original, []byte("\n")), bytes.Count(body, []byte("\n")))
+			_, imported := upload(t, fmt.Sprintf("file:%s_file?mode=memory&_fk=1", fixture), body)
+			require.Equal(t, invocation.InvocationID, imported.InvocationID)
+
+			// And reconstructed once it is gone, which saves the same invocation again.
+			require.NoError(t, os.Remove(eventFile))
+			for _, format := range []string{"ndjson", "bin"} {
+				response, body = get(path + "/events." + format)
+				require.Equal(t, http.StatusOK, response.StatusCode, string(body))
+				require.Equal(t, string(export.BEPSourceReconstructed), response.Header.Get("X-BEP-Source"))
+				require.Contains(t, response.Header.Get("Content-Disposition"), invocation.InvocationID.String()+".bep."+format)
+				client, imported := upload(t, fmt.Sprintf("file:%s_%s?mode=memory&_fk=1", fixture, format), body)
+				require.JSONEq(t, saved, savedInvocation(t, client, imported.InvocationID), format)
+			}
+		})
+	}
+
+	response, _ := get("/invocations/" + uuid.NewString() + "/events.ndjson")
+	require.Equal(t, http.StatusNotFound, response.StatusCode)
+	response, _ = get("/invocations/not-a-uuid/events.bin")
+	require.Equal(t, http.StatusBadRequest, response.StatusCode)
+}
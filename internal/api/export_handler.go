@@ -0,0 +1,186 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/pkg/export"
+)
+
+// A struct to serve the document of an invocation.
+type invocationExportHandler struct {
+	client *ent.Client
+}
+
+// A struct to serve the document of a build.
+type buildExportHandler struct {
+	client *ent.Client
+}
+
+// A struct to stream the list of invocations.
+type invocationListHandler struct {
+	client *ent.Client
+}
+
+// NewInvocationExportHandler Constructor function for a handler serving the versioned JSON document of the invocation
+// with the uuid path value.
+func NewInvocationExportHandler(client *ent.Client) http.Handler {
+	return &invocationExportHandler{client: client}
+}
+
+// NewBuildExportHandler Constructor function for a handler serving the versioned JSON document of the build with the
+// uuid path value.
+func NewBuildExportHandler(client *ent.Client) http.Handler {
+	return &buildExportHandler{client: client}
+}
+
+// NewInvocationListHandler Constructor function for a handler streaming the summaries of the invocations as newline
+// delimited JSON, the last saved first. The query parameters build, branch, commit, stepLabel, user, since and until
+// filter them, limit sets the size of the pages and cursor the page after the one which returned it in the
+// X-Next-Cursor header.
+func NewInvocationListHandler(client *ent.Client) http.Handler {
+	return &invocationListHandler{client: client}
+}
+
+// ServeHTTP Serve this over http.
+func (h *invocationExportHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	invocationID, err := uuid.Parse(request.PathValue("uuid"))
+	if err != nil {
+		writeErr(writer, request, http.StatusBadRequest, fmt.Sprintf("Invalid invocation ID: %s", request.PathValue("uuid")))
+		return
+	}
+	document, err := export.LoadInvocation(request.Context(), h.client, invocationID)
+	if ent.IsNotFound(err) {
+		writeErr(writer, request, http.StatusNotFound, fmt.Sprintf("Could not find invocation with ID: %s", invocationID))
+		return
+	}
+	if err != nil {
+		writeErr(writer, request, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(writer, request, document)
+}
+
+// ServeHTTP Serve this over http.
+func (h *buildExportHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	buildUUID, err := uuid.Parse(request.PathValue("uuid"))
+	if err != nil {
+		writeErr(writer, request, http.StatusBadRequest, fmt.Sprintf("Invalid build UUID: %s", request.PathValue("uuid")))
+		return
+	}
+	document, err := export.LoadBuild(request.Context(), h.client, buildUUID)
+	if ent.IsNotFound(err) {
+		writeErr(writer, request, http.StatusNotFound, fmt.Sprintf("Could not find build with UUID: %s", buildUUID))
+		return
+	}
+	if err != nil {
+		writeErr(writer, request, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(writer, request, document)
+}
+
+// ServeHTTP Serve this over http.
+func (h *invocationListHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	query := request.URL.Query()
+	filter, err := listFilter(query)
+	if err != nil {
+		writeErr(writer, request, http.StatusBadRequest, err.Error())
+		return
+	}
+	page := export.ListPage{Cursor: query.Get("cursor")}
+	if limit := query.Get("limit"); limit != "" {
+		if page.Limit, err = strconv.Atoi(limit); err != nil {
+			writeErr(writer, request, http.StatusBadRequest, fmt.Sprintf("Invalid limit: %s", limit))
+			return
+		}
+	}
+	summaries, next, err := export.ListInvocations(request.Context(), h.client, filter, page)
+	if errors.Is(err, export.ErrInvalidCursor) {
+		writeErr(writer, request, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		writeErr(writer, request, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/x-ndjson")
+	if next != "" {
+		nextQuery := request.URL.Query()
+		nextQuery.Set("cursor", next)
+		nextURL := url.URL{Path: request.URL.Path, RawQuery: nextQuery.Encode()}
+		writer.Header().Set("X-Next-Cursor", next)
+		writer.Header().Set("Link", fmt.Sprintf("<%s>; rel=\"next\"", nextURL.String()))
+	}
+	encoder := json.NewEncoder(writer)
+	flusher, _ := writer.(http.Flusher)
+	for _, summary := range summaries {
+		line := export.InvocationSummaryDocument{
+			Header:            export.Header{APIVersion: export.Version, Kind: export.KindInvocationSummary},
+			InvocationSummary: summary,
+		}
+		if err = encoder.Encode(line); err != nil {
+			slog.ErrorContext(request.Context(), "failed to write response", "err", err)
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+}
+
+// The filter of the list of invocations set by the query parameters.
+func listFilter(query url.Values) (export.ListFilter, error) {
+	filter := export.ListFilter{
+		Branch:    query.Get("branch"),
+		Commit:    query.Get("commit"),
+		StepLabel: query.Get("stepLabel"),
+		User:      query.Get("user"),
+	}
+	if buildUUID := query.Get("build"); buildUUID != "" {
+		parsed, err := uuid.Parse(buildUUID)
+		if err != nil {
+			return filter, fmt.Errorf("invalid build UUID %s: %w", buildUUID, err)
+		}
+		filter.BuildUUID = &parsed
+	}
+	var err error
+	if filter.Since, err = timeParameter(query, "since"); err != nil {
+		return filter, err
+	}
+	if filter.Until, err = timeParameter(query, "until"); err != nil {
+		return filter, err
+	}
+	return filter, nil
+}
+
+// An RFC 3339 time query parameter, nil if not set.
+func timeParameter(query url.Values, name string) (*time.Time, error) {
+	value := query.Get(name)
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s time %s, expected RFC 3339: %w", name, value, err)
+	}
+	return &parsed, nil
+}
+
+// Write a JSON document.
+func writeJSON(writer http.ResponseWriter, request *http.Request, document any) {
+	writer.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(writer).Encode(document); err != nil {
+		slog.ErrorContext(request.Context(), "failed to write response", "err", err)
+	}
+}
@@ -0,0 +1,153 @@
+package api_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/enttest"
+	"github.com/buildbarn/bb-portal/internal/api"
+	"github.com/buildbarn/bb-portal/pkg/export"
+	"github.com/buildbarn/bb-portal/pkg/processing"
+	"github.com/buildbarn/bb-portal/pkg/summary"
+)
+
+func TestExportHandlers(t *testing.T) {
+	ctx := context.Background()
+	db := enttest.Open(t, "sqlite3", "file:export_handlers?mode=memory&_fk=1")
+	defer db.Close()
+
+	worker := processing.New(db, nil, nil, summary.DefaultTestHealthThresholds())
+	var invocationIDs []uuid.UUID
+	var testFail *ent.BazelInvocation
+	for _, name := range []string{
+		"nextjs_build.bep.ndjson",
+		"nextjs_build_fail.bep.ndjson",
+		"nextjs_error_progress.bep.ndjson",
+		"nextjs_test.bep.ndjson",
+		"nextjs_test_fail.bep.ndjson",
+	} {
+		invocation, err := worker.ProcessFile(ctx, filepath.Join("../../pkg/summary/testdata", name))
+		require.NoError(t, err)
+		invocationIDs = append(invocationIDs, invocation.InvocationID)
+		testFail = invocation
+	}
+	testFailBuild, err := testFail.QueryBuild().Only(ctx)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /api/v1/invocations", api.NewInvocationListHandler(db))
+	mux.Handle("GET /api/v1/invocations/{uuid}", api.NewInvocationExportHandler(db))
+	mux.Handle("GET /api/v1/builds/{uuid}", api.NewBuildExportHandler(db))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	get := func(path string) (*http.Response, []byte) {
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+path, nil)
+		require.NoError(t, err)
+		response, err := server.Client().Do(request)
+		require.NoError(t, err)
+		defer response.Body.Close()
+		body, err := io.ReadAll(response.Body)
+		require.NoError(t, err)
+		return response, body
+	}
+
+	t.Run("invocation", func(t *testing.T) {
+		response, body := get("/api/v1/invocations/" + invocationIDs[3].String())
+		require.Equal(t, http.StatusOK, response.StatusCode)
+		require.Equal(t, "application/json", response.Header.Get("Content-Type"))
+		var document export.Invocation
+		require.NoError(t, json.Unmarshal(body, &document))
+		require.Equal(t, export.Header{APIVersion: "v1", Kind: "Invocation"}, document.Header)
+		require.Equal(t, invocationIDs[3], document.InvocationID)
+		require.Equal(t, "test", document.Command)
+		require.Equal(t, &export.ExitCode{Code: 0, Name: "SUCCESS"}, document.ExitCode)
+		require.NotEmpty(t, document.Targets)
+		require.NotEmpty(t, document.Tests)
+		require.Equal(t, "PASSED", document.Tests[0].Status)
+		require.NotEmpty(t, document.Tests[0].Results)
+		require.Contains(t, document.Metrics, "ACTIONS_EXECUTED")
+
+		response, body = get("/api/v1/invocations/" + invocationIDs[4].String())
+		require.Equal(t, http.StatusOK, response.StatusCode)
+		require.NoError(t, json.Unmarshal(body, &document))
+		require.NotEmpty(t, document.Problems)
+		require.Equal(t, &testFailBuild.BuildUUID, document.BuildUUID)
+
+		response, _ = get("/api/v1/invocations/" + uuid.NewString())
+		require.Equal(t, http.StatusNotFound, response.StatusCode)
+		response, _ = get("/api/v1/invocations/not-a-uuid")
+		require.Equal(t, http.StatusBadRequest, response.StatusCode)
+	})
+
+	t.Run("build", func(t *testing.T) {
+		response, body := get("/api/v1/builds/" + testFailBuild.BuildUUID.String())
+		require.Equal(t, http.StatusOK, response.StatusCode)
+		var document export.Build
+		require.NoError(t, json.Unmarshal(body, &document))
+		require.Equal(t, export.Header{APIVersion: "v1", Kind: "Build"}, document.Header)
+		require.Equal(t, "https://example.com/build/1234", document.BuildURL)
+		require.Len(t, document.Invocations, 1)
+		require.Equal(t, invocationIDs[4], document.Invocations[0].InvocationID)
+
+		response, _ = get("/api/v1/builds/" + uuid.NewString())
+		require.Equal(t, http.StatusNotFound, response.StatusCode)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		list := func(path string) ([]export.InvocationSummaryDocument, string) {
+			response, body := get(path)
+			require.Equal(t, http.StatusOK, response.StatusCode, string(body))
+			require.Equal(t, "application/x-ndjson", response.Header.Get("Content-Type"))
+			var lines []export.InvocationSummaryDocument
+			scanner := bufio.NewScanner(bytes.NewReader(body))
+			for scanner.Scan() {
+				var line export.InvocationSummaryDocument
+				require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+				require.Equal(t, "InvocationSummary", line.Kind)
+				lines = append(lines, line)
+			}
+			return lines, response.Header.Get("X-Next-Cursor")
+		}
+
+		// The pages of two, the last saved first.
+		var listed []uuid.UUID
+		cursor := ""
+		for page := 0; ; page++ {
+			require.Less(t, page, 3)
+			lines, next := list("/api/v1/invocations?limit=2&cursor=" + url.QueryEscape(cursor))
+			for _, line := range lines {
+				listed = append(listed, line.InvocationID)
+			}
+			if next == "" {
+				break
+			}
+			cursor = next
+		}
+		require.Equal(t, []uuid.UUID{invocationIDs[4], invocationIDs[3], invocationIDs[2], invocationIDs[1], invocationIDs[0]}, listed)
+
+		lines, next := list("/api/v1/invocations?build=" + testFailBuild.BuildUUID.String())
+		require.Len(t, lines, 1)
+		require.Empty(t, next)
+		require.Equal(t, invocationIDs[4], lines[0].InvocationID)
+
+		lines, _ = list("/api/v1/invocations?since=2100-01-01T00:00:00Z")
+		require.Empty(t, lines)
+
+		response, _ := get("/api/v1/invocations?cursor=!!")
+		require.Equal(t, http.StatusBadRequest, response.StatusCode)
+		response, _ = get("/api/v1/invocations?since=yesterday")
+		require.Equal(t, http.StatusBadRequest, response.StatusCode)
+	})
+}
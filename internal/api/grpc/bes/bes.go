@@ -14,21 +14,26 @@ import (
 	"github.com/buildbarn/bb-portal/ent/gen/ent"
 	"github.com/buildbarn/bb-portal/pkg/events"
 	"github.com/buildbarn/bb-portal/pkg/processing"
+	"github.com/buildbarn/bb-portal/pkg/progress"
 	"github.com/buildbarn/bb-portal/pkg/summary"
 	"github.com/buildbarn/bb-portal/third_party/bazel/gen/bes"
 )
 
 // BES A type for the Build Event Service.
 type BES struct {
-	db           *ent.Client
-	blobArchiver processing.BlobMultiArchiver
+	db                   *ent.Client
+	blobArchivingPool    *processing.BlobArchivingPool
+	progressHub          *progress.Hub
+	testHealthThresholds summary.TestHealthThresholds
 }
 
 // New BES initializer function.
-func New(db *ent.Client, blobArchiver processing.BlobMultiArchiver) build.PublishBuildEventServer {
+func New(db *ent.Client, blobArchivingPool *processing.BlobArchivingPool, progressHub *progress.Hub, testHealthThresholds summary.TestHealthThresholds) build.PublishBuildEventServer {
 	return &BES{
-		db:           db,
-		blobArchiver: blobArchiver,
+		db:                   db,
+		blobArchivingPool:    blobArchivingPool,
+		progressHub:          progressHub,
+		testHealthThresholds: testHealthThresholds,
 	}
 }
 
@@ -42,7 +47,7 @@ func (b BES) PublishLifecycleEvent(ctx context.Context, request *build.PublishLi
 func (b BES) PublishBuildToolEventStream(stream build.PublishBuildEvent_PublishBuildToolEventStreamServer) error {
 	slog.InfoContext(stream.Context(), "Stream started", "event", stream.Context())
 
-	summarizer := summary.NewSummarizer()
+	summarizer := summary.NewSummarizer(b.testHealthThresholds)
 
 	ack := func(req *build.PublishBuildToolEventStreamRequest) {
 		if err := stream.Send(&build.PublishBuildToolEventStreamResponse{
@@ -53,6 +58,15 @@ func (b BES) PublishBuildToolEventStream(stream build.PublishBuildEvent_PublishB
 		}
 	}
 
+	// The subscribers to the invocation are told it finished, whether it could be saved or not.
+	var invocationID string
+	saved := false
+	defer func() {
+		if !saved {
+			b.progressHub.Publish(progress.IncompleteEvent(invocationID))
+		}
+	}()
+
 	var streamID *build.StreamId
 	for {
 		req, err := stream.Recv()
@@ -70,10 +84,18 @@ func (b BES) PublishBuildToolEventStream(stream build.PublishBuildEvent_PublishB
 			streamID = req.GetOrderedBuildEvent().GetStreamId()
 		}
 
-		err = processBazelEvent(stream.Context(), req.OrderedBuildEvent.Event, summarizer)
+		buildEvent, err := processBazelEvent(stream.Context(), req.OrderedBuildEvent.Event, summarizer)
 		if err != nil {
 			return err
 		}
+		if buildEvent != nil {
+			if started := buildEvent.GetStarted(); started != nil {
+				invocationID = started.GetUuid()
+			}
+			if event, ok := progress.FromBuildEvent(invocationID, buildEvent); ok {
+				b.progressHub.Publish(event)
+			}
+		}
 
 		ack(req)
 	}
@@ -89,32 +111,33 @@ func (b BES) PublishBuildToolEventStream(stream build.PublishBuildEvent_PublishB
 		"grpc://localhost:8082/google.devtools.build.v1/PublishLifecycleEvent?streamID=%s",
 		streamID.String(),
 	)
-	workflow := processing.New(b.db, b.blobArchiver)
+	workflow := processing.New(b.db, b.blobArchivingPool, b.progressHub, b.testHealthThresholds)
 	invocation, err := workflow.SaveSummary(stream.Context(), summaryReport)
 	if err != nil {
 		slog.ErrorContext(stream.Context(), "SaveSummary failed", "err", err)
 		return err
 	}
+	saved = true
 	slog.InfoContext(stream.Context(), "saved invocation", "id", invocation.InvocationID)
 	return nil
 }
 
-// Process a bazel Event.
-func processBazelEvent(ctx context.Context, event *build.BuildEvent, summarizer *summary.Summarizer) error {
+// Process a bazel Event, returning it unless the event is not one.
+func processBazelEvent(ctx context.Context, event *build.BuildEvent, summarizer *summary.Summarizer) (*events.BuildEvent, error) {
 	if event.GetBazelEvent() == nil {
-		return nil
+		return nil, nil
 	}
 
 	var bazelEvent bes.BuildEvent
 	err := event.GetBazelEvent().UnmarshalTo(&bazelEvent)
 	if err != nil {
 		slog.ErrorContext(ctx, "UnmarshalTo failed", "err", err)
-		return err
+		return nil, err
 	}
 	buildEvent := events.NewBuildEvent(&bazelEvent, json.RawMessage(protojson.Format(&bazelEvent)))
 	if err = summarizer.ProcessEvent(&buildEvent); err != nil {
 		slog.ErrorContext(ctx, "ProcessEvent failed", "err", err)
-		return fmt.Errorf("could not process event (%s): , %w", buildEvent, err)
+		return nil, fmt.Errorf("could not process event (%s): , %w", buildEvent, err)
 	}
-	return nil
+	return &buildEvent, nil
 }
@@ -7,15 +7,17 @@ import (
 	"github.com/buildbarn/bb-portal/ent/gen/ent"
 	"github.com/buildbarn/bb-portal/internal/api/grpc/bes"
 	"github.com/buildbarn/bb-portal/pkg/processing"
+	"github.com/buildbarn/bb-portal/pkg/progress"
+	"github.com/buildbarn/bb-portal/pkg/summary"
 )
 
 // Server A helper type for a grpc server.
 type Server = grpc.Server
 
 // NewServer Initializes a new server.
-func NewServer(db *ent.Client, blobArchiver processing.BlobMultiArchiver, opts ...grpc.ServerOption) *grpc.Server {
+func NewServer(db *ent.Client, blobArchivingPool *processing.BlobArchivingPool, progressHub *progress.Hub, testHealthThresholds summary.TestHealthThresholds, opts ...grpc.ServerOption) *grpc.Server {
 	grpcServer := grpc.NewServer(opts...)
 
-	build.RegisterPublishBuildEventServer(grpcServer, bes.New(db, blobArchiver))
+	build.RegisterPublishBuildEventServer(grpcServer, bes.New(db, blobArchivingPool, progressHub, testHealthThresholds))
 	return grpcServer
 }
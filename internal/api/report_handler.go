@@ -0,0 +1,112 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/pkg/export"
+)
+
+// A struct to serve the report of an invocation.
+type invocationReportHandler struct {
+	client    *ent.Client
+	portalURL string
+	format    export.ReportFormat
+}
+
+// A struct to serve the report of the invocations of a build.
+type buildReportHandler struct {
+	client    *ent.Client
+	portalURL string
+	format    export.ReportFormat
+}
+
+// NewInvocationReportHandler Constructor function for a handler serving the report of the invocation with the uuid
+// path value in a format, linking to the portal at portalURL, or at the URL of the request when empty.
+func NewInvocationReportHandler(client *ent.Client, portalURL string, format export.ReportFormat) http.Handler {
+	return &invocationReportHandler{client: client, portalURL: portalURL, format: format}
+}
+
+// NewBuildReportHandler Constructor function for a handler serving the report aggregating the invocations of the build
+// with the uuid path value in a format, linking to the portal at portalURL, or at the URL of the request when empty.
+func NewBuildReportHandler(client *ent.Client, portalURL string, format export.ReportFormat) http.Handler {
+	return &buildReportHandler{client: client, portalURL: portalURL, format: format}
+}
+
+// ServeHTTP Serve this over http.
+func (h *invocationReportHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	invocationID, err := uuid.Parse(request.PathValue("uuid"))
+	if err != nil {
+		writeErr(writer, request, http.StatusBadRequest, fmt.Sprintf("Invalid invocation ID: %s", request.PathValue("uuid")))
+		return
+	}
+	invocation, err := export.LoadInvocation(request.Context(), h.client, invocationID)
+	if ent.IsNotFound(err) {
+		writeErr(writer, request, http.StatusNotFound, fmt.Sprintf("Could not find invocation with ID: %s", invocationID))
+		return
+	}
+	if err != nil {
+		writeErr(writer, request, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeReport(writer, request, h.format, &export.Report{
+		Invocations: []*export.Invocation{invocation},
+		PortalURL:   reportPortalURL(request, h.portalURL),
+	})
+}
+
+// ServeHTTP Serve this over http.
+func (h *buildReportHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	buildUUID, err := uuid.Parse(request.PathValue("uuid"))
+	if err != nil {
+		writeErr(writer, request, http.StatusBadRequest, fmt.Sprintf("Invalid build UUID: %s", request.PathValue("uuid")))
+		return
+	}
+	build, invocations, err := export.LoadBuildInvocations(request.Context(), h.client, buildUUID)
+	if ent.IsNotFound(err) {
+		writeErr(writer, request, http.StatusNotFound, fmt.Sprintf("Could not find build with UUID: %s", buildUUID))
+		return
+	}
+	if err != nil {
+		writeErr(writer, request, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeReport(writer, request, h.format, &export.Report{
+		Build:       build,
+		Invocations: invocations,
+		PortalURL:   reportPortalURL(request, h.portalURL),
+	})
+}
+
+// The URL of the portal the reports link to, the scheme and host of the request unless configured.
+func reportPortalURL(request *http.Request, portalURL string) string {
+	if portalURL != "" {
+		return portalURL
+	}
+	scheme := "http"
+	if request.TLS != nil {
+		scheme = "https"
+	}
+	if forwarded := request.Header.Get("X-Forwarded-Proto"); forwarded == "http" || forwarded == "https" {
+		scheme = forwarded
+	}
+	return fmt.Sprintf("%s://%s", scheme, request.Host)
+}
+
+// Write a report, rendered before the headers so that a failure is still answered with an error status.
+func writeReport(writer http.ResponseWriter, request *http.Request, format export.ReportFormat, report *export.Report) {
+	var rendered bytes.Buffer
+	if err := report.Write(&rendered, format); err != nil {
+		writeErr(writer, request, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writer.Header().Set("Content-Type", format.ContentType())
+	if _, err := rendered.WriteTo(writer); err != nil {
+		slog.ErrorContext(request.Context(), "failed to write response", "err", err)
+	}
+}
@@ -0,0 +1,130 @@
+package api_test
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent/enttest"
+	"github.com/buildbarn/bb-portal/internal/api"
+	"github.com/buildbarn/bb-portal/pkg/export"
+	"github.com/buildbarn/bb-portal/pkg/processing"
+	"github.com/buildbarn/bb-portal/pkg/summary"
+)
+
+// The parts of a JUnit XML report checked by the tests.
+type junitReport struct {
+	Tests    int `xml:"tests,attr"`
+	Failures int `xml:"failures,attr"`
+	Suites   []struct {
+		ID         string `xml:"id,attr"`
+		Failures   int    `xml:"failures,attr"`
+		Properties []struct {
+			Name  string `xml:"name,attr"`
+			Value string `xml:"value,attr"`
+		} `xml:"properties>property"`
+		Cases []struct {
+			Name      string `xml:"name,attr"`
+			Classname string `xml:"classname,attr"`
+			Failure   *struct {
+				Type string `xml:"type,attr"`
+			} `xml:"failure"`
+		} `xml:"testcase"`
+	} `xml:"testsuite"`
+}
+
+func TestReportHandlers(t *testing.T) {
+	ctx := context.Background()
+	db := enttest.Open(t, "sqlite3", "file:report_handlers?mode=memory&_fk=1")
+	defer db.Close()
+
+	worker := processing.New(db, nil, nil, summary.DefaultTestHealthThresholds())
+	testPass, err := worker.ProcessFile(ctx, filepath.Join("../../pkg/summary/testdata", "nextjs_test.bep.ndjson"))
+	require.NoError(t, err)
+	testFail, err := worker.ProcessFile(ctx, filepath.Join("../../pkg/summary/testdata", "nextjs_test_fail.bep.ndjson"))
+	require.NoError(t, err)
+	testFailBuild, err := testFail.QueryBuild().Only(ctx)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /invocations/{uuid}/junit.xml", api.NewInvocationReportHandler(db, "", export.ReportJUnit))
+	mux.Handle("GET /invocations/{uuid}/report.md", api.NewInvocationReportHandler(db, "", export.ReportMarkdown))
+	mux.Handle("GET /builds/{uuid}/junit.xml", api.NewBuildReportHandler(db, "", export.ReportJUnit))
+	mux.Handle("GET /builds/{uuid}/report.md", api.NewBuildReportHandler(db, "https://portal.example.com/", export.ReportMarkdown))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	get := func(path string) (*http.Response, string) {
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+path, nil)
+		require.NoError(t, err)
+		response, err := server.Client().Do(request)
+		require.NoError(t, err)
+		defer response.Body.Close()
+		body, err := io.ReadAll(response.Body)
+		require.NoError(t, err)
+		return response, string(body)
+	}
+
+	t.Run("invocation", func(t *testing.T) {
+		response, body := get("/invocations/" + testFail.InvocationID.String() + "/junit.xml")
+		require.Equal(t, http.StatusOK, response.StatusCode, body)
+		require.Equal(t, "application/xml", response.Header.Get("Content-Type"))
+		var report junitReport
+		require.NoError(t, xml.Unmarshal([]byte(body), &report))
+		require.Equal(t, 3, report.Tests)
+		require.Equal(t, 1, report.Failures)
+		require.Len(t, report.Suites, 1)
+		suite := report.Suites[0]
+		require.Equal(t, testFail.InvocationID.String(), suite.ID)
+		require.Equal(t, "url", suite.Properties[0].Name)
+		require.Equal(t, server.URL+"/bazel-invocations/"+testFail.InvocationID.String(), suite.Properties[0].Value)
+		require.Equal(t, "jest_test", suite.Cases[0].Name)
+		require.Equal(t, "//next.js/pages", suite.Cases[0].Classname)
+		require.NotNil(t, suite.Cases[0].Failure)
+		require.Equal(t, "FAILED", suite.Cases[0].Failure.Type)
+		require.Nil(t, suite.Cases[1].Failure)
+
+		response, body = get("/invocations/" + testFail.InvocationID.String() + "/report.md")
+		require.Equal(t, http.StatusOK, response.StatusCode, body)
+		require.Equal(t, "text/markdown; charset=utf-8", response.Header.Get("Content-Type"))
+		require.Contains(t, body, "## Bazel test `nextjs_test` TESTS_FAILED\n")
+		require.Contains(t, body, "("+server.URL+"/bazel-invocations/"+testFail.InvocationID.String()+")")
+		require.Contains(t, body, "**Failing tests**\n\n- `//next.js/pages:jest_test` FAILED\n")
+		require.Contains(t, body, "**Problems**\n\n- `//next.js/pages:jest_test` TEST_PROBLEM\n")
+
+		response, body = get("/invocations/" + testPass.InvocationID.String() + "/report.md")
+		require.Equal(t, http.StatusOK, response.StatusCode, body)
+		require.Contains(t, body, "SUCCESS")
+		require.NotContains(t, body, "**Failing tests**")
+
+		response, _ = get("/invocations/" + uuid.NewString() + "/junit.xml")
+		require.Equal(t, http.StatusNotFound, response.StatusCode)
+		response, _ = get("/invocations/not-a-uuid/report.md")
+		require.Equal(t, http.StatusBadRequest, response.StatusCode)
+	})
+
+	t.Run("build", func(t *testing.T) {
+		response, body := get("/builds/" + testFailBuild.BuildUUID.String() + "/junit.xml")
+		require.Equal(t, http.StatusOK, response.StatusCode, body)
+		var report junitReport
+		require.NoError(t, xml.Unmarshal([]byte(body), &report))
+		require.Len(t, report.Suites, 1)
+		require.Equal(t, testFail.InvocationID.String(), report.Suites[0].ID)
+		require.Equal(t, 1, report.Failures)
+
+		response, body = get("/builds/" + testFailBuild.BuildUUID.String() + "/report.md")
+		require.Equal(t, http.StatusOK, response.StatusCode, body)
+		require.Contains(t, body, "## Build [https://example.com/build/1234](https://portal.example.com/builds/"+testFailBuild.BuildUUID.String()+")\n\n1 invocations, 1 failed.\n")
+		require.Contains(t, body, "### Bazel test `nextjs_test` TESTS_FAILED\n")
+		require.Contains(t, body, "(https://portal.example.com/bazel-invocations/"+testFail.InvocationID.String()+")")
+
+		response, _ = get("/builds/" + uuid.NewString() + "/report.md")
+		require.Equal(t, http.StatusNotFound, response.StatusCode)
+	})
+}
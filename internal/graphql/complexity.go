@@ -0,0 +1,91 @@
+package graphql
+
+import (
+	"math"
+	"time"
+
+	"entgo.io/contrib/entgql"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/internal/graphql/model"
+	"github.com/buildbarn/bb-portal/pkg/search"
+)
+
+// Estimates of the sizes of the lists, by which the complexity of their fields is multiplied.
+const (
+	// The lists of edges, which are not paginated.
+	edgeListSize = 10
+	// The connections queried without first or last, as many as ent returns then.
+	defaultPageSize = 100
+)
+
+// The complexity of the fields loading lists from the database, the cost of the fields of every object times how
+// many objects there may be. Other fields cost one plus the cost of their fields.
+func complexity() ComplexityRoot {
+	var c ComplexityRoot
+
+	c.BazelInvocation.Problems = edgeList
+	c.BazelInvocation.Targets = edgeList
+	c.BazelInvocation.TestCollection = edgeList
+	c.BazelInvocation.TestHealthReports = edgeList
+	c.BazelInvocationProblem.Blobs = edgeList
+	c.Build.Invocations = edgeList
+	c.KnownProblem.Invocations = edgeList
+	c.KnownProblem.Problems = edgeList
+	c.TestCollection.TestResults = edgeList
+
+	c.Query.FindBazelInvocations = func(childComplexity int, _ *entgql.Cursor[int], first *int, _ *entgql.Cursor[int], last *int, _ *ent.BazelInvocationWhereInput) int {
+		return connection(childComplexity, first, last)
+	}
+	c.Query.FindBuilds = func(childComplexity int, _ *entgql.Cursor[int], first *int, _ *entgql.Cursor[int], last *int, _ *ent.BuildWhereInput) int {
+		return connection(childComplexity, first, last)
+	}
+	c.Query.FindKnownProblems = func(childComplexity int, _ *entgql.Cursor[int], first *int, _ *entgql.Cursor[int], last *int, _ *ent.KnownProblemWhereInput) int {
+		return connection(childComplexity, first, last)
+	}
+	c.Query.FindMetrics = func(childComplexity int, _ *entgql.Cursor[int], first *int, _ *entgql.Cursor[int], last *int, _ *ent.MetricsWhereInput) int {
+		return connection(childComplexity, first, last)
+	}
+	c.Query.FindRunnerCounts = func(childComplexity int, _ *entgql.Cursor[int], first *int, _ *entgql.Cursor[int], last *int, _ *ent.RunnerCountWhereInput) int {
+		return connection(childComplexity, first, last)
+	}
+	c.Query.FindTestHealthReports = func(childComplexity int, _ *entgql.Cursor[int], first *int, _ *entgql.Cursor[int], last *int, _ *ent.TestHealthReportWhereInput) int {
+		return connection(childComplexity, first, last)
+	}
+	c.Query.Nodes = func(childComplexity int, ids []string) int {
+		return list(childComplexity, len(ids))
+	}
+	c.Query.SearchInvocations = func(childComplexity int, _ string, _ *time.Time, _ *time.Time, _ []model.SearchSource, first *int) int {
+		size := search.DefaultLimit
+		if first != nil {
+			size = min(*first, search.MaxLimit)
+		}
+		return list(childComplexity, size)
+	}
+	return c
+}
+
+// The complexity of an edge to a list of objects.
+func edgeList(childComplexity int) int {
+	return list(childComplexity, edgeListSize)
+}
+
+// The complexity of a page of a connection.
+func connection(childComplexity int, first *int, last *int) int {
+	size := defaultPageSize
+	if first != nil {
+		size = *first
+	} else if last != nil {
+		size = *last
+	}
+	return list(childComplexity, size)
+}
+
+// The complexity of a list of a given size, not overflowing for huge sizes.
+func list(childComplexity int, size int) int {
+	size = max(size, 1)
+	if childComplexity > (math.MaxInt-1)/size {
+		return math.MaxInt
+	}
+	return 1 + size*childComplexity
+}
@@ -8,9 +8,9 @@ import (
 	"cmp"
 	"context"
 	"fmt"
-	"net/url"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/buildbarn/bb-portal/ent/gen/ent"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
@@ -22,6 +22,16 @@ import (
 	"github.com/google/uuid"
 )
 
+// KnownProblem is the resolver for the knownProblem field.
+func (r *actionProblemResolver) KnownProblem(ctx context.Context, obj *model.ActionProblem) (*ent.KnownProblem, error) {
+	return helpers.KnownProblemForFingerprint(ctx, r.client, obj.Fingerprint)
+}
+
+// FirstFailure is the resolver for the firstFailure field.
+func (r *actionProblemResolver) FirstFailure(ctx context.Context, obj *model.ActionProblem) (*model.FirstFailure, error) {
+	return helpers.FirstFailureForProblem(ctx, r.client, obj.ID)
+}
+
 // Stdout is the resolver for the stdout field.
 func (r *actionProblemResolver) Stdout(ctx context.Context, obj *model.ActionProblem) (*model.BlobReference, error) {
 	return helpers.BlobReferenceForFile(ctx, r.client, func(ctx context.Context) (*bes.File, error) {
@@ -96,7 +106,7 @@ func (r *bazelInvocationResolver) RelatedFiles(ctx context.Context, obj *ent.Baz
 
 // Problems is the resolver for the problems field.
 func (r *bazelInvocationResolver) Problems(ctx context.Context, obj *ent.BazelInvocation) ([]model.Problem, error) {
-	problems, err := obj.QueryProblems().All(ctx)
+	problems, err := helpers.InvocationProblems(ctx, r.client, obj)
 	if err != nil {
 		return nil, fmt.Errorf("could not fetch problems: %w", err)
 	}
@@ -109,7 +119,7 @@ func (r *blobReferenceResolver) DownloadURL(ctx context.Context, obj *model.Blob
 	if obj.Blob == nil {
 		panic("Got a name but not blob")
 	}
-	return fmt.Sprintf("/api/v1/blobs/%d/%s", obj.Blob.ID, url.PathEscape(obj.Name)), nil
+	return helpers.BlobDownloadURL(obj.Blob, obj.Name), nil
 }
 
 // SizeInBytes is the resolver for the sizeInBytes field.
@@ -140,6 +150,11 @@ func (r *blobReferenceResolver) AvailabilityStatus(ctx context.Context, obj *mod
 	}
 }
 
+// ZipEntries is the resolver for the zipEntries field.
+func (r *blobReferenceResolver) ZipEntries(ctx context.Context, obj *model.BlobReference) ([]*model.ZipEntry, error) {
+	return helpers.ZipEntries(ctx, r.blobOpener, obj)
+}
+
 // Env is the resolver for the env field.
 func (r *buildResolver) Env(ctx context.Context, obj *ent.Build) ([]*model.EnvVar, error) {
 	envVars := make([]*model.EnvVar, 0, len(obj.Env))
@@ -156,6 +171,106 @@ func (r *buildResolver) Env(ctx context.Context, obj *ent.Build) ([]*model.EnvVa
 	return envVars, nil
 }
 
+// Problems is the resolver for the problems field.
+func (r *knownProblemResolver) Problems(ctx context.Context, obj *ent.KnownProblem) ([]model.Problem, error) {
+	problems, err := obj.QueryProblems().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch problems: %w", err)
+	}
+
+	return r.helper.DBProblemsToAPIProblems(ctx, problems)
+}
+
+// Invocations is the resolver for the invocations field.
+func (r *knownProblemResolver) Invocations(ctx context.Context, obj *ent.KnownProblem) ([]*ent.BazelInvocation, error) {
+	return obj.QueryProblems().
+		QueryBazelInvocation().
+		Order(ent.Desc(bazelinvocation.FieldStartedAt)).
+		All(ctx)
+}
+
+// DeleteInvocation is the resolver for the deleteInvocation field.
+func (r *mutationResolver) DeleteInvocation(ctx context.Context, id string) (string, error) {
+	if r.garbageCollector == nil {
+		return "", errUnavailable
+	}
+	invocationID, err := helpers.IntIDFromGraphQLID(id, "BazelInvocation")
+	if err != nil {
+		return "", err
+	}
+	if _, err = r.client.BazelInvocation.Get(ctx, invocationID); err != nil {
+		return "", fmt.Errorf("could not find invocation: %w", err)
+	}
+	if _, err = r.garbageCollector.DeleteInvocations(ctx, []int{invocationID}); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// DeleteBuild is the resolver for the deleteBuild field.
+func (r *mutationResolver) DeleteBuild(ctx context.Context, id string) (string, error) {
+	if r.garbageCollector == nil {
+		return "", errUnavailable
+	}
+	buildID, err := helpers.IntIDFromGraphQLID(id, "Build")
+	if err != nil {
+		return "", err
+	}
+	if _, err = r.client.Build.Get(ctx, buildID); err != nil {
+		return "", fmt.Errorf("could not find build: %w", err)
+	}
+	if _, err = r.garbageCollector.DeleteBuild(ctx, buildID); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// ResummarizeInvocation is the resolver for the resummarizeInvocation field.
+func (r *mutationResolver) ResummarizeInvocation(ctx context.Context, id string) (*ent.BazelInvocation, error) {
+	if r.workflow == nil {
+		return nil, errUnavailable
+	}
+	invocationID, err := helpers.IntIDFromGraphQLID(id, "BazelInvocation")
+	if err != nil {
+		return nil, err
+	}
+	return r.workflow.Resummarize(ctx, invocationID)
+}
+
+// RetryFailedBlobArchiving is the resolver for the retryFailedBlobArchiving field.
+func (r *mutationResolver) RetryFailedBlobArchiving(ctx context.Context, invocationID *string) (int, error) {
+	if r.blobArchivingPool == nil {
+		return 0, errUnavailable
+	}
+	if invocationID == nil {
+		return r.blobArchivingPool.RetryFailed(ctx, nil)
+	}
+	intID, err := helpers.IntIDFromGraphQLID(*invocationID, "BazelInvocation")
+	if err != nil {
+		return 0, err
+	}
+	return r.blobArchivingPool.RetryFailed(ctx, &intID)
+}
+
+// SetInvocationPinned is the resolver for the setInvocationPinned field.
+func (r *mutationResolver) SetInvocationPinned(ctx context.Context, id string, pinned bool) (*ent.BazelInvocation, error) {
+	invocationID, err := helpers.IntIDFromGraphQLID(id, "BazelInvocation")
+	if err != nil {
+		return nil, err
+	}
+	return r.client.BazelInvocation.UpdateOneID(invocationID).SetPinned(pinned).Save(ctx)
+}
+
+// KnownProblem is the resolver for the knownProblem field.
+func (r *progressProblemResolver) KnownProblem(ctx context.Context, obj *model.ProgressProblem) (*ent.KnownProblem, error) {
+	return helpers.KnownProblemForFingerprint(ctx, r.client, obj.Fingerprint)
+}
+
+// FirstFailure is the resolver for the firstFailure field.
+func (r *progressProblemResolver) FirstFailure(ctx context.Context, obj *model.ProgressProblem) (*model.FirstFailure, error) {
+	return helpers.FirstFailureForProblem(ctx, r.client, obj.ID)
+}
+
 // BazelInvocation is the resolver for the bazelInvocation field.
 func (r *queryResolver) BazelInvocation(ctx context.Context, invocationID string) (*ent.BazelInvocation, error) {
 	invocationUUID, err := uuid.Parse(invocationID)
@@ -180,6 +295,64 @@ func (r *queryResolver) GetBuild(ctx context.Context, buildURL *string, buildUUI
 	return r.client.Build.Query().Where(build.BuildUUID(*buildUUID)).First(ctx)
 }
 
+// DiffInvocations is the resolver for the diffInvocations field.
+func (r *queryResolver) DiffInvocations(ctx context.Context, fromInvocationID string, toInvocationID string) (*model.InvocationDiff, error) {
+	from, err := r.BazelInvocation(ctx, fromInvocationID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := r.BazelInvocation(ctx, toInvocationID)
+	if err != nil {
+		return nil, err
+	}
+	return r.helper.DiffInvocations(ctx, from, to)
+}
+
+// TestHealthTrends is the resolver for the testHealthTrends field.
+func (r *queryResolver) TestHealthTrends(ctx context.Context, label *string, since *time.Time) ([]*model.TestHealthTrend, error) {
+	return helpers.TestHealthTrends(ctx, r.client, label, since)
+}
+
+// MetricsTrends is the resolver for the metricsTrends field.
+func (r *queryResolver) MetricsTrends(ctx context.Context, metrics []model.MetricsTrendMetric, from time.Time, to *time.Time, bucket model.TrendBucket, filter *model.MetricsTrendFilter) ([]*model.MetricsTrend, error) {
+	return helpers.MetricsTrends(ctx, r.client, metrics, from, to, bucket, filter)
+}
+
+// SearchInvocations is the resolver for the searchInvocations field.
+func (r *queryResolver) SearchInvocations(ctx context.Context, query string, from *time.Time, to *time.Time, sources []model.SearchSource, first *int) ([]*model.InvocationSearchResult, error) {
+	return helpers.SearchInvocations(ctx, r.client, query, from, to, sources, first)
+}
+
+// InvocationProgress is the resolver for the invocationProgress field.
+func (r *subscriptionResolver) InvocationProgress(ctx context.Context, invocationID string) (<-chan *model.InvocationProgressEvent, error) {
+	return helpers.InvocationProgress(ctx, r.client, r.progressHub, invocationID), nil
+}
+
+// BuildInvocationCreated is the resolver for the buildInvocationCreated field.
+func (r *subscriptionResolver) BuildInvocationCreated(ctx context.Context, buildUUID uuid.UUID) (<-chan *ent.BazelInvocation, error) {
+	return helpers.BuildInvocationCreated(ctx, r.client, r.progressHub, buildUUID), nil
+}
+
+// KnownProblem is the resolver for the knownProblem field.
+func (r *targetProblemResolver) KnownProblem(ctx context.Context, obj *model.TargetProblem) (*ent.KnownProblem, error) {
+	return helpers.KnownProblemForFingerprint(ctx, r.client, obj.Fingerprint)
+}
+
+// FirstFailure is the resolver for the firstFailure field.
+func (r *targetProblemResolver) FirstFailure(ctx context.Context, obj *model.TargetProblem) (*model.FirstFailure, error) {
+	return helpers.FirstFailureForProblem(ctx, r.client, obj.ID)
+}
+
+// KnownProblem is the resolver for the knownProblem field.
+func (r *testProblemResolver) KnownProblem(ctx context.Context, obj *model.TestProblem) (*ent.KnownProblem, error) {
+	return helpers.KnownProblemForFingerprint(ctx, r.client, obj.Fingerprint)
+}
+
+// FirstFailure is the resolver for the firstFailure field.
+func (r *testProblemResolver) FirstFailure(ctx context.Context, obj *model.TestProblem) (*model.FirstFailure, error) {
+	return helpers.FirstFailureForProblem(ctx, r.client, obj.ID)
+}
+
 // ActionLogOutput is the resolver for the actionLogOutput field.
 func (r *testResultResolver) ActionLogOutput(ctx context.Context, obj *model.TestResult) (*model.BlobReference, error) {
 	return helpers.GetTestResultActionLogOutput(ctx, r.client, obj)
@@ -196,6 +369,21 @@ func (r *Resolver) ActionProblem() ActionProblemResolver { return &actionProblem
 // BlobReference returns BlobReferenceResolver implementation.
 func (r *Resolver) BlobReference() BlobReferenceResolver { return &blobReferenceResolver{r} }
 
+// Mutation returns MutationResolver implementation.
+func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
+
+// ProgressProblem returns ProgressProblemResolver implementation.
+func (r *Resolver) ProgressProblem() ProgressProblemResolver { return &progressProblemResolver{r} }
+
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
+// TargetProblem returns TargetProblemResolver implementation.
+func (r *Resolver) TargetProblem() TargetProblemResolver { return &targetProblemResolver{r} }
+
+// TestProblem returns TestProblemResolver implementation.
+func (r *Resolver) TestProblem() TestProblemResolver { return &testProblemResolver{r} }
+
 // TestResult returns TestResultResolver implementation.
 func (r *Resolver) TestResult() TestResultResolver { return &testResultResolver{r} }
 
@@ -205,5 +393,20 @@ type actionProblemResolver struct{ *Resolver }
 // The blob reference resolver type
 type blobReferenceResolver struct{ *Resolver }
 
+// The mutation resolver type
+type mutationResolver struct{ *Resolver }
+
+// The progress problem resolver type
+type progressProblemResolver struct{ *Resolver }
+
+// The subscription resolver type
+type subscriptionResolver struct{ *Resolver }
+
+// The target problem resolver type
+type targetProblemResolver struct{ *Resolver }
+
+// The test problem resolver type
+type testProblemResolver struct{ *Resolver }
+
 // the test result resolver type
 type testResultResolver struct{ *Resolver }
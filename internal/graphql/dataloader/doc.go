@@ -0,0 +1,4 @@
+// Package dataloader batches the lookups of the GraphQL resolvers resolving the same field for many objects, like
+// the problems of every invocation in a list, into a single query, and caches their results for the rest of the
+// request.
+package dataloader
@@ -0,0 +1,66 @@
+package dataloader_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildbarn/bb-portal/internal/graphql/dataloader"
+)
+
+var errFetch = errors.New("fetch failed")
+
+func TestLoader_BatchesConcurrentLoads(t *testing.T) {
+	ctx := context.Background()
+	var mu sync.Mutex
+	var batches [][]int
+	loader := dataloader.NewLoader(func(_ context.Context, keys []int) (map[int]string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		batches = append(batches, slices.Clone(keys))
+		values := map[int]string{}
+		for _, key := range keys {
+			if key%2 == 0 {
+				values[key] = "even"
+			}
+		}
+		return values, nil
+	})
+
+	var wg sync.WaitGroup
+	values := make([]string, 10)
+	for i := range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := loader.Load(ctx, i%5)
+			require.NoError(t, err)
+			values[i] = value
+		}()
+	}
+	wg.Wait()
+
+	require.Len(t, batches, 1)
+	slices.Sort(batches[0])
+	require.Equal(t, []int{0, 1, 2, 3, 4}, batches[0])
+	require.Equal(t, "even", values[2])
+	require.Empty(t, values[3])
+
+	// Cached for the lifetime of the loader.
+	value, err := loader.Load(ctx, 4)
+	require.NoError(t, err)
+	require.Equal(t, "even", value)
+	require.Len(t, batches, 1)
+}
+
+func TestLoader_Error(t *testing.T) {
+	loader := dataloader.NewLoader(func(_ context.Context, _ []string) (map[string]int, error) {
+		return nil, errFetch
+	})
+	_, err := loader.Load(context.Background(), "key")
+	require.ErrorIs(t, err, errFetch)
+}
@@ -0,0 +1,144 @@
+package dataloader
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocationproblem"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/build"
+)
+
+// The context key of the loaders of a request.
+type loadersKey struct{}
+
+// Loaders The loaders of a request, keyed by the row ID of the objects the edges are loaded from.
+type Loaders struct {
+	ProblemsByInvocation *Loader[int, []*ent.BazelInvocationProblem]
+	MetricsByInvocation  *Loader[int, *ent.Metrics]
+	BlobsByProblem       *Loader[int, []*ent.Blob]
+	BlobsByURI           *Loader[string, *ent.Blob]
+	InvocationsByBuild   *Loader[int, []*ent.BazelInvocation]
+}
+
+// NewLoaders Constructor for the loaders of a request.
+func NewLoaders(client *ent.Client) *Loaders {
+	return &Loaders{
+		ProblemsByInvocation: NewLoader(func(ctx context.Context, ids []int) (map[int][]*ent.BazelInvocationProblem, error) {
+			invocations, err := client.BazelInvocation.Query().
+				Where(bazelinvocation.IDIn(ids...)).
+				Select(bazelinvocation.FieldID).
+				WithProblems().
+				All(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("could not load problems: %w", err)
+			}
+			problems := make(map[int][]*ent.BazelInvocationProblem, len(invocations))
+			for _, invocation := range invocations {
+				problems[invocation.ID] = invocation.Edges.Problems
+			}
+			return problems, nil
+		}),
+		MetricsByInvocation: NewLoader(func(ctx context.Context, ids []int) (map[int]*ent.Metrics, error) {
+			invocations, err := client.BazelInvocation.Query().
+				Where(bazelinvocation.IDIn(ids...)).
+				Select(bazelinvocation.FieldID).
+				WithMetrics().
+				All(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("could not load metrics: %w", err)
+			}
+			metrics := make(map[int]*ent.Metrics, len(invocations))
+			for _, invocation := range invocations {
+				if invocation.Edges.Metrics != nil {
+					metrics[invocation.ID] = invocation.Edges.Metrics
+				}
+			}
+			return metrics, nil
+		}),
+		BlobsByProblem: NewLoader(func(ctx context.Context, ids []int) (map[int][]*ent.Blob, error) {
+			problems, err := client.BazelInvocationProblem.Query().
+				Where(bazelinvocationproblem.IDIn(ids...)).
+				Select(bazelinvocationproblem.FieldID).
+				WithBlobs().
+				All(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("could not load blobs: %w", err)
+			}
+			blobs := make(map[int][]*ent.Blob, len(problems))
+			for _, problem := range problems {
+				blobs[problem.ID] = problem.Edges.Blobs
+			}
+			return blobs, nil
+		}),
+		BlobsByURI: NewLoader(func(ctx context.Context, uris []string) (map[string]*ent.Blob, error) {
+			blobRecords, err := client.Blob.Query().
+				Where(blob.URIIn(uris...)).
+				Order(ent.Asc(blob.FieldID)).
+				All(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("could not load blobs: %w", err)
+			}
+			blobs := make(map[string]*ent.Blob, len(blobRecords))
+			for _, blobRecord := range blobRecords {
+				// The first blob saved for a URI, as when looking up a single one.
+				if _, ok := blobs[blobRecord.URI]; !ok {
+					blobs[blobRecord.URI] = blobRecord
+				}
+			}
+			return blobs, nil
+		}),
+		InvocationsByBuild: NewLoader(func(ctx context.Context, ids []int) (map[int][]*ent.BazelInvocation, error) {
+			builds, err := client.Build.Query().
+				Where(build.IDIn(ids...)).
+				Select(build.FieldID).
+				WithInvocations().
+				All(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("could not load invocations: %w", err)
+			}
+			invocations := make(map[int][]*ent.BazelInvocation, len(builds))
+			for _, b := range builds {
+				invocations[b.ID] = b.Edges.Invocations
+			}
+			return invocations, nil
+		}),
+	}
+}
+
+// For The loaders of the request, or new ones, which batch nothing, when the context has none.
+func For(ctx context.Context, client *ent.Client) *Loaders {
+	if loaders, ok := ctx.Value(loadersKey{}).(*Loaders); ok {
+		return loaders
+	}
+	return NewLoaders(client)
+}
+
+// Schema Wraps an executable schema to give every query and mutation loaders of their own. Subscriptions get none,
+// as they resolve their events over time and must not see the values cached for earlier ones.
+func Schema(schema graphql.ExecutableSchema, client *ent.Client) graphql.ExecutableSchema {
+	return loadingSchema{ExecutableSchema: schema, client: client}
+}
+
+// An executable schema adding loaders to the context of the operations.
+type loadingSchema struct {
+	graphql.ExecutableSchema
+	client *ent.Client
+}
+
+// Exec Executes an operation with loaders of its own.
+func (s loadingSchema) Exec(ctx context.Context) graphql.ResponseHandler {
+	next := s.ExecutableSchema.Exec(ctx)
+	if oc := graphql.GetOperationContext(ctx); oc.Operation == nil || oc.Operation.Operation == ast.Subscription {
+		return next
+	}
+	loaders := NewLoaders(s.client)
+	return func(ctx context.Context) *graphql.Response {
+		return next(context.WithValue(ctx, loadersKey{}, loaders))
+	}
+}
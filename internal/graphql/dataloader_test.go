@@ -0,0 +1,76 @@
+package graphql_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	gql "github.com/machinebox/graphql"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/enttest"
+	"github.com/buildbarn/bb-portal/internal/graphql"
+	"github.com/buildbarn/bb-portal/internal/graphql/helpers"
+	"github.com/buildbarn/bb-portal/pkg/processing"
+	"github.com/buildbarn/bb-portal/pkg/summary"
+)
+
+func TestGraphQLAPI_DataloadersBatchQueries(t *testing.T) {
+	ctx := context.Background()
+	var queries atomic.Int64
+	// A database file rather than in memory, as the batches are fetched concurrently.
+	client := enttest.Open(t, "sqlite3", "file:"+filepath.Join(t.TempDir(), "dataloader.db")+"?_fk=1",
+		enttest.WithOptions(ent.Debug(), ent.Log(func(...any) { queries.Add(1) })))
+	defer client.Close()
+
+	worker := processing.New(client, nil, nil, summary.DefaultTestHealthThresholds())
+	var ids []string
+	for _, name := range []string{
+		"nextjs_build.bep.ndjson",
+		"nextjs_build_fail.bep.ndjson",
+		"nextjs_error_progress.bep.ndjson",
+		"nextjs_test.bep.ndjson",
+		"nextjs_test_fail.bep.ndjson",
+	} {
+		invocation, err := worker.ProcessFile(ctx, filepath.Join("../../pkg/summary/testdata", name))
+		require.NoError(t, err)
+		ids = append(ids, helpers.GraphQLIDFromTypeAndID("BazelInvocation", invocation.ID))
+	}
+
+	server := httptest.NewServer(handler.NewDefaultServer(graphql.NewSchema(client, graphql.SchemaParams{})))
+	defer server.Close()
+	gqlClient := gql.NewClient(server.URL)
+	run := func(ids []string) int64 {
+		req := gql.NewRequest(`query ($ids: [ID!]!) {
+			nodes(ids: $ids) {
+				... on BazelInvocation {
+					metrics { id }
+					problems {
+						__typename
+						... on ActionProblem { stdout { sizeInBytes } stderr { sizeInBytes } }
+						... on TestProblem { results { actionLogOutput { sizeInBytes } } }
+					}
+				}
+			}
+		}`)
+		req.Var("ids", ids)
+		queries.Store(0)
+		require.NoError(t, gqlClient.Run(ctx, req, &struct{}{}))
+		return queries.Load()
+	}
+
+	// Without batching, every invocation costs as many queries as when requested alone.
+	var unbatched int64
+	for _, id := range ids {
+		unbatched += run([]string{id})
+	}
+	batched := run(ids)
+	t.Logf("%d queries for the invocations requested together, %d when requested one by one", batched, unbatched)
+	// Two queries per invocation for the nodes and their eager loaded metrics, and about one per edge for all of them.
+	require.LessOrEqual(t, batched, int64(2*len(ids))+4)
+	require.Less(t, batched, unbatched)
+}
@@ -0,0 +1,48 @@
+package graphql_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	gql "github.com/machinebox/graphql"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent/enttest"
+	"github.com/buildbarn/bb-portal/internal/graphql"
+	"github.com/buildbarn/bb-portal/pkg/processing"
+	"github.com/buildbarn/bb-portal/pkg/summary"
+	"github.com/buildbarn/bb-portal/pkg/testkit"
+)
+
+// The snapshot database has no metrics, so the invocations are diffed as processed from their files.
+func TestGraphQLAPI_DiffInvocationsMetrics(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:diff_invocations?mode=memory&_fk=1")
+	defer client.Close()
+
+	worker := processing.New(client, nil, nil, summary.DefaultTestHealthThresholds())
+	for _, name := range []string{"nextjs_build.bep.ndjson", "nextjs_build_fail.bep.ndjson"} {
+		_, err := worker.ProcessFile(ctx, filepath.Join("../../pkg/summary/testdata", name))
+		require.NoError(t, err)
+	}
+
+	server := httptest.NewServer(handler.NewDefaultServer(graphql.NewSchema(client, graphql.SchemaParams{})))
+	defer server.Close()
+	req := testkit.LoadQueryRegistry(t, queryDir, consumerContractFile).NewRequest("DiffInvocations")
+	req.Var("fromInvocationID", successfulBazelBuild)
+	req.Var("toInvocationID", basicFailedInvocation)
+	var got map[string]interface{}
+	require.NoError(t, gql.NewClient(server.URL).Run(ctx, req, &got))
+
+	// Every metric of both invocations has a delta.
+	metrics := got["diffInvocations"].(map[string]interface{})["metrics"].([]interface{})
+	require.NotEmpty(t, metrics)
+	for _, metric := range metrics {
+		delta := metric.(map[string]interface{})
+		require.Equal(t, delta["to"].(float64)-delta["from"].(float64), delta["delta"], delta["name"])
+	}
+	testkit.CheckAgainstGoldenFile(t, got, snapshotDir, "DiffInvocations/diff processed successful and failed bazel build", update, &testkit.CompareOptions{DateTimeAgnostic: true})
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/build"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/eventfile"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/knownproblem"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/testhealthreport"
 	"github.com/buildbarn/bb-portal/internal/graphql/helpers"
 )
 
@@ -43,11 +45,21 @@ func (r *bazelInvocationResolver) ID(ctx context.Context, obj *ent.BazelInvocati
 	return helpers.GraphQLIDFromTypeAndID("BazelInvocation", obj.ID), nil
 }
 
+// Metrics is the resolver for the metrics field.
+func (r *bazelInvocationResolver) Metrics(ctx context.Context, obj *ent.BazelInvocation) (*ent.Metrics, error) {
+	return helpers.InvocationMetrics(ctx, r.client, obj)
+}
+
 // ID is the resolver for the id field.
 func (r *bazelInvocationProblemResolver) ID(ctx context.Context, obj *ent.BazelInvocationProblem) (string, error) {
 	return helpers.GraphQLIDFromTypeAndID("BazelInvocationProblem", obj.ID), nil
 }
 
+// Blobs is the resolver for the blobs field.
+func (r *bazelInvocationProblemResolver) Blobs(ctx context.Context, obj *ent.BazelInvocationProblem) ([]*ent.Blob, error) {
+	return helpers.ProblemBlobs(ctx, r.client, obj)
+}
+
 // ID is the resolver for the id field.
 func (r *blobResolver) ID(ctx context.Context, obj *ent.Blob) (string, error) {
 	return helpers.GraphQLIDFromTypeAndID("Blob", obj.ID), nil
@@ -58,6 +70,11 @@ func (r *buildResolver) ID(ctx context.Context, obj *ent.Build) (string, error)
 	return helpers.GraphQLIDFromTypeAndID("Build", obj.ID), nil
 }
 
+// Invocations is the resolver for the invocations field.
+func (r *buildResolver) Invocations(ctx context.Context, obj *ent.Build) ([]*ent.BazelInvocation, error) {
+	return helpers.BuildInvocations(ctx, r.client, obj)
+}
+
 // ID is the resolver for the id field.
 func (r *buildGraphMetricsResolver) ID(ctx context.Context, obj *ent.BuildGraphMetrics) (string, error) {
 	return helpers.GraphQLIDFromTypeAndID("BuildGraphMetrics", obj.ID), nil
@@ -98,6 +115,11 @@ func (r *garbageMetricsResolver) ID(ctx context.Context, obj *ent.GarbageMetrics
 	return helpers.GraphQLIDFromTypeAndID("GarbageMetrics", obj.ID), nil
 }
 
+// ID is the resolver for the id field.
+func (r *knownProblemResolver) ID(ctx context.Context, obj *ent.KnownProblem) (string, error) {
+	return helpers.GraphQLIDFromTypeAndID("KnownProblem", obj.ID), nil
+}
+
 // ID is the resolver for the id field.
 func (r *memoryMetricsResolver) ID(ctx context.Context, obj *ent.MemoryMetrics) (string, error) {
 	return helpers.GraphQLIDFromTypeAndID("MemoryMetrics", obj.ID), nil
@@ -154,6 +176,8 @@ func (r *queryResolver) Node(ctx context.Context, id string) (ent.Noder, error)
 		"Blob":                   blob.Table,
 		"Build":                  build.Table,
 		"EventFile":              eventfile.Table,
+		"KnownProblem":           knownproblem.Table,
+		"TestHealthReport":       testhealthreport.Table,
 	}[typ]
 
 	var n ent.Noder
@@ -200,6 +224,11 @@ func (r *queryResolver) FindBuilds(ctx context.Context, after *entgql.Cursor[int
 	return r.client.Build.Query().Paginate(ctx, after, first, before, last, ent.WithBuildFilter(where.Filter))
 }
 
+// FindKnownProblems is the resolver for the findKnownProblems field.
+func (r *queryResolver) FindKnownProblems(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, where *ent.KnownProblemWhereInput) (*ent.KnownProblemConnection, error) {
+	return r.client.KnownProblem.Query().Paginate(ctx, after, first, before, last, ent.WithKnownProblemFilter(where.Filter))
+}
+
 // FindMetrics is the resolver for the findMetrics field.
 func (r *queryResolver) FindMetrics(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, where *ent.MetricsWhereInput) (*ent.MetricsConnection, error) {
 	return r.client.Metrics.Query().Paginate(ctx, after, first, before, last, ent.WithMetricsFilter(where.Filter))
@@ -210,6 +239,11 @@ func (r *queryResolver) FindRunnerCounts(ctx context.Context, after *entgql.Curs
 	return r.client.RunnerCount.Query().Paginate(ctx, after, first, before, last, ent.WithRunnerCountFilter(where.Filter))
 }
 
+// FindTestHealthReports is the resolver for the findTestHealthReports field.
+func (r *queryResolver) FindTestHealthReports(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, where *ent.TestHealthReportWhereInput) (*ent.TestHealthReportConnection, error) {
+	return r.client.TestHealthReport.Query().Paginate(ctx, after, first, before, last, ent.WithTestHealthReportFilter(where.Filter))
+}
+
 // ID is the resolver for the id field.
 func (r *raceStatisticsResolver) ID(ctx context.Context, obj *ent.RaceStatistics) (string, error) {
 	return helpers.GraphQLIDFromTypeAndID("RaceStatistics", obj.ID), nil
@@ -260,6 +294,11 @@ func (r *testFileResolver) ID(ctx context.Context, obj *ent.TestFile) (string, e
 	return helpers.GraphQLIDFromTypeAndID("TestFile", obj.ID), nil
 }
 
+// ID is the resolver for the id field.
+func (r *testHealthReportResolver) ID(ctx context.Context, obj *ent.TestHealthReport) (string, error) {
+	return helpers.GraphQLIDFromTypeAndID("TestHealthReport", obj.ID), nil
+}
+
 // ID is the resolver for the id field.
 func (r *testResultBESResolver) ID(ctx context.Context, obj *ent.TestResultBES) (string, error) {
 	return helpers.GraphQLIDFromTypeAndID("TestResultBES", obj.ID), nil
@@ -925,6 +964,46 @@ func (r *garbageMetricsWhereInputResolver) IDLte(ctx context.Context, obj *ent.G
 	panic(fmt.Errorf("not implemented: IDLte - idLTE"))
 }
 
+// ID is the resolver for the id field.
+func (r *knownProblemWhereInputResolver) ID(ctx context.Context, obj *ent.KnownProblemWhereInput, data *string) error {
+	panic(fmt.Errorf("not implemented: ID - id"))
+}
+
+// IDNeq is the resolver for the idNEQ field.
+func (r *knownProblemWhereInputResolver) IDNeq(ctx context.Context, obj *ent.KnownProblemWhereInput, data *string) error {
+	panic(fmt.Errorf("not implemented: IDNeq - idNEQ"))
+}
+
+// IDIn is the resolver for the idIn field.
+func (r *knownProblemWhereInputResolver) IDIn(ctx context.Context, obj *ent.KnownProblemWhereInput, data []string) error {
+	panic(fmt.Errorf("not implemented: IDIn - idIn"))
+}
+
+// IDNotIn is the resolver for the idNotIn field.
+func (r *knownProblemWhereInputResolver) IDNotIn(ctx context.Context, obj *ent.KnownProblemWhereInput, data []string) error {
+	panic(fmt.Errorf("not implemented: IDNotIn - idNotIn"))
+}
+
+// IDGt is the resolver for the idGT field.
+func (r *knownProblemWhereInputResolver) IDGt(ctx context.Context, obj *ent.KnownProblemWhereInput, data *string) error {
+	panic(fmt.Errorf("not implemented: IDGt - idGT"))
+}
+
+// IDGte is the resolver for the idGTE field.
+func (r *knownProblemWhereInputResolver) IDGte(ctx context.Context, obj *ent.KnownProblemWhereInput, data *string) error {
+	panic(fmt.Errorf("not implemented: IDGte - idGTE"))
+}
+
+// IDLt is the resolver for the idLT field.
+func (r *knownProblemWhereInputResolver) IDLt(ctx context.Context, obj *ent.KnownProblemWhereInput, data *string) error {
+	panic(fmt.Errorf("not implemented: IDLt - idLT"))
+}
+
+// IDLte is the resolver for the idLTE field.
+func (r *knownProblemWhereInputResolver) IDLte(ctx context.Context, obj *ent.KnownProblemWhereInput, data *string) error {
+	panic(fmt.Errorf("not implemented: IDLte - idLTE"))
+}
+
 // ID is the resolver for the id field.
 func (r *memoryMetricsWhereInputResolver) ID(ctx context.Context, obj *ent.MemoryMetricsWhereInput, data *string) error {
 	panic(fmt.Errorf("not implemented: ID - id"))
@@ -1645,6 +1724,46 @@ func (r *testFileWhereInputResolver) IDLte(ctx context.Context, obj *ent.TestFil
 	panic(fmt.Errorf("not implemented: IDLte - idLTE"))
 }
 
+// ID is the resolver for the id field.
+func (r *testHealthReportWhereInputResolver) ID(ctx context.Context, obj *ent.TestHealthReportWhereInput, data *string) error {
+	panic(fmt.Errorf("not implemented: ID - id"))
+}
+
+// IDNeq is the resolver for the idNEQ field.
+func (r *testHealthReportWhereInputResolver) IDNeq(ctx context.Context, obj *ent.TestHealthReportWhereInput, data *string) error {
+	panic(fmt.Errorf("not implemented: IDNeq - idNEQ"))
+}
+
+// IDIn is the resolver for the idIn field.
+func (r *testHealthReportWhereInputResolver) IDIn(ctx context.Context, obj *ent.TestHealthReportWhereInput, data []string) error {
+	panic(fmt.Errorf("not implemented: IDIn - idIn"))
+}
+
+// IDNotIn is the resolver for the idNotIn field.
+func (r *testHealthReportWhereInputResolver) IDNotIn(ctx context.Context, obj *ent.TestHealthReportWhereInput, data []string) error {
+	panic(fmt.Errorf("not implemented: IDNotIn - idNotIn"))
+}
+
+// IDGt is the resolver for the idGT field.
+func (r *testHealthReportWhereInputResolver) IDGt(ctx context.Context, obj *ent.TestHealthReportWhereInput, data *string) error {
+	panic(fmt.Errorf("not implemented: IDGt - idGT"))
+}
+
+// IDGte is the resolver for the idGTE field.
+func (r *testHealthReportWhereInputResolver) IDGte(ctx context.Context, obj *ent.TestHealthReportWhereInput, data *string) error {
+	panic(fmt.Errorf("not implemented: IDGte - idGTE"))
+}
+
+// IDLt is the resolver for the idLT field.
+func (r *testHealthReportWhereInputResolver) IDLt(ctx context.Context, obj *ent.TestHealthReportWhereInput, data *string) error {
+	panic(fmt.Errorf("not implemented: IDLt - idLT"))
+}
+
+// IDLte is the resolver for the idLTE field.
+func (r *testHealthReportWhereInputResolver) IDLte(ctx context.Context, obj *ent.TestHealthReportWhereInput, data *string) error {
+	panic(fmt.Errorf("not implemented: IDLte - idLTE"))
+}
+
 // ID is the resolver for the id field.
 func (r *testResultBESWhereInputResolver) ID(ctx context.Context, obj *ent.TestResultBESWhereInput, data *string) error {
 	panic(fmt.Errorf("not implemented: ID - id"))
@@ -1903,6 +2022,9 @@ func (r *Resolver) FilesMetric() FilesMetricResolver { return &filesMetricResolv
 // GarbageMetrics returns GarbageMetricsResolver implementation.
 func (r *Resolver) GarbageMetrics() GarbageMetricsResolver { return &garbageMetricsResolver{r} }
 
+// KnownProblem returns KnownProblemResolver implementation.
+func (r *Resolver) KnownProblem() KnownProblemResolver { return &knownProblemResolver{r} }
+
 // MemoryMetrics returns MemoryMetricsResolver implementation.
 func (r *Resolver) MemoryMetrics() MemoryMetricsResolver { return &memoryMetricsResolver{r} }
 
@@ -1964,6 +2086,9 @@ func (r *Resolver) TestCollection() TestCollectionResolver { return &testCollect
 // TestFile returns TestFileResolver implementation.
 func (r *Resolver) TestFile() TestFileResolver { return &testFileResolver{r} }
 
+// TestHealthReport returns TestHealthReportResolver implementation.
+func (r *Resolver) TestHealthReport() TestHealthReportResolver { return &testHealthReportResolver{r} }
+
 // TestResultBES returns TestResultBESResolver implementation.
 func (r *Resolver) TestResultBES() TestResultBESResolver { return &testResultBESResolver{r} }
 
@@ -2055,6 +2180,11 @@ func (r *Resolver) GarbageMetricsWhereInput() GarbageMetricsWhereInputResolver {
 	return &garbageMetricsWhereInputResolver{r}
 }
 
+// KnownProblemWhereInput returns KnownProblemWhereInputResolver implementation.
+func (r *Resolver) KnownProblemWhereInput() KnownProblemWhereInputResolver {
+	return &knownProblemWhereInputResolver{r}
+}
+
 // MemoryMetricsWhereInput returns MemoryMetricsWhereInputResolver implementation.
 func (r *Resolver) MemoryMetricsWhereInput() MemoryMetricsWhereInputResolver {
 	return &memoryMetricsWhereInputResolver{r}
@@ -2145,6 +2275,11 @@ func (r *Resolver) TestFileWhereInput() TestFileWhereInputResolver {
 	return &testFileWhereInputResolver{r}
 }
 
+// TestHealthReportWhereInput returns TestHealthReportWhereInputResolver implementation.
+func (r *Resolver) TestHealthReportWhereInput() TestHealthReportWhereInputResolver {
+	return &testHealthReportWhereInputResolver{r}
+}
+
 // TestResultBESWhereInput returns TestResultBESWhereInputResolver implementation.
 func (r *Resolver) TestResultBESWhereInput() TestResultBESWhereInputResolver {
 	return &testResultBESWhereInputResolver{r}
@@ -2218,6 +2353,9 @@ type filesMetricResolver struct{ *Resolver }
 // garbageMetricsResolver
 type garbageMetricsResolver struct{ *Resolver }
 
+// knownProblemResolver
+type knownProblemResolver struct{ *Resolver }
+
 // memoryMetricsResolver
 type memoryMetricsResolver struct{ *Resolver }
 
@@ -2275,6 +2413,9 @@ type testCollectionResolver struct{ *Resolver }
 // testFileResolver
 type testFileResolver struct{ *Resolver }
 
+// testHealthReportResolver
+type testHealthReportResolver struct{ *Resolver }
+
 // testResultBESResolver
 type testResultBESResolver struct{ *Resolver }
 
@@ -2338,6 +2479,9 @@ type filesMetricWhereInputResolver struct{ *Resolver }
 // garbageMetricsWhereInputResolver
 type garbageMetricsWhereInputResolver struct{ *Resolver }
 
+// knownProblemWhereInputResolver
+type knownProblemWhereInputResolver struct{ *Resolver }
+
 // memoryMetricsWhereInputResolver
 type memoryMetricsWhereInputResolver struct{ *Resolver }
 
@@ -2392,6 +2536,9 @@ type testCollectionWhereInputResolver struct{ *Resolver }
 // testFileWhereInputResolver
 type testFileWhereInputResolver struct{ *Resolver }
 
+// testHealthReportWhereInputResolver
+type testHealthReportWhereInputResolver struct{ *Resolver }
+
 // testResultBESWhereInputResolver
 type testResultBESWhereInputResolver struct{ *Resolver }
 
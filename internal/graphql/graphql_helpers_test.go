@@ -56,7 +56,7 @@ func newMockServer(t *testing.T, entDataSource string) *mockServer {
 
 	client := enttest.Open(t, "sqlite3", entDataSource)
 
-	graphQLHandler := handler.NewDefaultServer(graphql.NewSchema(client))
+	graphQLHandler := handler.NewDefaultServer(graphql.NewSchema(client, graphql.SchemaParams{}))
 
 	// Limit concurrency to 1. This prevents GraphQL resolver from creating additional database connections
 	// that don't know about the in-memory db schema, thus resulting in an error.
@@ -121,6 +121,21 @@ func TestGraphQLAPI_Snapshots(t *testing.T) {
 				wantErr: helpers.ErrOnlyURLOrUUID,
 			},
 		},
+		"DiffInvocations": {
+			"diff successful and failed bazel build": {
+				variables: testkit.Variables{
+					"fromInvocationID": successfulBazelBuild,
+					"toInvocationID":   basicFailedInvocation,
+				},
+			},
+			"diff with invocation not found": {
+				variables: testkit.Variables{
+					"fromInvocationID": successfulBazelBuild,
+					"toInvocationID":   invocationNotFound,
+				},
+				wantErr: errInvocationNotFound,
+			},
+		},
 		"GetActionProblem": {
 			"get action problem by ID output blob archiving queued": {
 				variables: testkit.Variables{
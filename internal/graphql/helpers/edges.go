@@ -0,0 +1,51 @@
+package helpers
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/internal/graphql/dataloader"
+)
+
+// InvocationProblems The problems of an invocation, batched with the other invocations of the request.
+func InvocationProblems(ctx context.Context, client *ent.Client, invocation *ent.BazelInvocation) ([]*ent.BazelInvocationProblem, error) {
+	return dataloader.For(ctx, client).ProblemsByInvocation.Load(ctx, invocation.ID)
+}
+
+// InvocationMetrics The metrics of an invocation, unless eager loaded batched with the other invocations of the
+// request.
+func InvocationMetrics(ctx context.Context, client *ent.Client, invocation *ent.BazelInvocation) (*ent.Metrics, error) {
+	metrics, err := invocation.Edges.MetricsOrErr()
+	if ent.IsNotLoaded(err) {
+		return dataloader.For(ctx, client).MetricsByInvocation.Load(ctx, invocation.ID)
+	}
+	return metrics, ent.MaskNotFound(err)
+}
+
+// ProblemBlobs The blobs of a problem, unless eager loaded batched with the other problems of the request.
+func ProblemBlobs(ctx context.Context, client *ent.Client, problem *ent.BazelInvocationProblem) ([]*ent.Blob, error) {
+	blobs, err := loadedEdges(ctx, problem.NamedBlobs, problem.Edges.BlobsOrErr)
+	if ent.IsNotLoaded(err) {
+		return dataloader.For(ctx, client).BlobsByProblem.Load(ctx, problem.ID)
+	}
+	return blobs, err
+}
+
+// BuildInvocations The invocations of a build, unless eager loaded batched with the other builds of the request.
+func BuildInvocations(ctx context.Context, client *ent.Client, b *ent.Build) ([]*ent.BazelInvocation, error) {
+	invocations, err := loadedEdges(ctx, b.NamedInvocations, b.Edges.InvocationsOrErr)
+	if ent.IsNotLoaded(err) {
+		return dataloader.For(ctx, client).InvocationsByBuild.Load(ctx, b.ID)
+	}
+	return invocations, err
+}
+
+// The edges eager loaded for the field being resolved, under its alias if it has one.
+func loadedEdges[V any](ctx context.Context, named func(string) ([]V, error), unnamed func() ([]V, error)) ([]V, error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		return named(fc.Field.Alias)
+	}
+	return unnamed()
+}
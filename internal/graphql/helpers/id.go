@@ -2,11 +2,15 @@ package helpers
 
 import (
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 )
 
+// errWrongIDType An error helper.
+var errWrongIDType = errors.New("ID of an unexpected type")
+
 // graphQLIDFromString graphQLIDFromString converts a string ID to a base64 encoded string for use as a GraphQL ID.
 func graphQLIDFromString(input string) string {
 	return base64.URLEncoding.EncodeToString([]byte(input))
@@ -24,10 +28,22 @@ func GraphQLTypeAndIntIDFromID(id string) (string, int, error) {
 		return "", 0, fmt.Errorf("could not decode ID (id: %s): %w", id, err)
 	}
 	s := string(bytes)
-	parts := strings.SplitN(s, ":", 2)
-	intID, err := strconv.Atoi(parts[1])
+	typ, rawID, _ := strings.Cut(s, ":")
+	intID, err := strconv.Atoi(rawID)
 	if err != nil {
 		return "", 0, fmt.Errorf("could extract int ID (id: %s): %w", id, err)
 	}
-	return parts[0], intID, nil
+	return typ, intID, nil
+}
+
+// IntIDFromGraphQLID Decodes the int ID of an object of a given type.
+func IntIDFromGraphQLID(id string, objType string) (int, error) {
+	typ, intID, err := GraphQLTypeAndIntIDFromID(id)
+	if err != nil {
+		return 0, err
+	}
+	if typ != objType {
+		return 0, fmt.Errorf("%w: %s is not a %s", errWrongIDType, id, objType)
+	}
+	return intID, nil
 }
@@ -0,0 +1,307 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/internal/graphql/model"
+	"github.com/buildbarn/bb-portal/pkg/summary"
+)
+
+// DiffInvocations Compare two invocations, reporting only what changed between them except for the metrics,
+// which are all reported with their delta.
+func (h Helper) DiffInvocations(ctx context.Context, from *ent.BazelInvocation, to *ent.BazelInvocation) (*model.InvocationDiff, error) {
+	diff := &model.InvocationDiff{
+		From:        from,
+		To:          to,
+		CommandLine: diffCommandLines(from.Summary, to.Summary),
+		EnvVars:     diffValues(buildRelevantEnvVars(from.Summary.EnvVars), buildRelevantEnvVars(to.Summary.EnvVars)),
+	}
+	diff.AddedOptions, diff.RemovedOptions = diffOptions(from.Summary.BazelCommandLine.Options, to.Summary.BazelCommandLine.Options)
+
+	var err error
+	if diff.Targets, err = diffTargets(ctx, from, to); err != nil {
+		return nil, err
+	}
+	if diff.Tests, err = diffTests(ctx, from, to); err != nil {
+		return nil, err
+	}
+	if diff.NewProblems, diff.ResolvedProblems, err = h.diffProblems(ctx, from, to); err != nil {
+		return nil, err
+	}
+	if diff.Metrics, err = diffMetrics(ctx, from, to); err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
+
+// Diff the parts of the command line, and the Bazel version running it.
+func diffCommandLines(from summary.InvocationSummary, to summary.InvocationSummary) []*model.ValueChange {
+	return diffValues(commandLineValues(from), commandLineValues(to))
+}
+
+// Get the parts of the command line, keyed by name.
+func commandLineValues(invocationSummary summary.InvocationSummary) map[string]string {
+	return map[string]string{
+		"bazelVersion": invocationSummary.BazelVersion,
+		"executable":   invocationSummary.BazelCommandLine.Executable,
+		"command":      invocationSummary.BazelCommandLine.Command,
+		"residual":     invocationSummary.BazelCommandLine.Residual,
+	}
+}
+
+// Get the environment variables that may change the outcome of a build.
+func buildRelevantEnvVars(envVars map[string]string) map[string]string {
+	relevant := make(map[string]string, len(envVars))
+	for k, v := range envVars {
+		if summary.IsBuildEnvKey(k) || summary.IsToolchainEnvKey(k) {
+			relevant[k] = v
+		}
+	}
+	return relevant
+}
+
+// Diff two sets of named values, a missing value is reported as null.
+func diffValues(from map[string]string, to map[string]string) []*model.ValueChange {
+	changes := []*model.ValueChange{}
+	for _, name := range unionOfKeys(from, to) {
+		fromValue, fromOk := from[name]
+		toValue, toOk := to[name]
+		if fromOk == toOk && fromValue == toValue {
+			continue
+		}
+		changes = append(changes, &model.ValueChange{
+			Name: name,
+			From: optional(fromValue, fromOk),
+			To:   optional(toValue, toOk),
+		})
+	}
+	return changes
+}
+
+// Diff the explicit command line options.
+func diffOptions(from []string, to []string) ([]string, []string) {
+	added := []string{}
+	for _, option := range to {
+		if !slices.Contains(from, option) {
+			added = append(added, option)
+		}
+	}
+	removed := []string{}
+	for _, option := range from {
+		if !slices.Contains(to, option) {
+			removed = append(removed, option)
+		}
+	}
+	return added, removed
+}
+
+// Diff the success of the targets built by both invocations, or only one of them.
+func diffTargets(ctx context.Context, from *ent.BazelInvocation, to *ent.BazelInvocation) ([]*model.TargetChange, error) {
+	fromSuccess, err := targetSuccess(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	toSuccess, err := targetSuccess(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+	changes := []*model.TargetChange{}
+	for _, label := range unionOfKeys(fromSuccess, toSuccess) {
+		fromValue, fromOk := fromSuccess[label]
+		toValue, toOk := toSuccess[label]
+		if fromOk == toOk && fromValue == toValue {
+			continue
+		}
+		changes = append(changes, &model.TargetChange{
+			Label:       label,
+			FromSuccess: optional(fromValue, fromOk),
+			ToSuccess:   optional(toValue, toOk),
+		})
+	}
+	return changes, nil
+}
+
+// Get the success of the targets of an invocation, keyed by label.
+func targetSuccess(ctx context.Context, invocation *ent.BazelInvocation) (map[string]bool, error) {
+	targets, err := invocation.QueryTargets().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch targets: %w", err)
+	}
+	success := make(map[string]bool, len(targets))
+	for _, target := range targets {
+		success[target.Label] = target.Success
+	}
+	return success, nil
+}
+
+// Diff the status of the tests run by both invocations, or only one of them.
+func diffTests(ctx context.Context, from *ent.BazelInvocation, to *ent.BazelInvocation) ([]*model.TestStatusChange, error) {
+	fromStatus, err := testStatus(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	toStatus, err := testStatus(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+	changes := []*model.TestStatusChange{}
+	for _, change := range diffValues(fromStatus, toStatus) {
+		changes = append(changes, &model.TestStatusChange{
+			Label:      change.Name,
+			FromStatus: change.From,
+			ToStatus:   change.To,
+		})
+	}
+	return changes, nil
+}
+
+// Get the overall status of the tests of an invocation, keyed by label.
+func testStatus(ctx context.Context, invocation *ent.BazelInvocation) (map[string]string, error) {
+	tests, err := invocation.QueryTestCollection().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch tests: %w", err)
+	}
+	status := make(map[string]string, len(tests))
+	for _, test := range tests {
+		status[test.Label] = test.OverallStatus.String()
+	}
+	return status, nil
+}
+
+// Diff the problems, matching them by type and label.
+func (h Helper) diffProblems(ctx context.Context, from *ent.BazelInvocation, to *ent.BazelInvocation) ([]model.Problem, []model.Problem, error) {
+	fromProblems, err := from.QueryProblems().All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not fetch problems: %w", err)
+	}
+	toProblems, err := to.QueryProblems().All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not fetch problems: %w", err)
+	}
+	newProblems, err := h.DBProblemsToAPIProblems(ctx, problemsMissingFrom(toProblems, fromProblems))
+	if err != nil {
+		return nil, nil, err
+	}
+	resolvedProblems, err := h.DBProblemsToAPIProblems(ctx, problemsMissingFrom(fromProblems, toProblems))
+	if err != nil {
+		return nil, nil, err
+	}
+	return newProblems, resolvedProblems, nil
+}
+
+// Get the problems for which there is no problem of the same type and label in others.
+func problemsMissingFrom(problems []*ent.BazelInvocationProblem, others []*ent.BazelInvocationProblem) []*ent.BazelInvocationProblem {
+	type problemKey struct{ problemType, label string }
+	seen := make(map[problemKey]struct{}, len(others))
+	for _, other := range others {
+		seen[problemKey{other.ProblemType, other.Label}] = struct{}{}
+	}
+	var missing []*ent.BazelInvocationProblem
+	for _, problem := range problems {
+		if _, ok := seen[problemKey{problem.ProblemType, problem.Label}]; !ok {
+			missing = append(missing, problem)
+		}
+	}
+	return missing
+}
+
+// Diff the main build metrics.
+func diffMetrics(ctx context.Context, from *ent.BazelInvocation, to *ent.BazelInvocation) ([]*model.MetricDelta, error) {
+	fromMetrics, err := metricValues(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	toMetrics, err := metricValues(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+	deltas := []*model.MetricDelta{}
+	for _, name := range unionOfKeys(fromMetrics, toMetrics) {
+		fromValue, fromOk := fromMetrics[name]
+		toValue, toOk := toMetrics[name]
+		delta := &model.MetricDelta{
+			Name: name,
+			From: optional(int(fromValue), fromOk),
+			To:   optional(int(toValue), toOk),
+		}
+		if fromOk && toOk {
+			v := int(toValue - fromValue)
+			delta.Delta = &v
+		}
+		deltas = append(deltas, delta)
+	}
+	return deltas, nil
+}
+
+// Get the main build metrics of an invocation, keyed by name.
+func metricValues(ctx context.Context, invocation *ent.BazelInvocation) (map[string]int64, error) {
+	metrics, err := invocation.QueryMetrics().
+		WithActionSummary(func(query *ent.ActionSummaryQuery) {
+			query.WithActionCacheStatistics()
+		}).
+		WithTimingMetrics().
+		WithTargetMetrics().
+		WithPackageMetrics().
+		WithMemoryMetrics().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return map[string]int64{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch metrics: %w", err)
+	}
+
+	values := map[string]int64{}
+	for _, actionSummary := range metrics.Edges.ActionSummary {
+		values["actionsCreated"] = actionSummary.ActionsCreated
+		values["actionsExecuted"] = actionSummary.ActionsExecuted
+		values["remoteCacheHits"] = actionSummary.RemoteCacheHits
+		for _, actionCacheStatistics := range actionSummary.Edges.ActionCacheStatistics {
+			values["actionCacheHits"] = int64(actionCacheStatistics.Hits)
+			values["actionCacheMisses"] = int64(actionCacheStatistics.Misses)
+		}
+	}
+	for _, timingMetrics := range metrics.Edges.TimingMetrics {
+		values["wallTimeInMs"] = timingMetrics.WallTimeInMs
+		values["cpuTimeInMs"] = timingMetrics.CPUTimeInMs
+		values["analysisPhaseTimeInMs"] = timingMetrics.AnalysisPhaseTimeInMs
+		values["executionPhaseTimeInMs"] = timingMetrics.ExecutionPhaseTimeInMs
+	}
+	for _, targetMetrics := range metrics.Edges.TargetMetrics {
+		values["targetsLoaded"] = targetMetrics.TargetsLoaded
+		values["targetsConfigured"] = targetMetrics.TargetsConfigured
+	}
+	for _, packageMetrics := range metrics.Edges.PackageMetrics {
+		values["packagesLoaded"] = packageMetrics.PackagesLoaded
+	}
+	for _, memoryMetrics := range metrics.Edges.MemoryMetrics {
+		values["peakPostGcHeapSize"] = memoryMetrics.PeakPostGcHeapSize
+	}
+	return values, nil
+}
+
+// Get the sorted union of the keys of two maps.
+func unionOfKeys[V any](a map[string]V, b map[string]V) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// Get a pointer to a value, or nil if it is not set.
+func optional[V any](v V, ok bool) *V {
+	if !ok {
+		return nil
+	}
+	return &v
+}
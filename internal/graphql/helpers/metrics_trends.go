@@ -0,0 +1,63 @@
+package helpers
+
+import (
+	"context"
+	"time"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/metricsrollup"
+	"github.com/buildbarn/bb-portal/internal/graphql/model"
+	"github.com/buildbarn/bb-portal/pkg/rollup"
+)
+
+// MetricsTrends Read the trends of metrics over the buckets overlapping [from, to) from the metrics rollups.
+func MetricsTrends(
+	ctx context.Context,
+	client *ent.Client,
+	metrics []model.MetricsTrendMetric,
+	from time.Time,
+	to *time.Time,
+	bucket model.TrendBucket,
+	filter *model.MetricsTrendFilter,
+) ([]*model.MetricsTrend, error) {
+	end := time.Now()
+	if to != nil {
+		end = *to
+	}
+	rollupMetrics := make([]rollup.Metric, 0, len(metrics))
+	for _, metric := range metrics {
+		rollupMetrics = append(rollupMetrics, rollup.Metric(metric))
+	}
+	var rollupFilter rollup.Filter
+	if filter != nil {
+		rollupFilter = rollup.Filter{
+			Branch:    filter.Branch,
+			Command:   filter.Command,
+			StepLabel: filter.StepLabel,
+			User:      filter.User,
+		}
+	}
+	trends, err := rollup.Trends(ctx, client, rollupMetrics, from, end, metricsrollup.Granularity(bucket), rollupFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.MetricsTrend, 0, len(trends))
+	for _, trend := range trends {
+		points := make([]*model.MetricsTrendPoint, 0, len(trend.Points))
+		for _, point := range trend.Points {
+			points = append(points, &model.MetricsTrendPoint{
+				Time:        point.Time,
+				Invocations: int(point.Invocations),
+				Value:       point.Value,
+				Min:         point.Min,
+				Max:         point.Max,
+				P50:         point.P50,
+				P90:         point.P90,
+				P99:         point.P99,
+			})
+		}
+		result = append(result, &model.MetricsTrend{Metric: model.MetricsTrendMetric(trend.Metric), Points: points})
+	}
+	return result, nil
+}
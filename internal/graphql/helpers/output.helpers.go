@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net/url"
+	"strconv"
 
 	"github.com/buildbarn/bb-portal/ent/gen/ent"
-	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
+	"github.com/buildbarn/bb-portal/internal/graphql/dataloader"
 	"github.com/buildbarn/bb-portal/internal/graphql/model"
 	"github.com/buildbarn/bb-portal/pkg/events"
 	"github.com/buildbarn/bb-portal/third_party/bazel/gen/bes"
@@ -34,14 +36,30 @@ func BlobReferenceForFile(ctx context.Context, db *ent.Client, fileLookup FileLo
 	}, nil
 }
 
-// find blob function.
+// BlobDownloadURL The URL to download a blob by its key, or by its row ID if it was saved before blobs had keys.
+func BlobDownloadURL(blobRecord *ent.Blob, name string) string {
+	key := blobRecord.Key
+	if key == "" {
+		key = strconv.Itoa(blobRecord.ID)
+	}
+	return fmt.Sprintf("/api/v1/blobs/%s/%s", key, url.PathEscape(name))
+}
+
+// find blob function, batched with the other files of the request.
 func findBlob(ctx context.Context, db *ent.Client, file *bes.File) (*ent.Blob, error) {
 	uri := file.GetUri()
 	if uri == "" {
 		return nil, nil
 	}
 
-	return db.Blob.Query().Where(blob.URI(uri)).First(ctx)
+	blobRecord, err := dataloader.For(ctx, db).BlobsByURI.Load(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	if blobRecord == nil {
+		return nil, errBlobNotFound
+	}
+	return blobRecord, nil
 }
 
 // GetAction Get an Action.
@@ -0,0 +1,115 @@
+package helpers
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
+	"github.com/buildbarn/bb-portal/internal/graphql/model"
+	"github.com/buildbarn/bb-portal/pkg/progress"
+	"github.com/buildbarn/bb-portal/pkg/summary"
+)
+
+// InvocationProgress Follows the progress of an invocation, with the saved invocation on the finished event. An
+// invocation which was already saved only gets its finished event.
+func InvocationProgress(ctx context.Context, client *ent.Client, progressHub *progress.Hub, invocationID string) <-chan *model.InvocationProgressEvent {
+	// Subscribed before looking for the saved invocation, not to miss it being saved in between.
+	events := progressHub.SubscribeInvocation(ctx, invocationID)
+	out := make(chan *model.InvocationProgressEvent)
+	go func() {
+		defer close(out)
+		if finished := savedInvocationFinished(ctx, client, invocationID); finished != nil {
+			select {
+			case out <- finished:
+			case <-ctx.Done():
+			}
+			return
+		}
+		for event := range events {
+			progressEvent := &model.InvocationProgressEvent{
+				Kind:         model.InvocationProgressEventKind(event.Kind),
+				InvocationID: event.InvocationID,
+				Time:         event.Time,
+				Label:        optionalString(event.Label),
+				Success:      event.Success,
+				Status:       optionalString(event.Status),
+			}
+			if event.RecordID != 0 {
+				invocation, err := client.BazelInvocation.Get(ctx, event.RecordID)
+				if err != nil {
+					slog.WarnContext(ctx, "could not fetch the finished invocation", "id", event.RecordID, "err", err)
+				}
+				progressEvent.Invocation = invocation
+			}
+			select {
+			case out <- progressEvent:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// The finished event of an invocation which was already saved, nil if it was not.
+func savedInvocationFinished(ctx context.Context, client *ent.Client, invocationID string) *model.InvocationProgressEvent {
+	parsedID, err := uuid.Parse(invocationID)
+	if err != nil {
+		return nil
+	}
+	invocation, err := client.BazelInvocation.Query().Where(bazelinvocation.InvocationID(parsedID)).Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			slog.WarnContext(ctx, "could not look for the saved invocation", "invocationID", invocationID, "err", err)
+		}
+		return nil
+	}
+	finished := &model.InvocationProgressEvent{
+		Kind:         model.InvocationProgressEventKind(progress.EventKindFinished),
+		InvocationID: invocationID,
+		Time:         invocation.EndedAt,
+		Invocation:   invocation,
+	}
+	if finished.Time.IsZero() {
+		finished.Time = invocation.StartedAt
+	}
+	if exitCode := invocation.Summary.ExitCode; exitCode != nil {
+		finished.Success = exitCode.Code == summary.ExitCodeSuccess
+		finished.Status = optionalString(exitCode.Name)
+	}
+	return finished
+}
+
+// BuildInvocationCreated Follows the invocations saved for a build.
+func BuildInvocationCreated(ctx context.Context, client *ent.Client, progressHub *progress.Hub, buildUUID uuid.UUID) <-chan *ent.BazelInvocation {
+	newInvocations := progressHub.SubscribeBuild(ctx, buildUUID)
+	out := make(chan *ent.BazelInvocation)
+	go func() {
+		defer close(out)
+		for newInvocation := range newInvocations {
+			invocation, err := client.BazelInvocation.Get(ctx, newInvocation.RecordID)
+			if err != nil {
+				// Deleted in the meantime.
+				slog.WarnContext(ctx, "could not fetch the new invocation", "id", newInvocation.RecordID, "err", err)
+				continue
+			}
+			select {
+			case out <- invocation:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// A nil string when empty.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
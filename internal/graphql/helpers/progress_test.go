@@ -0,0 +1,51 @@
+package helpers_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent/enttest"
+	"github.com/buildbarn/bb-portal/internal/graphql/helpers"
+	"github.com/buildbarn/bb-portal/internal/graphql/model"
+	"github.com/buildbarn/bb-portal/pkg/processing"
+	"github.com/buildbarn/bb-portal/pkg/progress"
+	"github.com/buildbarn/bb-portal/pkg/summary"
+)
+
+func TestInvocationProgress(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := enttest.Open(t, "sqlite3", "file:invocation_progress?mode=memory&_fk=1")
+	defer client.Close()
+	progressHub := progress.NewHub()
+	invocation, err := processing.New(client, nil, nil, summary.DefaultTestHealthThresholds()).
+		ProcessFile(ctx, filepath.Join("../../../pkg/summary/testdata", "nextjs_build_fail.bep.ndjson"))
+	require.NoError(t, err)
+
+	// An invocation which was already saved only gets its finished event.
+	events := helpers.InvocationProgress(ctx, client, progressHub, invocation.InvocationID.String())
+	finished := <-events
+	require.Equal(t, model.InvocationProgressEventKind(progress.EventKindFinished), finished.Kind)
+	require.False(t, finished.Success)
+	require.Equal(t, "BUILD_FAILURE", *finished.Status)
+	require.Equal(t, invocation.ID, finished.Invocation.ID)
+	_, ok := <-events
+	require.False(t, ok)
+
+	// The others follow the published progress until they finish.
+	invocationID := uuid.NewString()
+	events = helpers.InvocationProgress(ctx, client, progressHub, invocationID)
+	progressHub.Publish(progress.Event{Kind: progress.EventKindStarted, InvocationID: invocationID})
+	progressHub.Publish(progress.IncompleteEvent(invocationID))
+	require.Equal(t, model.InvocationProgressEventKind(progress.EventKindStarted), (<-events).Kind)
+	finished = <-events
+	require.Equal(t, progress.StatusIncomplete, *finished.Status)
+	require.Nil(t, finished.Invocation)
+	_, ok = <-events
+	require.False(t, ok)
+}
@@ -8,8 +8,11 @@ import (
 	"strings"
 
 	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocationproblem"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/knownproblem"
 	"github.com/buildbarn/bb-portal/internal/graphql/model"
 	"github.com/buildbarn/bb-portal/pkg/events"
+	"github.com/buildbarn/bb-portal/pkg/processing"
 	"github.com/buildbarn/bb-portal/pkg/summary/detectors"
 	"github.com/buildbarn/bb-portal/third_party/bazel/gen/bes"
 )
@@ -21,6 +24,7 @@ var (
 	errUnknownProblemType = errors.New("unknown problem type")
 	errActionNotFound     = errors.New("action not found")
 	errStatusNotFound     = errors.New("status not found")
+	errBlobNotFound       = errors.New("blob not found")
 )
 
 // Helper A Helper struct.
@@ -73,16 +77,22 @@ func (ph problemHelper) DBProblemToAPIProblem(ctx context.Context, problem *ent.
 			return nil, fmt.Errorf("could not get results: %w", err)
 		}
 		return &model.TestProblem{
-			ID:      GraphQLIDFromTypeAndID("TestProblem", problem.ID),
-			Label:   problem.Label,
-			Status:  status,
-			Results: results,
+			ID:                    GraphQLIDFromTypeAndID("TestProblem", problem.ID),
+			Label:                 problem.Label,
+			Fingerprint:           fingerprint(problem),
+			FailureClassification: failureClassification(problem),
+			NewlyFailing:          problem.NewlyFailing,
+			Status:                status,
+			Results:               results,
 		}, nil
 
 	case detectors.BazelInvocationProblemFailedTarget:
 		return &model.TargetProblem{
-			ID:    GraphQLIDFromTypeAndID("TargetProblem", problem.ID),
-			Label: problem.Label,
+			ID:                    GraphQLIDFromTypeAndID("TargetProblem", problem.ID),
+			Label:                 problem.Label,
+			Fingerprint:           fingerprint(problem),
+			FailureClassification: failureClassification(problem),
+			NewlyFailing:          problem.NewlyFailing,
 		}, nil
 
 	case detectors.BazelInvocationProblemErrorProgress:
@@ -92,8 +102,11 @@ func (ph problemHelper) DBProblemToAPIProblem(ctx context.Context, problem *ent.
 			return nil, fmt.Errorf("could not get output: %w", err)
 		}
 		return &model.ProgressProblem{
-			ID:     GraphQLIDFromTypeAndID("ProgressProblem", problem.ID),
-			Output: output,
+			ID:                    GraphQLIDFromTypeAndID("ProgressProblem", problem.ID),
+			Fingerprint:           fingerprint(problem),
+			FailureClassification: failureClassification(problem),
+			NewlyFailing:          problem.NewlyFailing,
+			Output:                output,
 		}, nil
 
 	default:
@@ -128,13 +141,78 @@ func (ph problemHelper) getAction(ctx context.Context, problem *ent.BazelInvocat
 // Get an action problem form a database model.
 func (ph problemHelper) actionProblemFromDBModel(problem *ent.BazelInvocationProblem, actionType string) model.Problem {
 	return &model.ActionProblem{
-		ID:      GraphQLIDFromTypeAndID("ActionProblem", problem.ID),
-		Label:   problem.Label,
-		Type:    actionType,
-		Problem: problem,
+		ID:                    GraphQLIDFromTypeAndID("ActionProblem", problem.ID),
+		Label:                 problem.Label,
+		Fingerprint:           fingerprint(problem),
+		FailureClassification: failureClassification(problem),
+		NewlyFailing:          problem.NewlyFailing,
+		Type:                  actionType,
+		Problem:               problem,
 	}
 }
 
+// Get the fingerprint of a problem, problems saved before fingerprinting was introduced have none.
+func fingerprint(problem *ent.BazelInvocationProblem) *string {
+	if problem.Fingerprint == "" {
+		return nil
+	}
+	return &problem.Fingerprint
+}
+
+// Get the failure classification of a problem, problems saved before classification was introduced have none.
+func failureClassification(problem *ent.BazelInvocationProblem) *bazelinvocationproblem.FailureClassification {
+	if problem.FailureClassification == "" {
+		return nil
+	}
+	return &problem.FailureClassification
+}
+
+// FirstFailureForProblem Find where the problem with the given GraphQL ID started failing on its branch.
+func FirstFailureForProblem(ctx context.Context, client *ent.Client, problemID string) (*model.FirstFailure, error) {
+	_, intID, err := GraphQLTypeAndIntIDFromID(problemID)
+	if err != nil {
+		return nil, err
+	}
+	problem, err := client.BazelInvocationProblem.Query().
+		Where(bazelinvocationproblem.ID(intID)).
+		WithBazelInvocation().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch problem: %w", err)
+	}
+	if problem.Edges.BazelInvocation == nil {
+		return nil, nil
+	}
+
+	firstFailure, err := processing.FindFirstFailure(ctx, client, problem.Edges.BazelInvocation, problem.ProblemType, problem.Label)
+	if err != nil || firstFailure == nil {
+		return nil, err
+	}
+	result := &model.FirstFailure{
+		Invocation:          firstFailure.Invocation,
+		LastGreenInvocation: firstFailure.LastGreen,
+	}
+	if firstFailure.LastGreen != nil && firstFailure.LastGreen.Commit != "" {
+		result.LastGreenCommit = &firstFailure.LastGreen.Commit
+	}
+	return result, nil
+}
+
+// KnownProblemForFingerprint Get the known problem aggregating the problems with a fingerprint, if any.
+func KnownProblemForFingerprint(ctx context.Context, client *ent.Client, fingerprint *string) (*ent.KnownProblem, error) {
+	if fingerprint == nil {
+		return nil, nil
+	}
+	knownProblem, err := client.KnownProblem.Query().Where(knownproblem.Fingerprint(*fingerprint)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch known problem: %w", err)
+	}
+	return knownProblem, nil
+}
+
 // A test problem helper struct
 type testProblemHelper struct {
 	*ent.BazelInvocationProblem
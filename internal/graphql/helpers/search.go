@@ -0,0 +1,99 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocationproblem"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/searchchunk"
+	"github.com/buildbarn/bb-portal/internal/graphql/model"
+	"github.com/buildbarn/bb-portal/pkg/search"
+)
+
+// SearchInvocations Find the invocations with all the words of a query, with their matching lines.
+func SearchInvocations(
+	ctx context.Context,
+	client *ent.Client,
+	query string,
+	from *time.Time,
+	to *time.Time,
+	sources []model.SearchSource,
+	first *int,
+) ([]*model.InvocationSearchResult, error) {
+	searchQuery := search.Query{Text: query, From: from, To: to}
+	for _, source := range sources {
+		searchQuery.Sources = append(searchQuery.Sources, searchchunk.Source(source))
+	}
+	if first != nil {
+		searchQuery.Limit = *first
+	}
+	results, err := search.Search(ctx, client, searchQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	var invocationIDs, problemIDs, blobIDs []int
+	for _, result := range results {
+		invocationIDs = append(invocationIDs, result.InvocationID)
+		for _, match := range result.Matches {
+			if match.ProblemID != 0 {
+				problemIDs = append(problemIDs, match.ProblemID)
+			}
+			if match.BlobID != 0 {
+				blobIDs = append(blobIDs, match.BlobID)
+			}
+		}
+	}
+	invocations, err := client.BazelInvocation.Query().Where(bazelinvocation.IDIn(invocationIDs...)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the invocations found: %w", err)
+	}
+	invocationsByID := map[int]*ent.BazelInvocation{}
+	for _, invocation := range invocations {
+		invocationsByID[invocation.ID] = invocation
+	}
+	problems, err := client.BazelInvocationProblem.Query().Where(bazelinvocationproblem.IDIn(problemIDs...)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the problems found: %w", err)
+	}
+	problemsByID := map[int]model.Problem{}
+	for _, problem := range problems {
+		problemsByID[problem.ID], err = problemHelper{}.DBProblemToAPIProblem(ctx, problem)
+		if err != nil {
+			return nil, err
+		}
+	}
+	blobs, err := client.Blob.Query().Where(blob.IDIn(blobIDs...)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the blobs found: %w", err)
+	}
+	blobsByID := map[int]*ent.Blob{}
+	for _, b := range blobs {
+		blobsByID[b.ID] = b
+	}
+
+	found := make([]*model.InvocationSearchResult, 0, len(results))
+	for _, result := range results {
+		invocation, ok := invocationsByID[result.InvocationID]
+		if !ok {
+			// Deleted since.
+			continue
+		}
+		matches := make([]*model.SearchMatch, 0, len(result.Matches))
+		for _, match := range result.Matches {
+			matches = append(matches, &model.SearchMatch{
+				Source:  model.SearchSource(match.Source),
+				Line:    match.Line,
+				Snippet: match.Snippet,
+				Problem: problemsByID[match.ProblemID],
+				Blob:    blobsByID[match.BlobID],
+			})
+		}
+		found = append(found, &model.InvocationSearchResult{Invocation: invocation, Matches: matches})
+	}
+	return found, nil
+}
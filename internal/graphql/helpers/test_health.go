@@ -0,0 +1,77 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/testhealthreport"
+	"github.com/buildbarn/bb-portal/internal/graphql/model"
+)
+
+// TestHealthTrends Aggregate the test health reports of all the invocations per test and issue, optionally only
+// for a label and the invocations started since a time. The most recently seen issues come first.
+func TestHealthTrends(ctx context.Context, client *ent.Client, label *string, since *time.Time) ([]*model.TestHealthTrend, error) {
+	query := client.TestHealthReport.Query()
+	if label != nil {
+		query = query.Where(testhealthreport.LabelEQ(*label))
+	}
+	if since != nil {
+		query = query.Where(testhealthreport.HasBazelInvocationWith(bazelinvocation.StartedAtGTE(*since)))
+	}
+	reports, err := query.WithBazelInvocation().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch test health reports: %w", err)
+	}
+
+	type trendKey struct {
+		label string
+		issue testhealthreport.Issue
+	}
+	trends := map[trendKey]*model.TestHealthTrend{}
+	for _, report := range reports {
+		if report.Edges.BazelInvocation == nil {
+			continue
+		}
+		startedAt := report.Edges.BazelInvocation.StartedAt
+		key := trendKey{report.Label, report.Issue}
+		trend, ok := trends[key]
+		if !ok {
+			trend = &model.TestHealthTrend{
+				Label:     report.Label,
+				Issue:     report.Issue,
+				FirstSeen: startedAt,
+				LastSeen:  startedAt,
+				Latest:    report,
+			}
+			trends[key] = trend
+		}
+		trend.Occurrences++
+		trend.MaxDurationInMs = max(trend.MaxDurationInMs, int(report.MaxDurationInMs))
+		if startedAt.Before(trend.FirstSeen) {
+			trend.FirstSeen = startedAt
+		}
+		if !startedAt.Before(trend.LastSeen) {
+			trend.LastSeen = startedAt
+			trend.Latest = report
+		}
+	}
+
+	result := make([]*model.TestHealthTrend, 0, len(trends))
+	for _, trend := range trends {
+		result = append(result, trend)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].LastSeen.Equal(result[j].LastSeen) {
+			return result[i].LastSeen.After(result[j].LastSeen)
+		}
+		if result[i].Label != result[j].Label {
+			return result[i].Label < result[j].Label
+		}
+		return result[i].Issue < result[j].Issue
+	})
+	return result, nil
+}
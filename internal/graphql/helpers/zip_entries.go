@@ -0,0 +1,40 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/buildbarn/bb-portal/internal/graphql/model"
+	"github.com/buildbarn/bb-portal/pkg/blobs"
+)
+
+// ZipEntries List the files in a zip blob, nil for other blobs.
+func ZipEntries(ctx context.Context, blobOpener *blobs.Opener, blobReference *model.BlobReference) ([]*model.ZipEntry, error) {
+	if blobOpener == nil || blobReference.Blob == nil || !blobs.IsZip(blobReference.Name) {
+		return nil, nil
+	}
+	file, err := blobOpener.Open(ctx, blobReference.Blob)
+	if err != nil {
+		return nil, fmt.Errorf("could not open blob: %w", err)
+	}
+	defer file.Close()
+	entries, err := blobs.ListZipEntries(file, file.Size())
+	if err != nil {
+		return nil, err
+	}
+
+	zipEntries := make([]*model.ZipEntry, 0, len(entries))
+	for _, entry := range entries {
+		zipEntries = append(zipEntries, &model.ZipEntry{
+			Name:                  entry.Name,
+			SizeInBytes:           int(entry.SizeBytes),
+			CompressedSizeInBytes: int(entry.CompressedSizeBytes),
+			Compression:           model.ZipCompression(entry.Compression),
+			ContentType:           entry.ContentType,
+			DownloadURL: BlobDownloadURL(blobReference.Blob, blobReference.Name) +
+				"/entries/" + (&url.URL{Path: entry.Name}).EscapedPath(),
+		})
+	}
+	return zipEntries, nil
+}
@@ -0,0 +1,69 @@
+package limits
+
+import (
+	"context"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// errDepthLimit The code of the errors rejecting operations nested too deeply.
+const errDepthLimit = "DEPTH_LIMIT_EXCEEDED"
+
+// DepthLimit Rejects the operations nesting fields deeper than a maximum, counting the fields of fragments where they
+// are spread. Introspection fields are not counted. No limit when the maximum is 0.
+type DepthLimit struct {
+	MaxDepth int
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = DepthLimit{}
+
+// ExtensionName The name of the extension.
+func (DepthLimit) ExtensionName() string {
+	return "DepthLimit"
+}
+
+// Validate Nothing to validate.
+func (DepthLimit) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationContext Rejects the operation if it is nested too deeply.
+func (d DepthLimit) MutateOperationContext(_ context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	if d.MaxDepth <= 0 || rc.Operation == nil {
+		return nil
+	}
+	if depth := Depth(rc.Operation.SelectionSet); depth > d.MaxDepth {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, d.MaxDepth)
+		errcode.Set(err, errDepthLimit)
+		return err
+	}
+	return nil
+}
+
+// Depth How deeply the fields of a selection set are nested, a field without a selection set being at depth 1.
+func Depth(selectionSet ast.SelectionSet) int {
+	depth := 0
+	for _, selection := range selectionSet {
+		switch s := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+			depth = max(depth, 1+Depth(s.SelectionSet))
+		case *ast.InlineFragment:
+			depth = max(depth, Depth(s.SelectionSet))
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				depth = max(depth, Depth(s.Definition.SelectionSet))
+			}
+		}
+	}
+	return depth
+}
@@ -0,0 +1,4 @@
+// Package limits protects the database from expensive GraphQL operations: it rejects the operations nesting fields too
+// deeply, and rate limits the requests of every client. The complexity of the operations is limited by gqlgen's
+// complexity extension, with the costs of the fields set by the schema.
+package limits
@@ -0,0 +1,38 @@
+package limits
+
+import (
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+)
+
+// Params The limits of the GraphQL operations, 0 for no limit.
+type Params struct {
+	// MaxComplexity is the most an operation may cost, adding the costs of its fields.
+	MaxComplexity int
+
+	// MaxDepth is how deeply the fields of an operation may be nested.
+	MaxDepth int
+
+	// RatePerSecond is how many requests per second a client may send, after a burst of Burst requests.
+	RatePerSecond float64
+	Burst         int
+}
+
+// DefaultParams The default limits, leaving room for the operations of the frontend. The requests are not rate limited,
+// as all the clients behind a proxy would share its limit unless it is trusted.
+func DefaultParams() Params {
+	return Params{
+		MaxComplexity: 20000,
+		MaxDepth:      15,
+		RatePerSecond: 0,
+		Burst:         60,
+	}
+}
+
+// Use Adds the complexity and depth limits to a GraphQL server.
+func Use(srv *handler.Server, params Params) {
+	if params.MaxComplexity > 0 {
+		srv.Use(extension.FixedComplexityLimit(params.MaxComplexity))
+	}
+	srv.Use(DepthLimit{MaxDepth: params.MaxDepth})
+}
@@ -0,0 +1,178 @@
+package limits
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// errRateLimit The code of the errors rejecting requests over the rate limit.
+const errRateLimit = "RATE_LIMITED"
+
+// sweepInterval How often the buckets of the clients which stopped sending requests are dropped.
+const sweepInterval = time.Minute
+
+// RateLimiter Limits the rate of the requests of every client, told apart by their IP address, with a token bucket
+// per client: a client may send a burst of requests at once, then as many per second as the rate. The requests from
+// trusted proxies are told apart by the X-Forwarded-For address they were sent for instead. All methods let every
+// request through on a nil rate limiter.
+type RateLimiter struct {
+	rate           float64
+	burst          float64
+	trustedProxies []netip.Prefix
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+// The tokens left to a client, as of when they were last updated.
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// NewRateLimiter Constructor for a rate limiter, nil when the rate is 0 for no limit.
+func NewRateLimiter(ratePerSecond float64, burst int, trustedProxies []netip.Prefix) *RateLimiter {
+	if ratePerSecond <= 0 {
+		return nil
+	}
+	return &RateLimiter{
+		rate:           ratePerSecond,
+		burst:          math.Max(float64(burst), 1),
+		trustedProxies: trustedProxies,
+		buckets:        map[string]*bucket{},
+		lastSweep:      time.Now(),
+	}
+}
+
+// ParseTrustedProxies Parses a comma separated list of the IP addresses or CIDR ranges of trusted proxies.
+func ParseTrustedProxies(list string) ([]netip.Prefix, error) {
+	var trustedProxies []netip.Prefix
+	for _, proxy := range strings.Split(list, ",") {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+		if strings.Contains(proxy, "/") {
+			prefix, err := netip.ParsePrefix(proxy)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trusted proxy range %q: %w", proxy, err)
+			}
+			trustedProxies = append(trustedProxies, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy address %q: %w", proxy, err)
+		}
+		trustedProxies = append(trustedProxies, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return trustedProxies, nil
+}
+
+// Allow Takes a token from the bucket of a client, or tells how long until one is available.
+func (l *RateLimiter) Allow(client string) (bool, time.Duration) {
+	if l == nil {
+		return true, 0
+	}
+	now := time.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.sweep(now)
+
+	b, ok := l.buckets[client]
+	if !ok {
+		b = &bucket{tokens: l.burst}
+		l.buckets[client] = b
+	} else {
+		b.tokens = math.Min(l.burst, b.tokens+now.Sub(b.updated).Seconds()*l.rate)
+	}
+	b.updated = now
+	if b.tokens < 1 {
+		return false, time.Duration((1 - b.tokens) / l.rate * float64(time.Second))
+	}
+	b.tokens--
+	return true, 0
+}
+
+// Drop the buckets which refilled since they were last updated, the same as no bucket. Must be called with the lock
+// held.
+func (l *RateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+	refill := time.Duration(l.burst / l.rate * float64(time.Second))
+	for client, b := range l.buckets {
+		if now.Sub(b.updated) >= refill {
+			delete(l.buckets, client)
+		}
+	}
+}
+
+// Middleware Rejects the requests of the clients over the rate limit with a GraphQL error, and the 429 status code.
+func (l *RateLimiter) Middleware(next http.Handler) http.Handler {
+	if l == nil {
+		return next
+	}
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		allowed, retryAfter := l.Allow(l.clientAddress(request))
+		if allowed {
+			next.ServeHTTP(writer, request)
+			return
+		}
+		seconds := int(math.Ceil(retryAfter.Seconds()))
+		rateLimitErr := gqlerror.Errorf("rate limit exceeded, retry in %ds", seconds)
+		errcode.Set(rateLimitErr, errRateLimit)
+		writer.Header().Set("Content-Type", "application/json")
+		writer.Header().Set("Retry-After", strconv.Itoa(seconds))
+		writer.WriteHeader(http.StatusTooManyRequests)
+		if err := json.NewEncoder(writer).Encode(graphql.Response{Errors: gqlerror.List{rateLimitErr}}); err != nil {
+			slog.ErrorContext(request.Context(), "failed to write response", "err", err)
+		}
+	})
+}
+
+// The IP address a request comes from. Every proxy appends the address it forwards the request for to
+// X-Forwarded-For, so it is read from the right for as long as the address is one of a trusted proxy.
+func (l *RateLimiter) clientAddress(request *http.Request) string {
+	host, _, err := net.SplitHostPort(request.RemoteAddr)
+	if err != nil {
+		host = request.RemoteAddr
+	}
+	forwarded := strings.Split(strings.Join(request.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(forwarded) - 1; i >= 0 && l.trustedProxy(host); i-- {
+		if address := strings.TrimSpace(forwarded[i]); address != "" {
+			host = address
+		}
+	}
+	return host
+}
+
+// Whether an IP address is one of a trusted proxy.
+func (l *RateLimiter) trustedProxy(host string) bool {
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range l.trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
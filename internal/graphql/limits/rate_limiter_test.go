@@ -0,0 +1,91 @@
+package limits_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildbarn/bb-portal/internal/graphql/limits"
+)
+
+func TestRateLimiter_Middleware(t *testing.T) {
+	rateLimiter := limits.NewRateLimiter(0.5, 2, nil)
+	handler := rateLimiter.Middleware(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		writer.WriteHeader(http.StatusOK)
+	}))
+	serve := func(remoteAddr string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+		request.RemoteAddr = remoteAddr
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	// A burst of two requests, from any port.
+	require.Equal(t, http.StatusOK, serve("10.0.0.1:1234").Code)
+	require.Equal(t, http.StatusOK, serve("10.0.0.1:1235").Code)
+
+	rejected := serve("10.0.0.1:1236")
+	require.Equal(t, http.StatusTooManyRequests, rejected.Code)
+	require.Equal(t, "2", rejected.Header().Get("Retry-After"))
+	var resp struct {
+		Errors []struct {
+			Message    string
+			Extensions map[string]any
+		}
+	}
+	require.NoError(t, json.Unmarshal(rejected.Body.Bytes(), &resp))
+	require.Len(t, resp.Errors, 1)
+	require.Equal(t, "rate limit exceeded, retry in 2s", resp.Errors[0].Message)
+	require.Equal(t, "RATE_LIMITED", resp.Errors[0].Extensions["code"])
+
+	// Other clients have buckets of their own.
+	require.Equal(t, http.StatusOK, serve("10.0.0.2:1234").Code)
+}
+
+func TestRateLimiter_TrustedProxies(t *testing.T) {
+	trustedProxies, err := limits.ParseTrustedProxies("10.0.0.1, 192.168.0.0/16")
+	require.NoError(t, err)
+	rateLimiter := limits.NewRateLimiter(0.5, 1, trustedProxies)
+	handler := rateLimiter.Middleware(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		writer.WriteHeader(http.StatusOK)
+	}))
+	serve := func(remoteAddr, forwardedFor string) int {
+		request := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+		request.RemoteAddr = remoteAddr
+		if forwardedFor != "" {
+			request.Header.Set("X-Forwarded-For", forwardedFor)
+		}
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		return recorder.Code
+	}
+
+	// The clients behind the trusted proxies have buckets of their own.
+	require.Equal(t, http.StatusOK, serve("10.0.0.1:1234", "203.0.113.1"))
+	require.Equal(t, http.StatusOK, serve("10.0.0.1:1234", "203.0.113.2"))
+	require.Equal(t, http.StatusTooManyRequests, serve("10.0.0.1:1234", "203.0.113.1"))
+	// Read past the chain of trusted proxies, not past the first untrusted address.
+	require.Equal(t, http.StatusOK, serve("10.0.0.1:1234", "203.0.113.3, 203.0.113.4, 192.168.1.1"))
+	require.Equal(t, http.StatusTooManyRequests, serve("10.0.0.1:1234", "203.0.113.5, 203.0.113.4"))
+	// The header is ignored on the requests of the other clients.
+	require.Equal(t, http.StatusOK, serve("10.0.0.2:1234", "203.0.113.6"))
+	require.Equal(t, http.StatusTooManyRequests, serve("10.0.0.2:1234", "203.0.113.7"))
+
+	_, err = limits.ParseTrustedProxies("10.0.0.0/33")
+	require.Error(t, err)
+	_, err = limits.ParseTrustedProxies("proxy.example.com")
+	require.Error(t, err)
+}
+
+func TestRateLimiter_NoLimit(t *testing.T) {
+	rateLimiter := limits.NewRateLimiter(0, 0, nil)
+	require.Nil(t, rateLimiter)
+	for range 100 {
+		allowed, _ := rateLimiter.Allow("10.0.0.1")
+		require.True(t, allowed)
+	}
+}
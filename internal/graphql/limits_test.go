@@ -0,0 +1,79 @@
+package graphql_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql/handler"
+	gql "github.com/machinebox/graphql"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent/enttest"
+	"github.com/buildbarn/bb-portal/internal/graphql"
+	"github.com/buildbarn/bb-portal/internal/graphql/limits"
+)
+
+func TestGraphQLAPI_LimitsAllowFrontendOperations(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:limits_frontend?mode=memory&_fk=1")
+	defer client.Close()
+	schema := graphql.NewSchema(client, graphql.SchemaParams{})
+
+	contract, err := os.ReadFile(consumerContractFile)
+	require.NoError(t, err)
+	documents := map[string]string{}
+	require.NoError(t, json.Unmarshal(contract, &documents))
+	require.NotEmpty(t, documents)
+
+	params := limits.DefaultParams()
+	for _, document := range documents {
+		query, errs := gqlparser.LoadQuery(schema.Schema(), document)
+		require.Empty(t, errs)
+		for _, operation := range query.Operations {
+			cost := complexity.Calculate(schema, operation, nil)
+			depth := limits.Depth(operation.SelectionSet)
+			t.Logf("%s has complexity %d and depth %d", operation.Name, cost, depth)
+			require.LessOrEqual(t, cost, params.MaxComplexity, operation.Name)
+			require.LessOrEqual(t, depth, params.MaxDepth, operation.Name)
+		}
+	}
+}
+
+func TestGraphQLAPI_LimitsRejectExpensiveOperations(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:limits_reject?mode=memory&_fk=1")
+	defer client.Close()
+	srv := handler.NewDefaultServer(graphql.NewSchema(client, graphql.SchemaParams{}))
+	limits.Use(srv, limits.DefaultParams())
+	server := httptest.NewServer(srv)
+	defer server.Close()
+	gqlClient := gql.NewClient(server.URL)
+
+	// Every build times every invocation times every target.
+	err := gqlClient.Run(ctx, gql.NewRequest(`{
+		findBuilds(first: 1000) {
+			edges { node { invocations { targets { label } } } }
+		}
+	}`), &struct{}{})
+	require.ErrorContains(t, err, "which exceeds the limit of 20000")
+
+	// Cheap, but nested too deeply.
+	err = gqlClient.Run(ctx, gql.NewRequest(`{
+		bazelInvocation(invocationId: "e4b98ca5-7e0b-4a6b-9a8b-1c3f5f0e7a11") {
+			metrics { bazelInvocation { metrics { bazelInvocation { metrics { bazelInvocation { metrics {
+				bazelInvocation { metrics { bazelInvocation { metrics { bazelInvocation { metrics {
+					bazelInvocation { id }
+				} } } } } } } } } } } } }
+		}
+	}`), &struct{}{})
+	require.ErrorContains(t, err, "operation has depth 16, which exceeds the limit of 15")
+
+	var resp struct {
+		FindBuilds struct{ TotalCount int }
+	}
+	require.NoError(t, gqlClient.Run(ctx, gql.NewRequest(`{ findBuilds(first: 10) { totalCount } }`), &resp))
+}
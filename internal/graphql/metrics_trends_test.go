@@ -0,0 +1,110 @@
+package graphql_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	gql "github.com/machinebox/graphql"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent/enttest"
+	"github.com/buildbarn/bb-portal/internal/graphql"
+	"github.com/buildbarn/bb-portal/pkg/processing"
+	"github.com/buildbarn/bb-portal/pkg/rollup"
+	"github.com/buildbarn/bb-portal/pkg/summary"
+)
+
+type metricsTrendsResponse struct {
+	MetricsTrends []struct {
+		Metric string
+		Points []struct {
+			Time        time.Time
+			Invocations int
+			Value       float64
+			Min         float64
+			Max         float64
+			P50         float64
+		}
+	}
+}
+
+func TestGraphQLAPI_MetricsTrends(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:metrics_trends?mode=memory&_fk=1")
+	defer client.Close()
+
+	day := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	worker := processing.New(client, nil, nil, summary.DefaultTestHealthThresholds())
+	for i, name := range []string{"nextjs_build.bep.ndjson", "nextjs_test.bep.ndjson", "nextjs_test_fail.bep.ndjson"} {
+		invocation, err := worker.ProcessFile(ctx, filepath.Join("../../pkg/summary/testdata", name))
+		require.NoError(t, err)
+		// The first two in the same hour, the last one on the next day.
+		startedAt := day.Add(time.Duration(i) * 10 * time.Minute)
+		if i == 2 {
+			startedAt = day.Add(25 * time.Hour)
+		}
+		require.NoError(t, invocation.Update().SetStartedAt(startedAt).Exec(ctx))
+	}
+	// The rollups were updated with the original start times.
+	_, err := rollup.Backfill(ctx, client)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(handler.NewDefaultServer(graphql.NewSchema(client, graphql.SchemaParams{})))
+	defer server.Close()
+	gqlClient := gql.NewClient(server.URL)
+	query := func(bucket string, filter map[string]any) metricsTrendsResponse {
+		req := gql.NewRequest(`query ($from: Time!, $to: Time, $bucket: TrendBucket!, $filter: MetricsTrendFilter) {
+			metricsTrends(metrics: [ACTIONS_EXECUTED, ACTION_CACHE_HIT_RATE], from: $from, to: $to, bucket: $bucket, filter: $filter) {
+				metric
+				points { time invocations value min max p50 }
+			}
+		}`)
+		req.Var("from", day)
+		req.Var("to", day.Add(48*time.Hour))
+		req.Var("bucket", bucket)
+		req.Var("filter", filter)
+		var resp metricsTrendsResponse
+		require.NoError(t, gqlClient.Run(ctx, req, &resp))
+		return resp
+	}
+
+	resp := query("HOUR", nil)
+	require.Len(t, resp.MetricsTrends, 2)
+	executed := resp.MetricsTrends[0]
+	require.Equal(t, "ACTIONS_EXECUTED", executed.Metric)
+	require.Len(t, executed.Points, 2)
+	require.Equal(t, day, executed.Points[0].Time)
+	require.Equal(t, 2, executed.Points[0].Invocations)
+	require.Equal(t, (executed.Points[0].Min+executed.Points[0].Max)/2, executed.Points[0].Value)
+	require.Equal(t, day.Add(25*time.Hour), executed.Points[1].Time)
+	require.Equal(t, 1, executed.Points[1].Invocations)
+	require.InEpsilon(t, executed.Points[1].Value, executed.Points[1].P50, 0.1)
+
+	hitRate := resp.MetricsTrends[1]
+	require.Equal(t, "ACTION_CACHE_HIT_RATE", hitRate.Metric)
+	require.NotEmpty(t, hitRate.Points)
+	for _, point := range hitRate.Points {
+		require.GreaterOrEqual(t, point.Value, point.Min)
+		require.LessOrEqual(t, point.Value, point.Max)
+		require.LessOrEqual(t, point.Max, 1.0)
+	}
+
+	// All the fixtures are bazel test.
+	resp = query("DAY", map[string]any{"command": "test"})
+	require.Len(t, resp.MetricsTrends[0].Points, 2)
+	require.Equal(t, day, resp.MetricsTrends[0].Points[0].Time)
+	require.Equal(t, 2, resp.MetricsTrends[0].Points[0].Invocations)
+	require.Equal(t, day.Add(24*time.Hour), resp.MetricsTrends[0].Points[1].Time)
+
+	resp = query("DAY", map[string]any{"command": "build"})
+	require.Empty(t, resp.MetricsTrends[0].Points)
+	resp = query("DAY", map[string]any{"branch": "no-such-branch"})
+	require.Empty(t, resp.MetricsTrends[0].Points)
+
+	req := gql.NewRequest(`{ metricsTrends(metrics: [ACTIONS_EXECUTED], from: "2000-01-01T00:00:00Z", bucket: HOUR) { metric } }`)
+	require.ErrorContains(t, gqlClient.Run(ctx, req, &metricsTrendsResponse{}), "too many buckets")
+}
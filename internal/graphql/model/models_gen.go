@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocationproblem"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/testhealthreport"
 	"github.com/buildbarn/bb-portal/third_party/bazel/gen/bes"
 )
 
@@ -24,23 +26,40 @@ type Problem interface {
 	IsProblem()
 	GetID() string
 	GetLabel() string
+	GetFingerprint() *string
+	GetFailureClassification() *bazelinvocationproblem.FailureClassification
+	GetNewlyFailing() *bool
+	GetKnownProblem() *ent.KnownProblem
+	GetFirstFailure() *FirstFailure
 }
 
 type ActionProblem struct {
-	ID     string         `json:"id"`
-	Label  string         `json:"label"`
-	Type   string         `json:"type"`
-	Stdout *BlobReference `json:"stdout,omitempty"`
-	Stderr *BlobReference `json:"stderr,omitempty"`
+	ID                    string                                        `json:"id"`
+	Label                 string                                        `json:"label"`
+	Fingerprint           *string                                       `json:"fingerprint,omitempty"`
+	FailureClassification *bazelinvocationproblem.FailureClassification `json:"failureClassification,omitempty"`
+	NewlyFailing          *bool                                         `json:"newlyFailing,omitempty"`
+	KnownProblem          *ent.KnownProblem                             `json:"knownProblem,omitempty"`
+	FirstFailure          *FirstFailure                                 `json:"firstFailure,omitempty"`
+	Type                  string                                        `json:"type"`
+	Stdout                *BlobReference                                `json:"stdout,omitempty"`
+	Stderr                *BlobReference                                `json:"stderr,omitempty"`
 	// The underlying BazelInvocationProblem row
 	Problem *ent.BazelInvocationProblem `json:"-"`
 }
 
 func (ActionProblem) IsNode() {}
 
-func (ActionProblem) IsProblem()            {}
-func (this ActionProblem) GetID() string    { return this.ID }
-func (this ActionProblem) GetLabel() string { return this.Label }
+func (ActionProblem) IsProblem()                   {}
+func (this ActionProblem) GetID() string           { return this.ID }
+func (this ActionProblem) GetLabel() string        { return this.Label }
+func (this ActionProblem) GetFingerprint() *string { return this.Fingerprint }
+func (this ActionProblem) GetFailureClassification() *bazelinvocationproblem.FailureClassification {
+	return this.FailureClassification
+}
+func (this ActionProblem) GetNewlyFailing() *bool             { return this.NewlyFailing }
+func (this ActionProblem) GetKnownProblem() *ent.KnownProblem { return this.KnownProblem }
+func (this ActionProblem) GetFirstFailure() *FirstFailure     { return this.FirstFailure }
 
 type BazelCommand struct {
 	ID         string `json:"id"`
@@ -63,6 +82,8 @@ type BlobReference struct {
 	DownloadURL        string             `json:"downloadURL"`
 	SizeInBytes        *int               `json:"sizeInBytes,omitempty"`
 	AvailabilityStatus ActionOutputStatus `json:"availabilityStatus"`
+	// The files in the blob when it is a zip archive, like the undeclared test outputs. Null for other blobs.
+	ZipEntries []*ZipEntry `json:"zipEntries,omitempty"`
 	// The blob being referenced
 	Blob *ent.Blob `json:"-"`
 }
@@ -78,46 +99,190 @@ type ExitCode struct {
 	Name string `json:"name"`
 }
 
+type FirstFailure struct {
+	Invocation          *ent.BazelInvocation `json:"invocation"`
+	LastGreenInvocation *ent.BazelInvocation `json:"lastGreenInvocation,omitempty"`
+	LastGreenCommit     *string              `json:"lastGreenCommit,omitempty"`
+}
+
+type InvocationDiff struct {
+	From             *ent.BazelInvocation `json:"from"`
+	To               *ent.BazelInvocation `json:"to"`
+	CommandLine      []*ValueChange       `json:"commandLine"`
+	AddedOptions     []string             `json:"addedOptions"`
+	RemovedOptions   []string             `json:"removedOptions"`
+	EnvVars          []*ValueChange       `json:"envVars"`
+	Targets          []*TargetChange      `json:"targets"`
+	Tests            []*TestStatusChange  `json:"tests"`
+	NewProblems      []Problem            `json:"newProblems"`
+	ResolvedProblems []Problem            `json:"resolvedProblems"`
+	Metrics          []*MetricDelta       `json:"metrics"`
+}
+
+// A step in the progress of an invocation, as its build events arrive.
+type InvocationProgressEvent struct {
+	Kind         InvocationProgressEventKind `json:"kind"`
+	InvocationID string                      `json:"invocationID"`
+	Time         time.Time                   `json:"time"`
+	// The target, test or action the event is about.
+	Label   *string `json:"label,omitempty"`
+	Success bool    `json:"success"`
+	// The command of a started invocation, the status of a test, the abort reason of a target, the mnemonic of a failed
+	// action, and the exit code of a finished invocation or INCOMPLETE if it could not be saved.
+	Status *string `json:"status,omitempty"`
+	// The saved invocation, on the finished event.
+	Invocation *ent.BazelInvocation `json:"invocation,omitempty"`
+}
+
+type InvocationSearchResult struct {
+	Invocation *ent.BazelInvocation `json:"invocation"`
+	// The first matching lines.
+	Matches []*SearchMatch `json:"matches"`
+}
+
+type MetricDelta struct {
+	Name  string `json:"name"`
+	From  *int   `json:"from,omitempty"`
+	To    *int   `json:"to,omitempty"`
+	Delta *int   `json:"delta,omitempty"`
+}
+
+type MetricsTrend struct {
+	Metric MetricsTrendMetric   `json:"metric"`
+	Points []*MetricsTrendPoint `json:"points"`
+}
+
+// Only the invocations matching all the given fields.
+type MetricsTrendFilter struct {
+	Branch *string `json:"branch,omitempty"`
+	// The Bazel command, like build or test.
+	Command   *string `json:"command,omitempty"`
+	StepLabel *string `json:"stepLabel,omitempty"`
+	// The LDAP or email of the user who launched the invocation.
+	User *string `json:"user,omitempty"`
+}
+
+type MetricsTrendPoint struct {
+	// The start of the bucket.
+	Time        time.Time `json:"time"`
+	Invocations int       `json:"invocations"`
+	// Rates over the totals of the invocations of the bucket, other metrics averaged per invocation.
+	Value float64 `json:"value"`
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+	// Percentiles of the values of the invocations, estimated within about 10%.
+	P50 float64 `json:"p50"`
+	P90 float64 `json:"p90"`
+	P99 float64 `json:"p99"`
+}
+
 type NamedFile struct {
 	Name string `json:"name"`
 	URL  string `json:"url"`
 }
 
 type ProgressProblem struct {
-	ID     string `json:"id"`
-	Label  string `json:"label"`
-	Output string `json:"output"`
+	ID                    string                                        `json:"id"`
+	Label                 string                                        `json:"label"`
+	Fingerprint           *string                                       `json:"fingerprint,omitempty"`
+	FailureClassification *bazelinvocationproblem.FailureClassification `json:"failureClassification,omitempty"`
+	NewlyFailing          *bool                                         `json:"newlyFailing,omitempty"`
+	KnownProblem          *ent.KnownProblem                             `json:"knownProblem,omitempty"`
+	FirstFailure          *FirstFailure                                 `json:"firstFailure,omitempty"`
+	Output                string                                        `json:"output"`
 }
 
 func (ProgressProblem) IsNode() {}
 
-func (ProgressProblem) IsProblem()            {}
-func (this ProgressProblem) GetID() string    { return this.ID }
-func (this ProgressProblem) GetLabel() string { return this.Label }
+func (ProgressProblem) IsProblem()                   {}
+func (this ProgressProblem) GetID() string           { return this.ID }
+func (this ProgressProblem) GetLabel() string        { return this.Label }
+func (this ProgressProblem) GetFingerprint() *string { return this.Fingerprint }
+func (this ProgressProblem) GetFailureClassification() *bazelinvocationproblem.FailureClassification {
+	return this.FailureClassification
+}
+func (this ProgressProblem) GetNewlyFailing() *bool             { return this.NewlyFailing }
+func (this ProgressProblem) GetKnownProblem() *ent.KnownProblem { return this.KnownProblem }
+func (this ProgressProblem) GetFirstFailure() *FirstFailure     { return this.FirstFailure }
+
+type SearchMatch struct {
+	Source SearchSource `json:"source"`
+	// The number of the line in the build logs, the problem output or the blob, from 1.
+	Line int `json:"line"`
+	// The line escaped for HTML, with the matches between <mark> and </mark>, shortened around the first match.
+	Snippet string `json:"snippet"`
+	// The progress problem of a PROBLEM_OUTPUT match.
+	Problem Problem `json:"problem,omitempty"`
+	// The blob of a BLOB match.
+	Blob *ent.Blob `json:"blob,omitempty"`
+}
+
+type Subscription struct {
+}
+
+type TargetChange struct {
+	Label       string `json:"label"`
+	FromSuccess *bool  `json:"fromSuccess,omitempty"`
+	ToSuccess   *bool  `json:"toSuccess,omitempty"`
+}
 
 type TargetProblem struct {
-	ID    string `json:"id"`
-	Label string `json:"label"`
+	ID                    string                                        `json:"id"`
+	Label                 string                                        `json:"label"`
+	Fingerprint           *string                                       `json:"fingerprint,omitempty"`
+	FailureClassification *bazelinvocationproblem.FailureClassification `json:"failureClassification,omitempty"`
+	NewlyFailing          *bool                                         `json:"newlyFailing,omitempty"`
+	KnownProblem          *ent.KnownProblem                             `json:"knownProblem,omitempty"`
+	FirstFailure          *FirstFailure                                 `json:"firstFailure,omitempty"`
 }
 
 func (TargetProblem) IsNode() {}
 
-func (TargetProblem) IsProblem()            {}
-func (this TargetProblem) GetID() string    { return this.ID }
-func (this TargetProblem) GetLabel() string { return this.Label }
+func (TargetProblem) IsProblem()                   {}
+func (this TargetProblem) GetID() string           { return this.ID }
+func (this TargetProblem) GetLabel() string        { return this.Label }
+func (this TargetProblem) GetFingerprint() *string { return this.Fingerprint }
+func (this TargetProblem) GetFailureClassification() *bazelinvocationproblem.FailureClassification {
+	return this.FailureClassification
+}
+func (this TargetProblem) GetNewlyFailing() *bool             { return this.NewlyFailing }
+func (this TargetProblem) GetKnownProblem() *ent.KnownProblem { return this.KnownProblem }
+func (this TargetProblem) GetFirstFailure() *FirstFailure     { return this.FirstFailure }
+
+type TestHealthTrend struct {
+	Label           string                 `json:"label"`
+	Issue           testhealthreport.Issue `json:"issue"`
+	Occurrences     int                    `json:"occurrences"`
+	FirstSeen       time.Time              `json:"firstSeen"`
+	LastSeen        time.Time              `json:"lastSeen"`
+	MaxDurationInMs int                    `json:"maxDurationInMs"`
+	Latest          *ent.TestHealthReport  `json:"latest"`
+}
 
 type TestProblem struct {
-	ID      string        `json:"id"`
-	Label   string        `json:"label"`
-	Status  string        `json:"status"`
-	Results []*TestResult `json:"results"`
+	ID                    string                                        `json:"id"`
+	Label                 string                                        `json:"label"`
+	Fingerprint           *string                                       `json:"fingerprint,omitempty"`
+	FailureClassification *bazelinvocationproblem.FailureClassification `json:"failureClassification,omitempty"`
+	NewlyFailing          *bool                                         `json:"newlyFailing,omitempty"`
+	KnownProblem          *ent.KnownProblem                             `json:"knownProblem,omitempty"`
+	FirstFailure          *FirstFailure                                 `json:"firstFailure,omitempty"`
+	Status                string                                        `json:"status"`
+	Results               []*TestResult                                 `json:"results"`
 }
 
 func (TestProblem) IsNode() {}
 
-func (TestProblem) IsProblem()            {}
-func (this TestProblem) GetID() string    { return this.ID }
-func (this TestProblem) GetLabel() string { return this.Label }
+func (TestProblem) IsProblem()                   {}
+func (this TestProblem) GetID() string           { return this.ID }
+func (this TestProblem) GetLabel() string        { return this.Label }
+func (this TestProblem) GetFingerprint() *string { return this.Fingerprint }
+func (this TestProblem) GetFailureClassification() *bazelinvocationproblem.FailureClassification {
+	return this.FailureClassification
+}
+func (this TestProblem) GetNewlyFailing() *bool             { return this.NewlyFailing }
+func (this TestProblem) GetKnownProblem() *ent.KnownProblem { return this.KnownProblem }
+func (this TestProblem) GetFirstFailure() *FirstFailure     { return this.FirstFailure }
 
 type TestResult struct {
 	ID                    string         `json:"id"`
@@ -135,12 +300,34 @@ type TestResult struct {
 
 func (TestResult) IsNode() {}
 
+type TestStatusChange struct {
+	Label      string  `json:"label"`
+	FromStatus *string `json:"fromStatus,omitempty"`
+	ToStatus   *string `json:"toStatus,omitempty"`
+}
+
 type User struct {
 	ID    string `json:"id"`
 	Email string `json:"Email"`
 	Ldap  string `json:"LDAP"`
 }
 
+type ValueChange struct {
+	Name string  `json:"name"`
+	From *string `json:"from,omitempty"`
+	To   *string `json:"to,omitempty"`
+}
+
+type ZipEntry struct {
+	Name                  string         `json:"name"`
+	SizeInBytes           int            `json:"sizeInBytes"`
+	CompressedSizeInBytes int            `json:"compressedSizeInBytes"`
+	Compression           ZipCompression `json:"compression"`
+	ContentType           string         `json:"contentType"`
+	// Previews the file in the browser when safe, add ?download=true to always download it.
+	DownloadURL string `json:"downloadURL"`
+}
+
 type ActionOutputStatus string
 
 const (
@@ -228,3 +415,240 @@ func (e *BuildStepStatus) UnmarshalGQL(v interface{}) error {
 func (e BuildStepStatus) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type InvocationProgressEventKind string
+
+const (
+	InvocationProgressEventKindStarted         InvocationProgressEventKind = "STARTED"
+	InvocationProgressEventKindTargetCompleted InvocationProgressEventKind = "TARGET_COMPLETED"
+	InvocationProgressEventKindTestResult      InvocationProgressEventKind = "TEST_RESULT"
+	InvocationProgressEventKindProblem         InvocationProgressEventKind = "PROBLEM"
+	InvocationProgressEventKindFinished        InvocationProgressEventKind = "FINISHED"
+)
+
+var AllInvocationProgressEventKind = []InvocationProgressEventKind{
+	InvocationProgressEventKindStarted,
+	InvocationProgressEventKindTargetCompleted,
+	InvocationProgressEventKindTestResult,
+	InvocationProgressEventKindProblem,
+	InvocationProgressEventKindFinished,
+}
+
+func (e InvocationProgressEventKind) IsValid() bool {
+	switch e {
+	case InvocationProgressEventKindStarted, InvocationProgressEventKindTargetCompleted, InvocationProgressEventKindTestResult, InvocationProgressEventKindProblem, InvocationProgressEventKindFinished:
+		return true
+	}
+	return false
+}
+
+func (e InvocationProgressEventKind) String() string {
+	return string(e)
+}
+
+func (e *InvocationProgressEventKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = InvocationProgressEventKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid InvocationProgressEventKind", str)
+	}
+	return nil
+}
+
+func (e InvocationProgressEventKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type MetricsTrendMetric string
+
+const (
+	// Remote cache hits over the actions executed.
+	MetricsTrendMetricRemoteCacheHitRate MetricsTrendMetric = "REMOTE_CACHE_HIT_RATE"
+	// Action cache hits over its lookups.
+	MetricsTrendMetricActionCacheHitRate     MetricsTrendMetric = "ACTION_CACHE_HIT_RATE"
+	MetricsTrendMetricActionsExecuted        MetricsTrendMetric = "ACTIONS_EXECUTED"
+	MetricsTrendMetricActionsCreated         MetricsTrendMetric = "ACTIONS_CREATED"
+	MetricsTrendMetricWallTimeInMs           MetricsTrendMetric = "WALL_TIME_IN_MS"
+	MetricsTrendMetricCPUTimeInMs            MetricsTrendMetric = "CPU_TIME_IN_MS"
+	MetricsTrendMetricAnalysisPhaseTimeInMs  MetricsTrendMetric = "ANALYSIS_PHASE_TIME_IN_MS"
+	MetricsTrendMetricExecutionPhaseTimeInMs MetricsTrendMetric = "EXECUTION_PHASE_TIME_IN_MS"
+	MetricsTrendMetricPeakPostGcHeapSize     MetricsTrendMetric = "PEAK_POST_GC_HEAP_SIZE"
+	MetricsTrendMetricUsedHeapSizePostBuild  MetricsTrendMetric = "USED_HEAP_SIZE_POST_BUILD"
+	MetricsTrendMetricBytesRecv              MetricsTrendMetric = "BYTES_RECV"
+	MetricsTrendMetricBytesSent              MetricsTrendMetric = "BYTES_SENT"
+)
+
+var AllMetricsTrendMetric = []MetricsTrendMetric{
+	MetricsTrendMetricRemoteCacheHitRate,
+	MetricsTrendMetricActionCacheHitRate,
+	MetricsTrendMetricActionsExecuted,
+	MetricsTrendMetricActionsCreated,
+	MetricsTrendMetricWallTimeInMs,
+	MetricsTrendMetricCPUTimeInMs,
+	MetricsTrendMetricAnalysisPhaseTimeInMs,
+	MetricsTrendMetricExecutionPhaseTimeInMs,
+	MetricsTrendMetricPeakPostGcHeapSize,
+	MetricsTrendMetricUsedHeapSizePostBuild,
+	MetricsTrendMetricBytesRecv,
+	MetricsTrendMetricBytesSent,
+}
+
+func (e MetricsTrendMetric) IsValid() bool {
+	switch e {
+	case MetricsTrendMetricRemoteCacheHitRate, MetricsTrendMetricActionCacheHitRate, MetricsTrendMetricActionsExecuted, MetricsTrendMetricActionsCreated, MetricsTrendMetricWallTimeInMs, MetricsTrendMetricCPUTimeInMs, MetricsTrendMetricAnalysisPhaseTimeInMs, MetricsTrendMetricExecutionPhaseTimeInMs, MetricsTrendMetricPeakPostGcHeapSize, MetricsTrendMetricUsedHeapSizePostBuild, MetricsTrendMetricBytesRecv, MetricsTrendMetricBytesSent:
+		return true
+	}
+	return false
+}
+
+func (e MetricsTrendMetric) String() string {
+	return string(e)
+}
+
+func (e *MetricsTrendMetric) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MetricsTrendMetric(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MetricsTrendMetric", str)
+	}
+	return nil
+}
+
+func (e MetricsTrendMetric) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SearchSource string
+
+const (
+	SearchSourceBuildLogs     SearchSource = "BUILD_LOGS"
+	SearchSourceProblemOutput SearchSource = "PROBLEM_OUTPUT"
+	SearchSourceBlob          SearchSource = "BLOB"
+)
+
+var AllSearchSource = []SearchSource{
+	SearchSourceBuildLogs,
+	SearchSourceProblemOutput,
+	SearchSourceBlob,
+}
+
+func (e SearchSource) IsValid() bool {
+	switch e {
+	case SearchSourceBuildLogs, SearchSourceProblemOutput, SearchSourceBlob:
+		return true
+	}
+	return false
+}
+
+func (e SearchSource) String() string {
+	return string(e)
+}
+
+func (e *SearchSource) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SearchSource(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SearchSource", str)
+	}
+	return nil
+}
+
+func (e SearchSource) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TrendBucket string
+
+const (
+	TrendBucketHour TrendBucket = "HOUR"
+	TrendBucketDay  TrendBucket = "DAY"
+)
+
+var AllTrendBucket = []TrendBucket{
+	TrendBucketHour,
+	TrendBucketDay,
+}
+
+func (e TrendBucket) IsValid() bool {
+	switch e {
+	case TrendBucketHour, TrendBucketDay:
+		return true
+	}
+	return false
+}
+
+func (e TrendBucket) String() string {
+	return string(e)
+}
+
+func (e *TrendBucket) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TrendBucket(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TrendBucket", str)
+	}
+	return nil
+}
+
+func (e TrendBucket) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ZipCompression string
+
+const (
+	ZipCompressionStored   ZipCompression = "STORED"
+	ZipCompressionDeflated ZipCompression = "DEFLATED"
+	ZipCompressionOther    ZipCompression = "OTHER"
+)
+
+var AllZipCompression = []ZipCompression{
+	ZipCompressionStored,
+	ZipCompressionDeflated,
+	ZipCompressionOther,
+}
+
+func (e ZipCompression) IsValid() bool {
+	switch e {
+	case ZipCompressionStored, ZipCompressionDeflated, ZipCompressionOther:
+		return true
+	}
+	return false
+}
+
+func (e ZipCompression) String() string {
+	return string(e)
+}
+
+func (e *ZipCompression) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ZipCompression(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ZipCompression", str)
+	}
+	return nil
+}
+
+func (e ZipCompression) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
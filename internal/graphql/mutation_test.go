@@ -0,0 +1,78 @@
+package graphql_test
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	gql "github.com/machinebox/graphql"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent/enttest"
+	"github.com/buildbarn/bb-portal/internal/graphql"
+	"github.com/buildbarn/bb-portal/internal/graphql/helpers"
+	"github.com/buildbarn/bb-portal/pkg/auth"
+	"github.com/buildbarn/bb-portal/pkg/processing"
+	"github.com/buildbarn/bb-portal/pkg/storage"
+	"github.com/buildbarn/bb-portal/pkg/summary"
+)
+
+func TestGraphQLAPI_AdminMutations(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:mutations?mode=memory&_fk=1")
+	defer client.Close()
+	blobStorage, err := storage.NewLocalStorage(storage.LocalStorageParams{Folder: t.TempDir()})
+	require.NoError(t, err)
+
+	invocation, err := processing.New(client, nil, nil, summary.DefaultTestHealthThresholds()).ProcessFile(ctx, filepath.Join("../../pkg/summary/testdata", "nextjs_build.bep.ndjson"))
+	require.NoError(t, err)
+	id := helpers.GraphQLIDFromTypeAndID("BazelInvocation", invocation.ID)
+
+	graphQLHandler := handler.NewDefaultServer(graphql.NewSchema(client, graphql.SchemaParams{
+		GarbageCollector: processing.NewGarbageCollector(client, blobStorage, processing.DefaultRetentionParams()),
+	}))
+	server := httptest.NewServer(auth.NewAdminAuthorizer([]string{"secret"}).Middleware(graphQLHandler))
+	defer server.Close()
+	gqlClient := gql.NewClient(server.URL)
+
+	mutate := func(token, query string) (map[string]any, error) {
+		req := gql.NewRequest(query)
+		req.Var("id", id)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		var resp map[string]any
+		err := gqlClient.Run(ctx, req, &resp)
+		return resp, err
+	}
+
+	const pin = `mutation ($id: ID!) { setInvocationPinned(id: $id, pinned: true) { pinned } }`
+	_, err = mutate("", pin)
+	require.ErrorContains(t, err, "forbidden")
+	_, err = mutate("wrong", pin)
+	require.ErrorContains(t, err, "forbidden")
+	require.False(t, client.BazelInvocation.GetX(ctx, invocation.ID).Pinned)
+
+	resp, err := mutate("secret", pin)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"setInvocationPinned": map[string]any{"pinned": true}}, resp)
+	require.True(t, client.BazelInvocation.GetX(ctx, invocation.ID).Pinned)
+
+	// No blob archiving pool was given to the schema.
+	_, err = mutate("secret", `mutation { retryFailedBlobArchiving }`)
+	require.ErrorContains(t, err, "not available")
+	// Nor a workflow.
+	_, err = mutate("secret", `mutation ($id: ID!) { resummarizeInvocation(id: $id) { id } }`)
+	require.ErrorContains(t, err, "not available")
+
+	_, err = mutate("secret", fmt.Sprintf(`mutation { deleteBuild(id: %q) }`, helpers.GraphQLIDFromTypeAndID("Build", 404)))
+	require.ErrorContains(t, err, "not found")
+
+	resp, err = mutate("secret", `mutation ($id: ID!) { deleteInvocation(id: $id) }`)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"deleteInvocation": id}, resp)
+	require.Zero(t, client.BazelInvocation.Query().CountX(ctx))
+}
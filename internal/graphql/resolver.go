@@ -1,25 +1,75 @@
 package graphql
 
 import (
+	"context"
+	"errors"
+
 	"github.com/99designs/gqlgen/graphql"
 
 	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/internal/graphql/dataloader"
 	"github.com/buildbarn/bb-portal/internal/graphql/helpers"
+	"github.com/buildbarn/bb-portal/pkg/auth"
+	"github.com/buildbarn/bb-portal/pkg/blobs"
+	"github.com/buildbarn/bb-portal/pkg/processing"
+	"github.com/buildbarn/bb-portal/pkg/progress"
 )
 
 // This file will not be regenerated automatically.
 //
 // It serves as dependency injection for your app, add any dependencies you require here.
 
+// Error helpers.
+var (
+	errForbidden   = errors.New("forbidden: an administration token is required")
+	errUnavailable = errors.New("not available on this server")
+)
+
+// SchemaParams The services used by the resolvers besides the database. The fields needing a service which is nil
+// return an error, or null. Invocations are resummarized with the Workflow.
+type SchemaParams struct {
+	BlobOpener        *blobs.Opener
+	GarbageCollector  *processing.GarbageCollector
+	BlobArchivingPool *processing.BlobArchivingPool
+	ProgressHub       *progress.Hub
+	Workflow          *processing.Workflow
+}
+
 // The Resolver Type for DI
 type Resolver struct {
-	client *ent.Client
-	helper *helpers.Helper
+	client            *ent.Client
+	helper            *helpers.Helper
+	blobOpener        *blobs.Opener
+	garbageCollector  *processing.GarbageCollector
+	blobArchivingPool *processing.BlobArchivingPool
+	progressHub       *progress.Hub
+	workflow          *processing.Workflow
+}
+
+// NewSchema creates a graphql executable schema, batching the lookups of the resolvers of every request, with the
+// complexity of the fields loading lists multiplied by their estimated length.
+func NewSchema(client *ent.Client, params SchemaParams) graphql.ExecutableSchema {
+	return dataloader.Schema(NewExecutableSchema(Config{
+		Resolvers: &Resolver{
+			client:            client,
+			helper:            helpers.NewHelper(),
+			blobOpener:        params.BlobOpener,
+			garbageCollector:  params.GarbageCollector,
+			blobArchivingPool: params.BlobArchivingPool,
+			progressHub:       params.ProgressHub,
+			workflow:          params.Workflow,
+		},
+		Directives: DirectiveRoot{
+			Admin: adminDirective,
+		},
+		Complexity: complexity(),
+	}), client)
 }
 
-// NewSchema creates a graphql executable schema.
-func NewSchema(client *ent.Client) graphql.ExecutableSchema {
-	return NewExecutableSchema(Config{
-		Resolvers: &Resolver{client: client, helper: helpers.NewHelper()},
-	})
+// The @admin directive, only resolving the fields for requests authorized for administration.
+func adminDirective(ctx context.Context, _ interface{}, next graphql.Resolver) (interface{}, error) {
+	if !auth.IsAdmin(ctx) {
+		return nil, errForbidden
+	}
+	return next(ctx)
 }
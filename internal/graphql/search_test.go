@@ -0,0 +1,67 @@
+package graphql_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	gql "github.com/machinebox/graphql"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent/enttest"
+	"github.com/buildbarn/bb-portal/internal/graphql"
+	"github.com/buildbarn/bb-portal/pkg/processing"
+	"github.com/buildbarn/bb-portal/pkg/search"
+	"github.com/buildbarn/bb-portal/pkg/summary"
+)
+
+type searchInvocationsResponse struct {
+	SearchInvocations []struct {
+		Invocation struct {
+			InvocationID string
+		}
+		Matches []struct {
+			Source  string
+			Line    int
+			Snippet string
+			Problem *struct {
+				Typename string `json:"__typename"`
+			}
+		}
+	}
+}
+
+func TestGraphQLAPI_SearchInvocations(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:search_invocations?mode=memory&_fk=1")
+	defer client.Close()
+	require.NoError(t, search.Migrate(ctx, client))
+
+	invocation, err := processing.New(client, nil, nil, summary.DefaultTestHealthThresholds()).ProcessFile(ctx, filepath.Join("../../pkg/summary/testdata", "nextjs_error_progress.bep.ndjson"))
+	require.NoError(t, err)
+
+	server := httptest.NewServer(handler.NewDefaultServer(graphql.NewSchema(client, graphql.SchemaParams{})))
+	defer server.Close()
+	req := gql.NewRequest(`query ($query: String!) {
+		searchInvocations(query: $query, sources: [PROBLEM_OUTPUT], first: 5) {
+			invocation { invocationID }
+			matches { source line snippet problem { __typename } }
+		}
+	}`)
+	req.Var("query", `"no such package"`)
+	var resp searchInvocationsResponse
+	require.NoError(t, gql.NewClient(server.URL).Run(ctx, req, &resp))
+
+	require.Len(t, resp.SearchInvocations, 1)
+	result := resp.SearchInvocations[0]
+	require.Equal(t, invocation.InvocationID.String(), result.Invocation.InvocationID)
+	require.NotEmpty(t, result.Matches)
+	match := result.Matches[0]
+	require.Equal(t, "PROBLEM_OUTPUT", match.Source)
+	require.Equal(t, 1, match.Line)
+	require.Contains(t, match.Snippet, "ERROR: <mark>no such package</mark> &#39;next.js/pages2&#39;")
+	require.NotNil(t, match.Problem)
+	require.Equal(t, "ProgressProblem", match.Problem.Typename)
+}
@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// adminContextKey The key of the context value marking a request as authorized for administration.
+type adminContextKey struct{}
+
+// AdminAuthorizer Authorizes the administration requests bearing one of a set of tokens, as
+// Authorization: Bearer <token>.
+type AdminAuthorizer struct {
+	tokens []string
+}
+
+// NewAdminAuthorizer Constructor for an administration authorizer.
+func NewAdminAuthorizer(tokens []string) *AdminAuthorizer {
+	return &AdminAuthorizer{tokens: tokens}
+}
+
+// LoadAdminAuthorizer Loads the administration tokens from a file, one per line.
+func LoadAdminAuthorizer(path string) (*AdminAuthorizer, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read administration tokens: %w", err)
+	}
+	var tokens []string
+	for _, line := range strings.Split(string(content), "\n") {
+		if token := strings.TrimSpace(line); token != "" {
+			tokens = append(tokens, token)
+		}
+	}
+	return NewAdminAuthorizer(tokens), nil
+}
+
+// Middleware Marks the requests bearing an administration token as authorized in their context.
+func (a *AdminAuthorizer) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if a.authorized(request) {
+			request = request.WithContext(WithAdmin(request.Context()))
+		}
+		next.ServeHTTP(writer, request)
+	})
+}
+
+// Check if a request bears an administration token.
+func (a *AdminAuthorizer) authorized(request *http.Request) bool {
+	token, ok := strings.CutPrefix(request.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return false
+	}
+	for _, adminToken := range a.tokens {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// WithAdmin Marks a context as authorized for administration.
+func WithAdmin(ctx context.Context) context.Context {
+	return context.WithValue(ctx, adminContextKey{}, true)
+}
+
+// IsAdmin Checks if a context is authorized for administration.
+func IsAdmin(ctx context.Context) bool {
+	admin, _ := ctx.Value(adminContextKey{}).(bool)
+	return admin
+}
@@ -4,14 +4,45 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os/exec"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/shlex"
+	"golang.org/x/sync/singleflight"
 	"google.golang.org/grpc/credentials"
 )
 
+// errNoURI An error helper.
+var errNoURI = errors.New("no URI to get credentials for")
+
+// errEmptyCommand An error helper.
+var errEmptyCommand = errors.New("empty credential helper command")
+
+// CredentialsHelperParams The parameters of a credential helper, with the same meaning as Bazel's
+// --credential_helper, --credential_helper_timeout and --credential_helper_cache_duration.
+type CredentialsHelperParams struct {
+	// Command is the credential helper, run with the get argument.
+	Command string
+
+	// Timeout is how long the credential helper may run.
+	Timeout time.Duration
+
+	// CacheDuration is how long credentials are cached when the credential helper does not tell when they expire.
+	CacheDuration time.Duration
+}
+
+// DefaultCredentialsHelperParams The default parameters of a credential helper, the same as Bazel's.
+func DefaultCredentialsHelperParams() CredentialsHelperParams {
+	return CredentialsHelperParams{
+		Timeout:       10 * time.Second,
+		CacheDuration: 30 * time.Minute,
+	}
+}
+
 // A credentials Helper request struct.
 type credentialHelperRequest struct {
 	URI string `json:"uri"`
@@ -20,21 +51,66 @@ type credentialHelperRequest struct {
 // A credential helpers response struct.
 type credentialHelperResponse struct {
 	Headers map[string][]string `json:"headers"`
+	Expires *time.Time          `json:"expires,omitempty"`
 }
 
-// A credential Helpers struct.
+// Credentials cached for a URI until they expire.
+type cachedCredentials struct {
+	headers map[string]string
+	expires time.Time
+}
+
+// A credential Helpers struct, which caches the credentials per URI and runs a single credential helper at a time
+// per URI.
 type credentialsHelper struct {
-	command string
+	params CredentialsHelperParams
+
+	mu    sync.Mutex
+	cache map[string]cachedCredentials
+	calls singleflight.Group
 }
 
 // NewCredentialsHelper Credential Helper constructor.
-func NewCredentialsHelper(command string) credentials.PerRPCCredentials {
-	return &credentialsHelper{command: command}
+func NewCredentialsHelper(params CredentialsHelperParams) credentials.PerRPCCredentials {
+	return &credentialsHelper{params: params, cache: map[string]cachedCredentials{}}
 }
 
-// GetRequestMetadata Get the Request Metadata.
-func (h credentialsHelper) GetRequestMetadata(_ context.Context, uri ...string) (map[string]string, error) {
-	resp, err := h.getCredentialsFromHelper(uri[0])
+// GetRequestMetadata Get the Request Metadata, from the cache unless the credentials expired.
+func (h *credentialsHelper) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	if len(uri) == 0 || uri[0] == "" {
+		return nil, errNoURI
+	}
+	h.mu.Lock()
+	cached, ok := h.cache[uri[0]]
+	if ok && !time.Now().Before(cached.expires) {
+		delete(h.cache, uri[0])
+		ok = false
+	}
+	h.mu.Unlock()
+	if ok {
+		return cached.headers, nil
+	}
+
+	// The credential helper is not run with the context of the request, as concurrent requests share its result.
+	result := h.calls.DoChan(uri[0], func() (any, error) {
+		return h.refresh(uri[0])
+	})
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("could not get credentials for %s: %w", uri[0], ctx.Err())
+	case r := <-result:
+		if r.Err != nil {
+			return nil, r.Err
+		}
+		headers, _ := r.Val.(map[string]string)
+		return headers, nil
+	}
+}
+
+// Run the credential helper for a URI, and cache its credentials. The credentials of the other URIs which expired are
+// dropped, as they may not be asked for again.
+func (h *credentialsHelper) refresh(uri string) (map[string]string, error) {
+	resp, err := h.getCredentialsFromHelper(uri)
 	if err != nil {
 		return nil, err
 	}
@@ -42,27 +118,51 @@ func (h credentialsHelper) GetRequestMetadata(_ context.Context, uri ...string)
 	for key, values := range resp.Headers {
 		headers[key] = strings.Join(values, ",")
 	}
+	expires := time.Now().Add(h.params.CacheDuration)
+	if resp.Expires != nil {
+		expires = *resp.Expires
+	}
+	now := time.Now()
+	h.mu.Lock()
+	for cachedURI, cached := range h.cache {
+		if !now.Before(cached.expires) {
+			delete(h.cache, cachedURI)
+		}
+	}
+	h.cache[uri] = cachedCredentials{headers: headers, expires: expires}
+	h.mu.Unlock()
 	return headers, nil
 }
 
 // Get credentials from helper function.
-func (h credentialsHelper) getCredentialsFromHelper(uri string) (*credentialHelperResponse, error) {
+func (h *credentialsHelper) getCredentialsFromHelper(uri string) (*credentialHelperResponse, error) {
 	reqBytes, err := json.Marshal(credentialHelperRequest{
 		URI: uri,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("could not marshal credential helper request: %w", err)
 	}
-	var out bytes.Buffer
-	parts, err := shlex.Split(h.command)
+	parts, err := shlex.Split(h.params.Command)
 	if err != nil {
 		return nil, fmt.Errorf("could not parse command: %w", err)
 	}
-	cmd := exec.Command(parts[0], parts[1:]...) //nolint:gosec // G204 - Trusted input from local user.
+	if len(parts) == 0 {
+		return nil, errEmptyCommand
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), h.params.Timeout)
+	defer cancel()
+	var out, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, parts[0], append(parts[1:], "get")...) //nolint:gosec // G204 - Trusted input from local user.
 	cmd.Stdin = bytes.NewReader(reqBytes)
 	cmd.Stdout = &out
+	cmd.Stderr = &stderr
+	// Do not wait for the children of a helper which timed out to close its output.
+	cmd.WaitDelay = time.Second
 	if err = cmd.Run(); err != nil {
-		return nil, fmt.Errorf("error running credential helper: %w", err)
+		if ctx.Err() != nil {
+			err = fmt.Errorf("timed out after %s: %w", h.params.Timeout, ctx.Err())
+		}
+		return nil, fmt.Errorf("error running credential helper for %s: %w: %s", uri, err, strings.TrimSpace(stderr.String()))
 	}
 	var resp credentialHelperResponse
 	err = json.Unmarshal(out.Bytes(), &resp)
@@ -73,6 +173,6 @@ func (h credentialsHelper) getCredentialsFromHelper(uri string) (*credentialHelp
 }
 
 // RequireTransportSecurity Require Transport Security function.
-func (h credentialsHelper) RequireTransportSecurity() bool {
+func (h *credentialsHelper) RequireTransportSecurity() bool {
 	return false
 }
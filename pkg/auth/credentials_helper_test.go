@@ -0,0 +1,125 @@
+package auth_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildbarn/bb-portal/pkg/auth"
+)
+
+// writeHelper Write a credential helper script, which counts its runs in a file next to it.
+func writeHelper(t *testing.T, body string) (string, func() int) {
+	dir := t.TempDir()
+	script := filepath.Join(dir, "helper.sh")
+	runs := filepath.Join(dir, "runs")
+	content := "#!/bin/sh\n[ \"$1\" = get ] || exit 2\necho run >> " + runs + "\n" + body + "\n"
+	require.NoError(t, os.WriteFile(script, []byte(content), 0o700))
+	return script, func() int {
+		content, err := os.ReadFile(runs)
+		if os.IsNotExist(err) {
+			return 0
+		}
+		require.NoError(t, err)
+		return strings.Count(string(content), "run")
+	}
+}
+
+func TestCredentialsHelper_Caching(t *testing.T) {
+	ctx := context.Background()
+	script, runs := writeHelper(t, `sleep 0.2; echo '{"headers": {"Authorization": ["Bearer token"]}}'`)
+	params := auth.DefaultCredentialsHelperParams()
+	params.Command = script
+	helper := auth.NewCredentialsHelper(params)
+
+	// Concurrent requests for a URI share a single run of the helper.
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			headers, err := helper.GetRequestMetadata(ctx, "https://cas.example.com")
+			require.NoError(t, err)
+			require.Equal(t, map[string]string{"Authorization": "Bearer token"}, headers)
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, 1, runs())
+
+	_, err := helper.GetRequestMetadata(ctx, "https://cas.example.com")
+	require.NoError(t, err)
+	require.Equal(t, 1, runs())
+	_, err = helper.GetRequestMetadata(ctx, "https://other.example.com")
+	require.NoError(t, err)
+	require.Equal(t, 2, runs())
+
+	_, err = helper.GetRequestMetadata(ctx)
+	require.Error(t, err)
+	_, err = helper.GetRequestMetadata(ctx, "")
+	require.Error(t, err)
+}
+
+func TestCredentialsHelper_Expires(t *testing.T) {
+	ctx := context.Background()
+	script, runs := writeHelper(t, `echo '{"headers": {"Authorization": ["Bearer token"]}, "expires": "2000-01-01T00:00:00Z"}'`)
+	params := auth.DefaultCredentialsHelperParams()
+	params.Command = script
+	helper := auth.NewCredentialsHelper(params)
+
+	for range 2 {
+		_, err := helper.GetRequestMetadata(ctx, "https://cas.example.com")
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, runs())
+}
+
+func TestCredentialsHelper_PrunesExpired(t *testing.T) {
+	ctx := context.Background()
+	script, runs := writeHelper(t, `echo '{"headers": {"Authorization": ["Bearer token"]}}'`)
+	params := auth.DefaultCredentialsHelperParams()
+	params.Command = script
+	params.CacheDuration = 500 * time.Millisecond
+	helper := auth.NewCredentialsHelper(params)
+
+	for _, uri := range []string{"https://a.example.com", "https://b.example.com"} {
+		_, err := helper.GetRequestMetadata(ctx, uri)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, auth.CachedURIs(helper))
+
+	// Dropped when the credentials of another URI are cached.
+	time.Sleep(600 * time.Millisecond)
+	_, err := helper.GetRequestMetadata(ctx, "https://c.example.com")
+	require.NoError(t, err)
+	require.Equal(t, 1, auth.CachedURIs(helper))
+	require.Equal(t, 3, runs())
+}
+
+func TestCredentialsHelper_Errors(t *testing.T) {
+	ctx := context.Background()
+	script, _ := writeHelper(t, `echo 'no credentials' >&2; exit 1`)
+	params := auth.DefaultCredentialsHelperParams()
+	params.Command = script
+	_, err := auth.NewCredentialsHelper(params).GetRequestMetadata(ctx, "https://cas.example.com")
+	require.ErrorContains(t, err, "no credentials")
+
+	script, _ = writeHelper(t, `sleep 5`)
+	params.Command = script
+	params.Timeout = 100 * time.Millisecond
+	_, err = auth.NewCredentialsHelper(params).GetRequestMetadata(ctx, "https://cas.example.com")
+	require.ErrorContains(t, err, "timed out")
+
+	script, _ = writeHelper(t, `sleep 5`)
+	params.Command = script
+	params.Timeout = time.Minute
+	canceled, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	_, err = auth.NewCredentialsHelper(params).GetRequestMetadata(canceled, "https://cas.example.com")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,11 @@
+package auth
+
+import "google.golang.org/grpc/credentials"
+
+// CachedURIs exposes the number of URIs a credential helper caches credentials for to the tests.
+func CachedURIs(helper credentials.PerRPCCredentials) int {
+	h := helper.(*credentialsHelper)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.cache)
+}
@@ -0,0 +1,3 @@
+// Package blobs opens the blobs referenced from problems, wherever they are stored, and browses the zip archives
+// among them.
+package blobs
@@ -0,0 +1,28 @@
+package blobs
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/buildbarn/bb-portal/pkg/cas"
+)
+
+// Key The stable key of a blob in URLs: <hash>-<size> for a blob in a CAS, the same for all the blobs with that
+// content, else the SHA-256 of its URI.
+func Key(uri string) string {
+	if parsed, err := url.Parse(uri); err == nil && parsed.Scheme == "bytestream" {
+		if d, err := cas.DigestFromURI(parsed); err == nil {
+			return fmt.Sprintf("%s-%d", d.Hash, d.Size)
+		}
+	}
+	hash := sha256.Sum256([]byte(uri))
+	return hex.EncodeToString(hash[:])
+}
+
+// IsDigestKey Checks if a key is the digest of the content of a blob, rather than the hash of its URI.
+func IsDigestKey(key string) bool {
+	return strings.Contains(key, "-")
+}
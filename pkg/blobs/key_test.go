@@ -0,0 +1,21 @@
+package blobs_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildbarn/bb-portal/pkg/blobs"
+)
+
+func TestKey(t *testing.T) {
+	key := blobs.Key("bytestream://cas.example.com/instance/blobs/e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855/42")
+	require.Equal(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855-42", key)
+	require.True(t, blobs.IsDigestKey(key))
+	require.Equal(t, key, blobs.Key("bytestream://other.example.com/blobs/e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855/42"))
+
+	key = blobs.Key("file:///tmp/test.log")
+	require.Len(t, key, 64)
+	require.False(t, blobs.IsDigestKey(key))
+	require.NotEqual(t, key, blobs.Key("file:///tmp/other.log"))
+}
@@ -0,0 +1,108 @@
+package blobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"sync"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
+	"github.com/buildbarn/bb-portal/pkg/cas"
+	"github.com/buildbarn/bb-portal/pkg/storage"
+)
+
+// errUnsupportedScheme An error helper.
+var errUnsupportedScheme = errors.New("unsupported URI scheme")
+
+// Opener Opens blobs from the blob storage once archived, or from where they were uploaded until then.
+type Opener struct {
+	casManager  *cas.ConnectionManager
+	blobStorage storage.BlobStorage
+}
+
+// NewOpener Constructor for a blob opener.
+func NewOpener(casManager *cas.ConnectionManager, blobStorage storage.BlobStorage) *Opener {
+	return &Opener{casManager: casManager, blobStorage: blobStorage}
+}
+
+// File An opened blob, which can be read at any offset. Blobs are read where they are stored, from the offsets being
+// read, instead of being copied to this host.
+type File struct {
+	reader io.ReadSeekCloser
+	size   int64
+
+	mu sync.Mutex
+}
+
+// ReadAt Reads at an offset, by seeking to it unless the storage of the blob can read at an offset by itself.
+func (f *File) ReadAt(p []byte, offset int64) (int, error) {
+	if readerAt, ok := f.reader.(io.ReaderAt); ok {
+		return readerAt.ReadAt(p, offset)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, err := f.reader.Seek(offset, io.SeekStart); err != nil {
+		return 0, err
+	}
+	return io.ReadFull(f.reader, p)
+}
+
+// Close Closes the blob.
+func (f *File) Close() error {
+	return f.reader.Close()
+}
+
+// Size The size of the blob.
+func (f *File) Size() int64 {
+	return f.size
+}
+
+// Open Opens a blob. Truncated archived copies of blobs do not hold whole zips, so these blobs are opened from where
+// they were uploaded.
+func (o *Opener) Open(ctx context.Context, b *ent.Blob) (*File, error) {
+	if b.ArchivingStatus == blob.ArchivingStatusSUCCESS && b.Reason == "" {
+		reader, err := o.blobStorage.Get(ctx, b.ArchiveURL)
+		if err != nil {
+			return nil, err
+		}
+		return newFile(reader)
+	}
+
+	uri, err := url.Parse(b.URI)
+	if err != nil {
+		return nil, fmt.Errorf("blob %d had an invalid URI: %s: %w", b.ID, b.URI, err)
+	}
+	switch uri.Scheme {
+	case "file":
+		file, err := os.Open(uri.Path)
+		if err != nil {
+			return nil, fmt.Errorf("could not open blob %d: %w", b.ID, err)
+		}
+		return newFile(file)
+	case "bytestream":
+		d, err := cas.DigestFromURI(uri)
+		if err != nil {
+			return nil, err
+		}
+		casClient, err := o.casManager.GetClientForURI(ctx, uri)
+		if err != nil {
+			return nil, err
+		}
+		return newFile(casClient.NewBlobReader(ctx, d))
+	default:
+		return nil, fmt.Errorf("blob %d: %w: %s", b.ID, errUnsupportedScheme, uri.Scheme)
+	}
+}
+
+// Wrap the reader of a blob, measuring it.
+func newFile(reader io.ReadSeekCloser) (*File, error) {
+	size, err := reader.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("could not get the size of blob: %w", err), reader.Close())
+	}
+	return &File{reader: reader, size: size}, nil
+}
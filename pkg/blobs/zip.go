@@ -0,0 +1,146 @@
+package blobs
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+)
+
+// ErrZipEntryNotFound is returned when a zip archive has no entry with the requested name.
+var ErrZipEntryNotFound = errors.New("zip entry not found")
+
+// ZipCompression enum.
+type ZipCompression string
+
+// ZipCompression values.
+const (
+	ZipCompressionStored   ZipCompression = "STORED"
+	ZipCompressionDeflated ZipCompression = "DEFLATED"
+	ZipCompressionOther    ZipCompression = "OTHER"
+)
+
+// ZipEntry A file in a zip archive.
+type ZipEntry struct {
+	Name                string
+	SizeBytes           int64
+	CompressedSizeBytes int64
+	Compression         ZipCompression
+	Modified            time.Time
+	ContentType         string
+}
+
+// IsZip Checks if a blob is a zip archive from its name.
+func IsZip(name string) bool {
+	return strings.EqualFold(path.Ext(name), ".zip")
+}
+
+// ListZipEntries Lists the files in a zip archive, reading only its central directory.
+func ListZipEntries(r io.ReaderAt, size int64) ([]ZipEntry, error) {
+	zipReader, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("could not read zip archive: %w", err)
+	}
+	entries := make([]ZipEntry, 0, len(zipReader.File))
+	for _, file := range zipReader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		entries = append(entries, ZipEntry{
+			Name:                file.Name,
+			SizeBytes:           int64(file.UncompressedSize64),
+			CompressedSizeBytes: int64(file.CompressedSize64),
+			Compression:         zipCompression(file.Method),
+			Modified:            file.Modified,
+			ContentType:         ContentType(file.Name),
+		})
+	}
+	return entries, nil
+}
+
+// FindZipEntry Finds a file in a zip archive by name.
+func FindZipEntry(r io.ReaderAt, size int64, name string) (*zip.File, error) {
+	zipReader, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("could not read zip archive: %w", err)
+	}
+	for _, file := range zipReader.File {
+		if file.Name == name && !file.FileInfo().IsDir() {
+			return file, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrZipEntryNotFound, name)
+}
+
+// OpenStoredZipEntry Gets a seekable reader of a file stored uncompressed in a zip archive, nil if it is compressed.
+func OpenStoredZipEntry(r io.ReaderAt, file *zip.File) (io.ReadSeeker, error) {
+	if file.Method != zip.Store {
+		return nil, nil
+	}
+	offset, err := file.DataOffset()
+	if err != nil {
+		return nil, fmt.Errorf("could not find zip entry %s: %w", file.Name, err)
+	}
+	return io.NewSectionReader(r, offset, int64(file.CompressedSize64)), nil
+}
+
+// ContentType The content type of a file from its extension, application/octet-stream if unknown.
+func ContentType(name string) string {
+	if contentType := mime.TypeByExtension(path.Ext(name)); contentType != "" {
+		return contentType
+	}
+	return "application/octet-stream"
+}
+
+// DetectContentType The content type of a file from its extension, or else from its first bytes, after which the
+// content is rewound.
+func DetectContentType(name string, content io.ReadSeeker) (string, error) {
+	if contentType := mime.TypeByExtension(path.Ext(name)); contentType != "" {
+		return contentType, nil
+	}
+	head := make([]byte, 512)
+	n, err := io.ReadFull(content, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", fmt.Errorf("could not detect the content type of %s: %w", name, err)
+	}
+	if _, err = content.Seek(0, io.SeekStart); err != nil {
+		return "", fmt.Errorf("could not detect the content type of %s: %w", name, err)
+	}
+	return http.DetectContentType(head[:n]), nil
+}
+
+// PreviewContentType The content type to preview a file with in a browser, false if it is not safe to preview. Text
+// that a browser would render, like HTML, is previewed as plain text.
+func PreviewContentType(contentType string) (string, bool) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", false
+	}
+	switch {
+	case mediaType == "application/json":
+		return "application/json", true
+	case strings.HasPrefix(mediaType, "text/"), mediaType == "application/xml":
+		return "text/plain; charset=utf-8", true
+	case mediaType == "image/png", mediaType == "image/jpeg", mediaType == "image/gif", mediaType == "image/webp":
+		return mediaType, true
+	default:
+		return "", false
+	}
+}
+
+// Get the compression of a zip entry from its method.
+func zipCompression(method uint16) ZipCompression {
+	switch method {
+	case zip.Store:
+		return ZipCompressionStored
+	case zip.Deflate:
+		return ZipCompressionDeflated
+	default:
+		return ZipCompressionOther
+	}
+}
@@ -0,0 +1,132 @@
+package blobs_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
+	"github.com/buildbarn/bb-portal/pkg/blobs"
+	"github.com/buildbarn/bb-portal/pkg/storage"
+)
+
+// testZip Create a zip archive with a stored and a deflated file.
+func testZip(t *testing.T) []byte {
+	var buf bytes.Buffer
+	zipWriter := zip.NewWriter(&buf)
+	stored, err := zipWriter.CreateHeader(&zip.FileHeader{Name: "logs/stored.txt", Method: zip.Store})
+	require.NoError(t, err)
+	_, err = stored.Write([]byte("stored content"))
+	require.NoError(t, err)
+	_, err = zipWriter.Create("dir/")
+	require.NoError(t, err)
+	deflated, err := zipWriter.Create("report.json")
+	require.NoError(t, err)
+	_, err = deflated.Write(bytes.Repeat([]byte(`{"ok":true}`), 100))
+	require.NoError(t, err)
+	require.NoError(t, zipWriter.Close())
+	return buf.Bytes()
+}
+
+func TestZipEntries(t *testing.T) {
+	content := testZip(t)
+	r := bytes.NewReader(content)
+
+	entries, err := blobs.ListZipEntries(r, int64(len(content)))
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "logs/stored.txt", entries[0].Name)
+	require.Equal(t, int64(14), entries[0].SizeBytes)
+	require.Equal(t, blobs.ZipCompressionStored, entries[0].Compression)
+	require.Equal(t, "text/plain; charset=utf-8", entries[0].ContentType)
+	require.Equal(t, "report.json", entries[1].Name)
+	require.Equal(t, int64(1100), entries[1].SizeBytes)
+	require.Less(t, entries[1].CompressedSizeBytes, entries[1].SizeBytes)
+	require.Equal(t, blobs.ZipCompressionDeflated, entries[1].Compression)
+
+	entry, err := blobs.FindZipEntry(r, int64(len(content)), "logs/stored.txt")
+	require.NoError(t, err)
+	stored, err := blobs.OpenStoredZipEntry(r, entry)
+	require.NoError(t, err)
+	_, err = stored.Seek(7, io.SeekStart)
+	require.NoError(t, err)
+	rest, err := io.ReadAll(stored)
+	require.NoError(t, err)
+	require.Equal(t, "content", string(rest))
+
+	entry, err = blobs.FindZipEntry(r, int64(len(content)), "report.json")
+	require.NoError(t, err)
+	stored, err = blobs.OpenStoredZipEntry(r, entry)
+	require.NoError(t, err)
+	require.Nil(t, stored)
+
+	_, err = blobs.FindZipEntry(r, int64(len(content)), "dir/")
+	require.ErrorIs(t, err, blobs.ErrZipEntryNotFound)
+	_, err = blobs.ListZipEntries(bytes.NewReader([]byte("not a zip")), 9)
+	require.Error(t, err)
+}
+
+func TestPreviewContentType(t *testing.T) {
+	for contentType, expected := range map[string]string{
+		"text/plain; charset=utf-8": "text/plain; charset=utf-8",
+		"text/html; charset=utf-8":  "text/plain; charset=utf-8",
+		"application/json":          "application/json",
+		"image/png":                 "image/png",
+		"image/svg+xml":             "",
+		"application/octet-stream":  "",
+	} {
+		previewContentType, ok := blobs.PreviewContentType(contentType)
+		require.Equal(t, expected != "", ok, contentType)
+		require.Equal(t, expected, previewContentType, contentType)
+	}
+}
+
+func TestOpener_Open_CompressedStorage(t *testing.T) {
+	ctx := context.Background()
+	blobStorage, err := storage.NewLocalStorage(storage.LocalStorageParams{Folder: t.TempDir(), Compress: true})
+	require.NoError(t, err)
+	content := testZip(t)
+	require.NoError(t, blobStorage.Put(ctx, "ab/test", bytes.NewReader(content), int64(len(content))))
+
+	file, err := blobs.NewOpener(nil, blobStorage).Open(ctx, &ent.Blob{
+		ArchivingStatus: blob.ArchivingStatusSUCCESS,
+		ArchiveURL:      "ab/test",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(len(content)), file.Size())
+	entries, err := blobs.ListZipEntries(file, file.Size())
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.NoError(t, file.Close())
+}
+
+func TestOpener_Open_Truncated(t *testing.T) {
+	ctx := context.Background()
+	blobStorage, err := storage.NewLocalStorage(storage.LocalStorageParams{Folder: t.TempDir()})
+	require.NoError(t, err)
+	content := testZip(t)
+	require.NoError(t, blobStorage.Put(ctx, "ab/test-truncated-20", bytes.NewReader(content[:20]), 20))
+	sourcePath := filepath.Join(t.TempDir(), "test.zip")
+	require.NoError(t, os.WriteFile(sourcePath, content, 0o600))
+
+	// The truncated archived copy is not a zip, the uploaded blob is read instead.
+	file, err := blobs.NewOpener(nil, blobStorage).Open(ctx, &ent.Blob{
+		URI:             "file://" + sourcePath,
+		ArchivingStatus: blob.ArchivingStatusSUCCESS,
+		ArchiveURL:      "ab/test-truncated-20",
+		Reason:          "truncated",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(len(content)), file.Size())
+	entries, err := blobs.ListZipEntries(file, file.Size())
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.NoError(t, file.Close())
+}
@@ -2,20 +2,38 @@ package cas
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/bazelbuild/remote-apis-sdks/go/pkg/client"
 	"github.com/bazelbuild/remote-apis-sdks/go/pkg/digest"
+	bspb "google.golang.org/genproto/googleapis/bytestream"
+	"google.golang.org/grpc/credentials"
 
 	"github.com/buildbarn/bb-portal/pkg/auth"
 )
 
-// ConnectionManager A connection manager.
+// writeChunkSize is the size of the chunks of blobs written to the CAS.
+const writeChunkSize = 1024 * 1024
+
+// errInvalidPath An error helper.
+var errInvalidPath = errors.New("path is not of the form <hash>/<size>")
+
+// ConnectionManager A connection manager, which keeps a connection open per host and instance while it is used,
+// and closes the ones left idle.
 type ConnectionManager struct {
-	params ManagerParams
+	params            ManagerParams
+	credentialsHelper credentials.PerRPCCredentials
+
+	mu          sync.Mutex
+	connections map[connectionKey]*connection
 }
 
 // ManagerParams A manager Params struct.
@@ -23,50 +41,196 @@ type ManagerParams struct {
 	// TLSCACertFile is the PEM file that contains TLS root certificates.
 	TLSCACertFile string
 
-	// CredentialsHelperCommand is a command to use as a Bazel credentials helper.
-	CredentialsHelperCommand string
+	// CredentialsHelper is a Bazel credentials helper, none if its command is empty.
+	CredentialsHelper auth.CredentialsHelperParams
+
+	// Hosts overrides how to connect to some hosts, keyed by host name.
+	Hosts map[string]HostParams
+
+	// IdleTimeout is how long a connection is kept open unused, forever if zero.
+	IdleTimeout time.Duration
+}
+
+// DefaultManagerParams The default parameters of a connection manager.
+func DefaultManagerParams() ManagerParams {
+	return ManagerParams{
+		CredentialsHelper: auth.DefaultCredentialsHelperParams(),
+		IdleTimeout:       5 * time.Minute,
+	}
+}
+
+// The key of a connection, which is shared by the clients of an instance on a host.
+type connectionKey struct {
+	address      string
+	instanceName string
+}
+
+// A connection, with the number of clients using it, and when the last one was closed.
+type connection struct {
+	client   *client.Client
+	users    int
+	lastUsed time.Time
 }
 
 // NewConnectionManager Connection Manager constructor.
 func NewConnectionManager(params ManagerParams) *ConnectionManager {
-	return &ConnectionManager{
-		params: params,
+	manager := &ConnectionManager{
+		params:      params,
+		connections: map[connectionKey]*connection{},
+	}
+	// The credentials helper is shared by all the connections, for its cache.
+	if params.CredentialsHelper.Command != "" {
+		manager.credentialsHelper = auth.NewCredentialsHelper(params.CredentialsHelper)
 	}
+	return manager
 }
 
-// Client A client struct.
+// Client A client struct, using a connection of the manager until closed.
 type Client struct {
-	client *client.Client
+	client  *client.Client
+	release func()
 }
 
-// GetClientForURI A client to Get a connection for a given URI.
+// GetClientForURI A client to Get a connection for a given URI, reusing the connection to its host and instance if
+// there is one.
 func (manager *ConnectionManager) GetClientForURI(ctx context.Context, uri *url.URL) (*Client, error) {
-	instanceName := strings.Split(strings.TrimPrefix(uri.Path, "/"), "/")[0]
+	hostParams := manager.params.Hosts[uri.Hostname()]
+	key := connectionKey{
+		address:      hostParams.address(uri),
+		instanceName: hostParams.instanceName(InstanceNameFromURI(uri)),
+	}
+	manager.mu.Lock()
+	conn, ok := manager.connections[key]
+	if ok {
+		conn.users++
+	}
+	manager.mu.Unlock()
+
+	if !ok {
+		remoteAPIsClient, err := manager.dial(ctx, key, hostParams)
+		if err != nil {
+			return nil, err
+		}
+		manager.mu.Lock()
+		// Another request may have connected meanwhile, its connection is kept.
+		if conn, ok = manager.connections[key]; ok {
+			defer remoteAPIsClient.Close()
+		} else {
+			conn = &connection{client: remoteAPIsClient}
+			manager.connections[key] = conn
+		}
+		conn.users++
+		manager.mu.Unlock()
+	}
+
+	var once sync.Once
+	return &Client{
+		client: conn.client,
+		release: func() {
+			once.Do(func() {
+				manager.mu.Lock()
+				defer manager.mu.Unlock()
+				conn.users--
+				conn.lastUsed = time.Now()
+			})
+		},
+	}, nil
+}
+
+// Dial a new connection to an instance on a host.
+func (manager *ConnectionManager) dial(ctx context.Context, key connectionKey, hostParams HostParams) (*client.Client, error) {
 	dialParms := client.DialParams{
-		Service:       uri.Hostname() + ":443",
-		TLSCACertFile: manager.params.TLSCACertFile,
+		Service:           key.address,
+		TLSCACertFile:     manager.params.TLSCACertFile,
+		TLSClientAuthCert: hostParams.TLSClientCertFile,
+		TLSClientAuthKey:  hostParams.TLSClientKeyFile,
+	}
+	if hostParams.TLSCACertFile != "" {
+		dialParms.TLSCACertFile = hostParams.TLSCACertFile
 	}
-	if manager.params.CredentialsHelperCommand != "" {
-		credentialsHelper := auth.NewCredentialsHelper(manager.params.CredentialsHelperCommand)
+	switch {
+	case hostParams.Plaintext:
+		dialParms.NoSecurity = true
+	case manager.credentialsHelper != nil:
 		dialParms.UseExternalAuthToken = true
-		dialParms.ExternalPerRPCCreds = &client.PerRPCCreds{Creds: credentialsHelper}
+		dialParms.ExternalPerRPCCreds = &client.PerRPCCreds{Creds: manager.credentialsHelper}
 	}
-	remoteAPIsClient, err := client.NewClient(ctx, instanceName, dialParms)
+	slog.InfoContext(ctx, "connecting to CAS", "address", key.address, "instance", key.instanceName)
+	remoteAPIsClient, err := client.NewClient(ctx, key.instanceName, dialParms)
 	if err != nil {
 		return nil, fmt.Errorf("could not open a remote APIs SDK client: %w", err)
 	}
-	return &Client{
-		client: remoteAPIsClient,
-	}, nil
+	return remoteAPIsClient, nil
 }
 
-// ReadBlobToFile Reads a blob to a file.
-func (c *Client) ReadBlobToFile(ctx context.Context, uri *url.URL, fpath string) error {
+// Run Closes the connections left idle for longer than the idle timeout, until the context is done.
+func (manager *ConnectionManager) Run(ctx context.Context) {
+	if manager.params.IdleTimeout <= 0 {
+		return
+	}
+	ticker := time.NewTicker(manager.params.IdleTimeout / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			manager.CloseIdle(time.Now().Add(-manager.params.IdleTimeout))
+		}
+	}
+}
+
+// CloseIdle Closes the connections which have not been used since a given time.
+func (manager *ConnectionManager) CloseIdle(since time.Time) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+	for key, conn := range manager.connections {
+		if conn.users > 0 || conn.lastUsed.After(since) {
+			continue
+		}
+		if err := conn.client.Close(); err != nil {
+			slog.Warn("could not close CAS connection", "address", key.address, "instance", key.instanceName, "err", err)
+		}
+		delete(manager.connections, key)
+	}
+}
+
+// Connections The number of open connections.
+func (manager *ConnectionManager) Connections() int {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+	return len(manager.connections)
+}
+
+// InstanceNameFromURI Gets the instance name of a bytestream URI, the path before /blobs/<hash>/<size>, or the
+// whole path if it does not refer to a blob.
+func InstanceNameFromURI(uri *url.URL) string {
+	pathParts := strings.Split(strings.Trim(uri.Path, "/"), "/")
+	if len(pathParts) >= 3 && pathParts[len(pathParts)-3] == "blobs" {
+		pathParts = pathParts[:len(pathParts)-3]
+	}
+	return strings.Join(pathParts, "/")
+}
+
+// DigestFromURI Gets the digest of a blob from its bytestream URI, ending with <hash>/<size>.
+func DigestFromURI(uri *url.URL) (digest.Digest, error) {
 	pathParts := strings.Split(uri.Path, "/")
+	if len(pathParts) < 2 {
+		return digest.Digest{}, fmt.Errorf("could not create digest from path %s: %w", uri.Path, errInvalidPath)
+	}
 	digestPath := strings.Join(pathParts[len(pathParts)-2:], "/")
 	d, err := digest.NewFromString(digestPath)
 	if err != nil {
-		return fmt.Errorf("could not create digest from path %s: %w", uri.Path, err)
+		return digest.Digest{}, fmt.Errorf("could not create digest from path %s: %w", uri.Path, err)
+	}
+	return d, nil
+}
+
+// ReadBlobToFile Reads a blob to a file.
+func (c *Client) ReadBlobToFile(ctx context.Context, uri *url.URL, fpath string) error {
+	d, err := DigestFromURI(uri)
+	if err != nil {
+		return err
 	}
 
 	slog.InfoContext(ctx, "reading from CAS", "digest", d.String(), "file", fpath)
@@ -79,7 +243,65 @@ func (c *Client) ReadBlobToFile(ctx context.Context, uri *url.URL, fpath string)
 	return nil
 }
 
-// Close Close the connection.
+// ReadBlob Streams a blob to a writer.
+func (c *Client) ReadBlob(ctx context.Context, d digest.Digest, w io.Writer) error {
+	name, err := c.client.ResourceName("blobs", d.Hash, strconv.FormatInt(d.Size, 10))
+	if err != nil {
+		return fmt.Errorf("could not create resource name for %s: %w", d.String(), err)
+	}
+	if _, err = c.client.ReadResourceTo(ctx, name, w); err != nil {
+		return fmt.Errorf("could not read blob %s: %w", d.String(), err)
+	}
+	return nil
+}
+
+// WriteBlob Streams a blob from a reader, in chunks, the reader must provide exactly the content of the digest.
+func (c *Client) WriteBlob(ctx context.Context, d digest.Digest, r io.Reader) error {
+	name, err := c.client.ResourceNameWrite(d.Hash, d.Size)
+	if err != nil {
+		return fmt.Errorf("could not create resource name for %s: %w", d.String(), err)
+	}
+	stream, err := c.client.Write(ctx)
+	if err != nil {
+		return fmt.Errorf("could not start writing blob %s: %w", d.String(), err)
+	}
+	buf := make([]byte, writeChunkSize)
+	var offset int64
+	for finished := false; !finished; {
+		n, err := io.ReadFull(r, buf)
+		finished = errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+		if err != nil && !finished {
+			return fmt.Errorf("could not read blob %s: %w", d.String(), err)
+		}
+		request := &bspb.WriteRequest{WriteOffset: offset, Data: buf[:n], FinishWrite: finished}
+		if offset == 0 {
+			request.ResourceName = name
+		}
+		if err = stream.Send(request); errors.Is(err, io.EOF) {
+			// The server ended the stream, for instance because it already has the blob.
+			break
+		} else if err != nil {
+			return fmt.Errorf("could not write blob %s: %w", d.String(), err)
+		}
+		offset += int64(n)
+	}
+	if _, err = stream.CloseAndRecv(); err != nil {
+		return fmt.Errorf("could not write blob %s: %w", d.String(), err)
+	}
+	return nil
+}
+
+// HasBlob Checks if the CAS has a blob.
+func (c *Client) HasBlob(ctx context.Context, d digest.Digest) (bool, error) {
+	missing, err := c.client.MissingBlobs(ctx, []digest.Digest{d})
+	if err != nil {
+		return false, fmt.Errorf("could not check blob %s: %w", d.String(), err)
+	}
+	return len(missing) == 0, nil
+}
+
+// Close Releases the connection, which is kept open for other clients until idle.
 func (c *Client) Close() error {
-	return c.client.Close()
+	c.release()
+	return nil
 }
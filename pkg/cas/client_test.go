@@ -0,0 +1,167 @@
+package cas_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	repb "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/stretchr/testify/require"
+	bspb "google.golang.org/genproto/googleapis/bytestream"
+	"google.golang.org/grpc"
+
+	"github.com/buildbarn/bb-portal/pkg/cas"
+)
+
+// fakeCAS A CAS serving a single blob, recording the instance names it is asked for.
+type fakeCAS struct {
+	repb.UnimplementedCapabilitiesServer
+	bspb.UnimplementedByteStreamServer
+
+	content string
+
+	mu            sync.Mutex
+	instanceNames []string
+	readOffsets   []int64
+	readLimits    []int64
+}
+
+// GetCapabilities Supports SHA-256 digests.
+func (f *fakeCAS) GetCapabilities(_ context.Context, request *repb.GetCapabilitiesRequest) (*repb.ServerCapabilities, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.instanceNames = append(f.instanceNames, request.GetInstanceName())
+	return &repb.ServerCapabilities{
+		CacheCapabilities: &repb.CacheCapabilities{DigestFunctions: []repb.DigestFunction_Value{repb.DigestFunction_SHA256}},
+	}, nil
+}
+
+// Read Streams the blob from the requested offset up to the requested limit, in chunks of 10 bytes.
+func (f *fakeCAS) Read(request *bspb.ReadRequest, stream bspb.ByteStream_ReadServer) error {
+	f.mu.Lock()
+	f.readOffsets = append(f.readOffsets, request.GetReadOffset())
+	f.readLimits = append(f.readLimits, request.GetReadLimit())
+	f.mu.Unlock()
+	last := int64(len(f.content))
+	if request.GetReadLimit() > 0 {
+		last = min(last, request.GetReadOffset()+request.GetReadLimit())
+	}
+	for offset := request.GetReadOffset(); offset < last; offset += 10 {
+		end := min(offset+10, last)
+		if err := stream.Send(&bspb.ReadResponse{Data: []byte(f.content[offset:end])}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// startFakeCAS Serve a fake CAS in plaintext, returning its port.
+func startFakeCAS(t *testing.T, f *fakeCAS) int {
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	repb.RegisterCapabilitiesServer(server, f)
+	bspb.RegisterByteStreamServer(server, f)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+	return listener.Addr().(*net.TCPAddr).Port
+}
+
+func TestConnectionManager_GetClientForURI(t *testing.T) {
+	ctx := context.Background()
+	f := &fakeCAS{content: strings.Repeat("0123456789", 10)}
+	port := startFakeCAS(t, f)
+	params := cas.DefaultManagerParams()
+	params.Hosts = map[string]cas.HostParams{
+		"localhost": {Port: port, Plaintext: true, InstanceNames: map[string]string{"bazel": "server"}},
+	}
+	manager := cas.NewConnectionManager(params)
+	hash := sha256.Sum256([]byte(f.content))
+	uri, err := url.Parse(fmt.Sprintf("bytestream://localhost/bazel/blobs/%s/%d", hex.EncodeToString(hash[:]), len(f.content)))
+	require.NoError(t, err)
+
+	// Clients of the same instance share a connection, on the mapped instance name.
+	first, err := manager.GetClientForURI(ctx, uri)
+	require.NoError(t, err)
+	second, err := manager.GetClientForURI(ctx, uri)
+	require.NoError(t, err)
+	require.Equal(t, 1, manager.Connections())
+	require.Equal(t, []string{"server"}, f.instanceNames)
+
+	// Connections in use, or used recently, are kept.
+	require.NoError(t, first.Close())
+	manager.CloseIdle(time.Now())
+	require.Equal(t, 1, manager.Connections())
+	require.NoError(t, second.Close())
+	manager.CloseIdle(time.Now().Add(-time.Minute))
+	require.Equal(t, 1, manager.Connections())
+	manager.CloseIdle(time.Now())
+	require.Zero(t, manager.Connections())
+
+	// Reading a range of a blob only streams from its start.
+	d, err := cas.DigestFromURI(uri)
+	require.NoError(t, err)
+	casClient, err := manager.GetClientForURI(ctx, uri)
+	require.NoError(t, err)
+	reader := casClient.NewBlobReader(ctx, d)
+	_, err = reader.Seek(-15, io.SeekEnd)
+	require.NoError(t, err)
+	tail, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, "567890123456789", string(tail))
+
+	// Reading at an offset only streams the bytes read.
+	buf := make([]byte, 12)
+	n, err := reader.ReadAt(buf, 23)
+	require.NoError(t, err)
+	require.Equal(t, "345678901234", string(buf[:n]))
+	n, err = reader.ReadAt(buf, 95)
+	require.ErrorIs(t, err, io.EOF)
+	require.Equal(t, "56789", string(buf[:n]))
+	require.NoError(t, reader.Close())
+	require.Equal(t, []int64{85, 23, 95}, f.readOffsets)
+	require.Equal(t, []int64{0, 12, 5}, f.readLimits)
+	require.Equal(t, []string{"server", "server"}, f.instanceNames)
+}
+
+func TestInstanceNameFromURI(t *testing.T) {
+	for uri, expected := range map[string]string{
+		"bytestream://cas.example.com/blobs/" + strings.Repeat("a", 64) + "/1":     "",
+		"bytestream://cas.example.com/a/b/blobs/" + strings.Repeat("a", 64) + "/1": "a/b",
+		"bytestream://cas.example.com/instance":                                    "instance",
+		"bytestream://cas.example.com":                                             "",
+	} {
+		parsed, err := url.Parse(uri)
+		require.NoError(t, err)
+		require.Equal(t, expected, cas.InstanceNameFromURI(parsed), uri)
+	}
+}
+
+func TestHostParams_Address(t *testing.T) {
+	for _, tc := range []struct {
+		uri      string
+		params   cas.HostParams
+		expected string
+	}{
+		{"bytestream://cas.example.com/blobs/a/1", cas.HostParams{}, "cas.example.com:443"},
+		{"bytestream://cas.example.com/blobs/a/1", cas.HostParams{Port: 8980}, "cas.example.com:8980"},
+		{"bytestream://cas.example.com:1234/blobs/a/1", cas.HostParams{Port: 8980}, "cas.example.com:1234"},
+		{"bytestream://cas.example.com:1234/blobs/a/1", cas.HostParams{}, "cas.example.com:1234"},
+		{"bytestream://[::1]/blobs/a/1", cas.HostParams{}, "[::1]:443"},
+		{"bytestream://[::1]:1234/blobs/a/1", cas.HostParams{}, "[::1]:1234"},
+	} {
+		parsed, err := url.Parse(tc.uri)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, cas.HostAddress(tc.params, parsed), tc.uri)
+	}
+}
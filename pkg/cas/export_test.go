@@ -0,0 +1,4 @@
+package cas
+
+// HostAddress exposes the address dialed for a URI to the tests.
+var HostAddress = HostParams.address
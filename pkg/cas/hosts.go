@@ -0,0 +1,66 @@
+package cas
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+)
+
+// defaultPort is the port CAS hosts are dialed on unless configured otherwise.
+const defaultPort = 443
+
+// HostParams Parameters of the connections to a CAS host, overriding the defaults of the connection manager.
+type HostParams struct {
+	// Port is the port to dial when the bytestream URIs have none, 443 if zero.
+	Port int `json:"port"`
+
+	// Plaintext dials without TLS, and so without credentials, for instance for frontends inside a cluster.
+	Plaintext bool `json:"plaintext"`
+
+	// TLSCACertFile is the PEM file that contains TLS root certificates, the one of the manager if empty.
+	TLSCACertFile string `json:"tlsCACertFile"`
+
+	// TLSClientCertFile and TLSClientKeyFile are the PEM files of a client certificate for mTLS.
+	TLSClientCertFile string `json:"tlsClientCertFile"`
+	TLSClientKeyFile  string `json:"tlsClientKeyFile"`
+
+	// InstanceNames maps the instance names in bytestream URIs to the instance names to use on the host, when they
+	// differ, for instance when the host is reached through another frontend than the one Bazel used.
+	InstanceNames map[string]string `json:"instanceNames"`
+}
+
+// LoadHostParams Loads the parameters of CAS hosts from a JSON file, an object keyed by host name.
+func LoadHostParams(path string) (map[string]HostParams, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read CAS hosts configuration: %w", err)
+	}
+	var hosts map[string]HostParams
+	if err = json.Unmarshal(content, &hosts); err != nil {
+		return nil, fmt.Errorf("could not parse CAS hosts configuration %s: %w", path, err)
+	}
+	return hosts, nil
+}
+
+// Get the address to dial for the host of a URI, on the port of the URI if it has one.
+func (p HostParams) address(uri *url.URL) string {
+	port := uri.Port()
+	if port == "" && p.Port != 0 {
+		port = strconv.Itoa(p.Port)
+	}
+	if port == "" {
+		port = strconv.Itoa(defaultPort)
+	}
+	return net.JoinHostPort(uri.Hostname(), port)
+}
+
+// Get the instance name to use on the host for an instance name in a URI.
+func (p HostParams) instanceName(instanceName string) string {
+	if mapped, ok := p.InstanceNames[instanceName]; ok {
+		return mapped
+	}
+	return instanceName
+}
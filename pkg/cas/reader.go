@@ -0,0 +1,148 @@
+package cas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/bazelbuild/remote-apis-sdks/go/pkg/digest"
+	bspb "google.golang.org/genproto/googleapis/bytestream"
+)
+
+// errInvalidOffset An error helper.
+var errInvalidOffset = errors.New("invalid offset")
+
+// BlobReader Reads a blob from the CAS from any offset. A ByteStream read is only started from the current offset when
+// reading, so seeking to a range of a blob, as http.ServeContent does, does not read what comes before it. ReadAt reads
+// only the bytes asked for, with ByteStream reads limited to them.
+type BlobReader struct {
+	ctx    context.Context
+	client *Client
+	digest digest.Digest
+	offset int64
+
+	stream bspb.ByteStream_ReadClient
+	cancel context.CancelFunc
+	buf    []byte
+}
+
+// NewBlobReader Constructor for a reader of a blob, which closes the client when closed.
+func (c *Client) NewBlobReader(ctx context.Context, d digest.Digest) *BlobReader {
+	return &BlobReader{ctx: ctx, client: c, digest: d}
+}
+
+// Size The size of the blob.
+func (r *BlobReader) Size() int64 {
+	return r.digest.Size
+}
+
+// Read Reads from the current offset, starting a ByteStream read if none is in progress.
+func (r *BlobReader) Read(p []byte) (int, error) {
+	if r.offset >= r.digest.Size {
+		return 0, io.EOF
+	}
+	if r.stream == nil {
+		stream, cancel, err := r.startStream(r.offset, 0)
+		if err != nil {
+			return 0, err
+		}
+		r.stream = stream
+		r.cancel = cancel
+	}
+	for len(r.buf) == 0 {
+		response, err := r.stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return 0, fmt.Errorf("could not read blob %s: %w", r.digest.String(), io.ErrUnexpectedEOF)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("could not read blob %s: %w", r.digest.String(), err)
+		}
+		r.buf = response.GetData()
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	r.offset += int64(n)
+	return n, nil
+}
+
+// Seek Sets the offset of the next read, stopping the ByteStream read in progress if it moves.
+func (r *BlobReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		offset += r.digest.Size
+	}
+	if offset < 0 {
+		return 0, fmt.Errorf("could not seek blob %s to %d: %w", r.digest.String(), offset, errInvalidOffset)
+	}
+	if offset != r.offset {
+		r.stopStream()
+		r.offset = offset
+	}
+	return offset, nil
+}
+
+// ReadAt Reads the bytes at an offset with a ByteStream read of as many bytes, independently of the current offset.
+func (r *BlobReader) ReadAt(p []byte, offset int64) (int, error) {
+	if offset < 0 {
+		return 0, fmt.Errorf("could not read blob %s at %d: %w", r.digest.String(), offset, errInvalidOffset)
+	}
+	if offset >= r.digest.Size {
+		return 0, io.EOF
+	}
+	limit := min(int64(len(p)), r.digest.Size-offset)
+	stream, cancel, err := r.startStream(offset, limit)
+	if err != nil {
+		return 0, err
+	}
+	defer cancel()
+	n := 0
+	for int64(n) < limit {
+		response, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return n, fmt.Errorf("could not read blob %s: %w", r.digest.String(), io.ErrUnexpectedEOF)
+		}
+		if err != nil {
+			return n, fmt.Errorf("could not read blob %s: %w", r.digest.String(), err)
+		}
+		n += copy(p[n:limit], response.GetData())
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Close Stops the ByteStream read in progress and closes the client.
+func (r *BlobReader) Close() error {
+	r.stopStream()
+	return r.client.Close()
+}
+
+// Start a ByteStream read of limit bytes from an offset, to the end of the blob if the limit is 0.
+func (r *BlobReader) startStream(offset, limit int64) (bspb.ByteStream_ReadClient, context.CancelFunc, error) {
+	name, err := r.client.client.ResourceName("blobs", r.digest.Hash, strconv.FormatInt(r.digest.Size, 10))
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create resource name for %s: %w", r.digest.String(), err)
+	}
+	ctx, cancel := context.WithCancel(r.ctx)
+	stream, err := r.client.client.Read(ctx, &bspb.ReadRequest{ResourceName: name, ReadOffset: offset, ReadLimit: limit})
+	if err != nil {
+		cancel()
+		return nil, nil, fmt.Errorf("could not read blob %s: %w", r.digest.String(), err)
+	}
+	return stream, cancel, nil
+}
+
+// Stop the ByteStream read in progress, if any.
+func (r *BlobReader) stopStream() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.stream = nil
+	r.cancel = nil
+	r.buf = nil
+}
@@ -4,7 +4,8 @@
 // as protobuf. They are built as a Go library in github.com/buildbarn/bb-portal/third_party/bazel/gen/bes.
 //
 // This package may provide convenience functions for working with those events, such as:
-// - Iterating over events in a line-delimited JSON file (NDJSON file).
+// - Iterating over events in a line-delimited JSON file (NDJSON file) or a binary file.
+// - Writing events to either kind of file.
 // - Converting events to/from a JSON array in order to save them in a DB as JSON.
 //
 // This package should not contain any code to process or interpret events, and should not be
@@ -4,12 +4,14 @@ import (
 	"bufio"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"strings"
 
 	"google.golang.org/api/iterator"
+	"google.golang.org/protobuf/encoding/protodelim"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 
@@ -33,6 +35,8 @@ type BuildEventIterator struct {
 	ctx         context.Context
 	scanner     *bufio.Scanner
 	unmarshaler protojson.UnmarshalOptions
+	// binary Reads the events of a binary file, nil for an NDJSON file.
+	binary *bufio.Reader
 }
 
 // BuildEvent A build event.
@@ -187,12 +191,21 @@ func (e BuildEvent) FindUndeclaredTestOutputsURI() string {
 	return ""
 }
 
-// NewBuildEventIterator Build Event Iterator constructor.
+// NewBuildEventIterator Build Event Iterator constructor, for NDJSON files as written by --build_event_json_file and
+// binary files as written by --build_event_binary_file.
 func NewBuildEventIterator(ctx context.Context, reader io.Reader) *BuildEventIterator {
 	unmarshaler := protojson.UnmarshalOptions{
 		DiscardUnknown: true,
 	}
-	scanner := bufio.NewScanner(reader)
+	buffered := bufio.NewReader(reader)
+	if DetectFormat(buffered) == FormatBinary {
+		return &BuildEventIterator{
+			ctx:         ctx,
+			unmarshaler: unmarshaler,
+			binary:      buffered,
+		}
+	}
+	scanner := bufio.NewScanner(buffered)
 	scanner.Buffer(make([]byte, 0, initialBufferSize), maxBufferSize)
 	it := BuildEventIterator{
 		ctx:         ctx,
@@ -210,6 +223,9 @@ func NewBuildEventIterator(ctx context.Context, reader io.Reader) *BuildEventIte
 // In the future we may change this so that it is the consumers responsibility to clone the event if they are
 // using it as more than a temporary variable inside a single iteration of the loop.
 func (it *BuildEventIterator) Next() (*BuildEvent, error) {
+	if it.binary != nil {
+		return it.nextBinary()
+	}
 	if !it.scanner.Scan() {
 		err := it.scanner.Err()
 		if err == nil {
@@ -238,3 +254,21 @@ func (it *BuildEventIterator) Next() (*BuildEvent, error) {
 	buildEvent := NewBuildEvent(bepEvent, lineBytes).Clone()
 	return &buildEvent, nil
 }
+
+// nextBinary returns the next event of a binary file, its raw message being its JSON.
+func (it *BuildEventIterator) nextBinary() (*BuildEvent, error) {
+	bepEvent := &bes.BuildEvent{}
+	err := protodelim.UnmarshalOptions{MaxSize: maxBufferSize}.UnmarshalFrom(it.binary, bepEvent)
+	if errors.Is(err, io.EOF) {
+		return nil, iterator.Done
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read a build event from binary file: %w", err)
+	}
+	rawEvent, err := protojson.Marshal(bepEvent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal build event to JSON: %w", err)
+	}
+	buildEvent := NewBuildEvent(bepEvent, rawEvent)
+	return &buildEvent, nil
+}
@@ -0,0 +1,77 @@
+package events
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Format The format of a build event file.
+type Format int
+
+// Formats of build event files.
+const (
+	// FormatJSON One JSON event per line, as written by --build_event_json_file.
+	FormatJSON Format = iota
+	// FormatBinary Varint length delimited protobuf events, as written by --build_event_binary_file.
+	FormatBinary
+)
+
+// The tag of the id field, first in the protobuf of an event.
+const eventIDTag = 0x0a
+
+// DetectFormat Detects the format of a build event file from its first bytes, without consuming them. An NDJSON file
+// starts with an opening brace followed by a quote, while the length of the first event of a binary file is followed
+// by the tag of its id, even when that length happens to be the code of a brace.
+func DetectFormat(reader *bufio.Reader) Format {
+	head, _ := reader.Peek(2)
+	if len(head) == 0 || (head[0] == '{' && (len(head) == 1 || head[1] != eventIDTag)) {
+		return FormatJSON
+	}
+	return FormatBinary
+}
+
+// Writer Writes build events to a file of a format.
+type Writer struct {
+	writer io.Writer
+	format Format
+	buffer bytes.Buffer
+}
+
+// NewWriter Writer constructor.
+func NewWriter(writer io.Writer, format Format) *Writer {
+	return &Writer{writer: writer, format: format}
+}
+
+// Write Writes an event. Its raw message is written as is to NDJSON files, on a single line, so that the fields
+// unknown to this version of the protocol are kept.
+func (w *Writer) Write(event *BuildEvent) error {
+	if w.format == FormatBinary {
+		if _, err := protodelim.MarshalTo(w.writer, event.BuildEvent); err != nil {
+			return fmt.Errorf("failed to write build event: %w", err)
+		}
+		return nil
+	}
+
+	rawEvent := event.RawMessage()
+	if len(rawEvent) == 0 {
+		var err error
+		if rawEvent, err = protojson.Marshal(event.BuildEvent); err != nil {
+			return fmt.Errorf("failed to marshal build event to JSON: %w", err)
+		}
+	}
+	w.buffer.Reset()
+	if err := json.Compact(&w.buffer, rawEvent); err != nil {
+		return fmt.Errorf("failed to compact build event JSON: %w", err)
+	}
+	w.buffer.WriteByte('\n')
+	if _, err := w.buffer.WriteTo(w.writer); err != nil {
+		return fmt.Errorf("failed to write build event: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package events_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/iterator"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/buildbarn/bb-portal/pkg/events"
+)
+
+// readAll Reads all the events of a file.
+func readAll(t *testing.T, data []byte) []*events.BuildEvent {
+	it := events.NewBuildEventIterator(context.Background(), bytes.NewReader(data))
+	var buildEvents []*events.BuildEvent
+	for {
+		buildEvent, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			return buildEvents
+		}
+		require.NoError(t, err)
+		buildEvents = append(buildEvents, buildEvent)
+	}
+}
+
+// writeAll Writes events to a file of a format.
+func writeAll(t *testing.T, buildEvents []*events.BuildEvent, format events.Format) []byte {
+	var written bytes.Buffer
+	writer := events.NewWriter(&written, format)
+	for _, buildEvent := range buildEvents {
+		require.NoError(t, writer.Write(buildEvent))
+	}
+	return written.Bytes()
+}
+
+// TestWriter_RoundTrip Writes the events read from a file in both formats, and reads them back.
+func TestWriter_RoundTrip(t *testing.T) {
+	original, err := os.ReadFile(filepath.Join("testdata", "bazelbuild/examples/cpp-tutorial/stage1/test.bep.ndjson"))
+	require.NoError(t, err)
+	buildEvents := readAll(t, original)
+	require.Len(t, buildEvents, 27)
+
+	// The raw messages are written back as they were read.
+	ndjson := writeAll(t, buildEvents, events.FormatJSON)
+	require.Equal(t, events.FormatJSON, events.DetectFormat(bufio.NewReader(bytes.NewReader(ndjson))))
+	require.Equal(t, original, ndjson)
+
+	binary := writeAll(t, buildEvents, events.FormatBinary)
+	require.Equal(t, events.FormatBinary, events.DetectFormat(bufio.NewReader(bytes.NewReader(binary))))
+	fromBinary := readAll(t, binary)
+	require.Len(t, fromBinary, len(buildEvents))
+	for i, buildEvent := range fromBinary {
+		require.True(t, proto.Equal(buildEvents[i].BuildEvent, buildEvent.BuildEvent), "event %d", i)
+		require.NotEmpty(t, buildEvent.RawMessage())
+	}
+
+	// Events without raw messages are marshaled.
+	started := events.NewBuildEvent(buildEvents[0].BuildEvent, nil)
+	fromJSON := readAll(t, writeAll(t, []*events.BuildEvent{&started}, events.FormatJSON))
+	require.Len(t, fromJSON, 1)
+	require.True(t, proto.Equal(started.BuildEvent, fromJSON[0].BuildEvent))
+}
+
+// TestDetectFormat_LengthOfABrace A binary file whose first event is as long as the code of a brace is not NDJSON.
+func TestDetectFormat_LengthOfABrace(t *testing.T) {
+	detect := func(data []byte) events.Format {
+		return events.DetectFormat(bufio.NewReader(bytes.NewReader(data)))
+	}
+	require.Equal(t, events.FormatBinary, detect([]byte{'{', 0x0a, 0x02}))
+	require.Equal(t, events.FormatJSON, detect([]byte(`{"id":{}}`)))
+	require.Equal(t, events.FormatJSON, detect(nil))
+}
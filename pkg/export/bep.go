@@ -0,0 +1,723 @@
+package export
+
+import (
+	"cmp"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/api/iterator"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/missdetail"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/testsummary"
+	"github.com/buildbarn/bb-portal/pkg/events"
+	"github.com/buildbarn/bb-portal/pkg/summary"
+	"github.com/buildbarn/bb-portal/third_party/bazel/gen/bes"
+	"github.com/buildbarn/bb-portal/third_party/bazel/gen/bescore"
+)
+
+// BEPSource Where the build events of an exported invocation come from.
+type BEPSource string
+
+// Sources of exported build events.
+const (
+	// BEPSourceEventFile The event file the invocation was imported from, still on disk.
+	BEPSourceEventFile BEPSource = "event-file"
+	// BEPSourceReconstructed Events reconstructed from what was saved of the invocation and the raw events of its
+	// problems.
+	BEPSourceReconstructed BEPSource = "reconstructed"
+)
+
+// The layout the summarizer saves the start time of test attempts in.
+const testAttemptStartLayout = "2006-01-02 15:04:05.999999999 -0700 MST"
+
+// LoadEvents The build events of the invocation with the ID, a not found error if there is none. They are those of the
+// file it was imported from while it is on disk, and are otherwise reconstructed so that importing them saves the same
+// invocation: the events the portal does not summarize, and the fields it drops, cannot be recovered.
+func LoadEvents(ctx context.Context, client *ent.Client, invocationID uuid.UUID) ([]*events.BuildEvent, BEPSource, error) {
+	byID := ent.Asc("id")
+	invocation, err := client.BazelInvocation.Query().
+		Where(bazelinvocation.InvocationID(invocationID)).
+		WithEventFile().
+		WithProblems(func(query *ent.BazelInvocationProblemQuery) {
+			query.Order(byID)
+		}).
+		WithTargets(func(query *ent.TargetPairQuery) {
+			query.WithConfiguration().WithCompletion()
+		}).
+		WithTestCollection(func(query *ent.TestCollectionQuery) {
+			query.WithTestSummary().WithTestResults(func(query *ent.TestResultBESQuery) {
+				query.Order(byID).WithExecutionInfo(func(query *ent.ExectionInfoQuery) {
+					query.WithTimingBreakdown(func(query *ent.TimingBreakdownQuery) {
+						query.WithChild(func(query *ent.TimingChildQuery) {
+							query.Order(byID)
+						})
+					})
+				})
+			})
+		}).
+		WithMetrics(func(query *ent.MetricsQuery) {
+			query.
+				WithActionSummary(func(query *ent.ActionSummaryQuery) {
+					query.
+						WithActionData(func(query *ent.ActionDataQuery) {
+							query.Order(byID)
+						}).
+						WithRunnerCount(func(query *ent.RunnerCountQuery) {
+							query.Order(byID)
+						}).
+						WithActionCacheStatistics(func(query *ent.ActionCacheStatisticsQuery) {
+							query.WithMissDetails(func(query *ent.MissDetailQuery) {
+								query.Order(byID)
+							})
+						})
+				}).
+				WithMemoryMetrics(func(query *ent.MemoryMetricsQuery) {
+					query.WithGarbageMetrics(func(query *ent.GarbageMetricsQuery) {
+						query.Order(byID)
+					})
+				}).
+				WithTargetMetrics().
+				WithPackageMetrics(func(query *ent.PackageMetricsQuery) {
+					query.WithPackageLoadMetrics(func(query *ent.PackageLoadMetricsQuery) {
+						query.Order(byID)
+					})
+				}).
+				WithTimingMetrics().
+				WithCumulativeMetrics().
+				WithArtifactMetrics(func(query *ent.ArtifactMetricsQuery) {
+					query.
+						WithSourceArtifactsRead().
+						WithOutputArtifactsSeen().
+						WithOutputArtifactsFromActionCache().
+						WithTopLevelArtifacts()
+				}).
+				WithNetworkMetrics(func(query *ent.NetworkMetricsQuery) {
+					query.WithSystemNetworkStats()
+				}).
+				WithBuildGraphMetrics()
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not load invocation %s: %w", invocationID, err)
+	}
+
+	if eventFile := invocation.Edges.EventFile; eventFile != nil {
+		if buildEvents := readEventFile(ctx, eventFile.URL, invocationID); buildEvents != nil {
+			return buildEvents, BEPSourceEventFile, nil
+		}
+	}
+	buildEvents, err := reconstructEvents(invocation)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not reconstruct the events of invocation %s: %w", invocationID, err)
+	}
+	return buildEvents, BEPSourceReconstructed, nil
+}
+
+// The events of the event file of an invocation, nil unless it is a local file still holding the events of the
+// invocation. Files uploaded are deleted once imported and streamed events are never written to a file.
+func readEventFile(ctx context.Context, eventFileURL string, invocationID uuid.UUID) []*events.BuildEvent {
+	reader, err := os.Open(eventFileURL)
+	if err != nil {
+		return nil
+	}
+	defer reader.Close()
+
+	var buildEvents []*events.BuildEvent
+	it := events.NewBuildEventIterator(ctx, reader)
+	for {
+		buildEvent, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			slog.WarnContext(ctx, "could not read event file, reconstructing its events", "url", eventFileURL, "err", err)
+			return nil
+		}
+		buildEvents = append(buildEvents, buildEvent)
+	}
+	if len(buildEvents) == 0 || buildEvents[0].GetStarted().GetUuid() != invocationID.String() {
+		return nil
+	}
+	return buildEvents
+}
+
+// The raw events saved with the problems of an invocation, by the events they replace.
+type problemEvents struct {
+	progress        []*events.BuildEvent
+	actions         []*events.BuildEvent
+	targetCompleted map[string]*events.BuildEvent
+	testResult      map[string]*events.BuildEvent
+	testSummary     map[string]*events.BuildEvent
+}
+
+// Read the raw events of the problems of an invocation.
+func readProblemEvents(problems []*ent.BazelInvocationProblem) (*problemEvents, error) {
+	raw := &problemEvents{
+		targetCompleted: map[string]*events.BuildEvent{},
+		testResult:      map[string]*events.BuildEvent{},
+		testSummary:     map[string]*events.BuildEvent{},
+	}
+	for _, problem := range problems {
+		if len(problem.BepEvents) == 0 {
+			continue
+		}
+		buildEvents, err := events.FromJSONArray(problem.BepEvents)
+		if err != nil {
+			return nil, fmt.Errorf("could not read the events of problem %d: %w", problem.ID, err)
+		}
+		for i := range buildEvents {
+			buildEvent := &buildEvents[i]
+			switch id := buildEvent.GetId(); {
+			case id.GetProgress() != nil:
+				raw.progress = append(raw.progress, buildEvent)
+			case id.GetActionCompleted() != nil:
+				raw.actions = append(raw.actions, buildEvent)
+			case id.GetTargetCompleted() != nil:
+				raw.targetCompleted[id.GetTargetCompleted().GetLabel()] = buildEvent
+			case id.GetTestResult() != nil:
+				raw.testResult[id.GetTestResult().GetLabel()] = buildEvent
+			case id.GetTestSummary() != nil:
+				raw.testSummary[id.GetTestSummary().GetLabel()] = buildEvent
+			}
+		}
+	}
+	return raw, nil
+}
+
+// An event stream being reconstructed.
+type reconstruction struct {
+	buildEvents  []*events.BuildEvent
+	nextProgress int32
+}
+
+// Add an event reconstructed from the saved entities, marshaled when written.
+func (r *reconstruction) add(event *bes.BuildEvent) {
+	buildEvent := events.NewBuildEvent(event, nil)
+	r.buildEvents = append(r.buildEvents, &buildEvent)
+}
+
+// Reconstruct the events of an invocation, in the order Bazel sends them. The raw events of its problems replace
+// those which would be reconstructed, so that importing the events detects the same problems.
+func reconstructEvents(invocation *ent.BazelInvocation) ([]*events.BuildEvent, error) {
+	raw, err := readProblemEvents(invocation.Edges.Problems)
+	if err != nil {
+		return nil, err
+	}
+	r := &reconstruction{}
+	for _, event := range raw.progress {
+		r.nextProgress = max(r.nextProgress, event.GetId().GetProgress().GetOpaqueCount()+1)
+	}
+
+	r.started(invocation)
+	r.commandLine(invocation.Summary)
+	r.metadata(invocation)
+	r.configuration(invocation)
+	for range invocation.NumFetches {
+		r.add(&bes.BuildEvent{
+			Id:      &bes.BuildEventId{Id: &bes.BuildEventId_Fetch{Fetch: &bes.BuildEventId_FetchId{}}},
+			Payload: &bes.BuildEvent_Fetch{Fetch: &bes.Fetch{Success: true}},
+		})
+	}
+	r.progress(invocation.BuildLogs, raw.progress)
+	exitCode := invocation.Summary.ExitCode
+	ignoresProblems := exitCode != nil && (exitCode.Code == summary.ExitCodeSuccess || exitCode.Code == summary.ExitCodeInterrupted)
+	r.targets(invocation.Edges.Targets, raw.targetCompleted, ignoresProblems)
+	r.buildEvents = append(r.buildEvents, raw.actions...)
+	r.tests(invocation.Edges.TestCollection, raw)
+	if exitCode != nil {
+		r.add(&bes.BuildEvent{
+			Id: &bes.BuildEventId{Id: &bes.BuildEventId_BuildFinished{BuildFinished: &bes.BuildEventId_BuildFinishedId{}}},
+			Payload: &bes.BuildEvent_Finished{Finished: &bes.BuildFinished{
+				OverallSuccess: exitCode.Code == summary.ExitCodeSuccess,
+				ExitCode:       &bes.BuildFinished_ExitCode{Name: exitCode.Name, Code: int32(exitCode.Code)},
+				FinishTime:     timestamppb.New(invocation.EndedAt),
+			}},
+		})
+	}
+	if metrics := invocation.Edges.Metrics; metrics != nil {
+		r.add(&bes.BuildEvent{
+			Id:      &bes.BuildEventId{Id: &bes.BuildEventId_BuildMetrics{BuildMetrics: &bes.BuildEventId_BuildMetricsId{}}},
+			Payload: &bes.BuildEvent_BuildMetrics{BuildMetrics: buildMetrics(metrics)},
+		})
+	}
+	r.toolLogs(invocation)
+	return r.buildEvents, nil
+}
+
+// Add the event starting the invocation.
+func (r *reconstruction) started(invocation *ent.BazelInvocation) {
+	r.add(&bes.BuildEvent{
+		Id: &bes.BuildEventId{Id: &bes.BuildEventId_Started{Started: &bes.BuildEventId_BuildStartedId{}}},
+		Payload: &bes.BuildEvent_Started{Started: &bes.BuildStarted{
+			Uuid:             invocation.InvocationID.String(),
+			StartTime:        timestamppb.New(invocation.StartedAt),
+			BuildToolVersion: invocation.Summary.BazelVersion,
+			Command:          invocation.Summary.BazelCommandLine.Command,
+		}},
+	})
+}
+
+// Add the original command line, with the client environment, and the options parsed from it.
+func (r *reconstruction) commandLine(invocationSummary summary.InvocationSummary) {
+	commandLine := invocationSummary.BazelCommandLine
+	var sections []*bescore.CommandLineSection
+	for _, section := range []struct{ label, chunks string }{
+		{"executable", commandLine.Executable},
+		{"command", commandLine.Command},
+		{"residual", commandLine.Residual},
+	} {
+		if section.chunks == "" {
+			continue
+		}
+		sections = append(sections, &bescore.CommandLineSection{
+			SectionLabel: section.label,
+			SectionType: &bescore.CommandLineSection_ChunkList{
+				ChunkList: &bescore.ChunkList{Chunk: []string{section.chunks}},
+			},
+		})
+	}
+	if invocationSummary.EnvVars != nil {
+		names := make([]string, 0, len(invocationSummary.EnvVars))
+		for name := range invocationSummary.EnvVars {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+		options := make([]*bescore.Option, 0, len(names))
+		for _, name := range names {
+			value := invocationSummary.EnvVars[name]
+			if name == "GERRIT_CHANGE_COMMIT_MESSAGE" {
+				// Decoded by the summarizer.
+				value = base64.StdEncoding.EncodeToString([]byte(value))
+			}
+			options = append(options, &bescore.Option{
+				CombinedForm: fmt.Sprintf("--client_env=%s=%s", name, value),
+				OptionName:   "client_env",
+				OptionValue:  fmt.Sprintf("%s=%s", name, value),
+			})
+		}
+		sections = append(sections, &bescore.CommandLineSection{
+			SectionLabel: "command options",
+			SectionType: &bescore.CommandLineSection_OptionList{
+				OptionList: &bescore.OptionList{Option: options},
+			},
+		})
+	}
+	if len(sections) > 0 {
+		r.add(&bes.BuildEvent{
+			Id: &bes.BuildEventId{Id: &bes.BuildEventId_StructuredCommandLine{
+				StructuredCommandLine: &bes.BuildEventId_StructuredCommandLineId{CommandLineLabel: "original"},
+			}},
+			Payload: &bes.BuildEvent_StructuredCommandLine{StructuredCommandLine: &bescore.CommandLine{
+				CommandLineLabel: "original",
+				Sections:         sections,
+			}},
+		})
+	}
+	if commandLine.Options != nil {
+		r.add(&bes.BuildEvent{
+			Id: &bes.BuildEventId{Id: &bes.BuildEventId_OptionsParsed{OptionsParsed: &bes.BuildEventId_OptionsParsedId{}}},
+			Payload: &bes.BuildEvent_OptionsParsed{OptionsParsed: &bes.OptionsParsed{
+				ExplicitCmdLine: commandLine.Options,
+			}},
+		})
+	}
+}
+
+// Add the metadata of the build step and of its user.
+func (r *reconstruction) metadata(invocation *ent.BazelInvocation) {
+	metadata := map[string]string{}
+	for key, value := range map[string]string{
+		summary.StepLabelKey: invocation.StepLabel,
+		summary.UserEmailKey: invocation.UserEmail,
+		summary.UserLdapKey:  invocation.UserLdap,
+	} {
+		if value != "" {
+			metadata[key] = value
+		}
+	}
+	if len(metadata) == 0 {
+		return
+	}
+	r.add(&bes.BuildEvent{
+		Id:      &bes.BuildEventId{Id: &bes.BuildEventId_BuildMetadata{BuildMetadata: &bes.BuildEventId_BuildMetadataId{}}},
+		Payload: &bes.BuildEvent_BuildMetadata{BuildMetadata: &bes.BuildMetadata{Metadata: metadata}},
+	})
+}
+
+// Add the configuration of the invocation.
+func (r *reconstruction) configuration(invocation *ent.BazelInvocation) {
+	if invocation.CPU == "" && invocation.PlatformName == "" && invocation.ConfigurationMnemonic == "" {
+		return
+	}
+	r.add(&bes.BuildEvent{
+		Id: &bes.BuildEventId{Id: &bes.BuildEventId_Configuration{Configuration: &bes.BuildEventId_ConfigurationId{}}},
+		Payload: &bes.BuildEvent_Configuration{Configuration: &bes.Configuration{
+			Mnemonic:     invocation.ConfigurationMnemonic,
+			PlatformName: invocation.PlatformName,
+			Cpu:          invocation.CPU,
+		}},
+	})
+}
+
+// Add the build logs, around the raw progress events of the error progress problem found in them. The logs between
+// those are written to stdout, where no error is detected.
+func (r *reconstruction) progress(buildLogs string, raw []*events.BuildEvent) {
+	for _, event := range raw {
+		text := event.GetProgress().GetStderr() + event.GetProgress().GetStdout()
+		if i := strings.Index(buildLogs, text); i >= 0 {
+			r.logs(buildLogs[:i])
+			buildLogs = buildLogs[i+len(text):]
+		}
+		r.buildEvents = append(r.buildEvents, event)
+	}
+	r.logs(buildLogs)
+}
+
+// Add a progress event with logs.
+func (r *reconstruction) logs(text string) {
+	if text == "" {
+		return
+	}
+	r.add(&bes.BuildEvent{
+		Id:      &bes.BuildEventId{Id: &bes.BuildEventId_Progress{Progress: &bes.BuildEventId_ProgressId{OpaqueCount: r.nextProgress}}},
+		Payload: &bes.BuildEvent_Progress{Progress: &bes.Progress{Stdout: text}},
+	})
+	r.nextProgress++
+}
+
+// Add the configured and completed events of targets, the raw completed events of failed target problems replacing
+// the latter. A failed target is aborted unless its completion can be sent without being detected as a problem,
+// which it would not have been as it has none.
+func (r *reconstruction) targets(targets []*ent.TargetPair, raw map[string]*events.BuildEvent, ignoresProblems bool) {
+	targets = slices.Clone(targets)
+	slices.SortFunc(targets, func(a, b *ent.TargetPair) int {
+		return strings.Compare(a.Label, b.Label)
+	})
+	for _, target := range targets {
+		configured := &bes.TargetConfigured{
+			TargetKind: target.TargetKind,
+			TestSize:   bes.TestSize(bes.TestSize_value[string(target.TestSize)]),
+		}
+		if configuration := target.Edges.Configuration; configuration != nil {
+			configured.Tag = configuration.Tag
+		}
+		r.add(&bes.BuildEvent{
+			Id: &bes.BuildEventId{Id: &bes.BuildEventId_TargetConfigured{
+				TargetConfigured: &bes.BuildEventId_TargetConfiguredId{Label: target.Label},
+			}},
+			Payload: &bes.BuildEvent_Configured{Configured: configured},
+		})
+
+		if event, ok := raw[target.Label]; ok {
+			r.buildEvents = append(r.buildEvents, event)
+			continue
+		}
+		completion := target.Edges.Completion
+		if completion == nil || completion.EndTimeInMs == 0 {
+			continue
+		}
+		event := &bes.BuildEvent{Id: &bes.BuildEventId{Id: &bes.BuildEventId_TargetCompleted{
+			TargetCompleted: &bes.BuildEventId_TargetCompletedId{Label: target.Label},
+		}}}
+		reason := cmp.Or(string(target.AbortReason), bes.Aborted_UNKNOWN.String())
+		if target.Success || (reason == bes.Aborted_UNKNOWN.String() && ignoresProblems) {
+			complete := &bes.TargetComplete{Success: target.Success, Tag: completion.Tag}
+			if completion.TestTimeout != 0 {
+				complete.TestTimeout = durationpb.New(time.Duration(completion.TestTimeout) * time.Second)
+			}
+			event.Payload = &bes.BuildEvent_Completed{Completed: complete}
+		} else {
+			event.Payload = &bes.BuildEvent_Aborted{Aborted: &bes.Aborted{
+				Reason: bes.Aborted_AbortReason(bes.Aborted_AbortReason_value[reason]),
+			}}
+		}
+		r.add(event)
+	}
+}
+
+// Add the results and summaries of tests. The raw result of a test problem replaces the last result which did not
+// pass, so that it stays the one detected, and its raw summary the summary.
+func (r *reconstruction) tests(collections []*ent.TestCollection, raw *problemEvents) {
+	collections = slices.Clone(collections)
+	slices.SortFunc(collections, func(a, b *ent.TestCollection) int {
+		return strings.Compare(a.Label, b.Label)
+	})
+	for _, collection := range collections {
+		results := collection.Edges.TestResults
+		if len(results) == 0 {
+			continue
+		}
+		resultEvents := make([]*events.BuildEvent, 0, len(results))
+		lastNotPassed := -1
+		for i, result := range results {
+			if string(result.TestStatus) != bes.TestStatus_PASSED.String() {
+				lastNotPassed = i
+			}
+			event := events.NewBuildEvent(&bes.BuildEvent{
+				Id: &bes.BuildEventId{Id: &bes.BuildEventId_TestResult{TestResult: &bes.BuildEventId_TestResultId{
+					Label:   collection.Label,
+					Run:     1,
+					Attempt: int32(i + 1),
+				}}},
+				Payload: &bes.BuildEvent_TestResult{TestResult: testResult(result)},
+			}, nil)
+			resultEvents = append(resultEvents, &event)
+		}
+		if event, ok := raw.testResult[collection.Label]; ok && lastNotPassed >= 0 {
+			resultEvents[lastNotPassed] = event
+		}
+		r.buildEvents = append(r.buildEvents, resultEvents...)
+
+		if event, ok := raw.testSummary[collection.Label]; ok {
+			r.buildEvents = append(r.buildEvents, event)
+			continue
+		}
+		testSummary := collection.Edges.TestSummary
+		if testSummary == nil || (testSummary.OverallStatus == testsummary.OverallStatusNO_STATUS && testSummary.FirstStartTime == 0) {
+			// The summary was never received.
+			continue
+		}
+		r.add(&bes.BuildEvent{
+			Id: &bes.BuildEventId{Id: &bes.BuildEventId_TestSummary{
+				TestSummary: &bes.BuildEventId_TestSummaryId{Label: collection.Label},
+			}},
+			Payload: &bes.BuildEvent_TestSummary{TestSummary: &bes.TestSummary{
+				OverallStatus:    bes.TestStatus(bes.TestStatus_value[string(testSummary.OverallStatus)]),
+				TotalRunCount:    testSummary.TotalRunCount,
+				RunCount:         testSummary.RunCount,
+				AttemptCount:     testSummary.AttemptCount,
+				ShardCount:       testSummary.ShardCount,
+				TotalNumCached:   testSummary.TotalNumCached,
+				FirstStartTime:   timestamppb.New(time.Unix(testSummary.FirstStartTime, 0)),
+				TotalRunDuration: durationpb.New(time.Duration(testSummary.TotalRunDuration) * time.Microsecond),
+			}},
+		})
+	}
+}
+
+// The result of a test run, shard or attempt.
+func testResult(result *ent.TestResultBES) *bes.TestResult {
+	testResult := &bes.TestResult{
+		Status:              bes.TestStatus(bes.TestStatus_value[string(result.TestStatus)]),
+		StatusDetails:       result.StatusDetails,
+		CachedLocally:       result.CachedLocally,
+		TestAttemptDuration: durationpb.New(time.Duration(result.TestAttemptDuration) * time.Millisecond),
+		Warning:             result.Warning,
+	}
+	if start, err := time.Parse(testAttemptStartLayout, result.TestAttemptStart); err == nil {
+		testResult.TestAttemptStart = timestamppb.New(start)
+	}
+	if info := result.Edges.ExecutionInfo; info != nil {
+		testResult.ExecutionInfo = &bes.TestResult_ExecutionInfo{
+			Strategy:        info.Strategy,
+			CachedRemotely:  info.CachedRemotely,
+			ExitCode:        info.ExitCode,
+			Hostname:        info.Hostname,
+			TimingBreakdown: timingBreakdown(info.Edges.TimingBreakdown),
+		}
+	}
+	return testResult
+}
+
+// The timing breakdown of a test, nil when none was received. The summarizer saves its time in the text format.
+func timingBreakdown(breakdown *ent.TimingBreakdown) *bes.TestResult_ExecutionInfo_TimingBreakdown {
+	if breakdown == nil || (breakdown.Name == "" && breakdown.Time == "" && len(breakdown.Edges.Child) == 0) {
+		return nil
+	}
+	result := &bes.TestResult_ExecutionInfo_TimingBreakdown{Name: breakdown.Name}
+	var breakdownTime durationpb.Duration
+	if err := prototext.Unmarshal([]byte(breakdown.Time), &breakdownTime); err == nil {
+		result.Time = &breakdownTime
+	}
+	for _, child := range breakdown.Edges.Child {
+		childTime, _ := time.ParseDuration(child.Time)
+		result.Child = append(result.Child, &bes.TestResult_ExecutionInfo_TimingBreakdown{
+			Name: child.Name,
+			Time: durationpb.New(childTime),
+		})
+	}
+	return result
+}
+
+// The first of the entities of a metrics edge, which holds a single one, or an empty one.
+func first[T any](entities []*T) *T {
+	if len(entities) == 0 {
+		return new(T)
+	}
+	return entities[0]
+}
+
+// The build metrics of an invocation, all of their messages set as the summarizer reads them.
+func buildMetrics(metrics *ent.Metrics) *bes.BuildMetrics {
+	actionSummary := first(metrics.Edges.ActionSummary)
+	actionCacheStatistics := first(actionSummary.Edges.ActionCacheStatistics)
+	memoryMetrics := first(metrics.Edges.MemoryMetrics)
+	targetMetrics := first(metrics.Edges.TargetMetrics)
+	packageMetrics := first(metrics.Edges.PackageMetrics)
+	timingMetrics := first(metrics.Edges.TimingMetrics)
+	cumulativeMetrics := first(metrics.Edges.CumulativeMetrics)
+	artifactMetrics := first(metrics.Edges.ArtifactMetrics)
+	buildGraphMetrics := first(metrics.Edges.BuildGraphMetrics)
+
+	result := &bes.BuildMetrics{
+		ActionSummary: &bes.BuildMetrics_ActionSummary{
+			ActionsCreated:                    actionSummary.ActionsCreated,
+			ActionsCreatedNotIncludingAspects: actionSummary.ActionsCreatedNotIncludingAspects,
+			ActionsExecuted:                   actionSummary.ActionsExecuted,
+			ActionCacheStatistics: &bescore.ActionCacheStatistics{
+				SizeInBytes:  actionCacheStatistics.SizeInBytes,
+				SaveTimeInMs: actionCacheStatistics.SaveTimeInMs,
+				Hits:         actionCacheStatistics.Hits,
+				Misses:       actionCacheStatistics.Misses,
+			},
+		},
+		MemoryMetrics: &bes.BuildMetrics_MemoryMetrics{
+			UsedHeapSizePostBuild:          memoryMetrics.UsedHeapSizePostBuild,
+			PeakPostGcHeapSize:             memoryMetrics.PeakPostGcHeapSize,
+			PeakPostGcTenuredSpaceHeapSize: memoryMetrics.PeakPostGcTenuredSpaceHeapSize,
+		},
+		TargetMetrics: &bes.BuildMetrics_TargetMetrics{
+			TargetsLoaded:                        targetMetrics.TargetsLoaded,
+			TargetsConfigured:                    targetMetrics.TargetsConfigured,
+			TargetsConfiguredNotIncludingAspects: targetMetrics.TargetsConfiguredNotIncludingAspects,
+		},
+		PackageMetrics: &bes.BuildMetrics_PackageMetrics{
+			PackagesLoaded: packageMetrics.PackagesLoaded,
+		},
+		TimingMetrics: &bes.BuildMetrics_TimingMetrics{
+			CpuTimeInMs:            timingMetrics.CPUTimeInMs,
+			WallTimeInMs:           timingMetrics.WallTimeInMs,
+			AnalysisPhaseTimeInMs:  timingMetrics.AnalysisPhaseTimeInMs,
+			ExecutionPhaseTimeInMs: timingMetrics.ExecutionPhaseTimeInMs,
+		},
+		CumulativeMetrics: &bes.BuildMetrics_CumulativeMetrics{
+			NumAnalyses: cumulativeMetrics.NumAnalyses,
+			NumBuilds:   cumulativeMetrics.NumBuilds,
+		},
+		ArtifactMetrics: &bes.BuildMetrics_ArtifactMetrics{
+			SourceArtifactsRead:            filesMetric(artifactMetrics.Edges.SourceArtifactsRead),
+			OutputArtifactsSeen:            filesMetric(artifactMetrics.Edges.OutputArtifactsSeen),
+			OutputArtifactsFromActionCache: filesMetric(artifactMetrics.Edges.OutputArtifactsFromActionCache),
+			TopLevelArtifacts:              filesMetric(artifactMetrics.Edges.TopLevelArtifacts),
+		},
+		BuildGraphMetrics: &bes.BuildMetrics_BuildGraphMetrics{
+			ActionLookupValueCount:                    buildGraphMetrics.ActionLookupValueCount,
+			ActionLookupValueCountNotIncludingAspects: buildGraphMetrics.ActionLookupValueCountNotIncludingAspects,
+			ActionCount:                     buildGraphMetrics.ActionCount,
+			InputFileConfiguredTargetCount:  buildGraphMetrics.InputFileConfiguredTargetCount,
+			OutputFileConfiguredTargetCount: buildGraphMetrics.OutputFileConfiguredTargetCount,
+			OtherConfiguredTargetCount:      buildGraphMetrics.OtherConfiguredTargetCount,
+			OutputArtifactCount:             buildGraphMetrics.OutputArtifactCount,
+			PostInvocationSkyframeNodeCount: buildGraphMetrics.PostInvocationSkyframeNodeCount,
+		},
+	}
+	for _, actionData := range actionSummary.Edges.ActionData {
+		result.ActionSummary.ActionData = append(result.ActionSummary.ActionData, &bes.BuildMetrics_ActionSummary_ActionData{
+			Mnemonic:        actionData.Mnemonic,
+			ActionsExecuted: actionData.ActionsExecuted,
+			FirstStartedMs:  actionData.FirstStartedMs,
+			LastEndedMs:     actionData.LastEndedMs,
+			SystemTime:      durationpb.New(time.Duration(actionData.SystemTime) * time.Millisecond),
+			UserTime:        durationpb.New(time.Duration(actionData.UserTime) * time.Millisecond),
+		})
+	}
+	for _, runnerCount := range actionSummary.Edges.RunnerCount {
+		result.ActionSummary.RunnerCount = append(result.ActionSummary.RunnerCount, &bes.BuildMetrics_ActionSummary_RunnerCount{
+			Name:     runnerCount.Name,
+			Count:    int32(runnerCount.ActionsExecuted),
+			ExecKind: runnerCount.ExecKind,
+		})
+	}
+	for _, missDetail := range actionCacheStatistics.Edges.MissDetails {
+		result.ActionSummary.ActionCacheStatistics.MissDetails = append(result.ActionSummary.ActionCacheStatistics.MissDetails, &bescore.ActionCacheStatistics_MissDetail{
+			Reason: missReason(missDetail.Reason),
+			Count:  missDetail.Count,
+		})
+	}
+	for _, garbageMetrics := range memoryMetrics.Edges.GarbageMetrics {
+		result.MemoryMetrics.GarbageMetrics = append(result.MemoryMetrics.GarbageMetrics, &bes.BuildMetrics_MemoryMetrics_GarbageMetrics{
+			Type:             garbageMetrics.Type,
+			GarbageCollected: garbageMetrics.GarbageCollected,
+		})
+	}
+	for _, packageLoadMetrics := range packageMetrics.Edges.PackageLoadMetrics {
+		result.PackageMetrics.PackageLoadMetrics = append(result.PackageMetrics.PackageLoadMetrics, &bescore.PackageLoadMetrics{
+			Name:               proto.String(packageLoadMetrics.Name),
+			LoadDuration:       durationpb.New(time.Duration(packageLoadMetrics.LoadDuration) * time.Millisecond),
+			NumTargets:         proto.Uint64(packageLoadMetrics.NumTargets),
+			ComputationSteps:   proto.Uint64(packageLoadMetrics.ComputationSteps),
+			NumTransitiveLoads: proto.Uint64(packageLoadMetrics.NumTransitiveLoads),
+			PackageOverhead:    proto.Uint64(packageLoadMetrics.PackageOverhead),
+		})
+	}
+	if len(metrics.Edges.NetworkMetrics) > 0 {
+		stats := first(metrics.Edges.NetworkMetrics[0].Edges.SystemNetworkStats)
+		result.NetworkMetrics = &bes.BuildMetrics_NetworkMetrics{
+			SystemNetworkStats: &bes.BuildMetrics_NetworkMetrics_SystemNetworkStats{
+				BytesSent:             stats.BytesSent,
+				BytesRecv:             stats.BytesRecv,
+				PacketsSent:           stats.PacketsSent,
+				PacketsRecv:           stats.PacketsRecv,
+				PeakBytesSentPerSec:   stats.PeakBytesSentPerSec,
+				PeakBytesRecvPerSec:   stats.PeakBytesRecvPerSec,
+				PeakPacketsSentPerSec: stats.PeakPacketsSentPerSec,
+				PeakPacketsRecvPerSec: stats.PeakPacketsRecvPerSec,
+			},
+		}
+	}
+	return result
+}
+
+// A files metric of the artifact metrics.
+func filesMetric(entities []*ent.FilesMetric) *bes.BuildMetrics_ArtifactMetrics_FilesMetric {
+	metric := first(entities)
+	return &bes.BuildMetrics_ArtifactMetrics_FilesMetric{SizeInBytes: metric.SizeInBytes, Count: metric.Count}
+}
+
+// The reason of action cache misses, numbered as the summarizer reads them.
+func missReason(reason missdetail.Reason) bescore.ActionCacheStatistics_MissReason {
+	for r := summary.MissReason(0); r <= summary.MissReasonUNCONDITIONALEXECUTION; r++ {
+		if r.String() == string(reason) {
+			return bescore.ActionCacheStatistics_MissReason(r)
+		}
+	}
+	return 0
+}
+
+// Add the logs of the build tool, but the event file, last. It is the last message of a completed invocation.
+func (r *reconstruction) toolLogs(invocation *ent.BazelInvocation) {
+	eventFileURL := ""
+	if invocation.Edges.EventFile != nil {
+		eventFileURL = invocation.Edges.EventFile.URL
+	}
+	names := make([]string, 0, len(invocation.RelatedFiles))
+	for name, uri := range invocation.RelatedFiles {
+		if uri != "" && uri != eventFileURL {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	logs := make([]*bes.File, 0, len(names))
+	for _, name := range names {
+		logs = append(logs, &bes.File{Name: name, File: &bes.File_Uri{Uri: invocation.RelatedFiles[name]}})
+	}
+	r.add(&bes.BuildEvent{
+		Id:          &bes.BuildEventId{Id: &bes.BuildEventId_BuildToolLogs{BuildToolLogs: &bes.BuildEventId_BuildToolLogsId{}}},
+		Payload:     &bes.BuildEvent_BuildToolLogs{BuildToolLogs: &bes.BuildToolLogs{Log: logs}},
+		LastMessage: invocation.BepCompleted,
+	})
+}
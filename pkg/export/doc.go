@@ -0,0 +1,5 @@
+// Package export builds the versioned JSON documents of invocations and builds served by the REST API to the
+// integrations which do not use GraphQL, and renders them as JUnit XML and Markdown reports. The fields of a version
+// are only ever added to, a change breaking the documents bumps Version. It also loads the build events of an
+// invocation, reconstructing them from the saved entities when the file it was imported from is gone.
+package export
@@ -0,0 +1,115 @@
+package export
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Version The version of the documents.
+const Version = "v1"
+
+// Kinds of documents.
+const (
+	KindInvocation        = "Invocation"
+	KindInvocationSummary = "InvocationSummary"
+	KindBuild             = "Build"
+)
+
+// Header The version and kind of a document, first in its JSON.
+type Header struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+}
+
+// InvocationSummary The summary of an invocation, as listed.
+type InvocationSummary struct {
+	InvocationID          uuid.UUID  `json:"invocationId"`
+	BuildUUID             *uuid.UUID `json:"buildUuid,omitempty"`
+	StartedAt             time.Time  `json:"startedAt"`
+	EndedAt               *time.Time `json:"endedAt,omitempty"`
+	Command               string     `json:"command,omitempty"`
+	StepLabel             string     `json:"stepLabel,omitempty"`
+	Branch                string     `json:"branch,omitempty"`
+	Commit                string     `json:"commit,omitempty"`
+	UserLdap              string     `json:"userLdap,omitempty"`
+	UserEmail             string     `json:"userEmail,omitempty"`
+	BazelVersion          string     `json:"bazelVersion,omitempty"`
+	BEPCompleted          bool       `json:"bepCompleted"`
+	ExitCode              *ExitCode  `json:"exitCode,omitempty"`
+	FailureClassification string     `json:"failureClassification,omitempty"`
+	Pinned                bool       `json:"pinned"`
+}
+
+// ExitCode The exit code of an invocation.
+type ExitCode struct {
+	Code int    `json:"code"`
+	Name string `json:"name"`
+}
+
+// InvocationSummaryDocument A summary of an invocation, as a line of the list of invocations.
+type InvocationSummaryDocument struct {
+	Header
+	InvocationSummary
+}
+
+// Invocation The document of an invocation.
+type Invocation struct {
+	Header
+	InvocationSummary
+	Problems []Problem `json:"problems"`
+	Targets  []Target  `json:"targets"`
+	Tests    []Test    `json:"tests"`
+	// Metrics are the key metrics of the invocation, by the names of the metrics trends.
+	Metrics map[string]float64 `json:"metrics"`
+}
+
+// Problem A problem of an invocation.
+type Problem struct {
+	Type                  string `json:"type"`
+	Label                 string `json:"label"`
+	Fingerprint           string `json:"fingerprint,omitempty"`
+	FailureClassification string `json:"failureClassification,omitempty"`
+	NewlyFailing          *bool  `json:"newlyFailing,omitempty"`
+}
+
+// Target A target of an invocation.
+type Target struct {
+	Label       string `json:"label"`
+	Kind        string `json:"kind,omitempty"`
+	Success     bool   `json:"success"`
+	DurationMs  int64  `json:"durationMs"`
+	TestSize    string `json:"testSize,omitempty"`
+	AbortReason string `json:"abortReason,omitempty"`
+}
+
+// Test A test of an invocation, with the results of its runs, shards and attempts.
+type Test struct {
+	Label          string       `json:"label"`
+	Status         string       `json:"status"`
+	Strategy       string       `json:"strategy,omitempty"`
+	CachedLocally  bool         `json:"cachedLocally"`
+	CachedRemotely bool         `json:"cachedRemotely"`
+	DurationMs     int64        `json:"durationMs"`
+	RunCount       int32        `json:"runCount"`
+	AttemptCount   int32        `json:"attemptCount"`
+	ShardCount     int32        `json:"shardCount"`
+	Results        []TestResult `json:"results"`
+}
+
+// TestResult The result of a run, shard or attempt of a test.
+type TestResult struct {
+	Status        string     `json:"status"`
+	StatusDetails string     `json:"statusDetails,omitempty"`
+	CachedLocally bool       `json:"cachedLocally"`
+	StartedAt     *time.Time `json:"startedAt,omitempty"`
+	DurationMs    int64      `json:"durationMs"`
+}
+
+// Build The document of a build, with the summaries of its invocations, latest first.
+type Build struct {
+	Header
+	BuildUUID   uuid.UUID           `json:"buildUuid"`
+	BuildURL    string              `json:"buildUrl"`
+	Invocations []InvocationSummary `json:"invocations"`
+}
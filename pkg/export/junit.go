@@ -0,0 +1,193 @@
+package export
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// The root of a JUnit XML report.
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr,omitempty"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+// The test suite of an invocation.
+type junitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	ID         string          `xml:"id,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Errors     int             `xml:"errors,attr"`
+	Skipped    int             `xml:"skipped,attr"`
+	Time       string          `xml:"time,attr"`
+	Timestamp  string          `xml:"timestamp,attr,omitempty"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	Cases      []junitTestCase `xml:"testcase"`
+}
+
+// A property of a test suite.
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+// The test case of a test.
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Error     *junitFailure `xml:"error,omitempty"`
+	Skipped   *junitFailure `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+// The failure, error or skipping of a test case.
+type junitFailure struct {
+	Message string `xml:"message,attr,omitempty"`
+	Type    string `xml:"type,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit Render the report as JUnit XML, a test suite per invocation with a test case per test. The results of the
+// runs, shards and attempts of a test are listed in its failure, or its output when it passed.
+func (r *Report) WriteJUnit(w io.Writer) error {
+	suites := junitTestSuites{Suites: make([]junitTestSuite, 0, len(r.Invocations))}
+	if r.Build != nil {
+		suites.Name = r.Build.BuildURL
+	}
+	var durationMs int64
+	for _, invocation := range r.Invocations {
+		suite := r.junitTestSuite(invocation)
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Errors += suite.Errors
+		suites.Skipped += suite.Skipped
+		for _, test := range invocation.Tests {
+			durationMs += test.DurationMs
+		}
+		suites.Suites = append(suites.Suites, suite)
+	}
+	suites.Time = seconds(durationMs)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return fmt.Errorf("could not write JUnit XML: %w", err)
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(suites); err != nil {
+		return fmt.Errorf("could not write JUnit XML: %w", err)
+	}
+	if _, err := io.WriteString(w, "\n"); err != nil {
+		return fmt.Errorf("could not write JUnit XML: %w", err)
+	}
+	return nil
+}
+
+// The test suite of an invocation.
+func (r *Report) junitTestSuite(invocation *Invocation) junitTestSuite {
+	name := invocation.InvocationID.String()
+	if invocation.StepLabel != "" {
+		name = invocation.StepLabel
+	}
+	suite := junitTestSuite{
+		Name:      name,
+		ID:        invocation.InvocationID.String(),
+		Tests:     len(invocation.Tests),
+		Timestamp: invocation.StartedAt.UTC().Format(time.RFC3339),
+		Cases:     make([]junitTestCase, 0, len(invocation.Tests)),
+	}
+	exitCode := ""
+	if invocation.ExitCode != nil {
+		exitCode = invocation.ExitCode.Name
+	}
+	for _, property := range []junitProperty{
+		{Name: "url", Value: r.InvocationURL(invocation.InvocationID)},
+		{Name: "command", Value: invocation.Command},
+		{Name: "exitCode", Value: exitCode},
+		{Name: "branch", Value: invocation.Branch},
+		{Name: "commit", Value: invocation.Commit},
+	} {
+		if property.Value != "" {
+			suite.Properties = append(suite.Properties, property)
+		}
+	}
+
+	var durationMs int64
+	for _, test := range invocation.Tests {
+		testCase := junitCase(test)
+		switch {
+		case testCase.Failure != nil:
+			suite.Failures++
+		case testCase.Error != nil:
+			suite.Errors++
+		case testCase.Skipped != nil:
+			suite.Skipped++
+		}
+		durationMs += test.DurationMs
+		suite.Cases = append(suite.Cases, testCase)
+	}
+	suite.Time = seconds(durationMs)
+	return suite
+}
+
+// The test case of a test, named after the target in the class of its package.
+func junitCase(test Test) junitTestCase {
+	classname, name := test.Label, test.Label
+	if i := strings.LastIndex(test.Label, ":"); i >= 0 {
+		classname, name = test.Label[:i], test.Label[i+1:]
+	}
+	testCase := junitTestCase{
+		Name:      name,
+		Classname: classname,
+		Time:      seconds(test.DurationMs),
+	}
+
+	var message string
+	var results strings.Builder
+	for i, result := range test.Results {
+		fmt.Fprintf(&results, "#%d %s", i+1, result.Status)
+		if result.DurationMs > 0 {
+			fmt.Fprintf(&results, " in %ss", seconds(result.DurationMs))
+		}
+		if result.CachedLocally {
+			results.WriteString(" (cached)")
+		}
+		if result.StatusDetails != "" {
+			fmt.Fprintf(&results, ": %s", result.StatusDetails)
+			if message == "" && outcome(result.Status) != testPassed {
+				message = result.StatusDetails
+			}
+		}
+		results.WriteString("\n")
+	}
+	if message == "" {
+		message = fmt.Sprintf("%s %s", test.Label, test.Status)
+	}
+
+	switch outcome(test.Status) {
+	case testFailed:
+		testCase.Failure = &junitFailure{Message: message, Type: test.Status, Text: results.String()}
+	case testErrored:
+		testCase.Error = &junitFailure{Message: message, Type: test.Status, Text: results.String()}
+	case testSkipped:
+		testCase.Skipped = &junitFailure{Message: "no status"}
+	default:
+		testCase.SystemOut = results.String()
+	}
+	return testCase
+}
+
+// A duration in milliseconds as the seconds of JUnit.
+func seconds(durationMs int64) string {
+	return fmt.Sprintf("%.3f", float64(durationMs)/1000)
+}
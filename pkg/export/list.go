@@ -0,0 +1,132 @@
+package export
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/build"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/predicate"
+)
+
+// Page sizes of the list of invocations.
+const (
+	DefaultListLimit = 100
+	MaxListLimit     = 1000
+)
+
+// ErrInvalidCursor An error helper.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// ListFilter The filters of the list of invocations, the empty ones matching all invocations.
+type ListFilter struct {
+	BuildUUID *uuid.UUID
+	Branch    string
+	Commit    string
+	StepLabel string
+	User      string
+	// Since and Until bound when the invocations started, [Since, Until).
+	Since *time.Time
+	Until *time.Time
+}
+
+// ListPage The page of invocations after a cursor, the first page if empty, and how many at most, DefaultListLimit
+// when 0.
+type ListPage struct {
+	Cursor string
+	Limit  int
+}
+
+// ListInvocations The summaries of the invocations matching a filter, the last saved first, and the cursor of the next
+// page, empty if this is the last one.
+func ListInvocations(ctx context.Context, client *ent.Client, filter ListFilter, page ListPage) ([]InvocationSummary, string, error) {
+	limit := page.Limit
+	if limit <= 0 {
+		limit = DefaultListLimit
+	}
+	limit = min(limit, MaxListLimit)
+
+	predicates := filterPredicates(filter)
+	if page.Cursor != "" {
+		id, err := decodeCursor(page.Cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		predicates = append(predicates, bazelinvocation.IDLT(id))
+	}
+	invocations, err := client.BazelInvocation.Query().
+		Where(predicates...).
+		WithBuild().
+		Order(ent.Desc(bazelinvocation.FieldID)).
+		Limit(limit + 1).
+		All(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not list invocations: %w", err)
+	}
+
+	next := ""
+	if len(invocations) > limit {
+		invocations = invocations[:limit]
+		next = encodeCursor(invocations[limit-1].ID)
+	}
+	summaries := make([]InvocationSummary, 0, len(invocations))
+	for _, invocation := range invocations {
+		summaries = append(summaries, invocationSummary(invocation))
+	}
+	return summaries, next, nil
+}
+
+// The predicates of the filters which are set.
+func filterPredicates(filter ListFilter) []predicate.BazelInvocation {
+	var predicates []predicate.BazelInvocation
+	if filter.BuildUUID != nil {
+		predicates = append(predicates, bazelinvocation.HasBuildWith(build.BuildUUID(*filter.BuildUUID)))
+	}
+	if filter.Branch != "" {
+		predicates = append(predicates, bazelinvocation.Branch(filter.Branch))
+	}
+	if filter.Commit != "" {
+		predicates = append(predicates, bazelinvocation.Commit(filter.Commit))
+	}
+	if filter.StepLabel != "" {
+		predicates = append(predicates, bazelinvocation.StepLabel(filter.StepLabel))
+	}
+	if filter.User != "" {
+		predicates = append(predicates, bazelinvocation.Or(
+			bazelinvocation.UserLdap(filter.User),
+			bazelinvocation.UserEmail(filter.User),
+		))
+	}
+	if filter.Since != nil {
+		predicates = append(predicates, bazelinvocation.StartedAtGTE(*filter.Since))
+	}
+	if filter.Until != nil {
+		predicates = append(predicates, bazelinvocation.StartedAtLT(*filter.Until))
+	}
+	return predicates
+}
+
+// The opaque cursor after an invocation.
+func encodeCursor(id int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(id)))
+}
+
+// The row ID of the invocation a cursor is after.
+func decodeCursor(cursor string) (int, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+	id, err := strconv.Atoi(string(decoded))
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+	return id, nil
+}
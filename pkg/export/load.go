@@ -0,0 +1,198 @@
+package export
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/build"
+	"github.com/buildbarn/bb-portal/pkg/rollup"
+)
+
+// LoadInvocation The document of an invocation, a not found error if there is none with the ID.
+func LoadInvocation(ctx context.Context, client *ent.Client, invocationID uuid.UUID) (*Invocation, error) {
+	invocation, err := documentQuery(client).
+		Where(bazelinvocation.InvocationID(invocationID)).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not load invocation %s: %w", invocationID, err)
+	}
+	return invocationDocument(invocation), nil
+}
+
+// LoadBuildInvocations The document of a build and the documents of its invocations, latest first, a not found error
+// if there is none with the UUID.
+func LoadBuildInvocations(ctx context.Context, client *ent.Client, buildUUID uuid.UUID) (*Build, []*Invocation, error) {
+	document, err := LoadBuild(ctx, client, buildUUID)
+	if err != nil {
+		return nil, nil, err
+	}
+	invocations, err := documentQuery(client).
+		Where(bazelinvocation.HasBuildWith(build.BuildUUID(buildUUID))).
+		Order(ent.Desc(bazelinvocation.FieldStartedAt), ent.Desc(bazelinvocation.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not load the invocations of build %s: %w", buildUUID, err)
+	}
+	documents := make([]*Invocation, 0, len(invocations))
+	for _, invocation := range invocations {
+		documents = append(documents, invocationDocument(invocation))
+	}
+	return document, documents, nil
+}
+
+// The query of invocations with the edges of their documents.
+func documentQuery(client *ent.Client) *ent.BazelInvocationQuery {
+	return client.BazelInvocation.Query().
+		WithBuild().
+		WithProblems().
+		WithTargets().
+		WithTestCollection(func(query *ent.TestCollectionQuery) {
+			query.WithTestSummary().WithTestResults()
+		}).
+		WithMetrics(rollup.WithMetricsEdges)
+}
+
+// LoadBuild The document of a build, a not found error if there is none with the UUID.
+func LoadBuild(ctx context.Context, client *ent.Client, buildUUID uuid.UUID) (*Build, error) {
+	b, err := client.Build.Query().
+		Where(build.BuildUUID(buildUUID)).
+		WithInvocations(func(query *ent.BazelInvocationQuery) {
+			query.Order(ent.Desc(bazelinvocation.FieldStartedAt), ent.Desc(bazelinvocation.FieldID))
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not load build %s: %w", buildUUID, err)
+	}
+	invocations := make([]InvocationSummary, 0, len(b.Edges.Invocations))
+	for _, invocation := range b.Edges.Invocations {
+		summary := invocationSummary(invocation)
+		summary.BuildUUID = &b.BuildUUID
+		invocations = append(invocations, summary)
+	}
+	return &Build{
+		Header:      Header{APIVersion: Version, Kind: KindBuild},
+		BuildUUID:   b.BuildUUID,
+		BuildURL:    b.BuildURL,
+		Invocations: invocations,
+	}, nil
+}
+
+// The document of an invocation loaded with its edges.
+func invocationDocument(invocation *ent.BazelInvocation) *Invocation {
+	document := &Invocation{
+		Header:            Header{APIVersion: Version, Kind: KindInvocation},
+		InvocationSummary: invocationSummary(invocation),
+		Problems:          make([]Problem, 0, len(invocation.Edges.Problems)),
+		Targets:           make([]Target, 0, len(invocation.Edges.Targets)),
+		Tests:             make([]Test, 0, len(invocation.Edges.TestCollection)),
+		Metrics:           map[string]float64{},
+	}
+
+	problems := slices.Clone(invocation.Edges.Problems)
+	slices.SortFunc(problems, func(a, b *ent.BazelInvocationProblem) int { return cmp.Compare(a.ID, b.ID) })
+	for _, problem := range problems {
+		document.Problems = append(document.Problems, Problem{
+			Type:                  problem.ProblemType,
+			Label:                 problem.Label,
+			Fingerprint:           problem.Fingerprint,
+			FailureClassification: string(problem.FailureClassification),
+			NewlyFailing:          problem.NewlyFailing,
+		})
+	}
+
+	for _, target := range invocation.Edges.Targets {
+		document.Targets = append(document.Targets, Target{
+			Label:       target.Label,
+			Kind:        target.TargetKind,
+			Success:     target.Success,
+			DurationMs:  target.DurationInMs,
+			TestSize:    string(target.TestSize),
+			AbortReason: string(target.AbortReason),
+		})
+	}
+	slices.SortFunc(document.Targets, func(a, b Target) int { return cmp.Compare(a.Label, b.Label) })
+
+	for _, testCollection := range invocation.Edges.TestCollection {
+		document.Tests = append(document.Tests, testDocument(testCollection))
+	}
+	slices.SortFunc(document.Tests, func(a, b Test) int { return cmp.Compare(a.Label, b.Label) })
+
+	if metrics := invocation.Edges.Metrics; metrics != nil {
+		for _, metric := range rollup.AllMetrics() {
+			if numerator, denominator, ok := rollup.Sample(metric, metrics); ok {
+				document.Metrics[string(metric)] = numerator / denominator
+			}
+		}
+	}
+	return document
+}
+
+// The summary of an invocation, with the UUID of its build if it was loaded.
+func invocationSummary(invocation *ent.BazelInvocation) InvocationSummary {
+	summary := InvocationSummary{
+		InvocationID:          invocation.InvocationID,
+		StartedAt:             invocation.StartedAt,
+		Command:               invocation.Summary.BazelCommandLine.Command,
+		StepLabel:             invocation.StepLabel,
+		Branch:                invocation.Branch,
+		Commit:                invocation.Commit,
+		UserLdap:              invocation.UserLdap,
+		UserEmail:             invocation.UserEmail,
+		BazelVersion:          invocation.Summary.BazelVersion,
+		BEPCompleted:          invocation.BepCompleted,
+		FailureClassification: string(invocation.FailureClassification),
+		Pinned:                invocation.Pinned,
+	}
+	if !invocation.EndedAt.IsZero() {
+		endedAt := invocation.EndedAt
+		summary.EndedAt = &endedAt
+	}
+	if exitCode := invocation.Summary.ExitCode; exitCode != nil {
+		summary.ExitCode = &ExitCode{Code: exitCode.Code, Name: exitCode.Name}
+	}
+	if b := invocation.Edges.Build; b != nil {
+		summary.BuildUUID = &b.BuildUUID
+	}
+	return summary
+}
+
+// The document of a test, with its results in the order they were saved.
+func testDocument(testCollection *ent.TestCollection) Test {
+	test := Test{
+		Label:          testCollection.Label,
+		Status:         string(testCollection.OverallStatus),
+		Strategy:       testCollection.Strategy,
+		CachedLocally:  testCollection.CachedLocally,
+		CachedRemotely: testCollection.CachedRemotely,
+		DurationMs:     testCollection.DurationMs,
+		Results:        make([]TestResult, 0, len(testCollection.Edges.TestResults)),
+	}
+	if testSummary := testCollection.Edges.TestSummary; testSummary != nil {
+		test.RunCount = testSummary.TotalRunCount
+		test.AttemptCount = testSummary.AttemptCount
+		test.ShardCount = testSummary.ShardCount
+	}
+	results := slices.Clone(testCollection.Edges.TestResults)
+	slices.SortFunc(results, func(a, b *ent.TestResultBES) int { return cmp.Compare(a.ID, b.ID) })
+	for _, result := range results {
+		testResult := TestResult{
+			Status:        string(result.TestStatus),
+			StatusDetails: result.StatusDetails,
+			CachedLocally: result.CachedLocally,
+			DurationMs:    result.TestAttemptDurationMillis,
+		}
+		if result.TestAttemptStartMillisEpoch != 0 {
+			startedAt := time.UnixMilli(result.TestAttemptStartMillisEpoch).UTC()
+			testResult.StartedAt = &startedAt
+		}
+		test.Results = append(test.Results, testResult)
+	}
+	return test
+}
@@ -0,0 +1,171 @@
+package export
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// The most items listed in a section of a Markdown report, the others are counted.
+const maxMarkdownItems = 20
+
+// WriteMarkdown Render the report as a concise Markdown summary: the outcome of the invocations with their failing
+// targets, failing tests and problems, and links back to the portal.
+func (r *Report) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+	heading := "##"
+	if r.Build != nil {
+		failed := 0
+		for _, invocation := range r.Invocations {
+			if invocationFailed(invocation) {
+				failed++
+			}
+		}
+		fmt.Fprintf(&b, "## Build [%s](%s)\n\n", markdownText(r.Build.BuildURL), r.BuildURL())
+		fmt.Fprintf(&b, "%d invocations, %d failed.\n", len(r.Invocations), failed)
+		heading = "###"
+	}
+	for i, invocation := range r.Invocations {
+		if i > 0 || r.Build != nil {
+			b.WriteString("\n")
+		}
+		r.writeMarkdownInvocation(&b, heading, invocation)
+	}
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("could not write Markdown: %w", err)
+	}
+	return nil
+}
+
+// Write the section of an invocation.
+func (r *Report) writeMarkdownInvocation(b *strings.Builder, heading string, invocation *Invocation) {
+	title := "Bazel"
+	if invocation.Command != "" {
+		title += " " + invocation.Command
+	}
+	if invocation.StepLabel != "" {
+		title += " " + markdownCode(invocation.StepLabel)
+	}
+	fmt.Fprintf(b, "%s %s %s\n\n", heading, title, invocationOutcome(invocation))
+
+	fmt.Fprintf(b, "[Invocation %s](%s)", invocation.InvocationID, r.InvocationURL(invocation.InvocationID))
+	if invocation.Branch != "" {
+		fmt.Fprintf(b, " on %s", markdownCode(invocation.Branch))
+	}
+	if invocation.Commit != "" {
+		fmt.Fprintf(b, " at %s", markdownCode(invocation.Commit))
+	}
+	if user := cmp.Or(invocation.UserLdap, invocation.UserEmail); user != "" {
+		fmt.Fprintf(b, " by %s", markdownText(user))
+	}
+	counts := map[testOutcome]int{}
+	flaky := 0
+	for _, test := range invocation.Tests {
+		counts[outcome(test.Status)]++
+		if test.Status == "FLAKY" {
+			flaky++
+		}
+	}
+	fmt.Fprintf(b, ", %d targets, %d tests: %d passed, %d flaky, %d failed.\n",
+		len(invocation.Targets), len(invocation.Tests), counts[testPassed], flaky, counts[testFailed]+counts[testErrored])
+
+	var failingTargets []string
+	for _, target := range invocation.Targets {
+		if target.Success {
+			continue
+		}
+		item := markdownCode(target.Label)
+		if target.Kind != "" {
+			item += " (" + markdownText(target.Kind) + ")"
+		}
+		if target.AbortReason != "" && target.AbortReason != "NONE" {
+			item += ": " + target.AbortReason
+		}
+		failingTargets = append(failingTargets, item)
+	}
+	r.writeMarkdownList(b, "Failing targets", failingTargets, invocation)
+
+	var failingTests []string
+	for _, test := range invocation.Tests {
+		if o := outcome(test.Status); o != testFailed && o != testErrored {
+			continue
+		}
+		item := fmt.Sprintf("%s %s", markdownCode(test.Label), test.Status)
+		failedResults := 0
+		for _, result := range test.Results {
+			if o := outcome(result.Status); o == testFailed || o == testErrored {
+				failedResults++
+			}
+		}
+		if len(test.Results) > 1 {
+			item += fmt.Sprintf(" in %d of %d runs", failedResults, len(test.Results))
+		}
+		failingTests = append(failingTests, item)
+	}
+	r.writeMarkdownList(b, "Failing tests", failingTests, invocation)
+
+	problems := make([]string, 0, len(invocation.Problems))
+	for _, problem := range invocation.Problems {
+		item := problem.Type
+		if problem.Label != "" {
+			item = fmt.Sprintf("%s %s", markdownCode(problem.Label), item)
+		}
+		if problem.NewlyFailing != nil && *problem.NewlyFailing {
+			item += ", newly failing"
+		}
+		problems = append(problems, item)
+	}
+	r.writeMarkdownList(b, "Problems", problems, invocation)
+}
+
+// Write a section listing items, those over the maximum counted with a link to the invocation.
+func (r *Report) writeMarkdownList(b *strings.Builder, title string, items []string, invocation *Invocation) {
+	if len(items) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "\n**%s**\n\n", title)
+	for i, item := range items {
+		if i == maxMarkdownItems {
+			fmt.Fprintf(b, "- [%d more](%s)\n", len(items)-i, r.InvocationURL(invocation.InvocationID))
+			break
+		}
+		fmt.Fprintf(b, "- %s\n", item)
+	}
+}
+
+// Whether an invocation failed, it exited with a non zero code.
+func invocationFailed(invocation *Invocation) bool {
+	return invocation.ExitCode != nil && invocation.ExitCode.Code != 0
+}
+
+// The outcome of an invocation in a title.
+func invocationOutcome(invocation *Invocation) string {
+	switch {
+	case invocation.ExitCode != nil && invocation.ExitCode.Name != "":
+		return invocation.ExitCode.Name
+	case invocation.ExitCode != nil:
+		return fmt.Sprintf("exited with %d", invocation.ExitCode.Code)
+	case !invocation.BEPCompleted:
+		return "in progress"
+	default:
+		return "without exit code"
+	}
+}
+
+// Text as inline code, its backticks replaced as they would end it.
+func markdownCode(text string) string {
+	return "`" + strings.ReplaceAll(text, "`", "'") + "`"
+}
+
+// Text with the characters which would be read as inline Markdown escaped.
+func markdownText(text string) string {
+	var b strings.Builder
+	for _, c := range text {
+		if strings.ContainsRune("\\`*_[]<>|~", c) {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
@@ -0,0 +1,83 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ReportFormat The format a report is rendered in.
+type ReportFormat int
+
+// Formats of reports.
+const (
+	// ReportJUnit The JUnit XML rendered by the CI systems, a test suite per invocation and a test case per test.
+	ReportJUnit ReportFormat = iota
+	// ReportMarkdown A concise Markdown summary of the failures, as posted on the pull requests.
+	ReportMarkdown
+)
+
+// ContentType The media type of the format.
+func (f ReportFormat) ContentType() string {
+	if f == ReportMarkdown {
+		return "text/markdown; charset=utf-8"
+	}
+	return "application/xml"
+}
+
+// Report The invocations a report renders, one or those of a build, and the portal it links back to.
+type Report struct {
+	// Build The build of the invocations, nil for the report of a single invocation.
+	Build       *Build
+	Invocations []*Invocation
+	// PortalURL The URL the links are relative to, without a trailing slash.
+	PortalURL string
+}
+
+// Write Render the report in a format.
+func (r *Report) Write(w io.Writer, format ReportFormat) error {
+	if format == ReportMarkdown {
+		return r.WriteMarkdown(w)
+	}
+	return r.WriteJUnit(w)
+}
+
+// InvocationURL The page of an invocation in the portal.
+func (r *Report) InvocationURL(invocationID uuid.UUID) string {
+	return fmt.Sprintf("%s/bazel-invocations/%s", strings.TrimSuffix(r.PortalURL, "/"), invocationID)
+}
+
+// BuildURL The page of the build in the portal, empty for the report of a single invocation.
+func (r *Report) BuildURL() string {
+	if r.Build == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s/builds/%s", strings.TrimSuffix(r.PortalURL, "/"), r.Build.BuildUUID)
+}
+
+// The outcomes of tests.
+type testOutcome int
+
+const (
+	testPassed testOutcome = iota
+	testFailed
+	testErrored
+	testSkipped
+)
+
+// The outcome of a test with a status: failed when its assertions failed or it timed out, errored when it could not
+// run to completion, skipped when it has no status.
+func outcome(status string) testOutcome {
+	switch status {
+	case "FAILED", "TIMEOUT":
+		return testFailed
+	case "INCOMPLETE", "REMOTE_FAILURE", "FAILED_TO_BUILD", "TOOL_HALTED_BEFORE_TESTING":
+		return testErrored
+	case "", "NO_STATUS":
+		return testSkipped
+	default:
+		return testPassed
+	}
+}
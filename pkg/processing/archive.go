@@ -11,16 +11,21 @@ import (
 	"strings"
 
 	"github.com/bazelbuild/remote-apis-sdks/go/pkg/digest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/buildbarn/bb-portal/ent/gen/ent"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
+	"github.com/buildbarn/bb-portal/pkg/cas"
+	"github.com/buildbarn/bb-portal/pkg/storage"
 	"github.com/buildbarn/bb-portal/pkg/summary/detectors"
 )
 
 // errNoArchiver An error helper.
 var errNoArchiver = errors.New("no archiver registered")
 
-// BlobArchiver A blob arhiver interace.
+// BlobArchiver A blob arhiver interace. A blob failing to archive for a reason that may go away is returned
+// QUEUED, to be retried.
 type BlobArchiver interface {
 	ArchiveBlob(ctx context.Context, blobURI detectors.BlobURI) ent.Blob
 }
@@ -42,43 +47,51 @@ func (ma *BlobMultiArchiver) RegisterArchiver(schema string, archiver BlobArchiv
 	ma.archivers[schema] = archiver
 }
 
-// ArchiveBlobs Archives blobs.
-func (ma *BlobMultiArchiver) ArchiveBlobs(ctx context.Context, blobURIs []detectors.BlobURI) ([]ent.Blob, error) {
-	if len(blobURIs) == 0 {
-		return nil, nil
-	}
-	if len(ma.archivers) == 0 {
-		return nil, nil
+// ArchiveBlobs Archives blobs, each with the archiver registered for its scheme. Blobs without one are FAILED.
+func (ma *BlobMultiArchiver) ArchiveBlobs(ctx context.Context, blobURIs []detectors.BlobURI) []ent.Blob {
+	blobs := make([]ent.Blob, 0, len(blobURIs))
+	for _, blobURI := range blobURIs {
+		blobs = append(blobs, ma.ArchiveBlob(ctx, blobURI))
 	}
-	uri, err := url.Parse(string(blobURIs[0]))
+	return blobs
+}
+
+// ArchiveBlob Archives a blob with the archiver registered for its scheme.
+func (ma *BlobMultiArchiver) ArchiveBlob(ctx context.Context, blobURI detectors.BlobURI) ent.Blob {
+	uri, err := url.Parse(string(blobURI))
 	if err != nil {
-		return nil, fmt.Errorf("invalid blob URI: %s: %w", blobURIs[0], err)
+		return ent.Blob{
+			URI:             string(blobURI),
+			ArchivingStatus: blob.ArchivingStatusFAILED,
+			Reason:          fmt.Sprintf("invalid blob URI: %s", err),
+		}
 	}
 	archiver, ok := ma.archivers[uri.Scheme]
 	if !ok {
-		return nil, fmt.Errorf("scheme %s: %w", uri.Scheme, errNoArchiver)
-	}
-	blobs := make([]ent.Blob, 0, len(blobURIs))
-	for _, blobURI := range blobURIs {
-		b := archiver.ArchiveBlob(ctx, blobURI)
-		blobs = append(blobs, b)
+		return ent.Blob{
+			URI:             string(blobURI),
+			ArchivingStatus: blob.ArchivingStatusFAILED,
+			Reason:          fmt.Errorf("scheme %s: %w", uri.Scheme, errNoArchiver).Error(),
+		}
 	}
-	return blobs, nil
+	return archiver.ArchiveBlob(ctx, blobURI)
 }
 
 // LocalFileArchiver Load file archiver struct.
 type LocalFileArchiver struct {
-	blobArchiveFolder string
+	blobStorage      storage.BlobStorage
+	maxBlobSizeBytes int64
 }
 
-// NewLocalFileArchiver Constrctor for file archiver.
-func NewLocalFileArchiver(blobArchiveFolder string) LocalFileArchiver {
-	return LocalFileArchiver{blobArchiveFolder: blobArchiveFolder}
+// NewLocalFileArchiver Constrctor for file archiver. Blobs bigger than maxBlobSizeBytes are truncated to their head
+// and tail, 0 for no limit.
+func NewLocalFileArchiver(blobStorage storage.BlobStorage, maxBlobSizeBytes int64) LocalFileArchiver {
+	return LocalFileArchiver{blobStorage: blobStorage, maxBlobSizeBytes: maxBlobSizeBytes}
 }
 
 // ArchiveBlob Archive Blob function.
-func (lfa LocalFileArchiver) ArchiveBlob(_ context.Context, blobURI detectors.BlobURI) ent.Blob {
-	b, err := lfa.archiveBlob(blobURI)
+func (lfa LocalFileArchiver) ArchiveBlob(ctx context.Context, blobURI detectors.BlobURI) ent.Blob {
+	b, err := lfa.archiveBlob(ctx, blobURI)
 	if err != nil {
 		return ent.Blob{
 			URI:             string(blobURI),
@@ -89,20 +102,23 @@ func (lfa LocalFileArchiver) ArchiveBlob(_ context.Context, blobURI detectors.Bl
 	return ent.Blob{
 		URI:             string(blobURI),
 		ArchivingStatus: blob.ArchivingStatusSUCCESS,
+		SizeBytes:       b.SizeBytes,
 		ArchiveURL:      b.ArchiveURL,
+		Reason:          b.Reason,
 	}
 }
 
 // A function to archive a blob.
-func (lfa LocalFileArchiver) archiveBlob(blobURI detectors.BlobURI) (*ent.Blob, error) {
+func (lfa LocalFileArchiver) archiveBlob(ctx context.Context, blobURI detectors.BlobURI) (*ent.Blob, error) {
 	sourcePath := strings.TrimPrefix(string(blobURI), "file://")
 	d, err := digest.NewFromFile(sourcePath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create digest for path %s: %w", sourcePath, err)
 	}
-	// Avoid using path.Join() as it removes the "./" prefix for a relative path.
-	destPath := lfa.blobArchiveFolder + "/" + d.Hash + "-" + strconv.FormatInt(d.Size, 10)
-	destPath = strings.ReplaceAll(destPath, "//", "/")
+	archived, truncated := archivedBlob(blobURI, d, lfa.maxBlobSizeBytes)
+	if exists, err := lfa.blobStorage.Exists(ctx, archived.ArchiveURL); err != nil || exists {
+		return archived, err
+	}
 
 	var source *os.File
 	if source, err = os.Open(sourcePath); err != nil {
@@ -110,18 +126,154 @@ func (lfa LocalFileArchiver) archiveBlob(blobURI detectors.BlobURI) (*ent.Blob,
 	}
 	defer source.Close()
 
-	var dest *os.File
-	if dest, err = os.Create(destPath); err != nil {
-		return nil, fmt.Errorf("failed to create destination %s: %w", destPath, err)
+	if err = lfa.blobStorage.Put(ctx, archived.ArchiveURL, truncated.reader(source), archived.SizeBytes); err != nil {
+		return nil, fmt.Errorf("failed to store %s: %w", sourcePath, err)
 	}
-	defer dest.Close()
+	return archived, nil
+}
+
+// BytestreamArchiver Archives blobs from the CAS they were uploaded to, so they outlive their eviction.
+type BytestreamArchiver struct {
+	casManager       *cas.ConnectionManager
+	blobStorage      storage.BlobStorage
+	maxBlobSizeBytes int64
+}
+
+// NewBytestreamArchiver Constructor for bytestream archiver. Blobs bigger than maxBlobSizeBytes are truncated to their
+// head and tail, 0 for no limit.
+func NewBytestreamArchiver(casManager *cas.ConnectionManager, blobStorage storage.BlobStorage, maxBlobSizeBytes int64) BytestreamArchiver {
+	return BytestreamArchiver{casManager: casManager, blobStorage: blobStorage, maxBlobSizeBytes: maxBlobSizeBytes}
+}
 
-	if _, err = io.Copy(dest, source); err != nil {
-		return nil, fmt.Errorf("failed to copy from source to destination %s: %w", destPath, err)
+// ArchiveBlob Archive Blob function.
+func (ba BytestreamArchiver) ArchiveBlob(ctx context.Context, blobURI detectors.BlobURI) ent.Blob {
+	b, err := ba.archiveBlob(ctx, blobURI)
+	if err != nil {
+		archivingStatus := blob.ArchivingStatusFAILED
+		if isTransientCASError(err) {
+			archivingStatus = blob.ArchivingStatusQUEUED
+		}
+		return ent.Blob{
+			URI:             string(blobURI),
+			ArchivingStatus: archivingStatus,
+			Reason:          err.Error(),
+		}
+	}
+	return ent.Blob{
+		URI:             string(blobURI),
+		ArchivingStatus: blob.ArchivingStatusSUCCESS,
+		SizeBytes:       b.SizeBytes,
+		ArchiveURL:      b.ArchiveURL,
+		Reason:          b.Reason,
 	}
-	return &ent.Blob{
-		URI:        destPath,
+}
+
+// A function to stream a blob from the CAS into the blob storage.
+func (ba BytestreamArchiver) archiveBlob(ctx context.Context, blobURI detectors.BlobURI) (*ent.Blob, error) {
+	uri, err := url.Parse(string(blobURI))
+	if err != nil {
+		return nil, fmt.Errorf("invalid blob URI: %s: %w", blobURI, err)
+	}
+	d, err := cas.DigestFromURI(uri)
+	if err != nil {
+		return nil, err
+	}
+	archived, truncated := archivedBlob(blobURI, d, ba.maxBlobSizeBytes)
+	if exists, err := ba.blobStorage.Exists(ctx, archived.ArchiveURL); err != nil || exists {
+		return archived, err
+	}
+
+	casClient, err := ba.casManager.GetClientForURI(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	defer casClient.Close()
+
+	// Stream from the CAS to the storage, a failed read fails the write with the same error and the other way around.
+	reader, writer := io.Pipe()
+	readDone := make(chan error, 1)
+	go func() {
+		err := casClient.ReadBlob(ctx, d, writer)
+		writer.CloseWithError(err)
+		readDone <- err
+	}()
+	err = ba.blobStorage.Put(ctx, archived.ArchiveURL, truncated.reader(reader), archived.SizeBytes)
+	reader.CloseWithError(err)
+	if readErr := <-readDone; err == nil {
+		err = readErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	return archived, nil
+}
+
+// archivedBlob The blob archived from a digest, with the key, size and note on the truncation of what is stored. Blobs
+// bigger than maxSizeBytes are truncated, and stored under keys of their own, so that the blobs found already stored
+// under a key are the same as the ones which were stored.
+func archivedBlob(blobURI detectors.BlobURI, d digest.Digest, maxSizeBytes int64) (*ent.Blob, *truncation) {
+	archived := &ent.Blob{
+		URI:        string(blobURI),
 		SizeBytes:  d.Size,
-		ArchiveURL: destPath,
-	}, nil
+		ArchiveURL: storage.KeyForDigest(d),
+	}
+	if maxSizeBytes <= 0 || d.Size <= maxSizeBytes {
+		return archived, nil
+	}
+	t := &truncation{
+		headSizeBytes: maxSizeBytes / 2,
+		skipBytes:     d.Size - maxSizeBytes,
+		marker:        fmt.Sprintf("\n\n... %d bytes truncated ...\n\n", d.Size-maxSizeBytes),
+	}
+	archived.SizeBytes = maxSizeBytes + int64(len(t.marker))
+	archived.ArchiveURL += "-truncated-" + strconv.FormatInt(maxSizeBytes, 10)
+	archived.Reason = fmt.Sprintf("truncated to the first %d and last %d of %d bytes", t.headSizeBytes, maxSizeBytes-t.headSizeBytes, d.Size)
+	return archived, t
+}
+
+// truncation Keeps the head and the tail of a blob, with a marker in place of the middle which is read and discarded.
+type truncation struct {
+	headSizeBytes int64
+	skipBytes     int64
+	marker        string
+}
+
+// The content to store of a blob, all of it without truncation.
+func (t *truncation) reader(r io.Reader) io.Reader {
+	if t == nil {
+		return r
+	}
+	return io.MultiReader(
+		io.LimitReader(r, t.headSizeBytes),
+		strings.NewReader(t.marker),
+		&skippingReader{r: r, skipBytes: t.skipBytes},
+	)
+}
+
+// skippingReader Discards the first bytes of a reader before reading the rest.
+type skippingReader struct {
+	r         io.Reader
+	skipBytes int64
+}
+
+// Read Reads after skipping.
+func (s *skippingReader) Read(p []byte) (int, error) {
+	if s.skipBytes > 0 {
+		n, err := io.CopyN(io.Discard, s.r, s.skipBytes)
+		s.skipBytes -= n
+		if err != nil {
+			return 0, err
+		}
+	}
+	return s.r.Read(p)
+}
+
+// isTransientCASError checks if reading from the CAS may succeed when tried again.
+func isTransientCASError(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
 }
@@ -0,0 +1,241 @@
+package processing
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocationproblem"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
+	"github.com/buildbarn/bb-portal/pkg/search"
+	"github.com/buildbarn/bb-portal/pkg/storage"
+	"github.com/buildbarn/bb-portal/pkg/summary/detectors"
+)
+
+// BlobArchivingParams Parameters of a blob archiving pool.
+type BlobArchivingParams struct {
+	// Workers is the number of blob batches archived concurrently.
+	Workers int
+	// BatchSize is the maximum number of blobs claimed at once by a worker.
+	BatchSize int
+	// PollInterval is how often idle workers look for queued blobs, in case they were not notified.
+	PollInterval time.Duration
+	// MaxAttempts is the number of times a blob is tried before giving up on transient failures.
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry, doubled for every next one.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between retries.
+	MaxBackoff time.Duration
+}
+
+// DefaultBlobArchivingParams The default parameters of a blob archiving pool.
+func DefaultBlobArchivingParams() BlobArchivingParams {
+	return BlobArchivingParams{
+		Workers:        4,
+		BatchSize:      16,
+		PollInterval:   10 * time.Second,
+		MaxAttempts:    5,
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Minute,
+	}
+}
+
+// BlobArchivingPool A pool of workers archiving the QUEUED blobs in the background. A blob is marked ARCHIVING
+// while a worker handles it, and ends up SUCCESS or FAILED. Archivers report transient failures by returning a
+// blob that is still QUEUED, those are retried with an exponential backoff. The archived text blobs are made
+// searchable when the pool reads the blob storage.
+type BlobArchivingPool struct {
+	db          *ent.Client
+	archiver    BlobMultiArchiver
+	blobStorage storage.BlobStorage
+	params      BlobArchivingParams
+	wake        chan struct{}
+}
+
+// NewBlobArchivingPool Constructor for a blob archiving pool, indexing the archived blobs for search unless the blob
+// storage is nil.
+func NewBlobArchivingPool(db *ent.Client, archiver BlobMultiArchiver, blobStorage storage.BlobStorage, params BlobArchivingParams) *BlobArchivingPool {
+	return &BlobArchivingPool{
+		db:          db,
+		archiver:    archiver,
+		blobStorage: blobStorage,
+		params:      params,
+		wake:        make(chan struct{}, params.Workers),
+	}
+}
+
+// Notify Wakes up idle workers, to be called after queuing blobs. Does nothing on a nil pool.
+func (p *BlobArchivingPool) Notify() {
+	if p == nil {
+		return
+	}
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run Runs the workers until the context is done. Blobs left ARCHIVING by a previous run are queued again first.
+func (p *BlobArchivingPool) Run(ctx context.Context) {
+	if err := p.db.Blob.Update().
+		Where(blob.ArchivingStatusEQ(blob.ArchivingStatusARCHIVING)).
+		SetArchivingStatus(blob.ArchivingStatusQUEUED).
+		Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to requeue interrupted blobs", "err", err)
+	}
+
+	var wg sync.WaitGroup
+	for range p.params.Workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p.work(ctx)
+		}()
+	}
+	wg.Wait()
+}
+
+// A worker loop, archiving batches of blobs until there are none left and then waiting for more.
+func (p *BlobArchivingPool) work(ctx context.Context) {
+	ticker := time.NewTicker(p.params.PollInterval)
+	defer ticker.Stop()
+	for {
+		blobs, err := p.claimBlobs(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to claim queued blobs", "err", err)
+		}
+		if len(blobs) > 0 {
+			p.archiveBlobs(ctx, blobs)
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-p.wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// Claim a batch of QUEUED blobs by marking them ARCHIVING. Blobs claimed concurrently by another worker are skipped.
+func (p *BlobArchivingPool) claimBlobs(ctx context.Context) ([]*ent.Blob, error) {
+	queued, err := p.db.Blob.Query().
+		Where(blob.ArchivingStatusEQ(blob.ArchivingStatusQUEUED)).
+		Order(ent.Asc(blob.FieldID)).
+		Limit(p.params.BatchSize).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	claimed := make([]*ent.Blob, 0, len(queued))
+	for _, b := range queued {
+		n, err := p.db.Blob.Update().
+			Where(blob.ID(b.ID), blob.ArchivingStatusEQ(blob.ArchivingStatusQUEUED)).
+			SetArchivingStatus(blob.ArchivingStatusARCHIVING).
+			Save(ctx)
+		if err != nil {
+			return claimed, err
+		}
+		if n == 1 {
+			claimed = append(claimed, b)
+		}
+	}
+	return claimed, nil
+}
+
+// Archive claimed blobs, retrying the transient failures until they succeed, fail for good or run out of attempts.
+func (p *BlobArchivingPool) archiveBlobs(ctx context.Context, blobs []*ent.Blob) {
+	pending := make([]detectors.BlobURI, 0, len(blobs))
+	for _, b := range blobs {
+		pending = append(pending, detectors.BlobURI(b.URI))
+	}
+	backoff := p.params.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		var retry []detectors.BlobURI
+		for _, archivedBlob := range p.archiver.ArchiveBlobs(ctx, pending) {
+			if archivedBlob.ArchivingStatus == blob.ArchivingStatusQUEUED {
+				if attempt < p.params.MaxAttempts {
+					retry = append(retry, detectors.BlobURI(archivedBlob.URI))
+					continue
+				}
+				archivedBlob.ArchivingStatus = blob.ArchivingStatusFAILED
+			}
+			p.updateBlobRecord(ctx, archivedBlob)
+		}
+		if len(retry) == 0 {
+			return
+		}
+		slog.InfoContext(ctx, "retrying blob archiving", "blobs", len(retry), "attempt", attempt, "backoff", backoff)
+		select {
+		case <-ctx.Done():
+			// Left ARCHIVING, to be queued again on the next run.
+			return
+		case <-time.After(backoff):
+		}
+		pending = retry
+		backoff = min(2*backoff, p.params.MaxBackoff)
+	}
+}
+
+// RetryFailed Queues the blobs which failed to archive again, only the ones referenced by an invocation when its ID
+// is given, and wakes up the workers. Returns the number of blobs queued.
+func (p *BlobArchivingPool) RetryFailed(ctx context.Context, invocationID *int) (int, error) {
+	update := p.db.Blob.Update().Where(blob.ArchivingStatusEQ(blob.ArchivingStatusFAILED))
+	if invocationID != nil {
+		update = update.Where(blob.HasProblemsWith(
+			bazelinvocationproblem.HasBazelInvocationWith(bazelinvocation.ID(*invocationID)),
+		))
+	}
+	queued, err := update.SetArchivingStatus(blob.ArchivingStatusQUEUED).ClearReason().Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("could not queue failed blobs: %w", err)
+	}
+	p.Notify()
+	return queued, nil
+}
+
+// Save the outcome of archiving a blob.
+func (p *BlobArchivingPool) updateBlobRecord(ctx context.Context, b ent.Blob) {
+	update := p.db.Blob.Update().Where(blob.URI(b.URI)).SetArchivingStatus(b.ArchivingStatus)
+	if b.ArchiveURL != "" {
+		update = update.SetArchiveURL(b.ArchiveURL)
+	}
+	if b.Reason != "" {
+		update = update.SetReason(b.Reason)
+	}
+	if b.SizeBytes != 0 {
+		update = update.SetSizeBytes(b.SizeBytes)
+	}
+	if _, err := update.Save(ctx); err != nil {
+		slog.Error("failed to save archived blob", "uri", b.URI, "err", err)
+		return
+	}
+	if b.ArchivingStatus == blob.ArchivingStatusSUCCESS {
+		p.indexBlob(ctx, b)
+	}
+}
+
+// Make an archived blob searchable.
+func (p *BlobArchivingPool) indexBlob(ctx context.Context, b ent.Blob) {
+	if p.blobStorage == nil {
+		return
+	}
+	blobID, err := p.db.Blob.Query().Where(blob.URI(b.URI)).OnlyID(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to query archived blob", "uri", b.URI, "err", err)
+		return
+	}
+	reader, err := p.blobStorage.Get(ctx, b.ArchiveURL)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to read archived blob for search", "uri", b.URI, "key", b.ArchiveURL, "err", err)
+		return
+	}
+	defer reader.Close()
+	if err = search.IndexBlob(ctx, p.db, blobID, reader); err != nil {
+		slog.ErrorContext(ctx, "failed to index archived blob for search", "uri", b.URI, "err", err)
+	}
+}
@@ -0,0 +1,103 @@
+package processing_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/enttest"
+	"github.com/buildbarn/bb-portal/pkg/processing"
+	"github.com/buildbarn/bb-portal/pkg/summary/detectors"
+)
+
+// flakyArchiver Fails every blob with a transient error on the first attempt, then archives it unless it is
+// "fake://broken".
+type flakyArchiver struct {
+	mu       sync.Mutex
+	attempts map[detectors.BlobURI]int
+}
+
+func (a *flakyArchiver) ArchiveBlob(_ context.Context, blobURI detectors.BlobURI) ent.Blob {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.attempts[blobURI]++
+	switch {
+	case a.attempts[blobURI] == 1:
+		return ent.Blob{URI: string(blobURI), ArchivingStatus: blob.ArchivingStatusQUEUED, Reason: "unavailable"}
+	case blobURI == "fake://broken":
+		return ent.Blob{URI: string(blobURI), ArchivingStatus: blob.ArchivingStatusFAILED, Reason: "broken"}
+	default:
+		return ent.Blob{URI: string(blobURI), ArchivingStatus: blob.ArchivingStatusSUCCESS, ArchiveURL: "/archive/" + string(blobURI)}
+	}
+}
+
+func TestBlobArchivingPool_Run(t *testing.T) {
+	db := enttest.Open(t, "sqlite3", "file:archivepool?mode=memory&_fk=1")
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	ctx := context.Background()
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	archiver := &flakyArchiver{attempts: map[detectors.BlobURI]int{}}
+	multiArchiver := processing.NewBlobMultiArchiver()
+	multiArchiver.RegisterArchiver("fake", archiver)
+	params := processing.DefaultBlobArchivingParams()
+	params.Workers = 1
+	params.InitialBackoff = time.Millisecond
+	pool := processing.NewBlobArchivingPool(db, multiArchiver, nil, params)
+
+	db.Blob.Create().SetURI("fake://log").SaveX(ctx)
+	db.Blob.Create().SetURI("fake://broken").SaveX(ctx)
+	// Left over by an interrupted run.
+	db.Blob.Create().SetURI("fake://interrupted").SetArchivingStatus(blob.ArchivingStatusARCHIVING).SaveX(ctx)
+
+	done := make(chan struct{})
+	go func() {
+		pool.Run(runCtx)
+		close(done)
+	}()
+	pool.Notify()
+
+	require.Eventually(t, func() bool {
+		return db.Blob.Query().Where(blob.ArchivingStatusIn(blob.ArchivingStatusQUEUED, blob.ArchivingStatusARCHIVING)).CountX(ctx) == 0
+	}, 10*time.Second, 10*time.Millisecond)
+	cancel()
+	<-done
+
+	archived := db.Blob.Query().Where(blob.URI("fake://log")).OnlyX(ctx)
+	require.Equal(t, blob.ArchivingStatusSUCCESS, archived.ArchivingStatus)
+	require.Equal(t, "/archive/fake://log", archived.ArchiveURL)
+	require.Equal(t, blob.ArchivingStatusSUCCESS, db.Blob.Query().Where(blob.URI("fake://interrupted")).OnlyX(ctx).ArchivingStatus)
+	broken := db.Blob.Query().Where(blob.URI("fake://broken")).OnlyX(ctx)
+	require.Equal(t, blob.ArchivingStatusFAILED, broken.ArchivingStatus)
+	require.Equal(t, "broken", broken.Reason)
+	require.Equal(t, 2, archiver.attempts["fake://log"])
+}
+
+func TestBlobArchivingPool_RetryFailed(t *testing.T) {
+	db := enttest.Open(t, "sqlite3", "file:archivepool_retry?mode=memory&_fk=1")
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	ctx := context.Background()
+	pool := processing.NewBlobArchivingPool(db, processing.NewBlobMultiArchiver(), nil, processing.DefaultBlobArchivingParams())
+
+	db.Blob.Create().SetURI("fake://broken").SetArchivingStatus(blob.ArchivingStatusFAILED).SetReason("broken").SaveX(ctx)
+	db.Blob.Create().SetURI("fake://log").SetArchivingStatus(blob.ArchivingStatusSUCCESS).SaveX(ctx)
+
+	queued, err := pool.RetryFailed(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, queued)
+	broken := db.Blob.Query().Where(blob.URI("fake://broken")).OnlyX(ctx)
+	require.Equal(t, blob.ArchivingStatusQUEUED, broken.ArchivingStatus)
+	require.Empty(t, broken.Reason)
+	require.Equal(t, blob.ArchivingStatusSUCCESS, db.Blob.Query().Where(blob.URI("fake://log")).OnlyX(ctx).ArchivingStatus)
+}
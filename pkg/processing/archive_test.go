@@ -0,0 +1,113 @@
+package processing_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
+	"github.com/buildbarn/bb-portal/pkg/cas"
+	"github.com/buildbarn/bb-portal/pkg/processing"
+	"github.com/buildbarn/bb-portal/pkg/storage"
+	"github.com/buildbarn/bb-portal/pkg/summary/detectors"
+)
+
+// namedArchiver Archives every blob under its name.
+type namedArchiver string
+
+func (a namedArchiver) ArchiveBlob(_ context.Context, blobURI detectors.BlobURI) ent.Blob {
+	return ent.Blob{URI: string(blobURI), ArchivingStatus: blob.ArchivingStatusSUCCESS, ArchiveURL: string(a)}
+}
+
+func TestBlobMultiArchiver_ArchiveBlobs_MixedSchemes(t *testing.T) {
+	archiver := processing.NewBlobMultiArchiver()
+	archiver.RegisterArchiver("file", namedArchiver("file"))
+	archiver.RegisterArchiver("bytestream", namedArchiver("bytestream"))
+
+	archived := archiver.ArchiveBlobs(context.Background(), []detectors.BlobURI{
+		"file:///tmp/test.log",
+		"bytestream://cas.example.com/blobs/abc/1",
+		"s3://bucket/test.log",
+		"file://%zz",
+	})
+	require.Len(t, archived, 4)
+	require.Equal(t, "file", archived[0].ArchiveURL)
+	require.Equal(t, "bytestream", archived[1].ArchiveURL)
+	require.Equal(t, blob.ArchivingStatusFAILED, archived[2].ArchivingStatus)
+	require.Equal(t, "scheme s3: no archiver registered", archived[2].Reason)
+	require.Equal(t, blob.ArchivingStatusFAILED, archived[3].ArchivingStatus)
+	require.Contains(t, archived[3].Reason, "invalid blob URI")
+}
+
+func TestLocalFileArchiver_ArchiveBlob(t *testing.T) {
+	blobStorage, err := storage.NewLocalStorage(storage.LocalStorageParams{Folder: t.TempDir()})
+	require.NoError(t, err)
+	source := filepath.Join(t.TempDir(), "test.log")
+	require.NoError(t, os.WriteFile(source, []byte("FAILED: //foo:bar_test\n"), 0o600))
+
+	archived := processing.NewLocalFileArchiver(blobStorage, 0).ArchiveBlob(context.Background(), detectors.BlobURI("file://"+source))
+	require.Equal(t, blob.ArchivingStatusSUCCESS, archived.ArchivingStatus)
+	require.Equal(t, int64(23), archived.SizeBytes)
+
+	reader, err := blobStorage.Get(context.Background(), archived.ArchiveURL)
+	require.NoError(t, err)
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, "FAILED: //foo:bar_test\n", string(content))
+}
+
+func TestLocalFileArchiver_ArchiveBlob_TruncatesAndDedupes(t *testing.T) {
+	folder := t.TempDir()
+	blobStorage, err := storage.NewLocalStorage(storage.LocalStorageParams{Folder: folder, Compress: true})
+	require.NoError(t, err)
+	archiver := processing.NewLocalFileArchiver(blobStorage, 8)
+	source := filepath.Join(t.TempDir(), "test.log")
+	require.NoError(t, os.WriteFile(source, []byte("0123456789abcdefghij"), 0o600))
+
+	archived := archiver.ArchiveBlob(context.Background(), detectors.BlobURI("file://"+source))
+	require.Equal(t, blob.ArchivingStatusSUCCESS, archived.ArchivingStatus)
+	require.Equal(t, "truncated to the first 4 and last 4 of 20 bytes", archived.Reason)
+	require.Regexp(t, `^[0-9a-f]{2}/[0-9a-f]{2}/[0-9a-f]{64}-20-truncated-8$`, archived.ArchiveURL)
+
+	reader, err := blobStorage.Get(context.Background(), archived.ArchiveURL)
+	require.NoError(t, err)
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	require.Equal(t, "0123\n\n... 12 bytes truncated ...\n\nghij", string(content))
+	require.Equal(t, int64(len(content)), archived.SizeBytes)
+
+	// The same content from another file is not stored again.
+	sizeBytes := blobStorage.TotalSizeBytes()
+	other := filepath.Join(t.TempDir(), "other.log")
+	require.NoError(t, os.WriteFile(other, []byte("0123456789abcdefghij"), 0o600))
+	archivedAgain := archiver.ArchiveBlob(context.Background(), detectors.BlobURI("file://"+other))
+	require.Equal(t, blob.ArchivingStatusSUCCESS, archivedAgain.ArchivingStatus)
+	require.Equal(t, archived.ArchiveURL, archivedAgain.ArchiveURL)
+	require.Equal(t, archived.SizeBytes, archivedAgain.SizeBytes)
+	require.Equal(t, archived.Reason, archivedAgain.Reason)
+	require.Equal(t, sizeBytes, blobStorage.TotalSizeBytes())
+
+	// Unless it is not truncated.
+	archivedWhole := processing.NewLocalFileArchiver(blobStorage, 0).ArchiveBlob(context.Background(), detectors.BlobURI("file://"+other))
+	require.Equal(t, blob.ArchivingStatusSUCCESS, archivedWhole.ArchivingStatus)
+	require.Equal(t, int64(20), archivedWhole.SizeBytes)
+	require.Empty(t, archivedWhole.Reason)
+	require.NotEqual(t, archived.ArchiveURL, archivedWhole.ArchiveURL)
+}
+
+func TestBytestreamArchiver_ArchiveBlob_InvalidDigest(t *testing.T) {
+	blobStorage, err := storage.NewLocalStorage(storage.LocalStorageParams{Folder: t.TempDir()})
+	require.NoError(t, err)
+	archiver := processing.NewBytestreamArchiver(cas.NewConnectionManager(cas.ManagerParams{}), blobStorage, 0)
+
+	archived := archiver.ArchiveBlob(context.Background(), detectors.BlobURI("bytestream://cas.example.com/blobs/not-a-digest"))
+	require.Equal(t, blob.ArchivingStatusFAILED, archived.ArchivingStatus)
+	require.Contains(t, archived.Reason, "could not create digest")
+}
@@ -0,0 +1,97 @@
+package processing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocationproblem"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/targetpair"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/testcollection"
+	"github.com/buildbarn/bb-portal/pkg/summary"
+)
+
+// maxFirstFailureLookback is the number of previous invocations on a branch looked at to find where a problem
+// started.
+const maxFirstFailureLookback = 100
+
+// FirstFailure The start of a streak of invocations on a branch failing with the same problem.
+type FirstFailure struct {
+	// Invocation is the first invocation of the streak.
+	Invocation *ent.BazelInvocation
+	// LastGreen is the last invocation before the streak in which the target or test succeeded, if found.
+	LastGreen *ent.BazelInvocation
+}
+
+// NewlyFailing tells if the problem started failing in the given invocation, nil when the history of the branch
+// does not tell.
+func (f *FirstFailure) NewlyFailing(invocation *ent.BazelInvocation) *bool {
+	if f.Invocation.ID != invocation.ID {
+		newlyFailing := false
+		return &newlyFailing
+	}
+	if f.LastGreen == nil {
+		return nil
+	}
+	newlyFailing := true
+	return &newlyFailing
+}
+
+// FindFirstFailure walks back the invocations on the branch of a failing invocation, ordered by start time, to
+// find the first one failing with a problem of the same type and label, and the last one before it in which the
+// label succeeded. Invocations that neither failed with the problem nor succeeded for the label, because they
+// did not build it, are skipped. Returns nil for invocations without a branch.
+func FindFirstFailure(ctx context.Context, db *ent.Client, invocation *ent.BazelInvocation, problemType string, label string) (*FirstFailure, error) {
+	if invocation.Branch == "" {
+		return nil, nil
+	}
+
+	previousInvocations, err := db.BazelInvocation.Query().
+		Where(
+			bazelinvocation.Branch(invocation.Branch),
+			bazelinvocation.StartedAtLT(invocation.StartedAt),
+			bazelinvocation.IDNEQ(invocation.ID),
+		).
+		Order(ent.Desc(bazelinvocation.FieldStartedAt)).
+		Limit(maxFirstFailureLookback).
+		WithProblems(func(query *ent.BazelInvocationProblemQuery) {
+			query.Where(bazelinvocationproblem.ProblemType(problemType), bazelinvocationproblem.LabelEQ(label))
+		}).
+		WithTargets(func(query *ent.TargetPairQuery) {
+			query.Where(targetpair.LabelEQ(label), targetpair.Success(true))
+		}).
+		WithTestCollection(func(query *ent.TestCollectionQuery) {
+			query.Where(
+				testcollection.LabelEQ(label),
+				testcollection.OverallStatusIn(testcollection.OverallStatusPASSED, testcollection.OverallStatusFLAKY),
+			)
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not query previous invocations: %w", err)
+	}
+
+	firstFailure := &FirstFailure{Invocation: invocation}
+	for _, previousInvocation := range previousInvocations {
+		if len(previousInvocation.Edges.Problems) > 0 {
+			firstFailure.Invocation = previousInvocation
+			continue
+		}
+		if isGreenFor(previousInvocation, label) {
+			firstFailure.LastGreen = previousInvocation
+			break
+		}
+	}
+	return firstFailure, nil
+}
+
+// isGreenFor checks if a label succeeded in an invocation loaded with its matching successful targets and tests. The
+// problems without a label are those of the whole invocation, which succeeded if its exit code tells so.
+func isGreenFor(invocation *ent.BazelInvocation, label string) bool {
+	if label != "" {
+		return len(invocation.Edges.Targets) > 0 || len(invocation.Edges.TestCollection) > 0
+	}
+	exitCode := invocation.Summary.ExitCode
+	return exitCode != nil && exitCode.Code == summary.ExitCodeSuccess
+}
@@ -0,0 +1,93 @@
+package processing_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/enttest"
+	"github.com/buildbarn/bb-portal/pkg/processing"
+	"github.com/buildbarn/bb-portal/pkg/summary"
+	"github.com/buildbarn/bb-portal/pkg/summary/detectors"
+)
+
+func TestFindFirstFailure(t *testing.T) {
+	db := enttest.Open(t, "sqlite3", "file:culprit?mode=memory&_fk=1")
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	ctx := context.Background()
+	startedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// An invocation failing with a problem for //foo:bar, or succeeding for the given labels.
+	createInvocation := func(i int, exitCode int, failing bool, greenLabels ...string) *ent.BazelInvocation {
+		eventFile := db.EventFile.Create().
+			SetURL(fmt.Sprintf("file:///%d.bep.ndjson", i)).
+			SetModTime(startedAt).
+			SetProtocol("BEP").
+			SetMimeType("application/x-ndjson").
+			SaveX(ctx)
+		invocation := db.BazelInvocation.Create().
+			SetInvocationID(uuid.New()).
+			SetStartedAt(startedAt.Add(time.Duration(i) * time.Hour)).
+			SetSummary(summary.InvocationSummary{ExitCode: &summary.ExitCode{Code: exitCode}}).
+			SetStepLabel("").
+			SetRelatedFiles(map[string]string{}).
+			SetBranch("main").
+			SetCommit(fmt.Sprintf("commit%d", i)).
+			SetEventFile(eventFile).
+			SaveX(ctx)
+		if failing {
+			db.BazelInvocationProblem.Create().
+				SetProblemType(detectors.BazelInvocationActionProblem).
+				SetLabel("//foo:bar").
+				SetBepEvents([]byte("[]")).
+				SetBazelInvocation(invocation).
+				SaveX(ctx)
+		}
+		for _, label := range greenLabels {
+			db.TargetPair.Create().
+				SetLabel(label).
+				SetSuccess(true).
+				AddBazelInvocation(invocation).
+				ExecX(ctx)
+		}
+		return invocation
+	}
+
+	createInvocation(0, 1, true)
+	lastGreen := createInvocation(1, 0, false, "//foo:bar", "//foo:other")
+	// Did not build //foo:bar.
+	unrelatedGreen := createInvocation(2, 0, false, "//unrelated:baz")
+	firstFailing := createInvocation(3, 1, true)
+	createInvocation(4, 1, true)
+	current := createInvocation(5, 1, true)
+
+	firstFailure, err := processing.FindFirstFailure(ctx, db, current, detectors.BazelInvocationActionProblem, "//foo:bar")
+	require.NoError(t, err)
+	require.Equal(t, firstFailing.ID, firstFailure.Invocation.ID)
+	require.Equal(t, lastGreen.ID, firstFailure.LastGreen.ID)
+	require.Equal(t, "commit1", firstFailure.LastGreen.Commit)
+	require.False(t, *firstFailure.NewlyFailing(current))
+
+	firstFailure, err = processing.FindFirstFailure(ctx, db, firstFailing, detectors.BazelInvocationActionProblem, "//foo:bar")
+	require.NoError(t, err)
+	require.True(t, *firstFailure.NewlyFailing(firstFailing))
+
+	firstFailure, err = processing.FindFirstFailure(ctx, db, current, detectors.BazelInvocationActionProblem, "//foo:other")
+	require.NoError(t, err)
+	require.Equal(t, current.ID, firstFailure.Invocation.ID)
+	require.True(t, *firstFailure.NewlyFailing(current))
+
+	// Problems without a label succeeded in the invocations succeeding overall.
+	firstFailure, err = processing.FindFirstFailure(ctx, db, current, detectors.BazelInvocationActionProblem, "")
+	require.NoError(t, err)
+	require.Equal(t, current.ID, firstFailure.Invocation.ID)
+	require.Equal(t, unrelatedGreen.ID, firstFailure.LastGreen.ID)
+}
@@ -0,0 +1,710 @@
+package processing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/actioncachestatistics"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/actiondata"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/actionsummary"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/artifactmetrics"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocationproblem"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/build"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/buildgraphmetrics"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/cumulativemetrics"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/dynamicexecutionmetrics"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/evaluationstat"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/eventfile"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/exectioninfo"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/filesmetric"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/garbagemetrics"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/memorymetrics"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/metrics"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/missdetail"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/networkmetrics"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/outputgroup"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/packageloadmetrics"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/packagemetrics"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/racestatistics"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/resourceusage"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/runnercount"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/searchchunk"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/systemnetworkstats"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/targetcomplete"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/targetconfigured"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/targetmetrics"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/targetpair"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/testcollection"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/testfile"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/testhealthreport"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/testresultbes"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/testsummary"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/timingbreakdown"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/timingchild"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/timingmetrics"
+	"github.com/buildbarn/bb-portal/pkg/events"
+	"github.com/buildbarn/bb-portal/pkg/rollup"
+	"github.com/buildbarn/bb-portal/pkg/storage"
+	"github.com/buildbarn/bb-portal/pkg/summary"
+	"github.com/buildbarn/bb-portal/pkg/summary/detectors"
+)
+
+// deleteChunkSize is the maximum number of rows deleted by a single statement, to stay under the limits of the
+// number of parameters of the databases.
+const deleteChunkSize = 500
+
+// migrateBatchSize is the number of problems looked at by a single query of MigrateProblemBlobs.
+const migrateBatchSize = 100
+
+// RetentionParams Parameters of the garbage collection of invocations and blobs.
+type RetentionParams struct {
+	// Interval between two garbage collections.
+	Interval time.Duration
+	// MaxAge of invocations, older ones are deleted. 0 keeps them forever.
+	MaxAge time.Duration
+	// FailedMaxAge of failed invocations, to keep them longer than MaxAge. Ignored when shorter than MaxAge.
+	FailedMaxAge time.Duration
+	// MaxInvocationsPerBuild keeps only the latest invocations of every build. 0 for no limit.
+	MaxInvocationsPerBuild int
+	// BatchSize is the number of invocations looked at by a single query.
+	BatchSize int
+}
+
+// DefaultRetentionParams The default parameters of the garbage collection, keeping everything.
+func DefaultRetentionParams() RetentionParams {
+	return RetentionParams{
+		Interval:  time.Hour,
+		BatchSize: 100,
+	}
+}
+
+// GarbageCollectionStats Counts of what the garbage collector did.
+type GarbageCollectionStats struct {
+	Runs                 int64
+	DeletedInvocations   int64
+	DeletedBuilds        int64
+	DeletedBlobs         int64
+	DeletedArchivedBlobs int64
+	Errors               int64
+}
+
+// Add the counts of another run.
+func (s *GarbageCollectionStats) add(other GarbageCollectionStats) {
+	s.Runs += other.Runs
+	s.DeletedInvocations += other.DeletedInvocations
+	s.DeletedBuilds += other.DeletedBuilds
+	s.DeletedBlobs += other.DeletedBlobs
+	s.DeletedArchivedBlobs += other.DeletedArchivedBlobs
+	s.Errors += other.Errors
+}
+
+// GarbageCollector Deletes the invocations falling out of the retention policy in the background. Pinned invocations
+// are always kept. An invocation is deleted with everything saved from its event file, and taken out of the known
+// problems and the metrics rollups, then the blobs no longer referenced by any problem are deleted along with their
+// archived content. Builds left without invocations are deleted too. Every collection also sweeps the blobs left
+// unreferenced since the previous one, whoever deleted their problems.
+type GarbageCollector struct {
+	db          *ent.Client
+	blobStorage storage.BlobStorage
+	params      RetentionParams
+
+	mu    sync.Mutex
+	stats GarbageCollectionStats
+	// The blobs found unreferenced by the previous collection.
+	unreferencedBlobIDs []int
+}
+
+// NewGarbageCollector Constructor for a garbage collector. The interval of its params must be positive to Run it.
+func NewGarbageCollector(db *ent.Client, blobStorage storage.BlobStorage, params RetentionParams) *GarbageCollector {
+	return &GarbageCollector{
+		db:          db,
+		blobStorage: blobStorage,
+		params:      params,
+	}
+}
+
+// Stats The counts of what the garbage collector did since it was created, to be published as metrics.
+func (gc *GarbageCollector) Stats() GarbageCollectionStats {
+	gc.mu.Lock()
+	defer gc.mu.Unlock()
+	return gc.stats
+}
+
+// Run Collects garbage now and then at every interval, until the context is done.
+func (gc *GarbageCollector) Run(ctx context.Context) {
+	ticker := time.NewTicker(gc.params.Interval)
+	defer ticker.Stop()
+	for {
+		if _, err := gc.Collect(ctx); err != nil {
+			slog.ErrorContext(ctx, "garbage collection failed", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Collect Deletes the invocations falling out of the retention policy and what is left unreferenced by them.
+// Failures to delete a single invocation or blob are logged and counted, and do not stop the collection.
+func (gc *GarbageCollector) Collect(ctx context.Context) (GarbageCollectionStats, error) {
+	start := time.Now()
+	run := GarbageCollectionStats{Runs: 1}
+	defer func() {
+		gc.mu.Lock()
+		defer gc.mu.Unlock()
+		gc.stats.add(run)
+	}()
+
+	invocationIDs, err := gc.expiredInvocations(ctx, start)
+	if err != nil {
+		run.Errors++
+		return run, err
+	}
+	// Failures to delete are already logged and counted.
+	deleted, _ := gc.deleteInvocations(ctx, invocationIDs)
+	run.add(deleted)
+	run.add(gc.sweepUnreferencedBlobs(ctx))
+
+	slog.InfoContext(ctx, "garbage collection done",
+		"invocations", run.DeletedInvocations,
+		"builds", run.DeletedBuilds,
+		"blobs", run.DeletedBlobs,
+		"archivedBlobs", run.DeletedArchivedBlobs,
+		"errors", run.Errors,
+		"duration", time.Since(start))
+	return run, nil
+}
+
+// DeleteInvocations Deletes invocations, pinned or not, and what is left unreferenced by them. Deletions which failed
+// are returned as errors, after the others are done.
+func (gc *GarbageCollector) DeleteInvocations(ctx context.Context, invocationIDs []int) (GarbageCollectionStats, error) {
+	deleted, err := gc.deleteInvocations(ctx, invocationIDs)
+	gc.mu.Lock()
+	defer gc.mu.Unlock()
+	gc.stats.add(deleted)
+	return deleted, err
+}
+
+// DeleteBuild Deletes a build with all its invocations, pinned or not.
+func (gc *GarbageCollector) DeleteBuild(ctx context.Context, buildID int) (GarbageCollectionStats, error) {
+	invocationIDs, err := gc.db.BazelInvocation.Query().Where(bazelinvocation.HasBuildWith(build.ID(buildID))).IDs(ctx)
+	if err != nil {
+		return GarbageCollectionStats{}, fmt.Errorf("could not query invocations of build %d: %w", buildID, err)
+	}
+	deleted, err := gc.DeleteInvocations(ctx, invocationIDs)
+	if err != nil {
+		return deleted, err
+	}
+	// A build without invocations is not deleted along with them.
+	if len(invocationIDs) == 0 {
+		if err = gc.db.Build.DeleteOneID(buildID).Exec(ctx); err != nil {
+			return deleted, fmt.Errorf("could not delete build %d: %w", buildID, err)
+		}
+		deleted.DeletedBuilds++
+		gc.mu.Lock()
+		gc.stats.DeletedBuilds++
+		gc.mu.Unlock()
+	}
+	return deleted, nil
+}
+
+// Delete invocations and what is left unreferenced by them. Failures are logged and counted, and do not stop the
+// deletion of the others.
+func (gc *GarbageCollector) deleteInvocations(ctx context.Context, invocationIDs []int) (GarbageCollectionStats, error) {
+	var run GarbageCollectionStats
+	var errs []error
+	var buildIDs, blobIDs []int
+	for _, invocationID := range invocationIDs {
+		invocationBuildIDs, invocationBlobIDs, err := gc.deleteInvocation(ctx, invocationID)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to delete invocation", "id", invocationID, "err", err)
+			run.Errors++
+			errs = append(errs, fmt.Errorf("could not delete invocation %d: %w", invocationID, err))
+			continue
+		}
+		run.DeletedInvocations++
+		buildIDs = append(buildIDs, invocationBuildIDs...)
+		blobIDs = append(blobIDs, invocationBlobIDs...)
+	}
+
+	deletedBuilds, err := gc.deleteEmptyBuilds(ctx, buildIDs)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to delete builds without invocations", "err", err)
+		run.Errors++
+		errs = append(errs, fmt.Errorf("could not delete builds without invocations: %w", err))
+	}
+	run.DeletedBuilds = int64(deletedBuilds)
+
+	blobStats := gc.deleteUnreferencedBlobs(ctx, blobIDs)
+	run.add(blobStats)
+	return run, errors.Join(errs...)
+}
+
+// Find the invocations falling out of the retention policy.
+func (gc *GarbageCollector) expiredInvocations(ctx context.Context, now time.Time) ([]int, error) {
+	var expired []int
+	if gc.params.MaxAge > 0 {
+		lastID := 0
+		for {
+			invocations, err := gc.db.BazelInvocation.Query().
+				Where(
+					bazelinvocation.Pinned(false),
+					bazelinvocation.StartedAtLT(now.Add(-gc.params.MaxAge)),
+					bazelinvocation.IDGT(lastID),
+				).
+				Order(ent.Asc(bazelinvocation.FieldID)).
+				Limit(gc.params.BatchSize).
+				Select(bazelinvocation.FieldStartedAt, bazelinvocation.FieldSummary).
+				All(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("could not query old invocations: %w", err)
+			}
+			for _, invocation := range invocations {
+				if gc.keepFailedInvocation(invocation, now) {
+					continue
+				}
+				expired = append(expired, invocation.ID)
+			}
+			if len(invocations) < gc.params.BatchSize {
+				break
+			}
+			lastID = invocations[len(invocations)-1].ID
+		}
+	}
+
+	if gc.params.MaxInvocationsPerBuild > 0 {
+		buildIDs, err := gc.db.Build.Query().IDs(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not query builds: %w", err)
+		}
+		for _, buildID := range buildIDs {
+			invocationIDs, err := gc.db.BazelInvocation.Query().
+				Where(
+					bazelinvocation.Pinned(false),
+					bazelinvocation.HasBuildWith(build.ID(buildID)),
+				).
+				Order(ent.Desc(bazelinvocation.FieldStartedAt), ent.Desc(bazelinvocation.FieldID)).
+				Offset(gc.params.MaxInvocationsPerBuild).
+				IDs(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("could not query invocations of build %d: %w", buildID, err)
+			}
+			for _, invocationID := range invocationIDs {
+				if !slices.Contains(expired, invocationID) {
+					expired = append(expired, invocationID)
+				}
+			}
+		}
+	}
+	return expired, nil
+}
+
+// Check if a failed invocation is kept longer than the others.
+func (gc *GarbageCollector) keepFailedInvocation(invocation *ent.BazelInvocation, now time.Time) bool {
+	if gc.params.FailedMaxAge <= gc.params.MaxAge {
+		return false
+	}
+	exitCode := invocation.Summary.ExitCode
+	failed := exitCode != nil && exitCode.Code != summary.ExitCodeSuccess
+	return failed && invocation.StartedAt.After(now.Add(-gc.params.FailedMaxAge))
+}
+
+// idQuery A query of the IDs of the entities in the graph of an invocation.
+type idQuery interface {
+	IDs(ctx context.Context) ([]int, error)
+}
+
+// invocationGraphNodes Entities of a single type in the graph of an invocation, reached through some edges.
+type invocationGraphNodes struct {
+	name    string
+	queries []idQuery
+	delete  func(ctx context.Context, tx *ent.Tx, ids []int) (int, error)
+}
+
+// Delete an invocation with everything saved from its event file, in a transaction, taking it out of the metrics
+// rollups and of the known problems first. Returns the IDs of its build and of the blobs referenced from its problems,
+// that may now be unreferenced.
+func (gc *GarbageCollector) deleteInvocation(ctx context.Context, invocationID int) ([]int, []int, error) {
+	tx, err := gc.db.Tx(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not start transaction: %w", err)
+	}
+	invocation, err := tx.BazelInvocation.Get(ctx, invocationID)
+	if err != nil {
+		return nil, nil, errors.Join(fmt.Errorf("could not find invocation: %w", err), tx.Rollback())
+	}
+	if err = rollup.Subtract(ctx, tx.Client(), invocationID); err != nil {
+		return nil, nil, errors.Join(fmt.Errorf("could not update metrics rollups: %w", err), tx.Rollback())
+	}
+	knownProblemIDs, err := forgetKnownProblems(ctx, tx.Client(), invocationID)
+	if err != nil {
+		return nil, nil, errors.Join(err, tx.Rollback())
+	}
+	buildIDs, blobIDs, err := deleteInvocationGraph(ctx, tx, invocationID, false)
+	if err != nil {
+		return nil, nil, errors.Join(err, tx.Rollback())
+	}
+	if err = settleKnownProblems(ctx, tx.Client(), knownProblemIDs, invocation); err != nil {
+		return nil, nil, errors.Join(err, tx.Rollback())
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("could not commit deletion: %w", err)
+	}
+	return buildIDs, blobIDs, nil
+}
+
+// Delete the graph of an invocation. The IDs of all the entities are found first, as deleting them breaks the edges
+// leading to the others. When keeping the invocation, only the invocation and its event file are left, to save its
+// summary over.
+func deleteInvocationGraph(ctx context.Context, tx *ent.Tx, invocationID int, keepInvocation bool) ([]int, []int, error) {
+	invocation := tx.BazelInvocation.Query().Where(bazelinvocation.ID(invocationID))
+	buildIDs, err := invocation.Clone().QueryBuild().IDs(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not query build: %w", err)
+	}
+	blobIDs, err := invocation.Clone().QueryProblems().QueryBlobs().IDs(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not query blobs: %w", err)
+	}
+
+	problems := invocation.Clone().QueryProblems()
+	metricsQuery := invocation.Clone().QueryMetrics()
+	actionSummaries := metricsQuery.Clone().QueryActionSummary()
+	actionCacheStatistics := actionSummaries.Clone().QueryActionCacheStatistics()
+	memoryMetrics := metricsQuery.Clone().QueryMemoryMetrics()
+	packageMetrics := metricsQuery.Clone().QueryPackageMetrics()
+	artifactMetrics := metricsQuery.Clone().QueryArtifactMetrics()
+	networkMetrics := metricsQuery.Clone().QueryNetworkMetrics()
+	dynamicExecutionMetrics := metricsQuery.Clone().QueryDynamicExecutionMetrics()
+	buildGraphMetrics := metricsQuery.Clone().QueryBuildGraphMetrics()
+	testCollections := invocation.Clone().QueryTestCollection()
+	testSummaries := testCollections.Clone().QueryTestSummary()
+	testResults := testCollections.Clone().QueryTestResults()
+	executionInfos := testResults.Clone().QueryExecutionInfo()
+	timingBreakdowns := executionInfos.Clone().QueryTimingBreakdown()
+	targets := invocation.Clone().QueryTargets()
+	targetCompletions := targets.Clone().QueryCompletion()
+	outputGroups := targetCompletions.Clone().QueryOutputGroup()
+
+	nodes := []invocationGraphNodes{
+		// Before the problems they may refer to.
+		{"search chunks", []idQuery{invocation.Clone().QuerySearchChunks()}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.SearchChunk.Delete().Where(searchchunk.IDIn(ids...)).Exec(ctx)
+		}},
+		{"problems", []idQuery{problems}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.BazelInvocationProblem.Delete().Where(bazelinvocationproblem.IDIn(ids...)).Exec(ctx)
+		}},
+		{"metrics", []idQuery{metricsQuery}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.Metrics.Delete().Where(metrics.IDIn(ids...)).Exec(ctx)
+		}},
+		{"action summaries", []idQuery{actionSummaries}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.ActionSummary.Delete().Where(actionsummary.IDIn(ids...)).Exec(ctx)
+		}},
+		{"action data", []idQuery{actionSummaries.Clone().QueryActionData()}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.ActionData.Delete().Where(actiondata.IDIn(ids...)).Exec(ctx)
+		}},
+		{"runner counts", []idQuery{actionSummaries.Clone().QueryRunnerCount()}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.RunnerCount.Delete().Where(runnercount.IDIn(ids...)).Exec(ctx)
+		}},
+		{"action cache statistics", []idQuery{actionCacheStatistics}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.ActionCacheStatistics.Delete().Where(actioncachestatistics.IDIn(ids...)).Exec(ctx)
+		}},
+		{"miss details", []idQuery{actionCacheStatistics.Clone().QueryMissDetails()}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.MissDetail.Delete().Where(missdetail.IDIn(ids...)).Exec(ctx)
+		}},
+		{"memory metrics", []idQuery{memoryMetrics}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.MemoryMetrics.Delete().Where(memorymetrics.IDIn(ids...)).Exec(ctx)
+		}},
+		{"garbage metrics", []idQuery{memoryMetrics.Clone().QueryGarbageMetrics()}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.GarbageMetrics.Delete().Where(garbagemetrics.IDIn(ids...)).Exec(ctx)
+		}},
+		{"target metrics", []idQuery{metricsQuery.Clone().QueryTargetMetrics()}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.TargetMetrics.Delete().Where(targetmetrics.IDIn(ids...)).Exec(ctx)
+		}},
+		{"package metrics", []idQuery{packageMetrics}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.PackageMetrics.Delete().Where(packagemetrics.IDIn(ids...)).Exec(ctx)
+		}},
+		{"package load metrics", []idQuery{packageMetrics.Clone().QueryPackageLoadMetrics()}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.PackageLoadMetrics.Delete().Where(packageloadmetrics.IDIn(ids...)).Exec(ctx)
+		}},
+		{"timing metrics", []idQuery{metricsQuery.Clone().QueryTimingMetrics()}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.TimingMetrics.Delete().Where(timingmetrics.IDIn(ids...)).Exec(ctx)
+		}},
+		{"cumulative metrics", []idQuery{metricsQuery.Clone().QueryCumulativeMetrics()}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.CumulativeMetrics.Delete().Where(cumulativemetrics.IDIn(ids...)).Exec(ctx)
+		}},
+		{"artifact metrics", []idQuery{artifactMetrics}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.ArtifactMetrics.Delete().Where(artifactmetrics.IDIn(ids...)).Exec(ctx)
+		}},
+		{"files metrics", []idQuery{
+			artifactMetrics.Clone().QuerySourceArtifactsRead(),
+			artifactMetrics.Clone().QueryOutputArtifactsSeen(),
+			artifactMetrics.Clone().QueryOutputArtifactsFromActionCache(),
+			artifactMetrics.Clone().QueryTopLevelArtifacts(),
+		}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.FilesMetric.Delete().Where(filesmetric.IDIn(ids...)).Exec(ctx)
+		}},
+		{"network metrics", []idQuery{networkMetrics}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.NetworkMetrics.Delete().Where(networkmetrics.IDIn(ids...)).Exec(ctx)
+		}},
+		{"system network stats", []idQuery{networkMetrics.Clone().QuerySystemNetworkStats()}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.SystemNetworkStats.Delete().Where(systemnetworkstats.IDIn(ids...)).Exec(ctx)
+		}},
+		{"dynamic execution metrics", []idQuery{dynamicExecutionMetrics}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.DynamicExecutionMetrics.Delete().Where(dynamicexecutionmetrics.IDIn(ids...)).Exec(ctx)
+		}},
+		{"race statistics", []idQuery{dynamicExecutionMetrics.Clone().QueryRaceStatistics()}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.RaceStatistics.Delete().Where(racestatistics.IDIn(ids...)).Exec(ctx)
+		}},
+		{"build graph metrics", []idQuery{buildGraphMetrics}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.BuildGraphMetrics.Delete().Where(buildgraphmetrics.IDIn(ids...)).Exec(ctx)
+		}},
+		{"evaluation stats", []idQuery{
+			buildGraphMetrics.Clone().QueryDirtiedValues(),
+			buildGraphMetrics.Clone().QueryChangedValues(),
+			buildGraphMetrics.Clone().QueryBuiltValues(),
+			buildGraphMetrics.Clone().QueryCleanedValues(),
+			buildGraphMetrics.Clone().QueryEvaluatedValues(),
+		}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.EvaluationStat.Delete().Where(evaluationstat.IDIn(ids...)).Exec(ctx)
+		}},
+		{"test collections", []idQuery{testCollections}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.TestCollection.Delete().Where(testcollection.IDIn(ids...)).Exec(ctx)
+		}},
+		{"test summaries", []idQuery{testSummaries}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.TestSummary.Delete().Where(testsummary.IDIn(ids...)).Exec(ctx)
+		}},
+		{"test results", []idQuery{testResults}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.TestResultBES.Delete().Where(testresultbes.IDIn(ids...)).Exec(ctx)
+		}},
+		{"execution infos", []idQuery{executionInfos}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.ExectionInfo.Delete().Where(exectioninfo.IDIn(ids...)).Exec(ctx)
+		}},
+		{"timing breakdowns", []idQuery{timingBreakdowns}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.TimingBreakdown.Delete().Where(timingbreakdown.IDIn(ids...)).Exec(ctx)
+		}},
+		{"timing children", []idQuery{timingBreakdowns.Clone().QueryChild()}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.TimingChild.Delete().Where(timingchild.IDIn(ids...)).Exec(ctx)
+		}},
+		{"resource usages", []idQuery{executionInfos.Clone().QueryResourceUsage()}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.ResourceUsage.Delete().Where(resourceusage.IDIn(ids...)).Exec(ctx)
+		}},
+		{"targets", []idQuery{targets}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.TargetPair.Delete().Where(targetpair.IDIn(ids...)).Exec(ctx)
+		}},
+		{"target configurations", []idQuery{targets.Clone().QueryConfiguration()}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.TargetConfigured.Delete().Where(targetconfigured.IDIn(ids...)).Exec(ctx)
+		}},
+		{"target completions", []idQuery{targetCompletions}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.TargetComplete.Delete().Where(targetcomplete.IDIn(ids...)).Exec(ctx)
+		}},
+		{"output groups", []idQuery{outputGroups}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.OutputGroup.Delete().Where(outputgroup.IDIn(ids...)).Exec(ctx)
+		}},
+		{"files", []idQuery{
+			testSummaries.Clone().QueryPassed(),
+			testSummaries.Clone().QueryFailed(),
+			testResults.Clone().QueryTestActionOutput(),
+			targetCompletions.Clone().QueryImportantOutput(),
+			targetCompletions.Clone().QueryDirectoryOutput(),
+			outputGroups.Clone().QueryInlineFiles(),
+		}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.TestFile.Delete().Where(testfile.IDIn(ids...)).Exec(ctx)
+		}},
+		{"test health reports", []idQuery{invocation.Clone().QueryTestHealthReports()}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+			return tx.TestHealthReport.Delete().Where(testhealthreport.IDIn(ids...)).Exec(ctx)
+		}},
+	}
+	if !keepInvocation {
+		nodes = append(nodes,
+			// Before the event file it requires.
+			invocationGraphNodes{"invocations", []idQuery{invocation.Clone()}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+				return tx.BazelInvocation.Delete().Where(bazelinvocation.IDIn(ids...)).Exec(ctx)
+			}},
+			invocationGraphNodes{"event files", []idQuery{invocation.Clone().QueryEventFile()}, func(ctx context.Context, tx *ent.Tx, ids []int) (int, error) {
+				return tx.EventFile.Delete().Where(eventfile.IDIn(ids...)).Exec(ctx)
+			}},
+		)
+	}
+
+	nodeIDs := make([][]int, len(nodes))
+	for i, node := range nodes {
+		for _, query := range node.queries {
+			ids, err := query.IDs(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("could not query %s: %w", node.name, err)
+			}
+			nodeIDs[i] = append(nodeIDs[i], ids...)
+		}
+	}
+	for i, node := range nodes {
+		ids := nodeIDs[i]
+		for start := 0; start < len(ids); start += deleteChunkSize {
+			if _, err := node.delete(ctx, tx, ids[start:min(start+deleteChunkSize, len(ids))]); err != nil {
+				return nil, nil, fmt.Errorf("could not delete %s: %w", node.name, err)
+			}
+		}
+	}
+	return buildIDs, blobIDs, nil
+}
+
+// Delete the builds left without invocations.
+func (gc *GarbageCollector) deleteEmptyBuilds(ctx context.Context, buildIDs []int) (int, error) {
+	if len(buildIDs) == 0 {
+		return 0, nil
+	}
+	return gc.db.Build.Delete().
+		Where(build.IDIn(buildIDs...), build.Not(build.HasInvocations())).
+		Exec(ctx)
+}
+
+// MigrateProblemBlobs Links the problems saved before problems referenced their blobs to the blobs detected in their
+// events, so that the garbage collector does not take these blobs for unreferenced ones. Must run before it.
+func MigrateProblemBlobs(ctx context.Context, db *ent.Client) error {
+	linked := 0
+	lastID := 0
+	for {
+		problems, err := db.BazelInvocationProblem.Query().
+			Where(
+				bazelinvocationproblem.ProblemTypeIn(detectors.BazelInvocationActionProblem, detectors.BazelInvocationTestProblem),
+				bazelinvocationproblem.Not(bazelinvocationproblem.HasBlobs()),
+				bazelinvocationproblem.IDGT(lastID),
+			).
+			Order(ent.Asc(bazelinvocationproblem.FieldID)).
+			Limit(migrateBatchSize).
+			Select(bazelinvocationproblem.FieldBepEvents).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("could not query problems without blobs: %w", err)
+		}
+		for _, problem := range problems {
+			buildEvents, err := events.FromJSONArray(problem.BepEvents)
+			if err != nil {
+				slog.ErrorContext(ctx, "failed to read the events of problem", "id", problem.ID, "err", err)
+				continue
+			}
+			var uris []string
+			for _, detectedBlob := range detectors.DetectedBlobs(buildEvents) {
+				uris = append(uris, string(detectedBlob))
+			}
+			if len(uris) == 0 {
+				continue
+			}
+			blobIDs, err := db.Blob.Query().Where(blob.URIIn(uris...)).IDs(ctx)
+			if err != nil {
+				return fmt.Errorf("could not query the blobs of problem %d: %w", problem.ID, err)
+			}
+			if len(blobIDs) == 0 {
+				continue
+			}
+			if err = db.BazelInvocationProblem.UpdateOneID(problem.ID).AddBlobIDs(blobIDs...).Exec(ctx); err != nil {
+				return fmt.Errorf("could not link the blobs of problem %d: %w", problem.ID, err)
+			}
+			linked++
+		}
+		if len(problems) < migrateBatchSize {
+			break
+		}
+		lastID = problems[len(problems)-1].ID
+	}
+	if linked > 0 {
+		slog.InfoContext(ctx, "linked problems to their blobs", "problems", linked)
+	}
+	return nil
+}
+
+// Delete the blobs which were already unreferenced by the previous collection and still are. The blobs saved along
+// with an invocation are referenced by its problems only once those are saved, which is done long before the next
+// collection.
+func (gc *GarbageCollector) sweepUnreferencedBlobs(ctx context.Context) GarbageCollectionStats {
+	unreferenced, err := gc.db.Blob.Query().Where(blob.Not(blob.HasProblems())).IDs(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to query unreferenced blobs", "err", err)
+		return GarbageCollectionStats{Errors: 1}
+	}
+	gc.mu.Lock()
+	var blobIDs []int
+	for _, blobID := range unreferenced {
+		if slices.Contains(gc.unreferencedBlobIDs, blobID) {
+			blobIDs = append(blobIDs, blobID)
+		}
+	}
+	gc.unreferencedBlobIDs = unreferenced
+	gc.mu.Unlock()
+	return gc.deleteUnreferencedBlobs(ctx, blobIDs)
+}
+
+// Delete the blobs no longer referenced by any problem, and their archived content unless another blob has the same.
+// Blobs being archived are left alone, as the archiver would store their content after they are gone, until a later
+// collection once they are archived.
+func (gc *GarbageCollector) deleteUnreferencedBlobs(ctx context.Context, blobIDs []int) GarbageCollectionStats {
+	var run GarbageCollectionStats
+	if len(blobIDs) == 0 {
+		return run
+	}
+	unreferenced, err := gc.db.Blob.Query().
+		Where(
+			blob.IDIn(blobIDs...),
+			blob.Not(blob.HasProblems()),
+			blob.ArchivingStatusNEQ(blob.ArchivingStatusARCHIVING),
+		).
+		All(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to query unreferenced blobs", "err", err)
+		run.Errors++
+		return run
+	}
+	for _, b := range unreferenced {
+		if _, err := gc.db.SearchChunk.Delete().Where(searchchunk.HasBlobWith(blob.ID(b.ID))).Exec(ctx); err != nil {
+			slog.ErrorContext(ctx, "failed to delete the search chunks of blob", "uri", b.URI, "err", err)
+			run.Errors++
+			continue
+		}
+		// Unless it was claimed by the archiver or referenced again meanwhile.
+		n, err := gc.db.Blob.Delete().
+			Where(
+				blob.ID(b.ID),
+				blob.Not(blob.HasProblems()),
+				blob.ArchivingStatusNEQ(blob.ArchivingStatusARCHIVING),
+			).
+			Exec(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to delete blob", "uri", b.URI, "err", err)
+			run.Errors++
+			continue
+		}
+		if n == 0 {
+			continue
+		}
+		run.DeletedBlobs++
+		if b.ArchiveURL == "" {
+			continue
+		}
+		shared, err := gc.db.Blob.Query().Where(blob.ArchiveURL(b.ArchiveURL)).Exist(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to check for blobs with the same content", "uri", b.URI, "err", err)
+			run.Errors++
+			continue
+		}
+		if shared {
+			continue
+		}
+		if err := gc.blobStorage.Delete(ctx, b.ArchiveURL); err != nil {
+			slog.ErrorContext(ctx, "failed to delete archived blob", "uri", b.URI, "key", b.ArchiveURL, "err", err)
+			run.Errors++
+			continue
+		}
+		run.DeletedArchivedBlobs++
+	}
+	return run
+}
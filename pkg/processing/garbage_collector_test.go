@@ -0,0 +1,336 @@
+package processing_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/enttest"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/metricsrollup"
+	"github.com/buildbarn/bb-portal/pkg/processing"
+	"github.com/buildbarn/bb-portal/pkg/rollup"
+	"github.com/buildbarn/bb-portal/pkg/storage"
+	"github.com/buildbarn/bb-portal/pkg/summary"
+)
+
+// processFixtures Process event files, returning their invocations in order.
+func processFixtures(t *testing.T, db *ent.Client, names ...string) []*ent.BazelInvocation {
+	worker := processing.New(db, nil, nil, summary.DefaultTestHealthThresholds())
+	invocations := make([]*ent.BazelInvocation, 0, len(names))
+	for _, name := range names {
+		invocation, err := worker.ProcessFile(context.Background(), filepath.Join(inputFixtureBaseDir, name))
+		require.NoError(t, err)
+		invocations = append(invocations, invocation)
+	}
+	return invocations
+}
+
+// countRows Count the rows saved from event files, except for the builds and the blobs.
+func countRows(t *testing.T, db *ent.Client) int {
+	ctx := context.Background()
+	counts := []func(context.Context) (int, error){
+		db.BazelInvocation.Query().Count,
+		db.BazelInvocationProblem.Query().Count,
+		db.EventFile.Query().Count,
+		db.Metrics.Query().Count,
+		db.ActionSummary.Query().Count,
+		db.ActionData.Query().Count,
+		db.RunnerCount.Query().Count,
+		db.ActionCacheStatistics.Query().Count,
+		db.MissDetail.Query().Count,
+		db.MemoryMetrics.Query().Count,
+		db.GarbageMetrics.Query().Count,
+		db.TargetMetrics.Query().Count,
+		db.PackageMetrics.Query().Count,
+		db.PackageLoadMetrics.Query().Count,
+		db.TimingMetrics.Query().Count,
+		db.CumulativeMetrics.Query().Count,
+		db.ArtifactMetrics.Query().Count,
+		db.FilesMetric.Query().Count,
+		db.NetworkMetrics.Query().Count,
+		db.SystemNetworkStats.Query().Count,
+		db.DynamicExecutionMetrics.Query().Count,
+		db.RaceStatistics.Query().Count,
+		db.BuildGraphMetrics.Query().Count,
+		db.EvaluationStat.Query().Count,
+		db.TestCollection.Query().Count,
+		db.TestSummary.Query().Count,
+		db.TestResultBES.Query().Count,
+		db.ExectionInfo.Query().Count,
+		db.TimingBreakdown.Query().Count,
+		db.TimingChild.Query().Count,
+		db.ResourceUsage.Query().Count,
+		db.TargetPair.Query().Count,
+		db.TargetConfigured.Query().Count,
+		db.TargetComplete.Query().Count,
+		db.OutputGroup.Query().Count,
+		db.TestFile.Query().Count,
+		db.TestHealthReport.Query().Count,
+	}
+	total := 0
+	for _, count := range counts {
+		n, err := count(ctx)
+		require.NoError(t, err)
+		total += n
+	}
+	return total
+}
+
+func TestGarbageCollector_MaxAge(t *testing.T) {
+	ctx := context.Background()
+	db := enttest.Open(t, "sqlite3", "file:gc_max_age?mode=memory&_fk=1")
+	defer db.Close()
+	blobStorage, err := storage.NewLocalStorage(storage.LocalStorageParams{Folder: t.TempDir()})
+	require.NoError(t, err)
+
+	invocations := processFixtures(t, db, "nextjs_test.bep.ndjson", "nextjs_test_fail.bep.ndjson")
+	failed := invocations[1]
+	require.NoError(t, db.BazelInvocation.Update().SetStartedAt(time.Now().Add(-48*time.Hour)).Exec(ctx))
+
+	// Archive the blobs of the failed invocation, two of them with the same content.
+	blobs, err := db.Blob.Query().Order(ent.Asc(blob.FieldID)).All(ctx)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(blobs), 2)
+	for i, b := range blobs {
+		key := b.URI[strings.LastIndex(b.URI, "/")+1:]
+		if i == 1 {
+			key = blobs[0].ArchiveURL
+		}
+		require.NoError(t, blobStorage.Put(ctx, key, strings.NewReader("log"), 3))
+		b, err = b.Update().SetArchivingStatus(blob.ArchivingStatusSUCCESS).SetArchiveURL(key).Save(ctx)
+		require.NoError(t, err)
+		blobs[i] = b
+	}
+
+	params := processing.DefaultRetentionParams()
+	params.MaxAge = 24 * time.Hour
+	params.FailedMaxAge = 72 * time.Hour
+	stats, err := processing.NewGarbageCollector(db, blobStorage, params).Collect(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), stats.DeletedInvocations)
+	require.Zero(t, stats.Errors)
+	remaining, err := db.BazelInvocation.Query().IDs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []int{failed.ID}, remaining)
+
+	// Without keeping failed invocations longer, everything goes.
+	params.FailedMaxAge = 0
+	garbageCollector := processing.NewGarbageCollector(db, blobStorage, params)
+	stats, err = garbageCollector.Collect(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), stats.DeletedInvocations)
+	require.Equal(t, int64(1), stats.DeletedBuilds)
+	require.Equal(t, int64(len(blobs)), stats.DeletedBlobs)
+	require.Equal(t, int64(len(blobs)-1), stats.DeletedArchivedBlobs)
+	require.Zero(t, stats.Errors)
+	require.Equal(t, stats, garbageCollector.Stats())
+
+	require.Zero(t, countRows(t, db))
+	require.Zero(t, db.Build.Query().CountX(ctx))
+	require.Zero(t, db.Blob.Query().CountX(ctx))
+	// Known problems without any problem left go with them.
+	require.Zero(t, db.KnownProblem.Query().CountX(ctx))
+	for _, b := range blobs {
+		exists, err := blobStorage.Exists(ctx, b.ArchiveURL)
+		require.NoError(t, err)
+		require.False(t, exists)
+	}
+}
+
+func TestGarbageCollector_MaxInvocationsPerBuild(t *testing.T) {
+	ctx := context.Background()
+	db := enttest.Open(t, "sqlite3", "file:gc_max_per_build?mode=memory&_fk=1")
+	defer db.Close()
+	blobStorage, err := storage.NewLocalStorage(storage.LocalStorageParams{Folder: t.TempDir()})
+	require.NoError(t, err)
+
+	invocations := processFixtures(t, db, "nextjs_build.bep.ndjson", "nextjs_build_fail.bep.ndjson", "nextjs_test.bep.ndjson")
+	for i, invocation := range invocations {
+		require.NoError(t, invocation.Update().SetStartedAt(time.Now().Add(time.Duration(i-len(invocations))*time.Hour)).Exec(ctx))
+	}
+	require.NoError(t, invocations[0].Update().SetPinned(true).Exec(ctx))
+	require.NoError(t, db.Build.Create().
+		SetBuildURL("https://example.com/build/5678").
+		SetBuildUUID(uuid.New()).
+		SetEnv(map[string]string{}).
+		AddInvocations(invocations...).
+		Exec(ctx))
+
+	params := processing.DefaultRetentionParams()
+	params.MaxInvocationsPerBuild = 1
+	stats, err := processing.NewGarbageCollector(db, blobStorage, params).Collect(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), stats.DeletedInvocations)
+	require.Zero(t, stats.DeletedBuilds)
+	remaining, err := db.BazelInvocation.Query().Order(ent.Asc(bazelinvocation.FieldStartedAt)).IDs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []int{invocations[0].ID, invocations[2].ID}, remaining)
+}
+
+func TestGarbageCollector_DeleteBuild(t *testing.T) {
+	ctx := context.Background()
+	db := enttest.Open(t, "sqlite3", "file:gc_delete_build?mode=memory&_fk=1")
+	defer db.Close()
+	blobStorage, err := storage.NewLocalStorage(storage.LocalStorageParams{Folder: t.TempDir()})
+	require.NoError(t, err)
+
+	invocations := processFixtures(t, db, "nextjs_build.bep.ndjson", "nextjs_test.bep.ndjson")
+	require.NoError(t, invocations[0].Update().SetPinned(true).Exec(ctx))
+	build := db.Build.Create().
+		SetBuildURL("https://example.com/build/5678").
+		SetBuildUUID(uuid.New()).
+		SetEnv(map[string]string{}).
+		AddInvocations(invocations[0]).
+		SaveX(ctx)
+	empty := db.Build.Create().
+		SetBuildURL("https://example.com/build/9012").
+		SetBuildUUID(uuid.New()).
+		SetEnv(map[string]string{}).
+		SaveX(ctx)
+
+	garbageCollector := processing.NewGarbageCollector(db, blobStorage, processing.DefaultRetentionParams())
+	stats, err := garbageCollector.DeleteBuild(ctx, build.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), stats.DeletedInvocations)
+	require.Equal(t, int64(1), stats.DeletedBuilds)
+	remaining, err := db.BazelInvocation.Query().IDs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []int{invocations[1].ID}, remaining)
+
+	stats, err = garbageCollector.DeleteBuild(ctx, empty.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), stats.DeletedBuilds)
+	require.Zero(t, db.Build.Query().CountX(ctx))
+	require.Equal(t, int64(2), garbageCollector.Stats().DeletedBuilds)
+}
+
+func TestGarbageCollector_SweepUnreferencedBlobs(t *testing.T) {
+	ctx := context.Background()
+	db := enttest.Open(t, "sqlite3", "file:gc_sweep_blobs?mode=memory&_fk=1")
+	defer db.Close()
+	blobStorage, err := storage.NewLocalStorage(storage.LocalStorageParams{Folder: t.TempDir()})
+	require.NoError(t, err)
+
+	processFixtures(t, db, "nextjs_test_fail.bep.ndjson")
+	referenced := db.Blob.Query().CountX(ctx)
+	require.NotZero(t, referenced)
+	unreferenced := map[blob.ArchivingStatus]*ent.Blob{}
+	for _, status := range []blob.ArchivingStatus{
+		blob.ArchivingStatusQUEUED,
+		blob.ArchivingStatusARCHIVING,
+		blob.ArchivingStatusFAILED,
+	} {
+		uri := "bytestream://cas.example.com/blobs/" + strings.Repeat("a", 64) + "/" + status.String()
+		unreferenced[status] = db.Blob.Create().SetURI(uri).SetArchivingStatus(status).SaveX(ctx)
+	}
+
+	// Blobs are swept once they were unreferenced in the previous collection too, and not being archived.
+	garbageCollector := processing.NewGarbageCollector(db, blobStorage, processing.DefaultRetentionParams())
+	stats, err := garbageCollector.Collect(ctx)
+	require.NoError(t, err)
+	require.Zero(t, stats.DeletedBlobs)
+	stats, err = garbageCollector.Collect(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), stats.DeletedBlobs)
+	remaining, err := db.Blob.Query().Where(blob.Not(blob.HasProblems())).IDs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []int{unreferenced[blob.ArchivingStatusARCHIVING].ID}, remaining)
+
+	// Until it is archived.
+	require.NoError(t, unreferenced[blob.ArchivingStatusARCHIVING].Update().SetArchivingStatus(blob.ArchivingStatusSUCCESS).Exec(ctx))
+	stats, err = garbageCollector.Collect(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), stats.DeletedBlobs)
+	require.Zero(t, stats.Errors)
+	require.Equal(t, referenced, db.Blob.Query().CountX(ctx))
+}
+
+func TestMigrateProblemBlobs(t *testing.T) {
+	ctx := context.Background()
+	db := enttest.Open(t, "sqlite3", "file:gc_migrate_problem_blobs?mode=memory&_fk=1")
+	defer db.Close()
+	blobStorage, err := storage.NewLocalStorage(storage.LocalStorageParams{Folder: t.TempDir()})
+	require.NoError(t, err)
+
+	// Blobs saved before problems referenced them.
+	processFixtures(t, db, "nextjs_test_fail.bep.ndjson")
+	blobCount := db.Blob.Query().CountX(ctx)
+	require.NotZero(t, blobCount)
+	require.NoError(t, db.BazelInvocationProblem.Update().ClearBlobs().Exec(ctx))
+	require.Equal(t, blobCount, db.Blob.Query().Where(blob.Not(blob.HasProblems())).CountX(ctx))
+
+	require.NoError(t, processing.MigrateProblemBlobs(ctx, db))
+	require.Zero(t, db.Blob.Query().Where(blob.Not(blob.HasProblems())).CountX(ctx))
+	require.NoError(t, processing.MigrateProblemBlobs(ctx, db))
+
+	garbageCollector := processing.NewGarbageCollector(db, blobStorage, processing.DefaultRetentionParams())
+	for range 2 {
+		stats, err := garbageCollector.Collect(ctx)
+		require.NoError(t, err)
+		require.Zero(t, stats.DeletedBlobs)
+	}
+	require.Equal(t, blobCount, db.Blob.Query().CountX(ctx))
+}
+
+func TestGarbageCollector_DeleteInvocations_Aggregates(t *testing.T) {
+	ctx := context.Background()
+	db := enttest.Open(t, "sqlite3", "file:gc_aggregates?mode=memory&_fk=1")
+	defer db.Close()
+	blobStorage, err := storage.NewLocalStorage(storage.LocalStorageParams{Folder: t.TempDir()})
+	require.NoError(t, err)
+
+	// The same invocation saved under different IDs on two branches.
+	original, err := os.ReadFile(filepath.Join(inputFixtureBaseDir, "nextjs_test_fail.bep.ndjson"))
+	require.NoError(t, err)
+	worker := processing.New(db, nil, nil, summary.DefaultTestHealthThresholds())
+	var invocations []*ent.BazelInvocation
+	for _, branch := range []string{"main", "release"} {
+		onBranch := bytes.ReplaceAll(original, []byte(`"optionValue":"HOME\u003d/Users/nameless"`), []byte(`"optionValue":"GIT_BRANCH\u003d`+branch+`"`))
+		onBranch = bytes.ReplaceAll(onBranch, []byte("571d0839-fd63-4442-bb4d-61f7bfa4ddae"), []byte(uuid.NewString()))
+		eventFile := filepath.Join(t.TempDir(), "nextjs_test_fail.bep.ndjson")
+		require.NoError(t, os.WriteFile(eventFile, onBranch, 0o600))
+		invocation, err := worker.ProcessFile(ctx, eventFile)
+		require.NoError(t, err)
+		invocations = append(invocations, invocation)
+	}
+	from := rollup.BucketStart(invocations[0].StartedAt, metricsrollup.GranularityDAY)
+	trendInvocations := func() int64 {
+		trends, err := rollup.Trends(ctx, db, []rollup.Metric{rollup.MetricActionsExecuted}, from, from.Add(24*time.Hour), metricsrollup.GranularityDAY, rollup.Filter{})
+		require.NoError(t, err)
+		var total int64
+		for _, point := range trends[0].Points {
+			total += point.Invocations
+		}
+		return total
+	}
+	require.Equal(t, int64(2), trendInvocations())
+
+	// The known problems and the trends no longer count a deleted invocation.
+	garbageCollector := processing.NewGarbageCollector(db, blobStorage, processing.DefaultRetentionParams())
+	_, err = garbageCollector.DeleteInvocations(ctx, []int{invocations[0].ID})
+	require.NoError(t, err)
+	knownProblems := db.KnownProblem.Query().WithProblems().AllX(ctx)
+	require.NotEmpty(t, knownProblems)
+	for _, knownProblem := range knownProblems {
+		require.Len(t, knownProblem.Edges.Problems, knownProblem.Occurrences)
+		require.Equal(t, 1, knownProblem.Occurrences)
+		require.Equal(t, []string{"release"}, knownProblem.Branches)
+	}
+	require.Equal(t, int64(1), trendInvocations())
+
+	// Nor the last one.
+	_, err = garbageCollector.DeleteInvocations(ctx, []int{invocations[1].ID})
+	require.NoError(t, err)
+	require.Zero(t, db.KnownProblem.Query().CountX(ctx))
+	require.Zero(t, trendInvocations())
+}
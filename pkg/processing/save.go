@@ -2,37 +2,76 @@ package processing
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"github.com/google/uuid"
 
 	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocationproblem"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/build"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/knownproblem"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/missdetail"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/targetcomplete"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/targetconfigured"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/targetpair"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testcollection"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/testhealthreport"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testresultbes"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/testsummary"
+	"github.com/buildbarn/bb-portal/pkg/blobs"
+	"github.com/buildbarn/bb-portal/pkg/progress"
+	"github.com/buildbarn/bb-portal/pkg/rollup"
+	"github.com/buildbarn/bb-portal/pkg/search"
 	"github.com/buildbarn/bb-portal/pkg/summary"
 	"github.com/buildbarn/bb-portal/pkg/summary/detectors"
 )
 
-// SaveActor ...SaveActor The save actor struct with the db client and a blob archiver.
+// SaveActor ...SaveActor The save actor struct with the db client, a blob archiving pool and a progress hub.
 type SaveActor struct {
-	db           *ent.Client
-	blobArchiver BlobMultiArchiver
+	db                *ent.Client
+	blobArchivingPool *BlobArchivingPool
+	progressHub       *progress.Hub
 }
 
-// SaveSummary saves an invocation summary to the database.
+// SaveSummary saves an invocation summary to the database in a transaction, so that an invocation failing to save
+// along with its known problems, metrics rollups and search index can be saved again. Blobs are archived and the
+// subscribers told once it is committed. Within the transaction of the client, that is left to its caller.
 func (act SaveActor) SaveSummary(ctx context.Context, summary *summary.Summary) (*ent.BazelInvocation, error) {
-	eventFile, err := act.saveEventFile(ctx, summary)
+	tx, err := act.db.Tx(ctx)
+	if errors.Is(err, ent.ErrTxStarted) {
+		return act.saveSummary(ctx, summary, nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not start transaction: %w", err)
+	}
+	bazelInvocation, err := SaveActor{db: tx.Client()}.saveSummary(ctx, summary, nil)
 	if err != nil {
-		return nil, fmt.Errorf("could not save EventFile: %w", err)
+		return nil, errors.Join(err, tx.Rollback())
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("could not commit summary: %w", err)
+	}
+	act.blobArchivingPool.Notify()
+	act.publishSaved(summary, bazelInvocation.ID)
+	return bazelInvocation.Unwrap(), nil
+}
+
+// Save an invocation summary with the client, over the given invocation if any, which keeps its ID and event file.
+func (act SaveActor) saveSummary(ctx context.Context, summary *summary.Summary, invocation *ent.BazelInvocation) (*ent.BazelInvocation, error) {
+	var eventFile *ent.EventFile
+	if invocation == nil {
+		var err error
+		if eventFile, err = act.saveEventFile(ctx, summary); err != nil {
+			return nil, fmt.Errorf("could not save EventFile: %w", err)
+		}
 	}
 	buildRecord, err := act.findOrCreateBuild(ctx, summary)
 	if err != nil {
@@ -50,43 +89,98 @@ func (act SaveActor) SaveSummary(ctx context.Context, summary *summary.Summary)
 	if err != nil {
 		return nil, fmt.Errorf("could not save test results: %w", err)
 	}
-	bazelInvocation, err := act.saveBazelInvocation(ctx, summary, eventFile, buildRecord, metrics, tests, targets)
+	bazelInvocation, err := act.saveBazelInvocation(ctx, summary, invocation, eventFile, buildRecord, metrics, tests, targets)
 	if err != nil {
 		return nil, fmt.Errorf("could not save BazelInvocation: %w", err)
 	}
-	var detectedBlobs []detectors.BlobURI
+	err = act.saveTestHealthReports(ctx, summary.TestHealthReports, bazelInvocation)
+	if err != nil {
+		return nil, fmt.Errorf("could not save TestHealthReports: %w", err)
+	}
+	newlyFailing, err := act.determineNewlyFailing(ctx, bazelInvocation, summary.Problems)
+	if err != nil {
+		return nil, err
+	}
+	blobIDs, err := act.saveBlobs(ctx, summary.Problems)
+	if err != nil {
+		return nil, err
+	}
 	err = act.db.BazelInvocationProblem.MapCreateBulk(summary.Problems, func(create *ent.BazelInvocationProblemCreate, i int) {
 		problem := summary.Problems[i]
-		detectedBlobs = append(detectedBlobs, problem.DetectedBlobs...)
 		create.
 			SetProblemType(string(problem.ProblemType)).
 			SetLabel(problem.Label).
 			SetBepEvents(problem.BEPEvents).
+			SetFingerprint(problem.Fingerprint).
+			SetFailureClassification(bazelinvocationproblem.FailureClassification(problem.FailureClassification)).
+			SetNillableNewlyFailing(newlyFailing[i]).
 			SetBazelInvocation(bazelInvocation)
+		problemBlobIDs := make([]int, 0, len(problem.DetectedBlobs))
+		for _, detectedBlob := range problem.DetectedBlobs {
+			if blobID := blobIDs[string(detectedBlob)]; !slices.Contains(problemBlobIDs, blobID) {
+				problemBlobIDs = append(problemBlobIDs, blobID)
+			}
+		}
+		create.AddBlobIDs(problemBlobIDs...)
 	}).Exec(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("could not save BazelInvocationProblems: %w", err)
 	}
+	if err = act.saveKnownProblems(ctx, summary, bazelInvocation.ID); err != nil {
+		return nil, fmt.Errorf("could not save KnownProblems: %w", err)
+	}
+	if err = rollup.Add(ctx, act.db, bazelInvocation.ID); err != nil {
+		return nil, fmt.Errorf("could not update metrics rollups: %w", err)
+	}
+	if err = search.IndexInvocation(ctx, act.db, bazelInvocation.ID); err != nil {
+		return nil, fmt.Errorf("could not index the invocation for search: %w", err)
+	}
+	return bazelInvocation, nil
+}
+
+// Tell the subscribers to an invocation and to its build that it was saved.
+func (act SaveActor) publishSaved(summary *summary.Summary, bazelInvocationID int) {
+	act.progressHub.Publish(progress.FinishedEvent(summary, bazelInvocationID))
+	if summary.BuildURL != "" {
+		act.progressHub.PublishNewInvocation(progress.NewInvocation{BuildUUID: summary.BuildUUID, RecordID: bazelInvocationID})
+	}
+}
+
+// Save the blobs detected in problems that are not known yet, returning the IDs of all of them keyed by URI.
+func (act SaveActor) saveBlobs(ctx context.Context, problems []detectors.Problem) (map[string]int, error) {
+	var detectedBlobs []detectors.BlobURI
+	for _, problem := range problems {
+		for _, detectedBlob := range problem.DetectedBlobs {
+			if !slices.Contains(detectedBlobs, detectedBlob) {
+				detectedBlobs = append(detectedBlobs, detectedBlob)
+			}
+		}
+	}
 	missingBlobs, err := act.determineMissingBlobs(ctx, detectedBlobs)
 	if err != nil {
 		return nil, err
 	}
+	// Unless saved concurrently by another invocation.
 	err = act.db.Blob.MapCreateBulk(missingBlobs, func(create *ent.BlobCreate, i int) {
 		b := missingBlobs[i]
-		create.SetURI(string(b))
-	}).Exec(ctx)
+		create.SetURI(string(b)).SetKey(blobs.Key(string(b)))
+	}).OnConflictColumns(blob.FieldURI).Ignore().Exec(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("could not save Blobs: %w", err)
 	}
-	var archivedBlobs []ent.Blob
-	archivedBlobs, err = act.blobArchiver.ArchiveBlobs(ctx, missingBlobs)
+	uris := make([]string, 0, len(detectedBlobs))
+	for _, detectedBlob := range detectedBlobs {
+		uris = append(uris, string(detectedBlob))
+	}
+	blobRecords, err := act.db.Blob.Query().Where(blob.URIIn(uris...)).All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to archive blobs: %w", err)
+		return nil, fmt.Errorf("could not query Blobs: %w", err)
 	}
-	for _, archivedBlob := range archivedBlobs {
-		act.updateBlobRecord(ctx, archivedBlob)
+	blobIDs := make(map[string]int, len(blobRecords))
+	for _, b := range blobRecords {
+		blobIDs[b.URI] = b.ID
 	}
-	return bazelInvocation, nil
+	return blobIDs, nil
 }
 
 func (act SaveActor) determineMissingBlobs(ctx context.Context, detectedBlobs []detectors.BlobURI) ([]detectors.BlobURI, error) {
@@ -116,40 +210,70 @@ func (act SaveActor) determineMissingBlobs(ctx context.Context, detectedBlobs []
 func (act SaveActor) saveBazelInvocation(
 	ctx context.Context,
 	summary *summary.Summary,
+	invocation *ent.BazelInvocation,
 	eventFile *ent.EventFile,
 	buildRecord *ent.Build,
 	metrics *ent.Metrics,
 	tests []*ent.TestCollection,
 	targets []*ent.TargetPair,
 ) (*ent.BazelInvocation, error) {
-	create := act.db.BazelInvocation.Create().
-		SetInvocationID(uuid.MustParse(summary.InvocationID)).
-		SetStartedAt(summary.StartedAt).
-		SetNillableEndedAt(summary.EndedAt).
-		SetChangeNumber(summary.ChangeNumber).
-		SetPatchsetNumber(summary.PatchsetNumber).
-		SetSummary(*summary.InvocationSummary).
-		SetBepCompleted(summary.BEPCompleted).
-		SetStepLabel(summary.StepLabel).
-		SetUserEmail(summary.UserEmail).
-		SetCPU(summary.CPU).
-		SetConfigurationMnemonic(summary.ConfigrationMnemonic).
-		SetPlatformName(summary.PlatformName).
-		SetNumFetches(summary.NumFetches).
-		SetBuildLogs(summary.BuildLogs.String()).
-		SetUserLdap(summary.UserLDAP).
-		SetRelatedFiles(summary.RelatedFiles).
-		SetEventFile(eventFile).
-		SetMetrics(metrics).
-		AddTestCollection(tests...).
-		AddTargets(targets...)
+	var mutation *ent.BazelInvocationMutation
+	var save func(context.Context) (*ent.BazelInvocation, error)
+	if invocation == nil {
+		create := act.db.BazelInvocation.Create().
+			SetInvocationID(uuid.MustParse(summary.InvocationID)).
+			SetEventFile(eventFile)
+		mutation, save = create.Mutation(), create.Save
+	} else {
+		// Clearing what the summary no longer has.
+		update := act.db.BazelInvocation.UpdateOne(invocation)
+		if summary.EndedAt == nil {
+			update.ClearEndedAt()
+		}
+		if buildRecord == nil {
+			update.ClearBuild()
+		}
+		if summary.FailureClassification == "" {
+			update.ClearFailureClassification()
+		}
+		mutation, save = update.Mutation(), update.Save
+	}
+	mutation.SetStartedAt(summary.StartedAt)
+	if summary.EndedAt != nil {
+		mutation.SetEndedAt(*summary.EndedAt)
+	}
+	mutation.SetChangeNumber(summary.ChangeNumber)
+	mutation.SetPatchsetNumber(summary.PatchsetNumber)
+	mutation.SetSummary(*summary.InvocationSummary)
+	mutation.SetBepCompleted(summary.BEPCompleted)
+	mutation.SetStepLabel(summary.StepLabel)
+	mutation.SetUserEmail(summary.UserEmail)
+	mutation.SetCPU(summary.CPU)
+	mutation.SetConfigurationMnemonic(summary.ConfigrationMnemonic)
+	mutation.SetPlatformName(summary.PlatformName)
+	mutation.SetNumFetches(summary.NumFetches)
+	mutation.SetBuildLogs(summary.BuildLogs.String())
+	mutation.SetUserLdap(summary.UserLDAP)
+	mutation.SetBranch(summary.Branch)
+	mutation.SetCommit(summary.Commit)
+	mutation.SetRelatedFiles(summary.RelatedFiles)
+	mutation.SetMetricsID(metrics.ID)
+	for _, test := range tests {
+		mutation.AddTestCollectionIDs(test.ID)
+	}
+	for _, target := range targets {
+		mutation.AddTargetIDs(target.ID)
+	}
 
 	if buildRecord != nil {
-		create = create.SetBuild(buildRecord)
+		mutation.SetBuildID(buildRecord.ID)
 	}
 
-	return create.
-		Save(ctx)
+	if summary.FailureClassification != "" {
+		mutation.SetFailureClassification(bazelinvocation.FailureClassification(summary.FailureClassification))
+	}
+
+	return save(ctx)
 }
 
 func (act SaveActor) saveEventFile(ctx context.Context, summary *summary.Summary) (*ent.EventFile, error) {
@@ -395,6 +519,23 @@ func (act SaveActor) saveTests(ctx context.Context, summary *summary.Summary) ([
 	return result, nil
 }
 
+func (act SaveActor) saveTestHealthReports(ctx context.Context, reports []summary.TestHealthReport, bazelInvocation *ent.BazelInvocation) error {
+	return act.db.TestHealthReport.MapCreateBulk(reports, func(create *ent.TestHealthReportCreate, i int) {
+		report := reports[i]
+		create.
+			SetLabel(report.Label).
+			SetIssue(testhealthreport.Issue(report.Issue)).
+			SetTestSize(testhealthreport.TestSize(report.TestSize.String())).
+			SetShardCount(report.ShardCount).
+			SetMaxDurationInMs(report.MaxDurationInMs).
+			SetMedianShardDurationInMs(report.MedianShardDurationInMs).
+			SetTimeoutInMs(report.TimeoutInMs).
+			SetSuggestedShardCount(report.SuggestedShardCount).
+			SetSuggestedSize(testhealthreport.SuggestedSize(report.SuggestedSize.String())).
+			SetBazelInvocation(bazelInvocation)
+	}).Exec(ctx)
+}
+
 func (act SaveActor) saveMissDetails(ctx context.Context, missDetails []summary.MissDetail) ([]*ent.MissDetail, error) {
 	return act.db.MissDetail.MapCreateBulk(missDetails, func(create *ent.MissDetailCreate, i int) {
 		missDetal := missDetails[i]
@@ -602,7 +743,7 @@ func (act SaveActor) saveSystemNetworkStats(ctx context.Context, systemNetworkSt
 		SetPeakBytesRecvPerSec(systemNetworkStats.PeakBytesRecvPerSec).
 		SetPeakBytesSentPerSec(systemNetworkStats.PeakBytesSentPerSec).
 		SetPeakPacketsRecvPerSec(systemNetworkStats.PeakPacketsRecvPerSec).
-		SetPeakBytesSentPerSec(systemNetworkStats.PeakPacketsSentPerSec).
+		SetPeakPacketsSentPerSec(systemNetworkStats.PeakPacketsSentPerSec).
 		Save(ctx)
 }
 
@@ -687,20 +828,206 @@ func (act SaveActor) findOrCreateBuild(ctx context.Context, summary *summary.Sum
 	return buildRecord, nil
 }
 
-func (act SaveActor) updateBlobRecord(ctx context.Context, b ent.Blob) {
-	update := act.db.Blob.Update().Where(blob.URI(b.URI)).SetArchivingStatus(b.ArchivingStatus)
-	if b.ArchiveURL != "" {
-		update = update.SetArchiveURL(b.ArchiveURL)
+// determineNewlyFailing tells for each problem if it started failing in the invocation, see FindFirstFailure.
+func (act SaveActor) determineNewlyFailing(ctx context.Context, bazelInvocation *ent.BazelInvocation, problems []detectors.Problem) ([]*bool, error) {
+	newlyFailing := make([]*bool, len(problems))
+	if bazelInvocation.Branch == "" {
+		return newlyFailing, nil
 	}
-	if b.Reason != "" {
-		update = update.SetReason(b.Reason)
+	for i, problem := range problems {
+		firstFailure, err := FindFirstFailure(ctx, act.db, bazelInvocation, string(problem.ProblemType), problem.Label)
+		if err != nil {
+			return nil, fmt.Errorf("could not find first failure of %s: %w", problem.Label, err)
+		}
+		newlyFailing[i] = firstFailure.NewlyFailing(bazelInvocation)
+	}
+	return newlyFailing, nil
+}
+
+// saveKnownProblems records the saved problems of an invocation as occurrences of the known problems with the same
+// fingerprints, creating the known problems seen for the first time. It runs in the transaction of the summary, so
+// that the occurrences are only counted along with the problems linked to them.
+func (act SaveActor) saveKnownProblems(ctx context.Context, summary *summary.Summary, bazelInvocationID int) error {
+	occurrences := make(map[string]int)
+	var fingerprinted []detectors.Problem
+	for _, problem := range summary.Problems {
+		if problem.Fingerprint == "" {
+			continue
+		}
+		if occurrences[problem.Fingerprint] == 0 {
+			fingerprinted = append(fingerprinted, problem)
+		}
+		occurrences[problem.Fingerprint]++
+	}
+	for _, problem := range fingerprinted {
+		knownProblem, err := findOrCreateKnownProblem(ctx, act.db, summary, problem)
+		if err != nil {
+			return err
+		}
+		err = act.db.BazelInvocationProblem.Update().
+			Where(
+				bazelinvocationproblem.Fingerprint(problem.Fingerprint),
+				bazelinvocationproblem.HasBazelInvocationWith(bazelinvocation.ID(bazelInvocationID)),
+			).
+			SetKnownProblem(knownProblem).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("could not link problems to known problem: %w", err)
+		}
+		if err = recordOccurrences(ctx, act.db, knownProblem.ID, occurrences[problem.Fingerprint], summary); err != nil {
+			return fmt.Errorf("could not update known problem: %w", err)
+		}
+	}
+	return nil
+}
+
+// recordOccurrences adds occurrences seen in an invocation to a known problem. Each field is updated by a single
+// statement conditioned on its current value, so that concurrent saves neither lose occurrences nor append a branch
+// twice.
+func recordOccurrences(ctx context.Context, client *ent.Client, knownProblemID, occurrences int, summary *summary.Summary) error {
+	if err := client.KnownProblem.UpdateOneID(knownProblemID).AddOccurrences(occurrences).Exec(ctx); err != nil {
+		return err
+	}
+	err := client.KnownProblem.Update().
+		Where(knownproblem.ID(knownProblemID), knownproblem.FirstSeenGT(summary.StartedAt)).
+		SetFirstSeen(summary.StartedAt).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	err = client.KnownProblem.Update().
+		Where(knownproblem.ID(knownProblemID), knownproblem.LastSeenLT(summary.StartedAt)).
+		SetLastSeen(summary.StartedAt).
+		Exec(ctx)
+	if err != nil || summary.Branch == "" {
+		return err
+	}
+	return client.KnownProblem.Update().
+		Where(knownproblem.ID(knownProblemID), func(s *sql.Selector) {
+			s.Where(sql.Or(
+				sql.IsNull(s.C(knownproblem.FieldBranches)),
+				sql.Not(sqljson.ValueContains(s.C(knownproblem.FieldBranches), summary.Branch)),
+			))
+		}).
+		AppendBranches([]string{summary.Branch}).
+		Exec(ctx)
+}
+
+// forgetKnownProblems takes the problems of an invocation out of the occurrences of their known problems, before it is
+// summarized again, returning the IDs of those known problems.
+func forgetKnownProblems(ctx context.Context, client *ent.Client, bazelInvocationID int) ([]int, error) {
+	problems, err := client.BazelInvocationProblem.Query().
+		Where(
+			bazelinvocationproblem.HasBazelInvocationWith(bazelinvocation.ID(bazelInvocationID)),
+			bazelinvocationproblem.HasKnownProblem(),
+		).
+		WithKnownProblem().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not query known problems: %w", err)
+	}
+	occurrences := make(map[int]int)
+	for _, problem := range problems {
+		occurrences[problem.Edges.KnownProblem.ID]++
 	}
-	if b.SizeBytes != 0 {
-		update = update.SetSizeBytes(b.SizeBytes)
+	knownProblemIDs := make([]int, 0, len(occurrences))
+	for knownProblemID, count := range occurrences {
+		if err = client.KnownProblem.UpdateOneID(knownProblemID).AddOccurrences(-count).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("could not update known problem: %w", err)
+		}
+		knownProblemIDs = append(knownProblemIDs, knownProblemID)
 	}
-	if _, err := update.Save(ctx); err != nil {
-		slog.Error("failed to save archived blob", "uri", b.URI, "err", err)
+	return knownProblemIDs, nil
+}
+
+// settleKnownProblems fixes up the known problems forgotten by an invocation summarized again once its new problems
+// are saved, or deleted once its problems are. The known problems it was the only occurrence of are deleted. The ones
+// it no longer has see their first and last seen times narrowed to the invocations of the problems left, when they
+// were those of the invocation, and lose its branch when no invocation left has it.
+func settleKnownProblems(ctx context.Context, client *ent.Client, knownProblemIDs []int, invocation *ent.BazelInvocation) error {
+	for _, knownProblemID := range knownProblemIDs {
+		knownProblem, err := client.KnownProblem.Query().
+			Where(knownproblem.ID(knownProblemID)).
+			WithProblems(func(query *ent.BazelInvocationProblemQuery) {
+				query.WithBazelInvocation()
+			}).
+			Only(ctx)
+		if err != nil {
+			return fmt.Errorf("could not query known problem: %w", err)
+		}
+		if knownProblem.Occurrences <= 0 {
+			if err = client.KnownProblem.DeleteOne(knownProblem).Exec(ctx); err != nil {
+				return fmt.Errorf("could not delete known problem: %w", err)
+			}
+			continue
+		}
+
+		var firstSeen, lastSeen time.Time
+		seenAgain := false
+		var branches []string
+		for _, problem := range knownProblem.Edges.Problems {
+			other := problem.Edges.BazelInvocation
+			if other == nil {
+				continue
+			}
+			if other.ID == invocation.ID {
+				seenAgain = true
+				break
+			}
+			if firstSeen.IsZero() || other.StartedAt.Before(firstSeen) {
+				firstSeen = other.StartedAt
+			}
+			if other.StartedAt.After(lastSeen) {
+				lastSeen = other.StartedAt
+			}
+			branches = append(branches, other.Branch)
+		}
+		if seenAgain {
+			continue
+		}
+		update := knownProblem.Update()
+		if !firstSeen.IsZero() && knownProblem.FirstSeen.Equal(invocation.StartedAt) {
+			update = update.SetFirstSeen(firstSeen)
+		}
+		if !lastSeen.IsZero() && knownProblem.LastSeen.Equal(invocation.StartedAt) {
+			update = update.SetLastSeen(lastSeen)
+		}
+		if invocation.Branch != "" && !slices.Contains(branches, invocation.Branch) {
+			update = update.SetBranches(slices.DeleteFunc(knownProblem.Branches, func(branch string) bool {
+				return branch == invocation.Branch
+			}))
+		}
+		if err = update.Exec(ctx); err != nil {
+			return fmt.Errorf("could not update known problem: %w", err)
+		}
+	}
+	return nil
+}
+
+func findOrCreateKnownProblem(ctx context.Context, client *ent.Client, summary *summary.Summary, problem detectors.Problem) (*ent.KnownProblem, error) {
+	knownProblem, err := client.KnownProblem.Query().
+		Where(knownproblem.Fingerprint(problem.Fingerprint)).Only(ctx)
+	if ent.IsNotFound(err) {
+		// Unless created concurrently by another invocation with the same problem, without failing the transaction.
+		err = client.KnownProblem.Create().
+			SetFingerprint(problem.Fingerprint).
+			SetProblemType(string(problem.ProblemType)).
+			SetLabel(problem.Label).
+			SetFirstSeen(summary.StartedAt).
+			SetLastSeen(summary.StartedAt).
+			OnConflictColumns(knownproblem.FieldFingerprint).
+			Ignore().
+			Exec(ctx)
+		if err == nil {
+			knownProblem, err = client.KnownProblem.Query().
+				Where(knownproblem.Fingerprint(problem.Fingerprint)).Only(ctx)
+		}
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not find or create known problem: %w", err)
 	}
+	return knownProblem, nil
 }
 
 // buildEnvVars filters the input so it only contains well known environment
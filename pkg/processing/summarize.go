@@ -6,10 +6,12 @@ import (
 	"github.com/buildbarn/bb-portal/pkg/summary"
 )
 
-// SummarizeActor struct.
-type SummarizeActor struct{}
+// SummarizeActor struct with the thresholds above which test health issues are reported.
+type SummarizeActor struct {
+	testHealthThresholds summary.TestHealthThresholds
+}
 
 // Summarize function.
-func (SummarizeActor) Summarize(ctx context.Context, eventFileURL string) (*summary.Summary, error) {
-	return summary.Summarize(ctx, eventFileURL)
+func (act SummarizeActor) Summarize(ctx context.Context, eventFileURL string) (*summary.Summary, error) {
+	return summary.Summarize(ctx, eventFileURL, act.testHealthThresholds)
 }
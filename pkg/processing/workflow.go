@@ -2,11 +2,24 @@ package processing
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
+	"os"
 
 	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
+	"github.com/buildbarn/bb-portal/pkg/progress"
+	"github.com/buildbarn/bb-portal/pkg/rollup"
+	"github.com/buildbarn/bb-portal/pkg/summary"
 )
 
+// errNoEventFile An error helper.
+var errNoEventFile = errors.New("no event file to summarize")
+
+// errIncompleteEventFile An error helper.
+var errIncompleteEventFile = errors.New("event file does not have a final event")
+
 // Workflow struct.
 type Workflow struct {
 	SummarizeActor
@@ -14,12 +27,13 @@ type Workflow struct {
 }
 
 // New Worflow constructor
-func New(db *ent.Client, blobArchiver BlobMultiArchiver) *Workflow {
+func New(db *ent.Client, blobArchivingPool *BlobArchivingPool, progressHub *progress.Hub, testHealthThresholds summary.TestHealthThresholds) *Workflow {
 	return &Workflow{
-		SummarizeActor: SummarizeActor{},
+		SummarizeActor: SummarizeActor{testHealthThresholds: testHealthThresholds},
 		SaveActor: SaveActor{
-			db:           db,
-			blobArchiver: blobArchiver,
+			db:                db,
+			blobArchivingPool: blobArchivingPool,
+			progressHub:       progressHub,
 		},
 	}
 }
@@ -37,3 +51,60 @@ func (w Workflow) ProcessFile(ctx context.Context, file string) (*ent.BazelInvoc
 	}
 	return w.SaveSummary(ctx, summary)
 }
+
+// Resummarize Summarizes the event file of an invocation again, and replaces everything saved from it in a
+// transaction. The invocation keeps its ID, and stays pinned if it was. Only the invocations read from the watched
+// folder still have their event file.
+func (w Workflow) Resummarize(ctx context.Context, invocationID int) (*ent.BazelInvocation, error) {
+	invocation, err := w.db.BazelInvocation.Query().
+		Where(bazelinvocation.ID(invocationID)).
+		WithEventFile().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not find invocation %d: %w", invocationID, err)
+	}
+	eventFile := invocation.Edges.EventFile
+	if eventFile == nil || eventFile.URL == "" {
+		return nil, fmt.Errorf("invocation %d: %w", invocationID, errNoEventFile)
+	}
+	// Only the files of the watched folder are kept: uploads are deleted once saved, and streams have no file.
+	if _, err = os.Stat(eventFile.URL); errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("invocation %d: %w: %s is gone", invocationID, errNoEventFile, eventFile.URL)
+	}
+	summary, err := w.Summarize(ctx, eventFile.URL)
+	if err != nil {
+		return nil, fmt.Errorf("could not summarize %s: %w", eventFile.URL, err)
+	}
+	if !summary.BEPCompleted {
+		return nil, fmt.Errorf("invocation %d: %w", invocationID, errIncompleteEventFile)
+	}
+
+	tx, err := w.db.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not start transaction: %w", err)
+	}
+	if err = rollup.Subtract(ctx, tx.Client(), invocationID); err != nil {
+		return nil, errors.Join(fmt.Errorf("could not update metrics rollups: %w", err), tx.Rollback())
+	}
+	knownProblemIDs, err := forgetKnownProblems(ctx, tx.Client(), invocationID)
+	if err != nil {
+		return nil, errors.Join(err, tx.Rollback())
+	}
+	if _, _, err = deleteInvocationGraph(ctx, tx, invocationID, true); err != nil {
+		return nil, errors.Join(err, tx.Rollback())
+	}
+	// Blobs are archived and the subscribers told once the transaction is committed.
+	saved, err := SaveActor{db: tx.Client()}.saveSummary(ctx, summary, invocation)
+	if err != nil {
+		return nil, errors.Join(err, tx.Rollback())
+	}
+	if err = settleKnownProblems(ctx, tx.Client(), knownProblemIDs, saved); err != nil {
+		return nil, errors.Join(err, tx.Rollback())
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("could not commit summary: %w", err)
+	}
+	w.blobArchivingPool.Notify()
+	w.publishSaved(summary, saved.ID)
+	return saved.Unwrap(), nil
+}
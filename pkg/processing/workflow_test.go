@@ -1,6 +1,7 @@
 package processing_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"flag"
@@ -9,11 +10,17 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/google/uuid"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/require"
 
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
 	"github.com/buildbarn/bb-portal/ent/gen/ent/enttest"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/hook"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/knownproblem"
 	"github.com/buildbarn/bb-portal/pkg/processing"
+	"github.com/buildbarn/bb-portal/pkg/progress"
+	"github.com/buildbarn/bb-portal/pkg/summary"
 )
 
 var (
@@ -42,7 +49,7 @@ func TestWorkflow_ProcessFile(t *testing.T) {
 		require.NoError(t, db.Close())
 	}()
 
-	worker := processing.New(db, processing.BlobMultiArchiver{})
+	worker := processing.New(db, nil, nil, summary.DefaultTestHealthThresholds())
 	ctx := context.Background()
 
 	dirEntries, err := os.ReadDir(inputFixtureBaseDir)
@@ -62,3 +69,202 @@ func TestWorkflow_ProcessFile(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkflow_Resummarize(t *testing.T) {
+	db := enttest.Open(t, "sqlite3", "file:resummarize?mode=memory&_fk=1")
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	ctx := context.Background()
+
+	worker := processing.New(db, nil, nil, summary.DefaultTestHealthThresholds())
+	invocation, err := worker.ProcessFile(ctx, filepath.Join(inputFixtureBaseDir, "nextjs_test_fail.bep.ndjson"))
+	require.NoError(t, err)
+	require.NoError(t, invocation.Update().SetPinned(true).Exec(ctx))
+	problems := db.BazelInvocationProblem.Query().CountX(ctx)
+	require.NotZero(t, problems)
+	knownProblems := db.KnownProblem.Query().Order(ent.Asc(knownproblem.FieldID)).AllX(ctx)
+	require.NotEmpty(t, knownProblems)
+	// A problem no longer detected, as if by an older version.
+	gone := db.KnownProblem.Create().
+		SetFingerprint("gone").
+		SetProblemType("GONE").
+		SetLabel("//gone").
+		SetFirstSeen(invocation.StartedAt).
+		SetLastSeen(invocation.StartedAt).
+		SetOccurrences(1).
+		SaveX(ctx)
+	db.BazelInvocationProblem.Create().
+		SetProblemType("GONE").
+		SetLabel("//gone").
+		SetBepEvents([]byte("[]")).
+		SetFingerprint("gone").
+		SetKnownProblem(gone).
+		SetBazelInvocation(invocation).
+		ExecX(ctx)
+
+	resummarized, err := worker.Resummarize(ctx, invocation.ID)
+	require.NoError(t, err)
+	require.Equal(t, invocation.ID, resummarized.ID)
+	require.Equal(t, invocation.InvocationID, resummarized.InvocationID)
+	require.True(t, resummarized.Pinned)
+	require.Equal(t, 1, db.BazelInvocation.Query().CountX(ctx))
+	require.Equal(t, 1, db.EventFile.Query().CountX(ctx))
+	require.Equal(t, problems, db.BazelInvocationProblem.Query().CountX(ctx))
+	// Counted once, and gone once no longer detected.
+	resummarizedKnownProblems := db.KnownProblem.Query().Order(ent.Asc(knownproblem.FieldID)).AllX(ctx)
+	require.Len(t, resummarizedKnownProblems, len(knownProblems))
+	for i, knownProblem := range resummarizedKnownProblems {
+		require.Equal(t, knownProblems[i].ID, knownProblem.ID)
+		require.Equal(t, knownProblems[i].Occurrences, knownProblem.Occurrences)
+		require.True(t, knownProblems[i].FirstSeen.Equal(knownProblem.FirstSeen))
+		require.True(t, knownProblems[i].LastSeen.Equal(knownProblem.LastSeen))
+	}
+}
+
+func TestWorkflow_ProcessFile_PublishesProgress(t *testing.T) {
+	db := enttest.Open(t, "sqlite3", "file:progress?mode=memory&_fk=1")
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	progressHub := progress.NewHub()
+	invocationEvents := progressHub.SubscribeInvocation(ctx, "571d0839-fd63-4442-bb4d-61f7bfa4ddae")
+	newInvocations := progressHub.SubscribeBuild(ctx, uuid.NewSHA1(uuid.NameSpaceURL, []byte("https://example.com/build/1234")))
+
+	invocation, err := processing.New(db, nil, progressHub, summary.DefaultTestHealthThresholds()).ProcessFile(ctx, filepath.Join(inputFixtureBaseDir, "nextjs_test_fail.bep.ndjson"))
+	require.NoError(t, err)
+
+	// Published before the file is done processing.
+	require.Len(t, invocationEvents, 1)
+	finished := <-invocationEvents
+	require.Equal(t, progress.EventKindFinished, finished.Kind)
+	require.Equal(t, invocation.ID, finished.RecordID)
+	require.False(t, finished.Success)
+	require.Equal(t, "TESTS_FAILED", finished.Status)
+	require.Len(t, newInvocations, 1)
+	require.Equal(t, invocation.ID, (<-newInvocations).RecordID)
+}
+
+func TestWorkflow_ProcessFile_SystemNetworkStats(t *testing.T) {
+	db := enttest.Open(t, "sqlite3", "file:network?mode=memory&_fk=1")
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	ctx := context.Background()
+
+	// Add the network metrics Bazel reports with --experimental_collect_system_network_usage to the build metrics.
+	original, err := os.ReadFile(filepath.Join(inputFixtureBaseDir, "nextjs_build.bep.ndjson"))
+	require.NoError(t, err)
+	lines := bytes.Split(original, []byte("\n"))
+	for i, line := range lines {
+		if !bytes.HasPrefix(line, []byte(`{"id":{"buildMetrics":{}},"buildMetrics":{`)) {
+			continue
+		}
+		networkMetrics := `"networkMetrics":{"systemNetworkStats":{"bytesSent":"1","bytesRecv":"2","packetsSent":"3","packetsRecv":"4",` +
+			`"peakBytesSentPerSec":"5","peakBytesRecvPerSec":"6","peakPacketsSentPerSec":"7","peakPacketsRecvPerSec":"8"}},`
+		lines[i] = bytes.Replace(line, []byte(`"buildMetrics":{"actionSummary"`), []byte(`"buildMetrics":{`+networkMetrics+`"actionSummary"`), 1)
+	}
+	eventFile := filepath.Join(t.TempDir(), "nextjs_build.bep.ndjson")
+	require.NoError(t, os.WriteFile(eventFile, bytes.Join(lines, []byte("\n")), 0o600))
+
+	_, err = processing.New(db, nil, nil, summary.DefaultTestHealthThresholds()).ProcessFile(ctx, eventFile)
+	require.NoError(t, err)
+	stats := db.SystemNetworkStats.Query().OnlyX(ctx)
+	require.Equal(t, uint64(1), stats.BytesSent)
+	require.Equal(t, uint64(2), stats.BytesRecv)
+	require.Equal(t, uint64(3), stats.PacketsSent)
+	require.Equal(t, uint64(4), stats.PacketsRecv)
+	require.Equal(t, uint64(5), stats.PeakBytesSentPerSec)
+	require.Equal(t, uint64(6), stats.PeakBytesRecvPerSec)
+	require.Equal(t, uint64(7), stats.PeakPacketsSentPerSec)
+	require.Equal(t, uint64(8), stats.PeakPacketsRecvPerSec)
+}
+
+func TestWorkflow_ProcessFile_KnownProblems(t *testing.T) {
+	db := enttest.Open(t, "sqlite3", "file:known_problems?mode=memory&_fk=1")
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	ctx := context.Background()
+
+	// The same invocation on a branch saved twice under different IDs.
+	original, err := os.ReadFile(filepath.Join(inputFixtureBaseDir, "nextjs_test_fail.bep.ndjson"))
+	require.NoError(t, err)
+	onBranch := bytes.ReplaceAll(original, []byte(`"optionValue":"HOME\u003d/Users/nameless"`), []byte(`"optionValue":"GIT_BRANCH\u003dmain"`))
+	worker := processing.New(db, nil, nil, summary.DefaultTestHealthThresholds())
+	for _, invocationID := range []string{"571d0839-fd63-4442-bb4d-61f7bfa4ddae", uuid.NewString()} {
+		eventFile := filepath.Join(t.TempDir(), "nextjs_test_fail.bep.ndjson")
+		require.NoError(t, os.WriteFile(eventFile, bytes.ReplaceAll(onBranch, []byte("571d0839-fd63-4442-bb4d-61f7bfa4ddae"), []byte(invocationID)), 0o600))
+		invocation, err := worker.ProcessFile(ctx, eventFile)
+		require.NoError(t, err)
+		require.Equal(t, "main", invocation.Branch)
+	}
+
+	knownProblems := db.KnownProblem.Query().WithProblems().AllX(ctx)
+	require.NotEmpty(t, knownProblems)
+	for _, knownProblem := range knownProblems {
+		require.Len(t, knownProblem.Edges.Problems, knownProblem.Occurrences)
+		require.Equal(t, 2, knownProblem.Occurrences)
+		require.Equal(t, []string{"main"}, knownProblem.Branches)
+	}
+}
+
+func TestWorkflow_Resummarize_FileGone(t *testing.T) {
+	db := enttest.Open(t, "sqlite3", "file:resummarize_gone?mode=memory&_fk=1")
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	ctx := context.Background()
+
+	// As the uploaded files, deleted once saved.
+	original, err := os.ReadFile(filepath.Join(inputFixtureBaseDir, "nextjs_build.bep.ndjson"))
+	require.NoError(t, err)
+	eventFile := filepath.Join(t.TempDir(), "nextjs_build.bep.ndjson")
+	require.NoError(t, os.WriteFile(eventFile, original, 0o600))
+	worker := processing.New(db, nil, nil, summary.DefaultTestHealthThresholds())
+	invocation, err := worker.ProcessFile(ctx, eventFile)
+	require.NoError(t, err)
+	require.NoError(t, os.Remove(eventFile))
+
+	_, err = worker.Resummarize(ctx, invocation.ID)
+	require.ErrorContains(t, err, "no event file to summarize")
+	require.Equal(t, 1, db.BazelInvocation.Query().CountX(ctx))
+}
+
+func TestWorkflow_ProcessFile_SavesAtomically(t *testing.T) {
+	db := enttest.Open(t, "sqlite3", "file:atomic_save?mode=memory&_fk=1")
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	ctx := context.Background()
+
+	// Fail to update the metrics rollups, once the invocation is saved.
+	failRollups := true
+	db.MetricsRollup.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.MetricsRollupFunc(func(ctx context.Context, m *ent.MetricsRollupMutation) (ent.Value, error) {
+			if failRollups {
+				return nil, errors.New("rollups are unavailable")
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+	worker := processing.New(db, nil, nil, summary.DefaultTestHealthThresholds())
+	file := filepath.Join(inputFixtureBaseDir, "nextjs_test_fail.bep.ndjson")
+	_, err := worker.ProcessFile(ctx, file)
+	require.ErrorContains(t, err, "rollups are unavailable")
+	require.Zero(t, db.BazelInvocation.Query().CountX(ctx))
+	require.Zero(t, db.BazelInvocationProblem.Query().CountX(ctx))
+	require.Zero(t, db.KnownProblem.Query().CountX(ctx))
+	require.Zero(t, db.Blob.Query().CountX(ctx))
+
+	// So that it can be saved again.
+	failRollups = false
+	invocation, err := worker.ProcessFile(ctx, file)
+	require.NoError(t, err)
+	require.NoError(t, invocation.Update().SetPinned(true).Exec(ctx))
+	require.NotZero(t, db.MetricsRollup.Query().CountX(ctx))
+	require.NotZero(t, db.KnownProblem.Query().CountX(ctx))
+}
@@ -0,0 +1,3 @@
+// Package progress publishes the progress of invocations as their build events arrive, and the invocations saved for
+// every build, to the subscribers following them live.
+package progress
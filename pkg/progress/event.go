@@ -0,0 +1,128 @@
+package progress
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/buildbarn/bb-portal/pkg/events"
+	"github.com/buildbarn/bb-portal/pkg/summary"
+	"github.com/buildbarn/bb-portal/third_party/bazel/gen/bes"
+)
+
+// EventKind enum.
+type EventKind string
+
+// EventKind values.
+const (
+	EventKindStarted         EventKind = "STARTED"
+	EventKindTargetCompleted EventKind = "TARGET_COMPLETED"
+	EventKindTestResult      EventKind = "TEST_RESULT"
+	EventKindProblem         EventKind = "PROBLEM"
+	EventKindFinished        EventKind = "FINISHED"
+)
+
+// StatusIncomplete The status of an invocation finished without its last build event, or which could not be saved.
+const StatusIncomplete = "INCOMPLETE"
+
+// Event A step in the progress of an invocation.
+type Event struct {
+	Kind EventKind
+	// InvocationID is the UUID Bazel gave to the invocation.
+	InvocationID string
+	Time         time.Time
+	// Label is the target, test or action the event is about.
+	Label   string
+	Success bool
+	// Status is the command of a started invocation, the status of a test, the abort reason of a target or the
+	// mnemonic of a failed action, and the exit code of a finished invocation.
+	Status string
+	// RecordID is the ID of the invocation once it was saved, set on the finished event.
+	RecordID int
+}
+
+// NewInvocation An invocation saved for a build.
+type NewInvocation struct {
+	BuildUUID uuid.UUID
+	// RecordID is the ID of the saved invocation.
+	RecordID int
+}
+
+// FromBuildEvent Gets the progress step a build event is about, false if it is not one.
+func FromBuildEvent(invocationID string, buildEvent *events.BuildEvent) (Event, bool) {
+	event := Event{InvocationID: invocationID, Time: time.Now()}
+	switch id := buildEvent.GetId().GetId().(type) {
+	case *bes.BuildEventId_Started:
+		event.Kind = EventKindStarted
+		event.Success = true
+		event.Status = buildEvent.GetStarted().GetCommand()
+	case *bes.BuildEventId_TargetCompleted:
+		event.Kind = EventKindTargetCompleted
+		event.Label = id.TargetCompleted.GetLabel()
+		event.Success = buildEvent.GetCompleted().GetSuccess()
+		if aborted := buildEvent.GetAborted(); aborted != nil {
+			event.Status = aborted.GetReason().String()
+		}
+	case *bes.BuildEventId_TestResult:
+		event.Kind = EventKindTestResult
+		event.Label = id.TestResult.GetLabel()
+		event.Success = buildEvent.GetTestResult().GetStatus() == bes.TestStatus_PASSED
+		event.Status = buildEvent.GetTestResult().GetStatus().String()
+	case *bes.BuildEventId_ActionCompleted:
+		action := buildEvent.GetAction()
+		if action == nil || action.GetSuccess() {
+			return Event{}, false
+		}
+		event.Kind = EventKindProblem
+		event.Label = id.ActionCompleted.GetLabel()
+		event.Status = action.GetType()
+	default:
+		// Targets and patterns failing to load or analyze.
+		aborted := buildEvent.GetAborted()
+		if aborted == nil || aborted.GetReason() == bes.Aborted_SKIPPED {
+			return Event{}, false
+		}
+		event.Kind = EventKindProblem
+		event.Label = abortedLabel(buildEvent.GetId())
+		event.Status = aborted.GetReason().String()
+	}
+	return event, true
+}
+
+// FinishedEvent The last progress step of an invocation saved with a record ID.
+func FinishedEvent(invocationSummary *summary.Summary, recordID int) Event {
+	event := IncompleteEvent(invocationSummary.InvocationID)
+	event.RecordID = recordID
+	if exitCode := invocationSummary.ExitCode; exitCode != nil {
+		event.Success = exitCode.Code == summary.ExitCodeSuccess
+		event.Status = exitCode.Name
+	}
+	return event
+}
+
+// IncompleteEvent The last progress step of an invocation which could not be saved.
+func IncompleteEvent(invocationID string) Event {
+	return Event{
+		Kind:         EventKindFinished,
+		InvocationID: invocationID,
+		Time:         time.Now(),
+		Status:       StatusIncomplete,
+	}
+}
+
+// The label of the target or pattern an aborted build event is about.
+func abortedLabel(id *bes.BuildEventId) string {
+	switch id := id.GetId().(type) {
+	case *bes.BuildEventId_TargetConfigured:
+		return id.TargetConfigured.GetLabel()
+	case *bes.BuildEventId_Pattern:
+		return strings.Join(id.Pattern.GetPattern(), " ")
+	case *bes.BuildEventId_UnconfiguredLabel:
+		return id.UnconfiguredLabel.GetLabel()
+	case *bes.BuildEventId_ConfiguredLabel:
+		return id.ConfiguredLabel.GetLabel()
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,161 @@
+package progress
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// subscriberBufferSize How many events a subscriber may fall behind before it is dropped.
+const subscriberBufferSize = 256
+
+// Hub Delivers the progress of invocations and the invocations saved for builds to their subscribers. A subscriber
+// which does not keep up is dropped, its channel closed, rather than holding up the build events. All methods do
+// nothing on a nil hub.
+type Hub struct {
+	mu          sync.Mutex
+	invocations topics[string, Event]
+	builds      topics[uuid.UUID, NewInvocation]
+}
+
+// A set of subscribers per key.
+type topics[K comparable, V any] map[K]map[chan V]struct{}
+
+// NewHub Constructor for a progress hub.
+func NewHub() *Hub {
+	return &Hub{
+		invocations: topics[string, Event]{},
+		builds:      topics[uuid.UUID, NewInvocation]{},
+	}
+}
+
+// Publish Sends a progress step to the subscribers of its invocation. The subscriptions end with the finished event.
+func (h *Hub) Publish(event Event) {
+	if h == nil || event.InvocationID == "" {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.invocations.publish(event.InvocationID, event)
+	if event.Kind == EventKindFinished {
+		h.invocations.closeAll(event.InvocationID)
+	}
+}
+
+// PublishNewInvocation Sends an invocation saved for a build to the subscribers of the build.
+func (h *Hub) PublishNewInvocation(newInvocation NewInvocation) {
+	if h == nil || newInvocation.BuildUUID == uuid.Nil {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.builds.publish(newInvocation.BuildUUID, newInvocation)
+}
+
+// SubscribeInvocation Subscribes to the progress of an invocation until it finished or the context is done, when the
+// channel is closed.
+func (h *Hub) SubscribeInvocation(ctx context.Context, invocationID string) <-chan Event {
+	if h == nil {
+		return closedChannel[Event]()
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	ch := h.invocations.subscribe(invocationID)
+	go func() {
+		<-ctx.Done()
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		h.invocations.unsubscribe(invocationID, ch)
+	}()
+	return ch
+}
+
+// SubscribeBuild Subscribes to the invocations saved for a build until the context is done, when the channel is
+// closed.
+func (h *Hub) SubscribeBuild(ctx context.Context, buildUUID uuid.UUID) <-chan NewInvocation {
+	if h == nil {
+		return closedChannel[NewInvocation]()
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	ch := h.builds.subscribe(buildUUID)
+	go func() {
+		<-ctx.Done()
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		h.builds.unsubscribe(buildUUID, ch)
+	}()
+	return ch
+}
+
+// Subscribers The number of subscriptions to invocations and to builds.
+func (h *Hub) Subscribers() map[string]int {
+	if h == nil {
+		return map[string]int{"invocations": 0, "builds": 0}
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return map[string]int{
+		"invocations": h.invocations.count(),
+		"builds":      h.builds.count(),
+	}
+}
+
+// Add a subscriber to a key.
+func (t topics[K, V]) subscribe(key K) chan V {
+	ch := make(chan V, subscriberBufferSize)
+	if t[key] == nil {
+		t[key] = map[chan V]struct{}{}
+	}
+	t[key][ch] = struct{}{}
+	return ch
+}
+
+// Remove a subscriber from a key and close its channel, unless it was already.
+func (t topics[K, V]) unsubscribe(key K, ch chan V) {
+	if _, ok := t[key][ch]; !ok {
+		return
+	}
+	delete(t[key], ch)
+	if len(t[key]) == 0 {
+		delete(t, key)
+	}
+	close(ch)
+}
+
+// Send a value to the subscribers of a key, dropping the ones which are full.
+func (t topics[K, V]) publish(key K, value V) {
+	for ch := range t[key] {
+		select {
+		case ch <- value:
+		default:
+			slog.Warn("dropping a progress subscriber which does not keep up", "key", key)
+			t.unsubscribe(key, ch)
+		}
+	}
+}
+
+// Remove all the subscribers of a key.
+func (t topics[K, V]) closeAll(key K) {
+	for ch := range t[key] {
+		t.unsubscribe(key, ch)
+	}
+}
+
+// Count the subscribers of all keys.
+func (t topics[K, V]) count() int {
+	n := 0
+	for _, subscribers := range t {
+		n += len(subscribers)
+	}
+	return n
+}
+
+// A channel without values.
+func closedChannel[V any]() <-chan V {
+	ch := make(chan V)
+	close(ch)
+	return ch
+}
@@ -0,0 +1,144 @@
+package progress_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildbarn/bb-portal/pkg/events"
+	"github.com/buildbarn/bb-portal/pkg/progress"
+	"github.com/buildbarn/bb-portal/third_party/bazel/gen/bes"
+)
+
+func TestHub_SubscribeInvocation(t *testing.T) {
+	ctx := context.Background()
+	hub := progress.NewHub()
+	events := hub.SubscribeInvocation(ctx, "invocation")
+	other := hub.SubscribeInvocation(ctx, "other")
+	require.Equal(t, map[string]int{"invocations": 2, "builds": 0}, hub.Subscribers())
+
+	hub.Publish(progress.Event{Kind: progress.EventKindStarted, InvocationID: "invocation"})
+	hub.Publish(progress.Event{Kind: progress.EventKindFinished, InvocationID: "invocation", RecordID: 1})
+	require.Equal(t, progress.EventKindStarted, (<-events).Kind)
+	require.Equal(t, 1, (<-events).RecordID)
+	_, ok := <-events
+	require.False(t, ok, "the subscription ends once the invocation finished")
+	require.Empty(t, other)
+	require.Equal(t, map[string]int{"invocations": 1, "builds": 0}, hub.Subscribers())
+}
+
+func TestHub_SubscribeBuild(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	hub := progress.NewHub()
+	buildUUID := uuid.New()
+	newInvocations := hub.SubscribeBuild(ctx, buildUUID)
+
+	hub.PublishNewInvocation(progress.NewInvocation{BuildUUID: uuid.New(), RecordID: 1})
+	hub.PublishNewInvocation(progress.NewInvocation{BuildUUID: buildUUID, RecordID: 2})
+	require.Equal(t, 2, (<-newInvocations).RecordID)
+
+	cancel()
+	_, ok := <-newInvocations
+	require.False(t, ok, "the subscription ends with its context")
+	require.Equal(t, map[string]int{"invocations": 0, "builds": 0}, hub.Subscribers())
+}
+
+func TestHub_DropsSlowSubscribers(t *testing.T) {
+	hub := progress.NewHub()
+	events := hub.SubscribeInvocation(context.Background(), "invocation")
+	for range 1000 {
+		hub.Publish(progress.Event{Kind: progress.EventKindTestResult, InvocationID: "invocation"})
+	}
+	received := 0
+	for range events {
+		received++
+	}
+	require.Less(t, received, 1000)
+	require.Equal(t, map[string]int{"invocations": 0, "builds": 0}, hub.Subscribers())
+}
+
+func TestHub_Nil(t *testing.T) {
+	var hub *progress.Hub
+	hub.Publish(progress.Event{Kind: progress.EventKindStarted, InvocationID: "invocation"})
+	_, ok := <-hub.SubscribeInvocation(context.Background(), "invocation")
+	require.False(t, ok)
+	require.Equal(t, map[string]int{"invocations": 0, "builds": 0}, hub.Subscribers())
+}
+
+func TestFromBuildEvent(t *testing.T) {
+	for name, tc := range map[string]struct {
+		buildEvent *bes.BuildEvent
+		want       progress.Event
+		wantOK     bool
+	}{
+		"target completed": {
+			buildEvent: &bes.BuildEvent{
+				Id: &bes.BuildEventId{Id: &bes.BuildEventId_TargetCompleted{
+					TargetCompleted: &bes.BuildEventId_TargetCompletedId{Label: "//:app"},
+				}},
+				Payload: &bes.BuildEvent_Completed{Completed: &bes.TargetComplete{Success: true}},
+			},
+			want:   progress.Event{Kind: progress.EventKindTargetCompleted, Label: "//:app", Success: true},
+			wantOK: true,
+		},
+		"test result": {
+			buildEvent: &bes.BuildEvent{
+				Id: &bes.BuildEventId{Id: &bes.BuildEventId_TestResult{
+					TestResult: &bes.BuildEventId_TestResultId{Label: "//:test"},
+				}},
+				Payload: &bes.BuildEvent_TestResult{TestResult: &bes.TestResult{Status: bes.TestStatus_FAILED}},
+			},
+			want:   progress.Event{Kind: progress.EventKindTestResult, Label: "//:test", Status: "FAILED"},
+			wantOK: true,
+		},
+		"failed action": {
+			buildEvent: &bes.BuildEvent{
+				Id: &bes.BuildEventId{Id: &bes.BuildEventId_ActionCompleted{
+					ActionCompleted: &bes.BuildEventId_ActionCompletedId{Label: "//:lib"},
+				}},
+				Payload: &bes.BuildEvent_Action{Action: &bes.ActionExecuted{Type: "CppCompile"}},
+			},
+			want:   progress.Event{Kind: progress.EventKindProblem, Label: "//:lib", Status: "CppCompile"},
+			wantOK: true,
+		},
+		"analysis failure": {
+			buildEvent: &bes.BuildEvent{
+				Id: &bes.BuildEventId{Id: &bes.BuildEventId_TargetConfigured{
+					TargetConfigured: &bes.BuildEventId_TargetConfiguredId{Label: "//:broken"},
+				}},
+				Payload: &bes.BuildEvent_Aborted{Aborted: &bes.Aborted{Reason: bes.Aborted_ANALYSIS_FAILURE}},
+			},
+			want:   progress.Event{Kind: progress.EventKindProblem, Label: "//:broken", Status: "ANALYSIS_FAILURE"},
+			wantOK: true,
+		},
+		"skipped": {
+			buildEvent: &bes.BuildEvent{
+				Id: &bes.BuildEventId{Id: &bes.BuildEventId_TargetConfigured{
+					TargetConfigured: &bes.BuildEventId_TargetConfiguredId{Label: "//:skipped"},
+				}},
+				Payload: &bes.BuildEvent_Aborted{Aborted: &bes.Aborted{Reason: bes.Aborted_SKIPPED}},
+			},
+		},
+		"progress": {
+			buildEvent: &bes.BuildEvent{
+				Id:      &bes.BuildEventId{Id: &bes.BuildEventId_Progress{Progress: &bes.BuildEventId_ProgressId{}}},
+				Payload: &bes.BuildEvent_Progress{Progress: &bes.Progress{Stderr: "Building..."}},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			buildEvent := events.NewBuildEvent(tc.buildEvent, nil)
+			event, ok := progress.FromBuildEvent("invocation", &buildEvent)
+			require.Equal(t, tc.wantOK, ok)
+			if !ok {
+				return
+			}
+			require.Equal(t, "invocation", event.InvocationID)
+			event.InvocationID = ""
+			event.Time = tc.want.Time
+			require.Equal(t, tc.want, event)
+		})
+	}
+}
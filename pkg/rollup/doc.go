@@ -0,0 +1,3 @@
+// Package rollup keeps build metrics aggregated per bucket of time, branch, command, step label and user, updated
+// as invocations are saved, so that their trends are read without scanning the metrics of every invocation.
+package rollup
@@ -0,0 +1,69 @@
+package rollup
+
+import (
+	"math"
+	"sort"
+)
+
+// bucketsPerOctave The number of histogram buckets per doubling of values, their width being about 19%.
+const bucketsPerOctave = 4
+
+// zeroBucket The histogram bucket of the values which are not positive.
+const zeroBucket = math.MinInt32
+
+// Histogram The number of values per logarithmic bucket, which can be added up and subtracted to estimate the
+// percentiles of the values of many invocations.
+type Histogram map[int]int64
+
+// Add Counts a value, or uncounts it with a negative count.
+func (h Histogram) Add(value float64, count int64) {
+	bucket := zeroBucket
+	if value > 0 {
+		bucket = int(math.Floor(math.Log2(value) * bucketsPerOctave))
+	}
+	h[bucket] += count
+	if h[bucket] <= 0 {
+		delete(h, bucket)
+	}
+}
+
+// Merge Adds up the counts of another histogram.
+func (h Histogram) Merge(other Histogram) {
+	for bucket, count := range other {
+		h[bucket] += count
+		if h[bucket] <= 0 {
+			delete(h, bucket)
+		}
+	}
+}
+
+// Quantile Estimates the value under which a fraction of the values are, false if there are none.
+func (h Histogram) Quantile(q float64) (float64, bool) {
+	var total int64
+	buckets := make([]int, 0, len(h))
+	for bucket, count := range h {
+		total += count
+		buckets = append(buckets, bucket)
+	}
+	if total <= 0 {
+		return 0, false
+	}
+	sort.Ints(buckets)
+	rank := int64(math.Ceil(q * float64(total)))
+	var seen int64
+	for _, bucket := range buckets {
+		seen += h[bucket]
+		if seen >= rank {
+			return bucketValue(bucket), true
+		}
+	}
+	return bucketValue(buckets[len(buckets)-1]), true
+}
+
+// The value representing a bucket, its geometric middle.
+func bucketValue(bucket int) float64 {
+	if bucket == zeroBucket {
+		return 0
+	}
+	return math.Exp2((float64(bucket) + 0.5) / bucketsPerOctave)
+}
@@ -0,0 +1,121 @@
+package rollup
+
+import (
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+)
+
+// Metric enum, with the same values as the MetricsTrendMetric GraphQL enum.
+type Metric string
+
+// Metric values.
+const (
+	MetricRemoteCacheHitRate     Metric = "REMOTE_CACHE_HIT_RATE"
+	MetricActionCacheHitRate     Metric = "ACTION_CACHE_HIT_RATE"
+	MetricActionsExecuted        Metric = "ACTIONS_EXECUTED"
+	MetricActionsCreated         Metric = "ACTIONS_CREATED"
+	MetricWallTimeInMs           Metric = "WALL_TIME_IN_MS"
+	MetricCPUTimeInMs            Metric = "CPU_TIME_IN_MS"
+	MetricAnalysisPhaseTimeInMs  Metric = "ANALYSIS_PHASE_TIME_IN_MS"
+	MetricExecutionPhaseTimeInMs Metric = "EXECUTION_PHASE_TIME_IN_MS"
+	MetricPeakPostGcHeapSize     Metric = "PEAK_POST_GC_HEAP_SIZE"
+	MetricUsedHeapSizePostBuild  Metric = "USED_HEAP_SIZE_POST_BUILD"
+	MetricBytesRecv              Metric = "BYTES_RECV"
+	MetricBytesSent              Metric = "BYTES_SENT"
+)
+
+// AllMetrics The metrics rolled up.
+func AllMetrics() []Metric {
+	return []Metric{
+		MetricRemoteCacheHitRate,
+		MetricActionCacheHitRate,
+		MetricActionsExecuted,
+		MetricActionsCreated,
+		MetricWallTimeInMs,
+		MetricCPUTimeInMs,
+		MetricAnalysisPhaseTimeInMs,
+		MetricExecutionPhaseTimeInMs,
+		MetricPeakPostGcHeapSize,
+		MetricUsedHeapSizePostBuild,
+		MetricBytesRecv,
+		MetricBytesSent,
+	}
+}
+
+// Sample The numerator and denominator an invocation contributes to a metric, false if it does not have it. The value
+// of a bucket is the ratio of their totals: the average for plain metrics, whose denominator is 1. The edges of the
+// metrics read are expected to be loaded, see WithMetricsEdges.
+func Sample(metric Metric, metrics *ent.Metrics) (float64, float64, bool) {
+	actionSummaries := metrics.Edges.ActionSummary
+	switch metric {
+	case MetricRemoteCacheHitRate:
+		hits, ok := sumOf(actionSummaries, func(a *ent.ActionSummary) float64 { return float64(a.RemoteCacheHits) })
+		executed, _ := sumOf(actionSummaries, func(a *ent.ActionSummary) float64 { return float64(a.ActionsExecuted) })
+		return hits, executed, ok && executed > 0
+	case MetricActionCacheHitRate:
+		var statistics []*ent.ActionCacheStatistics
+		for _, actionSummary := range actionSummaries {
+			statistics = append(statistics, actionSummary.Edges.ActionCacheStatistics...)
+		}
+		hits, ok := sumOf(statistics, func(s *ent.ActionCacheStatistics) float64 { return float64(s.Hits) })
+		misses, _ := sumOf(statistics, func(s *ent.ActionCacheStatistics) float64 { return float64(s.Misses) })
+		return hits, hits + misses, ok && hits+misses > 0
+	case MetricActionsExecuted:
+		return plainSample(sumOf(actionSummaries, func(a *ent.ActionSummary) float64 { return float64(a.ActionsExecuted) }))
+	case MetricActionsCreated:
+		return plainSample(sumOf(actionSummaries, func(a *ent.ActionSummary) float64 { return float64(a.ActionsCreated) }))
+	case MetricWallTimeInMs:
+		return plainSample(sumOf(metrics.Edges.TimingMetrics, func(t *ent.TimingMetrics) float64 { return float64(t.WallTimeInMs) }))
+	case MetricCPUTimeInMs:
+		return plainSample(sumOf(metrics.Edges.TimingMetrics, func(t *ent.TimingMetrics) float64 { return float64(t.CPUTimeInMs) }))
+	case MetricAnalysisPhaseTimeInMs:
+		return plainSample(sumOf(metrics.Edges.TimingMetrics, func(t *ent.TimingMetrics) float64 { return float64(t.AnalysisPhaseTimeInMs) }))
+	case MetricExecutionPhaseTimeInMs:
+		return plainSample(sumOf(metrics.Edges.TimingMetrics, func(t *ent.TimingMetrics) float64 { return float64(t.ExecutionPhaseTimeInMs) }))
+	case MetricPeakPostGcHeapSize:
+		return plainSample(sumOf(metrics.Edges.MemoryMetrics, func(m *ent.MemoryMetrics) float64 { return float64(m.PeakPostGcHeapSize) }))
+	case MetricUsedHeapSizePostBuild:
+		return plainSample(sumOf(metrics.Edges.MemoryMetrics, func(m *ent.MemoryMetrics) float64 { return float64(m.UsedHeapSizePostBuild) }))
+	case MetricBytesRecv:
+		return plainSample(sumOf(systemNetworkStats(metrics), func(s *ent.SystemNetworkStats) float64 { return float64(s.BytesRecv) }))
+	case MetricBytesSent:
+		return plainSample(sumOf(systemNetworkStats(metrics), func(s *ent.SystemNetworkStats) float64 { return float64(s.BytesSent) }))
+	default:
+		return 0, 0, false
+	}
+}
+
+// WithMetricsEdges Loads the edges of metrics the samples are read from.
+func WithMetricsEdges(query *ent.MetricsQuery) {
+	query.
+		WithActionSummary(func(query *ent.ActionSummaryQuery) {
+			query.WithActionCacheStatistics()
+		}).
+		WithTimingMetrics().
+		WithMemoryMetrics().
+		WithNetworkMetrics(func(query *ent.NetworkMetricsQuery) {
+			query.WithSystemNetworkStats()
+		})
+}
+
+// The system network stats of the network metrics.
+func systemNetworkStats(metrics *ent.Metrics) []*ent.SystemNetworkStats {
+	var stats []*ent.SystemNetworkStats
+	for _, networkMetrics := range metrics.Edges.NetworkMetrics {
+		stats = append(stats, networkMetrics.Edges.SystemNetworkStats...)
+	}
+	return stats
+}
+
+// Sum a value over items, false if there are none.
+func sumOf[T any](items []*T, value func(*T) float64) (float64, bool) {
+	sum := 0.0
+	for _, item := range items {
+		sum += value(item)
+	}
+	return sum, len(items) > 0
+}
+
+// The sample of a plain metric, averaged over invocations.
+func plainSample(sum float64, ok bool) (float64, float64, bool) {
+	return sum, 1, ok
+}
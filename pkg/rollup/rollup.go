@@ -0,0 +1,443 @@
+package rollup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/metricsrollup"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/predicate"
+)
+
+// maxBuckets The most buckets a trend may span.
+const maxBuckets = 2000
+
+// backfillBatchSize The number of invocations or rollups read or written at once by a backfill.
+const backfillBatchSize = 100
+
+// maxUpdateAttempts The number of times a rollup updated concurrently is read again before giving up.
+const maxUpdateAttempts = 100
+
+// Error helpers.
+var (
+	errTooManyBuckets    = errors.New("too many buckets, use a shorter window or larger buckets")
+	errConcurrentUpdates = errors.New("rollup updated concurrently")
+)
+
+// Filter Only the rollups of the invocations matching all the given fields.
+type Filter struct {
+	Branch    *string
+	Command   *string
+	StepLabel *string
+	// User is the ldap or the email of the user who launched the invocations.
+	User *string
+}
+
+// Point The value of a metric over a bucket.
+type Point struct {
+	Time        time.Time
+	Invocations int64
+	Value       float64
+	Min         float64
+	Max         float64
+	// P50, P90 and P99 are estimated, within about 10%.
+	P50 float64
+	P90 float64
+	P99 float64
+}
+
+// Trend The points of a metric in time order.
+type Trend struct {
+	Metric Metric
+	Points []Point
+}
+
+// The key of a rollup.
+type key struct {
+	granularity metricsrollup.Granularity
+	bucketStart time.Time
+	metric      Metric
+	branch      string
+	command     string
+	stepLabel   string
+	userLdap    string
+	userEmail   string
+}
+
+// The aggregate of a metric over invocations.
+type aggregate struct {
+	invocations            int64
+	numerator, denominator float64
+	min, max               float64
+	histogram              Histogram
+}
+
+// Add Rolls up the metrics of a saved invocation.
+func Add(ctx context.Context, client *ent.Client, invocationID int) error {
+	return apply(ctx, client, invocationID, 1)
+}
+
+// Subtract Takes the metrics of an invocation out of the rollups, before it is summarized again. The minimums and
+// maximums are left as they were.
+func Subtract(ctx context.Context, client *ent.Client, invocationID int) error {
+	return apply(ctx, client, invocationID, -1)
+}
+
+// Backfill Replaces the rollups with the ones of the invocations in the database, returning how many were rolled up. The
+// invocations deleted by the retention policies are no longer accounted for.
+func Backfill(ctx context.Context, client *ent.Client) (int, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("could not start transaction: %w", err)
+	}
+	count, err := backfill(ctx, tx.Client())
+	if err != nil {
+		return 0, errors.Join(err, tx.Rollback())
+	}
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("could not commit rollups: %w", err)
+	}
+	return count, nil
+}
+
+// Trends Reads the trends of metrics over the buckets overlapping [from, to).
+func Trends(ctx context.Context, client *ent.Client, metrics []Metric, from, to time.Time, granularity metricsrollup.Granularity, filter Filter) ([]Trend, error) {
+	if to.Sub(from)/bucketDuration(granularity) > maxBuckets {
+		return nil, fmt.Errorf("%w: at most %d", errTooManyBuckets, maxBuckets)
+	}
+	metricNames := make([]string, 0, len(metrics))
+	for _, metric := range metrics {
+		metricNames = append(metricNames, string(metric))
+	}
+	rollups, err := client.MetricsRollup.Query().
+		Where(
+			metricsrollup.GranularityEQ(granularity),
+			metricsrollup.MetricIn(metricNames...),
+			metricsrollup.BucketStartGTE(BucketStart(from, granularity)),
+			metricsrollup.BucketStartLT(to.UTC()),
+			metricsrollup.InvocationsGT(0),
+		).
+		Where(filterPredicates(filter)...).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch metrics rollups: %w", err)
+	}
+
+	buckets := make(map[Metric]map[time.Time]*aggregate, len(metrics))
+	for _, rollup := range rollups {
+		metric := Metric(rollup.Metric)
+		if buckets[metric] == nil {
+			buckets[metric] = map[time.Time]*aggregate{}
+		}
+		bucketStart := rollup.BucketStart.UTC()
+		a, ok := buckets[metric][bucketStart]
+		if !ok {
+			a = newAggregate()
+			buckets[metric][bucketStart] = a
+		}
+		a.merge(rollup)
+	}
+
+	trends := make([]Trend, 0, len(metrics))
+	for _, metric := range metrics {
+		points := make([]Point, 0, len(buckets[metric]))
+		for bucketStart, a := range buckets[metric] {
+			if a.invocations <= 0 || a.denominator == 0 {
+				continue
+			}
+			points = append(points, a.point(bucketStart))
+		}
+		sort.Slice(points, func(i, j int) bool {
+			return points[i].Time.Before(points[j].Time)
+		})
+		trends = append(trends, Trend{Metric: metric, Points: points})
+	}
+	return trends, nil
+}
+
+// BucketStart The start of the bucket a time falls in, in UTC.
+func BucketStart(t time.Time, granularity metricsrollup.Granularity) time.Time {
+	t = t.UTC()
+	if granularity == metricsrollup.GranularityDAY {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	return t.Truncate(time.Hour)
+}
+
+// The duration of a bucket.
+func bucketDuration(granularity metricsrollup.Granularity) time.Duration {
+	if granularity == metricsrollup.GranularityDAY {
+		return 24 * time.Hour
+	}
+	return time.Hour
+}
+
+// Add the samples of an invocation to the rollups, or subtract them with a negative sign.
+func apply(ctx context.Context, client *ent.Client, invocationID int, sign int64) error {
+	invocation, err := client.BazelInvocation.Query().
+		Where(bazelinvocation.ID(invocationID)).
+		WithMetrics(WithMetricsEdges).
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("could not fetch the metrics of invocation %d: %w", invocationID, err)
+	}
+	for k, value := range samples(invocation) {
+		if err = applySample(ctx, client, k, value, sign); err != nil {
+			return fmt.Errorf("could not roll up %s of invocation %d: %w", k.metric, invocationID, err)
+		}
+	}
+	return nil
+}
+
+// Add a sample to a rollup, creating it empty if needed, or subtract it with a negative sign. The rollup is updated only if
+// no other sample was applied since it was read, and read again otherwise.
+func applySample(ctx context.Context, client *ent.Client, k key, value sample, sign int64) error {
+	for range maxUpdateAttempts {
+		rollup, err := client.MetricsRollup.Query().Where(keyPredicates(k)...).Only(ctx)
+		if ent.IsNotFound(err) {
+			if sign < 0 {
+				// Never rolled up.
+				return nil
+			}
+			// Then updated like any other, unless created concurrently by another invocation.
+			if err = createEmptyRollup(ctx, client, k, value.ratio()); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		histogram := Histogram{}
+		histogram.Merge(rollup.Histogram)
+		histogram.Add(value.ratio(), sign)
+		update := client.MetricsRollup.Update().
+			Where(metricsrollup.ID(rollup.ID), metricsrollup.Revision(rollup.Revision)).
+			AddRevision(1).
+			AddInvocations(sign).
+			AddNumerator(float64(sign) * value.numerator).
+			AddDenominator(float64(sign) * value.denominator).
+			SetHistogram(histogram)
+		if sign > 0 {
+			update = update.
+				SetMin(math.Min(rollup.Min, value.ratio())).
+				SetMax(math.Max(rollup.Max, value.ratio()))
+		}
+		updated, err := update.Save(ctx)
+		if err != nil || updated == 1 {
+			return err
+		}
+	}
+	return fmt.Errorf("%w after %d attempts", errConcurrentUpdates, maxUpdateAttempts)
+}
+
+// Aggregate the samples of all the invocations in memory and save them as new rollups.
+func backfill(ctx context.Context, client *ent.Client) (int, error) {
+	if _, err := client.MetricsRollup.Delete().Exec(ctx); err != nil {
+		return 0, fmt.Errorf("could not delete metrics rollups: %w", err)
+	}
+	aggregates := map[key]*aggregate{}
+	count := 0
+	lastID := 0
+	for {
+		invocations, err := client.BazelInvocation.Query().
+			Where(bazelinvocation.IDGT(lastID)).
+			Order(ent.Asc(bazelinvocation.FieldID)).
+			Limit(backfillBatchSize).
+			WithMetrics(WithMetricsEdges).
+			All(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("could not fetch the metrics of invocations: %w", err)
+		}
+		if len(invocations) == 0 {
+			break
+		}
+		for _, invocation := range invocations {
+			for k, value := range samples(invocation) {
+				a, ok := aggregates[k]
+				if !ok {
+					a = newAggregate()
+					aggregates[k] = a
+				}
+				a.add(value)
+			}
+		}
+		count += len(invocations)
+		lastID = invocations[len(invocations)-1].ID
+	}
+
+	keys := make([]key, 0, len(aggregates))
+	for k := range aggregates {
+		keys = append(keys, k)
+	}
+	for start := 0; start < len(keys); start += backfillBatchSize {
+		batch := keys[start:min(start+backfillBatchSize, len(keys))]
+		err := client.MetricsRollup.MapCreateBulk(batch, func(create *ent.MetricsRollupCreate, i int) {
+			setRollup(create, batch[i], aggregates[batch[i]])
+		}).Exec(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("could not save metrics rollups: %w", err)
+		}
+	}
+	return count, nil
+}
+
+// A sample of a metric of an invocation.
+type sample struct {
+	numerator, denominator float64
+}
+
+// The value of a sample.
+func (s sample) ratio() float64 {
+	return s.numerator / s.denominator
+}
+
+// The samples of an invocation, keyed by the rollups they go to.
+func samples(invocation *ent.BazelInvocation) map[key]sample {
+	if invocation.Edges.Metrics == nil {
+		return nil
+	}
+	result := map[key]sample{}
+	for _, metric := range AllMetrics() {
+		numerator, denominator, ok := Sample(metric, invocation.Edges.Metrics)
+		if !ok || denominator == 0 {
+			continue
+		}
+		for _, granularity := range []metricsrollup.Granularity{metricsrollup.GranularityHOUR, metricsrollup.GranularityDAY} {
+			k := key{
+				granularity: granularity,
+				bucketStart: BucketStart(invocation.StartedAt, granularity),
+				metric:      metric,
+				branch:      invocation.Branch,
+				command:     invocation.Summary.BazelCommandLine.Command,
+				stepLabel:   invocation.StepLabel,
+				userLdap:    invocation.UserLdap,
+				userEmail:   invocation.UserEmail,
+			}
+			result[k] = sample{numerator: numerator, denominator: denominator}
+		}
+	}
+	return result
+}
+
+// Create the rollup of a key without any invocation, unless it exists. Conflicts are resolved by the database, as a
+// failed statement would fail the transaction it runs in with PostgreSQL.
+func createEmptyRollup(ctx context.Context, client *ent.Client, k key, ratio float64) error {
+	a := newAggregate()
+	a.min, a.max = ratio, ratio
+	create := client.MetricsRollup.Create()
+	setRollup(create, k, a)
+	return create.
+		OnConflictColumns(
+			metricsrollup.FieldGranularity,
+			metricsrollup.FieldMetric,
+			metricsrollup.FieldBucketStart,
+			metricsrollup.FieldBranch,
+			metricsrollup.FieldCommand,
+			metricsrollup.FieldStepLabel,
+			metricsrollup.FieldUserLdap,
+			metricsrollup.FieldUserEmail,
+		).
+		Ignore().
+		Exec(ctx)
+}
+
+// Set the fields of a rollup to be created.
+func setRollup(create *ent.MetricsRollupCreate, k key, a *aggregate) {
+	create.
+		SetGranularity(k.granularity).
+		SetBucketStart(k.bucketStart).
+		SetMetric(string(k.metric)).
+		SetBranch(k.branch).
+		SetCommand(k.command).
+		SetStepLabel(k.stepLabel).
+		SetUserLdap(k.userLdap).
+		SetUserEmail(k.userEmail).
+		SetInvocations(a.invocations).
+		SetNumerator(a.numerator).
+		SetDenominator(a.denominator).
+		SetMin(a.min).
+		SetMax(a.max).
+		SetHistogram(a.histogram)
+}
+
+// The predicates selecting the rollup of a key.
+func keyPredicates(k key) []predicate.MetricsRollup {
+	return []predicate.MetricsRollup{
+		metricsrollup.GranularityEQ(k.granularity),
+		metricsrollup.Metric(string(k.metric)),
+		metricsrollup.BucketStart(k.bucketStart),
+		metricsrollup.Branch(k.branch),
+		metricsrollup.Command(k.command),
+		metricsrollup.StepLabel(k.stepLabel),
+		metricsrollup.UserLdap(k.userLdap),
+		metricsrollup.UserEmail(k.userEmail),
+	}
+}
+
+// The predicates selecting the rollups matching a filter.
+func filterPredicates(filter Filter) []predicate.MetricsRollup {
+	var predicates []predicate.MetricsRollup
+	if filter.Branch != nil {
+		predicates = append(predicates, metricsrollup.Branch(*filter.Branch))
+	}
+	if filter.Command != nil {
+		predicates = append(predicates, metricsrollup.Command(*filter.Command))
+	}
+	if filter.StepLabel != nil {
+		predicates = append(predicates, metricsrollup.StepLabel(*filter.StepLabel))
+	}
+	if filter.User != nil {
+		predicates = append(predicates, metricsrollup.Or(
+			metricsrollup.UserLdap(*filter.User),
+			metricsrollup.UserEmail(*filter.User),
+		))
+	}
+	return predicates
+}
+
+// An empty aggregate.
+func newAggregate() *aggregate {
+	return &aggregate{min: math.Inf(1), max: math.Inf(-1), histogram: Histogram{}}
+}
+
+// Add the sample of an invocation.
+func (a *aggregate) add(value sample) {
+	a.invocations++
+	a.numerator += value.numerator
+	a.denominator += value.denominator
+	a.min = math.Min(a.min, value.ratio())
+	a.max = math.Max(a.max, value.ratio())
+	a.histogram.Add(value.ratio(), 1)
+}
+
+// Add a rollup.
+func (a *aggregate) merge(rollup *ent.MetricsRollup) {
+	a.invocations += rollup.Invocations
+	a.numerator += rollup.Numerator
+	a.denominator += rollup.Denominator
+	a.min = math.Min(a.min, rollup.Min)
+	a.max = math.Max(a.max, rollup.Max)
+	a.histogram.Merge(rollup.Histogram)
+}
+
+// The point of a bucket.
+func (a *aggregate) point(bucketStart time.Time) Point {
+	point := Point{
+		Time:        bucketStart,
+		Invocations: a.invocations,
+		Value:       a.numerator / a.denominator,
+		Min:         a.min,
+		Max:         a.max,
+	}
+	point.P50, _ = a.histogram.Quantile(0.5)
+	point.P90, _ = a.histogram.Quantile(0.9)
+	point.P99, _ = a.histogram.Quantile(0.99)
+	return point
+}
@@ -0,0 +1,188 @@
+package rollup_test
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/enttest"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/metricsrollup"
+	"github.com/buildbarn/bb-portal/pkg/processing"
+	"github.com/buildbarn/bb-portal/pkg/rollup"
+	"github.com/buildbarn/bb-portal/pkg/summary"
+)
+
+func TestHistogram_Quantile(t *testing.T) {
+	histogram := rollup.Histogram{}
+	_, ok := histogram.Quantile(0.5)
+	require.False(t, ok)
+
+	for value := 1; value <= 100; value++ {
+		histogram.Add(float64(value), 1)
+	}
+	histogram.Add(0, 1)
+	p50, ok := histogram.Quantile(0.5)
+	require.True(t, ok)
+	require.InEpsilon(t, 50, p50, 0.1)
+	p99, ok := histogram.Quantile(0.99)
+	require.True(t, ok)
+	require.InEpsilon(t, 99, p99, 0.1)
+	p0, ok := histogram.Quantile(0)
+	require.True(t, ok)
+	require.Zero(t, p0)
+
+	other := rollup.Histogram{}
+	other.Merge(histogram)
+	for value := 1; value <= 100; value++ {
+		other.Add(float64(value), -1)
+	}
+	other.Add(0, -1)
+	require.Empty(t, other)
+}
+
+// The fields of a rollup but its ID.
+type rollupRow struct {
+	Granularity                                     metricsrollup.Granularity
+	BucketStart                                     time.Time
+	Metric, Branch, Command, StepLabel, Ldap, Email string
+	Invocations                                     int64
+	Numerator, Denominator, Min, Max                float64
+	Histogram                                       map[int]int64
+}
+
+// The rollups, in a stable order.
+func rollupRows(ctx context.Context, t *testing.T, client *ent.Client) []rollupRow {
+	var rows []rollupRow
+	for _, r := range client.MetricsRollup.Query().Where(metricsrollup.InvocationsGT(0)).AllX(ctx) {
+		rows = append(rows, rollupRow{
+			Granularity: r.Granularity,
+			BucketStart: r.BucketStart.UTC(),
+			Metric:      r.Metric,
+			Branch:      r.Branch,
+			Command:     r.Command,
+			StepLabel:   r.StepLabel,
+			Ldap:        r.UserLdap,
+			Email:       r.UserEmail,
+			Invocations: r.Invocations,
+			Numerator:   r.Numerator,
+			Denominator: r.Denominator,
+			Min:         r.Min,
+			Max:         r.Max,
+			Histogram:   r.Histogram,
+		})
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		if a.Granularity != b.Granularity {
+			return a.Granularity < b.Granularity
+		}
+		if a.Metric != b.Metric {
+			return a.Metric < b.Metric
+		}
+		return a.BucketStart.Before(b.BucketStart)
+	})
+	require.NotEmpty(t, rows)
+	return rows
+}
+
+func TestRollup_IncrementalMatchesBackfill(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:rollup_incremental?mode=memory&_fk=1")
+	defer func() {
+		require.NoError(t, client.Close())
+	}()
+	ctx := context.Background()
+
+	worker := processing.New(client, nil, nil, summary.DefaultTestHealthThresholds())
+	var invocation *ent.BazelInvocation
+	for _, name := range []string{"nextjs_build.bep.ndjson", "nextjs_test.bep.ndjson", "nextjs_test_fail.bep.ndjson"} {
+		var err error
+		invocation, err = worker.ProcessFile(ctx, filepath.Join("../summary/testdata", name))
+		require.NoError(t, err)
+	}
+	_, err := worker.Resummarize(ctx, invocation.ID)
+	require.NoError(t, err)
+	incremental := rollupRows(ctx, t, client)
+
+	count, err := rollup.Backfill(ctx, client)
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+	require.Equal(t, incremental, rollupRows(ctx, t, client))
+}
+
+func TestRollup_Trends(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:rollup_trends?mode=memory&_fk=1")
+	defer func() {
+		require.NoError(t, client.Close())
+	}()
+	ctx := context.Background()
+
+	invocation, err := processing.New(client, nil, nil, summary.DefaultTestHealthThresholds()).ProcessFile(ctx, filepath.Join("../summary/testdata", "nextjs_test.bep.ndjson"))
+	require.NoError(t, err)
+	from := rollup.BucketStart(invocation.StartedAt, metricsrollup.GranularityDAY)
+	to := from.Add(24 * time.Hour)
+	trend := func(filter rollup.Filter) rollup.Trend {
+		trends, err := rollup.Trends(ctx, client, []rollup.Metric{rollup.MetricActionsExecuted}, from, to, metricsrollup.GranularityDAY, filter)
+		require.NoError(t, err)
+		require.Len(t, trends, 1)
+		return trends[0]
+	}
+
+	points := trend(rollup.Filter{}).Points
+	require.Len(t, points, 1)
+	require.Equal(t, from, points[0].Time)
+	require.Equal(t, int64(1), points[0].Invocations)
+	require.Equal(t, points[0].Min, points[0].Value)
+	require.Equal(t, points[0].Max, points[0].Value)
+
+	command := "test"
+	require.Len(t, trend(rollup.Filter{Command: &command}).Points, 1)
+	command = "build"
+	require.Empty(t, trend(rollup.Filter{Command: &command}).Points)
+
+	_, err = rollup.Trends(ctx, client, rollup.AllMetrics(), from, from.AddDate(1, 0, 0), metricsrollup.GranularityHOUR, rollup.Filter{})
+	require.Error(t, err)
+}
+
+func TestRollup_ConcurrentAdd(t *testing.T) {
+	dataSourceName := "file:" + filepath.Join(t.TempDir(), "rollup.db") + "?_fk=1&_journal=WAL&_busy_timeout=10000"
+	client := enttest.Open(t, "sqlite3", dataSourceName)
+	defer func() {
+		require.NoError(t, client.Close())
+	}()
+	ctx := context.Background()
+
+	invocation, err := processing.New(client, nil, nil, summary.DefaultTestHealthThresholds()).ProcessFile(ctx, filepath.Join("../summary/testdata", "nextjs_test.bep.ndjson"))
+	require.NoError(t, err)
+
+	// No sample is lost when the same rollups are updated at once.
+	const concurrentAdds = 8
+	var wg sync.WaitGroup
+	errs := make(chan error, concurrentAdds)
+	for range concurrentAdds {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- rollup.Add(ctx, client, invocation.ID)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	for _, row := range rollupRows(ctx, t, client) {
+		require.Equal(t, int64(1+concurrentAdds), row.Invocations, row.Metric)
+		var histogramCount int64
+		for _, count := range row.Histogram {
+			histogramCount += count
+		}
+		require.Equal(t, int64(1+concurrentAdds), histogramCount, row.Metric)
+	}
+}
@@ -0,0 +1,4 @@
+// Package search makes the build logs, the output of progress problems and the archived text blobs searchable with
+// the full-text index of the database, FTS5 (or FTS4) with SQLite and a tsvector with PostgreSQL, and finds the
+// invocations with matching lines.
+package search
@@ -0,0 +1,102 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocationproblem"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/searchchunk"
+	"github.com/buildbarn/bb-portal/pkg/events"
+	"github.com/buildbarn/bb-portal/pkg/summary/detectors"
+)
+
+// MaxBlobBytes The head of a blob which is searchable, the rest is not indexed.
+const MaxBlobBytes = 4 << 20
+
+// createBatchSize The number of chunks saved at once.
+const createBatchSize = 100
+
+// IndexInvocation Makes the build logs and the output of the progress problems of a saved invocation searchable.
+func IndexInvocation(ctx context.Context, client *ent.Client, invocationID int) error {
+	invocation, err := client.BazelInvocation.Query().
+		Where(bazelinvocation.ID(invocationID)).
+		WithProblems(func(query *ent.BazelInvocationProblemQuery) {
+			query.Where(bazelinvocationproblem.ProblemType(detectors.BazelInvocationProblemErrorProgress))
+		}).
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("could not fetch invocation %d: %w", invocationID, err)
+	}
+
+	var creates []*ent.SearchChunkCreate
+	for _, c := range splitChunks(invocation.BuildLogs) {
+		creates = append(creates, newChunk(client, searchchunk.SourceBUILD_LOGS, c).SetBazelInvocationID(invocationID))
+	}
+	for _, problem := range invocation.Edges.Problems {
+		output, err := progressOutput(problem)
+		if err != nil {
+			return err
+		}
+		for _, c := range splitChunks(output) {
+			creates = append(creates, newChunk(client, searchchunk.SourcePROBLEM_OUTPUT, c).
+				SetBazelInvocationID(invocationID).
+				SetProblemID(problem.ID))
+		}
+	}
+	return saveChunks(ctx, client, creates)
+}
+
+// IndexBlob Makes the head of an archived blob searchable if it is text, replacing what was indexed before.
+func IndexBlob(ctx context.Context, client *ent.Client, blobID int, r io.Reader) error {
+	if _, err := client.SearchChunk.Delete().Where(searchchunk.HasBlobWith(blob.ID(blobID))).Exec(ctx); err != nil {
+		return fmt.Errorf("could not delete the search chunks of blob %d: %w", blobID, err)
+	}
+	head, err := io.ReadAll(io.LimitReader(r, MaxBlobBytes))
+	if err != nil {
+		return fmt.Errorf("could not read blob %d: %w", blobID, err)
+	}
+	if !isText(head) {
+		return nil
+	}
+	var creates []*ent.SearchChunkCreate
+	for _, c := range splitChunks(strings.ToValidUTF8(string(head), "")) {
+		creates = append(creates, newChunk(client, searchchunk.SourceBLOB, c).SetBlobID(blobID))
+	}
+	return saveChunks(ctx, client, creates)
+}
+
+// A chunk to be created.
+func newChunk(client *ent.Client, source searchchunk.Source, c chunk) *ent.SearchChunkCreate {
+	return client.SearchChunk.Create().
+		SetSource(source).
+		SetFirstLine(c.firstLine).
+		SetContent(c.content)
+}
+
+// Save chunks in batches.
+func saveChunks(ctx context.Context, client *ent.Client, creates []*ent.SearchChunkCreate) error {
+	for start := 0; start < len(creates); start += createBatchSize {
+		if err := client.SearchChunk.CreateBulk(creates[start:min(start+createBatchSize, len(creates))]...).Exec(ctx); err != nil {
+			return fmt.Errorf("could not save search chunks: %w", err)
+		}
+	}
+	return nil
+}
+
+// The standard error of a progress problem, as shown for it.
+func progressOutput(problem *ent.BazelInvocationProblem) (string, error) {
+	bepEvents, err := events.FromJSONArray(problem.BepEvents)
+	if err != nil {
+		return "", fmt.Errorf("could not parse the events of problem %d: %w", problem.ID, err)
+	}
+	var output strings.Builder
+	for _, event := range bepEvents {
+		output.WriteString(event.GetProgress().GetStderr())
+	}
+	return output.String(), nil
+}
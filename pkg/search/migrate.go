@@ -0,0 +1,122 @@
+package search
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/searchchunk"
+)
+
+// indexTable The virtual table of the full-text index of SQLite.
+const indexTable = "search_chunk_index"
+
+// vectorColumn The column of the full-text index of PostgreSQL.
+const vectorColumn = "search_vector"
+
+// errUnsupportedDialect An error helper.
+var errUnsupportedDialect = errors.New("full-text search is not supported with this database")
+
+// Migrate Creates the full-text index of the search chunks, after the schema migration of ent. With SQLite, the index
+// is a FTS5 table, or a FTS4 one when SQLite was built without FTS5 (with go-sqlite3, unless the sqlite_fts5 build tag
+// is set), kept up to date by triggers. With PostgreSQL, it is a generated tsvector column with a GIN index.
+func Migrate(ctx context.Context, client *ent.Client) error {
+	var statements []string
+	switch client.Dialect() {
+	case dialect.SQLite:
+		module, created, err := createSQLiteIndex(ctx, client)
+		if err != nil {
+			return err
+		}
+		statements = sqliteTriggers(module)
+		if created {
+			// Index the chunks saved before.
+			statements = append(statements, fmt.Sprintf("INSERT INTO %[1]s(%[1]s) VALUES('rebuild')", indexTable))
+		}
+	case dialect.Postgres:
+		statements = []string{
+			fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS %s tsvector GENERATED ALWAYS AS (to_tsvector('simple', %s)) STORED",
+				searchchunk.Table, vectorColumn, searchchunk.FieldContent),
+			fmt.Sprintf("CREATE INDEX IF NOT EXISTS %[1]s_%[2]s ON %[1]s USING GIN (%[2]s)", searchchunk.Table, vectorColumn),
+		}
+	default:
+		return fmt.Errorf("%w: %s", errUnsupportedDialect, client.Dialect())
+	}
+	for _, statement := range statements {
+		if _, err := client.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("could not migrate the search index: %w", err)
+		}
+	}
+	return nil
+}
+
+// Create the virtual table of the index unless it exists, returning its module and whether it was created.
+func createSQLiteIndex(ctx context.Context, client *ent.Client) (string, bool, error) {
+	definition, err := sqliteIndexDefinition(ctx, client)
+	if err != nil {
+		return "", false, fmt.Errorf("could not look for the search index: %w", err)
+	}
+	if definition.Valid {
+		if strings.Contains(strings.ToLower(definition.String), "fts5") {
+			return "fts5", false, nil
+		}
+		return "fts4", false, nil
+	}
+
+	_, err = client.ExecContext(ctx, fmt.Sprintf(
+		"CREATE VIRTUAL TABLE %s USING fts5(%s, content='%s', content_rowid='%s', tokenize='unicode61')",
+		indexTable, searchchunk.FieldContent, searchchunk.Table, searchchunk.FieldID))
+	if err == nil {
+		return "fts5", true, nil
+	}
+	if !strings.Contains(err.Error(), "no such module: fts5") {
+		return "", false, fmt.Errorf("could not create the search index: %w", err)
+	}
+	_, err = client.ExecContext(ctx, fmt.Sprintf(
+		"CREATE VIRTUAL TABLE %s USING fts4(%s, content='%s', tokenize=unicode61)",
+		indexTable, searchchunk.FieldContent, searchchunk.Table))
+	if err != nil {
+		return "", false, fmt.Errorf("could not create the search index: %w", err)
+	}
+	return "fts4", true, nil
+}
+
+// The triggers keeping the index of a module in sync with the search chunks, which are never updated.
+func sqliteTriggers(module string) []string {
+	insert := fmt.Sprintf(
+		"CREATE TRIGGER IF NOT EXISTS %[1]s_insert AFTER INSERT ON %[2]s BEGIN "+
+			"INSERT INTO %[1]s(rowid, %[3]s) VALUES (new.%[4]s, new.%[3]s); END",
+		indexTable, searchchunk.Table, searchchunk.FieldContent, searchchunk.FieldID)
+	if module == "fts5" {
+		return []string{insert, fmt.Sprintf(
+			"CREATE TRIGGER IF NOT EXISTS %[1]s_delete AFTER DELETE ON %[2]s BEGIN "+
+				"INSERT INTO %[1]s(%[1]s, rowid, %[3]s) VALUES ('delete', old.%[4]s, old.%[3]s); END",
+			indexTable, searchchunk.Table, searchchunk.FieldContent, searchchunk.FieldID)}
+	}
+	return []string{insert, fmt.Sprintf(
+		"CREATE TRIGGER IF NOT EXISTS %[1]s_delete BEFORE DELETE ON %[2]s BEGIN "+
+			"DELETE FROM %[1]s WHERE docid = old.%[3]s; END",
+		indexTable, searchchunk.Table, searchchunk.FieldID)}
+}
+
+// The statement which created the virtual table of the index, if it exists.
+func sqliteIndexDefinition(ctx context.Context, client *ent.Client) (sql.NullString, error) {
+	var definition sql.NullString
+	rows, err := client.QueryContext(ctx, "SELECT sql FROM sqlite_master WHERE type = 'table' AND name = ?", indexTable)
+	if err != nil {
+		return definition, err
+	}
+	// Closed before running other statements, which could otherwise run on another connection.
+	defer rows.Close()
+	if rows.Next() {
+		if err = rows.Scan(&definition); err != nil {
+			return definition, err
+		}
+	}
+	return definition, rows.Err()
+}
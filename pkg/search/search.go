@@ -0,0 +1,220 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocation"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/bazelinvocationproblem"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/predicate"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/searchchunk"
+)
+
+// DefaultLimit The number of invocations found by default.
+const DefaultLimit = 20
+
+// MaxLimit The most invocations found at once.
+const MaxLimit = 100
+
+// maxChunks The most matching chunks read by a search.
+const maxChunks = 1000
+
+// maxMatches The most matching lines returned per invocation and source.
+const maxMatches = 10
+
+// Query A full-text search.
+type Query struct {
+	// Text is the words to find, all of them, where the words between double quotes are a phrase.
+	Text string
+	// From and To bound when the invocations started, when given.
+	From, To *time.Time
+	// Sources are where to search, everywhere if empty.
+	Sources []searchchunk.Source
+	// Limit is the most invocations found, DefaultLimit when 0.
+	Limit int
+}
+
+// Match A line matching a search.
+type Match struct {
+	Source searchchunk.Source
+	// ProblemID is the progress problem of the PROBLEM_OUTPUT matches.
+	ProblemID int
+	// BlobID is the blob of the BLOB matches.
+	BlobID int
+	// Line is the number of the line in its source, from 1.
+	Line int
+	// Snippet is the line, escaped for HTML with the matches between <mark> and </mark>, shortened around the first
+	// match.
+	Snippet string
+}
+
+// Result An invocation found by a search, with its matching lines.
+type Result struct {
+	InvocationID int
+	Matches      []Match
+}
+
+// Search Finds the invocations with all the words of a query in their build logs, the output of their progress
+// problems or their archived text blobs, the most recently indexed first.
+func Search(ctx context.Context, client *ent.Client, query Query) ([]Result, error) {
+	terms, err := parseQuery(query.Text)
+	if err != nil {
+		return nil, err
+	}
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	limit = min(limit, MaxLimit)
+
+	var invocationPredicates []predicate.BazelInvocation
+	if query.From != nil {
+		invocationPredicates = append(invocationPredicates, bazelinvocation.StartedAtGTE(*query.From))
+	}
+	if query.To != nil {
+		invocationPredicates = append(invocationPredicates, bazelinvocation.StartedAtLT(*query.To))
+	}
+	chunkQuery := client.SearchChunk.Query().
+		Where(
+			matches(terms),
+			searchchunk.Or(
+				searchchunk.HasBazelInvocationWith(invocationPredicates...),
+				searchchunk.HasBlobWith(blob.HasProblemsWith(
+					bazelinvocationproblem.HasBazelInvocationWith(invocationPredicates...),
+				)),
+			),
+		)
+	if len(query.Sources) > 0 {
+		chunkQuery = chunkQuery.Where(searchchunk.SourceIn(query.Sources...))
+	}
+	chunks, err := chunkQuery.
+		WithBazelInvocation(func(q *ent.BazelInvocationQuery) { q.Select(bazelinvocation.FieldID) }).
+		WithProblem(func(q *ent.BazelInvocationProblemQuery) { q.Select(bazelinvocationproblem.FieldID) }).
+		WithBlob(func(q *ent.BlobQuery) { q.Select(blob.FieldID) }).
+		Order(ent.Desc(searchchunk.FieldID)).
+		Limit(maxChunks).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not search: %w", err)
+	}
+
+	var results []*Result
+	resultsByInvocation := map[int]*Result{}
+	blobInvocations := map[int][]int{}
+	for _, c := range chunks {
+		var invocationIDs []int
+		match := Match{Source: c.Source}
+		switch {
+		case c.Edges.BazelInvocation != nil:
+			invocationIDs = []int{c.Edges.BazelInvocation.ID}
+			if c.Edges.Problem != nil {
+				match.ProblemID = c.Edges.Problem.ID
+			}
+		case c.Edges.Blob != nil:
+			match.BlobID = c.Edges.Blob.ID
+			ids, ok := blobInvocations[match.BlobID]
+			if !ok {
+				ids, err = client.BazelInvocation.Query().
+					Where(invocationPredicates...).
+					Where(bazelinvocation.HasProblemsWith(bazelinvocationproblem.HasBlobsWith(blob.ID(match.BlobID)))).
+					Order(ent.Desc(bazelinvocation.FieldID)).
+					IDs(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("could not fetch the invocations of blob %d: %w", match.BlobID, err)
+				}
+				blobInvocations[match.BlobID] = ids
+			}
+			invocationIDs = ids
+		}
+		for _, invocationID := range invocationIDs {
+			result, ok := resultsByInvocation[invocationID]
+			if !ok {
+				if len(results) == limit {
+					continue
+				}
+				result = &Result{InvocationID: invocationID}
+				resultsByInvocation[invocationID] = result
+				results = append(results, result)
+			}
+			for _, line := range matchingLines(c, terms) {
+				match.Line, match.Snippet = line.number, line.snippet
+				result.Matches = append(result.Matches, match)
+			}
+		}
+	}
+
+	found := make([]Result, 0, len(results))
+	for _, result := range results {
+		sort.SliceStable(result.Matches, func(i, j int) bool {
+			a, b := result.Matches[i], result.Matches[j]
+			if a.Source != b.Source {
+				return a.Source < b.Source
+			}
+			if a.ProblemID != b.ProblemID {
+				return a.ProblemID < b.ProblemID
+			}
+			if a.BlobID != b.BlobID {
+				return a.BlobID < b.BlobID
+			}
+			return a.Line < b.Line
+		})
+		perSource := map[searchchunk.Source]int{}
+		kept := result.Matches[:0]
+		for _, match := range result.Matches {
+			if perSource[match.Source] < maxMatches {
+				perSource[match.Source]++
+				kept = append(kept, match)
+			}
+		}
+		result.Matches = kept
+		found = append(found, *result)
+	}
+	return found, nil
+}
+
+// The predicate selecting the chunks with all the terms in the full-text index.
+func matches(terms [][]string) predicate.SearchChunk {
+	return func(s *sql.Selector) {
+		if s.Dialect() == dialect.Postgres {
+			s.Where(sql.P(func(b *sql.Builder) {
+				b.WriteString(s.C(vectorColumn)).WriteString(" @@ to_tsquery('simple', ").Arg(postgresQuery(terms)).WriteString(")")
+			}))
+			return
+		}
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString(s.C(searchchunk.FieldID)).
+				WriteString(fmt.Sprintf(" IN (SELECT rowid FROM %[1]s WHERE %[1]s MATCH ", indexTable)).
+				Arg(sqliteMatch(terms)).
+				WriteString(")")
+		}))
+	}
+}
+
+// A line of a chunk and its snippet.
+type matchingLine struct {
+	number  int
+	snippet string
+}
+
+// The lines of a chunk with a term, its first line when the terms span lines.
+func matchingLines(c *ent.SearchChunk, terms [][]string) []matchingLine {
+	lines := strings.Split(c.Content, "\n")
+	var found []matchingLine
+	for i, line := range lines {
+		if highlights := findTerms(line, terms); len(highlights) > 0 {
+			found = append(found, matchingLine{number: c.FirstLine + i, snippet: snippet(line, highlights)})
+		}
+	}
+	if len(found) == 0 {
+		found = append(found, matchingLine{number: c.FirstLine, snippet: snippet(lines[0], nil)})
+	}
+	return found
+}
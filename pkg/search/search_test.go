@@ -0,0 +1,125 @@
+package search_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildbarn/bb-portal/ent/gen/ent/blob"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/enttest"
+	"github.com/buildbarn/bb-portal/ent/gen/ent/searchchunk"
+	"github.com/buildbarn/bb-portal/pkg/processing"
+	"github.com/buildbarn/bb-portal/pkg/search"
+	"github.com/buildbarn/bb-portal/pkg/summary"
+)
+
+func TestSearch(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:search?mode=memory&_fk=1")
+	defer func() {
+		require.NoError(t, client.Close())
+	}()
+	ctx := context.Background()
+	require.NoError(t, search.Migrate(ctx, client))
+
+	worker := processing.New(client, nil, nil, summary.DefaultTestHealthThresholds())
+	failed, err := worker.ProcessFile(ctx, filepath.Join("../summary/testdata", "nextjs_error_progress.bep.ndjson"))
+	require.NoError(t, err)
+	passed, err := worker.ProcessFile(ctx, filepath.Join("../summary/testdata", "nextjs_build.bep.ndjson"))
+	require.NoError(t, err)
+	// Chunks saved before the index is created are indexed by the migration, which can run again.
+	require.NoError(t, search.Migrate(ctx, client))
+
+	results, err := search.Search(ctx, client, search.Query{Text: `"No such package" DIRECTORIES`})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, failed.ID, results[0].InvocationID)
+	sources := map[searchchunk.Source]bool{}
+	buildLogs := strings.Split(failed.BuildLogs, "\n")
+	for _, match := range results[0].Matches {
+		require.Contains(t, match.Snippet, "<mark>")
+		if !strings.Contains(match.Snippet, "<mark>no such package</mark>") {
+			continue
+		}
+		sources[match.Source] = true
+		if match.Source == searchchunk.SourceBUILD_LOGS {
+			require.Contains(t, buildLogs[match.Line-1], "no such package")
+		} else {
+			require.NotZero(t, match.ProblemID)
+		}
+	}
+	require.Equal(t, map[searchchunk.Source]bool{searchchunk.SourceBUILD_LOGS: true, searchchunk.SourcePROBLEM_OUTPUT: true}, sources)
+
+	results, err = search.Search(ctx, client, search.Query{
+		Text:    "package",
+		Sources: []searchchunk.Source{searchchunk.SourcePROBLEM_OUTPUT},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	for _, match := range results[0].Matches {
+		require.Equal(t, searchchunk.SourcePROBLEM_OUTPUT, match.Source)
+	}
+
+	results, err = search.Search(ctx, client, search.Query{Text: `"packages loaded" targets`})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, passed.ID, results[0].InvocationID)
+
+	later := passed.StartedAt.Add(time.Hour)
+	results, err = search.Search(ctx, client, search.Query{Text: `"packages loaded" targets`, From: &later})
+	require.NoError(t, err)
+	require.Empty(t, results)
+
+	results, err = search.Search(ctx, client, search.Query{Text: `"package such no"`})
+	require.NoError(t, err)
+	require.Empty(t, results)
+
+	_, err = search.Search(ctx, client, search.Query{Text: ` "" -- `})
+	require.Error(t, err)
+}
+
+func TestIndexBlob(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:search_blob?mode=memory&_fk=1")
+	defer func() {
+		require.NoError(t, client.Close())
+	}()
+	ctx := context.Background()
+	require.NoError(t, search.Migrate(ctx, client))
+
+	invocation, err := processing.New(client, nil, nil, summary.DefaultTestHealthThresholds()).ProcessFile(ctx, filepath.Join("../summary/testdata", "nextjs_test_fail.bep.ndjson"))
+	require.NoError(t, err)
+	b := client.Blob.Query().FirstX(ctx)
+
+	require.NoError(t, search.IndexBlob(ctx, client, b.ID, strings.NewReader("Starting\n\x1b[31mConnection reset by peer\x1b[0m\n")))
+	results, err := search.Search(ctx, client, search.Query{Text: `"connection reset by peer"`})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, invocation.ID, results[0].InvocationID)
+	require.Equal(t, []search.Match{{
+		Source:  searchchunk.SourceBLOB,
+		BlobID:  b.ID,
+		Line:    2,
+		Snippet: "<mark>Connection reset by peer</mark>",
+	}}, results[0].Matches)
+
+	// Binary blobs are not searchable.
+	require.NoError(t, search.IndexBlob(ctx, client, b.ID, bytes.NewReader([]byte{0x7f, 'E', 'L', 'F', 0, 1, 2})))
+	results, err = search.Search(ctx, client, search.Query{Text: `"connection reset by peer"`})
+	require.NoError(t, err)
+	require.Empty(t, results)
+
+	// Deleted with the invocation and the blobs it referenced.
+	require.NoError(t, search.IndexBlob(ctx, client, b.ID, strings.NewReader("Connection reset by peer\n")))
+	client.Blob.Update().SetArchivingStatus(blob.ArchivingStatusFAILED).ExecX(ctx)
+	_, err = processing.NewGarbageCollector(client, nil, processing.DefaultRetentionParams()).DeleteInvocations(ctx, []int{invocation.ID})
+	require.NoError(t, err)
+	require.Zero(t, client.SearchChunk.Query().CountX(ctx))
+	results, err = search.Search(ctx, client, search.Query{Text: "connection"})
+	require.NoError(t, err)
+	require.Empty(t, results)
+}
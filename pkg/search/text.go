@@ -0,0 +1,216 @@
+package search
+
+import (
+	"bytes"
+	"errors"
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// chunkLines The most lines in a chunk.
+const chunkLines = 64
+
+// chunkBytes The size over which a chunk ends early, unless it is a single line.
+const chunkBytes = 16 << 10
+
+// snippetBytes The most bytes of a line in a snippet.
+const snippetBytes = 240
+
+// snippetContextBytes The bytes of a line kept before the first match in a snippet.
+const snippetContextBytes = 80
+
+// errEmptyQuery An error helper.
+var errEmptyQuery = errors.New("the search query has no words")
+
+// Escape sequences setting the colors of terminal output, as in Bazel output with --color=yes.
+var ansiEscapePattern = regexp.MustCompile("\x1b\\[[0-9;?]*[ -/]*[@-~]")
+
+// A run of consecutive lines of a text.
+type chunk struct {
+	firstLine int
+	content   string
+}
+
+// A word of a text, lowercased, and where it is.
+type token struct {
+	text       string
+	start, end int
+}
+
+// A range of bytes of a line matching a term.
+type highlight struct {
+	start, end int
+}
+
+// Split a text in chunks of lines, numbered from 1, without the terminal escape sequences nor the blank chunks.
+func splitChunks(text string) []chunk {
+	lines := strings.Split(ansiEscapePattern.ReplaceAllString(text, ""), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	var chunks []chunk
+	for first := 0; first < len(lines); {
+		last, size := first+1, len(lines[first])
+		for last < len(lines) && last-first < chunkLines && size+len(lines[last]) <= chunkBytes {
+			size += len(lines[last]) + 1
+			last++
+		}
+		content := strings.Join(lines[first:last], "\n")
+		if strings.TrimSpace(content) != "" {
+			chunks = append(chunks, chunk{firstLine: first + 1, content: content})
+		}
+		first = last
+	}
+	return chunks
+}
+
+// Split a text in words, the runs of letters and numbers, like the unicode61 tokenizer of SQLite and the simple
+// configuration of PostgreSQL.
+func tokenize(text string) []token {
+	var tokens []token
+	start := -1
+	for i, r := range text {
+		isWord := unicode.IsLetter(r) || unicode.IsNumber(r)
+		switch {
+		case isWord && start < 0:
+			start = i
+		case !isWord && start >= 0:
+			tokens = append(tokens, token{text: strings.ToLower(text[start:i]), start: start, end: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, token{text: strings.ToLower(text[start:]), start: start, end: len(text)})
+	}
+	return tokens
+}
+
+// Parse a query into terms, the words of every phrase between double quotes or of every other word, which must all
+// be found.
+func parseQuery(query string) ([][]string, error) {
+	var terms [][]string
+	addTerm := func(text string) {
+		var words []string
+		for _, t := range tokenize(text) {
+			words = append(words, t.text)
+		}
+		if len(words) > 0 {
+			terms = append(terms, words)
+		}
+	}
+	for i, part := range strings.Split(query, `"`) {
+		if i%2 == 1 {
+			addTerm(part)
+			continue
+		}
+		for _, word := range strings.Fields(part) {
+			addTerm(word)
+		}
+	}
+	if len(terms) == 0 {
+		return nil, errEmptyQuery
+	}
+	return terms, nil
+}
+
+// The MATCH expression of SQLite finding all the terms, as phrases.
+func sqliteMatch(terms [][]string) string {
+	phrases := make([]string, 0, len(terms))
+	for _, words := range terms {
+		phrases = append(phrases, `"`+strings.Join(words, " ")+`"`)
+	}
+	return strings.Join(phrases, " ")
+}
+
+// The tsquery of PostgreSQL finding all the terms, as phrases.
+func postgresQuery(terms [][]string) string {
+	phrases := make([]string, 0, len(terms))
+	for _, words := range terms {
+		quoted := make([]string, 0, len(words))
+		for _, word := range words {
+			quoted = append(quoted, "'"+word+"'")
+		}
+		phrases = append(phrases, "("+strings.Join(quoted, " <-> ")+")")
+	}
+	return strings.Join(phrases, " & ")
+}
+
+// Find where the terms are in a line.
+func findTerms(line string, terms [][]string) []highlight {
+	tokens := tokenize(line)
+	var highlights []highlight
+	for i := range tokens {
+		for _, words := range terms {
+			if i+len(words) > len(tokens) {
+				continue
+			}
+			found := true
+			for j, word := range words {
+				if tokens[i+j].text != word {
+					found = false
+					break
+				}
+			}
+			if found {
+				highlights = append(highlights, highlight{start: tokens[i].start, end: tokens[i+len(words)-1].end})
+				break
+			}
+		}
+	}
+	return highlights
+}
+
+// A line escaped for HTML with the matches between <mark> and </mark>, shortened around the first match.
+func snippet(line string, highlights []highlight) string {
+	line = strings.TrimRight(line, "\r")
+	start := 0
+	if len(highlights) > 0 && highlights[0].start > snippetContextBytes {
+		start = highlights[0].start - snippetContextBytes
+	}
+	end := min(start+snippetBytes, len(line))
+	for start > 0 && !utf8.RuneStart(line[start]) {
+		start++
+	}
+	for end < len(line) && !utf8.RuneStart(line[end]) {
+		end--
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	position := start
+	for _, h := range highlights {
+		hStart, hEnd := max(h.start, position), min(h.end, end)
+		if hStart >= hEnd {
+			continue
+		}
+		b.WriteString(html.EscapeString(line[position:hStart]))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(line[hStart:hEnd]))
+		b.WriteString("</mark>")
+		position = hEnd
+	}
+	b.WriteString(html.EscapeString(line[position:end]))
+	if end < len(line) {
+		b.WriteString("…")
+	}
+	return b.String()
+}
+
+// Whether the head of a blob is text, valid UTF-8 without NUL bytes. The last rune may be cut by the head.
+func isText(head []byte) bool {
+	if len(head) == 0 || bytes.IndexByte(head, 0) >= 0 {
+		return false
+	}
+	for i := 0; i < utf8.UTFMax && len(head) > 0; i++ {
+		if utf8.Valid(head) {
+			return true
+		}
+		head = head[:len(head)-1]
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/bazelbuild/remote-apis-sdks/go/pkg/digest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/buildbarn/bb-portal/pkg/cas"
+)
+
+// CASStorage Stores blobs in a Buildbarn CAS. Keys must end with the digest of the blob, see KeyForDigest. The
+// CAS evicts blobs by itself, so they are never deleted from it.
+type CASStorage struct {
+	casManager  *cas.ConnectionManager
+	instanceURI *url.URL
+}
+
+// NewCASStorage Constructor for a CAS storage, instanceURI being of the form bytestream://host/instance.
+func NewCASStorage(casManager *cas.ConnectionManager, instanceURI *url.URL) *CASStorage {
+	return &CASStorage{casManager: casManager, instanceURI: instanceURI}
+}
+
+// KeyForDigest The key of a blob named after its digest, sharded in two levels of folders named after the start
+// of its hash to keep folders small.
+func KeyForDigest(d digest.Digest) string {
+	name := d.Hash + "-" + strconv.FormatInt(d.Size, 10)
+	if len(d.Hash) < 4 {
+		return name
+	}
+	return d.Hash[:2] + "/" + d.Hash[2:4] + "/" + name
+}
+
+// Put Streams the blob to the CAS.
+func (s *CASStorage) Put(ctx context.Context, key string, r io.Reader, _ int64) error {
+	d, err := digestFromKey(key)
+	if err != nil {
+		return err
+	}
+	casClient, err := s.casManager.GetClientForURI(ctx, s.instanceURI)
+	if err != nil {
+		return err
+	}
+	defer casClient.Close()
+	return casClient.WriteBlob(ctx, d, r)
+}
+
+// Get Opens the blob for ByteStream reads from the offsets being read. A missing blob is only reported once reading
+// starts.
+func (s *CASStorage) Get(ctx context.Context, key string) (io.ReadSeekCloser, error) {
+	d, err := digestFromKey(key)
+	if err != nil {
+		return nil, err
+	}
+	casClient, err := s.casManager.GetClientForURI(ctx, s.instanceURI)
+	if err != nil {
+		return nil, err
+	}
+	return &casBlob{BlobReader: casClient.NewBlobReader(ctx, d), key: key}, nil
+}
+
+// Exists Checks if the CAS has the blob.
+func (s *CASStorage) Exists(ctx context.Context, key string) (bool, error) {
+	d, err := digestFromKey(key)
+	if err != nil {
+		return false, err
+	}
+	casClient, err := s.casManager.GetClientForURI(ctx, s.instanceURI)
+	if err != nil {
+		return false, err
+	}
+	defer casClient.Close()
+	return casClient.HasBlob(ctx, d)
+}
+
+// Delete Does nothing, blobs are evicted by the CAS.
+func (s *CASStorage) Delete(_ context.Context, _ string) error {
+	return nil
+}
+
+// Get the digest a key ends with.
+func digestFromKey(key string) (digest.Digest, error) {
+	name := path.Base(key)
+	separator := strings.LastIndex(name, "-")
+	if separator < 0 {
+		return digest.Digest{}, fmt.Errorf("%w: %s is not named after a digest", errInvalidKey, key)
+	}
+	size, err := strconv.ParseInt(name[separator+1:], 10, 64)
+	if err != nil {
+		return digest.Digest{}, fmt.Errorf("%w: %s is not named after a digest", errInvalidKey, key)
+	}
+	d, err := digest.New(name[:separator], size)
+	if err != nil {
+		return digest.Digest{}, fmt.Errorf("%w: %s: %w", errInvalidKey, key, err)
+	}
+	return d, nil
+}
+
+// casBlob A blob read from the CAS, reporting a missing blob as ErrNotFound.
+type casBlob struct {
+	*cas.BlobReader
+	key string
+}
+
+// Read Reads from the current offset.
+func (b *casBlob) Read(p []byte) (int, error) {
+	n, err := b.BlobReader.Read(p)
+	return n, b.readErr(err)
+}
+
+// ReadAt Reads at an offset.
+func (b *casBlob) ReadAt(p []byte, offset int64) (int, error) {
+	n, err := b.BlobReader.ReadAt(p, offset)
+	return n, b.readErr(err)
+}
+
+// Report a read error, as ErrNotFound if the CAS does not have the blob.
+func (b *casBlob) readErr(err error) error {
+	if err != nil && status.Code(err) == codes.NotFound {
+		return fmt.Errorf("blob %s: %w", b.key, ErrNotFound)
+	}
+	return err
+}
@@ -0,0 +1,3 @@
+// Package storage provides the backends archived blobs are stored in: the local filesystem, an S3-compatible
+// object storage or a Buildbarn CAS.
+package storage
@@ -0,0 +1,332 @@
+package storage
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// errInvalidKey An error helper.
+var errInvalidKey = errors.New("invalid blob key")
+
+// errInvalidOffset An error helper.
+var errInvalidOffset = errors.New("invalid offset")
+
+// errSizeMismatch An error helper.
+var errSizeMismatch = errors.New("blob size mismatch")
+
+// ErrStorageFull is returned when writing a blob to a storage holding its maximum total size.
+var ErrStorageFull = errors.New("blob storage is full")
+
+const (
+	// folderPermission is the permission of the folders created in the local storage.
+	folderPermission = 0o750
+
+	// compressedSuffix is appended to the name of the files of compressed blobs.
+	compressedSuffix = ".gz"
+
+	// tmpPrefix starts the name of files being written.
+	tmpPrefix = ".tmp-"
+
+	// gzipSizeID1 and gzipSizeID2 identify the extra field of the gzip header recording the size of a blob.
+	gzipSizeID1 = 'B'
+	gzipSizeID2 = 'S'
+)
+
+// LocalStorageParams Parameters of a local storage.
+type LocalStorageParams struct {
+	// Folder the blobs are stored in.
+	Folder string
+
+	// Compress blobs at rest with gzip, they are transparently decompressed when read.
+	Compress bool
+
+	// MaxTotalSizeBytes stops blobs from being written once the files in the folder add up to it, 0 for no limit.
+	MaxTotalSizeBytes int64
+}
+
+// LocalStorage Stores blobs as files in a local folder.
+type LocalStorage struct {
+	params LocalStorageParams
+
+	mu             sync.Mutex
+	totalSizeBytes int64
+}
+
+// NewLocalStorage Constructor for a local storage, creating its folder if needed and measuring what it holds.
+func NewLocalStorage(params LocalStorageParams) (*LocalStorage, error) {
+	if err := os.MkdirAll(params.Folder, folderPermission); err != nil {
+		return nil, fmt.Errorf("could not create blob storage folder %s: %w", params.Folder, err)
+	}
+	s := &LocalStorage{params: params}
+	err := filepath.WalkDir(params.Folder, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		s.totalSizeBytes += info.Size()
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not measure blob storage folder %s: %w", params.Folder, err)
+	}
+	return s, nil
+}
+
+// TotalSizeBytes The size of the files in the folder.
+func (s *LocalStorage) TotalSizeBytes() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.totalSizeBytes
+}
+
+// Put Writes the blob to a temporary file first, so that readers never see a partial blob.
+func (s *LocalStorage) Put(_ context.Context, key string, r io.Reader, size int64) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if s.params.MaxTotalSizeBytes > 0 && s.TotalSizeBytes() >= s.params.MaxTotalSizeBytes {
+		return fmt.Errorf("could not write blob %s: %w", key, ErrStorageFull)
+	}
+	if s.params.Compress {
+		path += compressedSuffix
+	}
+	if err = os.MkdirAll(filepath.Dir(path), folderPermission); err != nil {
+		return fmt.Errorf("could not create folder for blob %s: %w", key, err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), tmpPrefix)
+	if err != nil {
+		return fmt.Errorf("could not create file for blob %s: %w", key, err)
+	}
+	defer os.Remove(tmp.Name())
+	if err = s.write(tmp, r, size); err != nil {
+		tmp.Close()
+		return fmt.Errorf("could not write blob %s: %w", key, err)
+	}
+	info, err := tmp.Stat()
+	if err != nil {
+		tmp.Close()
+		return fmt.Errorf("could not write blob %s: %w", key, err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("could not write blob %s: %w", key, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	replacedSizeBytes, _ := s.sizeOfFiles(key)
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("could not write blob %s: %w", key, err)
+	}
+	// The blob may have been stored uncompressed before.
+	if otherPath := s.otherPath(path); otherPath != path {
+		if err = os.Remove(otherPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("could not replace blob %s: %w", key, err)
+		}
+	}
+	s.totalSizeBytes += info.Size() - replacedSizeBytes
+	return nil
+}
+
+// Write a blob to a file, compressing it if configured, checking its size when known. The size of a compressed blob
+// is recorded in the gzip header, so that seeking from its end does not decompress it whole.
+func (s *LocalStorage) write(file *os.File, r io.Reader, size int64) error {
+	var w io.Writer = file
+	var gzipWriter *gzip.Writer
+	if s.params.Compress {
+		gzipWriter = gzip.NewWriter(file)
+		if size >= 0 {
+			gzipWriter.Extra = binary.LittleEndian.AppendUint64([]byte{gzipSizeID1, gzipSizeID2, 8, 0}, uint64(size))
+		}
+		w = gzipWriter
+	}
+	written, err := io.Copy(w, r)
+	if err != nil {
+		return err
+	}
+	if size >= 0 && written != size {
+		return fmt.Errorf("%w: got %d bytes instead of %d", errSizeMismatch, written, size)
+	}
+	if gzipWriter == nil {
+		return nil
+	}
+	return gzipWriter.Close()
+}
+
+// Get Opens the file of the blob. Compressed blobs are decompressed up to the offset being sought.
+func (s *LocalStorage) Get(_ context.Context, key string) (io.ReadSeekCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path + compressedSuffix)
+	if errors.Is(err, os.ErrNotExist) {
+		file, err = os.Open(path)
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("blob %s: %w", key, ErrNotFound)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not open blob %s: %w", key, err)
+		}
+		return file, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not open blob %s: %w", key, err)
+	}
+	gzipReader, err := gzip.NewReader(file)
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("could not decompress blob %s: %w", key, err)
+	}
+	return &gzipFile{Reader: gzipReader, file: file, size: gzipSize(gzipReader.Header)}, nil
+}
+
+// Exists Checks for the file of the blob.
+func (s *LocalStorage) Exists(_ context.Context, key string) (bool, error) {
+	if _, err := s.path(key); err != nil {
+		return false, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, exists := s.sizeOfFiles(key)
+	return exists, nil
+}
+
+// Delete Removes the file of the blob.
+func (s *LocalStorage) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, p := range []string{path, path + compressedSuffix} {
+		info, err := os.Stat(p)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err == nil {
+			err = os.Remove(p)
+		}
+		if err != nil {
+			return fmt.Errorf("could not delete blob %s: %w", key, err)
+		}
+		s.totalSizeBytes -= info.Size()
+	}
+	return nil
+}
+
+// Get the path of a blob, refusing keys escaping the folder.
+func (s *LocalStorage) path(key string) (string, error) {
+	if !filepath.IsLocal(key) || strings.HasPrefix(filepath.Base(key), tmpPrefix) {
+		return "", fmt.Errorf("%w: %s", errInvalidKey, key)
+	}
+	return filepath.Join(s.params.Folder, key), nil
+}
+
+// Get the path of the compressed file for an uncompressed one, and the other way around.
+func (s *LocalStorage) otherPath(path string) string {
+	if strings.HasSuffix(path, compressedSuffix) {
+		return strings.TrimSuffix(path, compressedSuffix)
+	}
+	return path + compressedSuffix
+}
+
+// Get the size of the compressed and uncompressed files of a blob, and if there are any.
+func (s *LocalStorage) sizeOfFiles(key string) (int64, bool) {
+	path := filepath.Join(s.params.Folder, key)
+	var sizeBytes int64
+	exists := false
+	for _, p := range []string{path, path + compressedSuffix} {
+		if info, err := os.Stat(p); err == nil {
+			sizeBytes += info.Size()
+			exists = true
+		}
+	}
+	return sizeBytes, exists
+}
+
+// gzipFile A compressed file, decompressed while reading.
+type gzipFile struct {
+	*gzip.Reader
+	file   *os.File
+	offset int64
+	size   int64
+}
+
+// Read Decompresses from the current offset.
+func (f *gzipFile) Read(p []byte) (int, error) {
+	n, err := f.Reader.Read(p)
+	f.offset += int64(n)
+	return n, err
+}
+
+// Seek Decompresses up to an offset, from the start of the file if it is before the current offset. The end of a
+// blob written without its size is found by decompressing it whole. Seeking past the end is an error.
+func (f *gzipFile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekCurrent:
+		offset += f.offset
+	case io.SeekEnd:
+		if f.size < 0 {
+			if _, err := io.Copy(io.Discard, f); err != nil {
+				return 0, fmt.Errorf("could not seek blob %s: %w", f.file.Name(), err)
+			}
+			f.size = f.offset
+		}
+		offset += f.size
+	}
+	if offset < 0 {
+		return 0, fmt.Errorf("could not seek blob %s to %d: %w", f.file.Name(), offset, errInvalidOffset)
+	}
+	if offset < f.offset {
+		if _, err := f.file.Seek(0, io.SeekStart); err != nil {
+			return 0, fmt.Errorf("could not seek blob %s: %w", f.file.Name(), err)
+		}
+		if err := f.Reader.Reset(f.file); err != nil {
+			return 0, fmt.Errorf("could not seek blob %s: %w", f.file.Name(), err)
+		}
+		f.offset = 0
+	}
+	// Reading advances the offset.
+	if _, err := io.CopyN(io.Discard, f, offset-f.offset); errors.Is(err, io.EOF) {
+		return f.offset, fmt.Errorf("could not seek blob %s to %d, past its end at %d: %w",
+			f.file.Name(), offset, f.offset, errInvalidOffset)
+	} else if err != nil {
+		return 0, fmt.Errorf("could not seek blob %s: %w", f.file.Name(), err)
+	}
+	return offset, nil
+}
+
+// Get the size of a blob recorded in a gzip header, -1 if it is not.
+func gzipSize(header gzip.Header) int64 {
+	extra := header.Extra
+	for len(extra) >= 4 {
+		length := int(binary.LittleEndian.Uint16(extra[2:4]))
+		if len(extra) < 4+length {
+			break
+		}
+		if extra[0] == gzipSizeID1 && extra[1] == gzipSizeID2 && length == 8 {
+			return int64(binary.LittleEndian.Uint64(extra[4:12]))
+		}
+		extra = extra[4+length:]
+	}
+	return -1
+}
+
+// Close Closes both the decompressor and the file.
+func (f *gzipFile) Close() error {
+	return errors.Join(f.Reader.Close(), f.file.Close())
+}
@@ -0,0 +1,330 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// errUnexpectedStatus An error helper.
+var errUnexpectedStatus = errors.New("unexpected response status")
+
+// errUnknownSize An error helper.
+var errUnknownSize = errors.New("unknown blob size")
+
+// S3 request signing constants, see https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-authenticating-requests.html.
+const (
+	s3SigningAlgorithm = "AWS4-HMAC-SHA256"
+	s3UnsignedPayload  = "UNSIGNED-PAYLOAD"
+	s3SignedHeaders    = "host;x-amz-content-sha256;x-amz-date"
+	s3DateFormat       = "20060102"
+	s3TimeFormat       = "20060102T150405Z"
+)
+
+// S3Params Parameters of an S3-compatible object storage.
+type S3Params struct {
+	// Endpoint is the URL of the object storage, ex: https://s3.eu-west-1.amazonaws.com or http://localhost:9000.
+	Endpoint string
+
+	// Bucket the blobs are stored in, it is addressed in the path of the URLs.
+	Bucket string
+
+	// Region of the bucket, used to sign requests.
+	Region string
+
+	// AccessKeyID and SecretAccessKey are the credentials used to sign requests.
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// S3Storage Stores blobs as objects in a bucket of an S3-compatible object storage, like AWS S3 or MinIO.
+type S3Storage struct {
+	params   S3Params
+	endpoint *url.URL
+	client   *http.Client
+}
+
+// NewS3Storage Constructor for an S3 storage.
+func NewS3Storage(params S3Params, client *http.Client) (*S3Storage, error) {
+	endpoint, err := url.Parse(params.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid S3 endpoint %s: %w", params.Endpoint, err)
+	}
+	return &S3Storage{params: params, endpoint: endpoint, client: client}, nil
+}
+
+// Put Uploads the blob with a single streamed PUT request.
+func (s *S3Storage) Put(ctx context.Context, key string, r io.Reader, size int64) error {
+	request, err := s.newRequest(ctx, http.MethodPut, key, r)
+	if err != nil {
+		return err
+	}
+	request.ContentLength = size
+	response, err := s.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("could not put blob %s: %w", key, err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not put blob %s: %w", key, unexpectedStatus(response))
+	}
+	return nil
+}
+
+// Get Streams the object of the blob. Seeking stops the stream, and the next read gets the rest of the object with a
+// ranged request.
+func (s *S3Storage) Get(ctx context.Context, key string) (io.ReadSeekCloser, error) {
+	response, err := s.getRange(ctx, key, 0, -1)
+	if err != nil {
+		return nil, err
+	}
+	if response.ContentLength < 0 {
+		response.Body.Close()
+		return nil, fmt.Errorf("could not get blob %s: %w", key, errUnknownSize)
+	}
+	return &s3Object{ctx: ctx, storage: s, key: key, size: response.ContentLength, body: response.Body}, nil
+}
+
+// Exists Checks for the object of the blob.
+func (s *S3Storage) Exists(ctx context.Context, key string) (bool, error) {
+	request, err := s.newRequest(ctx, http.MethodHead, key, nil)
+	if err != nil {
+		return false, err
+	}
+	response, err := s.client.Do(request)
+	if err != nil {
+		return false, fmt.Errorf("could not check blob %s: %w", key, err)
+	}
+	defer response.Body.Close()
+	switch response.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("could not check blob %s: %w", key, unexpectedStatus(response))
+	}
+}
+
+// Delete Deletes the object of the blob.
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	request, err := s.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	response, err := s.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("could not delete blob %s: %w", key, err)
+	}
+	defer response.Body.Close()
+	switch response.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return fmt.Errorf("could not delete blob %s: %w", key, unexpectedStatus(response))
+	}
+}
+
+// Get the bytes of an object from first to last included, to its end if last is negative.
+func (s *S3Storage) getRange(ctx context.Context, key string, first, last int64) (*http.Response, error) {
+	request, err := s.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	if first > 0 || last >= 0 {
+		byteRange := "bytes=" + strconv.FormatInt(first, 10) + "-"
+		if last >= 0 {
+			byteRange += strconv.FormatInt(last, 10)
+		}
+		request.Header.Set("Range", byteRange)
+	}
+	response, err := s.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("could not get blob %s: %w", key, err)
+	}
+	switch {
+	case response.StatusCode == http.StatusPartialContent,
+		response.StatusCode == http.StatusOK && first == 0 && last < 0:
+		return response, nil
+	case response.StatusCode == http.StatusNotFound:
+		response.Body.Close()
+		return nil, fmt.Errorf("blob %s: %w", key, ErrNotFound)
+	default:
+		defer response.Body.Close()
+		return nil, fmt.Errorf("could not get blob %s: %w", key, unexpectedStatus(response))
+	}
+}
+
+// Create a signed request for the object of a blob.
+func (s *S3Storage) newRequest(ctx context.Context, method string, key string, body io.Reader) (*http.Request, error) {
+	objectURL := *s.endpoint
+	objectURL.Path = strings.TrimSuffix(objectURL.Path, "/") + "/" + s.params.Bucket + "/" + key
+	objectURL.RawPath = s3URIEncode(objectURL.Path, false)
+	request, err := http.NewRequestWithContext(ctx, method, objectURL.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("could not create request for blob %s: %w", key, err)
+	}
+	s.sign(request, time.Now().UTC())
+	return request, nil
+}
+
+// Sign a request with AWS Signature Version 4, without signing the payload so that it can be streamed.
+func (s *S3Storage) sign(request *http.Request, now time.Time) {
+	amzDate := now.Format(s3TimeFormat)
+	request.Header.Set("X-Amz-Date", amzDate)
+	request.Header.Set("X-Amz-Content-Sha256", s3UnsignedPayload)
+
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		request.URL.EscapedPath(),
+		request.URL.RawQuery,
+		"host:" + request.URL.Host,
+		"x-amz-content-sha256:" + s3UnsignedPayload,
+		"x-amz-date:" + amzDate,
+		"",
+		s3SignedHeaders,
+		s3UnsignedPayload,
+	}, "\n")
+	scope := strings.Join([]string{now.Format(s3DateFormat), s.params.Region, "s3", "aws4_request"}, "/")
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		s3SigningAlgorithm,
+		amzDate,
+		scope,
+		hex.EncodeToString(canonicalRequestHash[:]),
+	}, "\n")
+
+	signingKey := []byte("AWS4" + s.params.SecretAccessKey)
+	for _, part := range []string{now.Format(s3DateFormat), s.params.Region, "s3", "aws4_request"} {
+		signingKey = hmacSHA256(signingKey, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	request.Header.Set("Authorization", fmt.Sprintf(
+		"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3SigningAlgorithm, s.params.AccessKeyID, scope, s3SignedHeaders, signature,
+	))
+}
+
+// Compute an HMAC-SHA256.
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// URI-encode a string the way S3 expects it, only leaving the unreserved characters and optionally slashes.
+func s3URIEncode(s string, encodeSlash bool) string {
+	var encoded strings.Builder
+	for _, c := range []byte(s) {
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '.', c == '_', c == '~':
+			encoded.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			encoded.WriteByte(c)
+		default:
+			fmt.Fprintf(&encoded, "%%%02X", c)
+		}
+	}
+	return encoded.String()
+}
+
+// s3Object An object read from the offset being read, with a ranged request when it is not the offset of the stream
+// in progress.
+type s3Object struct {
+	ctx     context.Context
+	storage *S3Storage
+	key     string
+	size    int64
+	offset  int64
+	body    io.ReadCloser
+}
+
+// Read Reads from the current offset.
+func (o *s3Object) Read(p []byte) (int, error) {
+	if o.offset >= o.size {
+		return 0, io.EOF
+	}
+	if o.body == nil {
+		response, err := o.storage.getRange(o.ctx, o.key, o.offset, -1)
+		if err != nil {
+			return 0, err
+		}
+		o.body = response.Body
+	}
+	n, err := o.body.Read(p)
+	o.offset += int64(n)
+	if errors.Is(err, io.EOF) && o.offset < o.size {
+		err = fmt.Errorf("could not read blob %s: %w", o.key, io.ErrUnexpectedEOF)
+	}
+	return n, err
+}
+
+// ReadAt Reads the bytes at an offset with a request for as many bytes, independently of the current offset.
+func (o *s3Object) ReadAt(p []byte, offset int64) (int, error) {
+	if offset < 0 {
+		return 0, fmt.Errorf("could not read blob %s at %d: %w", o.key, offset, errInvalidOffset)
+	}
+	if offset >= o.size {
+		return 0, io.EOF
+	}
+	limit := min(int64(len(p)), o.size-offset)
+	if limit == 0 {
+		return 0, nil
+	}
+	response, err := o.storage.getRange(o.ctx, o.key, offset, offset+limit-1)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	n, err := io.ReadFull(response.Body, p[:limit])
+	if err != nil {
+		return n, fmt.Errorf("could not read blob %s: %w", o.key, err)
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Seek Sets the offset of the next read, stopping the stream in progress if it moves.
+func (o *s3Object) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekCurrent:
+		offset += o.offset
+	case io.SeekEnd:
+		offset += o.size
+	}
+	if offset < 0 {
+		return 0, fmt.Errorf("could not seek blob %s to %d: %w", o.key, offset, errInvalidOffset)
+	}
+	if offset != o.offset && o.body != nil {
+		o.body.Close()
+		o.body = nil
+	}
+	o.offset = offset
+	return offset, nil
+}
+
+// Close Stops the stream in progress.
+func (o *s3Object) Close() error {
+	if o.body == nil {
+		return nil
+	}
+	return o.body.Close()
+}
+
+// Describe an unexpected response, with the start of its body which holds the S3 error.
+func unexpectedStatus(response *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(response.Body, 512))
+	return fmt.Errorf("%w %s: %s", errUnexpectedStatus, response.Status, strings.TrimSpace(string(body)))
+}
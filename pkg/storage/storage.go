@@ -0,0 +1,26 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrNotFound is returned when reading a blob that is not in the storage.
+var ErrNotFound = errors.New("blob not found")
+
+// BlobStorage Stores archived blobs by key, the key being what is recorded as the archive URL of a blob.
+type BlobStorage interface {
+	// Put Streams a blob of the given size into the storage, replacing any blob with the same key.
+	Put(ctx context.Context, key string, r io.Reader, size int64) error
+
+	// Get Opens a blob for reading it out of the storage, ErrNotFound if there is none with this key. Seeking lets
+	// ranges of a blob be served, storages which can read from an offset do not read what comes before it.
+	Get(ctx context.Context, key string) (io.ReadSeekCloser, error)
+
+	// Exists Checks if there is a blob with this key, to avoid storing the same content twice.
+	Exists(ctx context.Context, key string) (bool, error)
+
+	// Delete Removes a blob from the storage, it is not an error if there is none with this key.
+	Delete(ctx context.Context, key string) error
+}
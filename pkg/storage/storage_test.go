@@ -0,0 +1,205 @@
+package storage_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildbarn/bb-portal/pkg/storage"
+)
+
+// testBlobStorage Check that blobs can be written, read back from any offset, replaced and deleted.
+func testBlobStorage(t *testing.T, blobStorage storage.BlobStorage) {
+	ctx := context.Background()
+	key := "0a/0a1b2c-12"
+
+	_, err := blobStorage.Get(ctx, key)
+	require.ErrorIs(t, err, storage.ErrNotFound)
+	exists, err := blobStorage.Exists(ctx, key)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	require.NoError(t, blobStorage.Put(ctx, key, strings.NewReader("first value"), 11))
+	exists, err = blobStorage.Exists(ctx, key)
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.NoError(t, blobStorage.Put(ctx, key, strings.NewReader("second value"), 12))
+	reader, err := blobStorage.Get(ctx, key)
+	require.NoError(t, err)
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, "second value", string(content))
+	testSeek(t, reader)
+	require.NoError(t, reader.Close())
+
+	require.NoError(t, blobStorage.Delete(ctx, key))
+	require.NoError(t, blobStorage.Delete(ctx, key))
+	_, err = blobStorage.Get(ctx, key)
+	require.ErrorIs(t, err, storage.ErrNotFound)
+	exists, err = blobStorage.Exists(ctx, key)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+// testSeek Check that the blob "second value" can be read from any offset.
+func testSeek(t *testing.T, reader io.ReadSeeker) {
+	offset, err := reader.Seek(-5, io.SeekEnd)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), offset)
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, "value", string(content))
+
+	_, err = reader.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+	content = make([]byte, 6)
+	_, err = io.ReadFull(reader, content)
+	require.NoError(t, err)
+	require.Equal(t, "second", string(content))
+	_, err = reader.Seek(1, io.SeekCurrent)
+	require.NoError(t, err)
+	content, err = io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, "value", string(content))
+}
+
+func TestLocalStorage(t *testing.T) {
+	blobStorage, err := storage.NewLocalStorage(storage.LocalStorageParams{Folder: t.TempDir()})
+	require.NoError(t, err)
+	testBlobStorage(t, blobStorage)
+	require.Zero(t, blobStorage.TotalSizeBytes())
+
+	require.Error(t, blobStorage.Put(context.Background(), "../escape", strings.NewReader(""), 0))
+	require.ErrorContains(t, blobStorage.Put(context.Background(), "aa/mismatch", strings.NewReader("value"), 6), "size mismatch")
+	require.Zero(t, blobStorage.TotalSizeBytes())
+}
+
+func TestLocalStorage_Compressed(t *testing.T) {
+	blobStorage, err := storage.NewLocalStorage(storage.LocalStorageParams{Folder: t.TempDir(), Compress: true})
+	require.NoError(t, err)
+	testBlobStorage(t, blobStorage)
+	require.Zero(t, blobStorage.TotalSizeBytes())
+
+	// The end of a blob written without its size is found by decompressing it.
+	ctx := context.Background()
+	require.NoError(t, blobStorage.Put(ctx, "aa/unsized", strings.NewReader("second value"), -1))
+	reader, err := blobStorage.Get(ctx, "aa/unsized")
+	require.NoError(t, err)
+	testSeek(t, reader)
+	_, err = reader.Seek(13, io.SeekStart)
+	require.ErrorContains(t, err, "past its end")
+	testSeek(t, reader)
+	require.NoError(t, reader.Close())
+
+	require.ErrorContains(t, blobStorage.Put(ctx, "aa/mismatch", strings.NewReader("value"), 6), "size mismatch")
+}
+
+func TestLocalStorage_Full(t *testing.T) {
+	ctx := context.Background()
+	folder := t.TempDir()
+	blobStorage, err := storage.NewLocalStorage(storage.LocalStorageParams{Folder: folder, MaxTotalSizeBytes: 10})
+	require.NoError(t, err)
+	require.NoError(t, blobStorage.Put(ctx, "aa/first", strings.NewReader("0123456789"), 10))
+	require.Equal(t, int64(10), blobStorage.TotalSizeBytes())
+	require.ErrorIs(t, blobStorage.Put(ctx, "bb/second", strings.NewReader("x"), 1), storage.ErrStorageFull)
+
+	// The size of what is already stored is measured on start.
+	reopened, err := storage.NewLocalStorage(storage.LocalStorageParams{Folder: folder, MaxTotalSizeBytes: 10})
+	require.NoError(t, err)
+	require.Equal(t, int64(10), reopened.TotalSizeBytes())
+
+	require.NoError(t, blobStorage.Delete(ctx, "aa/first"))
+	require.NoError(t, blobStorage.Put(ctx, "bb/second", strings.NewReader("x"), 1))
+}
+
+// fakeS3 A minimal S3 stand-in, storing objects in memory and only accepting signed requests.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	ranges  []string
+}
+
+var authorizationPattern = regexp.MustCompile(
+	`^AWS4-HMAC-SHA256 Credential=access-key/\d{8}/eu-west-1/s3/aws4_request, ` +
+		`SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=[0-9a-f]{64}$`)
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !authorizationPattern.MatchString(r.Header.Get("Authorization")) || r.Header.Get("X-Amz-Date") == "" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		body, err := io.ReadAll(r.Body)
+		if err != nil || int64(len(body)) != r.ContentLength {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.objects[r.URL.Path] = body
+	case http.MethodHead:
+		if _, ok := f.objects[r.URL.Path]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	case http.MethodGet:
+		body, ok := f.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if byteRange := r.Header.Get("Range"); byteRange != "" {
+			f.ranges = append(f.ranges, byteRange)
+		}
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(body))
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestS3Storage(t *testing.T) {
+	fake := &fakeS3{objects: map[string][]byte{}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	blobStorage, err := storage.NewS3Storage(storage.S3Params{
+		Endpoint:        server.URL,
+		Bucket:          "blobs",
+		Region:          "eu-west-1",
+		AccessKeyID:     "access-key",
+		SecretAccessKey: "secret-key",
+	}, server.Client())
+	require.NoError(t, err)
+	testBlobStorage(t, blobStorage)
+
+	require.Equal(t, []string{"bytes=7-", "bytes=7-"}, fake.ranges)
+
+	ctx := context.Background()
+	require.NoError(t, blobStorage.Put(ctx, "abc-3", strings.NewReader("abc"), 3))
+	require.Contains(t, fake.objects, "/blobs/abc-3")
+
+	// Reading at an offset only gets the bytes read.
+	reader, err := blobStorage.Get(ctx, "abc-3")
+	require.NoError(t, err)
+	readerAt, ok := reader.(io.ReaderAt)
+	require.True(t, ok)
+	content := make([]byte, 2)
+	n, err := readerAt.ReadAt(content, 1)
+	require.NoError(t, err)
+	require.Equal(t, "bc", string(content[:n]))
+	n, err = readerAt.ReadAt(content, 2)
+	require.ErrorIs(t, err, io.EOF)
+	require.Equal(t, "c", string(content[:n]))
+	require.NoError(t, reader.Close())
+	require.Equal(t, []string{"bytes=7-", "bytes=7-", "bytes=1-2", "bytes=2-2"}, fake.ranges)
+}
@@ -0,0 +1,152 @@
+package detectors
+
+import (
+	"regexp"
+
+	"github.com/buildbarn/bb-portal/pkg/events"
+	"github.com/buildbarn/bb-portal/third_party/bazel/gen/bes"
+	"github.com/buildbarn/bb-portal/third_party/bazel/gen/bescore"
+)
+
+// FailureClassification string
+type FailureClassification string
+
+// Failure classifications, telling who is responsible for a failure.
+const (
+	FailureClassificationUserError  FailureClassification = "USER_ERROR"
+	FailureClassificationInfraError FailureClassification = "INFRA_ERROR"
+	FailureClassificationFlaky      FailureClassification = "FLAKY"
+	FailureClassificationCancelled  FailureClassification = "CANCELLED"
+)
+
+// Progress output reporting problems with the remote cache, the remote executor or the BES backend.
+var infraProgressPattern = regexp.MustCompile(`(?i)(remote cache|remote execution|remote executor|build event service|` +
+	`unavailable: |deadline_exceeded|resource_exhausted|connection reset|connection refused|` +
+	`failed to query remote execution capabilities|bulktransferexception|cachenotfoundexception|` +
+	`lost inputs|no space left on device)`)
+
+// ClassifyInvocation classifies a failed invocation from its exit code name and the classification of its
+// problems. Successful invocations and invocations that did not finish are not classified.
+func ClassifyInvocation(exitCodeName string, problems []Problem) FailureClassification {
+	switch {
+	case exitCodeName == "" || exitCodeName == "SUCCESS":
+		return ""
+	case exitCodeName == "INTERRUPTED":
+		return FailureClassificationCancelled
+	}
+	if isInfraExitCode(exitCodeName) {
+		return FailureClassificationInfraError
+	}
+
+	// A single user error is enough to make the invocation a user error.
+	seen := map[FailureClassification]bool{}
+	for _, problem := range problems {
+		seen[problem.FailureClassification] = true
+	}
+	for _, classification := range []FailureClassification{
+		FailureClassificationUserError,
+		FailureClassificationInfraError,
+		FailureClassificationFlaky,
+		FailureClassificationCancelled,
+	} {
+		if seen[classification] {
+			return classification
+		}
+	}
+	return FailureClassificationUserError
+}
+
+// classifyProblem classifies a problem from the events making it up. Evidence of cancellation wins over
+// evidence of infrastructure errors, which wins over flakiness. Anything else is a user error.
+func classifyProblem(buildEvents []*events.BuildEvent) FailureClassification {
+	seen := map[FailureClassification]bool{}
+	for _, event := range buildEvents {
+		seen[classifyEvent(event)] = true
+	}
+	for _, classification := range []FailureClassification{
+		FailureClassificationCancelled,
+		FailureClassificationInfraError,
+		FailureClassificationFlaky,
+	} {
+		if seen[classification] {
+			return classification
+		}
+	}
+	return FailureClassificationUserError
+}
+
+// classifyEvent classifies a single event, returning a user error if the event shows no sign of anything else.
+func classifyEvent(event *events.BuildEvent) FailureClassification {
+	switch {
+	case event.IsActionCompleted():
+		return classifyFailureDetail(event.GetAction().GetFailureDetail())
+	case event.IsTargetCompleted():
+		switch event.GetAborted().GetReason() {
+		case bes.Aborted_USER_INTERRUPTED:
+			return FailureClassificationCancelled
+		case bes.Aborted_REMOTE_ENVIRONMENT_FAILURE, bes.Aborted_INTERNAL, bes.Aborted_OUT_OF_MEMORY:
+			return FailureClassificationInfraError
+		}
+		return classifyFailureDetail(event.GetCompleted().GetFailureDetail())
+	case event.IsTestSummary():
+		return classifyTestStatus(event.GetTestSummary().GetOverallStatus())
+	case event.IsTestResult():
+		return classifyTestStatus(event.GetTestResult().GetStatus())
+	case event.GetProgress() != nil:
+		for _, line := range errorLines(event.GetProgress().GetStderr()) {
+			if infraProgressPattern.MatchString(line) {
+				return FailureClassificationInfraError
+			}
+		}
+	}
+	return FailureClassificationUserError
+}
+
+// classifyFailureDetail classifies the failure detail of an action or target.
+func classifyFailureDetail(failureDetail *bescore.FailureDetail) FailureClassification {
+	switch {
+	case failureDetail.GetInterrupted() != nil:
+		return FailureClassificationCancelled
+	case failureDetail.GetRemoteExecution() != nil,
+		failureDetail.GetCrash() != nil,
+		failureDetail.GetFilesystem() != nil:
+		return FailureClassificationInfraError
+	}
+	switch failureDetail.GetSpawn().GetCode() {
+	case bescore.Spawn_OUT_OF_MEMORY,
+		bescore.Spawn_EXECUTION_FAILED,
+		bescore.Spawn_EXECUTION_DENIED,
+		bescore.Spawn_REMOTE_CACHE_FAILED,
+		bescore.Spawn_EXEC_IO_EXCEPTION,
+		bescore.Spawn_NO_USABLE_STRATEGY_FOUND,
+		bescore.Spawn_REMOTE_CACHE_EVICTED,
+		bescore.Spawn_SPAWN_LOG_IO_EXCEPTION:
+		return FailureClassificationInfraError
+	default:
+		return FailureClassificationUserError
+	}
+}
+
+// isInfraExitCode checks if an exit code name reports a failure of the environment the invocation ran in.
+func isInfraExitCode(exitCodeName string) bool {
+	switch exitCodeName {
+	case "REMOTE_ENVIRONMENTAL_ERROR", "OOM_ERROR", "REMOTE_ERROR", "LOCAL_ENVIRONMENTAL_ERROR",
+		"BLAZE_INTERNAL_ERROR", "TRANSIENT_BUILD_EVENT_SERVICE_UPLOAD_ERROR", "REMOTE_CACHE_EVICTED",
+		"PERSISTENT_BUILD_EVENT_SERVICE_UPLOAD_ERROR", "EXTERNAL_DEPS_ERROR":
+		return true
+	default:
+		return false
+	}
+}
+
+// classifyTestStatus classifies the status of a test.
+func classifyTestStatus(status bes.TestStatus) FailureClassification {
+	switch status {
+	case bes.TestStatus_FLAKY:
+		return FailureClassificationFlaky
+	case bes.TestStatus_REMOTE_FAILURE, bes.TestStatus_TOOL_HALTED_BEFORE_TESTING:
+		return FailureClassificationInfraError
+	default:
+		return FailureClassificationUserError
+	}
+}
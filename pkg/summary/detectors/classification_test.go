@@ -0,0 +1,200 @@
+package detectors_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildbarn/bb-portal/pkg/events"
+	"github.com/buildbarn/bb-portal/pkg/summary/detectors"
+	"github.com/buildbarn/bb-portal/third_party/bazel/gen/bes"
+	"github.com/buildbarn/bb-portal/third_party/bazel/gen/bescore"
+)
+
+// TestClassifyInvocation
+func TestClassifyInvocation(t *testing.T) {
+	userError := detectors.Problem{FailureClassification: detectors.FailureClassificationUserError}
+	infraError := detectors.Problem{FailureClassification: detectors.FailureClassificationInfraError}
+	flaky := detectors.Problem{FailureClassification: detectors.FailureClassificationFlaky}
+
+	for _, tc := range []struct {
+		name         string
+		exitCodeName string
+		problems     []detectors.Problem
+		expected     detectors.FailureClassification
+	}{
+		{"success", "SUCCESS", nil, ""},
+		{"unfinished", "", nil, ""},
+		{"interrupted", "INTERRUPTED", []detectors.Problem{userError}, detectors.FailureClassificationCancelled},
+		{"remote error", "REMOTE_ERROR", []detectors.Problem{userError}, detectors.FailureClassificationInfraError},
+		{"user error wins", "BUILD_FAILURE", []detectors.Problem{infraError, userError, flaky}, detectors.FailureClassificationUserError},
+		{"infra problems", "TESTS_FAILED", []detectors.Problem{infraError, flaky}, detectors.FailureClassificationInfraError},
+		{"flaky problems", "TESTS_FAILED", []detectors.Problem{flaky}, detectors.FailureClassificationFlaky},
+		{"no problems", "BUILD_FAILURE", nil, detectors.FailureClassificationUserError},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, detectors.ClassifyInvocation(tc.exitCodeName, tc.problems))
+		})
+	}
+}
+
+// spawnFailure A failure detail of a spawn failing with a code.
+func spawnFailure(code bescore.Spawn_Code) *bescore.FailureDetail {
+	return &bescore.FailureDetail{Category: &bescore.FailureDetail_Spawn{Spawn: &bescore.Spawn{Code: code}}}
+}
+
+// classifyEvent Classifies an event with an ID and a payload.
+func classifyEvent(id *bes.BuildEventId, event *bes.BuildEvent) detectors.FailureClassification {
+	event.Id = id
+	buildEvent := events.NewBuildEvent(event, nil)
+	return detectors.ClassifyEvent(&buildEvent)
+}
+
+// TestClassifyFailureDetail
+func TestClassifyFailureDetail(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		failureDetail *bescore.FailureDetail
+		expected      detectors.FailureClassification
+	}{
+		{"none", nil, detectors.FailureClassificationUserError},
+		{"interrupted", &bescore.FailureDetail{Category: &bescore.FailureDetail_Interrupted{Interrupted: &bescore.Interrupted{}}}, detectors.FailureClassificationCancelled},
+		{"remote execution", &bescore.FailureDetail{Category: &bescore.FailureDetail_RemoteExecution{RemoteExecution: &bescore.RemoteExecution{}}}, detectors.FailureClassificationInfraError},
+		{"crash", &bescore.FailureDetail{Category: &bescore.FailureDetail_Crash{Crash: &bescore.Crash{}}}, detectors.FailureClassificationInfraError},
+		{"filesystem", &bescore.FailureDetail{Category: &bescore.FailureDetail_Filesystem{Filesystem: &bescore.Filesystem{}}}, detectors.FailureClassificationInfraError},
+		{"spawn non zero exit", spawnFailure(bescore.Spawn_NON_ZERO_EXIT), detectors.FailureClassificationUserError},
+		{"spawn timeout", spawnFailure(bescore.Spawn_TIMEOUT), detectors.FailureClassificationUserError},
+		{"spawn out of memory", spawnFailure(bescore.Spawn_OUT_OF_MEMORY), detectors.FailureClassificationInfraError},
+		{"spawn execution failed", spawnFailure(bescore.Spawn_EXECUTION_FAILED), detectors.FailureClassificationInfraError},
+		{"spawn execution denied", spawnFailure(bescore.Spawn_EXECUTION_DENIED), detectors.FailureClassificationInfraError},
+		{"spawn remote cache failed", spawnFailure(bescore.Spawn_REMOTE_CACHE_FAILED), detectors.FailureClassificationInfraError},
+		{"spawn exec io exception", spawnFailure(bescore.Spawn_EXEC_IO_EXCEPTION), detectors.FailureClassificationInfraError},
+		{"spawn no usable strategy", spawnFailure(bescore.Spawn_NO_USABLE_STRATEGY_FOUND), detectors.FailureClassificationInfraError},
+		{"spawn remote cache evicted", spawnFailure(bescore.Spawn_REMOTE_CACHE_EVICTED), detectors.FailureClassificationInfraError},
+		{"spawn log io exception", spawnFailure(bescore.Spawn_SPAWN_LOG_IO_EXCEPTION), detectors.FailureClassificationInfraError},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, detectors.ClassifyFailureDetail(tc.failureDetail))
+		})
+	}
+}
+
+// TestClassifyEvent_ActionCompleted
+func TestClassifyEvent_ActionCompleted(t *testing.T) {
+	id := &bes.BuildEventId{Id: &bes.BuildEventId_ActionCompleted{ActionCompleted: &bes.BuildEventId_ActionCompletedId{Label: "//foo:bar"}}}
+	for _, tc := range []struct {
+		name          string
+		failureDetail *bescore.FailureDetail
+		expected      detectors.FailureClassification
+	}{
+		{"compile error", spawnFailure(bescore.Spawn_NON_ZERO_EXIT), detectors.FailureClassificationUserError},
+		{"remote cache failed", spawnFailure(bescore.Spawn_REMOTE_CACHE_FAILED), detectors.FailureClassificationInfraError},
+		{"interrupted", &bescore.FailureDetail{Category: &bescore.FailureDetail_Interrupted{Interrupted: &bescore.Interrupted{}}}, detectors.FailureClassificationCancelled},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			event := &bes.BuildEvent{Payload: &bes.BuildEvent_Action{Action: &bes.ActionExecuted{FailureDetail: tc.failureDetail}}}
+			require.Equal(t, tc.expected, classifyEvent(id, event))
+		})
+	}
+}
+
+// TestClassifyEvent_TargetCompleted
+func TestClassifyEvent_TargetCompleted(t *testing.T) {
+	id := &bes.BuildEventId{Id: &bes.BuildEventId_TargetCompleted{TargetCompleted: &bes.BuildEventId_TargetCompletedId{Label: "//foo:bar"}}}
+	aborted := func(reason bes.Aborted_AbortReason) *bes.BuildEvent {
+		return &bes.BuildEvent{Payload: &bes.BuildEvent_Aborted{Aborted: &bes.Aborted{Reason: reason}}}
+	}
+	for _, tc := range []struct {
+		name     string
+		event    *bes.BuildEvent
+		expected detectors.FailureClassification
+	}{
+		{"analysis failure", aborted(bes.Aborted_ANALYSIS_FAILURE), detectors.FailureClassificationUserError},
+		{"user interrupted", aborted(bes.Aborted_USER_INTERRUPTED), detectors.FailureClassificationCancelled},
+		{"remote environment failure", aborted(bes.Aborted_REMOTE_ENVIRONMENT_FAILURE), detectors.FailureClassificationInfraError},
+		{"internal", aborted(bes.Aborted_INTERNAL), detectors.FailureClassificationInfraError},
+		{"out of memory", aborted(bes.Aborted_OUT_OF_MEMORY), detectors.FailureClassificationInfraError},
+		{"failed", &bes.BuildEvent{Payload: &bes.BuildEvent_Completed{Completed: &bes.TargetComplete{}}}, detectors.FailureClassificationUserError},
+		{"failed remotely", &bes.BuildEvent{Payload: &bes.BuildEvent_Completed{Completed: &bes.TargetComplete{
+			FailureDetail: &bescore.FailureDetail{Category: &bescore.FailureDetail_RemoteExecution{RemoteExecution: &bescore.RemoteExecution{}}},
+		}}}, detectors.FailureClassificationInfraError},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, classifyEvent(id, tc.event))
+		})
+	}
+}
+
+// TestClassifyEvent_Test
+func TestClassifyEvent_Test(t *testing.T) {
+	summaryID := &bes.BuildEventId{Id: &bes.BuildEventId_TestSummary{TestSummary: &bes.BuildEventId_TestSummaryId{Label: "//foo:test"}}}
+	resultID := &bes.BuildEventId{Id: &bes.BuildEventId_TestResult{TestResult: &bes.BuildEventId_TestResultId{Label: "//foo:test"}}}
+	for _, tc := range []struct {
+		status   bes.TestStatus
+		expected detectors.FailureClassification
+	}{
+		{bes.TestStatus_FAILED, detectors.FailureClassificationUserError},
+		{bes.TestStatus_TIMEOUT, detectors.FailureClassificationUserError},
+		{bes.TestStatus_FLAKY, detectors.FailureClassificationFlaky},
+		{bes.TestStatus_REMOTE_FAILURE, detectors.FailureClassificationInfraError},
+		{bes.TestStatus_TOOL_HALTED_BEFORE_TESTING, detectors.FailureClassificationInfraError},
+	} {
+		t.Run(tc.status.String(), func(t *testing.T) {
+			summary := &bes.BuildEvent{Payload: &bes.BuildEvent_TestSummary{TestSummary: &bes.TestSummary{OverallStatus: tc.status}}}
+			require.Equal(t, tc.expected, classifyEvent(summaryID, summary))
+			result := &bes.BuildEvent{Payload: &bes.BuildEvent_TestResult{TestResult: &bes.TestResult{Status: tc.status}}}
+			require.Equal(t, tc.expected, classifyEvent(resultID, result))
+		})
+	}
+}
+
+// TestClassifyEvent_Progress
+func TestClassifyEvent_Progress(t *testing.T) {
+	id := &bes.BuildEventId{Id: &bes.BuildEventId_Progress{Progress: &bes.BuildEventId_ProgressId{}}}
+	for _, tc := range []struct {
+		name     string
+		stderr   string
+		expected detectors.FailureClassification
+	}{
+		{"compile error", "ERROR: /src/foo/BUILD:1:10: Compiling foo.cc failed: (Exit 1)\n", detectors.FailureClassificationUserError},
+		{"remote cache", "ERROR: Failed to fetch blobs because they do not exist remotely: remote cache evicted\n", detectors.FailureClassificationInfraError},
+		{"colored", "\x1b[31m\x1b[1mERROR: \x1b[0mUNAVAILABLE: io exception\n", detectors.FailureClassificationInfraError},
+		{"after other output", "INFO: Analyzed 1 target\nERROR: connection refused\n", detectors.FailureClassificationInfraError},
+		{"warning only", "WARNING: remote cache unavailable, falling back to local execution\n", detectors.FailureClassificationUserError},
+		{"stdout only", "", detectors.FailureClassificationUserError},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			event := &bes.BuildEvent{Payload: &bes.BuildEvent_Progress{Progress: &bes.Progress{
+				Stdout: "ERROR: remote cache unavailable\n",
+				Stderr: tc.stderr,
+			}}}
+			require.Equal(t, tc.expected, classifyEvent(id, event))
+		})
+	}
+}
+
+// TestInfraProgressPattern
+func TestInfraProgressPattern(t *testing.T) {
+	for _, tc := range []struct {
+		line     string
+		expected bool
+	}{
+		{"ERROR: Failed to query remote execution capabilities: UNAVAILABLE: io exception", true},
+		{"ERROR: The remote executor is unavailable", true},
+		{"ERROR: Build Event Service upload failed", true},
+		{"ERROR: DEADLINE_EXCEEDED: deadline exceeded after 59.9s", true},
+		{"ERROR: RESOURCE_EXHAUSTED: quota exceeded", true},
+		{"ERROR: Connection reset by peer", true},
+		{"ERROR: com.google.devtools.build.lib.remote.BulkTransferException", true},
+		{"ERROR: CacheNotFoundException: Missing digest", true},
+		{"ERROR: Lost inputs no longer available remotely", true},
+		{"ERROR: No space left on device", true},
+		{"ERROR: /src/foo/BUILD:1:10: Compiling foo.cc failed: (Exit 1)", false},
+		{"ERROR: no such target '//foo:baz'", false},
+		{"ERROR: Build did NOT complete successfully", false},
+	} {
+		t.Run(tc.line, func(t *testing.T) {
+			require.Equal(t, tc.expected, detectors.InfraProgressPattern.MatchString(tc.line))
+		})
+	}
+}
@@ -0,0 +1,8 @@
+package detectors
+
+// The unexported functions tested in the detectors_test package.
+var (
+	ClassifyEvent         = classifyEvent
+	ClassifyFailureDetail = classifyFailureDetail
+	InfraProgressPattern  = infraProgressPattern
+)
@@ -0,0 +1,95 @@
+package detectors
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/buildbarn/bb-portal/pkg/events"
+)
+
+// Patterns for the parts of an error message that differ between otherwise identical failures.
+var (
+	ansiEscapePattern = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]`)
+	timestampPattern  = regexp.MustCompile(`\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:?\d{2})?`)
+	execRootPattern   = regexp.MustCompile(`\S*/execroot/[^/\s]+/`)
+	bazelOutPattern   = regexp.MustCompile(`bazel-out/[^/\s]+/`)
+	tmpPathPattern    = regexp.MustCompile(`/tmp/\S*`)
+	hexPattern        = regexp.MustCompile(`\b[0-9a-fA-F]{8,}\b`)
+	numberPattern     = regexp.MustCompile(`\d+`)
+	whitespacePattern = regexp.MustCompile(`\s+`)
+)
+
+// Fingerprint constants.
+const (
+	// fingerprintVersion is mixed into every fingerprint so that changes to the normalization can be rolled out.
+	fingerprintVersion = "v1"
+	errorLinePrefix    = "ERROR: "
+)
+
+// NormalizeErrorText strips the volatile parts of an error message (timestamps, hashes, sandbox and output
+// paths, line numbers, ...) so that the same failure seen in different invocations produces the same text.
+func NormalizeErrorText(text string) string {
+	text = ansiEscapePattern.ReplaceAllString(text, "")
+	text = timestampPattern.ReplaceAllString(text, "<time>")
+	text = execRootPattern.ReplaceAllString(text, "")
+	text = bazelOutPattern.ReplaceAllString(text, "bazel-out/<config>/")
+	text = tmpPathPattern.ReplaceAllString(text, "<tmp>")
+	text = hexPattern.ReplaceAllString(text, "<hash>")
+	text = numberPattern.ReplaceAllString(text, "0")
+	text = whitespacePattern.ReplaceAllString(text, " ")
+	return strings.TrimSpace(text)
+}
+
+// Fingerprint returns a stable identifier for a problem, made of its type, label and normalized error text.
+func Fingerprint(problemType BazelInvocationProblemType, label string, errorText string) string {
+	hash := sha256.New()
+	for _, part := range []string{fingerprintVersion, string(problemType), label, NormalizeErrorText(errorText)} {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// errorText extracts the human-readable error message from the events making up a problem.
+func errorText(buildEvents []*events.BuildEvent) string {
+	var parts []string
+	for _, event := range buildEvents {
+		switch {
+		case event.IsActionCompleted():
+			action := event.GetAction()
+			if msg := action.GetFailureDetail().GetMessage(); msg != "" {
+				parts = append(parts, msg)
+			} else {
+				parts = append(parts, fmt.Sprintf("%s exited with %d", action.GetType(), action.GetExitCode()))
+			}
+		case event.IsTargetCompleted():
+			if msg := event.GetCompleted().GetFailureDetail().GetMessage(); msg != "" {
+				parts = append(parts, msg)
+			}
+			if aborted := event.GetAborted(); aborted != nil {
+				parts = append(parts, aborted.GetReason().String(), aborted.GetDescription())
+			}
+		case event.IsTestSummary():
+			parts = append(parts, event.GetTestSummary().GetOverallStatus().String())
+		case event.IsTestResult():
+			parts = append(parts, event.GetTestResult().GetStatus().String(), event.GetTestResult().GetStatusDetails())
+		case event.GetProgress() != nil:
+			parts = append(parts, errorLines(event.GetProgress().GetStderr())...)
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+// errorLines returns the lines of a progress output that report an error.
+func errorLines(output string) []string {
+	var lines []string
+	for _, line := range strings.Split(ansiEscapePattern.ReplaceAllString(output, ""), "\n") {
+		if strings.HasPrefix(line, errorLinePrefix) {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
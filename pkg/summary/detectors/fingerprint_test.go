@@ -0,0 +1,30 @@
+package detectors_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildbarn/bb-portal/pkg/summary/detectors"
+)
+
+// TestNormalizeErrorText
+func TestNormalizeErrorText(t *testing.T) {
+	require.Equal(t,
+		detectors.NormalizeErrorText("ERROR: /home/ci/.cache/bazel/_bazel_ci/0a1b2c3d4e/execroot/_main/src/BUILD:12:8: Compiling src/main.cc failed at 2024-05-01T10:11:12Z"),
+		detectors.NormalizeErrorText("ERROR: /home/other/.cache/bazel/_bazel_other/f9e8d7c6b5/execroot/_main/src/BUILD:40:3:  Compiling src/main.cc failed at 2024-06-02T01:02:03Z"),
+	)
+	require.Equal(t,
+		"bazel-out/<config>/bin/foo <tmp>",
+		detectors.NormalizeErrorText("\x1b[31mbazel-out/k8-fastbuild/bin/foo /tmp/sandbox/123\x1b[0m\n"),
+	)
+}
+
+// TestFingerprint
+func TestFingerprint(t *testing.T) {
+	fingerprint := detectors.Fingerprint(detectors.BazelInvocationActionProblem, "//src:main", "exit code 1 after 3.2s")
+	require.Len(t, fingerprint, 64)
+	require.Equal(t, fingerprint, detectors.Fingerprint(detectors.BazelInvocationActionProblem, "//src:main", "exit code 1 after 5.7s"))
+	require.NotEqual(t, fingerprint, detectors.Fingerprint(detectors.BazelInvocationActionProblem, "//src:other", "exit code 1 after 3.2s"))
+	require.NotEqual(t, fingerprint, detectors.Fingerprint(detectors.BazelInvocationTestProblem, "//src:main", "exit code 1 after 3.2s"))
+}
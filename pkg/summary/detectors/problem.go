@@ -2,6 +2,7 @@ package detectors
 
 import (
 	"github.com/buildbarn/bb-portal/pkg/events"
+	"github.com/buildbarn/bb-portal/third_party/bazel/gen/bes"
 )
 
 // a constant for status
@@ -20,9 +21,11 @@ func createProblem(problemType BazelInvocationProblemType, label string, buildEv
 	}
 
 	return &Problem{
-		ProblemType: problemType,
-		Label:       label,
-		BEPEvents:   bepEvents,
+		ProblemType:           problemType,
+		Label:                 label,
+		BEPEvents:             bepEvents,
+		Fingerprint:           Fingerprint(problemType, label, errorText(buildEvents)),
+		FailureClassification: classifyProblem(buildEvents),
 	}, nil
 }
 
@@ -37,3 +40,18 @@ func createProblemWithBlobs(problemType BazelInvocationProblemType, key labelKey
 	problem.DetectedBlobs = outputBlobs[key]
 	return problem, nil
 }
+
+// DetectedBlobs The blobs referenced from the events of a problem, the way they were detected when it was created.
+func DetectedBlobs(buildEvents []events.BuildEvent) []BlobURI {
+	var blobs []BlobURI
+	for i := range buildEvents {
+		event := &buildEvents[i]
+		switch {
+		case isFailedAction(event):
+			blobs = append(blobs, getOutputsBlobs(getActionOutputs(event))...)
+		case event.IsTestResult() && event.GetTestResult().GetStatus() != bes.TestStatus_PASSED:
+			blobs = append(blobs, getOutputsBlobs(event.GetTestResult().GetTestActionOutput())...)
+		}
+	}
+	return blobs
+}
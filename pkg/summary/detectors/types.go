@@ -26,4 +26,8 @@ type Problem struct {
 	ProblemType   BazelInvocationProblemType
 	Label         string
 	BEPEvents     json.RawMessage
+	// Fingerprint identifies the same problem across invocations, see Fingerprint.
+	Fingerprint string
+	// FailureClassification tells who is responsible for the problem.
+	FailureClassification FailureClassification
 }
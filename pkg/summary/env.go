@@ -38,3 +38,28 @@ func IsBuildEnvKey(k string) bool {
 		return false
 	}
 }
+
+// IsToolchainEnvKey checks if an environment variable key is one commonly
+// picked up by toolchains and rules, so that changing it may change the
+// outcome of a build.
+func IsToolchainEnvKey(k string) bool {
+	switch k {
+	case // Shell and locale.
+		"PATH", "HOME", "TMPDIR", "LANG", "LC_ALL", "LD_LIBRARY_PATH":
+		return true
+
+	case // C/C++ toolchains.
+		"CC", "CXX", "CFLAGS", "CXXFLAGS", "CPPFLAGS", "LDFLAGS", "BAZEL_USE_CPP_ONLY_TOOLCHAIN":
+		return true
+
+	case // Language toolchains.
+		"JAVA_HOME", "PYTHONPATH", "GOROOT", "GOPATH", "NODE_OPTIONS":
+		return true
+
+	case // Bazel launcher.
+		"USE_BAZEL_VERSION", "BAZELISK_HOME":
+		return true
+	default:
+		return false
+	}
+}
@@ -23,12 +23,13 @@ import (
 
 // Summarizer struct.
 type Summarizer struct {
-	summary         *Summary
-	problemDetector detectors.ProblemDetector
+	summary              *Summary
+	problemDetector      detectors.ProblemDetector
+	testHealthThresholds TestHealthThresholds
 }
 
 // Summarize function.
-func Summarize(ctx context.Context, eventFileURL string) (*Summary, error) {
+func Summarize(ctx context.Context, eventFileURL string, testHealthThresholds TestHealthThresholds) (*Summary, error) {
 	reader, err := os.Open(eventFileURL)
 	if err != nil {
 		return nil, fmt.Errorf("could not open %s: %w", eventFileURL, err)
@@ -36,18 +37,18 @@ func Summarize(ctx context.Context, eventFileURL string) (*Summary, error) {
 	defer reader.Close()
 
 	problemDetector := detectors.NewProblemDetector()
-	summarizer := newSummarizer(eventFileURL, problemDetector)
+	summarizer := newSummarizer(eventFileURL, problemDetector, testHealthThresholds)
 	it := events.NewBuildEventIterator(ctx, reader)
 	return summarizer.summarize(it)
 }
 
 // NewSummarizer constructor
-func NewSummarizer() *Summarizer {
-	return newSummarizer("", detectors.NewProblemDetector())
+func NewSummarizer(testHealthThresholds TestHealthThresholds) *Summarizer {
+	return newSummarizer("", detectors.NewProblemDetector(), testHealthThresholds)
 }
 
 // newSummarizer
-func newSummarizer(eventFileURL string, problemDetector detectors.ProblemDetector) *Summarizer {
+func newSummarizer(eventFileURL string, problemDetector detectors.ProblemDetector, testHealthThresholds TestHealthThresholds) *Summarizer {
 	return &Summarizer{
 		summary: &Summary{
 			InvocationSummary: &InvocationSummary{},
@@ -56,7 +57,8 @@ func newSummarizer(eventFileURL string, problemDetector detectors.ProblemDetecto
 				filepath.Base(eventFileURL): eventFileURL,
 			},
 		},
-		problemDetector: problemDetector,
+		problemDetector:      problemDetector,
+		testHealthThresholds: testHealthThresholds,
 	}
 }
 
@@ -92,6 +94,11 @@ func (s Summarizer) FinishProcessing() (*Summary, error) {
 		s.summary.Problems = append(s.summary.Problems, problems...)
 	}
 
+	if s.summary.ExitCode != nil {
+		s.summary.FailureClassification = detectors.ClassifyInvocation(s.summary.ExitCode.Name, s.summary.Problems)
+	}
+	s.summary.TestHealthReports = AnalyzeTestHealth(s.summary.Tests, s.summary.Targets, s.testHealthThresholds)
+
 	return s.summary, nil
 }
 
@@ -131,7 +138,7 @@ func (s Summarizer) ProcessEvent(buildEvent *events.BuildEvent) error {
 		s.handleFetch(buildEvent.GetFetch())
 
 	case *bes.BuildEventId_TestResult:
-		s.handleTestResult(buildEvent.GetTestResult(), buildEvent.GetId().GetTestResult().Label)
+		s.handleTestResult(buildEvent.GetTestResult(), buildEvent.GetId().GetTestResult())
 
 	case *bes.BuildEventId_TestSummary:
 		s.handleTestSummary(buildEvent.GetTestSummary(), buildEvent.GetId().GetTestSummary().Label)
@@ -257,7 +264,8 @@ func (s Summarizer) handleTargetCompleted(target *bes.TargetComplete, label stri
 }
 
 // handleTestResult
-func (s Summarizer) handleTestResult(testResult *bes.TestResult, label string) {
+func (s Summarizer) handleTestResult(testResult *bes.TestResult, id *bes.BuildEventId_TestResultId) {
+	label := id.GetLabel()
 	if len(label) == 0 {
 		panic("missing label on TestResult event")
 	}
@@ -285,6 +293,9 @@ func (s Summarizer) handleTestResult(testResult *bes.TestResult, label string) {
 		Status:              TestStatus(testResult.Status),
 		StatusDetails:       testResult.StatusDetails,
 		Label:               label,
+		Run:                 id.GetRun(),
+		Shard:               id.GetShard(),
+		Attempt:             id.GetAttempt(),
 		Warning:             testResult.Warning,
 		CachedLocally:       testResult.CachedLocally,
 		TestAttemptStart:    testResult.TestAttemptStart.AsTime().String(),
@@ -394,15 +405,15 @@ func (s Summarizer) handleBuildMetadata(metadataProto *bes.BuildMetadata) {
 	if metadataMap == nil {
 		return
 	}
-	stepLabel, stepLabelOk := metadataMap[stepLabelKey]
+	stepLabel, stepLabelOk := metadataMap[StepLabelKey]
 	if !stepLabelOk {
 		slog.Debug("No step label found in build metadata")
 	}
-	userEmail, userEmailOk := metadataMap[userEmailKey]
+	userEmail, userEmailOk := metadataMap[UserEmailKey]
 	if !userEmailOk {
 		slog.Debug("No user email found in build metadata")
 	}
-	userLdap, userLdapOk := metadataMap[userLdapKey]
+	userLdap, userLdapOk := metadataMap[UserLdapKey]
 	if !userLdapOk {
 		slog.Debug("No user ldap information found in build metadata")
 	}
@@ -748,6 +759,18 @@ func (s Summarizer) handleStructuredCommandLine(structuredCommandLine *bescore.C
 	s.summary.BuildURL = s.summary.InvocationSummary.EnvVars["BUILD_URL"]
 	s.summary.BuildUUID = uuid.NewSHA1(uuid.NameSpaceURL, []byte(s.summary.BuildURL))
 
+	// Set the branch, preferring git over Gerrit.
+	s.summary.Branch = s.summary.InvocationSummary.EnvVars["GIT_BRANCH"]
+	if s.summary.Branch == "" {
+		s.summary.Branch = s.summary.InvocationSummary.EnvVars["GERRIT_BRANCH"]
+	}
+
+	// Set the commit, as reported by the Jenkins git or GitHub plugin.
+	s.summary.Commit = s.summary.InvocationSummary.EnvVars["GIT_COMMIT"]
+	if s.summary.Commit == "" {
+		s.summary.Commit = s.summary.InvocationSummary.EnvVars["GIT_SHA"]
+	}
+
 	return nil
 }
 
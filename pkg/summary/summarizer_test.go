@@ -51,7 +51,7 @@ func testDirectory(t *testing.T, baseDir string) error {
 		t.Run(name, func(t *testing.T) {
 			ctx := context.Background()
 			file := filepath.Join(baseDir, name)
-			gotSummary, err := summary.Summarize(ctx, file)
+			gotSummary, err := summary.Summarize(ctx, file, summary.DefaultTestHealthThresholds())
 
 			// the timestamps and duration related fields can and will differ between runs, so we need to zero these out
 			for label, target := range gotSummary.Targets {
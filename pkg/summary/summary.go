@@ -12,10 +12,10 @@ import (
 
 // Step Label and user Key constants.
 const (
-	// stepLabelKey is used in buildMetadata events to provide a human-readable label for build steps.
-	stepLabelKey = "BUILD_STEP_LABEL"
-	userEmailKey = "user_email"
-	userLdapKey  = "user_ldap"
+	// StepLabelKey is used in buildMetadata events to provide a human-readable label for build steps.
+	StepLabelKey = "BUILD_STEP_LABEL"
+	UserEmailKey = "user_email"
+	UserLdapKey  = "user_ldap"
 )
 
 // Exit Code constatn.
@@ -178,6 +178,8 @@ type Summary struct {
 	PatchsetNumber       int
 	BuildURL             string
 	BuildUUID            uuid.UUID
+	Branch               string
+	Commit               string
 	UserLDAP             string
 	UserEmail            string
 	BuildLogs            strings.Builder
@@ -188,6 +190,11 @@ type Summary struct {
 	CPU                  string
 	PlatformName         string
 	ConfigrationMnemonic string
+
+	// FailureClassification tells who is responsible for a failed invocation, empty if it did not fail.
+	FailureClassification detectors.FailureClassification
+	// TestHealthReports are the tests with imbalanced shards, close to their timeout or oversized.
+	TestHealthReports []TestHealthReport
 }
 
 // Metrics holds Build metrics details
@@ -417,6 +424,9 @@ type TestResult struct {
 	Status              TestStatus
 	StatusDetails       string
 	Label               string
+	Run                 int32
+	Shard               int32
+	Attempt             int32
 	Warning             []string
 	CachedLocally       bool
 	TestAttemptDuration int64
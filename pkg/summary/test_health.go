@@ -0,0 +1,191 @@
+package summary
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"sort"
+
+	"github.com/buildbarn/bb-portal/third_party/bazel/gen/bes"
+)
+
+// TestHealthIssue enum.
+type TestHealthIssue string
+
+// TestHealthIssue values.
+const (
+	// TestHealthImbalancedShards The slowest shard of the test is far slower than the median shard.
+	TestHealthImbalancedShards TestHealthIssue = "IMBALANCED_SHARDS"
+	// TestHealthNearTimeout The test consistently uses most of the timeout of its size.
+	TestHealthNearTimeout TestHealthIssue = "NEAR_TIMEOUT"
+	// TestHealthOversized The test would comfortably fit in a smaller size.
+	TestHealthOversized TestHealthIssue = "OVERSIZED"
+)
+
+// minImbalancedShardDurationInMs Shards faster than this are never reported as imbalanced, it is not worth it.
+const minImbalancedShardDurationInMs = 10_000
+
+// TestHealthThresholds Thresholds above which test health issues are reported. The zero fields take the default
+// thresholds.
+type TestHealthThresholds struct {
+	// ShardImbalanceRatio is the ratio of the slowest shard duration to the median shard duration.
+	ShardImbalanceRatio float64
+	// TimeoutUsage is the fraction of the timeout of its size a test may use.
+	TimeoutUsage float64
+	// OversizedTimeoutUsage is the fraction of the timeout of a smaller size under which a test is oversized.
+	OversizedTimeoutUsage float64
+}
+
+// DefaultTestHealthThresholds The thresholds used unless configured otherwise.
+func DefaultTestHealthThresholds() TestHealthThresholds {
+	return TestHealthThresholds{
+		ShardImbalanceRatio:   2,
+		TimeoutUsage:          0.75,
+		OversizedTimeoutUsage: 0.25,
+	}
+}
+
+// withDefaults The thresholds with the zero fields set to the default ones.
+func (t TestHealthThresholds) withDefaults() TestHealthThresholds {
+	defaults := DefaultTestHealthThresholds()
+	return TestHealthThresholds{
+		ShardImbalanceRatio:   cmp.Or(t.ShardImbalanceRatio, defaults.ShardImbalanceRatio),
+		TimeoutUsage:          cmp.Or(t.TimeoutUsage, defaults.TimeoutUsage),
+		OversizedTimeoutUsage: cmp.Or(t.OversizedTimeoutUsage, defaults.OversizedTimeoutUsage),
+	}
+}
+
+// TestHealthReport An issue with the sharding or size of a test, with a suggested fix.
+type TestHealthReport struct {
+	Label                   string
+	Issue                   TestHealthIssue
+	TestSize                TestSize
+	ShardCount              int32
+	MaxDurationInMs         int64
+	MedianShardDurationInMs int64
+	TimeoutInMs             int64
+	SuggestedShardCount     int32
+	SuggestedSize           TestSize
+}
+
+// testSizes The test sizes with a timeout, from the smallest to the biggest.
+func testSizes() []TestSize {
+	return []TestSize{
+		TestSize(bes.TestSize_SMALL),
+		TestSize(bes.TestSize_MEDIUM),
+		TestSize(bes.TestSize_LARGE),
+		TestSize(bes.TestSize_ENORMOUS),
+	}
+}
+
+// TimeoutInMs The default timeout of tests of this size, 0 if unknown.
+func (r TestSize) TimeoutInMs() int64 {
+	switch bes.TestSize(r) {
+	case bes.TestSize_SMALL:
+		return 60_000
+	case bes.TestSize_MEDIUM:
+		return 300_000
+	case bes.TestSize_LARGE:
+		return 900_000
+	case bes.TestSize_ENORMOUS:
+		return 3_600_000
+	default:
+		return 0
+	}
+}
+
+// AnalyzeTestHealth Report tests with imbalanced shards, tests close to their timeout and oversized tests.
+func AnalyzeTestHealth(tests map[string]TestsCollection, targets map[string]TargetPair, thresholds TestHealthThresholds) []TestHealthReport {
+	thresholds = thresholds.withDefaults()
+	var reports []TestHealthReport
+	for label, test := range tests {
+		shardDurations := consistentShardDurations(test.TestResults)
+		if len(shardDurations) == 0 {
+			continue
+		}
+		slices.Sort(shardDurations)
+		report := TestHealthReport{
+			Label:                   label,
+			TestSize:                targets[label].TestSize,
+			ShardCount:              max(test.TestSummary.ShardCount, 1),
+			MaxDurationInMs:         shardDurations[len(shardDurations)-1],
+			MedianShardDurationInMs: shardDurations[len(shardDurations)/2],
+			TimeoutInMs:             targets[label].TestSize.TimeoutInMs(),
+		}
+		report.SuggestedShardCount = report.ShardCount
+		report.SuggestedSize = report.TestSize
+
+		if len(shardDurations) > 1 && report.MaxDurationInMs >= minImbalancedShardDurationInMs &&
+			float64(report.MaxDurationInMs) > thresholds.ShardImbalanceRatio*float64(max(report.MedianShardDurationInMs, 1)) {
+			imbalanced := report
+			imbalanced.Issue = TestHealthImbalancedShards
+			imbalanced.SuggestedShardCount = suggestedShardCount(shardDurations, report.MedianShardDurationInMs)
+			reports = append(reports, imbalanced)
+		}
+
+		if report.TimeoutInMs == 0 {
+			continue
+		}
+		if float64(report.MaxDurationInMs) > thresholds.TimeoutUsage*float64(report.TimeoutInMs) {
+			nearTimeout := report
+			nearTimeout.Issue = TestHealthNearTimeout
+			nearTimeout.SuggestedSize = smallestFittingSize(report.MaxDurationInMs, thresholds.TimeoutUsage)
+			if fittingTimeoutInMs := thresholds.TimeoutUsage * float64(nearTimeout.SuggestedSize.TimeoutInMs()); float64(report.MaxDurationInMs) > fittingTimeoutInMs {
+				// Too slow even for the biggest size, split it in more shards.
+				nearTimeout.SuggestedShardCount = int32(math.Ceil(float64(report.ShardCount) * float64(report.MaxDurationInMs) / fittingTimeoutInMs))
+			}
+			reports = append(reports, nearTimeout)
+		} else if fittingSize := smallestFittingSize(report.MaxDurationInMs, thresholds.OversizedTimeoutUsage); fittingSize < report.TestSize {
+			oversized := report
+			oversized.Issue = TestHealthOversized
+			oversized.SuggestedSize = fittingSize
+			reports = append(reports, oversized)
+		}
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		if reports[i].Label != reports[j].Label {
+			return reports[i].Label < reports[j].Label
+		}
+		return reports[i].Issue < reports[j].Issue
+	})
+	return reports
+}
+
+// consistentShardDurations Get the duration of each shard of a test, as the fastest of its runs and attempts so
+// that a single slow attempt does not count.
+func consistentShardDurations(testResults []TestResult) []int64 {
+	durations := map[int32]int64{}
+	for _, testResult := range testResults {
+		if testResult.TestAttemptDuration <= 0 {
+			continue
+		}
+		if duration, ok := durations[testResult.Shard]; !ok || testResult.TestAttemptDuration < duration {
+			durations[testResult.Shard] = testResult.TestAttemptDuration
+		}
+	}
+	shardDurations := make([]int64, 0, len(durations))
+	for _, duration := range durations {
+		shardDurations = append(shardDurations, duration)
+	}
+	return shardDurations
+}
+
+// suggestedShardCount The number of shards needed for every shard to take about the median shard duration.
+func suggestedShardCount(shardDurations []int64, medianShardDurationInMs int64) int32 {
+	var totalInMs int64
+	for _, duration := range shardDurations {
+		totalInMs += duration
+	}
+	return int32(math.Ceil(float64(totalInMs) / float64(max(medianShardDurationInMs, 1))))
+}
+
+// smallestFittingSize The smallest size whose timeout is not used above the given fraction, or the biggest size.
+func smallestFittingSize(durationInMs int64, timeoutUsage float64) TestSize {
+	sizes := testSizes()
+	for _, size := range sizes {
+		if float64(durationInMs) <= timeoutUsage*float64(size.TimeoutInMs()) {
+			return size
+		}
+	}
+	return sizes[len(sizes)-1]
+}
@@ -0,0 +1,124 @@
+package summary_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/buildbarn/bb-portal/pkg/summary"
+	"github.com/buildbarn/bb-portal/third_party/bazel/gen/bes"
+)
+
+// testWithShards Build a test collection with one attempt per shard duration.
+func testWithShards(shardDurations ...int64) summary.TestsCollection {
+	test := summary.TestsCollection{TestSummary: summary.TestSummary{ShardCount: int32(len(shardDurations))}}
+	for shard, duration := range shardDurations {
+		test.TestResults = append(test.TestResults, summary.TestResult{Shard: int32(shard), TestAttemptDuration: duration})
+	}
+	return test
+}
+
+// TestAnalyzeTestHealth
+func TestAnalyzeTestHealth(t *testing.T) {
+	small := summary.TestSize(bes.TestSize_SMALL)
+	medium := summary.TestSize(bes.TestSize_MEDIUM)
+	large := summary.TestSize(bes.TestSize_LARGE)
+	enormous := summary.TestSize(bes.TestSize_ENORMOUS)
+
+	retried := testWithShards(58_000)
+	retried.TestResults = append(retried.TestResults, summary.TestResult{Attempt: 2, TestAttemptDuration: 10_000})
+
+	tests := map[string]summary.TestsCollection{
+		"//:imbalanced": testWithShards(20_000, 20_000, 20_000, 90_000),
+		"//:near":       testWithShards(55_000),
+		"//:too_slow":   testWithShards(3_000_000),
+		"//:oversized":  testWithShards(10_000),
+		"//:retried":    retried,
+		"//:healthy":    testWithShards(30_000, 31_000),
+	}
+	targets := map[string]summary.TargetPair{
+		"//:imbalanced": {TestSize: medium},
+		"//:near":       {TestSize: small},
+		"//:too_slow":   {TestSize: enormous},
+		"//:oversized":  {TestSize: large},
+		"//:retried":    {TestSize: small},
+		"//:healthy":    {TestSize: medium},
+	}
+
+	reports := summary.AnalyzeTestHealth(tests, targets, summary.DefaultTestHealthThresholds())
+	require.Equal(t, []summary.TestHealthReport{
+		{
+			Label:                   "//:imbalanced",
+			Issue:                   summary.TestHealthImbalancedShards,
+			TestSize:                medium,
+			ShardCount:              4,
+			MaxDurationInMs:         90_000,
+			MedianShardDurationInMs: 20_000,
+			TimeoutInMs:             300_000,
+			SuggestedShardCount:     8,
+			SuggestedSize:           medium,
+		},
+		{
+			Label:                   "//:near",
+			Issue:                   summary.TestHealthNearTimeout,
+			TestSize:                small,
+			ShardCount:              1,
+			MaxDurationInMs:         55_000,
+			MedianShardDurationInMs: 55_000,
+			TimeoutInMs:             60_000,
+			SuggestedShardCount:     1,
+			SuggestedSize:           medium,
+		},
+		{
+			Label:                   "//:oversized",
+			Issue:                   summary.TestHealthOversized,
+			TestSize:                large,
+			ShardCount:              1,
+			MaxDurationInMs:         10_000,
+			MedianShardDurationInMs: 10_000,
+			TimeoutInMs:             900_000,
+			SuggestedShardCount:     1,
+			SuggestedSize:           small,
+		},
+		{
+			Label:                   "//:too_slow",
+			Issue:                   summary.TestHealthNearTimeout,
+			TestSize:                enormous,
+			ShardCount:              1,
+			MaxDurationInMs:         3_000_000,
+			MedianShardDurationInMs: 3_000_000,
+			TimeoutInMs:             3_600_000,
+			SuggestedShardCount:     2,
+			SuggestedSize:           enormous,
+		},
+	}, reports)
+}
+
+// TestAnalyzeTestHealth_Thresholds
+func TestAnalyzeTestHealth_Thresholds(t *testing.T) {
+	tests := map[string]summary.TestsCollection{
+		"//:imbalanced": testWithShards(20_000, 20_000, 20_000, 90_000),
+		"//:near":       testWithShards(55_000),
+		"//:oversized":  testWithShards(10_000),
+	}
+	targets := map[string]summary.TargetPair{
+		"//:imbalanced": {TestSize: summary.TestSize(bes.TestSize_MEDIUM)},
+		"//:near":       {TestSize: summary.TestSize(bes.TestSize_SMALL)},
+		"//:oversized":  {TestSize: summary.TestSize(bes.TestSize_LARGE)},
+	}
+
+	// The unset oversized timeout usage takes its default.
+	reports := summary.AnalyzeTestHealth(tests, targets, summary.TestHealthThresholds{
+		ShardImbalanceRatio: 5,
+		TimeoutUsage:        0.95,
+	})
+	require.Len(t, reports, 1)
+	require.Equal(t, "//:oversized", reports[0].Label)
+	require.Equal(t, summary.TestHealthOversized, reports[0].Issue)
+
+	require.Empty(t, summary.AnalyzeTestHealth(tests, targets, summary.TestHealthThresholds{
+		ShardImbalanceRatio:   5,
+		TimeoutUsage:          0.95,
+		OversizedTimeoutUsage: 0.01,
+	}))
+}